@@ -18,7 +18,6 @@ import (
 	"github.com/vmware/octant/pkg/plugin/service"
 	"github.com/vmware/octant/pkg/store"
 	"github.com/vmware/octant/pkg/view/component"
-	"github.com/vmware/octant/pkg/view/flexlayout"
 )
 
 var pluginName = "plugin-name"
@@ -31,22 +30,15 @@ func main() {
 	// This plugin is interested in Pods
 	podGVK := schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
 
-	// Tell Octant to call this plugin when printing configuration or tabs for Pods
-	capabilities := &plugin.Capabilities{
-		SupportsPrinterConfig: []schema.GroupVersionKind{podGVK},
-		SupportsTab:           []schema.GroupVersionKind{podGVK},
-		IsModule:              true,
-	}
-
-	// Set up what should happen when Octant calls this plugin.
-	options := []service.PluginOption{
-		service.WithPrinter(handlePrint),
-		service.WithTabPrinter(handleTab),
-		service.WithNavigation(handleNavigation, initRoutes),
-	}
-
-	// Use the plugin service helper to register this plugin.
-	p, err := service.Register(pluginName, "a description", capabilities, options...)
+	// Use the plugin builder to register this plugin. The builder tells
+	// Octant to call this plugin when printing configuration or tabs for
+	// Pods, and that this plugin is a module with navigation.
+	p, err := service.NewBuilder(pluginName, "a description").
+		HandlesObjects(podGVK).
+		WithPrinter(handlePrint).
+		WithTab(handleTab).
+		WithNavigation(handleNavigation, initRoutes).
+		Register()
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -62,27 +54,15 @@ func handleTab(request *service.PrintRequest) (plugin.TabResponse, error) {
 		return plugin.TabResponse{}, errors.New("object is nil")
 	}
 
-	// Octant uses flex layouts to display information. It's a flexible
-	// grid. A flex layout is composed of multiple section. Each section
-	// can contain multiple components. Components are displayed given
-	// a width. In the case below, the width is half of the visible space.
-	// Create sections to separate your components as each section will
-	// start a new row.
-	layout := flexlayout.New()
-	section := layout.AddSection()
-
 	// Octant contain's a library of components that can be used to display content.
 	// This example uses markdown text.
 	contents := component.NewMarkdownText("content from a *plugin*")
 
-	err := section.Add(contents, component.WidthHalf)
-	if err != nil {
-		return plugin.TabResponse{}, err
-	}
-
 	// In this example, this plugin will tell Octant to create a new
 	// tab when showing pods. This tab's name will be "Extra Pod Details".
-	tab := component.NewTabWithContents(*layout.ToComponent("Extra Pod Details"))
+	// Tabs use flex layouts to display information. Each component is
+	// shown in its own row.
+	tab := service.NewTab("Extra Pod Details", contents)
 
 	return plugin.TabResponse{Tab: tab}, nil
 }
@@ -113,8 +93,8 @@ func handlePrint(request *service.PrintRequest) (plugin.PrintResponse, error) {
 
 	// Octant has a component library that can be used to build content for a plugin.
 	// In this case, the plugin is creating a card.
-	podCard := component.NewCard(fmt.Sprintf("Extra Output for %s", u.GetName()))
-	podCard.SetBody(component.NewMarkdownText("This output was generated from _octant-sample-plugin_"))
+	podCard := service.NewCard(fmt.Sprintf("Extra Output for %s", u.GetName()),
+		"This output was generated from _octant-sample-plugin_")
 
 	msg := fmt.Sprintf("update from plugin at %s", time.Now().Format(time.RFC3339))
 
@@ -127,10 +107,10 @@ func handlePrint(request *service.PrintRequest) (plugin.PrintResponse, error) {
 	//   summary section for the component.
 	return plugin.PrintResponse{
 		Config: []component.SummarySection{
-			{Header: "from-plugin", Content: component.NewText(msg)},
+			service.TextSection("from-plugin", msg),
 		},
 		Status: []component.SummarySection{
-			{Header: "from-plugin", Content: component.NewText(msg)},
+			service.TextSection("from-plugin", msg),
 		},
 		Items: []component.FlexLayoutItem{
 			{
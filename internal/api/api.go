@@ -17,9 +17,13 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/vmware/octant/internal/config"
+	octantErrors "github.com/vmware/octant/internal/errors"
+	"github.com/vmware/octant/internal/exec"
+	"github.com/vmware/octant/internal/export"
 	"github.com/vmware/octant/internal/log"
 	"github.com/vmware/octant/internal/mime"
 	"github.com/vmware/octant/internal/module"
+	"github.com/vmware/octant/internal/session"
 )
 
 //go:generate mockgen -destination=./fake/mock_service.go -package=fake github.com/vmware/octant/internal/api Service
@@ -33,7 +37,7 @@ const (
 	defaultListenerAddr = "127.0.0.1:7777"
 )
 
-func acceptedHosts() []string {
+func acceptedHosts(listenerAddr string) []string {
 	hosts := []string{
 		"localhost",
 		"127.0.0.1",
@@ -43,10 +47,9 @@ func acceptedHosts() []string {
 		hosts = append(hosts, allowedHosts...)
 	}
 
-	listenerAddr := ListenerAddr()
 	host, _, err := net.SplitHostPort(listenerAddr)
 	if err != nil {
-		panic(fmt.Sprintf("Unable to parse OCTANT_LISTENER_ADDR: %s", listenerAddr))
+		panic(fmt.Sprintf("Unable to parse listener address: %s", listenerAddr))
 	}
 
 	hosts = append(hosts, host)
@@ -76,8 +79,9 @@ type Service interface {
 }
 
 type errorMessage struct {
-	Code    int    `json:"code,omitempty"`
-	Message string `json:"message,omitempty"`
+	Code    int               `json:"code,omitempty"`
+	Type    octantErrors.Code `json:"type,omitempty"`
+	Message string            `json:"message,omitempty"`
 }
 
 type errorResponse struct {
@@ -86,16 +90,30 @@ type errorResponse struct {
 
 // RespondWithError responds with an error message.
 func RespondWithError(w http.ResponseWriter, code int, message string, logger log.Logger) {
+	respondWithError(w, errorMessage{Code: code, Message: message}, logger)
+}
+
+// RespondWithTypedError responds with an error's message. The status code
+// and the type in the response are derived from the error's code.
+func RespondWithTypedError(w http.ResponseWriter, err error, logger log.Logger) {
+	errorCode := octantErrors.CodeOf(err)
+	respondWithError(w, errorMessage{
+		Code:    octantErrors.HTTPStatus(errorCode),
+		Type:    errorCode,
+		Message: err.Error(),
+	}, logger)
+}
+
+func respondWithError(w http.ResponseWriter, message errorMessage, logger log.Logger) {
+	code := message.Code
 	r := &errorResponse{
-		Error: errorMessage{
-			Code:    code,
-			Message: message,
-		},
+		Error: message,
 	}
 
 	logger.With(
 		"code", code,
-		"message", message,
+		"type", message.Type,
+		"message", message.Message,
 	).Infof("unable to serve")
 
 	w.Header().Set("Content-Type", mime.JSONContentType)
@@ -116,17 +134,64 @@ type API struct {
 	dashConfig       config.Dash
 	logger           log.Logger
 
-	modulePaths   map[string]module.Module
-	modules       []module.Module
-	forceUpdateCh chan bool
+	modulePaths    map[string]module.Module
+	modules        []module.Module
+	forceUpdateCh  chan bool
+	listenerAddr   string
+	exportRegistry *export.Registry
+	sessions       *session.Registry
+	accessLogger   log.Logger
+	executor       exec.Interface
 }
 
 var _ Service = (*API)(nil)
 
+// Option is an option for configuring API.
+type Option func(a *API)
+
+// WithListenerAddr sets the address the API is served on. Its host is
+// accepted by the DNS rebinding protection. It defaults to ListenerAddr.
+func WithListenerAddr(listenerAddr string) Option {
+	return func(a *API) {
+		a.listenerAddr = listenerAddr
+	}
+}
+
+// WithExportRegistry sets the formats components can be exported as. It
+// defaults to the built in formats.
+func WithExportRegistry(registry *export.Registry) Option {
+	return func(a *API) {
+		a.exportRegistry = registry
+	}
+}
+
+// WithSessions sets the registry which tracks connected clients.
+func WithSessions(registry *session.Registry) Option {
+	return func(a *API) {
+		a.sessions = registry
+	}
+}
+
+// WithAccessLog logs HTTP and websocket requests.
+func WithAccessLog() Option {
+	return func(a *API) {
+		a.accessLogger = a.dashConfig.Logger().With("component", "access")
+	}
+}
+
+// WithExec serves terminals attached to commands run in containers by
+// executor, and copies files to and from containers with it. Neither is
+// served without it.
+func WithExec(executor exec.Interface) Option {
+	return func(a *API) {
+		a.executor = executor
+	}
+}
+
 // New creates an instance of API.
-func New(ctx context.Context, prefix string, actionDispatcher ActionDispatcher, dashConfig config.Dash) *API {
+func New(ctx context.Context, prefix string, actionDispatcher ActionDispatcher, dashConfig config.Dash, options ...Option) *API {
 	logger := dashConfig.Logger().With("component", "api")
-	return &API{
+	a := &API{
 		ctx:              ctx,
 		prefix:           prefix,
 		actionDispatcher: actionDispatcher,
@@ -134,7 +199,17 @@ func New(ctx context.Context, prefix string, actionDispatcher ActionDispatcher,
 		dashConfig:       dashConfig,
 		logger:           logger,
 		forceUpdateCh:    make(chan bool, 1),
+		listenerAddr:     ListenerAddr(),
+		exportRegistry:   export.DefaultRegistry(),
+		sessions:         session.NewRegistry(),
+		accessLogger:     log.NopLogger(),
+	}
+
+	for _, option := range options {
+		option(a)
 	}
+
+	return a
 }
 
 func (a *API) ForceUpdate() error {
@@ -145,13 +220,24 @@ func (a *API) ForceUpdate() error {
 // Handler returns a HTTP handler for the service.
 func (a *API) Handler(ctx context.Context) (*mux.Router, error) {
 	router := mux.NewRouter()
-	router.Use(rebindHandler(ctx, acceptedHosts()))
+	hosts := acceptedHosts(a.listenerAddr)
+	router.Use(rebindHandler(ctx, hosts))
+	router.Use(accessLogHandler(a.accessLogger))
 
 	s := router.PathPrefix(a.prefix).Subrouter()
 
 	s.HandleFunc("/logs/namespace/{namespace}/pod/{pod}/container/{container}", containerLogsHandler(ctx, a.dashConfig.ClusterClient()))
+	s.HandleFunc("/logs/namespace/{namespace}/pods", aggregatedLogsHandler(ctx, a.dashConfig.ClusterClient()))
+	if a.executor != nil {
+		s.HandleFunc("/exec/namespace/{namespace}/pod/{pod}/container/{container}", execHandler(ctx, a.executor, newUpgrader(hosts)))
+		s.HandleFunc("/copy/namespace/{namespace}/pod/{pod}/container/{container}", copyHandler(ctx, a.executor)).
+			Methods(http.MethodGet, http.MethodPost)
+	}
+	s.HandleFunc("/inventory", inventoryHandler(ctx, a.dashConfig.ClusterClient(), a.dashConfig.ObjectStore()))
+	s.HandleFunc("/export", exportHandler(ctx, a.exportRegistry)).Methods(http.MethodPost)
+	s.HandleFunc("/export/table", exportTableHandler(ctx, a.dashConfig.ModuleManager())).Methods(http.MethodGet)
 
-	manager := NewWebsocketClientManager(ctx, a.actionDispatcher)
+	manager := NewWebsocketClientManager(ctx, a.actionDispatcher, hosts, a.sessions, a.accessLogger)
 	go manager.Run(ctx)
 	s.Handle("/stream", websocketService(manager, a.dashConfig))
 
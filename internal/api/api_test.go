@@ -13,6 +13,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -24,6 +25,7 @@ import (
 	apiFake "github.com/vmware/octant/internal/api/fake"
 	clusterFake "github.com/vmware/octant/internal/cluster/fake"
 	configFake "github.com/vmware/octant/internal/config/fake"
+	octantErrors "github.com/vmware/octant/internal/errors"
 	"github.com/vmware/octant/internal/log"
 	"github.com/vmware/octant/internal/module"
 	moduleFake "github.com/vmware/octant/internal/module/fake"
@@ -46,6 +48,57 @@ func TestAPI_routes(t *testing.T) {
 			method:       http.MethodGet,
 			expectedCode: http.StatusNotFound,
 		},
+		{
+			path:         "/inventory?format=xml",
+			method:       http.MethodGet,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			path:            "/export?format=markdown",
+			method:          http.MethodPost,
+			body:            strings.NewReader(`{"metadata":{"type":"summary"},"config":{"sections":[{"header":"Replicas","content":{"metadata":{"type":"text"},"config":{"value":"3"}}}]}}`),
+			expectedCode:    http.StatusOK,
+			expectedContent: "| Field | Value |\n| --- | --- |\n| Replicas | 3 |\n",
+		},
+		{
+			path:         "/export?format=xml",
+			method:       http.MethodPost,
+			body:         strings.NewReader(`{}`),
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			path:         "/export",
+			method:       http.MethodPost,
+			body:         strings.NewReader(`{"metadata":{"type":"text"},"config":{"value":"3"}}`),
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			path:            "/export/table?path=module/pods",
+			method:          http.MethodGet,
+			expectedCode:    http.StatusOK,
+			expectedContent: "Name\npod-1\n",
+		},
+		{
+			path:            "/export/table?path=module/pods&format=json&search=pod",
+			method:          http.MethodGet,
+			expectedCode:    http.StatusOK,
+			expectedContent: `[{"Name":"pod-1"}]` + "\n",
+		},
+		{
+			path:         "/export/table?path=module/pods&format=xml",
+			method:       http.MethodGet,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			path:         "/export/table?path=module/pods&table=1",
+			method:       http.MethodGet,
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			path:         "/export/table?path=missing",
+			method:       http.MethodGet,
+			expectedCode: http.StatusNotFound,
+		},
 	}
 
 	for _, tc := range cases {
@@ -59,6 +112,7 @@ func TestAPI_routes(t *testing.T) {
 			dashConfig.EXPECT().Logger().Return(logger).AnyTimes()
 			clusterClient := clusterFake.NewMockClientInterface(controller)
 			dashConfig.EXPECT().ClusterClient().Return(clusterClient).AnyTimes()
+			dashConfig.EXPECT().ObjectStore().Return(nil).AnyTimes()
 
 			m := moduleFake.NewMockModule(controller)
 			m.EXPECT().
@@ -67,7 +121,7 @@ func TestAPI_routes(t *testing.T) {
 				ContentPath().Return("/module").AnyTimes()
 			m.EXPECT().
 				Content(gomock.Any(), gomock.Any(), gomock.Any()).
-				DoAndReturn(func(ctx context.Context, contentPath, prefix, namespace string, opts module.ContentOptions) (component.ContentResponse, error) {
+				DoAndReturn(func(ctx context.Context, contentPath string, opts module.ContentOptions) (component.ContentResponse, error) {
 					switch contentPath {
 					case "/":
 						return component.ContentResponse{
@@ -77,6 +131,17 @@ func TestAPI_routes(t *testing.T) {
 						return component.ContentResponse{
 							Title: component.Title(component.NewText("/nested")),
 						}, nil
+					case "/pods":
+						table := component.NewTable("Pods", "placeholder", component.NewTableCols("Name"))
+						table.SetSearchable(true)
+						table.Add(component.TableRow{"Name": component.NewLink("", "pod-1", "/pod-1")})
+						table.Query(opts.Query)
+
+						layout := component.NewFlexLayout("Pods")
+						layout.AddSections(component.FlexLayoutSection{{Width: component.WidthFull, View: table}})
+						return component.ContentResponse{
+							Components: []component.Component{layout},
+						}, nil
 					default:
 						return component.ContentResponse{}, errors.New("not found")
 					}
@@ -94,6 +159,18 @@ func TestAPI_routes(t *testing.T) {
 				}).
 				AnyTimes()
 
+			moduleManager := moduleFake.NewMockManagerInterface(controller)
+			moduleManager.EXPECT().
+				ModuleForContentPath(gomock.Any()).
+				DoAndReturn(func(contentPath string) (module.Module, bool) {
+					if strings.HasPrefix(contentPath, "module") {
+						return m, true
+					}
+					return nil, false
+				}).
+				AnyTimes()
+			dashConfig.EXPECT().ModuleManager().Return(moduleManager).AnyTimes()
+
 			actionDispatcher := apiFake.NewMockActionDispatcher(controller)
 
 			ctx := context.Background()
@@ -133,3 +210,15 @@ func TestAPI_routes(t *testing.T) {
 		})
 	}
 }
+
+func TestRespondWithTypedError(t *testing.T) {
+	w := httptest.NewRecorder()
+
+	err := errors.Wrap(octantErrors.NewForbidden("no list access to pods"), "generate content")
+	api.RespondWithTypedError(w, err, log.NopLogger())
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	expected := `{"error":{"code":403,"type":"Forbidden","message":"generate content: no list access to pods"}}`
+	assert.JSONEq(t, expected, w.Body.String())
+}
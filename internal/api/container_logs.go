@@ -9,25 +9,62 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
-	"strings"
-	"time"
+	"net/url"
+	"sort"
+	"strconv"
 
 	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/vmware/octant/internal/cluster"
 	"github.com/vmware/octant/internal/log"
 	"github.com/vmware/octant/internal/modules/overview/container"
+	"github.com/vmware/octant/pkg/view/component"
 )
 
-type logEntry struct {
-	Timestamp time.Time `json:"timestamp,omitempty"`
-	Message   string    `json:"message,omitempty"`
-}
+const (
+	// maxLogEntries is the number of most recent entries returned when
+	// logs are not followed.
+	maxLogEntries = 100
+)
 
 type logResponse struct {
-	Entries []logEntry `json:"entries,omitempty"`
+	Entries []component.LogEntry `json:"entries,omitempty"`
+}
+
+// logOptionsFromQuery parses the sinceSeconds, follow, and previous
+// parameters of a logs request.
+func logOptionsFromQuery(query url.Values) (container.LogOptions, error) {
+	var options container.LogOptions
+
+	if value := query.Get("sinceSeconds"); value != "" {
+		sinceSeconds, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || sinceSeconds < 0 {
+			return container.LogOptions{}, errors.New("invalid sinceSeconds")
+		}
+		options.SinceSeconds = sinceSeconds
+	}
+
+	for name, dest := range map[string]*bool{"follow": &options.Follow, "previous": &options.Previous} {
+		value := query.Get(name)
+		if value == "" {
+			continue
+		}
+
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return container.LogOptions{}, errors.Errorf("invalid %s", name)
+		}
+		*dest = b
+	}
+
+	return options, nil
 }
 
+// containerLogsHandler returns a container's logs.
 func containerLogsHandler(ctx context.Context, clusterClient cluster.ClientInterface) http.HandlerFunc {
 	logger := log.From(ctx)
 
@@ -38,52 +75,167 @@ func containerLogsHandler(ctx context.Context, clusterClient cluster.ClientInter
 		podName := vars["pod"]
 		namespace := vars["namespace"]
 
+		options, err := logOptionsFromQuery(r.URL.Query())
+		if err != nil {
+			RespondWithError(w, http.StatusBadRequest, err.Error(), logger)
+			return
+		}
+
 		kubeClient, err := clusterClient.KubernetesClient()
 		if err != nil {
-			RespondWithError(w, http.StatusInternalServerError, err.Error(), logger)
+			RespondWithTypedError(w, err, logger)
 			return
 		}
 
-		lines := make(chan string)
-		done := make(chan bool)
+		respondWithLogEntries(w, options.Follow, logger, func(entryCh chan<- component.LogEntry) error {
+			return container.LogEntries(r.Context(), kubeClient, namespace, podName, containerName, options, entryCh)
+		})
+	}
+}
 
-		var entries []logEntry
+// aggregatedLogsHandler returns the merged logs of the pods in a namespace
+// which match the selector parameter. The container parameter limits logs
+// to a container. The include and exclude parameters filter entries by
+// their message.
+func aggregatedLogsHandler(ctx context.Context, clusterClient cluster.ClientInterface) http.HandlerFunc {
+	logger := log.From(ctx)
 
-		go func() {
-			for line := range lines {
-				parts := strings.SplitN(line, " ", 2)
-				logTime, err := time.Parse(time.RFC3339, parts[0])
-				if err == nil {
-					entries = append(entries, logEntry{
-						Timestamp: logTime,
-						Message:   parts[1],
-					})
-				}
-			}
+	return func(w http.ResponseWriter, r *http.Request) {
+		namespace := mux.Vars(r)["namespace"]
+		query := r.URL.Query()
 
-			done <- true
-		}()
+		options, err := logOptionsFromQuery(query)
+		if err != nil {
+			RespondWithError(w, http.StatusBadRequest, err.Error(), logger)
+			return
+		}
 
-		err = container.Logs(r.Context(), kubeClient, namespace, podName, containerName, lines)
+		selector, err := labels.Parse(query.Get("selector"))
 		if err != nil {
-			RespondWithError(w, http.StatusInternalServerError, err.Error(), logger)
+			RespondWithError(w, http.StatusBadRequest, "invalid selector", logger)
 			return
 		}
 
-		<-done
+		filter, err := container.NewLogFilter(query.Get("include"), query.Get("exclude"))
+		if err != nil {
+			RespondWithError(w, http.StatusBadRequest, err.Error(), logger)
+			return
+		}
 
-		var lr logResponse
+		kubeClient, err := clusterClient.KubernetesClient()
+		if err != nil {
+			RespondWithTypedError(w, err, logger)
+			return
+		}
 
-		if len(entries) <= 100 {
-			lr.Entries = entries
-		} else {
-			// take last 100 entries from the slice
-			lr.Entries = entries[len(entries)-100:]
+		pods, err := kubeClient.CoreV1().Pods(namespace).List(metav1.ListOptions{LabelSelector: selector.String()})
+		if err != nil {
+			RespondWithTypedError(w, err, logger)
+			return
 		}
 
-		if err := json.NewEncoder(w).Encode(&lr); err != nil {
-			logger := log.From(ctx)
-			logger.With("err", err.Error()).Errorf("unable to encode log entries")
+		containers := podContainers(pods.Items, query.Get("container"))
+
+		respondWithLogEntries(w, options.Follow, logger, func(entryCh chan<- component.LogEntry) error {
+			return container.AggregateLogs(r.Context(), kubeClient, namespace, containers, options, filter, entryCh)
+		})
+	}
+}
+
+// podContainers returns the containers of pods. If name is not blank, only
+// containers with that name are returned.
+func podContainers(pods []corev1.Pod, name string) []container.PodContainer {
+	var list []container.PodContainer
+	for _, pod := range pods {
+		for _, c := range pod.Spec.Containers {
+			if name != "" && c.Name != name {
+				continue
+			}
+
+			list = append(list, container.PodContainer{Pod: pod.Name, Container: c.Name})
+		}
+	}
+
+	return list
+}
+
+// respondWithLogEntries responds with the entries sent by stream. Unless
+// they are followed, the most recent entries are returned in a single
+// response. Followed entries are streamed as newline delimited JSON until
+// the client disconnects.
+func respondWithLogEntries(w http.ResponseWriter, follow bool, logger log.Logger, stream func(entryCh chan<- component.LogEntry) error) {
+	var entries []component.LogEntry
+	var handleEntry func(entry component.LogEntry)
+
+	if follow {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			RespondWithError(w, http.StatusInternalServerError, "streaming is not supported", logger)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		encoder := json.NewEncoder(w)
+		handleEntry = func(entry component.LogEntry) {
+			if err := encoder.Encode(&entry); err != nil {
+				logger.With("err", err.Error()).Debugf("unable to stream log entry")
+				return
+			}
+			flusher.Flush()
+		}
+	} else {
+		handleEntry = func(entry component.LogEntry) {
+			entries = append(entries, entry)
 		}
 	}
+
+	entryCh := make(chan component.LogEntry)
+	done := make(chan bool)
+
+	go func() {
+		for entry := range entryCh {
+			handleEntry(entry)
+		}
+
+		done <- true
+	}()
+
+	err := stream(entryCh)
+	<-done
+
+	if err != nil {
+		if follow {
+			// The response has started, so the error can only be logged.
+			logger.With("err", err.Error()).Errorf("streaming logs")
+			return
+		}
+		RespondWithTypedError(w, err, logger)
+		return
+	}
+
+	if follow {
+		return
+	}
+
+	// Merged streams are interleaved, so order entries by when they were
+	// logged.
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Timestamp.Before(entries[j].Timestamp)
+	})
+
+	var lr logResponse
+
+	if len(entries) <= maxLogEntries {
+		lr.Entries = entries
+	} else {
+		// take the most recent entries from the slice
+		lr.Entries = entries[len(entries)-maxLogEntries:]
+	}
+
+	if err := json.NewEncoder(w).Encode(&lr); err != nil {
+		logger.With("err", err.Error()).Errorf("unable to encode log entries")
+	}
 }
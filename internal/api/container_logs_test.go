@@ -0,0 +1,60 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/octant/internal/modules/overview/container"
+)
+
+func Test_logOptionsFromQuery(t *testing.T) {
+	cases := []struct {
+		name     string
+		query    string
+		expected container.LogOptions
+		isErr    bool
+	}{
+		{
+			name: "no options",
+		},
+		{
+			name:     "all options",
+			query:    "sinceSeconds=30&follow=true&previous=1",
+			expected: container.LogOptions{SinceSeconds: 30, Follow: true, Previous: true},
+		},
+		{
+			name:  "negative since seconds",
+			query: "sinceSeconds=-1",
+			isErr: true,
+		},
+		{
+			name:  "invalid follow",
+			query: "follow=maybe",
+			isErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			query, err := url.ParseQuery(tc.query)
+			require.NoError(t, err)
+
+			got, err := logOptionsFromQuery(query)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
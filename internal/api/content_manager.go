@@ -7,22 +7,32 @@ package api
 
 import (
 	"context"
+	"reflect"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
 
+	octantErrors "github.com/vmware/octant/internal/errors"
 	"github.com/vmware/octant/internal/event"
+	"github.com/vmware/octant/internal/eventfilter"
 	"github.com/vmware/octant/internal/log"
 	"github.com/vmware/octant/internal/module"
 	"github.com/vmware/octant/internal/octant"
 	"github.com/vmware/octant/pkg/action"
 	"github.com/vmware/octant/pkg/view/component"
+	"github.com/vmware/octant/pkg/view/diff"
 )
 
 const (
 	RequestSetContentPath = "setContentPath"
 	RequestSetNamespace   = "setNamespace"
+
+	// DefaultPageLimit is the number of rows list tables are limited to when
+	// a client does not request a limit.
+	DefaultPageLimit = 100
 )
 
 // ContentManagerOption is an option for configuring ContentManager.
@@ -53,6 +63,15 @@ type ContentManager struct {
 	contentGenerateFunc ContentGenerateFunc
 	poller              Poller
 	updateContentCh     chan struct{}
+
+	// lastSentHash is the hash of the last content event sent. Content is
+	// only sent when it has changed.
+	lastSentHash string
+	// page is the page of rows requested by the client.
+	page component.TablePage
+	// query narrows the rows of list tables.
+	query component.TableQuery
+	mu    sync.Mutex
 }
 
 // NewContentManager creates an instance of ContentManager.
@@ -62,6 +81,7 @@ func NewContentManager(moduleManager module.ManagerInterface, logger log.Logger,
 		logger:          logger,
 		poller:          NewInterruptiblePoller("content"),
 		updateContentCh: make(chan struct{}, 1),
+		page:            component.TablePage{Limit: DefaultPageLimit},
 	}
 	cm.contentGenerateFunc = cm.generateContent
 
@@ -81,6 +101,7 @@ func (cm *ContentManager) Start(ctx context.Context, state octant.State, s Octan
 	}()
 
 	updateCancel := state.OnContentPathUpdate(func(contentPath string) {
+		cm.setLastSentHash("")
 		cm.updateContentCh <- struct{}{}
 	})
 	defer updateCancel()
@@ -95,19 +116,58 @@ func (cm *ContentManager) runUpdate(state octant.State, s OctantClient) PollerFu
 			return false
 		}
 
-		contentResponse, _, err := cm.contentGenerateFunc(ctx, state)
+		generateCtx := eventfilter.WithPresets(ctx, state.GetEventFilters())
+		contentResponse, _, err := cm.contentGenerateFunc(generateCtx, state)
 		if err != nil {
+			if ctx.Err() == nil {
+				cm.logger.WithErr(err).With("contentPath", contentPath).Errorf("generate content")
+				event := CreateContentErrorEvent(err, contentPath)
+				if cm.isChanged(event) {
+					s.Send(event)
+				}
+			}
 			return false
 		}
 
 		if ctx.Err() == nil {
-			s.Send(CreateContentEvent(contentResponse, state.GetNamespace(), contentPath, state.GetQueryParams()))
+			event := CreateContentEvent(contentResponse, state.GetNamespace(), contentPath, state.GetQueryParams())
+			if cm.isChanged(event) {
+				s.Send(event)
+			}
 		}
 
 		return false
 	}
 }
 
+// isChanged returns true if an event differs from the last event sent, and
+// records it as sent. Events which can't be hashed are always sent.
+func (cm *ContentManager) isChanged(event octant.Event) bool {
+	hash, err := diff.Hash(event)
+	if err != nil {
+		cm.logger.WithErr(err).Errorf("hash content event")
+		cm.setLastSentHash("")
+		return true
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	if hash == cm.lastSentHash {
+		return false
+	}
+
+	cm.lastSentHash = hash
+	return true
+}
+
+func (cm *ContentManager) setLastSentHash(hash string) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	cm.lastSentHash = hash
+}
+
 func (cm *ContentManager) generateContent(ctx context.Context, state octant.State) (component.ContentResponse, bool, error) {
 	contentPath := state.GetContentPath()
 	logger := cm.logger.With("contentPath", contentPath)
@@ -124,6 +184,8 @@ func (cm *ContentManager) generateContent(ctx context.Context, state octant.Stat
 	modulePath := strings.TrimPrefix(contentPath, m.Name())
 	options := module.ContentOptions{
 		LabelSet: FiltersToLabelSet(state.GetFilters()),
+		Page:     cm.Page(),
+		Query:    cm.Query(),
 	}
 	contentResponse, err := m.Content(ctx, modulePath, options)
 	if err != nil {
@@ -164,11 +226,175 @@ func (cm *ContentManager) SetQueryParams(state octant.State, payload action.Payl
 			}
 			state.SetFilters(list)
 		}
+
+		page, err := PageFromQueryParams(params)
+		if err != nil {
+			return errors.Wrap(err, "extract page from query params")
+		}
+		cm.setPage(page)
+
+		query, err := TableQueryFromQueryParams(params)
+		if err != nil {
+			return errors.Wrap(err, "extract table query from query params")
+		}
+		cm.setQuery(query)
 	}
 
 	return nil
 }
 
+// Page returns the page of rows list tables are limited to.
+func (cm *ContentManager) Page() component.TablePage {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	return cm.page
+}
+
+// setPage sets the page of rows. Content is regenerated if the page changed.
+func (cm *ContentManager) setPage(page component.TablePage) {
+	cm.mu.Lock()
+	changed := cm.page != page
+	cm.page = page
+	cm.mu.Unlock()
+
+	if changed {
+		cm.regenerate()
+	}
+}
+
+// Query returns the query which narrows the rows of list tables.
+func (cm *ContentManager) Query() component.TableQuery {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	return cm.query
+}
+
+// setQuery sets the table query. Content is regenerated if the query
+// changed.
+func (cm *ContentManager) setQuery(query component.TableQuery) {
+	cm.mu.Lock()
+	changed := !reflect.DeepEqual(cm.query, query)
+	cm.query = query
+	cm.mu.Unlock()
+
+	if changed {
+		cm.regenerate()
+	}
+}
+
+// regenerate generates content without waiting for the next poll. The
+// content is sent even if it has not changed.
+func (cm *ContentManager) regenerate() {
+	cm.setLastSentHash("")
+	select {
+	case cm.updateContentCh <- struct{}{}:
+	default:
+	}
+}
+
+// PageFromQueryParams converts the offset, limit, and continue query params
+// to a page. The limit defaults to DefaultPageLimit. A limit of zero
+// requests every row.
+func PageFromQueryParams(params map[string]interface{}) (component.TablePage, error) {
+	page := component.TablePage{Limit: DefaultPageLimit}
+
+	for key, dest := range map[string]*int{"offset": &page.Offset, "limit": &page.Limit} {
+		in, ok := params[key]
+		if !ok {
+			continue
+		}
+
+		if list, ok := in.([]interface{}); ok && len(list) > 0 {
+			in = list[0]
+		}
+
+		raw, ok := in.(string)
+		if !ok {
+			return component.TablePage{}, errors.Errorf("%s has unexpected type %T", key, in)
+		}
+
+		i, err := strconv.Atoi(raw)
+		if err != nil || i < 0 {
+			return component.TablePage{}, errors.Errorf("%s %q is not a positive number", key, raw)
+		}
+
+		*dest = i
+	}
+
+	if in, ok := params["continue"]; ok {
+		values, err := queryParamValues(in)
+		if err != nil {
+			return component.TablePage{}, errors.Wrap(err, "continue")
+		}
+		if len(values) > 0 {
+			page.Continue = values[0]
+		}
+	}
+
+	return page, nil
+}
+
+// TableQueryFromQueryParams converts the search and columnFilter query
+// params to a table query. Column filters are formatted as column:value,
+// and can be repeated to select more than one value.
+func TableQueryFromQueryParams(params map[string]interface{}) (component.TableQuery, error) {
+	var query component.TableQuery
+
+	if in, ok := params["search"]; ok {
+		values, err := queryParamValues(in)
+		if err != nil {
+			return component.TableQuery{}, errors.Wrap(err, "search")
+		}
+		if len(values) > 0 {
+			query.Search = values[0]
+		}
+	}
+
+	if in, ok := params["columnFilter"]; ok {
+		values, err := queryParamValues(in)
+		if err != nil {
+			return component.TableQuery{}, errors.Wrap(err, "columnFilter")
+		}
+
+		for _, value := range values {
+			parts := strings.SplitN(value, ":", 2)
+			if len(parts) != 2 || parts[0] == "" {
+				return component.TableQuery{}, errors.Errorf("column filter %q is not formatted as column:value", value)
+			}
+
+			if query.Filters == nil {
+				query.Filters = make(map[string][]string)
+			}
+			query.Filters[parts[0]] = append(query.Filters[parts[0]], parts[1])
+		}
+	}
+
+	return query, nil
+}
+
+// queryParamValues returns the values of a query param. Query params with
+// one value are strings, and query params with more than one are lists.
+func queryParamValues(in interface{}) ([]string, error) {
+	switch v := in.(type) {
+	case string:
+		return []string{v}, nil
+	case []interface{}:
+		var values []string
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, errors.Errorf("unexpected type %T", item)
+			}
+			values = append(values, s)
+		}
+		return values, nil
+	default:
+		return nil, errors.Errorf("unexpected type %T", in)
+	}
+}
+
 // SetNamespace sets the current namespace.
 func (cm *ContentManager) SetNamespace(state octant.State, payload action.Payload) error {
 	namespace, err := payload.String("namespace")
@@ -198,6 +424,19 @@ type notFound interface {
 	Path() string
 }
 
+// CreateContentErrorEvent creates a content error event. The event's type
+// is the error's code, so the client can react to it.
+func CreateContentErrorEvent(err error, contentPath string) octant.Event {
+	return octant.Event{
+		Type: octant.EventTypeContentError,
+		Data: map[string]interface{}{
+			"type":        octantErrors.CodeOf(err),
+			"message":     err.Error(),
+			"contentPath": contentPath,
+		},
+	}
+}
+
 // CreateContentEvent creates a content event.
 func CreateContentEvent(contentResponse component.ContentResponse, namespace, contentPath string, queryParams map[string][]string) octant.Event {
 	return octant.Event{
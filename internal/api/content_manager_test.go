@@ -8,12 +8,15 @@ package api_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/vmware/octant/internal/api"
 	"github.com/vmware/octant/internal/api/fake"
+	octantErrors "github.com/vmware/octant/internal/errors"
 	"github.com/vmware/octant/internal/log"
 	moduleFake "github.com/vmware/octant/internal/module/fake"
 	"github.com/vmware/octant/internal/octant"
@@ -49,6 +52,7 @@ func TestContentManager_GenerateContent(t *testing.T) {
 	state.EXPECT().GetContentPath().Return("/path")
 	state.EXPECT().GetNamespace().Return("default")
 	state.EXPECT().GetQueryParams().Return(params)
+	state.EXPECT().GetEventFilters().Return(nil)
 	state.EXPECT().OnContentPathUpdate(gomock.Any()).DoAndReturn(func(fn octant.ContentPathUpdateFunc) octant.UpdateCancelFunc {
 		fn("foo")
 		return func() {}
@@ -76,6 +80,106 @@ func TestContentManager_GenerateContent(t *testing.T) {
 	manager.Start(ctx, state, octantClient)
 }
 
+func TestContentManager_GenerateContent_error(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	moduleManager := moduleFake.NewMockManagerInterface(controller)
+	state := octantFake.NewMockState(controller)
+
+	state.EXPECT().GetContentPath().Return("/path")
+	state.EXPECT().GetEventFilters().Return(nil)
+	state.EXPECT().OnContentPathUpdate(gomock.Any()).DoAndReturn(func(fn octant.ContentPathUpdateFunc) octant.UpdateCancelFunc {
+		return func() {}
+	})
+
+	contentErr := octantErrors.NewTimeout("list pods")
+
+	octantClient := fake.NewMockOctantClient(controller)
+	octantClient.EXPECT().Send(api.CreateContentErrorEvent(contentErr, "/path"))
+
+	logger := log.NopLogger()
+
+	poller := api.NewSingleRunPoller()
+
+	contentGenerator := func(ctx context.Context, state octant.State) (component.ContentResponse, bool, error) {
+		return component.EmptyContentResponse, false, contentErr
+	}
+	manager := api.NewContentManager(moduleManager, logger,
+		api.WithContentGenerator(contentGenerator),
+		api.WithContentGeneratorPoller(poller))
+
+	ctx := context.Background()
+	manager.Start(ctx, state, octantClient)
+}
+
+func TestCreateContentErrorEvent(t *testing.T) {
+	event := api.CreateContentErrorEvent(octantErrors.NewForbidden("no access"), "/path")
+
+	expected := octant.Event{
+		Type: octant.EventTypeContentError,
+		Data: map[string]interface{}{
+			"type":        octantErrors.CodeForbidden,
+			"message":     "no access",
+			"contentPath": "/path",
+		},
+	}
+	assert.Equal(t, expected, event)
+}
+
+type repeatPoller struct {
+	times int
+}
+
+func (p repeatPoller) Run(ctx context.Context, ch <-chan struct{}, action api.PollerFunc, resetDuration time.Duration) {
+	for i := 0; i < p.times; i++ {
+		action(ctx)
+	}
+}
+
+func TestContentManager_GenerateContent_unchanged(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	params := map[string][]string{}
+
+	moduleManager := moduleFake.NewMockManagerInterface(controller)
+	state := octantFake.NewMockState(controller)
+
+	state.EXPECT().GetContentPath().Return("/path").AnyTimes()
+	state.EXPECT().GetNamespace().Return("default").AnyTimes()
+	state.EXPECT().GetQueryParams().Return(params).AnyTimes()
+	state.EXPECT().GetEventFilters().Return(nil).AnyTimes()
+	state.EXPECT().OnContentPathUpdate(gomock.Any()).Return(func() {})
+	octantClient := fake.NewMockOctantClient(controller)
+
+	responses := []component.ContentResponse{
+		{IconName: "first"},
+		{IconName: "first"},
+		{IconName: "second"},
+	}
+
+	gomock.InOrder(
+		octantClient.EXPECT().Send(api.CreateContentEvent(responses[0], "default", "/path", params)),
+		octantClient.EXPECT().Send(api.CreateContentEvent(responses[2], "default", "/path", params)),
+	)
+
+	logger := log.NopLogger()
+
+	run := 0
+	contentGenerator := func(ctx context.Context, state octant.State) (component.ContentResponse, bool, error) {
+		response := responses[run]
+		run++
+		return response, false, nil
+	}
+	manager := api.NewContentManager(moduleManager, logger,
+		api.WithContentGenerator(contentGenerator),
+		api.WithContentGeneratorPoller(repeatPoller{times: len(responses)}))
+
+	ctx := context.Background()
+	manager.Start(ctx, state, octantClient)
+}
+
 func TestContentManager_SetContentPath(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
@@ -126,9 +230,11 @@ func TestContentManager_SetNamespace(t *testing.T) {
 
 func TestContentManager_SetQueryParams(t *testing.T) {
 	tests := []struct {
-		name    string
-		payload action.Payload
-		setup   func(state *octantFake.MockState)
+		name          string
+		payload       action.Payload
+		setup         func(state *octantFake.MockState)
+		expectedPage  component.TablePage
+		expectedQuery component.TableQuery
 	}{
 		{
 			name: "single filter",
@@ -160,6 +266,31 @@ func TestContentManager_SetQueryParams(t *testing.T) {
 				})
 			},
 		},
+		{
+			name: "page",
+			payload: action.Payload{
+				"params": map[string]interface{}{
+					"offset": "200",
+					"limit":  "50",
+				},
+			},
+			setup:        func(state *octantFake.MockState) {},
+			expectedPage: component.TablePage{Offset: 200, Limit: 50},
+		},
+		{
+			name: "table query",
+			payload: action.Payload{
+				"params": map[string]interface{}{
+					"search":       "nginx",
+					"columnFilter": "Phase:Running",
+				},
+			},
+			setup: func(state *octantFake.MockState) {},
+			expectedQuery: component.TableQuery{
+				Filters: map[string][]string{"Phase": {"Running"}},
+				Search:  "nginx",
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -181,6 +312,125 @@ func TestContentManager_SetQueryParams(t *testing.T) {
 			manager := api.NewContentManager(moduleManager, logger,
 				api.WithContentGeneratorPoller(api.NewSingleRunPoller()))
 			require.NoError(t, manager.SetQueryParams(state, test.payload))
+
+			expectedPage := test.expectedPage
+			if expectedPage == (component.TablePage{}) {
+				expectedPage.Limit = api.DefaultPageLimit
+			}
+			assert.Equal(t, expectedPage, manager.Page())
+			assert.Equal(t, test.expectedQuery, manager.Query())
+		})
+	}
+}
+
+func TestPageFromQueryParams(t *testing.T) {
+	tests := []struct {
+		name     string
+		params   map[string]interface{}
+		expected component.TablePage
+		isErr    bool
+	}{
+		{
+			name:     "default",
+			params:   map[string]interface{}{},
+			expected: component.TablePage{Limit: api.DefaultPageLimit},
+		},
+		{
+			name:     "offset and limit",
+			params:   map[string]interface{}{"offset": "100", "limit": "50"},
+			expected: component.TablePage{Offset: 100, Limit: 50},
+		},
+		{
+			name:     "repeated param",
+			params:   map[string]interface{}{"offset": []interface{}{"100", "200"}},
+			expected: component.TablePage{Offset: 100, Limit: api.DefaultPageLimit},
+		},
+		{
+			name:     "continue",
+			params:   map[string]interface{}{"offset": "100", "limit": "100", "continue": "cache:default/pod"},
+			expected: component.TablePage{Offset: 100, Limit: 100, Continue: "cache:default/pod"},
+		},
+		{
+			name:     "every row",
+			params:   map[string]interface{}{"limit": "0"},
+			expected: component.TablePage{},
+		},
+		{
+			name:   "negative limit",
+			params: map[string]interface{}{"limit": "-1"},
+			isErr:  true,
+		},
+		{
+			name:   "invalid offset",
+			params: map[string]interface{}{"offset": "first"},
+			isErr:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := api.PageFromQueryParams(test.params)
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func TestTableQueryFromQueryParams(t *testing.T) {
+	tests := []struct {
+		name     string
+		params   map[string]interface{}
+		expected component.TableQuery
+		isErr    bool
+	}{
+		{
+			name:   "default",
+			params: map[string]interface{}{},
+		},
+		{
+			name:     "search",
+			params:   map[string]interface{}{"search": "nginx"},
+			expected: component.TableQuery{Search: "nginx"},
+		},
+		{
+			name: "repeated column filter",
+			params: map[string]interface{}{
+				"columnFilter": []interface{}{"Phase:Running", "Phase:Pending", "Type:ClusterIP"},
+			},
+			expected: component.TableQuery{
+				Filters: map[string][]string{
+					"Phase": {"Running", "Pending"},
+					"Type":  {"ClusterIP"},
+				},
+			},
+		},
+		{
+			name:   "invalid column filter",
+			params: map[string]interface{}{"columnFilter": "Running"},
+			isErr:  true,
+		},
+		{
+			name:   "invalid type",
+			params: map[string]interface{}{"search": 1},
+			isErr:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := api.TableQueryFromQueryParams(test.params)
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, got)
 		})
 	}
 }
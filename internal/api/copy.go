@@ -0,0 +1,128 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/octant/internal/exec"
+	"github.com/vmware/octant/internal/log"
+)
+
+const (
+	// maxCopyFileSize is the largest file which can be copied to or from a
+	// container.
+	maxCopyFileSize = 10 << 20
+)
+
+// copyOptionsFromRequest returns the file a copy request references. If
+// the path of an upload ends with a slash, the uploaded file's name is
+// added to it.
+func copyOptionsFromRequest(r *http.Request, fileName string) exec.CopyOptions {
+	vars := mux.Vars(r)
+
+	filePath := r.URL.Query().Get("path")
+	if fileName != "" && strings.HasSuffix(filePath, "/") {
+		filePath = path.Join(filePath, path.Base(fileName))
+	}
+
+	return exec.CopyOptions{
+		Namespace: vars["namespace"],
+		Pod:       vars["pod"],
+		Container: vars["container"],
+		Path:      filePath,
+	}
+}
+
+// copyHandler downloads a file from a container on GET, and uploads the
+// file in a multipart form to a container on POST.
+func copyHandler(ctx context.Context, executor exec.Interface) http.HandlerFunc {
+	logger := log.From(ctx)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			uploadFile(w, r, executor, logger)
+			return
+		}
+
+		downloadFile(w, r, executor, logger)
+	}
+}
+
+func downloadFile(w http.ResponseWriter, r *http.Request, executor exec.Interface, logger log.Logger) {
+	options := copyOptionsFromRequest(r, "")
+	if err := options.Validate(); err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error(), logger)
+		return
+	}
+
+	buf := &limitedBuffer{max: maxCopyFileSize}
+	if err := exec.CopyFromContainer(r.Context(), executor, options, buf); err != nil {
+		RespondWithError(w, http.StatusInternalServerError, err.Error(), logger)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", path.Base(options.Path)))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		logger.WithErr(err).Errorf("write downloaded file")
+	}
+}
+
+func uploadFile(w http.ResponseWriter, r *http.Request, executor exec.Interface, logger log.Logger) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxCopyFileSize+(1<<20))
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, fmt.Sprintf("read uploaded file: %s", err), logger)
+		return
+	}
+	defer file.Close()
+
+	if header.Size > maxCopyFileSize {
+		message := fmt.Sprintf("file is larger than %d MiB", maxCopyFileSize>>20)
+		RespondWithError(w, http.StatusRequestEntityTooLarge, message, logger)
+		return
+	}
+
+	options := copyOptionsFromRequest(r, header.Filename)
+	if err := options.Validate(); err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error(), logger)
+		return
+	}
+
+	if err := exec.CopyToContainer(r.Context(), executor, options, file, header.Size); err != nil {
+		RespondWithError(w, http.StatusInternalServerError, err.Error(), logger)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// limitedBuffer is a buffer which can't grow larger than max bytes.
+type limitedBuffer struct {
+	bytes.Buffer
+	max int
+}
+
+// Write appends p to the buffer. It returns an error if the buffer would
+// grow larger than max bytes.
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.Len()+len(p) > b.max {
+		return 0, errors.Errorf("file is larger than %d MiB", b.max>>20)
+	}
+
+	return b.Buffer.Write(p)
+}
@@ -0,0 +1,130 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/octant/internal/exec"
+)
+
+// fileExecutor archives a single file, or extracts an uploaded one.
+type fileExecutor struct {
+	name     string
+	content  string
+	commands [][]string
+}
+
+func (e *fileExecutor) Stream(ctx context.Context, options exec.Options) error {
+	e.commands = append(e.commands, options.Command)
+
+	if options.Stdin != nil {
+		tr := tar.NewReader(options.Stdin)
+		header, err := tr.Next()
+		if err != nil {
+			return err
+		}
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return err
+		}
+		e.name, e.content = header.Name, string(data)
+		return nil
+	}
+
+	tw := tar.NewWriter(options.Stdout)
+	header := &tar.Header{Name: e.name, Mode: 0644, Size: int64(len(e.content)), Typeflag: tar.TypeReg}
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+	if _, err := tw.Write([]byte(e.content)); err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+func newCopyServer(executor exec.Interface) *httptest.Server {
+	router := mux.NewRouter()
+	router.HandleFunc("/copy/namespace/{namespace}/pod/{pod}/container/{container}",
+		copyHandler(context.Background(), executor)).
+		Methods(http.MethodGet, http.MethodPost)
+	return httptest.NewServer(router)
+}
+
+func Test_copyHandler_download(t *testing.T) {
+	executor := &fileExecutor{name: "config.yaml", content: "key: value\n"}
+	server := newCopyServer(executor)
+	defer server.Close()
+
+	res, err := http.Get(server.URL + "/copy/namespace/default/pod/pod/container/app?path=/etc/app/config.yaml")
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, `attachment; filename="config.yaml"`, res.Header.Get("Content-Disposition"))
+
+	data, err := ioutil.ReadAll(res.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "key: value\n", string(data))
+
+	assert.Equal(t, [][]string{{"tar", "cf", "-", "-C", "/etc/app", "config.yaml"}}, executor.commands)
+}
+
+func Test_copyHandler_download_invalid_path(t *testing.T) {
+	server := newCopyServer(&fileExecutor{})
+	defer server.Close()
+
+	res, err := http.Get(server.URL + "/copy/namespace/default/pod/pod/container/app?path=config.yaml")
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func Test_copyHandler_upload(t *testing.T) {
+	executor := &fileExecutor{}
+	server := newCopyServer(executor)
+	defer server.Close()
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	fw, err := mw.CreateFormFile("file", "upload.txt")
+	require.NoError(t, err)
+	_, err = fw.Write([]byte("uploaded"))
+	require.NoError(t, err)
+	require.NoError(t, mw.Close())
+
+	res, err := http.Post(server.URL+"/copy/namespace/default/pod/pod/container/app?path=/tmp/", mw.FormDataContentType(), &body)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	require.Equal(t, http.StatusNoContent, res.StatusCode)
+	assert.Equal(t, "upload.txt", executor.name)
+	assert.Equal(t, "uploaded", executor.content)
+	assert.Equal(t, [][]string{{"tar", "xf", "-", "-C", "/tmp"}}, executor.commands)
+}
+
+func Test_limitedBuffer(t *testing.T) {
+	buf := &limitedBuffer{max: 4}
+
+	_, err := buf.Write([]byte("1234"))
+	require.NoError(t, err)
+
+	_, err = buf.Write([]byte("5"))
+	require.Error(t, err)
+	assert.Equal(t, "1234", buf.String())
+}
@@ -0,0 +1,142 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware/octant/internal/event"
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/internal/rollout"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/store"
+)
+
+// DeploymentAlertManagerConfig is configuration for DeploymentAlertManager.
+type DeploymentAlertManagerConfig interface {
+	ObjectStore() store.Store
+	ObjectPath(namespace, apiVersion, kind, name string) (string, error)
+}
+
+// DeploymentAlertManagerOption is an option for configuring DeploymentAlertManager.
+type DeploymentAlertManagerOption func(m *DeploymentAlertManager)
+
+// StuckDeploymentsFunc is a function that finds stuck deployments.
+type StuckDeploymentsFunc func(ctx context.Context, config DeploymentAlertManagerConfig) ([]rollout.Stuck, error)
+
+// WithStuckDeploymentsFinder configures the stuck deployments finder function.
+func WithStuckDeploymentsFinder(fn StuckDeploymentsFunc) DeploymentAlertManagerOption {
+	return func(m *DeploymentAlertManager) {
+		m.stuckDeploymentsFunc = fn
+	}
+}
+
+// WithDeploymentAlertPoller configures the poller.
+func WithDeploymentAlertPoller(poller Poller) DeploymentAlertManagerOption {
+	return func(m *DeploymentAlertManager) {
+		m.poller = poller
+	}
+}
+
+// DeploymentAlertManager alerts when deployments in any namespace exceed
+// their progress deadline.
+type DeploymentAlertManager struct {
+	config               DeploymentAlertManagerConfig
+	stuckDeploymentsFunc StuckDeploymentsFunc
+	poller               Poller
+}
+
+var _ StateManager = (*DeploymentAlertManager)(nil)
+
+// NewDeploymentAlertManager creates an instance of DeploymentAlertManager.
+func NewDeploymentAlertManager(config DeploymentAlertManagerConfig, options ...DeploymentAlertManagerOption) *DeploymentAlertManager {
+	m := &DeploymentAlertManager{
+		config:               config,
+		stuckDeploymentsFunc: StuckDeployments,
+		poller:               NewInterruptiblePoller("deploymentAlerts"),
+	}
+
+	for _, option := range options {
+		option(m)
+	}
+
+	return m
+}
+
+// Handlers returns nil.
+func (m *DeploymentAlertManager) Handlers() []octant.ClientRequestHandler {
+	return nil
+}
+
+// Start starts the manager. It periodically checks for stuck deployments.
+func (m *DeploymentAlertManager) Start(ctx context.Context, state octant.State, s OctantClient) {
+	ch := make(chan struct{}, 1)
+	defer func() {
+		close(ch)
+	}()
+
+	m.poller.Run(ctx, ch, m.runUpdate(state), event.DefaultScheduleDelay)
+}
+
+func (m *DeploymentAlertManager) runUpdate(state octant.State) PollerFunc {
+	// alerted tracks deployments which have been alerted, so an alert is
+	// only sent when a deployment becomes stuck.
+	alerted := make(map[string]bool)
+
+	return func(ctx context.Context) bool {
+		logger := log.From(ctx)
+
+		stuck, err := m.stuckDeploymentsFunc(ctx, m.config)
+		if err != nil {
+			logger.WithErr(err).Errorf("find stuck deployments")
+			return false
+		}
+
+		if ctx.Err() != nil {
+			return false
+		}
+
+		current := make(map[string]bool)
+		for _, deployment := range stuck {
+			current[deployment.Key()] = true
+			if alerted[deployment.Key()] {
+				continue
+			}
+
+			state.SendAlert(m.createAlert(deployment, logger))
+		}
+
+		alerted = current
+
+		return false
+	}
+}
+
+func (m *DeploymentAlertManager) createAlert(stuck rollout.Stuck, logger log.Logger) action.Alert {
+	alert := action.CreateAlert(action.AlertTypeWarning, stuck.String(), 0)
+
+	link, err := m.config.ObjectPath(stuck.Namespace, "apps/v1", "Deployment", stuck.Name)
+	if err != nil {
+		logger.WithErr(err).Errorf("create path for deployment %s", stuck.Key())
+		return alert
+	}
+
+	alert.Link = link
+	return alert
+}
+
+// StuckDeployments finds deployments in all namespaces which have exceeded
+// their progress deadline.
+func StuckDeployments(ctx context.Context, config DeploymentAlertManagerConfig) ([]rollout.Stuck, error) {
+	if config == nil {
+		return nil, errors.New("deployment alert manager config is nil")
+	}
+
+	return rollout.FindStuck(ctx, config.ObjectStore())
+}
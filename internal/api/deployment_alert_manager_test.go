@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware/octant/internal/api"
+	"github.com/vmware/octant/internal/api/fake"
+	configFake "github.com/vmware/octant/internal/config/fake"
+	octantFake "github.com/vmware/octant/internal/octant/fake"
+	"github.com/vmware/octant/internal/rollout"
+	"github.com/vmware/octant/pkg/action"
+)
+
+func TestDeploymentAlertManager_Start(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().
+		ObjectPath("default", "apps/v1", "Deployment", "app").
+		Return("/overview/namespace/default/workloads/deployments/app", nil).
+		Times(2)
+
+	state := octantFake.NewMockState(controller)
+	octantClient := fake.NewMockOctantClient(controller)
+
+	stuck := rollout.Stuck{
+		Namespace: "default",
+		Name:      "app",
+		Reason:    rollout.ReasonImagePull,
+		Detail:    "container app in pod app-1: ErrImagePull",
+	}
+
+	// The deployment is stuck, stays stuck, recovers, and is stuck again.
+	responses := [][]rollout.Stuck{{stuck}, {stuck}, nil, {stuck}}
+
+	var alerts []action.Alert
+	state.EXPECT().
+		SendAlert(gomock.Any()).
+		Do(func(alert action.Alert) {
+			alerts = append(alerts, alert)
+		}).
+		Times(2)
+
+	manager := api.NewDeploymentAlertManager(dashConfig,
+		api.WithDeploymentAlertPoller(repeatPoller{times: len(responses)}),
+		api.WithStuckDeploymentsFinder(func(ctx context.Context, config api.DeploymentAlertManagerConfig) ([]rollout.Stuck, error) {
+			response := responses[0]
+			responses = responses[1:]
+			return response, nil
+		}))
+
+	manager.Start(context.Background(), state, octantClient)
+
+	expected := action.Alert{
+		Type:    action.AlertTypeWarning,
+		Message: "Deployment default/app exceeded its progress deadline (image pull failure): container app in pod app-1: ErrImagePull",
+		Link:    "/overview/namespace/default/workloads/deployments/app",
+	}
+	assert.Equal(t, []action.Alert{expected, expected}, alerts)
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware/octant/internal/eventfilter"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/pkg/action"
+)
+
+const (
+	RequestSetEventFilters = "setEventFilters"
+)
+
+// EventFilterManagerConfig is configuration for EventFilterManager.
+type EventFilterManagerConfig interface {
+	EventFilters() eventfilter.Presets
+}
+
+// EventFilterManager manages event filter presets. The presets configured
+// for the dashboard are the defaults, and a client can override them.
+type EventFilterManager struct {
+	config EventFilterManagerConfig
+}
+
+var _ StateManager = (*EventFilterManager)(nil)
+
+// NewEventFilterManager creates an instance of EventFilterManager.
+func NewEventFilterManager(config EventFilterManagerConfig) *EventFilterManager {
+	return &EventFilterManager{
+		config: config,
+	}
+}
+
+// Start starts the manager. It sends the current and default presets, so
+// the client can show them and apply its overrides.
+func (m *EventFilterManager) Start(ctx context.Context, state octant.State, s OctantClient) {
+	s.Send(CreateEventFiltersEvent(state.GetEventFilters(), m.config.EventFilters()))
+}
+
+// Handlers returns a slice of handlers.
+func (m *EventFilterManager) Handlers() []octant.ClientRequestHandler {
+	return []octant.ClientRequestHandler{
+		{
+			RequestType: RequestSetEventFilters,
+			Handler:     m.SetEventFilters,
+		},
+	}
+}
+
+// SetEventFilters sets the event filter presets.
+func (m *EventFilterManager) SetEventFilters(state octant.State, payload action.Payload) error {
+	names, _, err := unstructured.NestedStringSlice(payload, "presets")
+	if err != nil {
+		return errors.Wrap(err, "extract presets from payload")
+	}
+
+	presets, err := eventfilter.ParsePresets(strings.Join(names, ","))
+	if err != nil {
+		return err
+	}
+
+	state.SetEventFilters(presets)
+	return nil
+}
+
+// CreateEventFiltersEvent creates an event filters event.
+func CreateEventFiltersEvent(presets, defaults eventfilter.Presets) octant.Event {
+	if presets == nil {
+		presets = eventfilter.Presets{}
+	}
+	if defaults == nil {
+		defaults = eventfilter.Presets{}
+	}
+
+	return octant.Event{
+		Type: octant.EventTypeEventFilters,
+		Data: map[string]interface{}{
+			"presets":  presets,
+			"defaults": defaults,
+		},
+	}
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/octant/internal/api"
+	"github.com/vmware/octant/internal/api/fake"
+	configFake "github.com/vmware/octant/internal/config/fake"
+	"github.com/vmware/octant/internal/eventfilter"
+	octantFake "github.com/vmware/octant/internal/octant/fake"
+	"github.com/vmware/octant/pkg/action"
+)
+
+func TestEventFilterManager_Handlers(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	manager := api.NewEventFilterManager(configFake.NewMockDash(controller))
+	AssertHandlers(t, manager, []string{api.RequestSetEventFilters})
+}
+
+func TestEventFilterManager_Start(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	defaults := eventfilter.Presets{eventfilter.PresetHideProbes}
+	presets := eventfilter.Presets{eventfilter.PresetHideNormal}
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().EventFilters().Return(defaults)
+
+	state := octantFake.NewMockState(controller)
+	state.EXPECT().GetEventFilters().Return(presets)
+
+	octantClient := fake.NewMockOctantClient(controller)
+	octantClient.EXPECT().Send(api.CreateEventFiltersEvent(presets, defaults))
+
+	manager := api.NewEventFilterManager(dashConfig)
+	manager.Start(context.Background(), state, octantClient)
+}
+
+func TestEventFilterManager_SetEventFilters(t *testing.T) {
+	tests := []struct {
+		name     string
+		payload  action.Payload
+		expected eventfilter.Presets
+		isErr    bool
+	}{
+		{
+			name: "presets",
+			payload: action.Payload{
+				"presets": []interface{}{"hide-normal", "hide-image-pull"},
+			},
+			expected: eventfilter.Presets{eventfilter.PresetHideNormal, eventfilter.PresetHideImagePull},
+		},
+		{
+			name:    "no presets",
+			payload: action.Payload{"presets": []interface{}{}},
+		},
+		{
+			name:    "unknown preset",
+			payload: action.Payload{"presets": []interface{}{"hide-everything"}},
+			isErr:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			state := octantFake.NewMockState(controller)
+			if !test.isErr {
+				state.EXPECT().SetEventFilters(test.expected)
+			}
+
+			manager := api.NewEventFilterManager(configFake.NewMockDash(controller))
+			err := manager.SetEventFilters(state, test.payload)
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,187 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+
+	"github.com/vmware/octant/internal/exec"
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+const (
+	// execMessageStdin is sent by the terminal with input for the command.
+	execMessageStdin = "stdin"
+	// execMessageResize is sent by the terminal when it is resized.
+	execMessageResize = "resize"
+	// execMessageStdout is sent to the terminal with output of the command.
+	execMessageStdout = "stdout"
+	// execMessageExit is sent to the terminal when the command exits.
+	execMessageExit = "exit"
+)
+
+// execMessage is a message between a terminal and an exec session.
+type execMessage struct {
+	Type string `json:"type"`
+	Data string `json:"data,omitempty"`
+	Cols uint16 `json:"cols,omitempty"`
+	Rows uint16 `json:"rows,omitempty"`
+}
+
+// terminalSessionFromRequest returns the exec session a request references.
+// The request's path and query match the session's token.
+func terminalSessionFromRequest(r *http.Request) (component.TerminalSession, error) {
+	vars := mux.Vars(r)
+	query := r.URL.Query()
+
+	session := component.TerminalSession{
+		Namespace: vars["namespace"],
+		Pod:       vars["pod"],
+		Container: vars["container"],
+		Command:   query["command"],
+	}
+
+	if value := query.Get("tty"); value != "" {
+		tty, err := strconv.ParseBool(value)
+		if err != nil {
+			return component.TerminalSession{}, err
+		}
+		session.TTY = tty
+	}
+
+	if err := session.Validate(); err != nil {
+		return component.TerminalSession{}, err
+	}
+
+	return session, nil
+}
+
+// execHandler upgrades requests to a websocket which is attached to a
+// command running in a container.
+func execHandler(ctx context.Context, executor exec.Interface, upgrader *websocket.Upgrader) http.HandlerFunc {
+	logger := log.From(ctx)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		session, err := terminalSessionFromRequest(r)
+		if err != nil {
+			RespondWithError(w, http.StatusBadRequest, err.Error(), logger)
+			return
+		}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			logger.WithErr(err).Errorf("upgrade exec request")
+			return
+		}
+		defer conn.Close()
+
+		logger := logger.With(
+			"namespace", session.Namespace,
+			"pod", session.Pod,
+			"container", session.Container)
+		logger.Infof("starting exec session")
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		stdinReader, stdinWriter := io.Pipe()
+		// Unblock the reader if the command exits before stdin is read.
+		defer stdinReader.Close()
+		resizeCh := make(chan exec.TerminalSize, 1)
+		output := &execOutput{conn: conn}
+
+		go func() {
+			defer cancel()
+			defer stdinWriter.Close()
+			defer close(resizeCh)
+			readExecMessages(conn, stdinWriter, resizeCh)
+		}()
+
+		options := exec.Options{
+			Namespace: session.Namespace,
+			Pod:       session.Pod,
+			Container: session.Container,
+			Command:   session.Command,
+			TTY:       session.TTY,
+			Stdin:     stdinReader,
+			Stdout:    output,
+			Stderr:    output,
+			Resize:    resizeCh,
+		}
+
+		exitMessage := ""
+		if err := executor.Stream(ctx, options); err != nil {
+			exitMessage = err.Error()
+		}
+
+		logger.With("exit", exitMessage).Infof("exec session ended")
+
+		if err := output.send(execMessage{Type: execMessageExit, Data: exitMessage}); err != nil {
+			logger.WithErr(err).Debugf("send exec exit message")
+		}
+	}
+}
+
+// readExecMessages reads messages from a terminal until the connection is
+// closed.
+func readExecMessages(conn *websocket.Conn, stdin io.Writer, resizeCh chan<- exec.TerminalSize) {
+	for {
+		var message execMessage
+		if err := conn.ReadJSON(&message); err != nil {
+			return
+		}
+
+		switch message.Type {
+		case execMessageStdin:
+			if _, err := io.WriteString(stdin, message.Data); err != nil {
+				return
+			}
+		case execMessageResize:
+			if message.Cols == 0 || message.Rows == 0 {
+				continue
+			}
+
+			size := exec.TerminalSize{Width: message.Cols, Height: message.Rows}
+			select {
+			case resizeCh <- size:
+			default:
+				// Drop the size if the previous one hasn't been sent yet.
+			}
+		}
+	}
+}
+
+// execOutput sends a command's output to a terminal.
+type execOutput struct {
+	mu   sync.Mutex
+	conn *websocket.Conn
+}
+
+var _ io.Writer = (*execOutput)(nil)
+
+// Write sends output to the terminal.
+func (o *execOutput) Write(p []byte) (int, error) {
+	if err := o.send(execMessage{Type: execMessageStdout, Data: string(p)}); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+func (o *execOutput) send(message execMessage) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.conn.WriteJSON(&message)
+}
@@ -0,0 +1,93 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/octant/internal/exec"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// echoExecutor echoes a line of stdin to stdout.
+type echoExecutor struct {
+	options exec.Options
+}
+
+func (e *echoExecutor) Stream(ctx context.Context, options exec.Options) error {
+	e.options = options
+
+	line, err := bufio.NewReader(options.Stdin).ReadString('\n')
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(options.Stdout, line); err != nil {
+		return err
+	}
+
+	return errors.New("command terminated with exit code 1")
+}
+
+func Test_execHandler(t *testing.T) {
+	executor := &echoExecutor{}
+
+	router := mux.NewRouter()
+	router.HandleFunc("/exec/namespace/{namespace}/pod/{pod}/container/{container}",
+		execHandler(context.Background(), executor, newUpgrader([]string{"127.0.0.1"})))
+
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	session := component.TerminalSession{
+		Namespace: "default",
+		Pod:       "pod",
+		Container: "app",
+		Command:   []string{"/bin/sh", "-c", "cat"},
+		TTY:       true,
+	}
+
+	u := "ws" + strings.TrimPrefix(server.URL, "http") + "/exec/" + session.Token()
+	conn, _, err := websocket.DefaultDialer.Dial(u, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.NoError(t, conn.WriteJSON(execMessage{Type: execMessageResize, Cols: 80, Rows: 24}))
+	require.NoError(t, conn.WriteJSON(execMessage{Type: execMessageStdin, Data: "hello\n"}))
+
+	var stdout execMessage
+	require.NoError(t, conn.ReadJSON(&stdout))
+	assert.Equal(t, execMessage{Type: execMessageStdout, Data: "hello\n"}, stdout)
+
+	var exit execMessage
+	require.NoError(t, conn.ReadJSON(&exit))
+	assert.Equal(t, execMessage{Type: execMessageExit, Data: "command terminated with exit code 1"}, exit)
+
+	assert.Equal(t, "default", executor.options.Namespace)
+	assert.Equal(t, "pod", executor.options.Pod)
+	assert.Equal(t, "app", executor.options.Container)
+	assert.Equal(t, []string{"/bin/sh", "-c", "cat"}, executor.options.Command)
+	assert.True(t, executor.options.TTY)
+}
+
+func Test_terminalSessionFromRequest_invalid(t *testing.T) {
+	r := httptest.NewRequest("GET", "/exec/namespace/default/pod/pod/container/app", nil)
+	r = mux.SetURLVars(r, map[string]string{"namespace": "default", "pod": "pod", "container": "app"})
+
+	_, err := terminalSessionFromRequest(r)
+	require.Error(t, err)
+}
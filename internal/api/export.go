@@ -0,0 +1,195 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/vmware/octant/internal/export"
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/internal/module"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// exportHandler formats a component posted as JSON, e.g. a summary or a
+// table. The format query parameter selects the format, which defaults to
+// markdown.
+func exportHandler(ctx context.Context, registry *export.Registry) http.HandlerFunc {
+	logger := log.From(ctx)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		format := r.URL.Query().Get("format")
+		if format == "" {
+			format = "markdown"
+		}
+
+		formatter, ok := registry.Get(format)
+		if !ok {
+			RespondWithError(w, http.StatusBadRequest, fmt.Sprintf("unsupported format %q", format), logger)
+			return
+		}
+
+		var to component.TypedObject
+		if err := json.NewDecoder(r.Body).Decode(&to); err != nil {
+			RespondWithError(w, http.StatusBadRequest, "unable to decode component", logger)
+			return
+		}
+
+		c, err := to.ToComponent()
+		if err != nil {
+			RespondWithError(w, http.StatusBadRequest, err.Error(), logger)
+			return
+		}
+
+		w.Header().Set("Content-Type", formatter.ContentType())
+
+		if err := formatter.Format(w, c); err != nil {
+			RespondWithError(w, http.StatusBadRequest, err.Error(), logger)
+			return
+		}
+	}
+}
+
+// tableExportFormats are the formats tables can be downloaded as.
+var tableExportFormats = map[string]string{
+	string(component.TableExportCSV):  "text/csv; charset=utf-8",
+	string(component.TableExportJSON): "application/json",
+}
+
+// exportTableHandler downloads a table from the content at the path query
+// parameter, e.g. the pods list. Every row which matches the filters,
+// search, and columnFilter query parameters is exported, regardless of the
+// page shown in the client. The format query parameter selects CSV (the
+// default) or JSON, and the table query parameter selects a table when the
+// content has more than one.
+func exportTableHandler(ctx context.Context, moduleManager module.ManagerInterface) http.HandlerFunc {
+	logger := log.From(ctx)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		values := r.URL.Query()
+
+		format := values.Get("format")
+		if format == "" {
+			format = string(component.TableExportCSV)
+		}
+
+		contentType, ok := tableExportFormats[format]
+		if !ok {
+			RespondWithError(w, http.StatusBadRequest, fmt.Sprintf("unsupported format %q", format), logger)
+			return
+		}
+
+		index := 0
+		if s := values.Get("table"); s != "" {
+			i, err := strconv.Atoi(s)
+			if err != nil || i < 0 {
+				RespondWithError(w, http.StatusBadRequest, fmt.Sprintf("invalid table %q", s), logger)
+				return
+			}
+			index = i
+		}
+
+		contentPath := strings.TrimPrefix(values.Get("path"), "/")
+		m, ok := moduleManager.ModuleForContentPath(contentPath)
+		if !ok {
+			RespondWithError(w, http.StatusNotFound, fmt.Sprintf("unable to find module for content path %q", contentPath), logger)
+			return
+		}
+
+		options, err := exportContentOptions(values)
+		if err != nil {
+			RespondWithError(w, http.StatusBadRequest, err.Error(), logger)
+			return
+		}
+
+		contentResponse, err := m.Content(r.Context(), strings.TrimPrefix(contentPath, m.Name()), options)
+		if err != nil {
+			RespondWithTypedError(w, err, logger)
+			return
+		}
+
+		tables := contentTables(contentResponse.Components)
+		if index >= len(tables) {
+			RespondWithError(w, http.StatusNotFound, fmt.Sprintf("content path %q does not have table %d", contentPath, index), logger)
+			return
+		}
+
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s.%s", exportFilename(contentPath), format))
+
+		if err := tables[index].Export(w, component.TableExportFormat(format)); err != nil {
+			logger.WithErr(err).Errorf("unable to export table")
+		}
+	}
+}
+
+// exportContentOptions converts query parameters to content options. The
+// page is left blank so tables include every row.
+func exportContentOptions(values url.Values) (module.ContentOptions, error) {
+	params := make(map[string]interface{})
+	for key, list := range values {
+		var items []interface{}
+		for _, item := range list {
+			items = append(items, item)
+		}
+		params[key] = items
+	}
+
+	var options module.ContentOptions
+
+	if in, ok := params["filters"]; ok {
+		filters, err := FiltersFromQueryParams(in)
+		if err != nil {
+			return module.ContentOptions{}, err
+		}
+		options.LabelSet = FiltersToLabelSet(filters)
+	}
+
+	query, err := TableQueryFromQueryParams(params)
+	if err != nil {
+		return module.ContentOptions{}, err
+	}
+	options.Query = query
+
+	return options, nil
+}
+
+// contentTables returns the tables in components, including the tables in
+// flex layouts, in the order they are shown.
+func contentTables(components []component.Component) []*component.Table {
+	var tables []*component.Table
+	for _, c := range components {
+		switch t := c.(type) {
+		case *component.Table:
+			tables = append(tables, t)
+		case *component.FlexLayout:
+			for _, section := range t.Config.Sections {
+				for _, item := range section {
+					tables = append(tables, contentTables([]component.Component{item.View})...)
+				}
+			}
+		}
+	}
+
+	return tables
+}
+
+// exportFilename returns the name of a download for a content path, e.g.
+// pods for overview/namespace/default/workloads/pods.
+func exportFilename(contentPath string) string {
+	parts := strings.Split(strings.Trim(contentPath, "/"), "/")
+	if name := parts[len(parts)-1]; name != "" {
+		return name
+	}
+
+	return "table"
+}
@@ -0,0 +1,66 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/vmware/octant/internal/cluster"
+	"github.com/vmware/octant/internal/inventory"
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/internal/mime"
+	"github.com/vmware/octant/pkg/store"
+)
+
+// inventoryWriters are the inventory export formats and their content types.
+var inventoryWriters = map[string]struct {
+	contentType string
+	write       func(w io.Writer, report *inventory.Report) error
+}{
+	"json": {contentType: mime.JSONContentType, write: inventory.WriteJSON},
+	"csv":  {contentType: "text/csv; charset=utf-8", write: inventory.WriteCSV},
+}
+
+// inventoryHandler exports an inventory report as a download. The format
+// query parameter selects JSON (the default) or CSV.
+func inventoryHandler(ctx context.Context, clusterClient cluster.ClientInterface, objectStore store.Store) http.HandlerFunc {
+	logger := log.From(ctx)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		format := r.URL.Query().Get("format")
+		if format == "" {
+			format = "json"
+		}
+
+		writer, ok := inventoryWriters[format]
+		if !ok {
+			RespondWithError(w, http.StatusBadRequest, fmt.Sprintf("unsupported format %q", format), logger)
+			return
+		}
+
+		discoveryClient, err := clusterClient.DiscoveryClient()
+		if err != nil {
+			RespondWithTypedError(w, err, logger)
+			return
+		}
+
+		report, err := inventory.Generate(r.Context(), objectStore, discoveryClient)
+		if err != nil {
+			RespondWithTypedError(w, err, logger)
+			return
+		}
+
+		w.Header().Set("Content-Type", writer.contentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=inventory.%s", format))
+
+		if err := writer.write(w, report); err != nil {
+			logger.WithErr(err).Errorf("unable to write inventory report")
+		}
+	}
+}
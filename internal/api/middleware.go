@@ -6,13 +6,18 @@ SPDX-License-Identifier: Apache-2.0
 package api
 
 import (
+	"bufio"
 	"context"
 	"net"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+
 	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/internal/session"
 	dashstrings "github.com/vmware/octant/internal/util/strings"
 )
 
@@ -53,3 +58,58 @@ func rebindHandler(ctx context.Context, acceptedHosts []string) mux.MiddlewareFu
 		})
 	}
 }
+
+// statusRecorder records the status code of a response. It can be hijacked
+// so websocket upgrades are recorded as well.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	r.status = code
+	r.ResponseWriter.WriteHeader(code)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	return r.ResponseWriter.Write(b)
+}
+
+func (r *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := r.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer can't be hijacked")
+	}
+
+	r.status = http.StatusSwitchingProtocols
+	return hijacker.Hijack()
+}
+
+// accessLogHandler is a middleware that logs every request to logger.
+func accessLogHandler(logger log.Logger) mux.MiddlewareFunc {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			recorder := &statusRecorder{ResponseWriter: w}
+
+			h.ServeHTTP(recorder, r)
+
+			status := recorder.status
+			if status == 0 {
+				status = http.StatusOK
+			}
+
+			logger.With(
+				"method", r.Method,
+				"path", r.URL.Path,
+				"status", status,
+				"duration", time.Since(start).String(),
+				"remote", r.RemoteAddr,
+				"user", session.UserFromRequest(r),
+			).Infof("http request")
+		})
+	}
+}
@@ -6,6 +6,8 @@ SPDX-License-Identifier: Apache-2.0
 package api
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"context"
 	"net/http"
@@ -13,7 +15,12 @@ import (
 	"os"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/vmware/octant/internal/log"
 )
 
 func Test_rebindHandler(t *testing.T) {
@@ -57,7 +64,7 @@ func Test_rebindHandler(t *testing.T) {
 				fmt.Fprint(w, "response")
 			})
 
-			wrapped := rebindHandler(context.TODO(), acceptedHosts())(fake)
+			wrapped := rebindHandler(context.TODO(), acceptedHosts(ListenerAddr()))(fake)
 
 			ts := httptest.NewServer(wrapped)
 			defer ts.Close()
@@ -104,3 +111,38 @@ func Test_shouldAllowHost(t *testing.T) {
 		})
 	}
 }
+
+func Test_accessLogHandler(t *testing.T) {
+	var buf bytes.Buffer
+	core := zapcore.NewCore(
+		zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig()),
+		zapcore.AddSync(&buf),
+		zapcore.InfoLevel)
+	logger := log.Wrap(zap.New(core).Sugar())
+
+	fake := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "not found", http.StatusNotFound)
+	})
+
+	wrapped := accessLogHandler(logger)(fake)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/missing", nil)
+	req.RemoteAddr = "192.168.1.1:5000"
+	req.Header.Set("X-Forwarded-User", "user")
+
+	w := httptest.NewRecorder()
+	wrapped.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+
+	assert.Equal(t, "http request", entry["msg"])
+	assert.Equal(t, http.MethodGet, entry["method"])
+	assert.Equal(t, "/api/v1/missing", entry["path"])
+	assert.Equal(t, float64(http.StatusNotFound), entry["status"])
+	assert.Equal(t, "192.168.1.1:5000", entry["remote"])
+	assert.Equal(t, "user", entry["user"])
+	assert.Contains(t, entry, "duration")
+}
@@ -16,11 +16,13 @@ import (
 	"github.com/vmware/octant/internal/event"
 	"github.com/vmware/octant/internal/log"
 	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/internal/rollup"
 )
 
 // NamespaceManagerConfig is configuration for NamespacesManager.
 type NamespaceManagerConfig interface {
 	ClusterClient() cluster.ClientInterface
+	NamespaceRollups() *rollup.Tracker
 }
 
 // NamespacesManagerOption is an option for configuring NamespacesManager.
@@ -94,8 +96,10 @@ func (n *NamespacesManager) runUpdate(state octant.State, client OctantClient) P
 			return false
 		}
 
+		namespacesEvent := CreateNamespacesEvent(namespaces, n.config.NamespaceRollups().Rollups())
+
 		if ctx.Err() == nil {
-			cur, err := json.Marshal(namespaces)
+			cur, err := json.Marshal(namespacesEvent)
 			if err != nil {
 				logger.WithErr(err).Errorf("unable to marshal namespaces")
 				return false
@@ -103,7 +107,7 @@ func (n *NamespacesManager) runUpdate(state octant.State, client OctantClient) P
 
 			if bytes.Compare(previous, cur) != 0 {
 				previous = cur
-				client.Send(CreateNamespacesEvent(namespaces))
+				client.Send(namespacesEvent)
 			}
 		}
 
@@ -132,12 +136,14 @@ func NamespacesGenerator(_ context.Context, config NamespaceManagerConfig) ([]st
 	return names, nil
 }
 
-// CreateNamespacesEvent creates a namespaces event.
-func CreateNamespacesEvent(namespaces []string) octant.Event {
+// CreateNamespacesEvent creates a namespaces event. rollups summarize the
+// health of each namespace.
+func CreateNamespacesEvent(namespaces []string, rollups map[string]rollup.Rollup) octant.Event {
 	return octant.Event{
 		Type: octant.EventTypeNamespaces,
 		Data: map[string]interface{}{
 			"namespaces": namespaces,
+			"rollups":    rollups,
 		},
 	}
 }
@@ -24,6 +24,7 @@ func TestNamespacesManager_GenerateNamespaces(t *testing.T) {
 	defer controller.Finish()
 
 	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().NamespaceRollups().Return(nil)
 
 	state := octantFake.NewMockState(controller)
 	octantClient := fake.NewMockOctantClient(controller)
@@ -31,7 +32,7 @@ func TestNamespacesManager_GenerateNamespaces(t *testing.T) {
 	namespaces := []string{"default"}
 
 	octantClient.EXPECT().
-		Send(api.CreateNamespacesEvent(namespaces))
+		Send(api.CreateNamespacesEvent(namespaces, nil))
 
 	poller := api.NewSingleRunPoller()
 	manager := api.NewNamespacesManager(dashConfig,
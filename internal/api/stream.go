@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"path"
 	"strings"
+
+	octantErrors "github.com/vmware/octant/internal/errors"
 )
 
 // NotFoundError is a not found error.
@@ -29,6 +31,9 @@ func (e *NotFoundError) Path() string {
 // NotFound returns true to signify this is a not found error.
 func (e *NotFoundError) NotFound() bool { return true }
 
+// Code returns the error's code.
+func (e *NotFoundError) Code() octantErrors.Code { return octantErrors.CodeNotFound }
+
 // Error returns the error string.
 func (e *NotFoundError) Error() string {
 	return fmt.Sprintf("Not found: %s", e.path)
@@ -49,6 +49,9 @@ type WebsocketClient struct {
 	state    octant.State
 	handlers map[string][]octant.ClientRequestHandler
 	id       uuid.UUID
+
+	// accessLogger logs the requests made by the client.
+	accessLogger log.Logger
 }
 
 var _ OctantClient = (*WebsocketClient)(nil)
@@ -60,14 +63,15 @@ func NewWebsocketClient(ctx context.Context, conn *websocket.Conn, dashConfig co
 	ctx, cancel := context.WithCancel(ctx)
 
 	client := &WebsocketClient{
-		ctx:        ctx,
-		cancel:     cancel,
-		conn:       conn,
-		id:         id,
-		send:       make(chan octant.Event),
-		dashConfig: dashConfig,
-		logger:     logger,
-		handlers:   make(map[string][]octant.ClientRequestHandler),
+		ctx:          ctx,
+		cancel:       cancel,
+		conn:         conn,
+		id:           id,
+		send:         make(chan octant.Event),
+		dashConfig:   dashConfig,
+		logger:       logger,
+		handlers:     make(map[string][]octant.ClientRequestHandler),
+		accessLogger: log.NopLogger(),
 	}
 
 	state := NewWebsocketState(dashConfig, actionDispatcher, client)
@@ -138,9 +142,18 @@ func (c *WebsocketClient) handle(message []byte) error {
 
 	handlers, ok := c.handlers[request.Type]
 	if !ok {
+		c.accessLogger.With("request", request.Type).Infof("unknown websocket request")
 		return c.handleUnknownRequest(request)
 	}
 
+	start := time.Now()
+	defer func() {
+		c.accessLogger.With(
+			"request", request.Type,
+			"duration", time.Since(start).String(),
+		).Infof("websocket request")
+	}()
+
 	var g errgroup.Group
 
 	for _, handler := range handlers {
@@ -8,10 +8,14 @@ package api
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
 
 	"github.com/vmware/octant/internal/config"
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/internal/session"
 )
 
 //go:generate mockgen -destination=./fake/mock_client_manager.go -package=fake github.com/vmware/octant/internal/api ClientManager
@@ -39,18 +43,26 @@ type WebsocketClientManager struct {
 	unregister       chan *WebsocketClient
 	ctx              context.Context
 	actionDispatcher ActionDispatcher
+	upgrader         *websocket.Upgrader
+	sessions         *session.Registry
+	accessLogger     log.Logger
 }
 
 var _ ClientManager = (*WebsocketClientManager)(nil)
 
-// NewWebsocketClientManager creates an instance of WebsocketClientManager.
-func NewWebsocketClientManager(ctx context.Context, dispatcher ActionDispatcher) *WebsocketClientManager {
+// NewWebsocketClientManager creates an instance of WebsocketClientManager. Websocket
+// connections are only accepted from acceptedHosts. Connected clients are
+// tracked in sessions, and their requests are logged to accessLogger.
+func NewWebsocketClientManager(ctx context.Context, dispatcher ActionDispatcher, acceptedHosts []string, sessions *session.Registry, accessLogger log.Logger) *WebsocketClientManager {
 	return &WebsocketClientManager{
 		ctx:              ctx,
 		clients:          make(map[*WebsocketClient]context.CancelFunc),
 		register:         make(chan *clientMeta),
 		unregister:       make(chan *WebsocketClient),
 		actionDispatcher: dispatcher,
+		upgrader:         newUpgrader(acceptedHosts),
+		sessions:         sessions,
+		accessLogger:     accessLogger,
 	}
 }
 
@@ -67,6 +79,8 @@ func (m *WebsocketClientManager) Run(ctx context.Context) {
 			if cancelFunc, ok := m.clients[client]; ok {
 				cancelFunc()
 				delete(m.clients, client)
+				m.sessions.Remove(client.ID())
+				client.accessLogger.Infof("websocket disconnected")
 			}
 		}
 	}
@@ -79,20 +93,45 @@ func (m *WebsocketClientManager) ClientFromRequest(dashConfig config.Dash, w htt
 		return nil, err
 	}
 
-	conn, err := upgrader.Upgrade(w, r, nil)
+	conn, err := m.upgrader.Upgrade(w, r, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	ctx, cancel := context.WithCancel(m.ctx)
 	client := NewWebsocketClient(ctx, conn, dashConfig, m.actionDispatcher, clientID)
+
+	clientSession := session.FromRequest(client.ID(), r, time.Now())
+	clientSession.ContentPath = client.state.GetContentPath()
+	clientSession.Namespace = client.state.GetNamespace()
+	m.sessions.Add(clientSession, session.TerminateFunc(cancel))
+
+	client.state.OnContentPathUpdate(func(contentPath string) {
+		m.sessions.SetContentPath(client.ID(), contentPath)
+	})
+	client.state.OnNamespaceUpdate(func(namespace string) {
+		m.sessions.SetNamespace(client.ID(), namespace)
+	})
+
+	client.accessLogger = m.accessLogger.With(
+		"client-id", client.ID(),
+		"user", clientSession.User,
+		"remote", clientSession.RemoteAddr,
+	)
+	client.accessLogger.Infof("websocket connected")
+
 	m.register <- &clientMeta{
-		cancelFunc: func() {
-			cancel()
-			m.unregister <- client
-		},
-		client: client,
+		cancelFunc: cancel,
+		client:     client,
 	}
 
+	go func() {
+		<-client.ctx.Done()
+		select {
+		case m.unregister <- client:
+		case <-m.ctx.Done():
+		}
+	}()
+
 	return client, nil
 }
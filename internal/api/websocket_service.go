@@ -14,8 +14,10 @@ import (
 	"github.com/vmware/octant/internal/config"
 )
 
-var (
-	upgrader = websocket.Upgrader{
+// newUpgrader creates a websocket upgrader which only accepts connections
+// from accepted hosts.
+func newUpgrader(hosts []string) *websocket.Upgrader {
+	return &websocket.Upgrader{
 		ReadBufferSize:  1024,
 		WriteBufferSize: 1024,
 		CheckOrigin: func(r *http.Request) bool {
@@ -24,10 +26,10 @@ var (
 				return false
 			}
 
-			return shouldAllowHost(host, acceptedHosts())
+			return shouldAllowHost(host, hosts)
 		},
 	}
-)
+}
 
 func websocketService(manager ClientManager, dashConfig config.Dash) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -17,7 +17,9 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/vmware/octant/internal/config"
+	"github.com/vmware/octant/internal/eventfilter"
 	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/internal/session"
 	"github.com/vmware/octant/pkg/action"
 )
 
@@ -40,10 +42,12 @@ func defaultStateManagers(clientID string, dashConfig config.Dash) []StateManage
 	return []StateManager{
 		NewContentManager(dashConfig.ModuleManager(), logger),
 		NewFilterManager(),
+		NewEventFilterManager(dashConfig),
 		NewNavigationManager(dashConfig),
 		NewNamespacesManager(dashConfig),
 		NewContextManager(dashConfig),
 		NewActionRequestManager(),
+		NewDeploymentAlertManager(dashConfig),
 	}
 }
 
@@ -96,6 +100,7 @@ type WebsocketState struct {
 	contentPath        *atomicString
 	namespace          *atomicString
 	filters            []octant.Filter
+	eventFilters       eventfilter.Presets
 	contentPathUpdates map[string]octant.ContentPathUpdateFunc
 	namespaceUpdates   map[string]octant.NamespaceUpdateFunc
 
@@ -108,6 +113,8 @@ type WebsocketState struct {
 }
 
 var _ octant.State = (*WebsocketState)(nil)
+var _ octant.ProgressSender = (*WebsocketState)(nil)
+var _ octant.EditResultSender = (*WebsocketState)(nil)
 
 // NewWebsocketState creates an instance of WebsocketState.
 func NewWebsocketState(dashConfig config.Dash, actionDispatcher ActionDispatcher, wsClient OctantClient, options ...WebsocketStateOption) *WebsocketState {
@@ -121,6 +128,7 @@ func NewWebsocketState(dashConfig config.Dash, actionDispatcher ActionDispatcher
 		namespace:          newStringValue(defaultNamespace),
 		contentPath:        newStringValue(""),
 		filters:            make([]octant.Filter, 0),
+		eventFilters:       dashConfig.EventFilters(),
 		actionDispatcher:   actionDispatcher,
 	}
 
@@ -153,8 +161,10 @@ func (c *WebsocketState) Handlers() []octant.ClientRequestHandler {
 	return handlers
 }
 
-// Dispatch dispatches a message.
+// Dispatch dispatches a message. Actions can find which session dispatched
+// them with session.IDFrom.
 func (c *WebsocketState) Dispatch(ctx context.Context, actionName string, payload action.Payload) error {
+	ctx = session.WithID(ctx, c.wsClient.ID())
 	return c.actionDispatcher.Dispatch(ctx, c, actionName, payload)
 }
 
@@ -316,6 +326,29 @@ func (c *WebsocketState) SetFilters(filters []octant.Filter) {
 	c.filters = filters
 }
 
+// SetEventFilters sets the event filter presets, and regenerates content
+// so event tables are updated.
+func (c *WebsocketState) SetEventFilters(presets eventfilter.Presets) {
+	c.mu.Lock()
+	c.eventFilters = presets
+	c.mu.Unlock()
+
+	for _, fn := range c.contentPathUpdates {
+		fn(c.GetContentPath())
+	}
+}
+
+// GetEventFilters returns the event filter presets.
+func (c *WebsocketState) GetEventFilters() eventfilter.Presets {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	presets := make(eventfilter.Presets, len(c.eventFilters))
+	copy(presets, c.eventFilters)
+
+	return presets
+}
+
 // SetContext sets the Kubernetes context.
 func (c *WebsocketState) SetContext(requestedContext string) {
 	if err := c.dashConfig.UseContext(context.TODO(), requestedContext); err != nil {
@@ -358,6 +391,16 @@ func (c *WebsocketState) SendAlert(alert action.Alert) {
 	c.wsClient.Send(CreateAlertUpdate(alert))
 }
 
+// SendProgress sends operation progress to the websocket client.
+func (c *WebsocketState) SendProgress(progress octant.OperationProgress) {
+	c.wsClient.Send(CreateOperationProgressUpdate(progress))
+}
+
+// SendEditResult sends the result of an edit to the websocket client.
+func (c *WebsocketState) SendEditResult(result octant.EditResult) {
+	c.wsClient.Send(CreateEditResultUpdate(result))
+}
+
 func updateContentPathNamespace(in, namespace string) string {
 	parts := strings.Split(in, "/")
 	if in == "" {
@@ -381,11 +424,28 @@ func CreateFiltersUpdate(filters []octant.Filter) octant.Event {
 	})
 }
 
+// CreateOperationProgressUpdate creates an operation progress event.
+func CreateOperationProgressUpdate(progress octant.OperationProgress) octant.Event {
+	return octant.Event{
+		Type: octant.EventTypeOperationProgress,
+		Data: progress,
+	}
+}
+
+// CreateEditResultUpdate creates an edit result event.
+func CreateEditResultUpdate(result octant.EditResult) octant.Event {
+	return octant.Event{
+		Type: octant.EventTypeEditResult,
+		Data: result,
+	}
+}
+
 // CreateAlertUpdate creates an alert update event.
 func CreateAlertUpdate(alert action.Alert) octant.Event {
 	return CreateEvent(octant.EventTypeAlert, action.Payload{
 		"type":       alert.Type,
 		"message":    alert.Message,
 		"expiration": alert.Expiration,
+		"link":       alert.Link,
 	})
 }
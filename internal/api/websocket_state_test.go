@@ -18,6 +18,7 @@ import (
 	"github.com/vmware/octant/internal/api"
 	"github.com/vmware/octant/internal/api/fake"
 	configFake "github.com/vmware/octant/internal/config/fake"
+	"github.com/vmware/octant/internal/eventfilter"
 	"github.com/vmware/octant/internal/log"
 	moduleFake "github.com/vmware/octant/internal/module/fake"
 	"github.com/vmware/octant/internal/octant"
@@ -252,6 +253,45 @@ func TestWebsocketState_AddFilter(t *testing.T) {
 	assert.Equal(t, expected, got)
 }
 
+func TestWebsocketState_SetEventFilters(t *testing.T) {
+	mocks := newWebsocketStateMocks(t, "default")
+	defer mocks.finish()
+	s := mocks.factory()
+
+	var updated []string
+	s.OnContentPathUpdate(func(contentPath string) {
+		updated = append(updated, contentPath)
+	})
+
+	presets := eventfilter.Presets{eventfilter.PresetHideNormal}
+	s.SetEventFilters(presets)
+
+	assert.Equal(t, presets, s.GetEventFilters())
+	assert.Len(t, updated, 1)
+}
+
+func TestWebsocketState_SendProgress(t *testing.T) {
+	mocks := newWebsocketStateMocks(t, "default")
+	defer mocks.finish()
+	s := mocks.factory()
+
+	progress := octant.OperationProgress{ID: "id", Done: true}
+	mocks.wsClient.EXPECT().Send(api.CreateOperationProgressUpdate(progress))
+
+	s.SendProgress(progress)
+}
+
+func TestWebsocketState_SendEditResult(t *testing.T) {
+	mocks := newWebsocketStateMocks(t, "default")
+	defer mocks.finish()
+	s := mocks.factory()
+
+	result := octant.EditResult{ID: "id", Applied: true}
+	mocks.wsClient.EXPECT().Send(api.CreateEditResultUpdate(result))
+
+	s.SendEditResult(result)
+}
+
 type websocketStateMocks struct {
 	controller       *gomock.Controller
 	module           *moduleFake.MockModule
@@ -269,6 +309,7 @@ func newWebsocketStateMocks(t *testing.T, namespace string) *websocketStateMocks
 	moduleManager := moduleFake.NewMockManagerInterface(controller)
 	dashConfig := configFake.NewMockDash(controller)
 	dashConfig.EXPECT().DefaultNamespace().Return(namespace)
+	dashConfig.EXPECT().EventFilters().Return(nil).AnyTimes()
 	dashConfig.EXPECT().ModuleManager().Return(moduleManager).AnyTimes()
 	dashConfig.EXPECT().Logger().Return(log.NopLogger()).AnyTimes()
 	octantClient := fake.NewMockOctantClient(controller)
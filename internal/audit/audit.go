@@ -0,0 +1,285 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/internal/session"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/store"
+)
+
+const (
+	// DefaultSize is the default number of entries kept by a Log.
+	DefaultSize = 500
+
+	redacted = "<redacted>"
+)
+
+// Result is the outcome of an action.
+type Result string
+
+const (
+	// ResultSucceeded is for actions which did not report a problem.
+	ResultSucceeded Result = "Succeeded"
+	// ResultFailed is for actions which alerted the user with a warning or
+	// an error.
+	ResultFailed Result = "Failed"
+	// ResultError is for actions which returned an error.
+	ResultError Result = "Error"
+)
+
+// Entry is an action run from the dashboard.
+type Entry struct {
+	// Time is when the action was run.
+	Time time.Time
+	// SessionID is the ID of the session which ran the action.
+	SessionID string
+	// User is the user of the session, if it was set by an authenticating
+	// proxy.
+	User string
+	// RemoteAddr is the address of the session.
+	RemoteAddr string
+	// Action is the name of the action.
+	Action string
+	// Object is the object the action acted on. It is empty if the payload
+	// does not describe an object.
+	Object store.Key
+	// Payload is the payload of the action, with secret values redacted.
+	Payload action.Payload
+	// Result is the outcome of the action.
+	Result Result
+	// Message describes the outcome of the action.
+	Message string
+}
+
+// Log is a ring buffer of entries. It keeps the most recent entries, up
+// to its size.
+type Log struct {
+	mu      sync.RWMutex
+	entries []Entry
+	next    int
+	full    bool
+}
+
+// NewLog creates an instance of Log which keeps size entries.
+func NewLog(size int) *Log {
+	if size < 1 {
+		size = DefaultSize
+	}
+
+	return &Log{
+		entries: make([]Entry, size),
+	}
+}
+
+// Add adds an entry, replacing the oldest entry if the log is full.
+func (l *Log) Add(entry Entry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.entries[l.next] = entry
+	l.next = (l.next + 1) % len(l.entries)
+	if l.next == 0 {
+		l.full = true
+	}
+}
+
+// List lists entries, newest first.
+func (l *Log) List() []Entry {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	count := l.next
+	if l.full {
+		count = len(l.entries)
+	}
+
+	list := make([]Entry, 0, count)
+	for i := 1; i <= count; i++ {
+		index := (l.next - i + len(l.entries)) % len(l.entries)
+		list = append(list, l.entries[index])
+	}
+
+	return list
+}
+
+// ActionDispatcher dispatches actions.
+type ActionDispatcher interface {
+	Dispatch(ctx context.Context, alerter action.Alerter, actionName string, payload action.Payload) error
+}
+
+// Dispatcher is an ActionDispatcher which records the actions it
+// dispatches in a Log.
+type Dispatcher struct {
+	dispatcher ActionDispatcher
+	log        *Log
+	sessions   *session.Registry
+	logger     log.Logger
+}
+
+var _ ActionDispatcher = (*Dispatcher)(nil)
+
+// NewDispatcher creates an instance of Dispatcher. Sessions are used to
+// find who ran an action.
+func NewDispatcher(dispatcher ActionDispatcher, auditLog *Log, sessions *session.Registry, logger log.Logger) *Dispatcher {
+	return &Dispatcher{
+		dispatcher: dispatcher,
+		log:        auditLog,
+		sessions:   sessions,
+		logger:     logger.With("component", "audit"),
+	}
+}
+
+// Dispatch dispatches an action and records it. The result of the action
+// is taken from the error it returns, or the alerts it sends.
+func (d *Dispatcher) Dispatch(ctx context.Context, alerter action.Alerter, actionName string, payload action.Payload) error {
+	recorder := &alertRecorder{alerter: alerter}
+
+	entry := Entry{
+		Time:      time.Now(),
+		SessionID: session.IDFrom(ctx),
+		Action:    actionName,
+		Payload:   redact(actionName, payload),
+	}
+
+	if s, ok := d.sessions.Get(entry.SessionID); ok {
+		entry.User = s.User
+		entry.RemoteAddr = s.RemoteAddr
+	}
+
+	if key, err := store.KeyFromPayload(payload); err == nil {
+		entry.Object = key
+	}
+
+	err := d.dispatcher.Dispatch(ctx, recorder, actionName, payload)
+
+	entry.Result, entry.Message = recorder.result(err)
+	d.log.Add(entry)
+
+	d.logger.With(
+		"session", entry.SessionID,
+		"user", entry.User,
+		"action", entry.Action,
+		"object", entry.ObjectName(),
+		"result", entry.Result,
+	).Infof("%s", entry.Message)
+
+	return err
+}
+
+// alertRecorder records the alerts sent by an action.
+type alertRecorder struct {
+	alerter action.Alerter
+	alerts  []action.Alert
+}
+
+var _ action.Alerter = (*alertRecorder)(nil)
+
+func (r *alertRecorder) SendAlert(alert action.Alert) {
+	r.alerts = append(r.alerts, alert)
+	r.alerter.SendAlert(alert)
+}
+
+// result returns the outcome of an action which returned err. The last
+// warning or error alert means the action failed.
+func (r *alertRecorder) result(err error) (Result, string) {
+	if err != nil {
+		return ResultError, err.Error()
+	}
+
+	result, message := ResultSucceeded, ""
+	for _, alert := range r.alerts {
+		switch alert.Type {
+		case action.AlertTypeWarning, action.AlertTypeError:
+			result, message = ResultFailed, alert.Message
+		default:
+			if result == ResultSucceeded {
+				message = alert.Message
+			}
+		}
+	}
+
+	return result, message
+}
+
+// recordedFields are the payload fields recorded for every action. They
+// describe what an action acts on, rather than data it sends to the
+// cluster.
+var recordedFields = map[string]bool{
+	"action":                    true,
+	"apiVersion":                true,
+	"kind":                      true,
+	"namespace":                 true,
+	"name":                      true,
+	octant.ResourceVersionField: true,
+	"dryRun":                    true,
+}
+
+// actionRecordedFields are the payload fields recorded for an action, as
+// well as recordedFields. Other fields are redacted, since they can hold
+// secret data, e.g. the private key sent to create a TLS secret, or a
+// secret in a manifest.
+var actionRecordedFields = map[string][]string{
+	octant.ActionSetDataKey:       {octant.DataKeyField},
+	octant.ActionRemoveDataKey:    {octant.DataKeyField},
+	octant.ActionCreateSecret:     {"secretType"},
+	octant.ActionUploadFile:       {"containerName", "path"},
+	octant.ActionDownloadFile:     {"containerName", "path"},
+	octant.ActionStartPortForward: {"port"},
+	octant.ActionScale:            {"replicas"},
+	octant.ActionEditAutoscaler:   {octant.HPAMinReplicasField, octant.HPAMaxReplicasField, octant.HPATargetCPUField},
+	octant.ActionRollback:         {octant.RollbackRevisionField},
+	octant.ActionTerminateSession: {"id"},
+	octant.ActionStopPortForward:  {"id"},
+}
+
+// redact copies the payload of an action and hides the values of fields
+// which are not recorded for it. Data values are recorded unless they are
+// set in secrets.
+func redact(actionName string, payload action.Payload) action.Payload {
+	recorded := map[string]bool{}
+	for _, field := range actionRecordedFields[actionName] {
+		recorded[field] = true
+	}
+
+	if kind, _ := payload.OptionalString("kind"); actionName == octant.ActionSetDataKey && kind != "Secret" {
+		recorded[octant.DataValueField] = true
+	}
+
+	copied := action.Payload{}
+	for k, v := range payload {
+		if recordedFields[k] || recorded[k] {
+			copied[k] = v
+			continue
+		}
+
+		copied[k] = redacted
+	}
+
+	return copied
+}
+
+// ObjectName describes the object an entry's action acted on, e.g.
+// Deployment default/web. It is blank if there was no object.
+func (e Entry) ObjectName() string {
+	key := e.Object
+	if key.Kind == "" {
+		return ""
+	}
+
+	if key.Namespace == "" {
+		return fmt.Sprintf("%s %s", key.Kind, key.Name)
+	}
+
+	return fmt.Sprintf("%s %s/%s", key.Kind, key.Namespace, key.Name)
+}
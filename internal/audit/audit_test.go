@@ -0,0 +1,257 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package audit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/internal/session"
+	"github.com/vmware/octant/pkg/action"
+	actionFake "github.com/vmware/octant/pkg/action/fake"
+	"github.com/vmware/octant/pkg/store"
+)
+
+func TestLog(t *testing.T) {
+	l := NewLog(2)
+	assert.Empty(t, l.List())
+
+	l.Add(Entry{Action: "first"})
+	assert.Equal(t, []Entry{{Action: "first"}}, l.List())
+
+	l.Add(Entry{Action: "second"})
+	l.Add(Entry{Action: "third"})
+	assert.Equal(t, []Entry{{Action: "third"}, {Action: "second"}}, l.List())
+}
+
+type dispatchFunc func(ctx context.Context, alerter action.Alerter, actionName string, payload action.Payload) error
+
+func (f dispatchFunc) Dispatch(ctx context.Context, alerter action.Alerter, actionName string, payload action.Payload) error {
+	return f(ctx, alerter, actionName, payload)
+}
+
+func TestDispatcher_Dispatch(t *testing.T) {
+	infoAlert := action.CreateAlert(action.AlertTypeInfo, "done", 0)
+	warningAlert := action.CreateAlert(action.AlertTypeWarning, "failed", 0)
+
+	payload := action.Payload{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"namespace":  "default",
+		"name":       "secret",
+		"key":        "password",
+		"value":      "hunter2",
+	}
+
+	tests := []struct {
+		name            string
+		alerts          []action.Alert
+		err             error
+		expectedResult  Result
+		expectedMessage string
+	}{
+		{
+			name:            "succeeded",
+			alerts:          []action.Alert{infoAlert},
+			expectedResult:  ResultSucceeded,
+			expectedMessage: "done",
+		},
+		{
+			name:            "failed",
+			alerts:          []action.Alert{infoAlert, warningAlert},
+			expectedResult:  ResultFailed,
+			expectedMessage: "failed",
+		},
+		{
+			name:            "error",
+			err:             errors.New("error"),
+			expectedResult:  ResultError,
+			expectedMessage: "error",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			alerter := actionFake.NewMockAlerter(controller)
+			for _, alert := range test.alerts {
+				alerter.EXPECT().SendAlert(alert)
+			}
+
+			dispatcher := dispatchFunc(func(ctx context.Context, alerter action.Alerter, actionName string, got action.Payload) error {
+				assert.Equal(t, "overview/setDataKey", actionName)
+				assert.Equal(t, "hunter2", got["value"])
+				for _, alert := range test.alerts {
+					alerter.SendAlert(alert)
+				}
+				return test.err
+			})
+
+			sessions := session.NewRegistry()
+			sessions.Add(session.Session{ID: "id", User: "user", RemoteAddr: "127.0.0.1:5000"}, nil)
+
+			auditLog := NewLog(DefaultSize)
+			d := NewDispatcher(dispatcher, auditLog, sessions, log.NopLogger())
+
+			ctx := session.WithID(context.Background(), "id")
+			err := d.Dispatch(ctx, alerter, "overview/setDataKey", payload)
+			assert.Equal(t, test.err, err)
+
+			entries := auditLog.List()
+			require.Len(t, entries, 1)
+
+			entry := entries[0]
+			assert.WithinDuration(t, time.Now(), entry.Time, time.Minute)
+			assert.Equal(t, "id", entry.SessionID)
+			assert.Equal(t, "user", entry.User)
+			assert.Equal(t, "127.0.0.1:5000", entry.RemoteAddr)
+			assert.Equal(t, "overview/setDataKey", entry.Action)
+			assert.Equal(t, store.Key{Namespace: "default", APIVersion: "v1", Kind: "Secret", Name: "secret"}, entry.Object)
+			assert.Equal(t, "Secret default/secret", entry.ObjectName())
+			assert.Equal(t, redacted, entry.Payload["value"])
+			assert.Equal(t, "password", entry.Payload["key"])
+			assert.Equal(t, test.expectedResult, entry.Result)
+			assert.Equal(t, test.expectedMessage, entry.Message)
+		})
+	}
+}
+
+func Test_redact(t *testing.T) {
+	secretManifest := "apiVersion: v1\nkind: Secret\nmetadata:\n  name: secret\ndata:\n  password: aHVudGVyMg==\n"
+
+	tests := []struct {
+		name       string
+		actionName string
+		payload    action.Payload
+		expected   action.Payload
+	}{
+		{
+			name:       "create TLS secret",
+			actionName: octant.ActionCreateSecret,
+			payload: action.Payload{
+				"namespace":   "default",
+				"name":        "tls",
+				"secretType":  "kubernetes.io/tls",
+				"certificate": "certificate",
+				"key":         "private key",
+			},
+			expected: action.Payload{
+				"namespace":   "default",
+				"name":        "tls",
+				"secretType":  "kubernetes.io/tls",
+				"certificate": redacted,
+				"key":         redacted,
+			},
+		},
+		{
+			name:       "create registry secret",
+			actionName: octant.ActionCreateSecret,
+			payload: action.Payload{
+				"namespace":  "default",
+				"name":       "registry",
+				"secretType": "kubernetes.io/dockerconfigjson",
+				"server":     "registry.example.com",
+				"username":   "user",
+				"password":   "hunter2",
+			},
+			expected: action.Payload{
+				"namespace":  "default",
+				"name":       "registry",
+				"secretType": "kubernetes.io/dockerconfigjson",
+				"server":     redacted,
+				"username":   redacted,
+				"password":   redacted,
+			},
+		},
+		{
+			name:       "apply manifest with a secret",
+			actionName: octant.ActionApplyManifest,
+			payload: action.Payload{
+				"namespace":               "default",
+				"dryRun":                  true,
+				octant.ApplyManifestField: secretManifest,
+			},
+			expected: action.Payload{
+				"namespace":               "default",
+				"dryRun":                  true,
+				octant.ApplyManifestField: redacted,
+			},
+		},
+		{
+			name:       "apply secret",
+			actionName: octant.ActionApplyObject,
+			payload: action.Payload{
+				"apiVersion":              "v1",
+				"kind":                    "Secret",
+				"namespace":               "default",
+				"name":                    "secret",
+				octant.ApplyManifestField: secretManifest,
+			},
+			expected: action.Payload{
+				"apiVersion":              "v1",
+				"kind":                    "Secret",
+				"namespace":               "default",
+				"name":                    "secret",
+				octant.ApplyManifestField: redacted,
+			},
+		},
+		{
+			name:       "upload file",
+			actionName: octant.ActionUploadFile,
+			payload: action.Payload{
+				"namespace":     "default",
+				"name":          "pod",
+				"containerName": "app",
+				"path":          "/etc/app/credentials",
+				"file":          "contents",
+			},
+			expected: action.Payload{
+				"namespace":     "default",
+				"name":          "pod",
+				"containerName": "app",
+				"path":          "/etc/app/credentials",
+				"file":          redacted,
+			},
+		},
+		{
+			name:       "set config map data",
+			actionName: octant.ActionSetDataKey,
+			payload: action.Payload{
+				"apiVersion":          "v1",
+				"kind":                "ConfigMap",
+				"namespace":           "default",
+				"name":                "config",
+				octant.DataKeyField:   "level",
+				octant.DataValueField: "debug",
+			},
+			expected: action.Payload{
+				"apiVersion":          "v1",
+				"kind":                "ConfigMap",
+				"namespace":           "default",
+				"name":                "config",
+				octant.DataKeyField:   "level",
+				octant.DataValueField: "debug",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := redact(test.actionName, test.payload)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
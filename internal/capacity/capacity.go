@@ -0,0 +1,221 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package capacity sums the resources pods request and are limited to, and
+// compares them to what each node can allocate.
+package capacity
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/vmware/octant/pkg/store"
+)
+
+// Usage is the resources requested by and limited for a set of pods.
+type Usage struct {
+	Requests corev1.ResourceList
+	Limits   corev1.ResourceList
+	Pods     int
+}
+
+func newUsage() Usage {
+	return Usage{
+		Requests: corev1.ResourceList{},
+		Limits:   corev1.ResourceList{},
+	}
+}
+
+func (u *Usage) add(requests, limits corev1.ResourceList) {
+	addResourceList(u.Requests, requests)
+	addResourceList(u.Limits, limits)
+	u.Pods++
+}
+
+// Node is the usage of a node.
+type Node struct {
+	Name        string
+	Allocatable corev1.ResourceList
+	Usage
+}
+
+// Namespace is the usage of a namespace.
+type Namespace struct {
+	Name string
+	Usage
+}
+
+// Report is the usage of a cluster. The cluster's usage only includes pods
+// scheduled to a node, while namespace usage includes pending pods.
+type Report struct {
+	Allocatable corev1.ResourceList
+	Usage
+	Nodes      []Node
+	Namespaces []Namespace
+}
+
+// Generate generates a report using the nodes and pods in the object
+// store. Pods which have terminated are not included.
+func Generate(ctx context.Context, objectStore store.Store) (*Report, error) {
+	if objectStore == nil {
+		return nil, errors.New("object store is nil")
+	}
+
+	nodes, err := listNodes(ctx, objectStore)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := listPods(ctx, objectStore)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		Allocatable: corev1.ResourceList{},
+		Usage:       newUsage(),
+	}
+
+	nodeUsage := make(map[string]*Node)
+	for i := range nodes {
+		node := &Node{
+			Name:        nodes[i].Name,
+			Allocatable: nodes[i].Status.Allocatable,
+			Usage:       newUsage(),
+		}
+		nodeUsage[node.Name] = node
+		addResourceList(report.Allocatable, node.Allocatable)
+	}
+
+	namespaceUsage := make(map[string]*Namespace)
+	for i := range pods {
+		pod := &pods[i]
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+
+		requests, limits := PodRequestsAndLimits(pod)
+
+		namespace, ok := namespaceUsage[pod.Namespace]
+		if !ok {
+			namespace = &Namespace{Name: pod.Namespace, Usage: newUsage()}
+			namespaceUsage[pod.Namespace] = namespace
+		}
+		namespace.add(requests, limits)
+
+		if node, ok := nodeUsage[pod.Spec.NodeName]; ok {
+			node.add(requests, limits)
+			report.add(requests, limits)
+		}
+	}
+
+	for _, node := range nodeUsage {
+		report.Nodes = append(report.Nodes, *node)
+	}
+	sort.Slice(report.Nodes, func(i, j int) bool {
+		return report.Nodes[i].Name < report.Nodes[j].Name
+	})
+
+	for _, namespace := range namespaceUsage {
+		report.Namespaces = append(report.Namespaces, *namespace)
+	}
+	sort.Slice(report.Namespaces, func(i, j int) bool {
+		return report.Namespaces[i].Name < report.Namespaces[j].Name
+	})
+
+	return report, nil
+}
+
+// PodRequestsAndLimits returns the resources a pod requests and is limited
+// to. Init containers run one at a time before the other containers, so a
+// pod needs the larger of their largest value and the containers' sum.
+func PodRequestsAndLimits(pod *corev1.Pod) (corev1.ResourceList, corev1.ResourceList) {
+	requests, limits := corev1.ResourceList{}, corev1.ResourceList{}
+
+	for _, container := range pod.Spec.Containers {
+		addResourceList(requests, container.Resources.Requests)
+		addResourceList(limits, container.Resources.Limits)
+	}
+
+	for _, container := range pod.Spec.InitContainers {
+		maxResourceList(requests, container.Resources.Requests)
+		maxResourceList(limits, container.Resources.Limits)
+	}
+
+	return requests, limits
+}
+
+func addResourceList(list, add corev1.ResourceList) {
+	for name, quantity := range add {
+		if value, ok := list[name]; ok {
+			value.Add(quantity)
+			list[name] = value
+			continue
+		}
+
+		list[name] = quantity.DeepCopy()
+	}
+}
+
+func maxResourceList(list, other corev1.ResourceList) {
+	for name, quantity := range other {
+		if value, ok := list[name]; !ok || quantity.Cmp(value) > 0 {
+			list[name] = quantity.DeepCopy()
+		}
+	}
+}
+
+// Fraction returns how much of total used is. It returns zero if total
+// is zero.
+func Fraction(used, total resource.Quantity) float64 {
+	if total.IsZero() {
+		return 0
+	}
+
+	return float64(used.MilliValue()) / float64(total.MilliValue())
+}
+
+func listNodes(ctx context.Context, objectStore store.Store) ([]corev1.Node, error) {
+	objects, _, err := objectStore.List(ctx, store.Key{APIVersion: "v1", Kind: "Node"})
+	if err != nil {
+		return nil, errors.Wrap(err, "list nodes")
+	}
+
+	var list []corev1.Node
+	for i := range objects.Items {
+		node := corev1.Node{}
+		if err := scheme.Scheme.Convert(&objects.Items[i], &node, nil); err != nil {
+			return nil, errors.Wrap(err, "convert node")
+		}
+
+		list = append(list, node)
+	}
+
+	return list, nil
+}
+
+func listPods(ctx context.Context, objectStore store.Store) ([]corev1.Pod, error) {
+	objects, _, err := objectStore.List(ctx, store.Key{APIVersion: "v1", Kind: "Pod"})
+	if err != nil {
+		return nil, errors.Wrap(err, "list pods")
+	}
+
+	var list []corev1.Pod
+	for i := range objects.Items {
+		pod := corev1.Pod{}
+		if err := scheme.Scheme.Convert(&objects.Items[i], &pod, nil); err != nil {
+			return nil, errors.Wrap(err, "convert pod")
+		}
+
+		list = append(list, pod)
+	}
+
+	return list, nil
+}
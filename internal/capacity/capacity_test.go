@@ -0,0 +1,135 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package capacity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/store"
+	storeFake "github.com/vmware/octant/pkg/store/fake"
+)
+
+func TestGenerate(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	node := testutil.CreateNode("node")
+	node.Status.Allocatable = corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("2"),
+		corev1.ResourceMemory: resource.MustParse("4Gi"),
+		corev1.ResourcePods:   resource.MustParse("110"),
+	}
+
+	scheduled := testutil.CreatePod("scheduled", withContainer("500m", "1Gi", "1", "2Gi"))
+	scheduled.Spec.NodeName = "node"
+
+	pending := testutil.CreatePod("pending", withContainer("250m", "", "", ""))
+
+	succeeded := testutil.CreatePod("succeeded", withContainer("1", "", "", ""))
+	succeeded.Spec.NodeName = "node"
+	succeeded.Status.Phase = corev1.PodSucceeded
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Node"}).
+		Return(testutil.ToUnstructuredList(t, node), false, nil)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Pod"}).
+		Return(testutil.ToUnstructuredList(t, scheduled, pending, succeeded), false, nil)
+
+	report, err := Generate(context.Background(), objectStore)
+	require.NoError(t, err)
+
+	assert.Equal(t, "2", quantity(report.Allocatable, corev1.ResourceCPU))
+	assert.Equal(t, "500m", quantity(report.Requests, corev1.ResourceCPU))
+	assert.Equal(t, "2Gi", quantity(report.Limits, corev1.ResourceMemory))
+	assert.Equal(t, 1, report.Pods)
+
+	require.Len(t, report.Nodes, 1)
+	assert.Equal(t, "node", report.Nodes[0].Name)
+	assert.Equal(t, "1Gi", quantity(report.Nodes[0].Requests, corev1.ResourceMemory))
+	assert.Equal(t, 1, report.Nodes[0].Pods)
+
+	require.Len(t, report.Namespaces, 1)
+	assert.Equal(t, "namespace", report.Namespaces[0].Name)
+	assert.Equal(t, "750m", quantity(report.Namespaces[0].Requests, corev1.ResourceCPU))
+	assert.Equal(t, 2, report.Namespaces[0].Pods)
+}
+
+func TestGenerate_nil_store(t *testing.T) {
+	_, err := Generate(context.Background(), nil)
+	require.Error(t, err)
+}
+
+func TestPodRequestsAndLimits(t *testing.T) {
+	pod := testutil.CreatePod("pod",
+		withContainer("100m", "128Mi", "200m", ""),
+		withContainer("100m", "128Mi", "", ""))
+	pod.Spec.InitContainers = []corev1.Container{
+		{
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("50m"),
+					corev1.ResourceMemory: resource.MustParse("512Mi"),
+				},
+			},
+		},
+	}
+
+	requests, limits := PodRequestsAndLimits(pod)
+
+	assert.Equal(t, "200m", quantity(requests, corev1.ResourceCPU))
+	assert.Equal(t, "512Mi", quantity(requests, corev1.ResourceMemory))
+	assert.Equal(t, "200m", quantity(limits, corev1.ResourceCPU))
+	assert.Equal(t, "", quantity(limits, corev1.ResourceMemory))
+}
+
+func TestFraction(t *testing.T) {
+	assert.Equal(t, 0.25, Fraction(resource.MustParse("500m"), resource.MustParse("2")))
+	assert.Equal(t, 0.0, Fraction(resource.MustParse("1"), resource.Quantity{}))
+}
+
+func withContainer(cpuRequest, memoryRequest, cpuLimit, memoryLimit string) testutil.PodOption {
+	return func(pod *corev1.Pod) {
+		container := corev1.Container{
+			Name: "container",
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{},
+				Limits:   corev1.ResourceList{},
+			},
+		}
+
+		set := func(list corev1.ResourceList, name corev1.ResourceName, value string) {
+			if value != "" {
+				list[name] = resource.MustParse(value)
+			}
+		}
+
+		set(container.Resources.Requests, corev1.ResourceCPU, cpuRequest)
+		set(container.Resources.Requests, corev1.ResourceMemory, memoryRequest)
+		set(container.Resources.Limits, corev1.ResourceCPU, cpuLimit)
+		set(container.Resources.Limits, corev1.ResourceMemory, memoryLimit)
+
+		pod.Spec.Containers = append(pod.Spec.Containers, container)
+	}
+}
+
+func quantity(list corev1.ResourceList, name corev1.ResourceName) string {
+	value, ok := list[name]
+	if !ok {
+		return ""
+	}
+
+	return value.String()
+}
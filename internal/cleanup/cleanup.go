@@ -0,0 +1,283 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package cleanup finds objects in a namespace which are left over from
+// earlier changes and can usually be deleted.
+package cleanup
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware/octant/pkg/store"
+)
+
+const (
+	// revisionAnnotation is the annotation a deployment controller sets
+	// on the replica sets it creates.
+	revisionAnnotation = "deployment.kubernetes.io/revision"
+	// defaultRevisionHistoryLimit is the revision history limit of a
+	// deployment which does not set one.
+	defaultRevisionHistoryLimit = 10
+)
+
+// Reason is the reason an object is a cleanup candidate.
+type Reason string
+
+const (
+	// ReasonRevisionHistory is used for scaled down replica sets which are
+	// older than their deployment's revision history limit.
+	ReasonRevisionHistory Reason = "beyond revision history limit"
+	// ReasonOrphaned is used for replica sets without an owner.
+	ReasonOrphaned Reason = "no owner"
+	// ReasonControllerDeleted is used for objects whose controller has
+	// been deleted.
+	ReasonControllerDeleted Reason = "controller deleted"
+)
+
+// Candidate is an object which can likely be deleted.
+type Candidate struct {
+	Object *unstructured.Unstructured
+	Reason Reason
+	// Detail explains the reason.
+	Detail string
+}
+
+var (
+	replicaSetKey = store.Key{APIVersion: "apps/v1", Kind: "ReplicaSet"}
+	deploymentKey = store.Key{APIVersion: "apps/v1", Kind: "Deployment"}
+	podKey        = store.Key{APIVersion: "v1", Kind: "Pod"}
+)
+
+// Find finds cleanup candidates in a namespace. Candidates are sorted by
+// kind and name.
+func Find(ctx context.Context, objectStore store.Store, namespace string) ([]Candidate, error) {
+	if objectStore == nil {
+		return nil, errors.New("object store is nil")
+	}
+
+	f := &finder{
+		objectStore: objectStore,
+		namespace:   namespace,
+		controllers: make(map[types.UID]bool),
+	}
+
+	candidates, err := f.replicaSets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	podCandidates, err := f.pods(ctx)
+	if err != nil {
+		return nil, err
+	}
+	candidates = append(candidates, podCandidates...)
+
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i].Object, candidates[j].Object
+		if a.GetKind() != b.GetKind() {
+			return a.GetKind() < b.GetKind()
+		}
+		return a.GetName() < b.GetName()
+	})
+
+	return candidates, nil
+}
+
+type finder struct {
+	objectStore store.Store
+	namespace   string
+	// controllers caches whether a controller exists by UID.
+	controllers map[types.UID]bool
+}
+
+func (f *finder) list(ctx context.Context, key store.Key) (*unstructured.UnstructuredList, error) {
+	key.Namespace = f.namespace
+	list, _, err := f.objectStore.List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list %s", key.Kind)
+	}
+
+	return list, nil
+}
+
+func (f *finder) replicaSets(ctx context.Context) ([]Candidate, error) {
+	deploymentList, err := f.list(ctx, deploymentKey)
+	if err != nil {
+		return nil, err
+	}
+
+	deployments := make(map[types.UID]*appsv1.Deployment)
+	for i := range deploymentList.Items {
+		deployment := &appsv1.Deployment{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(deploymentList.Items[i].Object, deployment); err != nil {
+			return nil, errors.Wrap(err, "convert deployment")
+		}
+		deployments[deployment.UID] = deployment
+		f.controllers[deployment.UID] = true
+	}
+
+	replicaSetList, err := f.list(ctx, replicaSetKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []Candidate
+	scaledDown := make(map[types.UID][]*unstructured.Unstructured)
+
+	for i := range replicaSetList.Items {
+		object := &replicaSetList.Items[i]
+		replicaSet := &appsv1.ReplicaSet{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, replicaSet); err != nil {
+			return nil, errors.Wrap(err, "convert replica set")
+		}
+		f.controllers[replicaSet.UID] = true
+
+		controllerRef := metav1.GetControllerOf(replicaSet)
+		if controllerRef == nil {
+			candidates = append(candidates, Candidate{Object: object, Reason: ReasonOrphaned})
+			continue
+		}
+
+		if controllerRef.Kind != "Deployment" {
+			continue
+		}
+
+		if _, ok := deployments[controllerRef.UID]; !ok {
+			candidates = append(candidates, Candidate{
+				Object: object,
+				Reason: ReasonControllerDeleted,
+				Detail: fmt.Sprintf("Deployment %s", controllerRef.Name),
+			})
+			continue
+		}
+
+		if isScaledDown(replicaSet) {
+			scaledDown[controllerRef.UID] = append(scaledDown[controllerRef.UID], object)
+		}
+	}
+
+	for uid, replicaSets := range scaledDown {
+		candidates = append(candidates, beyondHistoryLimit(deployments[uid], replicaSets)...)
+	}
+
+	return candidates, nil
+}
+
+func isScaledDown(replicaSet *appsv1.ReplicaSet) bool {
+	desired := int32(1)
+	if replicaSet.Spec.Replicas != nil {
+		desired = *replicaSet.Spec.Replicas
+	}
+
+	return desired == 0 && replicaSet.Status.Replicas == 0
+}
+
+// beyondHistoryLimit returns the scaled down replica sets of a deployment
+// which are older than its revision history limit. The deployment
+// controller normally removes them, so they are left over from a
+// controller which was not running or a limit which was lowered.
+func beyondHistoryLimit(deployment *appsv1.Deployment, replicaSets []*unstructured.Unstructured) []Candidate {
+	limit := defaultRevisionHistoryLimit
+	if deployment.Spec.RevisionHistoryLimit != nil {
+		limit = int(*deployment.Spec.RevisionHistoryLimit)
+	}
+
+	if len(replicaSets) <= limit {
+		return nil
+	}
+
+	sort.Slice(replicaSets, func(i, j int) bool {
+		return revision(replicaSets[i]) > revision(replicaSets[j])
+	})
+
+	var candidates []Candidate
+	for _, replicaSet := range replicaSets[limit:] {
+		candidates = append(candidates, Candidate{
+			Object: replicaSet,
+			Reason: ReasonRevisionHistory,
+			Detail: fmt.Sprintf("revision %d of Deployment %s, which keeps %d",
+				revision(replicaSet), deployment.Name, limit),
+		})
+	}
+
+	return candidates
+}
+
+func revision(object *unstructured.Unstructured) int64 {
+	value, err := strconv.ParseInt(object.GetAnnotations()[revisionAnnotation], 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return value
+}
+
+func (f *finder) pods(ctx context.Context) ([]Candidate, error) {
+	podList, err := f.list(ctx, podKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []Candidate
+
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+
+		controllerRef := metav1.GetControllerOf(pod)
+		if controllerRef == nil {
+			continue
+		}
+
+		exists, err := f.controllerExists(ctx, controllerRef)
+		if err != nil {
+			return nil, err
+		}
+
+		if !exists {
+			candidates = append(candidates, Candidate{
+				Object: pod,
+				Reason: ReasonControllerDeleted,
+				Detail: fmt.Sprintf("%s %s", controllerRef.Kind, controllerRef.Name),
+			})
+		}
+	}
+
+	return candidates, nil
+}
+
+func (f *finder) controllerExists(ctx context.Context, ref *metav1.OwnerReference) (bool, error) {
+	if exists, ok := f.controllers[ref.UID]; ok {
+		return exists, nil
+	}
+
+	key := store.Key{
+		Namespace:  f.namespace,
+		APIVersion: ref.APIVersion,
+		Kind:       ref.Kind,
+		Name:       ref.Name,
+	}
+
+	object, found, err := f.objectStore.Get(ctx, key)
+	if err != nil {
+		return false, errors.Wrapf(err, "get %s %s", ref.Kind, ref.Name)
+	}
+
+	// a controller with the same name which was created again is a
+	// different object.
+	exists := found && object.GetUID() == ref.UID
+	f.controllers[ref.UID] = exists
+
+	return exists, nil
+}
@@ -0,0 +1,103 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cleanup
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/pointer"
+
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/store/fake"
+)
+
+func TestFind(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	deployment := testutil.CreateDeployment("web")
+	deployment.Spec.RevisionHistoryLimit = pointer.Int32Ptr(1)
+
+	replicaSet := func(name string, revision int, replicas int32) *unstructured.Unstructured {
+		rs := testutil.CreateAppReplicaSet(name)
+		rs.Annotations = map[string]string{revisionAnnotation: fmt.Sprintf("%d", revision)}
+		rs.Spec.Replicas = pointer.Int32Ptr(replicas)
+		rs.SetOwnerReferences(testutil.ToOwnerReferences(t, deployment))
+		return testutil.ToUnstructured(t, rs)
+	}
+
+	current := replicaSet("web-3", 3, 2)
+	previous := replicaSet("web-2", 2, 0)
+	oldest := replicaSet("web-1", 1, 0)
+
+	orphan := testutil.ToUnstructured(t, testutil.CreateAppReplicaSet("orphan"))
+
+	deletedDeployment := testutil.CreateDeployment("api")
+	leftover := testutil.CreateAppReplicaSet("api-1")
+	leftover.SetOwnerReferences(testutil.ToOwnerReferences(t, deletedDeployment))
+
+	ownedPod := testutil.CreatePod("web-3-abcde")
+	ownedPod.SetOwnerReferences(testutil.ToOwnerReferences(t, current))
+
+	job := testutil.CreateJob("job")
+	jobPod := testutil.CreatePod("job-abcde")
+	jobPod.SetOwnerReferences(testutil.ToOwnerReferences(t, job))
+
+	standalonePod := testutil.CreatePod("standalone")
+
+	objectStore := fake.NewMockStore(controller)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "default", APIVersion: "apps/v1", Kind: "Deployment"}).
+		Return(testutil.ToUnstructuredList(t, deployment), false, nil)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "default", APIVersion: "apps/v1", Kind: "ReplicaSet"}).
+		Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{
+			*current, *previous, *oldest, *orphan, *testutil.ToUnstructured(t, leftover),
+		}}, false, nil)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}).
+		Return(testutil.ToUnstructuredList(t, ownedPod, jobPod, standalonePod), false, nil)
+	objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{Namespace: "default", APIVersion: "batch/v1", Kind: "Job", Name: "job"}).
+		Return(nil, false, nil)
+
+	ctx := context.Background()
+	got, err := Find(ctx, objectStore, "default")
+	require.NoError(t, err)
+
+	type result struct {
+		kind   string
+		name   string
+		reason Reason
+		detail string
+	}
+
+	var results []result
+	for _, candidate := range got {
+		results = append(results, result{
+			kind:   candidate.Object.GetKind(),
+			name:   candidate.Object.GetName(),
+			reason: candidate.Reason,
+			detail: candidate.Detail,
+		})
+	}
+
+	expected := []result{
+		{kind: "Pod", name: "job-abcde", reason: ReasonControllerDeleted, detail: "Job job"},
+		{kind: "ReplicaSet", name: "api-1", reason: ReasonControllerDeleted, detail: "Deployment api"},
+		{kind: "ReplicaSet", name: "orphan", reason: ReasonOrphaned},
+		{kind: "ReplicaSet", name: "web-1", reason: ReasonRevisionHistory, detail: "revision 1 of Deployment web, which keeps 1"},
+	}
+
+	assert.Equal(t, expected, results)
+}
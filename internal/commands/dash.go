@@ -12,6 +12,7 @@ import (
 	golog "log"
 	"os"
 	"os/signal"
+	"time"
 
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
@@ -19,11 +20,12 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/klog"
 
+	"github.com/vmware/octant/internal/api"
 	"github.com/vmware/octant/internal/dash"
 	"github.com/vmware/octant/internal/log"
 )
 
-func newOctantCmd() *cobra.Command {
+func newOctantCmd(version string) *cobra.Command {
 	var namespace string
 	var uiURL string
 	var kubeConfig string
@@ -33,6 +35,18 @@ func newOctantCmd() *cobra.Command {
 	var klogVerbosity int
 	var clientQPS float32
 	var clientBurst int
+	var listenerAddr string
+	var disableOpenBrowser bool
+	var startupJSON bool
+	var notesStorage string
+	var eventFilters string
+	var accessLog bool
+	var prometheusURL string
+	var enableExec bool
+	var portForwardExpiry time.Duration
+	var pluginConfig string
+	var pluginSettings []string
+	var remotePlugins string
 
 	octantCmd := &cobra.Command{
 		Use:   "octant",
@@ -65,13 +79,26 @@ func newOctantCmd() *cobra.Command {
 
 			go func() {
 				options := dash.Options{
-					EnableOpenCensus: enableOpenCensus,
-					KubeConfig:       kubeConfig,
-					Namespace:        namespace,
-					FrontendURL:      uiURL,
-					Context:          initialContext,
-					ClientQPS:        clientQPS,
-					ClientBurst:      clientBurst,
+					EnableOpenCensus:   enableOpenCensus,
+					KubeConfig:         kubeConfig,
+					Namespace:          namespace,
+					FrontendURL:        uiURL,
+					Context:            initialContext,
+					ClientQPS:          clientQPS,
+					ClientBurst:        clientBurst,
+					ListenerAddr:       listenerAddr,
+					DisableOpenBrowser: disableOpenBrowser,
+					StartupJSON:        startupJSON,
+					NotesStorage:       notesStorage,
+					EventFilters:       eventFilters,
+					AccessLog:          accessLog,
+					PrometheusURL:      prometheusURL,
+					EnableExec:         enableExec,
+					PortForwardExpiry:  portForwardExpiry,
+					PluginConfig:       pluginConfig,
+					PluginSettings:     pluginSettings,
+					RemotePlugins:      remotePlugins,
+					Version:            version,
 				}
 
 				if klogVerbosity > 0 {
@@ -112,6 +139,18 @@ func newOctantCmd() *cobra.Command {
 	octantCmd.Flags().IntVarP(&klogVerbosity, "klog-verbosity", "", 0, "klog verbosity level")
 	octantCmd.Flags().Float32VarP(&clientQPS, "client-qps", "", 200, "maximum QPS for client")
 	octantCmd.Flags().IntVarP(&clientBurst, "client-burst", "", 400, "maximum burst for client throttle")
+	octantCmd.Flags().StringVar(&listenerAddr, "listener-addr", api.ListenerAddr(), "dashboard host:port; the port can be a range (e.g. 7777-7787) to use the first free port")
+	octantCmd.Flags().BoolVar(&disableOpenBrowser, "disable-open-browser", os.Getenv("OCTANT_DISABLE_OPEN_BROWSER") != "", "disable automatic launching of the browser")
+	octantCmd.Flags().StringVar(&notesStorage, "notes-storage", "annotation", "where object notes are stored (annotation or configmap)")
+	octantCmd.Flags().StringVar(&eventFilters, "event-filters", "", "comma separated event filter presets applied to event tables (hide-normal, hide-image-pull, hide-probes)")
+	octantCmd.Flags().BoolVar(&startupJSON, "startup-json", false, "print the dashboard address, version, and context as JSON on startup")
+	octantCmd.Flags().StringVar(&prometheusURL, "prometheus-url", os.Getenv("OCTANT_PROMETHEUS_URL"), "Prometheus server URL used to graph workload metrics history")
+	octantCmd.Flags().BoolVar(&enableExec, "enable-exec", os.Getenv("OCTANT_ENABLE_EXEC") != "", "enable terminals and file copies in containers")
+	octantCmd.Flags().DurationVar(&portForwardExpiry, "port-forward-expiry", 0, "stop port forwards after this duration (e.g. 30m); 0 keeps them until they are stopped")
+	octantCmd.Flags().StringVar(&pluginConfig, "plugin-config", os.Getenv("OCTANT_PLUGIN_CONFIG"), "YAML or JSON file with settings passed to plugins, keyed by plugin name")
+	octantCmd.Flags().StringArrayVar(&pluginSettings, "plugin-setting", nil, "setting passed to a plugin in the form plugin.key=value; can be repeated")
+	octantCmd.Flags().StringVar(&remotePlugins, "remote-plugins", os.Getenv("OCTANT_REMOTE_PLUGINS"), "YAML or JSON file listing plugins which run as remote gRPC services, with their addresses and TLS configuration")
+	octantCmd.Flags().BoolVar(&accessLog, "access-log", os.Getenv("OCTANT_ACCESS_LOG") != "", "log HTTP and websocket API requests")
 
 	kubeConfig = os.Getenv("KUBECONFIG")
 	if kubeConfig == "" {
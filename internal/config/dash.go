@@ -15,9 +15,14 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/vmware/octant/internal/cluster"
+	"github.com/vmware/octant/internal/eventfilter"
 	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/internal/metrics"
 	"github.com/vmware/octant/internal/module"
+	"github.com/vmware/octant/internal/notes"
 	"github.com/vmware/octant/internal/portforward"
+	"github.com/vmware/octant/internal/rollup"
+	"github.com/vmware/octant/internal/slo"
 	"github.com/vmware/octant/pkg/plugin"
 )
 
@@ -94,6 +99,18 @@ type Dash interface {
 	Validate() error
 
 	ModuleManager() module.ManagerInterface
+
+	NotesStorage() notes.Storage
+
+	EventFilters() eventfilter.Presets
+
+	NamespaceRollups() *rollup.Tracker
+
+	MetricsProvider() metrics.Provider
+
+	SLOHistory() *slo.History
+
+	ExecEnabled() bool
 }
 
 // Live is a live version of dash config.
@@ -108,6 +125,12 @@ type Live struct {
 	kubeConfigPath     string
 	currentContextName string
 	restConfigOptions  cluster.RESTConfigOptions
+	notesStorage       notes.Storage
+	eventFilters       eventfilter.Presets
+	namespaceRollups   *rollup.Tracker
+	metricsProvider    metrics.Provider
+	sloHistory         *slo.History
+	execEnabled        bool
 }
 
 var _ Dash = (*Live)(nil)
@@ -124,6 +147,12 @@ func NewLiveConfig(
 	portForwarder portforward.PortForwarder,
 	currentContextName string,
 	restConfigOptions cluster.RESTConfigOptions,
+	notesStorage notes.Storage,
+	eventFilters eventfilter.Presets,
+	namespaceRollups *rollup.Tracker,
+	metricsProvider metrics.Provider,
+	sloHistory *slo.History,
+	execEnabled bool,
 ) *Live {
 	l := &Live{
 		clusterClient:      clusterClient,
@@ -136,6 +165,12 @@ func NewLiveConfig(
 		portForwarder:      portForwarder,
 		currentContextName: currentContextName,
 		restConfigOptions:  restConfigOptions,
+		notesStorage:       notesStorage,
+		eventFilters:       eventFilters,
+		namespaceRollups:   namespaceRollups,
+		metricsProvider:    metricsProvider,
+		sloHistory:         sloHistory,
+		execEnabled:        execEnabled,
 	}
 	objectStore.RegisterOnUpdate(func(store store.Store) {
 		l.objectStore = store
@@ -164,6 +199,38 @@ func (l *Live) ObjectStore() store.Store {
 	return l.objectStore
 }
 
+// NotesStorage returns where object notes are stored.
+func (l *Live) NotesStorage() notes.Storage {
+	return l.notesStorage
+}
+
+// EventFilters returns the default event filter presets.
+func (l *Live) EventFilters() eventfilter.Presets {
+	return l.eventFilters
+}
+
+// NamespaceRollups returns the tracker for per namespace rollups.
+func (l *Live) NamespaceRollups() *rollup.Tracker {
+	return l.namespaceRollups
+}
+
+// MetricsProvider returns the provider of metrics history. It is nil if
+// none is configured.
+func (l *Live) MetricsProvider() metrics.Provider {
+	return l.metricsProvider
+}
+
+// SLOHistory returns the samples workloads' service level objectives are
+// measured against.
+func (l *Live) SLOHistory() *slo.History {
+	return l.sloHistory
+}
+
+// ExecEnabled returns true if terminals can run commands in containers.
+func (l *Live) ExecEnabled() bool {
+	return l.execEnabled
+}
+
 // KubeConfigPath returns the kube config path.
 func (l *Live) KubeConfigPath() string {
 	return l.kubeConfigPath
@@ -16,8 +16,10 @@ import (
 
 	"github.com/vmware/octant/internal/cluster"
 	clusterFake "github.com/vmware/octant/internal/cluster/fake"
+	"github.com/vmware/octant/internal/eventfilter"
 	"github.com/vmware/octant/internal/log"
 	moduleFake "github.com/vmware/octant/internal/module/fake"
+	"github.com/vmware/octant/internal/notes"
 	portForwardFake "github.com/vmware/octant/internal/portforward/fake"
 	"github.com/vmware/octant/internal/testutil"
 	pluginFake "github.com/vmware/octant/pkg/plugin/fake"
@@ -89,8 +91,9 @@ func TestLiveConfig(t *testing.T) {
 
 	contextName := "context-name"
 	restConfigOptions := cluster.RESTConfigOptions{}
+	eventFilters := eventfilter.Presets{eventfilter.PresetHideProbes}
 
-	config := NewLiveConfig(clusterClient, crdWatcher, kubeConfigPath, logger, moduleManager, objectStore, pluginManager, portForwarder, contextName, restConfigOptions)
+	config := NewLiveConfig(clusterClient, crdWatcher, kubeConfigPath, logger, moduleManager, objectStore, pluginManager, portForwarder, contextName, restConfigOptions, notes.StorageConfigMap, eventFilters, nil, nil, nil, false)
 
 	assert.NoError(t, config.Validate())
 	assert.Equal(t, clusterClient, config.ClusterClient())
@@ -99,6 +102,8 @@ func TestLiveConfig(t *testing.T) {
 	assert.Equal(t, objectStore, config.ObjectStore())
 	assert.Equal(t, pluginManager, config.PluginManager())
 	assert.Equal(t, portForwarder, config.PortForwarder())
+	assert.Equal(t, notes.StorageConfigMap, config.NotesStorage())
+	assert.Equal(t, eventFilters, config.EventFilters())
 
 	objectPath, err := config.ObjectPath("", "", "", "")
 	require.NoError(t, err)
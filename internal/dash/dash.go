@@ -24,18 +24,31 @@ import (
 	"go.opencensus.io/trace"
 
 	"github.com/vmware/octant/internal/api"
+	"github.com/vmware/octant/internal/audit"
 	"github.com/vmware/octant/internal/cluster"
 	"github.com/vmware/octant/internal/config"
 	"github.com/vmware/octant/internal/describer"
+	"github.com/vmware/octant/internal/eventfilter"
+	"github.com/vmware/octant/internal/exec"
 	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/internal/metrics"
 	"github.com/vmware/octant/internal/module"
 	"github.com/vmware/octant/internal/modules/applications"
 	"github.com/vmware/octant/internal/modules/clusteroverview"
 	"github.com/vmware/octant/internal/modules/configuration"
+	"github.com/vmware/octant/internal/modules/dashboards"
+	"github.com/vmware/octant/internal/modules/events"
 	"github.com/vmware/octant/internal/modules/localcontent"
+	"github.com/vmware/octant/internal/modules/manifests"
 	"github.com/vmware/octant/internal/modules/overview"
+	"github.com/vmware/octant/internal/modules/rbacexplorer"
+	"github.com/vmware/octant/internal/notes"
 	"github.com/vmware/octant/internal/objectstore"
 	"github.com/vmware/octant/internal/portforward"
+	"github.com/vmware/octant/internal/printer"
+	"github.com/vmware/octant/internal/rollup"
+	"github.com/vmware/octant/internal/session"
+	"github.com/vmware/octant/internal/slo"
 	"github.com/vmware/octant/pkg/action"
 	"github.com/vmware/octant/pkg/plugin"
 	pluginAPI "github.com/vmware/octant/pkg/plugin/api"
@@ -51,6 +64,37 @@ type Options struct {
 	Context          string
 	ClientQPS        float32
 	ClientBurst      int
+	// ListenerAddr is the address the dashboard listens on. The port can
+	// be a range, in which case the first free port is used.
+	ListenerAddr       string
+	DisableOpenBrowser bool
+	// StartupJSON prints StartupInfo to stdout once the dashboard is listening.
+	StartupJSON bool
+	// NotesStorage is where object notes are stored.
+	NotesStorage string
+	// EventFilters is a comma separated list of default event filter presets.
+	EventFilters string
+	// AccessLog logs HTTP and websocket API requests.
+	AccessLog bool
+	// PrometheusURL is the address of a Prometheus server workload metrics
+	// history is queried from. History is not shown if it is blank.
+	PrometheusURL string
+	// EnableExec enables terminals which run commands in containers, and
+	// copying files to and from containers.
+	EnableExec bool
+	// PortForwardExpiry is how long port forwards run before they are
+	// stopped. Port forwards run until they are stopped if it is zero.
+	PortForwardExpiry time.Duration
+	// PluginConfig is a YAML or JSON file with settings for plugins, keyed
+	// by plugin name.
+	PluginConfig string
+	// PluginSettings are settings for plugins in the form plugin.key=value.
+	// They replace the settings in PluginConfig.
+	PluginSettings []string
+	// RemotePlugins is a YAML or JSON file listing plugins which run as
+	// remote gRPC services.
+	RemotePlugins string
+	Version       string
 }
 
 // Run runs the dashboard.
@@ -100,7 +144,7 @@ func Run(ctx context.Context, logger log.Logger, shutdownCh chan bool, options O
 		return errors.Wrap(err, "initializing CRD watcher")
 	}
 
-	portForwarder, err := initPortForwarder(ctx, clusterClient, appObjectStore)
+	portForwarder, err := initPortForwarder(ctx, clusterClient, appObjectStore, options.PortForwardExpiry)
 	if err != nil {
 		return errors.Wrap(err, "initializing port forwarder")
 	}
@@ -126,11 +170,28 @@ func Run(ctx context.Context, logger log.Logger, shutdownCh chan bool, options O
 		FrontendProxy: frontendProxy,
 	}
 
-	pluginManager, err := initPlugin(moduleManager, actionManger, pluginDashboardService)
+	pluginManager, err := initPlugin(moduleManager, actionManger, pluginDashboardService, options)
 	if err != nil {
 		return errors.Wrap(err, "initializing plugin manager")
 	}
 
+	notesStorage, err := notes.ParseStorage(options.NotesStorage)
+	if err != nil {
+		return err
+	}
+
+	eventFilters, err := eventfilter.ParsePresets(options.EventFilters)
+	if err != nil {
+		return err
+	}
+
+	metricsProvider, err := initMetricsProvider(options.PrometheusURL)
+	if err != nil {
+		return errors.Wrap(err, "initializing metrics provider")
+	}
+
+	sloHistory := slo.NewHistory()
+
 	dashConfig := config.NewLiveConfig(
 		clusterClient,
 		crdWatcher,
@@ -141,13 +202,34 @@ func Run(ctx context.Context, logger log.Logger, shutdownCh chan bool, options O
 		pluginManager,
 		portForwarder,
 		options.Context,
-		restConfigOptions)
+		restConfigOptions,
+		notesStorage,
+		eventFilters,
+		rollup.NewTracker(ctx, appObjectStore),
+		metricsProvider,
+		sloHistory,
+		options.EnableExec)
+
+	sloSampler := slo.NewSampler(sloHistory, printer.ObserveWorkloadSLOs(dashConfig), slo.DefaultSampleInterval)
+	go sloSampler.Run(ctx)
+
+	sessions := session.NewRegistry()
+	auditLog := audit.NewLog(audit.DefaultSize)
 
-	moduleList, err := initModules(ctx, dashConfig, options.Namespace)
+	printRegistry, err := printer.NewDefaultRegistry()
+	if err != nil {
+		return errors.Wrap(err, "initializing print registry")
+	}
+
+	moduleList, err := initModules(ctx, dashConfig, printRegistry, options.Namespace, sessions, auditLog)
 	if err != nil {
 		return errors.Wrap(err, "initializing modules")
 	}
 
+	if err := registerPrintHandlers(moduleList, printRegistry); err != nil {
+		return errors.Wrap(err, "registering module print handlers")
+	}
+
 	for _, mod := range moduleList {
 		if err := moduleManager.Register(mod); err != nil {
 			return errors.Wrapf(err, "loading module %s", mod.Name())
@@ -158,14 +240,36 @@ func Run(ctx context.Context, logger log.Logger, shutdownCh chan bool, options O
 		return errors.Wrapf(err, "start plugin manager")
 	}
 
-	listener, err := buildListener()
+	listenerAddr := options.ListenerAddr
+	if listenerAddr == "" {
+		listenerAddr = api.ListenerAddr()
+	}
+
+	listener, err := buildListener(listenerAddr)
 	if err != nil {
 		err = errors.Wrap(err, "failed to create net listener")
-		return errors.Wrap(err, "use OCTANT_LISTENER_ADDR to set host:port")
+		return errors.Wrap(err, "use --listener-addr to set host:port")
+	}
+
+	addr, err := servedAddr(listenerAddr, listener)
+	if err != nil {
+		return err
 	}
 
 	// Initialize the API
-	apiService := api.New(ctx, api.PathPrefix, actionManger, dashConfig)
+	apiOptions := []api.Option{
+		api.WithListenerAddr(addr),
+		api.WithSessions(sessions),
+	}
+	if options.AccessLog {
+		apiOptions = append(apiOptions, api.WithAccessLog())
+	}
+	if options.EnableExec {
+		apiOptions = append(apiOptions, api.WithExec(exec.NewExecutor(dashConfig)))
+	}
+
+	actionDispatcher := audit.NewDispatcher(actionManger, auditLog, sessions, logger)
+	apiService := api.New(ctx, api.PathPrefix, actionDispatcher, dashConfig, apiOptions...)
 	frontendProxy.FrontendUpdateController = apiService
 
 	d, err := newDash(listener, options.Namespace, options.FrontendURL, apiService, logger)
@@ -173,10 +277,21 @@ func Run(ctx context.Context, logger log.Logger, shutdownCh chan bool, options O
 		return errors.Wrap(err, "failed to create dash instance")
 	}
 
-	if os.Getenv("OCTANT_DISABLE_OPEN_BROWSER") != "" {
+	if options.DisableOpenBrowser {
 		d.willOpenBrowser = false
 	}
 
+	if options.StartupJSON {
+		info := StartupInfo{
+			Address: fmt.Sprintf("http://%s", addr),
+			Version: options.Version,
+			Context: dashConfig.ContextName(),
+		}
+		if err := writeStartupInfo(os.Stdout, info); err != nil {
+			return errors.Wrap(err, "write startup info")
+		}
+	}
+
 	go func() {
 		if err := d.Run(ctx); err != nil {
 			logger.Debugf("running dashboard service: %v", err)
@@ -211,8 +326,18 @@ func initObjectStore(ctx context.Context, client cluster.ClientInterface) (store
 	return appObjectStore, nil
 }
 
-func initPortForwarder(ctx context.Context, client cluster.ClientInterface, appObjectStore store.Store) (portforward.PortForwarder, error) {
-	return portforward.Default(ctx, client, appObjectStore)
+func initPortForwarder(ctx context.Context, client cluster.ClientInterface, appObjectStore store.Store, expiry time.Duration) (portforward.PortForwarder, error) {
+	return portforward.Default(ctx, client, appObjectStore, expiry)
+}
+
+// initMetricsProvider initializes the provider of metrics history. There is
+// no provider if a Prometheus URL is not set.
+func initMetricsProvider(prometheusURL string) (metrics.Provider, error) {
+	if prometheusURL == "" {
+		return nil, nil
+	}
+
+	return metrics.NewPrometheus(prometheusURL)
 }
 
 type moduleOptions struct {
@@ -226,20 +351,25 @@ type moduleOptions struct {
 	actionManager  *action.Manager
 }
 
-func initModules(ctx context.Context, dashConfig config.Dash, namespace string) ([]module.Module, error) {
+func initModules(ctx context.Context, dashConfig config.Dash, printRegistry *printer.Registry, namespace string, sessions *session.Registry, auditLog *audit.Log) ([]module.Module, error) {
 	var list []module.Module
 
 	if os.Getenv("OCTANT_ENABLE_APPLICATIONS") != "" {
 		applicationsOptions := applications.Options{
-			DashConfig: dashConfig,
+			DashConfig:    dashConfig,
+			PrintRegistry: printRegistry,
+		}
+		applicationsModule, err := applications.New(ctx, applicationsOptions)
+		if err != nil {
+			return nil, errors.Wrap(err, "create applications module")
 		}
-		applicationsModule := applications.New(ctx, applicationsOptions)
 		list = append(list, applicationsModule)
 	}
 
 	overviewOptions := overview.Options{
-		Namespace:  namespace,
-		DashConfig: dashConfig,
+		Namespace:     namespace,
+		DashConfig:    dashConfig,
+		PrintRegistry: printRegistry,
 	}
 	overviewModule, err := overview.New(ctx, overviewOptions)
 	if err != nil {
@@ -249,7 +379,8 @@ func initModules(ctx context.Context, dashConfig config.Dash, namespace string)
 	list = append(list, overviewModule)
 
 	clusterOverviewOptions := clusteroverview.Options{
-		DashConfig: dashConfig,
+		DashConfig:    dashConfig,
+		PrintRegistry: printRegistry,
 	}
 	clusterOverviewModule, err := clusteroverview.New(ctx, clusterOverviewOptions)
 	if err != nil {
@@ -258,9 +389,23 @@ func initModules(ctx context.Context, dashConfig config.Dash, namespace string)
 
 	list = append(list, clusterOverviewModule)
 
+	rbacExplorerOptions := rbacexplorer.Options{
+		Namespace:  namespace,
+		DashConfig: dashConfig,
+	}
+	list = append(list, rbacexplorer.New(rbacExplorerOptions))
+
+	eventsOptions := events.Options{
+		Namespace:  namespace,
+		DashConfig: dashConfig,
+	}
+	list = append(list, events.New(ctx, eventsOptions))
+
 	configurationOptions := configuration.Options{
 		DashConfig:     dashConfig,
 		KubeConfigPath: dashConfig.KubeConfigPath(),
+		Sessions:       sessions,
+		AuditLog:       auditLog,
 	}
 	configurationModule := configuration.New(ctx, configurationOptions)
 
@@ -272,9 +417,46 @@ func initModules(ctx context.Context, dashConfig config.Dash, namespace string)
 		list = append(list, localContentModule)
 	}
 
+	localManifestsPath := os.Getenv("OCTANT_LOCAL_MANIFESTS")
+	if localManifestsPath != "" {
+		manifestsOptions := manifests.Options{
+			Root:       localManifestsPath,
+			Namespace:  namespace,
+			DashConfig: dashConfig,
+		}
+		list = append(list, manifests.New(manifestsOptions))
+	}
+
+	dashboardsPath := os.Getenv("OCTANT_DASHBOARDS")
+	if dashboardsPath != "" {
+		dashboardsOptions := dashboards.Options{
+			Root:       dashboardsPath,
+			Namespace:  namespace,
+			DashConfig: dashConfig,
+		}
+		list = append(list, dashboards.New(dashboardsOptions))
+	}
+
 	return list, nil
 }
 
+// registerPrintHandlers lets modules which print objects with their own
+// handlers register them in the print registry.
+func registerPrintHandlers(modules []module.Module, printRegistry *printer.Registry) error {
+	for _, mod := range modules {
+		registrar, ok := mod.(printer.HandlerRegistrar)
+		if !ok {
+			continue
+		}
+
+		if err := registrar.RegisterPrintHandlers(printRegistry); err != nil {
+			return errors.Wrapf(err, "module %s", mod.Name())
+		}
+	}
+
+	return nil
+}
+
 // initModuleManager initializes the moduleManager (and currently the modules themselves)
 func initModuleManager(options *moduleOptions) (*module.Manager, error) {
 	moduleManager, err := module.NewManager(options.clusterClient, options.namespace, options.actionManager, options.logger)
@@ -285,16 +467,6 @@ func initModuleManager(options *moduleOptions) (*module.Manager, error) {
 	return moduleManager, nil
 }
 
-func buildListener() (net.Listener, error) {
-	listenerAddr := api.ListenerAddr()
-	conn, err := net.DialTimeout("tcp", listenerAddr, time.Millisecond*500)
-	if err != nil {
-		return net.Listen("tcp", listenerAddr)
-	}
-	conn.Close()
-	return nil, errors.New(fmt.Sprintf("tcp %s: dial: already in use", listenerAddr))
-}
-
 type dash struct {
 	listener        net.Listener
 	uiURL           string
@@ -0,0 +1,50 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package dash
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+
+	"github.com/vmware/octant/internal/module"
+	"github.com/vmware/octant/internal/printer"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+type printingModule struct {
+	module.Module
+}
+
+func (m *printingModule) Name() string {
+	return "printing"
+}
+
+func (m *printingModule) RegisterPrintHandlers(registry *printer.Registry) error {
+	return registry.RegisterHandler(func(context.Context, *appsv1.Deployment, printer.Options) (component.Component, error) {
+		return component.NewText("printing"), nil
+	}, printer.WithPriority(printer.PriorityOverride))
+}
+
+func Test_registerPrintHandlers(t *testing.T) {
+	printRegistry, err := printer.NewDefaultRegistry()
+	require.NoError(t, err)
+
+	modules := []module.Module{&printingModule{}}
+	require.NoError(t, registerPrintHandlers(modules, printRegistry))
+
+	printFunc, ok := printRegistry.Lookup(appsv1.SchemeGroupVersion.WithKind("Deployment"))
+	require.True(t, ok)
+
+	got, err := printFunc(context.Background(), &appsv1.Deployment{}, printer.Options{})
+	require.NoError(t, err)
+	assert.Equal(t, "printing", got.String())
+
+	require.Error(t, registerPrintHandlers(modules, printRegistry), "registering twice is a duplicate")
+}
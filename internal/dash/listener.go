@@ -0,0 +1,136 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package dash
+
+import (
+	"encoding/json"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+// maxListenerPorts limits the size of a listener port range.
+const maxListenerPorts = 1000
+
+// StartupInfo describes a running dashboard. It is printed as JSON on
+// startup so wrapper tooling can find the dashboard.
+type StartupInfo struct {
+	Address string `json:"address"`
+	Version string `json:"version"`
+	Context string `json:"context"`
+}
+
+// writeStartupInfo writes startup info as a single line of JSON.
+func writeStartupInfo(w io.Writer, info StartupInfo) error {
+	return json.NewEncoder(w).Encode(&info)
+}
+
+// parseListenerAddr parses a listener address into the candidate addresses
+// to listen on. The port can be a single port (127.0.0.1:7777) or
+// an inclusive range (127.0.0.1:7777-7787).
+func parseListenerAddr(listenerAddr string) ([]string, error) {
+	host, port, err := net.SplitHostPort(listenerAddr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse listener address %q", listenerAddr)
+	}
+
+	parts := strings.SplitN(port, "-", 2)
+	if len(parts) == 1 {
+		return []string{listenerAddr}, nil
+	}
+
+	start, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse start of port range %q", port)
+	}
+
+	end, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse end of port range %q", port)
+	}
+
+	if start < 1 || end > 65535 || start > end {
+		return nil, errors.Errorf("port range %q is invalid", port)
+	}
+
+	if end-start+1 > maxListenerPorts {
+		return nil, errors.Errorf("port range %q contains more than %d ports", port, maxListenerPorts)
+	}
+
+	var addrs []string
+	for p := start; p <= end; p++ {
+		addrs = append(addrs, net.JoinHostPort(host, strconv.Itoa(p)))
+	}
+
+	return addrs, nil
+}
+
+// buildListener creates a listener on the first free address described by
+// listenerAddr. See parseListenerAddr for the supported formats. Addresses
+// in a range are tried in order until one isn't already in use; any other
+// error stops the search.
+func buildListener(listenerAddr string) (net.Listener, error) {
+	addrs, err := parseListenerAddr(listenerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(addrs) == 1 {
+		return net.Listen("tcp", addrs[0])
+	}
+
+	for _, addr := range addrs {
+		listener, err := net.Listen("tcp", addr)
+		if err == nil {
+			return listener, nil
+		}
+
+		if !isAddrInUse(err) {
+			return nil, err
+		}
+	}
+
+	return nil, errors.Errorf("tcp %s: no free port in range", listenerAddr)
+}
+
+// servedAddr returns the address a listener built from listenerAddr serves
+// on. The configured host is kept, since it is the host browsers request,
+// and the port is the one the listener chose from a range.
+func servedAddr(listenerAddr string, listener net.Listener) (string, error) {
+	host, _, err := net.SplitHostPort(listenerAddr)
+	if err != nil {
+		return "", errors.Wrapf(err, "parse listener address %q", listenerAddr)
+	}
+
+	_, port, err := net.SplitHostPort(listener.Addr().String())
+	if err != nil {
+		return "", errors.Wrapf(err, "parse address of listener %q", listener.Addr())
+	}
+
+	return net.JoinHostPort(host, port), nil
+}
+
+// isAddrInUse returns true if err is returned when listening on an address
+// which is already in use.
+func isAddrInUse(err error) bool {
+	opErr, ok := err.(*net.OpError)
+	if !ok {
+		return false
+	}
+
+	syscallErr, ok := opErr.Err.(*os.SyscallError)
+	if !ok {
+		return false
+	}
+
+	errno, ok := syscallErr.Err.(syscall.Errno)
+	return ok && errno == errAddrInUse
+}
@@ -0,0 +1,149 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package dash
+
+import (
+	"bytes"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseListenerAddr(t *testing.T) {
+	tests := []struct {
+		name     string
+		addr     string
+		expected []string
+		isErr    bool
+	}{
+		{
+			name:     "single port",
+			addr:     "127.0.0.1:7777",
+			expected: []string{"127.0.0.1:7777"},
+		},
+		{
+			name:     "port range",
+			addr:     "127.0.0.1:7777-7779",
+			expected: []string{"127.0.0.1:7777", "127.0.0.1:7778", "127.0.0.1:7779"},
+		},
+		{
+			name:  "missing port",
+			addr:  "127.0.0.1",
+			isErr: true,
+		},
+		{
+			name:  "invalid range",
+			addr:  "127.0.0.1:7779-7777",
+			isErr: true,
+		},
+		{
+			name:  "non numeric range",
+			addr:  "127.0.0.1:7777-end",
+			isErr: true,
+		},
+		{
+			name:  "range too large",
+			addr:  "127.0.0.1:1-5000",
+			isErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := parseListenerAddr(test.addr)
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func Test_buildListener_skips_used_ports(t *testing.T) {
+	used, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer used.Close()
+
+	port := used.Addr().(*net.TCPAddr).Port
+	addr := "127.0.0.1:" + strconv.Itoa(port) + "-" + strconv.Itoa(port+1)
+
+	listener, err := buildListener(addr)
+	if err != nil {
+		t.Skipf("next port is not available: %v", err)
+	}
+	defer listener.Close()
+
+	assert.Equal(t, port+1, listener.Addr().(*net.TCPAddr).Port)
+}
+
+func Test_buildListener_single_port_in_use(t *testing.T) {
+	used, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer used.Close()
+
+	_, err = buildListener(used.Addr().String())
+	require.Error(t, err)
+	assert.True(t, isAddrInUse(err))
+}
+
+func Test_servedAddr(t *testing.T) {
+	listener, err := buildListener("localhost:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	port := strconv.Itoa(listener.Addr().(*net.TCPAddr).Port)
+
+	tests := []struct {
+		name         string
+		listenerAddr string
+		expected     string
+	}{
+		{
+			name:         "hostname",
+			listenerAddr: "localhost:0",
+			expected:     "localhost:" + port,
+		},
+		{
+			name:         "all interfaces",
+			listenerAddr: "0.0.0.0:0",
+			expected:     "0.0.0.0:" + port,
+		},
+		{
+			name:         "port range",
+			listenerAddr: "myhost:7777-7787",
+			expected:     "myhost:" + port,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := servedAddr(test.listenerAddr, listener)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func Test_writeStartupInfo(t *testing.T) {
+	var buf bytes.Buffer
+
+	info := StartupInfo{
+		Address: "http://127.0.0.1:7777",
+		Version: "v0.1.0",
+		Context: "minikube",
+	}
+	require.NoError(t, writeStartupInfo(&buf, info))
+
+	expected := `{"address":"http://127.0.0.1:7777","version":"v0.1.0","context":"minikube"}` + "\n"
+	assert.Equal(t, expected, buf.String())
+}
@@ -0,0 +1,12 @@
+// +build !windows
+
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package dash
+
+import "syscall"
+
+const errAddrInUse = syscall.EADDRINUSE
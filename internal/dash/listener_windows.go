@@ -0,0 +1,13 @@
+// +build windows
+
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package dash
+
+import "syscall"
+
+// errAddrInUse is WSAEADDRINUSE.
+const errAddrInUse = syscall.Errno(10048)
@@ -7,6 +7,7 @@ package dash
 
 import (
 	"github.com/pkg/errors"
+	"github.com/spf13/afero"
 
 	"github.com/vmware/octant/internal/module"
 	"github.com/vmware/octant/pkg/action"
@@ -14,13 +15,22 @@ import (
 	"github.com/vmware/octant/pkg/plugin/api"
 )
 
-func initPlugin(moduleManager module.ManagerInterface, actionManager *action.Manager, service api.Service) (*plugin.Manager, error) {
+func initPlugin(moduleManager module.ManagerInterface, actionManager *action.Manager, service api.Service, options Options) (*plugin.Manager, error) {
 	apiService, err := api.New(service)
 	if err != nil {
 		return nil, errors.Wrap(err, "create dashboard api")
 	}
 
-	m := plugin.NewManager(apiService, moduleManager, actionManager)
+	fs := afero.NewOsFs()
+
+	settings, err := pluginSettings(fs, options)
+	if err != nil {
+		return nil, err
+	}
+
+	m := plugin.NewManager(apiService, moduleManager, actionManager,
+		plugin.WithConfig(plugin.DefaultConfig),
+		plugin.WithPluginSettings(settings))
 
 	pluginList, err := plugin.AvailablePlugins(plugin.DefaultConfig)
 	if err != nil {
@@ -34,5 +44,39 @@ func initPlugin(moduleManager module.ManagerInterface, actionManager *action.Man
 
 	}
 
+	if options.RemotePlugins != "" {
+		remotePlugins, err := plugin.LoadRemotePlugins(fs, options.RemotePlugins)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, remotePlugin := range remotePlugins {
+			if err := m.LoadRemote(remotePlugin); err != nil {
+				return nil, errors.Wrapf(err, "initialize remote plugin %q", remotePlugin.Name)
+			}
+		}
+	}
+
 	return m, nil
 }
+
+// pluginSettings loads the settings for plugins from the plugin config file
+// and the plugin settings flags.
+func pluginSettings(fs afero.Fs, options Options) (map[string]plugin.Settings, error) {
+	settings := make(map[string]plugin.Settings)
+	if options.PluginConfig != "" {
+		loaded, err := plugin.LoadSettings(fs, options.PluginConfig)
+		if err != nil {
+			return nil, err
+		}
+		settings = loaded
+	}
+
+	for _, setting := range options.PluginSettings {
+		if err := plugin.ParseSetting(settings, setting); err != nil {
+			return nil, err
+		}
+	}
+
+	return settings, nil
+}
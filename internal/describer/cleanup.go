@@ -0,0 +1,100 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package describer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware/octant/internal/cleanup"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+var (
+	cleanupCols = component.NewTableCols("Name", "Kind", "Reason", "Age")
+)
+
+// Cleanup lists objects in a namespace which are left over from earlier
+// changes, e.g. replica sets beyond their deployment's revision history
+// limit. Each object can be deleted from the list.
+type Cleanup struct {
+	base
+
+	path  string
+	title string
+}
+
+var _ Describer = (*Cleanup)(nil)
+
+// NewCleanup creates an instance of Cleanup.
+func NewCleanup(p, title string) *Cleanup {
+	return &Cleanup{
+		path:  p,
+		title: title,
+	}
+}
+
+// Describe generates a list of cleanup candidates.
+func (c *Cleanup) Describe(ctx context.Context, namespace string, options Options) (component.ContentResponse, error) {
+	candidates, err := cleanup.Find(ctx, options.ObjectStore(), namespace)
+	if err != nil {
+		return component.EmptyContentResponse, errors.Wrap(err, "find cleanup candidates")
+	}
+
+	table := component.NewTable("Cleanup Candidates", "There is nothing to clean up!", cleanupCols)
+
+	for _, candidate := range candidates {
+		object := candidate.Object
+
+		name, err := options.Link.ForObject(object, object.GetName())
+		if err != nil {
+			return component.EmptyContentResponse, err
+		}
+
+		reason := string(candidate.Reason)
+		if candidate.Detail != "" {
+			reason = fmt.Sprintf("%s (%s)", reason, candidate.Detail)
+		}
+
+		row := component.TableRow{
+			"Name":   name,
+			"Kind":   component.NewText(object.GetKind()),
+			"Reason": component.NewText(reason),
+			"Age":    component.NewTimestamp(object.GetCreationTimestamp().Time),
+		}
+
+		key, err := store.KeyFromObject(object)
+		if err != nil {
+			return component.EmptyContentResponse, err
+		}
+
+		row.AddAction(component.NewButton("Delete",
+			action.CreatePayload(octant.ActionDeleteObject, key.ToActionPayload()),
+			component.WithButtonConfirmation(
+				fmt.Sprintf("Delete %s", object.GetKind()),
+				fmt.Sprintf("Are you sure you want to delete *%s* **%s**? This action is permanent and cannot be recovered.",
+					object.GetKind(), object.GetName()))))
+
+		table.Add(row)
+	}
+
+	cr := component.NewContentResponse(component.Title(component.NewText(c.title)))
+	cr.Add(table)
+
+	return *cr, nil
+}
+
+// PathFilters returns path filters for the cleanup list.
+func (c *Cleanup) PathFilters() []PathFilter {
+	return []PathFilter{
+		*NewPathFilter(c.path, c),
+	}
+}
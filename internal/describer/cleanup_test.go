@@ -0,0 +1,94 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package describer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	configFake "github.com/vmware/octant/internal/config/fake"
+	linkFake "github.com/vmware/octant/internal/link/fake"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/store"
+	storeFake "github.com/vmware/octant/pkg/store/fake"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func TestCleanup_Describe(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	orphan := testutil.ToUnstructured(t, testutil.CreateAppReplicaSet("orphan"))
+	orphan.SetNamespace("default")
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "default", APIVersion: "apps/v1", Kind: "Deployment"}).
+		Return(testutil.ToUnstructuredList(t), false, nil)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "default", APIVersion: "apps/v1", Kind: "ReplicaSet"}).
+		Return(testutil.ToUnstructuredList(t, orphan), false, nil)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}).
+		Return(testutil.ToUnstructuredList(t), false, nil)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectStore().Return(objectStore).AnyTimes()
+
+	orphanLink := component.NewLink("", "orphan", "/orphan")
+	linkGenerator := linkFake.NewMockInterface(controller)
+	linkGenerator.EXPECT().
+		ForObject(orphan, "orphan").
+		Return(orphanLink, nil)
+
+	options := Options{
+		Dash: dashConfig,
+		Link: linkGenerator,
+	}
+
+	c := NewCleanup("/cleanup", "Cleanup")
+
+	ctx := context.Background()
+	got, err := c.Describe(ctx, "default", options)
+	require.NoError(t, err)
+
+	table := component.NewTable("Cleanup Candidates", "There is nothing to clean up!", cleanupCols)
+	row := component.TableRow{
+		"Name":   orphanLink,
+		"Kind":   component.NewText("ReplicaSet"),
+		"Reason": component.NewText("no owner"),
+		"Age":    component.NewTimestamp(orphan.GetCreationTimestamp().Time),
+	}
+	row.AddAction(component.NewButton("Delete",
+		action.CreatePayload(octant.ActionDeleteObject, action.Payload{
+			"namespace":  "default",
+			"apiVersion": "apps/v1",
+			"kind":       "ReplicaSet",
+			"name":       "orphan",
+		}),
+		component.WithButtonConfirmation("Delete ReplicaSet",
+			"Are you sure you want to delete *ReplicaSet* **orphan**? This action is permanent and cannot be recovered.")))
+	table.Add(row)
+
+	expected := component.NewContentResponse(component.TitleFromString("Cleanup"))
+	expected.Add(table)
+
+	assert.Equal(t, *expected, got)
+}
+
+func TestCleanup_PathFilters(t *testing.T) {
+	c := NewCleanup("/cleanup", "Cleanup")
+
+	filters := c.PathFilters()
+	require.Len(t, filters, 1)
+	assert.True(t, filters[0].Match("/namespace/default/cleanup"))
+}
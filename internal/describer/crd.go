@@ -27,7 +27,7 @@ import (
 
 type crdPrinter func(ctx context.Context, crd *apiextv1beta1.CustomResourceDefinition, object *unstructured.Unstructured, options printer.Options) (component.Component, error)
 type resourceViewerPrinter func(ctx context.Context, object *unstructured.Unstructured, dashConfig config.Dash, q queryer.Queryer) (component.Component, error)
-type yamlPrinter func(runtime.Object) (*component.YAML, error)
+type yamlPrinter func(runtime.Object) (*component.Code, error)
 
 type crdOption func(*crd)
 
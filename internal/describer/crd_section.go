@@ -7,7 +7,10 @@ package describer
 
 import (
 	"context"
+	"fmt"
+	"path"
 	"sort"
+	"strings"
 	"sync"
 
 	"github.com/vmware/octant/internal/log"
@@ -46,18 +49,30 @@ func (csd *CRDSection) Remove(name string) {
 	delete(csd.describers, name)
 }
 
+// Describe describes every custom resource in the section. If options
+// has a group field, only custom resources in that API group are described.
 func (csd *CRDSection) Describe(ctx context.Context, namespace string, options Options) (component.ContentResponse, error) {
 	csd.mu.Lock()
 	defer csd.mu.Unlock()
 
+	group := options.Fields["group"]
+
 	var names []string
 	for name := range csd.describers {
+		if group != "" && crdGroup(name) != group {
+			continue
+		}
 		names = append(names, name)
 	}
 
 	sort.Strings(names)
 
-	list := component.NewList("Custom Resources", nil)
+	title := "Custom Resources"
+	if group != "" {
+		title = fmt.Sprintf("Custom Resources / %s", group)
+	}
+
+	list := component.NewList(title, nil)
 
 	for _, name := range names {
 		resp, err := csd.describers[name].Describe(ctx, namespace, options)
@@ -85,12 +100,26 @@ func (csd *CRDSection) Describe(ctx context.Context, namespace string, options O
 	return cr, nil
 }
 
+// PathFilters returns the path filters for the section and for each API
+// group in it.
 func (csd *CRDSection) PathFilters() []PathFilter {
 	return []PathFilter{
 		*NewPathFilter(csd.path, csd),
+		*NewPathFilter(path.Join(csd.path, "groups", "(?P<group>[^/]+)"), csd),
 	}
 }
 
+// crdGroup returns the API group of a CRD from its name, which is always
+// <plural>.<group>.
+func crdGroup(name string) string {
+	parts := strings.SplitN(name, ".", 2)
+	if len(parts) != 2 {
+		return ""
+	}
+
+	return parts[1]
+}
+
 func (csd *CRDSection) Reset(ctx context.Context) error {
 	csd.mu.Lock()
 	defer csd.mu.Unlock()
@@ -66,3 +66,41 @@ func Test_crdSectionDescriber(t *testing.T) {
 
 	assert.Equal(t, expected3, view3)
 }
+
+func Test_crdSectionDescriber_group(t *testing.T) {
+	csd := NewCRDSection("/path", "title")
+
+	d1View := component.NewText("d1")
+	d1 := NewStubDescriber("/d1", component.NewList("", []component.Component{d1View}))
+	csd.Add("d1s.example.com", d1)
+
+	d2View := component.NewText("d2")
+	d2 := NewStubDescriber("/d2", component.NewList("", []component.Component{d2View}))
+	csd.Add("d2s.other.com", d2)
+
+	ctx := context.Background()
+
+	got, err := csd.Describe(ctx, "default", Options{Fields: map[string]string{"group": "example.com"}})
+	require.NoError(t, err)
+
+	expected := component.ContentResponse{
+		Title: component.TitleFromString("title"),
+		Components: []component.Component{
+			component.NewList("Custom Resources / example.com", []component.Component{d1View}),
+		},
+	}
+
+	assert.Equal(t, expected, got)
+}
+
+func Test_crdSectionDescriber_PathFilters(t *testing.T) {
+	csd := NewCRDSection("/path", "title")
+
+	filters := csd.PathFilters()
+	require.Len(t, filters, 2)
+
+	assert.True(t, filters[0].Match("/path"))
+	assert.True(t, filters[1].Match("/path/groups/example.com"))
+	assert.Equal(t, map[string]string{"namespace": "", "group": "example.com"},
+		filters[1].Fields("/path/groups/example.com"))
+}
@@ -78,8 +78,8 @@ func Test_crd(t *testing.T) {
 			return component.NewText("rv"), nil
 		}
 
-		cd.yamlPrinter = func(runtime.Object) (*component.YAML, error) {
-			return component.NewYAML(component.TitleFromString("yaml"), "data"), nil
+		cd.yamlPrinter = func(runtime.Object) (*component.Code, error) {
+			return component.NewCode("yaml", "yaml", "data"), nil
 		}
 	}
 
@@ -109,7 +109,7 @@ func Test_crd(t *testing.T) {
 	rvView := component.NewText("rv")
 	rvView.SetAccessor("resourceViewer")
 	expected.Add(rvView)
-	yView := component.NewYAML(component.TitleFromString("yaml"), "data")
+	yView := component.NewCode("yaml", "yaml", "data")
 	yView.SetAccessor("yaml")
 	expected.Add(yView)
 
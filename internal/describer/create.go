@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package describer
+
+import (
+	"context"
+
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// Create is a page for creating objects from a manifest. The manifest can
+// be previewed with a dry run before it is applied.
+type Create struct {
+	base
+
+	path  string
+	title string
+}
+
+var _ Describer = (*Create)(nil)
+
+// NewCreate creates an instance of Create.
+func NewCreate(p, title string) *Create {
+	return &Create{
+		path:  p,
+		title: title,
+	}
+}
+
+// Describe generates a card with actions to preview and apply a manifest.
+func (c *Create) Describe(ctx context.Context, namespace string, options Options) (component.ContentResponse, error) {
+	card := component.NewCard("Create from Manifest")
+	card.SetBody(component.NewMarkdownText(
+		"Paste YAML or JSON for one or more objects. Namespaced objects are created in the namespace below. " +
+			"Clear the namespace to use the namespaces in the manifest."))
+
+	card.AddAction(createManifestAction("Preview", "Preview Manifest", namespace, true))
+	card.AddAction(createManifestAction("Apply", "Apply Manifest", namespace, false))
+
+	cr := component.NewContentResponse(component.Title(component.NewText(c.title)))
+	cr.Add(card)
+
+	return *cr, nil
+}
+
+func createManifestAction(name, title, namespace string, dryRun bool) component.Action {
+	dryRunValue := "false"
+	if dryRun {
+		dryRunValue = "true"
+	}
+
+	return component.Action{
+		Name:  name,
+		Title: title,
+		Form: component.Form{
+			Fields: []component.FormField{
+				component.NewFormFieldTextarea("Manifest (YAML or JSON)", octant.ApplyManifestField, "",
+					component.WithTextareaRows(20), component.WithTextareaMonospace()),
+				component.NewFormFieldText("Namespace", "namespace", namespace),
+				component.NewFormFieldHidden("dryRun", dryRunValue),
+				component.NewFormFieldHidden("action", octant.ActionApplyManifest),
+			},
+		},
+		ConfirmChanges: !dryRun,
+	}
+}
+
+// PathFilters returns path filters for the create page.
+func (c *Create) PathFilters() []PathFilter {
+	return []PathFilter{
+		*NewPathFilter(c.path, c),
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package describer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func TestCreate_Describe(t *testing.T) {
+	c := NewCreate("/create", "Create")
+
+	got, err := c.Describe(context.Background(), "default", Options{})
+	require.NoError(t, err)
+
+	require.Len(t, got.Components, 1)
+	card, ok := got.Components[0].(*component.Card)
+	require.True(t, ok)
+	require.Len(t, card.Config.Actions, 2)
+
+	preview := card.Config.Actions[0]
+	assert.Equal(t, "Preview", preview.Name)
+	assert.False(t, preview.ConfirmChanges)
+
+	values := make(map[string]interface{})
+	for _, field := range preview.Form.Fields {
+		values[field.Name()] = field.Value()
+	}
+	assert.Equal(t, map[string]interface{}{
+		octant.ApplyManifestField: "",
+		"namespace":               "default",
+		"dryRun":                  "true",
+		"action":                  octant.ActionApplyManifest,
+	}, values)
+
+	apply := card.Config.Actions[1]
+	assert.Equal(t, "Apply", apply.Name)
+	assert.True(t, apply.ConfirmChanges)
+}
+
+func TestCreate_PathFilters(t *testing.T) {
+	c := NewCreate("/create", "Create")
+
+	filters := c.PathFilters()
+	require.Len(t, filters, 1)
+	assert.True(t, filters[0].Match("/create"))
+}
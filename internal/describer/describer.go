@@ -61,7 +61,8 @@ func LoadObject(ctx context.Context, objectStore store.Store, namespace string,
 	return object, nil
 }
 
-// loadObjects loads objects from the object store sorted by their name.
+// LoadObjects loads objects from the object store sorted by their name. If
+// a single key is loaded, the list has the continue token of its page.
 func LoadObjects(ctx context.Context, objectStore store.Store, namespace string, fields map[string]string, objectStoreKeys []store.Key) (*unstructured.UnstructuredList, error) {
 	list := &unstructured.UnstructuredList{}
 
@@ -78,6 +79,11 @@ func LoadObjects(ctx context.Context, objectStore store.Store, namespace string,
 		}
 
 		list.Items = append(list.Items, storedObjects.Items...)
+
+		if next := storedObjects.GetContinue(); next != "" && len(objectStoreKeys) == 1 {
+			list.SetContinue(next)
+			list.SetRemainingItemCount(storedObjects.GetRemainingItemCount())
+		}
 	}
 
 	sort.SliceStable(list.Items, func(i, j int) bool {
@@ -100,6 +106,10 @@ type Options struct {
 	Printer  printer.Printer
 	LabelSet *kLabels.Set
 	Link     link.Interface
+	// Page is the page of rows list tables are limited to.
+	Page component.TablePage
+	// Query narrows the rows of list tables.
+	Query component.TableQuery
 
 	LoadObjects func(ctx context.Context, namespace string, fields map[string]string, objectStoreKeys []store.Key) (*unstructured.UnstructuredList, error)
 	LoadObject  func(ctx context.Context, namespace string, fields map[string]string, objectStoreKey store.Key) (*unstructured.Unstructured, error)
@@ -14,7 +14,9 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
 
+	octantErrors "github.com/vmware/octant/internal/errors"
 	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/internal/printer"
 	"github.com/vmware/octant/pkg/store"
 	"github.com/vmware/octant/pkg/view/component"
 )
@@ -28,6 +30,7 @@ type ListConfig struct {
 	IsClusterWide bool
 	IconName      string
 	IconSource    string
+	Header        func(namespace string) (component.Component, error)
 }
 
 // List describes a list of objects.
@@ -42,6 +45,7 @@ type List struct {
 	isClusterWide  bool
 	iconName       string
 	iconSource     string
+	header         func(namespace string) (component.Component, error)
 }
 
 // NewList creates an instance of List.
@@ -56,6 +60,7 @@ func NewList(c ListConfig) *List {
 		isClusterWide:  c.IsClusterWide,
 		iconName:       c.IconName,
 		iconSource:     c.IconSource,
+		header:         c.Header,
 	}
 }
 
@@ -69,6 +74,15 @@ func (d *List) Describe(ctx context.Context, namespace string, options Options)
 	var key = d.objectStoreKey // copy
 	key.Selector = options.LabelSet
 
+	// the object store pages the list if it doesn't need every object to
+	// narrow the rows or to find the page's offset.
+	page := options.Page
+	storePaged := isStorePaged(page, options.Query)
+	if storePaged {
+		key.Limit = int64(page.Limit)
+		key.Continue = page.Continue
+	}
+
 	if d.isClusterWide {
 		namespace = ""
 	}
@@ -83,6 +97,14 @@ func (d *List) Describe(ctx context.Context, namespace string, options Options)
 	list := component.NewList(d.title, nil)
 	list.SetIcon(d.iconName, d.iconSource)
 
+	if d.header != nil {
+		header, err := d.header(namespace)
+		if err != nil {
+			return component.EmptyContentResponse, errors.Wrap(err, "create list header")
+		}
+		list.Add(header)
+	}
+
 	listType := d.listType()
 
 	v := reflect.ValueOf(listType)
@@ -92,7 +114,8 @@ func (d *List) Describe(ctx context.Context, namespace string, options Options)
 	for i := range objectList.Items {
 		item := d.objectType()
 		if err := scheme.Scheme.Convert(&objectList.Items[i], item, nil); err != nil {
-			return component.EmptyContentResponse, err
+			return component.EmptyContentResponse, octantErrors.WrapConversion(err, "convert %s %s",
+				objectList.Items[i].GetKind(), objectList.Items[i].GetName())
 		}
 
 		if err := copyObjectMeta(item, &objectList.Items[i]); err != nil {
@@ -109,13 +132,26 @@ func (d *List) Describe(ctx context.Context, namespace string, options Options)
 			listType)
 	}
 
-	viewComponent, err := options.Printer.Print(ctx, listObject, options.PluginManager())
+	printPage := page
+	if storePaged {
+		printPage = component.TablePage{}
+	}
+
+	printCtx := printer.WithQuery(printer.WithPage(ctx, printPage), options.Query)
+	viewComponent, err := options.Printer.Print(printCtx, listObject, options.PluginManager())
 	if err != nil {
 		return component.EmptyContentResponse, err
 	}
 
 	if viewComponent != nil {
 		if table, ok := viewComponent.(*component.Table); ok {
+			if storePaged {
+				var remaining int
+				if count := objectList.GetRemainingItemCount(); count != nil {
+					remaining = int(*count)
+				}
+				table.SetContinue(page, objectList.GetContinue(), remaining)
+			}
 			list.Add(table)
 		} else {
 			list.Add(viewComponent)
@@ -127,6 +163,17 @@ func (d *List) Describe(ctx context.Context, namespace string, options Options)
 	}, nil
 }
 
+// isStorePaged returns true if a list can be paged by the object store.
+// Queries need every object, and pages after the first can only be listed
+// with the previous page's continue token.
+func isStorePaged(page component.TablePage, query component.TableQuery) bool {
+	if page.Limit < 1 || query.Search != "" || len(query.Filters) > 0 {
+		return false
+	}
+
+	return page.Offset < 1 || page.Continue != ""
+}
+
 // PathFilters returns path filters for this Describer.
 func (d *List) PathFilters() []PathFilter {
 	return []PathFilter{
@@ -16,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	configFake "github.com/vmware/octant/internal/config/fake"
+	"github.com/vmware/octant/internal/printer"
 	printerFake "github.com/vmware/octant/internal/printer/fake"
 	"github.com/vmware/octant/internal/testutil"
 	"github.com/vmware/octant/pkg/plugin"
@@ -83,3 +84,134 @@ func TestListDescriber(t *testing.T) {
 
 	assert.Equal(t, expected, cResponse)
 }
+
+func TestListDescriber_header(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pod := testutil.CreatePod("pod")
+
+	key, err := store.KeyFromObject(pod)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	namespace := "default"
+
+	dashConfig := configFake.NewMockDash(controller)
+	pluginManager := plugin.NewManager(nil, pluginFake.NewMockModuleRegistrar(controller), pluginFake.NewMockActionRegistrar(controller))
+	dashConfig.EXPECT().PluginManager().Return(pluginManager)
+
+	podListTable := createPodTable(*pod)
+
+	objectPrinter := printerFake.NewMockPrinter(controller)
+	objectPrinter.EXPECT().Print(gomock.Any(), gomock.Any(), pluginManager).Return(podListTable, nil)
+
+	options := Options{
+		Dash:    dashConfig,
+		Printer: objectPrinter,
+		LoadObjects: func(ctx context.Context, namespace string, fields map[string]string, objectStoreKeys []store.Key) (*unstructured.UnstructuredList, error) {
+			return testutil.ToUnstructuredList(t, pod), nil
+		},
+	}
+
+	header := component.NewText("header")
+
+	d := NewList(ListConfig{
+		Path:       "/",
+		Title:      "list",
+		StoreKey:   key,
+		ListType:   podListType,
+		ObjectType: podObjectType,
+		Header: func(got string) (component.Component, error) {
+			assert.Equal(t, namespace, got)
+			return header, nil
+		},
+	})
+	cResponse, err := d.Describe(ctx, namespace, options)
+	require.NoError(t, err)
+
+	list := component.NewList("list", nil)
+	list.Add(header, podListTable)
+	expected := component.ContentResponse{
+		Components: []component.Component{list},
+	}
+
+	assert.Equal(t, expected, cResponse)
+}
+
+func TestListDescriber_store_paged(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pod := testutil.CreatePod("pod")
+
+	key, err := store.KeyFromObject(pod)
+	require.NoError(t, err)
+
+	dashConfig := configFake.NewMockDash(controller)
+	pluginManager := plugin.NewManager(nil, pluginFake.NewMockModuleRegistrar(controller), pluginFake.NewMockActionRegistrar(controller))
+	dashConfig.EXPECT().PluginManager().Return(pluginManager)
+
+	podListTable := createPodTable(*pod)
+
+	objectPrinter := printerFake.NewMockPrinter(controller)
+	objectPrinter.EXPECT().Print(gomock.Any(), gomock.Any(), pluginManager).
+		DoAndReturn(func(ctx context.Context, _ interface{}, _ interface{}) (component.Component, error) {
+			assert.Equal(t, component.TablePage{}, printer.PageFrom(ctx))
+			return podListTable, nil
+		})
+
+	page := component.TablePage{Offset: 1, Limit: 1, Continue: "cache:default/a"}
+
+	options := Options{
+		Dash:    dashConfig,
+		Printer: objectPrinter,
+		Page:    page,
+		LoadObjects: func(ctx context.Context, namespace string, fields map[string]string, objectStoreKeys []store.Key) (*unstructured.UnstructuredList, error) {
+			require.Len(t, objectStoreKeys, 1)
+			assert.Equal(t, int64(1), objectStoreKeys[0].Limit)
+			assert.Equal(t, "cache:default/a", objectStoreKeys[0].Continue)
+
+			list := testutil.ToUnstructuredList(t, pod)
+			list.SetContinue("cache:default/pod")
+			remaining := int64(2)
+			list.SetRemainingItemCount(&remaining)
+			return list, nil
+		},
+	}
+
+	d := NewList(ListConfig{
+		Path:       "/",
+		Title:      "list",
+		StoreKey:   key,
+		ListType:   podListType,
+		ObjectType: podObjectType,
+	})
+	_, err = d.Describe(context.Background(), "default", options)
+	require.NoError(t, err)
+
+	expected := &component.TablePagination{Offset: 1, Limit: 1, Total: 4, Continue: "cache:default/pod"}
+	assert.Equal(t, expected, podListTable.Config.Pagination)
+}
+
+func Test_isStorePaged(t *testing.T) {
+	tests := []struct {
+		name     string
+		page     component.TablePage
+		query    component.TableQuery
+		expected bool
+	}{
+		{name: "no limit", page: component.TablePage{}},
+		{name: "first page", page: component.TablePage{Limit: 10}, expected: true},
+		{name: "continued page", page: component.TablePage{Offset: 10, Limit: 10, Continue: "token"}, expected: true},
+		{name: "offset without a continue token", page: component.TablePage{Offset: 10, Limit: 10}},
+		{name: "search", page: component.TablePage{Limit: 10}, query: component.TableQuery{Search: "pod"}},
+		{name: "filters", page: component.TablePage{Limit: 10}, query: component.TableQuery{Filters: map[string][]string{"Status": {"Running"}}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, isStorePaged(test.page, test.query))
+		})
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package describer
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware/octant/internal/printer"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// addNavigation adds a breadcrumb and siblings for an object to a
+// content response.
+func addNavigation(ctx context.Context, object *unstructured.Unstructured, cr *component.ContentResponse, options Options) error {
+	breadcrumb, err := objectBreadcrumb(ctx, object, options)
+	if err != nil {
+		return errors.Wrap(err, "create breadcrumb")
+	}
+
+	siblings, err := objectSiblings(ctx, object, options)
+	if err != nil {
+		return errors.Wrap(err, "find siblings")
+	}
+
+	cr.Breadcrumb = breadcrumb
+	cr.Siblings = siblings
+
+	return nil
+}
+
+// objectBreadcrumb creates a breadcrumb from the controllers which own an
+// object to the object itself. It returns nil if the object does not have
+// a controller.
+func objectBreadcrumb(ctx context.Context, object *unstructured.Unstructured, options Options) (*component.Breadcrumb, error) {
+	links, err := printer.OwnerChain(ctx, options.ObjectStore(), options.Link, object)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(links) == 0 {
+		return nil, nil
+	}
+
+	objectLink, err := options.Link.ForObject(object, object.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return component.NewBreadcrumb(append(links, objectLink)...), nil
+}
+
+// objectSiblings finds the objects before and after an object. Siblings
+// have the same kind and namespace, and share the object's controller.
+// Objects without a controller are siblings of each other. Siblings are
+// ordered by name. It returns nil if the object has no siblings.
+func objectSiblings(ctx context.Context, object *unstructured.Unstructured, options Options) (*component.Siblings, error) {
+	key := store.Key{
+		Namespace:  object.GetNamespace(),
+		APIVersion: object.GetAPIVersion(),
+		Kind:       object.GetKind(),
+	}
+
+	list, _, err := options.ObjectStore().List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list %s", key)
+	}
+
+	uid := controllerUID(object)
+
+	var siblings []*unstructured.Unstructured
+	for i := range list.Items {
+		if controllerUID(&list.Items[i]) == uid {
+			siblings = append(siblings, &list.Items[i])
+		}
+	}
+
+	sort.Slice(siblings, func(i, j int) bool {
+		return siblings[i].GetName() < siblings[j].GetName()
+	})
+
+	index := -1
+	for i := range siblings {
+		if siblings[i].GetName() == object.GetName() {
+			index = i
+			break
+		}
+	}
+
+	if index < 0 || len(siblings) < 2 {
+		return nil, nil
+	}
+
+	result := &component.Siblings{}
+
+	if index > 0 {
+		previous := siblings[index-1]
+		result.Previous, err = options.Link.ForObject(previous, previous.GetName())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if index < len(siblings)-1 {
+		next := siblings[index+1]
+		result.Next, err = options.Link.ForObject(next, next.GetName())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func controllerUID(object *unstructured.Unstructured) types.UID {
+	controllerRef := metav1.GetControllerOf(object)
+	if controllerRef == nil {
+		return ""
+	}
+
+	return controllerRef.UID
+}
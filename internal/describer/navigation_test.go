@@ -0,0 +1,112 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package describer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	configFake "github.com/vmware/octant/internal/config/fake"
+	linkFake "github.com/vmware/octant/internal/link/fake"
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/store"
+	storeFake "github.com/vmware/octant/pkg/store/fake"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func Test_addNavigation(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	replicaSet := testutil.CreateAppReplicaSet("replicaset")
+	replicaSet.UID = "replicaset"
+
+	newPod := func(name string) *corev1.Pod {
+		pod := testutil.CreatePod(name)
+		pod.SetOwnerReferences(testutil.ToOwnerReferences(t, replicaSet))
+		return pod
+	}
+
+	podA := newPod("pod-a")
+	podB := newPod("pod-b")
+	podC := newPod("pod-c")
+	other := testutil.CreatePod("other")
+
+	objectStore := storeFake.NewMockStore(controller)
+	podKey := store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod"}
+	objectStore.EXPECT().List(gomock.Any(), podKey).
+		Return(testutil.ToUnstructuredList(t, podC, other, podA, podB), false, nil)
+	replicaSetKey := store.Key{Namespace: "namespace", APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "replicaset"}
+	objectStore.EXPECT().Get(gomock.Any(), replicaSetKey).
+		Return(testutil.ToUnstructured(t, replicaSet), true, nil)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectStore().Return(objectStore).AnyTimes()
+
+	object := testutil.ToUnstructured(t, podB)
+
+	linkGenerator := linkFake.NewMockInterface(controller)
+	linkGenerator.EXPECT().ForOwner(gomock.Any(), gomock.Any()).
+		Return(component.NewLink("", "replicaset", "/replicaset"), nil)
+	linkGenerator.EXPECT().ForObject(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(object *unstructured.Unstructured, name string) (*component.Link, error) {
+			return component.NewLink("", name, "/"+name), nil
+		}).Times(3)
+
+	options := Options{
+		Dash: dashConfig,
+		Link: linkGenerator,
+	}
+
+	cr := component.NewContentResponse(nil)
+
+	ctx := context.Background()
+	require.NoError(t, addNavigation(ctx, object, cr, options))
+
+	expectedBreadcrumb := component.NewBreadcrumb(
+		component.NewLink("", "replicaset", "/replicaset"),
+		component.NewLink("", "pod-b", "/pod-b"),
+	)
+	assert.Equal(t, expectedBreadcrumb, cr.Breadcrumb)
+
+	expectedSiblings := &component.Siblings{
+		Previous: component.NewLink("", "pod-a", "/pod-a"),
+		Next:     component.NewLink("", "pod-c", "/pod-c"),
+	}
+	assert.Equal(t, expectedSiblings, cr.Siblings)
+}
+
+func Test_objectSiblings_none(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pod := testutil.CreatePod("pod")
+
+	objectStore := storeFake.NewMockStore(controller)
+	podKey := store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod"}
+	objectStore.EXPECT().List(gomock.Any(), podKey).
+		Return(testutil.ToUnstructuredList(t, pod), false, nil)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectStore().Return(objectStore).AnyTimes()
+
+	options := Options{
+		Dash: dashConfig,
+		Link: linkFake.NewMockInterface(controller),
+	}
+
+	ctx := context.Background()
+	got, err := objectSiblings(ctx, testutil.ToUnstructured(t, pod), options)
+	require.NoError(t, err)
+
+	assert.Nil(t, got)
+}
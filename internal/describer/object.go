@@ -14,9 +14,11 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 
 	"github.com/vmware/octant/internal/api"
+	octantErrors "github.com/vmware/octant/internal/errors"
 	"github.com/vmware/octant/internal/log"
 	"github.com/vmware/octant/internal/modules/overview/logviewer"
 	"github.com/vmware/octant/internal/modules/overview/yamlviewer"
+	"github.com/vmware/octant/internal/printer"
 	"github.com/vmware/octant/internal/resourceviewer"
 	"github.com/vmware/octant/pkg/store"
 	"github.com/vmware/octant/pkg/view/component"
@@ -82,6 +84,12 @@ func (d *Object) Describe(ctx context.Context, namespace string, options Options
 
 	object, err := options.LoadObject(ctx, namespace, options.Fields, d.objectStoreKey)
 	if err != nil {
+		// an object the user can't access or that couldn't be loaded in time
+		// may exist, so only other errors are reported as not found.
+		switch octantErrors.CodeOf(err) {
+		case octantErrors.CodeForbidden, octantErrors.CodeTimeout:
+			return component.EmptyContentResponse, errors.Wrapf(err, "load object %s", d.objectStoreKey)
+		}
 		return component.EmptyContentResponse, api.NewNotFoundError(d.path)
 	} else if object == nil {
 		return component.EmptyContentResponse, errors.Errorf("unable to load object %s", d.objectStoreKey)
@@ -90,7 +98,7 @@ func (d *Object) Describe(ctx context.Context, namespace string, options Options
 	item := d.objectType()
 
 	if err := scheme.Scheme.Convert(object, item, nil); err != nil {
-		return component.EmptyContentResponse, errors.Wrapf(err, "converting dynamic object to a type")
+		return component.EmptyContentResponse, octantErrors.WrapConversion(err, "converting dynamic object to a type")
 	}
 
 	if err := copyObjectMeta(item, object); err != nil {
@@ -109,6 +117,12 @@ func (d *Object) Describe(ctx context.Context, namespace string, options Options
 	cr.IconSource = d.iconSource
 	cr.IconName = d.iconName
 
+	if options.Link != nil {
+		if err := addNavigation(ctx, object, cr, options); err != nil {
+			logger.WithErr(err).Errorf("generating object navigation")
+		}
+	}
+
 	currentObject, ok := item.(runtime.Object)
 	if !ok {
 		return component.EmptyContentResponse, errors.Errorf("expected item to be a runtime object. It was a %T",
@@ -151,6 +165,9 @@ func (d *Object) PathFilters() []PathFilter {
 }
 
 func (d *Object) addSummaryTab(ctx context.Context, object runtime.Object, cr *component.ContentResponse, options Options) error {
+	tabs := printer.NewTabs()
+	ctx = printer.WithTabs(ctx, tabs)
+
 	vc, err := options.Printer.Print(ctx, object, options.PluginManager())
 	if vc == nil {
 		return errors.Wrap(err, "unable to print a nil object")
@@ -169,6 +186,9 @@ func (d *Object) addSummaryTab(ctx context.Context, object runtime.Object, cr *c
 	vc.SetAccessor("summary")
 	cr.Add(vc)
 
+	// tabs registered by the object's printer follow its summary.
+	cr.Add(tabs.Components()...)
+
 	return nil
 }
 
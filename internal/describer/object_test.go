@@ -10,11 +10,15 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	configFake "github.com/vmware/octant/internal/config/fake"
+	octantErrors "github.com/vmware/octant/internal/errors"
+	"github.com/vmware/octant/internal/printer"
 	printerFake "github.com/vmware/octant/internal/printer/fake"
 	"github.com/vmware/octant/internal/testutil"
 	"github.com/vmware/octant/pkg/plugin"
@@ -88,3 +92,89 @@ func TestObjectDescriber(t *testing.T) {
 	assert.Equal(t, expected, cResponse)
 
 }
+
+func TestObjectDescriber_printer_tabs(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pod := testutil.CreatePod("pod")
+
+	key, err := store.KeyFromObject(pod)
+	require.NoError(t, err)
+
+	dashConfig := configFake.NewMockDash(controller)
+	moduleRegistrar := pluginFake.NewMockModuleRegistrar(controller)
+	actionRegistrar := pluginFake.NewMockActionRegistrar(controller)
+
+	pluginManager := plugin.NewManager(nil, moduleRegistrar, actionRegistrar)
+	dashConfig.EXPECT().PluginManager().Return(pluginManager).AnyTimes()
+
+	history := component.NewText("history")
+
+	objectPrinter := printerFake.NewMockPrinter(controller)
+	objectPrinter.EXPECT().Print(gomock.Any(), pod, pluginManager).
+		DoAndReturn(func(ctx context.Context, _ runtime.Object, _ plugin.ManagerInterface) (component.Component, error) {
+			printer.TabsFrom(ctx).Add(history)
+			return component.NewText("summary"), nil
+		})
+
+	options := Options{
+		Dash:    dashConfig,
+		Printer: objectPrinter,
+		LoadObject: func(ctx context.Context, namespace string, fields map[string]string, objectStoreKey store.Key) (*unstructured.Unstructured, error) {
+			return testutil.ToUnstructured(t, pod), nil
+		},
+	}
+
+	d := NewObject(ObjectConfig{
+		Path:       "/",
+		BaseTitle:  "object",
+		StoreKey:   key,
+		ObjectType: podObjectType,
+	})
+	d.tabFuncDescriptors = []tabFuncDescriptor{
+		{name: "summary", tabFunc: d.addSummaryTab},
+	}
+
+	cResponse, err := d.Describe(context.Background(), pod.Namespace, options)
+	require.NoError(t, err)
+
+	summary := component.NewText("summary")
+	summary.SetAccessor("summary")
+
+	assert.Equal(t, []component.Component{summary, history}, cResponse.Components)
+}
+
+func TestObjectDescriber_load_error(t *testing.T) {
+	cases := []struct {
+		name     string
+		err      error
+		expected octantErrors.Code
+	}{
+		{name: "forbidden", err: octantErrors.NewForbidden("no get access"), expected: octantErrors.CodeForbidden},
+		{name: "timeout", err: octantErrors.NewTimeout("get pod"), expected: octantErrors.CodeTimeout},
+		{name: "other", err: errors.New("failed"), expected: octantErrors.CodeNotFound},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			options := Options{
+				LoadObject: func(ctx context.Context, namespace string, fields map[string]string, objectStoreKey store.Key) (*unstructured.Unstructured, error) {
+					return nil, tc.err
+				},
+			}
+
+			d := NewObject(ObjectConfig{
+				Path:       "/pod",
+				BaseTitle:  "object",
+				StoreKey:   store.Key{APIVersion: "v1", Kind: "Pod", Name: "pod"},
+				ObjectType: podObjectType,
+			})
+
+			ctx := context.Background()
+			_, err := d.Describe(ctx, "default", options)
+			require.Error(t, err)
+			assert.Equal(t, tc.expected, octantErrors.CodeOf(err))
+		})
+	}
+}
@@ -9,12 +9,14 @@ import (
 	"sync"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/api/extensions/v1beta1"
 	rbacv1 "k8s.io/api/rbac/v1"
 
+	"github.com/vmware/octant/internal/printer"
 	"github.com/vmware/octant/pkg/icon"
 	"github.com/vmware/octant/pkg/store"
 )
@@ -79,6 +81,15 @@ func initNamespacedOverview() *Section {
 		IconName:       icon.OverviewDeployment,
 	})
 
+	workloadsHorizontalPodAutoscalers := NewResource(ResourceOptions{
+		Path:           "/workloads/horizontal-pod-autoscalers",
+		ObjectStoreKey: store.Key{APIVersion: "autoscaling/v1", Kind: "HorizontalPodAutoscaler"},
+		ListType:       &autoscalingv1.HorizontalPodAutoscalerList{},
+		ObjectType:     &autoscalingv1.HorizontalPodAutoscaler{},
+		Titles:         ResourceTitle{List: "Workloads / Horizontal Pod Autoscalers", Object: "Horizontal Pod Autoscaler"},
+		IconName:       icon.OverviewHorizontalPodAutoscaler,
+	})
+
 	workloadsJobs := NewResource(ResourceOptions{
 		Path:           "/workloads/jobs",
 		ObjectStoreKey: store.Key{APIVersion: "batch/v1", Kind: "Job"},
@@ -123,12 +134,20 @@ func initNamespacedOverview() *Section {
 		IconName:       icon.OverviewStatefulSet,
 	})
 
-	workloadsDescriber := NewSection(
+	workloadsDescriber := NewWorkloads(
 		"/workloads",
 		"Workloads",
+		[]*Resource{
+			workloadsDeployments,
+			workloadsStatefulSets,
+			workloadsDaemonSets,
+			workloadsJobs,
+			workloadsCronJobs,
+		},
 		workloadsCronJobs,
 		workloadsDaemonSets,
 		workloadsDeployments,
+		workloadsHorizontalPodAutoscalers,
 		workloadsJobs,
 		workloadsPods,
 		workloadsReplicaSets,
@@ -186,6 +205,7 @@ func initNamespacedOverview() *Section {
 		ObjectType:     &corev1.Secret{},
 		Titles:         ResourceTitle{List: "Config & Storage / Secrets", Object: "Secret"},
 		IconName:       icon.OverviewSecret,
+		ListHeader:     printer.SecretCreateCard,
 	})
 
 	csServiceAccounts := NewResource(ResourceOptions{
@@ -240,6 +260,10 @@ func initNamespacedOverview() *Section {
 		DisableResourceViewer: true,
 	})
 
+	cleanupDescriber := NewCleanup("/cleanup", "Cleanup")
+
+	createDescriber := NewCreate("/create", "Create")
+
 	rootDescriber := NewSection(
 		"/",
 		"Overview",
@@ -249,6 +273,8 @@ func initNamespacedOverview() *Section {
 		NamespacedCRD(),
 		rbacDescriber,
 		eventsDescriber,
+		cleanupDescriber,
+		createDescriber,
 	)
 
 	return rootDescriber
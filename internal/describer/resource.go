@@ -34,6 +34,8 @@ type ResourceOptions struct {
 	DisableResourceViewer bool
 	ClusterWide           bool
 	IconName              string
+	// ListHeader creates a component which is shown above the list.
+	ListHeader func(namespace string) (component.Component, error)
 }
 
 type Resource struct {
@@ -69,6 +71,7 @@ func (r *Resource) List() *List {
 			IsClusterWide: r.ClusterWide,
 			IconName:      iconName,
 			IconSource:    iconSource,
+			Header:        r.ListHeader,
 		},
 	)
 }
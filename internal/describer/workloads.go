@@ -0,0 +1,116 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package describer
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware/octant/internal/objectstatus"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+var (
+	workloadSummaryCols = component.NewTableCols("Kind", "Total", "OK", "Warning", "Error")
+)
+
+// Workloads summarizes the health of workloads in a namespace. It
+// replaces the content of its section with counts and health badges for
+// each summarized resource. It also serves the overview root, which makes
+// it the namespace landing page.
+type Workloads struct {
+	*Section
+
+	resources []*Resource
+}
+
+var _ Describer = (*Workloads)(nil)
+
+// NewWorkloads creates an instance of Workloads. Health is reported for
+// the objects of each summarized resource.
+func NewWorkloads(p, title string, summarized []*Resource, describers ...Describer) *Workloads {
+	return &Workloads{
+		Section:   NewSection(p, title, describers...),
+		resources: summarized,
+	}
+}
+
+// Describe generates a workload summary.
+func (w *Workloads) Describe(ctx context.Context, namespace string, options Options) (component.ContentResponse, error) {
+	table := component.NewTable("Summary", "There are no workloads!", workloadSummaryCols)
+	health := component.NewPodStatus()
+
+	for _, resource := range w.resources {
+		objects, err := options.LoadObjects(ctx, namespace, options.Fields, []store.Key{resource.ObjectStoreKey})
+		if err != nil {
+			return component.EmptyContentResponse, errors.Wrapf(err, "load %s", resource.Titles.List)
+		}
+
+		tally := make(map[component.NodeStatus]int)
+
+		for i := range objects.Items {
+			object := &objects.Items[i]
+
+			status, err := objectstatus.Status(ctx, object, options.ObjectStore())
+			if err != nil {
+				return component.EmptyContentResponse, errors.Wrapf(err, "get status for %s %s",
+					resource.Titles.Object, object.GetName())
+			}
+
+			pluginStatus, err := options.PluginManager().ObjectStatus(ctx, object)
+			if err != nil {
+				return component.EmptyContentResponse, errors.Wrapf(err, "get plugin status for %s %s",
+					resource.Titles.Object, object.GetName())
+			}
+			status.Contribute(pluginStatus.ObjectStatus)
+
+			tally[status.Status()]++
+
+			name := fmt.Sprintf("%s %s", resource.Titles.Object, object.GetName())
+			health.AddSummary(name, status.Details, status.Status())
+		}
+
+		if len(objects.Items) == 0 {
+			continue
+		}
+
+		table.Add(component.TableRow{
+			"Kind":    component.NewLink("", resource.Titles.Object, path.Join("/overview/namespace", namespace, resource.Path)),
+			"Total":   component.NewText(fmt.Sprintf("%d", len(objects.Items))),
+			"OK":      component.NewText(fmt.Sprintf("%d", tally[component.NodeStatusOK])),
+			"Warning": component.NewText(fmt.Sprintf("%d", tally[component.NodeStatusWarning])),
+			"Error":   component.NewText(fmt.Sprintf("%d", tally[component.NodeStatusError])),
+		})
+	}
+
+	cr := component.NewContentResponse(component.Title(component.NewText(w.title)))
+	cr.Add(table)
+
+	if len(health.Config.Pods) > 0 {
+		cr.Add(health)
+	}
+
+	return *cr, nil
+}
+
+// PathFilters returns path filters for the workloads summary and its
+// section.
+func (w *Workloads) PathFilters() []PathFilter {
+	filters := []PathFilter{
+		*NewPathFilter("/", w),
+		*NewPathFilter(w.path, w),
+	}
+
+	for _, child := range w.describers {
+		filters = append(filters, child.PathFilters()...)
+	}
+
+	return filters
+}
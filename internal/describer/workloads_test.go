@@ -0,0 +1,141 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package describer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	configFake "github.com/vmware/octant/internal/config/fake"
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/plugin"
+	pluginFake "github.com/vmware/octant/pkg/plugin/fake"
+	"github.com/vmware/octant/pkg/store"
+	storeFake "github.com/vmware/octant/pkg/store/fake"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func TestWorkloads_Describe(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.Status.Replicas = 1
+	deployment.Status.AvailableReplicas = 1
+	cronJob := testutil.CreateCronJob("cron-job")
+
+	deployments := NewResource(ResourceOptions{
+		Path:           "/workloads/deployments",
+		ObjectStoreKey: store.Key{APIVersion: "apps/v1", Kind: "Deployment"},
+		ListType:       &appsv1.DeploymentList{},
+		ObjectType:     &appsv1.Deployment{},
+		Titles:         ResourceTitle{List: "Workloads / Deployments", Object: "Deployment"},
+	})
+	cronJobs := NewResource(ResourceOptions{
+		Path:           "/workloads/cron-jobs",
+		ObjectStoreKey: store.Key{APIVersion: "batch/v1beta1", Kind: "CronJob"},
+		ListType:       &batchv1beta1.CronJobList{},
+		ObjectType:     &batchv1beta1.CronJob{},
+		Titles:         ResourceTitle{List: "Workloads / Cron Jobs", Object: "Cron Job"},
+	})
+	daemonSets := NewResource(ResourceOptions{
+		Path:           "/workloads/daemon-sets",
+		ObjectStoreKey: store.Key{APIVersion: "apps/v1", Kind: "DaemonSet"},
+		ListType:       &appsv1.DaemonSetList{},
+		ObjectType:     &appsv1.DaemonSet{},
+		Titles:         ResourceTitle{List: "Workloads / Daemon Sets", Object: "Daemon Set"},
+	})
+
+	objects := map[string]*unstructured.UnstructuredList{
+		"Deployment": testutil.ToUnstructuredList(t, deployment),
+		"CronJob":    testutil.ToUnstructuredList(t, cronJob),
+		"DaemonSet":  testutil.ToUnstructuredList(t),
+	}
+
+	pluginManager := pluginFake.NewMockManagerInterface(controller)
+	pluginManager.EXPECT().
+		ObjectStatus(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, object runtime.Object) (*plugin.ObjectStatusResponse, error) {
+			osr := &plugin.ObjectStatusResponse{}
+			if object.GetObjectKind().GroupVersionKind().Kind == "Deployment" {
+				osr.ObjectStatus = component.PodSummary{
+					Details: []component.Component{component.NewText("Deployment is degraded")},
+					Status:  component.NodeStatusWarning,
+				}
+			}
+			return osr, nil
+		}).
+		Times(2)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectStore().Return(storeFake.NewMockStore(controller)).AnyTimes()
+	dashConfig.EXPECT().PluginManager().Return(pluginManager).AnyTimes()
+
+	options := Options{
+		Dash: dashConfig,
+		LoadObjects: func(ctx context.Context, namespace string, fields map[string]string, objectStoreKeys []store.Key) (*unstructured.UnstructuredList, error) {
+			require.Len(t, objectStoreKeys, 1)
+			return objects[objectStoreKeys[0].Kind], nil
+		},
+	}
+
+	d := NewWorkloads("/workloads", "Workloads", []*Resource{deployments, daemonSets, cronJobs})
+
+	ctx := context.Background()
+	got, err := d.Describe(ctx, "default", options)
+	require.NoError(t, err)
+
+	table := component.NewTable("Summary", "There are no workloads!", workloadSummaryCols)
+	table.Add(
+		component.TableRow{
+			"Kind":    component.NewLink("", "Deployment", "/overview/namespace/default/workloads/deployments"),
+			"Total":   component.NewText("1"),
+			"OK":      component.NewText("0"),
+			"Warning": component.NewText("1"),
+			"Error":   component.NewText("0"),
+		},
+		component.TableRow{
+			"Kind":    component.NewLink("", "Cron Job", "/overview/namespace/default/workloads/cron-jobs"),
+			"Total":   component.NewText("1"),
+			"OK":      component.NewText("1"),
+			"Warning": component.NewText("0"),
+			"Error":   component.NewText("0"),
+		},
+	)
+
+	health := component.NewPodStatus()
+	health.AddSummary("Deployment deployment",
+		[]component.Component{component.NewText("Deployment is OK"), component.NewText("Deployment is degraded")},
+		component.NodeStatusWarning)
+	health.AddSummary("Cron Job cron-job",
+		[]component.Component{component.NewText("batch/v1beta1 CronJob is OK")}, component.NodeStatusOK)
+
+	expected := component.NewContentResponse(component.Title(component.NewText("Workloads")))
+	expected.Add(table, health)
+
+	assert.Equal(t, *expected, got)
+}
+
+func TestWorkloads_PathFilters(t *testing.T) {
+	pathMatcher := NewPathMatcher("overview")
+	for _, pf := range NamespacedOverview().PathFilters() {
+		pathMatcher.Register(context.Background(), pf)
+	}
+
+	for _, contentPath := range []string{"/", "/namespace/default", "/namespace/default/workloads"} {
+		pf, err := pathMatcher.Find(contentPath)
+		require.NoError(t, err)
+		assert.IsType(t, &Workloads{}, pf.Describer, contentPath)
+	}
+}
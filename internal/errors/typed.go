@@ -0,0 +1,149 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package errors
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Code identifies the kind of an error, so clients can react to it without
+// parsing its message.
+type Code string
+
+const (
+	// CodeUnknown is the code for errors which are not typed.
+	CodeUnknown Code = "Unknown"
+	// CodeNotFound is the code for objects or paths which do not exist.
+	CodeNotFound Code = "NotFound"
+	// CodeForbidden is the code for requests the user can't make.
+	CodeForbidden Code = "Forbidden"
+	// CodeTimeout is the code for requests which took too long.
+	CodeTimeout Code = "Timeout"
+	// CodeConversion is the code for objects which can't be converted to
+	// their type.
+	CodeConversion Code = "Conversion"
+	// CodePluginFailure is the code for errors returned by plugins.
+	CodePluginFailure Code = "PluginFailure"
+)
+
+// Coder is implemented by errors which have a code.
+type Coder interface {
+	Code() Code
+}
+
+// TypedError is an error with a code.
+type TypedError struct {
+	code    Code
+	message string
+	err     error
+}
+
+var _ Coder = (*TypedError)(nil)
+
+func newTypedError(code Code, err error, format string, args ...interface{}) *TypedError {
+	return &TypedError{
+		code:    code,
+		message: fmt.Sprintf(format, args...),
+		err:     err,
+	}
+}
+
+// NewNotFound creates a not found error.
+func NewNotFound(format string, args ...interface{}) *TypedError {
+	return newTypedError(CodeNotFound, nil, format, args...)
+}
+
+// NewForbidden creates a forbidden error.
+func NewForbidden(format string, args ...interface{}) *TypedError {
+	return newTypedError(CodeForbidden, nil, format, args...)
+}
+
+// NewTimeout creates a timeout error.
+func NewTimeout(format string, args ...interface{}) *TypedError {
+	return newTypedError(CodeTimeout, nil, format, args...)
+}
+
+// WrapConversion wraps an error returned while converting an object.
+func WrapConversion(err error, format string, args ...interface{}) *TypedError {
+	return newTypedError(CodeConversion, err, format, args...)
+}
+
+// WrapPluginFailure wraps an error returned by a plugin.
+func WrapPluginFailure(err error, format string, args ...interface{}) *TypedError {
+	return newTypedError(CodePluginFailure, err, format, args...)
+}
+
+// Code returns the error's code.
+func (e *TypedError) Code() Code {
+	return e.code
+}
+
+// Cause returns the wrapped error, or nil if the error does not wrap one.
+func (e *TypedError) Cause() error {
+	return e.err
+}
+
+// Error returns the error string.
+func (e *TypedError) Error() string {
+	if e.err == nil {
+		return e.message
+	}
+	return fmt.Sprintf("%s: %s", e.message, e.err)
+}
+
+type causer interface {
+	Cause() error
+}
+
+// CodeOf returns the code of an error. It looks through errors wrapped
+// with github.com/pkg/errors, and recognizes Kubernetes API errors and
+// context deadlines. Errors without a code are CodeUnknown.
+func CodeOf(err error) Code {
+	for err != nil {
+		if coder, ok := err.(Coder); ok {
+			return coder.Code()
+		}
+
+		switch {
+		case err == context.DeadlineExceeded,
+			kerrors.IsTimeout(err),
+			kerrors.IsServerTimeout(err):
+			return CodeTimeout
+		case kerrors.IsNotFound(err):
+			return CodeNotFound
+		case kerrors.IsForbidden(err):
+			return CodeForbidden
+		}
+
+		c, ok := err.(causer)
+		if !ok {
+			break
+		}
+		err = c.Cause()
+	}
+
+	return CodeUnknown
+}
+
+// HTTPStatus returns the HTTP status code for an error code.
+func HTTPStatus(code Code) int {
+	switch code {
+	case CodeNotFound:
+		return http.StatusNotFound
+	case CodeForbidden:
+		return http.StatusForbidden
+	case CodeTimeout:
+		return http.StatusGatewayTimeout
+	case CodePluginFailure:
+		return http.StatusBadGateway
+	default:
+		return http.StatusInternalServerError
+	}
+}
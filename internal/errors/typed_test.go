@@ -0,0 +1,71 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package errors
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+type coded struct{}
+
+func (coded) Error() string { return "coded" }
+func (coded) Code() Code    { return CodeForbidden }
+
+func TestCodeOf(t *testing.T) {
+	pods := schema.GroupResource{Resource: "pods"}
+
+	cases := []struct {
+		name     string
+		err      error
+		expected Code
+	}{
+		{name: "nil", err: nil, expected: CodeUnknown},
+		{name: "untyped", err: fmt.Errorf("untyped"), expected: CodeUnknown},
+		{name: "typed", err: NewNotFound("pod %s", "web"), expected: CodeNotFound},
+		{name: "wrapped", err: errors.Wrap(NewTimeout("list pods"), "generate content"), expected: CodeTimeout},
+		{
+			name:     "typed wrapping another code",
+			err:      WrapPluginFailure(kerrors.NewNotFound(pods, "web"), "print"),
+			expected: CodePluginFailure,
+		},
+		{name: "coder", err: errors.Wrap(coded{}, "load"), expected: CodeForbidden},
+		{name: "kubernetes not found", err: kerrors.NewNotFound(pods, "web"), expected: CodeNotFound},
+		{name: "kubernetes forbidden", err: kerrors.NewForbidden(pods, "web", fmt.Errorf("denied")), expected: CodeForbidden},
+		{name: "kubernetes timeout", err: kerrors.NewTimeoutError("slow", 1), expected: CodeTimeout},
+		{name: "context deadline", err: errors.Wrap(context.DeadlineExceeded, "list"), expected: CodeTimeout},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, CodeOf(tc.err))
+		})
+	}
+}
+
+func TestTypedError_Error(t *testing.T) {
+	assert.Equal(t, "pod web", NewNotFound("pod %s", "web").Error())
+
+	err := WrapConversion(fmt.Errorf("bad field"), "convert %s", "pod")
+	assert.Equal(t, "convert pod: bad field", err.Error())
+	assert.Equal(t, CodeConversion, err.Code())
+}
+
+func TestHTTPStatus(t *testing.T) {
+	assert.Equal(t, http.StatusNotFound, HTTPStatus(CodeNotFound))
+	assert.Equal(t, http.StatusForbidden, HTTPStatus(CodeForbidden))
+	assert.Equal(t, http.StatusGatewayTimeout, HTTPStatus(CodeTimeout))
+	assert.Equal(t, http.StatusBadGateway, HTTPStatus(CodePluginFailure))
+	assert.Equal(t, http.StatusInternalServerError, HTTPStatus(CodeConversion))
+	assert.Equal(t, http.StatusInternalServerError, HTTPStatus(CodeUnknown))
+}
@@ -0,0 +1,102 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package eventfilter hides noisy events from event tables.
+package eventfilter
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Preset is a named filter which hides a class of events.
+type Preset string
+
+const (
+	// PresetHideNormal hides events with the Normal type.
+	PresetHideNormal Preset = "hide-normal"
+	// PresetHideImagePull hides image pull progress events.
+	PresetHideImagePull Preset = "hide-image-pull"
+	// PresetHideProbes hides liveness and readiness probe events.
+	PresetHideProbes Preset = "hide-probes"
+)
+
+var presetMatchers = map[Preset]func(event corev1.Event) bool{
+	PresetHideNormal: func(event corev1.Event) bool {
+		return event.Type == corev1.EventTypeNormal
+	},
+	PresetHideImagePull: func(event corev1.Event) bool {
+		return event.Reason == "Pulling" || event.Reason == "Pulled"
+	},
+	PresetHideProbes: func(event corev1.Event) bool {
+		return event.Reason == "Unhealthy" || event.Reason == "ProbeWarning"
+	},
+}
+
+// Presets is a list of presets.
+type Presets []Preset
+
+// ParsePresets parses a comma separated list of presets.
+func ParsePresets(s string) (Presets, error) {
+	var presets Presets
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		preset := Preset(name)
+		if _, ok := presetMatchers[preset]; !ok {
+			return nil, errors.Errorf("unknown event filter preset %q", name)
+		}
+		presets = append(presets, preset)
+	}
+
+	return presets, nil
+}
+
+// Hides returns true if any of the presets hide an event.
+func (p Presets) Hides(event corev1.Event) bool {
+	for _, preset := range p {
+		if fn, ok := presetMatchers[preset]; ok && fn(event) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Filter returns the events which are not hidden by the presets.
+func (p Presets) Filter(events []corev1.Event) []corev1.Event {
+	if len(p) == 0 {
+		return events
+	}
+
+	var filtered []corev1.Event
+	for _, event := range events {
+		if !p.Hides(event) {
+			filtered = append(filtered, event)
+		}
+	}
+
+	return filtered
+}
+
+type contextKey struct{}
+
+// WithPresets returns a context with presets which are applied to event
+// tables printed with it.
+func WithPresets(ctx context.Context, presets Presets) context.Context {
+	return context.WithValue(ctx, contextKey{}, presets)
+}
+
+// From returns the presets for a context.
+func From(ctx context.Context) Presets {
+	presets, _ := ctx.Value(contextKey{}).(Presets)
+	return presets
+}
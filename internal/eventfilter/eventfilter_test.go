@@ -0,0 +1,99 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package eventfilter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestParsePresets(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       string
+		expected Presets
+		isErr    bool
+	}{
+		{name: "empty", in: ""},
+		{
+			name:     "multiple",
+			in:       "hide-normal, hide-probes,",
+			expected: Presets{PresetHideNormal, PresetHideProbes},
+		},
+		{name: "unknown", in: "hide-everything", isErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParsePresets(test.in)
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func TestPresets_Filter(t *testing.T) {
+	normal := corev1.Event{Type: corev1.EventTypeNormal, Reason: "Scheduled"}
+	pulling := corev1.Event{Type: corev1.EventTypeNormal, Reason: "Pulling"}
+	pulled := corev1.Event{Type: corev1.EventTypeNormal, Reason: "Pulled"}
+	unhealthy := corev1.Event{Type: corev1.EventTypeWarning, Reason: "Unhealthy"}
+	backOff := corev1.Event{Type: corev1.EventTypeWarning, Reason: "BackOff"}
+
+	events := []corev1.Event{normal, pulling, pulled, unhealthy, backOff}
+
+	tests := []struct {
+		name     string
+		presets  Presets
+		expected []corev1.Event
+	}{
+		{
+			name:     "no presets",
+			expected: events,
+		},
+		{
+			name:     "hide normal",
+			presets:  Presets{PresetHideNormal},
+			expected: []corev1.Event{unhealthy, backOff},
+		},
+		{
+			name:     "hide image pull",
+			presets:  Presets{PresetHideImagePull},
+			expected: []corev1.Event{normal, unhealthy, backOff},
+		},
+		{
+			name:     "hide probes",
+			presets:  Presets{PresetHideProbes},
+			expected: []corev1.Event{normal, pulling, pulled, backOff},
+		},
+		{
+			name:     "combined",
+			presets:  Presets{PresetHideNormal, PresetHideProbes},
+			expected: []corev1.Event{backOff},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.presets.Filter(events))
+		})
+	}
+}
+
+func TestFrom(t *testing.T) {
+	ctx := context.Background()
+	assert.Nil(t, From(ctx))
+
+	presets := Presets{PresetHideNormal}
+	assert.Equal(t, presets, From(WithPresets(ctx, presets)))
+}
@@ -0,0 +1,166 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package exec
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// CopyOptions are options for copying a file to or from a container.
+type CopyOptions struct {
+	Namespace string
+	Pod       string
+	Container string
+	// Path is the absolute path of the file in the container.
+	Path string
+}
+
+// Validate validates the options.
+func (o CopyOptions) Validate() error {
+	if o.Namespace == "" || o.Pod == "" || o.Container == "" {
+		return errors.New("namespace, pod, and container are required")
+	}
+
+	if !path.IsAbs(o.Path) || path.Clean(o.Path) == "/" {
+		return errors.Errorf("path %q is not an absolute path to a file", o.Path)
+	}
+
+	return nil
+}
+
+// CopyFromContainer writes the contents of a file in a container to w. Like
+// kubectl cp, the file is archived with tar in the container, so tar has to
+// be installed in the container's image.
+func CopyFromContainer(ctx context.Context, executor Interface, options CopyOptions, w io.Writer) error {
+	if err := options.Validate(); err != nil {
+		return err
+	}
+
+	filePath := path.Clean(options.Path)
+	reader, writer := io.Pipe()
+	var stderr bytes.Buffer
+
+	errCh := make(chan error, 1)
+	go func() {
+		err := executor.Stream(ctx, Options{
+			Namespace: options.Namespace,
+			Pod:       options.Pod,
+			Container: options.Container,
+			Command:   []string{"tar", "cf", "-", "-C", path.Dir(filePath), path.Base(filePath)},
+			Stdout:    writer,
+			Stderr:    &stderr,
+		})
+		_ = writer.CloseWithError(err)
+		errCh <- err
+	}()
+
+	copyErr := copyFileFromTar(reader, w, filePath)
+
+	// Drain the archive so the command can exit.
+	_, _ = io.Copy(ioutil.Discard, reader)
+
+	if err := <-errCh; err != nil {
+		return commandError(err, &stderr)
+	}
+
+	return copyErr
+}
+
+func copyFileFromTar(r io.Reader, w io.Writer, filePath string) error {
+	tr := tar.NewReader(r)
+
+	header, err := tr.Next()
+	if err != nil {
+		if err == io.EOF {
+			return errors.Errorf("%s does not exist", filePath)
+		}
+		return errors.Wrap(err, "read archive")
+	}
+
+	if header.Typeflag != tar.TypeReg {
+		return errors.Errorf("%s is not a regular file", filePath)
+	}
+
+	if _, err := io.Copy(w, tr); err != nil {
+		return errors.Wrapf(err, "copy %s", filePath)
+	}
+
+	return nil
+}
+
+// CopyToContainer writes size bytes read from r to a file in a container.
+// The file's directory has to exist. Like kubectl cp, the file is extracted
+// with tar in the container, so tar has to be installed in the container's
+// image.
+func CopyToContainer(ctx context.Context, executor Interface, options CopyOptions, r io.Reader, size int64) error {
+	if err := options.Validate(); err != nil {
+		return err
+	}
+
+	filePath := path.Clean(options.Path)
+	reader, writer := io.Pipe()
+	var stdout, stderr bytes.Buffer
+
+	go func() {
+		_ = writer.CloseWithError(writeFileToTar(writer, path.Base(filePath), r, size))
+	}()
+
+	err := executor.Stream(ctx, Options{
+		Namespace: options.Namespace,
+		Pod:       options.Pod,
+		Container: options.Container,
+		Command:   []string{"tar", "xf", "-", "-C", path.Dir(filePath)},
+		Stdin:     reader,
+		Stdout:    &stdout,
+		Stderr:    &stderr,
+	})
+	// Unblock the archive writer if the command exits early.
+	_ = reader.Close()
+
+	if err != nil {
+		return commandError(err, &stderr)
+	}
+
+	return nil
+}
+
+func writeFileToTar(w io.Writer, name string, r io.Reader, size int64) error {
+	tw := tar.NewWriter(w)
+
+	header := &tar.Header{
+		Name:     name,
+		Mode:     0644,
+		Size:     size,
+		Typeflag: tar.TypeReg,
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return errors.Wrap(err, "write archive header")
+	}
+
+	if _, err := io.CopyN(tw, r, size); err != nil {
+		return errors.Wrap(err, "write archive")
+	}
+
+	return tw.Close()
+}
+
+// commandError adds what a command wrote to stderr to the error it exited
+// with.
+func commandError(err error, stderr *bytes.Buffer) error {
+	if message := strings.TrimSpace(stderr.String()); message != "" {
+		return errors.Errorf("%s: %s", err, message)
+	}
+
+	return err
+}
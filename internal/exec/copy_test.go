@@ -0,0 +1,135 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package exec
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tarExecutor runs tar commands against an in memory file system.
+type tarExecutor struct {
+	files map[string]string
+}
+
+func (e *tarExecutor) Stream(ctx context.Context, options Options) error {
+	command := options.Command
+	if len(command) < 5 || command[0] != "tar" || command[2] != "-" || command[3] != "-C" {
+		return errors.Errorf("unexpected command %v", command)
+	}
+	dir := command[4]
+
+	switch command[1] {
+	case "cf":
+		if len(command) != 6 {
+			return errors.Errorf("unexpected command %v", command)
+		}
+		return e.archive(options, path.Join(dir, command[5]))
+	case "xf":
+		return e.extract(options, dir)
+	default:
+		return errors.Errorf("unexpected command %v", command)
+	}
+}
+
+func (e *tarExecutor) archive(options Options, name string) error {
+	content, ok := e.files[name]
+	if !ok {
+		_, _ = fmt.Fprintf(options.Stderr, "tar: %s: No such file or directory\n", path.Base(name))
+		return &ExitError{Code: 2}
+	}
+
+	tw := tar.NewWriter(options.Stdout)
+	header := &tar.Header{Name: path.Base(name), Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(tw, content); err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+func (e *tarExecutor) extract(options Options, dir string) error {
+	tr := tar.NewReader(options.Stdin)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return err
+		}
+		e.files[path.Join(dir, header.Name)] = string(data)
+	}
+}
+
+func TestCopyFromContainer(t *testing.T) {
+	executor := &tarExecutor{files: map[string]string{"/etc/app/config.yaml": "key: value\n"}}
+
+	options := CopyOptions{Namespace: "default", Pod: "pod", Container: "app", Path: "/etc/app/config.yaml"}
+
+	var buf bytes.Buffer
+	require.NoError(t, CopyFromContainer(context.Background(), executor, options, &buf))
+	assert.Equal(t, "key: value\n", buf.String())
+
+	options.Path = "/etc/app/missing.yaml"
+	err := CopyFromContainer(context.Background(), executor, options, &buf)
+	require.Error(t, err)
+	assert.Equal(t, "command terminated with exit code 2: tar: missing.yaml: No such file or directory", err.Error())
+}
+
+func TestCopyToContainer(t *testing.T) {
+	executor := &tarExecutor{files: map[string]string{}}
+
+	options := CopyOptions{Namespace: "default", Pod: "pod", Container: "app", Path: "/tmp/upload.txt"}
+
+	content := "uploaded"
+	err := CopyToContainer(context.Background(), executor, options, strings.NewReader(content), int64(len(content)))
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"/tmp/upload.txt": "uploaded"}, executor.files)
+}
+
+func TestCopyOptions_Validate(t *testing.T) {
+	cases := []struct {
+		name  string
+		path  string
+		isErr bool
+	}{
+		{name: "absolute path", path: "/etc/config"},
+		{name: "relative path", path: "etc/config", isErr: true},
+		{name: "root", path: "/", isErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			options := CopyOptions{Namespace: "default", Pod: "pod", Container: "app", Path: tc.path}
+			err := options.Validate()
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package exec
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/transport/spdy"
+
+	"github.com/vmware/octant/internal/cluster"
+)
+
+//go:generate mockgen -destination=./fake/mock_interface.go -package=fake github.com/vmware/octant/internal/exec Interface
+
+// TerminalSize is the size of a terminal in characters.
+type TerminalSize struct {
+	Width  uint16
+	Height uint16
+}
+
+// Options are options for running a command in a container.
+type Options struct {
+	Namespace string
+	Pod       string
+	Container string
+	Command   []string
+	// TTY allocates a terminal for the command. The command's stderr is
+	// sent to Stdout.
+	TTY bool
+	// Stdin is sent to the command. The command has no stdin if it is nil.
+	Stdin io.Reader
+	// Stdout receives the command's stdout.
+	Stdout io.Writer
+	// Stderr receives the command's stderr. It is ignored if TTY is true.
+	Stderr io.Writer
+	// Resize receives changes to the size of the terminal.
+	Resize <-chan TerminalSize
+}
+
+// Interface runs commands in containers.
+type Interface interface {
+	// Stream runs a command in a container and streams its input and output
+	// until the command exits or the context is done.
+	Stream(ctx context.Context, options Options) error
+}
+
+// ClusterClientGetter returns the current cluster client.
+type ClusterClientGetter interface {
+	ClusterClient() cluster.ClientInterface
+}
+
+// Executor runs commands in containers with the Kubernetes exec API.
+type Executor struct {
+	clusterClientGetter ClusterClientGetter
+}
+
+var _ Interface = (*Executor)(nil)
+
+// NewExecutor creates an instance of Executor. The cluster client is looked
+// up for each command, so the executor follows context changes.
+func NewExecutor(clusterClientGetter ClusterClientGetter) *Executor {
+	return &Executor{
+		clusterClientGetter: clusterClientGetter,
+	}
+}
+
+// Stream runs a command in a container and streams its input and output
+// until the command exits or the context is done.
+func (e *Executor) Stream(ctx context.Context, options Options) error {
+	if len(options.Command) == 0 {
+		return errors.New("command is blank")
+	}
+
+	clusterClient := e.clusterClientGetter.ClusterClient()
+	if clusterClient == nil {
+		return errors.New("cluster client is not available")
+	}
+
+	kubeClient, err := clusterClient.KubernetesClient()
+	if err != nil {
+		return errors.Wrap(err, "get kubernetes client")
+	}
+
+	req := kubeClient.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(options.Namespace).
+		Name(options.Pod).
+		SubResource("exec").
+		VersionedParams(podExecOptions(options), scheme.ParameterCodec)
+
+	transport, upgrader, err := spdy.RoundTripperFor(clusterClient.RESTConfig())
+	if err != nil {
+		return errors.Wrap(err, "create exec transport")
+	}
+
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, req.URL())
+	conn, protocol, err := dialer.Dial(StreamProtocolV4)
+	if err != nil {
+		return errors.Wrapf(err, "exec in %s/%s", options.Pod, options.Container)
+	}
+	defer conn.Close()
+
+	if protocol != StreamProtocolV4 {
+		return errors.Errorf("exec protocol %q is not supported", protocol)
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			// Closing the connection ends the streams.
+			_ = conn.Close()
+		case <-done:
+		}
+	}()
+
+	return stream(conn, options)
+}
+
+func podExecOptions(options Options) *corev1.PodExecOptions {
+	return &corev1.PodExecOptions{
+		Container: options.Container,
+		Command:   options.Command,
+		Stdin:     options.Stdin != nil,
+		Stdout:    options.Stdout != nil,
+		Stderr:    options.Stderr != nil && !options.TTY,
+		TTY:       options.TTY,
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package exec
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+)
+
+const (
+	// StreamProtocolV4 is the version of the exec streaming protocol
+	// Executor speaks. Errors are reported as a metav1.Status and
+	// terminals can be resized.
+	StreamProtocolV4 = "v4.channel.k8s.io"
+)
+
+// ExitError is returned when a command exits with a non zero code.
+type ExitError struct {
+	Code int
+}
+
+// Error returns the error string.
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("command terminated with exit code %d", e.Code)
+}
+
+// stream streams a command's input and output over the connection's
+// streams and returns how the command exited.
+func stream(conn httpstream.Connection, options Options) error {
+	errorStream, err := createStream(conn, corev1.StreamTypeError)
+	if err != nil {
+		return err
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		data, err := ioutil.ReadAll(errorStream)
+		if err != nil {
+			errCh <- errors.Wrap(err, "read error stream")
+			return
+		}
+		errCh <- decodeStatus(data)
+	}()
+
+	if options.TTY && options.Resize != nil {
+		resizeStream, err := createStream(conn, corev1.StreamTypeResize)
+		if err != nil {
+			return err
+		}
+
+		go func() {
+			encoder := json.NewEncoder(resizeStream)
+			for size := range options.Resize {
+				if err := encoder.Encode(&size); err != nil {
+					return
+				}
+			}
+		}()
+	}
+
+	if options.Stdin != nil {
+		stdinStream, err := createStream(conn, corev1.StreamTypeStdin)
+		if err != nil {
+			return err
+		}
+
+		go func() {
+			_, _ = io.Copy(stdinStream, options.Stdin)
+			// The command sees EOF once stdin is closed.
+			_ = stdinStream.Close()
+		}()
+	}
+
+	var wg sync.WaitGroup
+
+	copyOutput := func(streamType string, w io.Writer) error {
+		s, err := createStream(conn, streamType)
+		if err != nil {
+			return err
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = io.Copy(w, s)
+		}()
+
+		return nil
+	}
+
+	if options.Stdout != nil {
+		if err := copyOutput(corev1.StreamTypeStdout, options.Stdout); err != nil {
+			return err
+		}
+	}
+
+	if options.Stderr != nil && !options.TTY {
+		if err := copyOutput(corev1.StreamTypeStderr, options.Stderr); err != nil {
+			return err
+		}
+	}
+
+	wg.Wait()
+
+	return <-errCh
+}
+
+func createStream(conn httpstream.Connection, streamType string) (httpstream.Stream, error) {
+	headers := http.Header{}
+	headers.Set(corev1.StreamType, streamType)
+
+	s, err := conn.CreateStream(headers)
+	if err != nil {
+		return nil, errors.Wrapf(err, "create %s stream", streamType)
+	}
+
+	return s, nil
+}
+
+// decodeStatus decodes how a command exited from the error stream. An
+// empty stream means the command succeeded.
+func decodeStatus(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	var status metav1.Status
+	if err := json.Unmarshal(data, &status); err != nil {
+		return errors.Errorf("error stream returned %q", string(data))
+	}
+
+	if status.Status == metav1.StatusSuccess {
+		return nil
+	}
+
+	if status.Reason == "NonZeroExitCode" && status.Details != nil {
+		for _, cause := range status.Details.Causes {
+			if cause.Type != "ExitCode" {
+				continue
+			}
+
+			var code int
+			if _, err := fmt.Sscanf(cause.Message, "%d", &code); err == nil {
+				return &ExitError{Code: code}
+			}
+		}
+	}
+
+	return errors.New(status.Message)
+}
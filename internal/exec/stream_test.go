@@ -0,0 +1,82 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package exec
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func Test_decodeStatus(t *testing.T) {
+	cases := []struct {
+		name     string
+		data     string
+		expected error
+		errText  string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "success",
+			data: `{"status": "Success"}`,
+		},
+		{
+			name:     "exit code",
+			data:     `{"status": "Failure", "reason": "NonZeroExitCode", "details": {"causes": [{"reason": "ExitCode", "message": "2"}]}}`,
+			expected: &ExitError{Code: 2},
+		},
+		{
+			name:    "failure",
+			data:    `{"status": "Failure", "message": "container not found"}`,
+			errText: "container not found",
+		},
+		{
+			name:    "not a status",
+			data:    `oops`,
+			errText: `error stream returned "oops"`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := decodeStatus([]byte(tc.data))
+			switch {
+			case tc.expected != nil:
+				assert.Equal(t, tc.expected, err)
+			case tc.errText != "":
+				require.Error(t, err)
+				assert.Equal(t, tc.errText, err.Error())
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_podExecOptions(t *testing.T) {
+	options := Options{
+		Container: "app",
+		Command:   []string{"/bin/sh"},
+		TTY:       true,
+		Stdin:     strings.NewReader(""),
+		Stdout:    &strings.Builder{},
+		Stderr:    &strings.Builder{},
+	}
+
+	expected := &corev1.PodExecOptions{
+		Container: "app",
+		Command:   []string{"/bin/sh"},
+		Stdin:     true,
+		Stdout:    true,
+		TTY:       true,
+	}
+	assert.Equal(t, expected, podExecOptions(options))
+}
@@ -0,0 +1,90 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package export formats components, e.g. summaries and tables, so they
+// can be pasted into documents and chat.
+package export
+
+import (
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// Formatter formats a component.
+type Formatter interface {
+	// ContentType is the content type of formatted components.
+	ContentType() string
+	// Format writes a formatted component. It returns an error if the
+	// component type is not supported.
+	Format(w io.Writer, c component.Component) error
+}
+
+// Registry is a set of formatters by format name.
+type Registry struct {
+	formatters map[string]Formatter
+	mu         sync.RWMutex
+}
+
+// NewRegistry creates an instance of Registry with no formatters.
+func NewRegistry() *Registry {
+	return &Registry{
+		formatters: make(map[string]Formatter),
+	}
+}
+
+// DefaultRegistry creates an instance of Registry with the built in
+// formatters.
+func DefaultRegistry() *Registry {
+	r := NewRegistry()
+	r.formatters["markdown"] = &Markdown{}
+	r.formatters["csv"] = NewCSV()
+	r.formatters["json"] = NewJSON()
+	return r
+}
+
+// Register registers a formatter for a format.
+func (r *Registry) Register(format string, formatter Formatter) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if format == "" {
+		return errors.New("format name is blank")
+	}
+
+	if _, ok := r.formatters[format]; ok {
+		return errors.Errorf("format %q is already registered", format)
+	}
+
+	r.formatters[format] = formatter
+	return nil
+}
+
+// Get returns the formatter for a format.
+func (r *Registry) Get(format string) (Formatter, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	formatter, ok := r.formatters[format]
+	return formatter, ok
+}
+
+// Formats returns the registered format names.
+func (r *Registry) Formats() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var formats []string
+	for format := range r.formatters {
+		formats = append(formats, format)
+	}
+	sort.Strings(formats)
+
+	return formats
+}
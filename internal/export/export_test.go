@@ -0,0 +1,38 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package export
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+type fakeFormatter struct{}
+
+func (f *fakeFormatter) ContentType() string { return "text/plain" }
+
+func (f *fakeFormatter) Format(w io.Writer, c component.Component) error { return nil }
+
+func TestRegistry(t *testing.T) {
+	r := DefaultRegistry()
+
+	_, ok := r.Get("markdown")
+	assert.True(t, ok)
+
+	require.NoError(t, r.Register("text", &fakeFormatter{}))
+	require.Error(t, r.Register("text", &fakeFormatter{}))
+	require.Error(t, r.Register("", &fakeFormatter{}))
+
+	assert.Equal(t, []string{"csv", "json", "markdown", "text"}, r.Formats())
+
+	_, ok = r.Get("missing")
+	assert.False(t, ok)
+}
@@ -0,0 +1,96 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package export
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// Markdown formats summaries and tables as Markdown tables.
+type Markdown struct{}
+
+var _ Formatter = (*Markdown)(nil)
+
+// ContentType returns the Markdown content type.
+func (m *Markdown) ContentType() string {
+	return "text/markdown; charset=utf-8"
+}
+
+// Format writes a summary or table as Markdown.
+func (m *Markdown) Format(w io.Writer, c component.Component) error {
+	var sb strings.Builder
+
+	if title := componentTitle(c); title != "" {
+		fmt.Fprintf(&sb, "### %s\n\n", title)
+	}
+
+	switch t := c.(type) {
+	case *component.Summary:
+		writeMarkdownRow(&sb, "Field", "Value")
+		writeMarkdownRow(&sb, "---", "---")
+		for _, section := range t.Config.Sections {
+			writeMarkdownRow(&sb, section.Header, component.PlainText(section.Content))
+		}
+	case *component.Table:
+		if len(t.Config.Rows) == 0 {
+			fmt.Fprintf(&sb, "_%s_\n", markdownEscape(t.Config.EmptyContent))
+			break
+		}
+
+		var headers, separators []string
+		for _, col := range t.Config.Columns {
+			headers = append(headers, col.Name)
+			separators = append(separators, "---")
+		}
+		writeMarkdownRow(&sb, headers...)
+		writeMarkdownRow(&sb, separators...)
+
+		for _, row := range t.Config.Rows {
+			var cells []string
+			for _, col := range t.Config.Columns {
+				cells = append(cells, component.PlainText(row[col.Accessor]))
+			}
+			writeMarkdownRow(&sb, cells...)
+		}
+	default:
+		return errors.Errorf("unable to format %s as markdown", c.GetMetadata().Type)
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func componentTitle(c component.Component) string {
+	var parts []string
+	for _, title := range c.GetMetadata().Title {
+		parts = append(parts, title.String())
+	}
+
+	return strings.Join(parts, " / ")
+}
+
+func writeMarkdownRow(sb *strings.Builder, cells ...string) {
+	for i := range cells {
+		cells[i] = markdownEscape(cells[i])
+	}
+	fmt.Fprintf(sb, "| %s |\n", strings.Join(cells, " | "))
+}
+
+var markdownReplacer = strings.NewReplacer(
+	"|", `\|`,
+	"\r\n", "<br>",
+	"\n", "<br>",
+)
+
+func markdownEscape(s string) string {
+	return markdownReplacer.Replace(s)
+}
@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package export
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func TestMarkdown_Format(t *testing.T) {
+	table := component.NewTable("Pods", "There are no pods!", component.NewTableCols("Name", "Status"))
+	table.Add(component.TableRow{
+		"Name":   component.NewLink("", "pod-1", "/pod-1"),
+		"Status": component.NewText("Running"),
+	})
+
+	cases := []struct {
+		name     string
+		c        component.Component
+		expected string
+		isErr    bool
+	}{
+		{
+			name: "summary",
+			c: component.NewSummary("Configuration",
+				component.SummarySection{Header: "Replicas", Content: component.NewText("3")},
+				component.SummarySection{Header: "Command", Content: component.NewText("a | b\nc")},
+			),
+			expected: `### Configuration
+
+| Field | Value |
+| --- | --- |
+| Replicas | 3 |
+| Command | a \| b<br>c |
+`,
+		},
+		{
+			name: "table",
+			c:    table,
+			expected: `### Pods
+
+| Name | Status |
+| --- | --- |
+| pod-1 | Running |
+`,
+		},
+		{
+			name: "empty table",
+			c:    component.NewTable("Pods", "There are no pods!", component.NewTableCols("Name")),
+			expected: `### Pods
+
+_There are no pods!_
+`,
+		},
+		{
+			name:  "unsupported",
+			c:     component.NewText("text"),
+			isErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var sb strings.Builder
+			m := &Markdown{}
+			err := m.Format(&sb, tc.c)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, sb.String())
+		})
+	}
+}
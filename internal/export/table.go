@@ -0,0 +1,54 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package export
+
+import (
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// Table formats tables as rows of plain text, e.g. CSV.
+type Table struct {
+	format      component.TableExportFormat
+	contentType string
+}
+
+var _ Formatter = (*Table)(nil)
+
+// NewCSV creates an instance of Table which formats tables as CSV.
+func NewCSV() *Table {
+	return &Table{
+		format:      component.TableExportCSV,
+		contentType: "text/csv; charset=utf-8",
+	}
+}
+
+// NewJSON creates an instance of Table which formats tables as a JSON array
+// of objects keyed by column name.
+func NewJSON() *Table {
+	return &Table{
+		format:      component.TableExportJSON,
+		contentType: "application/json",
+	}
+}
+
+// ContentType returns the content type of the table format.
+func (t *Table) ContentType() string {
+	return t.contentType
+}
+
+// Format writes a table in the table format.
+func (t *Table) Format(w io.Writer, c component.Component) error {
+	table, ok := c.(*component.Table)
+	if !ok {
+		return errors.Errorf("unable to format %s as %s", c.GetMetadata().Type, t.format)
+	}
+
+	return table.Export(w, t.format)
+}
@@ -0,0 +1,65 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package export
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func TestTable_Format(t *testing.T) {
+	table := component.NewTable("Pods", "There are no pods!", component.NewTableCols("Name", "Status"))
+	table.Add(component.TableRow{
+		"Name":   component.NewLink("", "pod-1", "/pod-1"),
+		"Status": component.NewText("Running"),
+	})
+
+	cases := []struct {
+		name      string
+		formatter *Table
+		c         component.Component
+		expected  string
+		isErr     bool
+	}{
+		{
+			name:      "csv",
+			formatter: NewCSV(),
+			c:         table,
+			expected:  "Name,Status\npod-1,Running\n",
+		},
+		{
+			name:      "json",
+			formatter: NewJSON(),
+			c:         table,
+			expected:  `[{"Name":"pod-1","Status":"Running"}]` + "\n",
+		},
+		{
+			name:      "not a table",
+			formatter: NewCSV(),
+			c:         component.NewText("text"),
+			isErr:     true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var sb strings.Builder
+			err := tc.formatter.Format(&sb, tc.c)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, sb.String())
+		})
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/vmware/octant/internal/config"
 	"github.com/vmware/octant/internal/describer"
 	"github.com/vmware/octant/internal/link"
+	"github.com/vmware/octant/internal/metrics"
 	"github.com/vmware/octant/internal/printer"
 	"github.com/vmware/octant/internal/queryer"
 	"github.com/vmware/octant/pkg/view/component"
@@ -38,20 +39,25 @@ var _ Interface = (*Generator)(nil)
 // Options are additional options to pass a Generator
 type Options struct {
 	LabelSet *kLabels.Set
+	Page     component.TablePage
+	Query    component.TableQuery
 }
 
-// NewGenerator creates a Generator.
-func NewGenerator(pm *describer.PathMatcher, dashConfig config.Dash) (*Generator, error) {
-	p := printer.NewResource(dashConfig)
-
-	if err := printer.AddHandlers(p); err != nil {
-		return nil, errors.Wrap(err, "add print handlers")
-	}
-
+// NewGenerator creates a Generator. Objects are printed with the handlers in
+// printRegistry.
+func NewGenerator(pm *describer.PathMatcher, dashConfig config.Dash, printRegistry *printer.Registry) (*Generator, error) {
 	if pm == nil {
 		return nil, errors.New("path matcher is nil")
 	}
 
+	if printRegistry == nil {
+		return nil, errors.New("print registry is nil")
+	}
+
+	p := printer.NewResource(dashConfig,
+		printer.WithRegistry(printRegistry),
+		printer.WithMetrics(metrics.NewClient(dashConfig)))
+
 	return &Generator{
 		pathMatcher: pm,
 		printer:     p,
@@ -97,6 +103,8 @@ func (g *Generator) Generate(ctx context.Context, contentPath string, opts Optio
 		Fields:   fields,
 		Printer:  g.printer,
 		LabelSet: opts.LabelSet,
+		Page:     opts.Page,
+		Query:    opts.Query,
 		Dash:     g.dashConfig,
 		Link:     linkGenerator,
 
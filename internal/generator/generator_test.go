@@ -17,6 +17,7 @@ import (
 	clusterFake "github.com/vmware/octant/internal/cluster/fake"
 	configFake "github.com/vmware/octant/internal/config/fake"
 	"github.com/vmware/octant/internal/describer"
+	"github.com/vmware/octant/internal/printer"
 	objectStoreFake "github.com/vmware/octant/pkg/store/fake"
 	"github.com/vmware/octant/pkg/view/component"
 )
@@ -84,7 +85,10 @@ func Test_realGenerator_Generate(t *testing.T) {
 				pathMatcher.Register(ctx, pf)
 			}
 
-			g, err := NewGenerator(pathMatcher, dashConfig)
+			printRegistry, err := printer.NewDefaultRegistry()
+			require.NoError(t, err)
+
+			g, err := NewGenerator(pathMatcher, dashConfig, printRegistry)
 			require.NoError(t, err)
 
 			cResponse, err := g.Generate(ctx, tc.path, Options{})
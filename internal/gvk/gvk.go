@@ -21,16 +21,20 @@ var (
 	ExtDeployment            = schema.GroupVersionKind{Group: "extensions", Version: "v1beta1", Kind: "Deployment"}
 	ExtReplicaSet            = schema.GroupVersionKind{Group: "extensions", Version: "v1beta1", Kind: "ReplicaSet"}
 	Event                    = schema.GroupVersionKind{Version: "v1", Kind: "Event"}
+	HorizontalPodAutoscaler  = schema.GroupVersionKind{Group: "autoscaling", Version: "v1", Kind: "HorizontalPodAutoscaler"}
 	Ingress                  = schema.GroupVersionKind{Group: "extensions", Version: "v1beta1", Kind: "Ingress"}
 	Job                      = schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "Job"}
+	Namespace                = schema.GroupVersionKind{Version: "v1", Kind: "Namespace"}
 	Node                     = schema.GroupVersionKind{Version: "v1", Kind: "Node"}
 	ServiceAccount           = schema.GroupVersionKind{Version: "v1", Kind: "ServiceAccount"}
 	Secret                   = schema.GroupVersionKind{Version: "v1", Kind: "Secret"}
 	Service                  = schema.GroupVersionKind{Version: "v1", Kind: "Service"}
 	Pod                      = schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
+	PersistentVolume         = schema.GroupVersionKind{Version: "v1", Kind: "PersistentVolume"}
 	PersistentVolumeClaim    = schema.GroupVersionKind{Version: "v1", Kind: "PersistentVolumeClaim"}
 	ReplicationController    = schema.GroupVersionKind{Version: "v1", Kind: "ReplicationController"}
 	StatefulSet              = schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "StatefulSet"}
+	StorageClass             = schema.GroupVersionKind{Group: "storage.k8s.io", Version: "v1", Kind: "StorageClass"}
 	RoleBinding              = schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "RoleBinding"}
 	Role                     = schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "Role"}
 )
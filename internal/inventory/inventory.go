@@ -0,0 +1,279 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package inventory generates a census of the objects in a cluster: how
+// many objects of each kind are in each namespace, the most used label
+// keys, and how many objects each custom resource definition has.
+package inventory
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+
+	"github.com/vmware/octant/pkg/store"
+)
+
+const (
+	// topLabelKeysCount is the number of label keys in a report.
+	topLabelKeysCount = 10
+)
+
+// Count is the number of objects of a kind in a namespace. Cluster scoped
+// objects have a blank namespace.
+type Count struct {
+	Namespace  string `json:"namespace"`
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Count      int    `json:"count"`
+}
+
+// LabelKeyCount is the number of objects with a label key.
+type LabelKeyCount struct {
+	Key   string `json:"key"`
+	Count int    `json:"count"`
+}
+
+// CRDUsage is the number of objects for a custom resource definition.
+type CRDUsage struct {
+	Name  string `json:"name"`
+	Group string `json:"group"`
+	Kind  string `json:"kind"`
+	Count int    `json:"count"`
+}
+
+// Report is a census of the objects in a cluster.
+type Report struct {
+	GeneratedAt time.Time       `json:"generatedAt"`
+	Counts      []Count         `json:"counts"`
+	LabelKeys   []LabelKeyCount `json:"labelKeys"`
+	CRDs        []CRDUsage      `json:"crds"`
+	// Errors are resources which could not be listed, e.g. because access
+	// is forbidden. They are not included in the report.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// Total returns the number of objects in the report.
+func (r *Report) Total() int {
+	total := 0
+	for _, count := range r.Counts {
+		total += count.Count
+	}
+
+	return total
+}
+
+// Generate generates a report using the resources the cluster serves and
+// the objects in the object store.
+func Generate(ctx context.Context, objectStore store.Store, discoveryClient discovery.DiscoveryInterface) (*Report, error) {
+	if objectStore == nil {
+		return nil, errors.New("object store is nil")
+	}
+
+	if discoveryClient == nil {
+		return nil, errors.New("discovery client is nil")
+	}
+
+	resourceLists, err := discoveryClient.ServerPreferredResources()
+	if err != nil {
+		return nil, errors.Wrap(err, "retrieve server resources")
+	}
+
+	report := &Report{
+		GeneratedAt: time.Now(),
+	}
+
+	counts := make(map[Count]int)
+	labelKeys := make(map[string]int)
+	kindCounts := make(map[schema.GroupKind]int)
+
+	for _, resourceList := range resourceLists {
+		if resourceList == nil {
+			continue
+		}
+
+		gv, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse group version %s", resourceList.GroupVersion)
+		}
+
+		for _, apiResource := range resourceList.APIResources {
+			if !canList(apiResource.Verbs) {
+				continue
+			}
+
+			key := store.Key{
+				APIVersion: resourceList.GroupVersion,
+				Kind:       apiResource.Kind,
+			}
+
+			list, _, err := objectStore.List(ctx, key)
+			if err != nil {
+				report.Errors = append(report.Errors, errors.Wrapf(err, "list %s %s", key.APIVersion, key.Kind).Error())
+				continue
+			}
+
+			for i := range list.Items {
+				object := &list.Items[i]
+				counts[Count{
+					Namespace:  object.GetNamespace(),
+					APIVersion: key.APIVersion,
+					Kind:       key.Kind,
+				}]++
+
+				for labelKey := range object.GetLabels() {
+					labelKeys[labelKey]++
+				}
+			}
+
+			kindCounts[schema.GroupKind{Group: gv.Group, Kind: apiResource.Kind}] += len(list.Items)
+		}
+	}
+
+	report.Counts = sortCounts(counts)
+	report.LabelKeys = topLabelKeys(labelKeys, topLabelKeysCount)
+
+	crds, err := crdUsage(ctx, objectStore, kindCounts)
+	if err != nil {
+		return nil, err
+	}
+	report.CRDs = crds
+
+	return report, nil
+}
+
+func canList(verbs []string) bool {
+	for _, verb := range verbs {
+		if verb == "list" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sortCounts(m map[Count]int) []Count {
+	counts := make([]Count, 0, len(m))
+	for count, n := range m {
+		count.Count = n
+		counts = append(counts, count)
+	}
+
+	sort.Slice(counts, func(i, j int) bool {
+		a, b := counts[i], counts[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.APIVersion != b.APIVersion {
+			return a.APIVersion < b.APIVersion
+		}
+		return a.Kind < b.Kind
+	})
+
+	return counts
+}
+
+func topLabelKeys(m map[string]int, n int) []LabelKeyCount {
+	keys := make([]LabelKeyCount, 0, len(m))
+	for key, count := range m {
+		keys = append(keys, LabelKeyCount{Key: key, Count: count})
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Count != keys[j].Count {
+			return keys[i].Count > keys[j].Count
+		}
+		return keys[i].Key < keys[j].Key
+	})
+
+	if len(keys) > n {
+		keys = keys[:n]
+	}
+
+	return keys
+}
+
+func crdUsage(ctx context.Context, objectStore store.Store, kindCounts map[schema.GroupKind]int) ([]CRDUsage, error) {
+	key := store.Key{
+		APIVersion: "apiextensions.k8s.io/v1beta1",
+		Kind:       "CustomResourceDefinition",
+	}
+
+	list, _, err := objectStore.List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "list custom resource definitions")
+	}
+
+	crds := make([]CRDUsage, 0, len(list.Items))
+	for i := range list.Items {
+		crd := &list.Items[i]
+
+		group, _, err := unstructured.NestedString(crd.Object, "spec", "group")
+		if err != nil {
+			return nil, errors.Wrapf(err, "get group for crd %s", crd.GetName())
+		}
+
+		kind, _, err := unstructured.NestedString(crd.Object, "spec", "names", "kind")
+		if err != nil {
+			return nil, errors.Wrapf(err, "get kind for crd %s", crd.GetName())
+		}
+
+		crds = append(crds, CRDUsage{
+			Name:  crd.GetName(),
+			Group: group,
+			Kind:  kind,
+			Count: kindCounts[schema.GroupKind{Group: group, Kind: kind}],
+		})
+	}
+
+	sort.Slice(crds, func(i, j int) bool {
+		return crds[i].Name < crds[j].Name
+	})
+
+	return crds, nil
+}
+
+// WriteJSON writes a report as JSON.
+func WriteJSON(w io.Writer, report *Report) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(report); err != nil {
+		return errors.Wrap(err, "encode report")
+	}
+
+	return nil
+}
+
+// WriteCSV writes a report's counts as CSV, one row for each kind in each
+// namespace.
+func WriteCSV(w io.Writer, report *Report) error {
+	writer := csv.NewWriter(w)
+
+	rows := [][]string{{"namespace", "apiVersion", "kind", "count"}}
+	for _, count := range report.Counts {
+		rows = append(rows, []string{
+			count.Namespace,
+			count.APIVersion,
+			count.Kind,
+			strconv.Itoa(count.Count),
+		})
+	}
+
+	if err := writer.WriteAll(rows); err != nil {
+		return errors.Wrap(err, "write report")
+	}
+
+	return nil
+}
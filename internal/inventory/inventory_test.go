@@ -0,0 +1,137 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package inventory
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	queryerFake "github.com/vmware/octant/internal/queryer/fake"
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/store"
+	storeFake "github.com/vmware/octant/pkg/store/fake"
+)
+
+func TestGenerate(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	resourceLists := []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Kind: "Pod", Namespaced: true, Verbs: metav1.Verbs{"get", "list"}},
+				{Kind: "Secret", Namespaced: true, Verbs: metav1.Verbs{"get", "list"}},
+				{Kind: "Binding", Namespaced: true, Verbs: metav1.Verbs{"create"}},
+			},
+		},
+		{
+			GroupVersion: "stable.example.com/v1",
+			APIResources: []metav1.APIResource{
+				{Kind: "CronTab", Namespaced: true, Verbs: metav1.Verbs{"list"}},
+			},
+		},
+	}
+
+	discoveryClient := queryerFake.NewMockDiscoveryInterface(controller)
+	discoveryClient.EXPECT().ServerPreferredResources().Return(resourceLists, nil)
+
+	pod1 := testutil.CreatePod("pod-1")
+	pod1.Labels = map[string]string{"app": "one", "tier": "web"}
+	pod2 := testutil.CreatePod("pod-2")
+	pod2.Namespace = "other"
+	pod2.Labels = map[string]string{"app": "two"}
+
+	cronTab := testutil.CreateCustomResource("cron-tab")
+	cronTab.SetAPIVersion("stable.example.com/v1")
+	cronTab.SetKind("CronTab")
+
+	crd := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apiextensions.k8s.io/v1beta1",
+		"kind":       "CustomResourceDefinition",
+		"metadata":   map[string]interface{}{"name": "crontabs.stable.example.com"},
+		"spec": map[string]interface{}{
+			"group": "stable.example.com",
+			"names": map[string]interface{}{"kind": "CronTab"},
+		},
+	}}
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Pod"}).
+		Return(testutil.ToUnstructuredList(t, pod1, pod2), false, nil)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Secret"}).
+		Return(nil, false, errors.New("forbidden"))
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: "stable.example.com/v1", Kind: "CronTab"}).
+		Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{*cronTab}}, false, nil)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: "apiextensions.k8s.io/v1beta1", Kind: "CustomResourceDefinition"}).
+		Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{*crd}}, false, nil)
+
+	got, err := Generate(context.Background(), objectStore, discoveryClient)
+	require.NoError(t, err)
+
+	assert.False(t, got.GeneratedAt.IsZero())
+
+	expectedCounts := []Count{
+		{Namespace: "namespace", APIVersion: "stable.example.com/v1", Kind: "CronTab", Count: 1},
+		{Namespace: "namespace", APIVersion: "v1", Kind: "Pod", Count: 1},
+		{Namespace: "other", APIVersion: "v1", Kind: "Pod", Count: 1},
+	}
+	assert.Equal(t, expectedCounts, got.Counts)
+	assert.Equal(t, 3, got.Total())
+
+	expectedLabelKeys := []LabelKeyCount{
+		{Key: "app", Count: 2},
+		{Key: "tier", Count: 1},
+	}
+	assert.Equal(t, expectedLabelKeys, got.LabelKeys)
+
+	expectedCRDs := []CRDUsage{
+		{Name: "crontabs.stable.example.com", Group: "stable.example.com", Kind: "CronTab", Count: 1},
+	}
+	assert.Equal(t, expectedCRDs, got.CRDs)
+
+	assert.Equal(t, []string{"list v1 Secret: forbidden"}, got.Errors)
+}
+
+func Test_topLabelKeys(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 3, "c": 3, "d": 2}
+
+	got := topLabelKeys(m, 3)
+
+	expected := []LabelKeyCount{
+		{Key: "b", Count: 3},
+		{Key: "c", Count: 3},
+		{Key: "d", Count: 2},
+	}
+	assert.Equal(t, expected, got)
+}
+
+func TestWriteCSV(t *testing.T) {
+	report := &Report{
+		Counts: []Count{
+			{APIVersion: "v1", Kind: "Node", Count: 3},
+			{Namespace: "default", APIVersion: "v1", Kind: "Pod", Count: 2},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteCSV(&buf, report))
+
+	expected := "namespace,apiVersion,kind,count\n,v1,Node,3\ndefault,v1,Pod,2\n"
+	assert.Equal(t, expected, buf.String())
+}
@@ -0,0 +1,283 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/vmware/octant/internal/cluster"
+)
+
+//go:generate mockgen -destination=./fake/mock_interface.go -package=fake github.com/vmware/octant/internal/metrics Interface
+
+var (
+	// PodMetricsResource is the metrics-server resource for pod metrics.
+	PodMetricsResource = schema.GroupVersionResource{Group: "metrics.k8s.io", Version: "v1beta1", Resource: "pods"}
+	// NodeMetricsResource is the metrics-server resource for node metrics.
+	NodeMetricsResource = schema.GroupVersionResource{Group: "metrics.k8s.io", Version: "v1beta1", Resource: "nodes"}
+
+	// ErrNotAvailable is returned when the cluster does not serve the
+	// metrics API, e.g. metrics-server is not installed.
+	ErrNotAvailable = errors.New("metrics API is not available")
+)
+
+// Usage is the compute resources a container, pod, or node is using.
+type Usage struct {
+	CPU    resource.Quantity
+	Memory resource.Quantity
+}
+
+// NewUsage creates an instance of Usage from a resource list.
+func NewUsage(list corev1.ResourceList) Usage {
+	var u Usage
+	if cpu, ok := list[corev1.ResourceCPU]; ok {
+		u.CPU = cpu.DeepCopy()
+	}
+	if memory, ok := list[corev1.ResourceMemory]; ok {
+		u.Memory = memory.DeepCopy()
+	}
+
+	return u
+}
+
+// Add adds other usage to the usage.
+func (u *Usage) Add(other Usage) {
+	u.CPU.Add(other.CPU)
+	u.Memory.Add(other.Memory)
+}
+
+// ContainerMetrics is the usage of a container.
+type ContainerMetrics struct {
+	Name  string
+	Usage Usage
+}
+
+// PodMetrics is the usage of a pod's containers.
+type PodMetrics struct {
+	Namespace string
+	Name      string
+	// Timestamp is when the usage was sampled.
+	Timestamp time.Time
+	// Window is the interval the usage was sampled over.
+	Window     time.Duration
+	Containers []ContainerMetrics
+}
+
+// Total returns the sum of the usage of the pod's containers.
+func (p *PodMetrics) Total() Usage {
+	var total Usage
+	for _, container := range p.Containers {
+		total.Add(container.Usage)
+	}
+
+	return total
+}
+
+// Container returns the usage of a container by name.
+func (p *PodMetrics) Container(name string) (Usage, bool) {
+	for _, container := range p.Containers {
+		if container.Name == name {
+			return container.Usage, true
+		}
+	}
+
+	return Usage{}, false
+}
+
+// NodeMetrics is the usage of a node.
+type NodeMetrics struct {
+	Name string
+	// Timestamp is when the usage was sampled.
+	Timestamp time.Time
+	// Window is the interval the usage was sampled over.
+	Window time.Duration
+	Usage  Usage
+}
+
+// Interface queries current resource usage.
+type Interface interface {
+	// Available returns true if the cluster serves the metrics API.
+	Available() bool
+	// PodMetrics returns the usage of a pod.
+	PodMetrics(ctx context.Context, namespace, name string) (*PodMetrics, error)
+	// PodMetricsList returns the usage of the pods in a namespace which
+	// match a selector.
+	PodMetricsList(ctx context.Context, namespace string, selector labels.Selector) ([]PodMetrics, error)
+	// NodeMetrics returns the usage of a node.
+	NodeMetrics(ctx context.Context, name string) (*NodeMetrics, error)
+}
+
+// ClusterClientGetter returns the current cluster client.
+type ClusterClientGetter interface {
+	ClusterClient() cluster.ClientInterface
+}
+
+// Client queries current resource usage from metrics-server.
+type Client struct {
+	clusterClientGetter ClusterClientGetter
+}
+
+var _ Interface = (*Client)(nil)
+
+// NewClient creates an instance of Client. The cluster client is looked up
+// for each query, so the client follows context changes.
+func NewClient(clusterClientGetter ClusterClientGetter) *Client {
+	return &Client{
+		clusterClientGetter: clusterClientGetter,
+	}
+}
+
+// Available returns true if the cluster serves the metrics API.
+func (c *Client) Available() bool {
+	clusterClient := c.clusterClientGetter.ClusterClient()
+	if clusterClient == nil {
+		return false
+	}
+
+	return clusterClient.ResourceExists(PodMetricsResource)
+}
+
+// PodMetrics returns the usage of a pod. It returns ErrNotAvailable if the
+// cluster does not serve the metrics API.
+func (c *Client) PodMetrics(ctx context.Context, namespace, name string) (*PodMetrics, error) {
+	client, err := c.resourceClient(PodMetricsResource)
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := client.Namespace(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "get metrics for pod %s/%s", namespace, name)
+	}
+
+	return podMetricsFromObject(object)
+}
+
+// PodMetricsList returns the usage of the pods in a namespace which match a
+// selector. It returns ErrNotAvailable if the cluster does not serve the
+// metrics API.
+func (c *Client) PodMetricsList(ctx context.Context, namespace string, selector labels.Selector) ([]PodMetrics, error) {
+	client, err := c.resourceClient(PodMetricsResource)
+	if err != nil {
+		return nil, err
+	}
+
+	if selector == nil {
+		selector = labels.Everything()
+	}
+
+	list, err := client.Namespace(namespace).List(metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, errors.Wrapf(err, "list metrics for pods in %s", namespace)
+	}
+
+	var podMetrics []PodMetrics
+	for i := range list.Items {
+		pm, err := podMetricsFromObject(&list.Items[i])
+		if err != nil {
+			return nil, err
+		}
+
+		podMetrics = append(podMetrics, *pm)
+	}
+
+	return podMetrics, nil
+}
+
+// NodeMetrics returns the usage of a node. It returns ErrNotAvailable if the
+// cluster does not serve the metrics API.
+func (c *Client) NodeMetrics(ctx context.Context, name string) (*NodeMetrics, error) {
+	client, err := c.resourceClient(NodeMetricsResource)
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := client.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "get metrics for node %s", name)
+	}
+
+	var nm nodeMetricsObject
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, &nm); err != nil {
+		return nil, errors.Wrap(err, "convert node metrics")
+	}
+
+	return &NodeMetrics{
+		Name:      nm.Name,
+		Timestamp: nm.Timestamp.Time,
+		Window:    nm.Window.Duration,
+		Usage:     NewUsage(nm.Usage),
+	}, nil
+}
+
+func (c *Client) resourceClient(gvr schema.GroupVersionResource) (dynamic.NamespaceableResourceInterface, error) {
+	clusterClient := c.clusterClientGetter.ClusterClient()
+	if clusterClient == nil || !clusterClient.ResourceExists(gvr) {
+		return nil, ErrNotAvailable
+	}
+
+	dynamicClient, err := clusterClient.DynamicClient()
+	if err != nil {
+		return nil, errors.Wrap(err, "get dynamic client")
+	}
+
+	return dynamicClient.Resource(gvr), nil
+}
+
+// podMetricsObject mirrors metrics.k8s.io/v1beta1 PodMetrics.
+type podMetricsObject struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Timestamp         metav1.Time              `json:"timestamp"`
+	Window            metav1.Duration          `json:"window"`
+	Containers        []containerMetricsObject `json:"containers"`
+}
+
+type containerMetricsObject struct {
+	Name  string              `json:"name"`
+	Usage corev1.ResourceList `json:"usage"`
+}
+
+// nodeMetricsObject mirrors metrics.k8s.io/v1beta1 NodeMetrics.
+type nodeMetricsObject struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Timestamp         metav1.Time         `json:"timestamp"`
+	Window            metav1.Duration     `json:"window"`
+	Usage             corev1.ResourceList `json:"usage"`
+}
+
+func podMetricsFromObject(object *unstructured.Unstructured) (*PodMetrics, error) {
+	var pm podMetricsObject
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, &pm); err != nil {
+		return nil, errors.Wrap(err, "convert pod metrics")
+	}
+
+	podMetrics := &PodMetrics{
+		Namespace: pm.Namespace,
+		Name:      pm.Name,
+		Timestamp: pm.Timestamp.Time,
+		Window:    pm.Window.Duration,
+	}
+
+	for _, container := range pm.Containers {
+		podMetrics.Containers = append(podMetrics.Containers, ContainerMetrics{
+			Name:  container.Name,
+			Usage: NewUsage(container.Usage),
+		})
+	}
+
+	return podMetrics, nil
+}
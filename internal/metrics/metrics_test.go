@@ -0,0 +1,101 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package metrics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/vmware/octant/internal/cluster"
+	clusterFake "github.com/vmware/octant/internal/cluster/fake"
+)
+
+type clusterClientGetter struct {
+	clusterClient cluster.ClientInterface
+}
+
+func (c clusterClientGetter) ClusterClient() cluster.ClientInterface {
+	return c.clusterClient
+}
+
+func TestClient_PodMetrics(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dc := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+	_, err := dc.Resource(PodMetricsResource).Namespace("default").Create(&unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "metrics.k8s.io/v1beta1",
+			"kind":       "PodMetrics",
+			"metadata": map[string]interface{}{
+				"namespace": "default",
+				"name":      "pod",
+			},
+			"timestamp": "2019-10-01T12:00:00Z",
+			"window":    "30s",
+			"containers": []interface{}{
+				map[string]interface{}{
+					"name":  "a",
+					"usage": map[string]interface{}{"cpu": "250m", "memory": "64Mi"},
+				},
+				map[string]interface{}{
+					"name":  "b",
+					"usage": map[string]interface{}{"cpu": "750m", "memory": "64Mi"},
+				},
+			},
+		},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	clusterClient.EXPECT().ResourceExists(PodMetricsResource).Return(true)
+	clusterClient.EXPECT().DynamicClient().Return(dc, nil)
+
+	client := NewClient(clusterClientGetter{clusterClient: clusterClient})
+
+	got, err := client.PodMetrics(context.Background(), "default", "pod")
+	require.NoError(t, err)
+
+	assert.Equal(t, "pod", got.Name)
+	assert.Equal(t, 30*time.Second, got.Window)
+	assert.Len(t, got.Containers, 2)
+
+	total := got.Total()
+	assert.Equal(t, int64(1000), total.CPU.MilliValue())
+	assert.True(t, total.Memory.Equal(resource.MustParse("128Mi")))
+
+	usage, ok := got.Container("a")
+	require.True(t, ok)
+	assert.Equal(t, int64(250), usage.CPU.MilliValue())
+}
+
+func TestClient_notAvailable(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	clusterClient.EXPECT().ResourceExists(gomock.Any()).Return(false).AnyTimes()
+
+	client := NewClient(clusterClientGetter{clusterClient: clusterClient})
+
+	assert.False(t, client.Available())
+
+	_, err := client.PodMetrics(context.Background(), "default", "pod")
+	assert.Equal(t, ErrNotAvailable, err)
+
+	_, err = client.NodeMetrics(context.Background(), "node")
+	assert.Equal(t, ErrNotAvailable, err)
+}
@@ -0,0 +1,189 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// PrometheusOption is an option for configuring Prometheus.
+type PrometheusOption func(p *Prometheus)
+
+// WithHTTPClient sets the HTTP client Prometheus is queried with.
+func WithHTTPClient(client *http.Client) PrometheusOption {
+	return func(p *Prometheus) {
+		p.client = client
+	}
+}
+
+// Prometheus queries the history of metrics from a Prometheus server.
+type Prometheus struct {
+	address *url.URL
+	client  *http.Client
+}
+
+var _ Provider = (*Prometheus)(nil)
+
+// NewPrometheus creates an instance of Prometheus for the server at address,
+// e.g. http://prometheus.monitoring:9090.
+func NewPrometheus(address string, options ...PrometheusOption) (*Prometheus, error) {
+	u, err := url.Parse(address)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse prometheus address")
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, errors.Errorf("prometheus address %q must be an http or https URL", address)
+	}
+
+	p := &Prometheus{
+		address: u,
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}
+
+	for _, option := range options {
+		option(p)
+	}
+
+	return p, nil
+}
+
+// QueryRange returns the series a query evaluates to over a range.
+func (p *Prometheus) QueryRange(ctx context.Context, query string, r Range) ([]Series, error) {
+	values := url.Values{}
+	values.Set("query", query)
+	values.Set("start", formatPrometheusTime(r.Start))
+	values.Set("end", formatPrometheusTime(r.End))
+	values.Set("step", strconv.FormatFloat(r.Step.Seconds(), 'f', -1, 64))
+
+	u := *p.address
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/api/v1/query_range"
+	u.RawQuery = values.Encode()
+
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "create prometheus request")
+	}
+
+	resp, err := p.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "query prometheus")
+	}
+	defer resp.Body.Close()
+
+	var qr prometheusResponse
+	if err := json.NewDecoder(resp.Body).Decode(&qr); err != nil {
+		return nil, errors.Wrapf(err, "decode prometheus response (status %d)", resp.StatusCode)
+	}
+
+	if qr.Status != "success" {
+		return nil, errors.Errorf("prometheus query %q failed: %s: %s", query, qr.ErrorType, qr.Error)
+	}
+
+	if qr.Data.ResultType != "matrix" {
+		return nil, errors.Errorf("prometheus query %q returned %s, expected matrix", query, qr.Data.ResultType)
+	}
+
+	var list []Series
+	for _, result := range qr.Data.Result {
+		series := Series{
+			Name: seriesName(result.Metric),
+		}
+
+		for _, value := range result.Values {
+			point, err := value.point()
+			if err != nil {
+				return nil, errors.Wrapf(err, "parse value of series %s", series.Name)
+			}
+			series.Points = append(series.Points, point)
+		}
+
+		list = append(list, series)
+	}
+
+	return list, nil
+}
+
+type prometheusResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType"`
+	Error     string `json:"error"`
+	Data      struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Metric map[string]string `json:"metric"`
+			Values []prometheusValue `json:"values"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// prometheusValue is a [<unix time>, "<value>"] pair.
+type prometheusValue []interface{}
+
+func (v prometheusValue) point() (Point, error) {
+	if len(v) != 2 {
+		return Point{}, errors.Errorf("expected timestamp and value, got %d items", len(v))
+	}
+
+	ts, ok := v[0].(float64)
+	if !ok {
+		return Point{}, errors.Errorf("timestamp %v is not a number", v[0])
+	}
+
+	s, ok := v[1].(string)
+	if !ok {
+		return Point{}, errors.Errorf("value %v is not a string", v[1])
+	}
+
+	value, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return Point{}, err
+	}
+
+	sec, frac := math.Modf(ts)
+	return Point{
+		Timestamp: time.Unix(int64(sec), int64(frac*float64(time.Second))).UTC(),
+		Value:     value,
+	}, nil
+}
+
+// seriesName names a series after its labels. A series with a single label,
+// e.g. from a sum by (pod), is named after the label's value.
+func seriesName(metric map[string]string) string {
+	if len(metric) == 1 {
+		for _, v := range metric {
+			return v
+		}
+	}
+
+	var keys []string
+	for k := range metric {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var parts []string
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf("%s=%s", k, metric[k]))
+	}
+
+	return strings.Join(parts, ",")
+}
+
+func formatPrometheusTime(t time.Time) string {
+	return strconv.FormatFloat(float64(t.UnixNano())/float64(time.Second), 'f', -1, 64)
+}
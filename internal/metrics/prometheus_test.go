@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPrometheus_invalid_address(t *testing.T) {
+	_, err := NewPrometheus("prometheus:9090")
+	require.Error(t, err)
+}
+
+func TestPrometheus_QueryRange(t *testing.T) {
+	start := time.Unix(1569931200, 0).UTC()
+	end := start.Add(time.Minute)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/prometheus/api/v1/query_range", r.URL.Path)
+		assert.Equal(t, "up", r.URL.Query().Get("query"))
+		assert.Equal(t, "1569931200", r.URL.Query().Get("start"))
+		assert.Equal(t, "1569931260", r.URL.Query().Get("end"))
+		assert.Equal(t, "30", r.URL.Query().Get("step"))
+
+		fmt.Fprint(w, `{
+  "status": "success",
+  "data": {
+    "resultType": "matrix",
+    "result": [
+      {"metric": {"pod": "a"}, "values": [[1569931200, "1"], [1569931230.5, "0.5"]]},
+      {"metric": {"pod": "b", "container": "c"}, "values": [[1569931200, "2"]]}
+    ]
+  }
+}`)
+	}))
+	defer server.Close()
+
+	p, err := NewPrometheus(server.URL + "/prometheus/")
+	require.NoError(t, err)
+
+	got, err := p.QueryRange(context.Background(), "up", Range{Start: start, End: end, Step: 30 * time.Second})
+	require.NoError(t, err)
+
+	expected := []Series{
+		{
+			Name: "a",
+			Points: []Point{
+				{Timestamp: start, Value: 1},
+				{Timestamp: start.Add(30*time.Second + 500*time.Millisecond), Value: 0.5},
+			},
+		},
+		{
+			Name:   "container=c,pod=b",
+			Points: []Point{{Timestamp: start, Value: 2}},
+		},
+	}
+	assert.Equal(t, expected, got)
+}
+
+func TestPrometheus_QueryRange_error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"status": "error", "errorType": "bad_data", "error": "parse error"}`)
+	}))
+	defer server.Close()
+
+	p, err := NewPrometheus(server.URL)
+	require.NoError(t, err)
+
+	_, err = p.QueryRange(context.Background(), "sum(", RangeEndingAt(time.Now(), time.Hour, time.Minute))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "bad_data: parse error")
+}
@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package metrics
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -destination=./fake/mock_provider.go -package=fake github.com/vmware/octant/internal/metrics Provider
+
+// Point is a value at a point in time.
+type Point struct {
+	Timestamp time.Time
+	Value     float64
+}
+
+// Series is a named series of points.
+type Series struct {
+	Name   string
+	Points []Point
+}
+
+// Range is the window and resolution of a range query.
+type Range struct {
+	Start time.Time
+	End   time.Time
+	Step  time.Duration
+}
+
+// RangeEndingAt creates a range of duration which ends at end.
+func RangeEndingAt(end time.Time, duration, step time.Duration) Range {
+	return Range{
+		Start: end.Add(-duration),
+		End:   end,
+		Step:  step,
+	}
+}
+
+// Provider queries the history of metrics, e.g. from Prometheus.
+type Provider interface {
+	// QueryRange returns the series a query evaluates to over a range.
+	QueryRange(ctx context.Context, query string, r Range) ([]Series, error)
+}
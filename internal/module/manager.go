@@ -8,6 +8,7 @@ package module
 import (
 	"context"
 	"strings"
+	"sync"
 
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -33,6 +34,7 @@ type ActionRegistrar interface {
 type ManagerInterface interface {
 	Modules() []Module
 	Register(mod Module) error
+	Unregister(name string)
 	SetNamespace(namespace string)
 	GetNamespace() string
 	UpdateContext(ctx context.Context, contextName string) error
@@ -54,6 +56,8 @@ type Manager struct {
 	registeredModules []Module
 
 	loadedModules []Module
+
+	mu sync.RWMutex
 }
 
 var _ ManagerInterface = (*Manager)(nil)
@@ -72,6 +76,9 @@ func NewManager(clusterClient cluster.ClientInterface, namespace string, actionR
 
 // Register register a module with the manager.
 func (m *Manager) Register(mod Module) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	m.registeredModules = append(m.registeredModules, mod)
 
 	if receiver, ok := mod.(ActionReceiver); ok {
@@ -92,14 +99,45 @@ func (m *Manager) Register(mod Module) error {
 	return nil
 }
 
+// Unregister stops and removes the module with name. Actions the module
+// registered are left to be removed by their owner.
+func (m *Manager) Unregister(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, mod := range m.loadedModules {
+		if mod.Name() == name {
+			mod.Stop()
+		}
+	}
+
+	m.registeredModules = removeModule(m.registeredModules, name)
+	m.loadedModules = removeModule(m.loadedModules, name)
+}
+
+// removeModule returns a copy of list without the module with name.
+func removeModule(list []Module, name string) []Module {
+	var out []Module
+	for _, mod := range list {
+		if mod.Name() != name {
+			out = append(out, mod)
+		}
+	}
+
+	return out
+}
+
 // Modules returns a list of modules.
 func (m *Manager) Modules() []Module {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
 	return m.loadedModules
 }
 
 // Unload unloads modules.
 func (m *Manager) Unload() {
-	for _, module := range m.loadedModules {
+	for _, module := range m.Modules() {
 		module.Stop()
 	}
 }
@@ -107,7 +145,7 @@ func (m *Manager) Unload() {
 // SetNamespace sets the current namespace.
 func (m *Manager) SetNamespace(namespace string) {
 	m.namespace = namespace
-	for _, module := range m.loadedModules {
+	for _, module := range m.Modules() {
 		if err := module.SetNamespace(namespace); err != nil {
 			m.logger.Errorf("setting namespace for module %q: %v",
 				module.Name(), err)
@@ -121,7 +159,7 @@ func (m *Manager) GetNamespace() string {
 }
 
 func (m *Manager) UpdateContext(ctx context.Context, contextName string) error {
-	for _, module := range m.loadedModules {
+	for _, module := range m.Modules() {
 		if err := module.SetContext(ctx, contextName); err != nil {
 			return err
 		}
@@ -142,8 +180,12 @@ func (m *Manager) ObjectPath(namespace, apiVersion, kind, name string) (string,
 		Kind:    kind,
 	}
 
+	m.mu.RLock()
+	registeredModules := m.registeredModules
+	m.mu.RUnlock()
+
 	objectPaths := make(map[schema.GroupVersionKind]Module)
-	for _, registered := range m.registeredModules {
+	for _, registered := range registeredModules {
 		for _, supported := range registered.SupportedGroupVersionKind() {
 			objectPaths[supported] = registered
 		}
@@ -171,7 +213,7 @@ func (m *Manager) ModuleForContentPath(contentPath string) (Module, bool) {
 func (m *Manager) ClientRequestHandlers() []octant.ClientRequestHandler {
 	var list []octant.ClientRequestHandler
 
-	for _, m := range m.loadedModules {
+	for _, m := range m.Modules() {
 		list = append(list, m.ClientRequestHandlers()...)
 	}
 
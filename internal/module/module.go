@@ -22,6 +22,10 @@ import (
 // ContentOptions are additional options for content generation
 type ContentOptions struct {
 	LabelSet *labels.Set
+	// Page is the page of rows list tables are limited to.
+	Page component.TablePage
+	// Query narrows the rows of list tables.
+	Query component.TableQuery
 }
 
 // Module is an octant plugin.
@@ -14,7 +14,6 @@ import (
 	"github.com/vmware/octant/internal/config"
 	"github.com/vmware/octant/internal/describer"
 	"github.com/vmware/octant/internal/gvk"
-	"github.com/vmware/octant/internal/printer"
 	"github.com/vmware/octant/internal/queryer"
 	"github.com/vmware/octant/internal/resourceviewer"
 	"github.com/vmware/octant/pkg/store"
@@ -40,19 +39,16 @@ var allowed = []schema.GroupVersionKind{
 
 // ApplicationDescriber describes an application.
 type ApplicationDescriber struct {
-	printer *printer.Resource
-
 	overviewFactory       func(ctx context.Context, namespace string, options describer.Options) (component.Component, error)
 	resourceViewerFactory func(ctx context.Context, namespace string, options describer.Options) (component.Component, error)
 }
 
 var _ describer.Describer = (*ApplicationDescriber)(nil)
 
-// NewApplicationDescriber creates an instance of ApplicationDescriber.
-func NewApplicationDescriber(dashConfig config.Dash) *ApplicationDescriber {
-	p := printer.NewResource(dashConfig)
+// NewApplicationDescriber creates an instance of ApplicationDescriber. It
+// prints objects with the printer in its describer options.
+func NewApplicationDescriber() *ApplicationDescriber {
 	d := &ApplicationDescriber{
-		printer:               p,
 		overviewFactory:       overviewFactory,
 		resourceViewerFactory: resourceViewerFactory,
 	}
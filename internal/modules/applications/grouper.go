@@ -0,0 +1,252 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package applications
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware/octant/internal/link"
+	"github.com/vmware/octant/internal/objectstatus"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+//go:generate mockgen -destination=./fake/mock_grouper.go -package=fake github.com/vmware/octant/internal/modules/applications Grouper
+
+var (
+	workloadListColumns = component.NewTableCols("Name", "Kind", "Status", "Pods")
+
+	workloadKeys = []store.Key{
+		{APIVersion: "apps/v1", Kind: "Deployment"},
+		{APIVersion: "apps/v1", Kind: "StatefulSet"},
+		{APIVersion: "apps/v1", Kind: "DaemonSet"},
+	}
+)
+
+const (
+	appLabelPartOf = "app.kubernetes.io/part-of"
+)
+
+// GrouperConfig is configuration for Group.
+type GrouperConfig interface {
+	ObjectStore() store.Store
+}
+
+// Grouper groups the workloads in a namespace into applications. Workloads are grouped by
+// their app.kubernetes.io/part-of label (or app.kubernetes.io/name if it is missing) and
+// their app.kubernetes.io/instance label.
+type Grouper interface {
+	// Group generates a card per application.
+	Group(ctx context.Context, namespace string, config GrouperConfig, linker link.Interface) (*component.CardList, error)
+}
+
+type grouper struct{}
+
+var _ Grouper = (*grouper)(nil)
+
+// Group converts workloads in namespace to a card list.
+func (g *grouper) Group(ctx context.Context, namespace string, config GrouperConfig, linker link.Interface) (*component.CardList, error) {
+	if config == nil {
+		return nil, errors.Errorf("config is nil")
+	}
+
+	if linker == nil {
+		return nil, errors.Errorf("link is nil")
+	}
+
+	groups, err := listWorkloadGroups(ctx, config.ObjectStore(), namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	cardList := component.NewCardList("Applications")
+
+	for _, group := range groups {
+		table := component.NewTable("Workloads", "There are no workloads", workloadListColumns)
+
+		for _, w := range group.workloads {
+			nameLink, err := linker.ForObject(w.object, w.object.GetName())
+			if err != nil {
+				return nil, errors.Wrapf(err, "create link for %s %s", w.object.GetKind(), w.object.GetName())
+			}
+
+			table.Add(component.TableRow{
+				"Name":   nameLink,
+				"Kind":   component.NewText(w.object.GetKind()),
+				"Status": component.NewStatusText(string(w.status), w.status),
+				"Pods":   component.NewText(podCount(w.ready, w.desired)),
+			})
+		}
+
+		ready, desired := group.pods()
+		status := group.status()
+
+		card := component.NewCard(group.title())
+		card.SetBody(component.NewSummary("",
+			component.SummarySection{
+				Header:  "Health",
+				Content: component.NewStatusText(string(status), status),
+			},
+			component.SummarySection{
+				Header:  "Pods",
+				Content: component.NewText(podCount(ready, desired)),
+			},
+			component.SummarySection{
+				Header:  "Workloads",
+				Content: table,
+			},
+		))
+
+		cardList.AddCard(*card)
+	}
+
+	return cardList, nil
+}
+
+func podCount(ready, desired int64) string {
+	return fmt.Sprintf("%d/%d", ready, desired)
+}
+
+type workload struct {
+	object  *unstructured.Unstructured
+	status  component.NodeStatus
+	ready   int64
+	desired int64
+}
+
+type workloadGroup struct {
+	partOf    string
+	instance  string
+	workloads []workload
+}
+
+func (g *workloadGroup) title() string {
+	if g.instance == "" {
+		return g.partOf
+	}
+
+	return fmt.Sprintf("%s (%s)", g.partOf, g.instance)
+}
+
+// status is the worst status of the workloads in the group.
+func (g *workloadGroup) status() component.NodeStatus {
+	status := component.NodeStatusOK
+
+	for _, w := range g.workloads {
+		switch w.status {
+		case component.NodeStatusError:
+			return component.NodeStatusError
+		case component.NodeStatusWarning:
+			status = component.NodeStatusWarning
+		}
+	}
+
+	return status
+}
+
+func (g *workloadGroup) pods() (int64, int64) {
+	var ready, desired int64
+	for _, w := range g.workloads {
+		ready += w.ready
+		desired += w.desired
+	}
+
+	return ready, desired
+}
+
+func listWorkloadGroups(ctx context.Context, objectStore store.Store, namespace string) ([]*workloadGroup, error) {
+	if objectStore == nil {
+		return nil, errors.New("object store is nil")
+	}
+
+	groups := make(map[string]*workloadGroup)
+
+	for _, key := range workloadKeys {
+		key.Namespace = namespace
+
+		list, _, err := objectStore.List(ctx, key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "list %s", key.Kind)
+		}
+
+		for i := range list.Items {
+			object := &list.Items[i]
+
+			labels := object.GetLabels()
+			partOf := labels[appLabelPartOf]
+			if partOf == "" {
+				partOf = labels[appLabelName]
+			}
+			if partOf == "" {
+				continue
+			}
+			instance := labels[appLabelInstance]
+
+			w, err := newWorkload(ctx, object, objectStore)
+			if err != nil {
+				return nil, err
+			}
+
+			id := partOf + "/" + instance
+			group, ok := groups[id]
+			if !ok {
+				group = &workloadGroup{partOf: partOf, instance: instance}
+				groups[id] = group
+			}
+			group.workloads = append(group.workloads, w)
+		}
+	}
+
+	var list []*workloadGroup
+	for _, group := range groups {
+		sort.Slice(group.workloads, func(i, j int) bool {
+			a, b := group.workloads[i].object, group.workloads[j].object
+			if a.GetName() != b.GetName() {
+				return a.GetName() < b.GetName()
+			}
+			return a.GetKind() < b.GetKind()
+		})
+		list = append(list, group)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].title() < list[j].title()
+	})
+
+	return list, nil
+}
+
+func newWorkload(ctx context.Context, object *unstructured.Unstructured, objectStore store.Store) (workload, error) {
+	objectStatus, err := objectstatus.Status(ctx, object, objectStore)
+	if err != nil {
+		return workload{}, errors.Wrapf(err, "get status for %s %s", object.GetKind(), object.GetName())
+	}
+
+	w := workload{
+		object: object,
+		status: objectStatus.Status(),
+	}
+
+	switch object.GetKind() {
+	case "DaemonSet":
+		w.ready, _, _ = unstructured.NestedInt64(object.Object, "status", "numberReady")
+		w.desired, _, _ = unstructured.NestedInt64(object.Object, "status", "desiredNumberScheduled")
+	default:
+		w.ready, _, _ = unstructured.NestedInt64(object.Object, "status", "readyReplicas")
+		desired, found, _ := unstructured.NestedInt64(object.Object, "spec", "replicas")
+		if !found {
+			desired = 1
+		}
+		w.desired = desired
+	}
+
+	return w, nil
+}
@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package applications
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+
+	configFake "github.com/vmware/octant/internal/config/fake"
+	linkFake "github.com/vmware/octant/internal/link/fake"
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/store/fake"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func Test_grouper(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	ctx := context.Background()
+
+	replicas := int32(2)
+	web := testutil.CreateDeployment("web")
+	web.SetLabels(map[string]string{appLabelPartOf: "shop", appLabelInstance: "prod"})
+	web.Spec.Replicas = &replicas
+	web.Status = appsv1.DeploymentStatus{Replicas: 2, ReadyReplicas: 2, AvailableReplicas: 2}
+
+	blog := testutil.CreateDeployment("blog")
+	blog.SetLabels(map[string]string{appLabelName: "blog"})
+	blog.Status = appsv1.DeploymentStatus{Replicas: 1, ReadyReplicas: 1, AvailableReplicas: 1}
+
+	unlabeled := testutil.CreateDeployment("unlabeled")
+
+	dbReplicas := int32(1)
+	db := testutil.CreateStatefulSet("db")
+	db.SetLabels(map[string]string{appLabelPartOf: "shop", appLabelInstance: "prod"})
+	db.Spec.Replicas = &dbReplicas
+	db.Status = appsv1.StatefulSetStatus{Replicas: 1}
+
+	objectStore := fake.NewMockStore(controller)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "default", APIVersion: "apps/v1", Kind: "Deployment"}).
+		Return(testutil.ToUnstructuredList(t, web, blog, unlabeled), false, nil)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "default", APIVersion: "apps/v1", Kind: "StatefulSet"}).
+		Return(testutil.ToUnstructuredList(t, db), false, nil)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "default", APIVersion: "apps/v1", Kind: "DaemonSet"}).
+		Return(testutil.ToUnstructuredList(t), false, nil)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectStore().Return(objectStore)
+
+	linker := linkFake.NewMockInterface(controller)
+	for _, name := range []string{"web", "blog", "db"} {
+		linker.EXPECT().
+			ForObject(gomock.Any(), name).
+			Return(component.NewLink("", name, "/"+name), nil)
+	}
+
+	g := grouper{}
+	actual, err := g.Group(ctx, "default", dashConfig, linker)
+	require.NoError(t, err)
+
+	blogTable := component.NewTableWithRows("Workloads", "There are no workloads", workloadListColumns, []component.TableRow{
+		{
+			"Name":   component.NewLink("", "blog", "/blog"),
+			"Kind":   component.NewText("Deployment"),
+			"Status": component.NewStatusText("ok", component.NodeStatusOK),
+			"Pods":   component.NewText("1/1"),
+		},
+	})
+	blogCard := component.NewCard("blog")
+	blogCard.SetBody(component.NewSummary("",
+		component.SummarySection{Header: "Health", Content: component.NewStatusText("ok", component.NodeStatusOK)},
+		component.SummarySection{Header: "Pods", Content: component.NewText("1/1")},
+		component.SummarySection{Header: "Workloads", Content: blogTable},
+	))
+
+	shopTable := component.NewTableWithRows("Workloads", "There are no workloads", workloadListColumns, []component.TableRow{
+		{
+			"Name":   component.NewLink("", "db", "/db"),
+			"Kind":   component.NewText("StatefulSet"),
+			"Status": component.NewStatusText("warning", component.NodeStatusWarning),
+			"Pods":   component.NewText("0/1"),
+		},
+		{
+			"Name":   component.NewLink("", "web", "/web"),
+			"Kind":   component.NewText("Deployment"),
+			"Status": component.NewStatusText("ok", component.NodeStatusOK),
+			"Pods":   component.NewText("2/2"),
+		},
+	})
+	shopCard := component.NewCard("shop (prod)")
+	shopCard.SetBody(component.NewSummary("",
+		component.SummarySection{Header: "Health", Content: component.NewStatusText("warning", component.NodeStatusWarning)},
+		component.SummarySection{Header: "Pods", Content: component.NewText("2/3")},
+		component.SummarySection{Header: "Workloads", Content: shopTable},
+	))
+
+	expected := component.NewCardList("Applications")
+	expected.AddCard(*blogCard)
+	expected.AddCard(*shopCard)
+
+	component.AssertEqual(t, expected, actual)
+}
@@ -24,9 +24,17 @@ func WithHomeDescriberSummarizer(s Summarizer) HomeDescriberOption {
 	}
 }
 
+// WithHomeDescriberGrouper configures the Grouper for HomeDescriber.
+func WithHomeDescriberGrouper(g Grouper) HomeDescriberOption {
+	return func(d *HomeDescriber) {
+		d.grouper = g
+	}
+}
+
 // HomeDescriber describes content for applications.
 type HomeDescriber struct {
 	summarizer Summarizer
+	grouper    Grouper
 }
 
 var _ describer.Describer = (*HomeDescriber)(nil)
@@ -43,11 +51,20 @@ func NewHomeDescriber(options ...HomeDescriberOption) *HomeDescriber {
 		d.summarizer = &summarizer{}
 	}
 
+	if d.grouper == nil {
+		d.grouper = &grouper{}
+	}
+
 	return d
 }
 
 // Describe prints a summary of applications.
 func (l *HomeDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	cardList, err := l.grouper.Group(ctx, namespace, options, options.Link)
+	if err != nil {
+		return component.EmptyContentResponse, errors.Wrap(err, "group applications")
+	}
+
 	table, err := l.summarizer.Summarize(ctx, namespace, options)
 	if err != nil {
 		return component.EmptyContentResponse, errors.Wrap(err, "summarize applications")
@@ -55,7 +72,7 @@ func (l *HomeDescriber) Describe(ctx context.Context, namespace string, options
 
 	contentResponse := component.ContentResponse{
 		Title:      component.TitleFromString("Applications"),
-		Components: []component.Component{table},
+		Components: []component.Component{cardList, table},
 		IconName:   "",
 		IconSource: "",
 	}
@@ -30,9 +30,18 @@ func Test_homeDescriber_Describe(t *testing.T) {
 		Summarize(gomock.Any(), "default", gomock.Any()).
 		Return(table, nil)
 
+	cardList := component.NewCardList("Applications")
+
+	g := fake.NewMockGrouper(controller)
+	g.EXPECT().
+		Group(gomock.Any(), "default", gomock.Any(), gomock.Any()).
+		Return(cardList, nil)
+
 	dashConfig := configFake.NewMockDash(controller)
 
-	d := applications.NewHomeDescriber(applications.WithHomeDescriberSummarizer(s))
+	d := applications.NewHomeDescriber(
+		applications.WithHomeDescriberSummarizer(s),
+		applications.WithHomeDescriberGrouper(g))
 
 	ctx := context.Background()
 	options := describer.Options{
@@ -43,7 +52,7 @@ func Test_homeDescriber_Describe(t *testing.T) {
 
 	expected := component.ContentResponse{
 		Title:      component.TitleFromString("Applications"),
-		Components: []component.Component{table},
+		Components: []component.Component{cardList, table},
 		IconName:   "",
 		IconSource: "",
 	}
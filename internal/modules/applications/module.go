@@ -19,6 +19,7 @@ import (
 	"github.com/vmware/octant/internal/generator"
 	"github.com/vmware/octant/internal/module"
 	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/internal/printer"
 	"github.com/vmware/octant/pkg/navigation"
 	"github.com/vmware/octant/pkg/view/component"
 )
@@ -26,32 +27,39 @@ import (
 // Options are options for configuring Module.
 type Options struct {
 	DashConfig config.Dash
+	// PrintRegistry holds the handlers objects are printed with.
+	PrintRegistry *printer.Registry
 }
 
 // Module is an applications module.
 type Module struct {
 	Options
-	pathMatcher *describer.PathMatcher
+	generator *generator.Generator
 }
 
 var _ module.Module = (*Module)(nil)
 
 // New creates an instance of Module.
-func New(ctx context.Context, options Options) *Module {
+func New(ctx context.Context, options Options) (*Module, error) {
 	pm := describer.NewPathMatcher("applications")
 	for _, pf := range rootDescriber.PathFilters() {
 		pm.Register(ctx, pf)
 	}
 
-	appDescriber := NewApplicationDescriber(options.DashConfig)
+	appDescriber := NewApplicationDescriber()
 	for _, pf := range appDescriber.PathFilters() {
 		pm.Register(ctx, pf)
 	}
 
-	return &Module{
-		Options:     options,
-		pathMatcher: pm,
+	g, err := generator.NewGenerator(pm, options.DashConfig, options.PrintRegistry)
+	if err != nil {
+		return nil, errors.Wrap(err, "create applications generator")
 	}
+
+	return &Module{
+		Options:   options,
+		generator: g,
+	}, nil
 }
 
 // Name is the name of the module.
@@ -66,12 +74,7 @@ func (m Module) ClientRequestHandlers() []octant.ClientRequestHandler {
 
 // Content generates content for a content path.
 func (m *Module) Content(ctx context.Context, contentPath string, opts module.ContentOptions) (component.ContentResponse, error) {
-	g, err := generator.NewGenerator(m.pathMatcher, m.DashConfig)
-	if err != nil {
-		return component.EmptyContentResponse, err
-	}
-
-	return g.Generate(ctx, contentPath, generator.Options{})
+	return m.generator.Generate(ctx, contentPath, generator.Options{})
 }
 
 // ContentPath returns the root content path for the module.
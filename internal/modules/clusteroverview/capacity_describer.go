@@ -0,0 +1,143 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clusteroverview
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware/octant/internal/capacity"
+	"github.com/vmware/octant/internal/describer"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// CapacityDescriber describes the resources pods request and are limited
+// to compared to what nodes can allocate.
+type CapacityDescriber struct {
+}
+
+// NewCapacityDescriber creates an instance of CapacityDescriber.
+func NewCapacityDescriber() *CapacityDescriber {
+	return &CapacityDescriber{}
+}
+
+var _ describer.Describer = (*CapacityDescriber)(nil)
+
+// Describe describes the capacity report as content.
+func (d *CapacityDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	report, err := capacity.Generate(ctx, options.Dash.ObjectStore())
+	if err != nil {
+		return component.EmptyContentResponse, errors.Wrap(err, "generate capacity")
+	}
+
+	list := component.NewList("Capacity", nil)
+
+	stats := component.NewStatGrid("")
+	stats.Add("Nodes", strconv.Itoa(len(report.Nodes)))
+	stats.Add("Pods", strconv.Itoa(report.Pods))
+	stats.Add("Allocatable CPU", quantity(report.Allocatable, corev1.ResourceCPU))
+	stats.Add("Allocatable Memory", quantity(report.Allocatable, corev1.ResourceMemory))
+	list.Add(stats)
+
+	list.Add(component.NewSummary("Cluster",
+		component.SummarySection{
+			Header:  "CPU Requests",
+			Content: createCapacityProgress(report.Requests, report.Allocatable, corev1.ResourceCPU),
+		},
+		component.SummarySection{
+			Header:  "CPU Limits",
+			Content: createCapacityProgress(report.Limits, report.Allocatable, corev1.ResourceCPU),
+		},
+		component.SummarySection{
+			Header:  "Memory Requests",
+			Content: createCapacityProgress(report.Requests, report.Allocatable, corev1.ResourceMemory),
+		},
+		component.SummarySection{
+			Header:  "Memory Limits",
+			Content: createCapacityProgress(report.Limits, report.Allocatable, corev1.ResourceMemory),
+		},
+	))
+
+	nodesTable, err := createCapacityNodesTable(report, options)
+	if err != nil {
+		return component.EmptyContentResponse, err
+	}
+	list.Add(nodesTable)
+
+	list.Add(createCapacityNamespacesTable(report))
+
+	return component.ContentResponse{
+		Components: []component.Component{list},
+	}, nil
+}
+
+func createCapacityNodesTable(report *capacity.Report, options describer.Options) (*component.Table, error) {
+	cols := component.NewTableCols("Name", "CPU Requests", "CPU Limits", "Memory Requests", "Memory Limits", "Pods")
+	table := component.NewTable("Nodes", "There are no nodes!", cols)
+
+	for _, node := range report.Nodes {
+		nodeLink, err := options.Link.ForGVK("", "v1", "Node", node.Name, node.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		table.Add(component.TableRow{
+			"Name":            nodeLink,
+			"CPU Requests":    createCapacityProgress(node.Requests, node.Allocatable, corev1.ResourceCPU),
+			"CPU Limits":      createCapacityProgress(node.Limits, node.Allocatable, corev1.ResourceCPU),
+			"Memory Requests": createCapacityProgress(node.Requests, node.Allocatable, corev1.ResourceMemory),
+			"Memory Limits":   createCapacityProgress(node.Limits, node.Allocatable, corev1.ResourceMemory),
+			"Pods":            component.NewText(fmt.Sprintf("%d / %s", node.Pods, quantity(node.Allocatable, corev1.ResourcePods))),
+		})
+	}
+
+	return table, nil
+}
+
+func createCapacityNamespacesTable(report *capacity.Report) *component.Table {
+	cols := component.NewTableCols("Name", "CPU Requests", "CPU Limits", "Memory Requests", "Memory Limits", "Pods")
+	table := component.NewTable("Namespaces", "There are no pods!", cols)
+
+	for _, namespace := range report.Namespaces {
+		table.Add(component.TableRow{
+			"Name":            component.NewText(namespace.Name),
+			"CPU Requests":    component.NewText(quantity(namespace.Requests, corev1.ResourceCPU)),
+			"CPU Limits":      component.NewText(quantity(namespace.Limits, corev1.ResourceCPU)),
+			"Memory Requests": component.NewText(quantity(namespace.Requests, corev1.ResourceMemory)),
+			"Memory Limits":   component.NewText(quantity(namespace.Limits, corev1.ResourceMemory)),
+			"Pods":            component.NewText(strconv.Itoa(namespace.Pods)),
+		})
+	}
+
+	return table
+}
+
+// createCapacityProgress creates a progress for how much of a node's
+// allocatable resource is used.
+func createCapacityProgress(used, allocatable corev1.ResourceList, name corev1.ResourceName) *component.Progress {
+	label := fmt.Sprintf("%s / %s", quantity(used, name), quantity(allocatable, name))
+	return component.NewProgress(label, capacity.Fraction(used[name], allocatable[name]), 1)
+}
+
+func quantity(list corev1.ResourceList, name corev1.ResourceName) string {
+	value := list[name]
+	return value.String()
+}
+
+// PathFilters returns the path filters for capacity.
+func (d *CapacityDescriber) PathFilters() []describer.PathFilter {
+	filter := describer.NewPathFilter("/capacity", d)
+	return []describer.PathFilter{*filter}
+}
+
+// Reset does nothing.
+func (d *CapacityDescriber) Reset(ctx context.Context) error {
+	return nil
+}
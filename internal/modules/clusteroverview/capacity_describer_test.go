@@ -0,0 +1,111 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clusteroverview
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	configFake "github.com/vmware/octant/internal/config/fake"
+	"github.com/vmware/octant/internal/describer"
+	linkFake "github.com/vmware/octant/internal/link/fake"
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/store"
+	storeFake "github.com/vmware/octant/pkg/store/fake"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func TestCapacityDescriber_Describe(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	node := testutil.CreateNode("node")
+	node.Status.Allocatable = corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("2"),
+		corev1.ResourceMemory: resource.MustParse("4Gi"),
+		corev1.ResourcePods:   resource.MustParse("110"),
+	}
+
+	pod := testutil.CreatePod("pod", func(pod *corev1.Pod) {
+		pod.Spec.NodeName = "node"
+		pod.Spec.Containers = []corev1.Container{
+			{
+				Name: "container",
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: resource.MustParse("1Gi"),
+					},
+				},
+			},
+		}
+	})
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Node"}).
+		Return(testutil.ToUnstructuredList(t, node), false, nil)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Pod"}).
+		Return(testutil.ToUnstructuredList(t, pod), false, nil)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectStore().Return(objectStore)
+
+	nodeLink := component.NewLink("", "node", "/node")
+	linker := linkFake.NewMockInterface(controller)
+	linker.EXPECT().ForGVK("", "v1", "Node", "node", "node").Return(nodeLink, nil)
+
+	d := NewCapacityDescriber()
+	got, err := d.Describe(context.Background(), "", describer.Options{Dash: dashConfig, Link: linker})
+	require.NoError(t, err)
+
+	require.Len(t, got.Components, 1)
+	list, ok := got.Components[0].(*component.List)
+	require.True(t, ok)
+	require.Len(t, list.Config.Items, 4)
+
+	expectedStats := component.NewStatGrid("",
+		component.Stat{Label: "Nodes", Value: "1"},
+		component.Stat{Label: "Pods", Value: "1"},
+		component.Stat{Label: "Allocatable CPU", Value: "2"},
+		component.Stat{Label: "Allocatable Memory", Value: "4Gi"},
+	)
+	component.AssertEqual(t, expectedStats, list.Config.Items[0])
+
+	expectedNodes := component.NewTableWithRows("Nodes", "There are no nodes!",
+		component.NewTableCols("Name", "CPU Requests", "CPU Limits", "Memory Requests", "Memory Limits", "Pods"),
+		[]component.TableRow{
+			{
+				"Name":            nodeLink,
+				"CPU Requests":    component.NewProgress("1 / 2", 0.5, 1),
+				"CPU Limits":      component.NewProgress("0 / 2", 0, 1),
+				"Memory Requests": component.NewProgress("1Gi / 4Gi", 0.25, 1),
+				"Memory Limits":   component.NewProgress("0 / 4Gi", 0, 1),
+				"Pods":            component.NewText("1 / 110"),
+			},
+		})
+	component.AssertEqual(t, expectedNodes, list.Config.Items[2])
+
+	expectedNamespaces := component.NewTableWithRows("Namespaces", "There are no pods!",
+		component.NewTableCols("Name", "CPU Requests", "CPU Limits", "Memory Requests", "Memory Limits", "Pods"),
+		[]component.TableRow{
+			{
+				"Name":            component.NewText("namespace"),
+				"CPU Requests":    component.NewText("1"),
+				"CPU Limits":      component.NewText("0"),
+				"Memory Requests": component.NewText("1Gi"),
+				"Memory Limits":   component.NewText("0"),
+				"Pods":            component.NewText("1"),
+			},
+		})
+	component.AssertEqual(t, expectedNamespaces, list.Config.Items[3])
+}
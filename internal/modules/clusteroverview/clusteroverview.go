@@ -19,6 +19,7 @@ import (
 	"github.com/vmware/octant/internal/gvk"
 	"github.com/vmware/octant/internal/link"
 	"github.com/vmware/octant/internal/loading"
+	"github.com/vmware/octant/internal/metrics"
 	"github.com/vmware/octant/internal/module"
 	"github.com/vmware/octant/internal/octant"
 	"github.com/vmware/octant/internal/printer"
@@ -33,6 +34,8 @@ import (
 // Options are options for ClusterOverview.
 type Options struct {
 	DashConfig config.Dash
+	// PrintRegistry holds the handlers objects are printed with.
+	PrintRegistry *printer.Registry
 }
 
 // ClusterOverview is a module for the cluster overview.
@@ -41,6 +44,7 @@ type ClusterOverview struct {
 	Options
 
 	pathMatcher *describer.PathMatcher
+	printer     *printer.Resource
 	watchedCRDs []*unstructured.Unstructured
 
 	mu sync.Mutex
@@ -65,10 +69,17 @@ func New(ctx context.Context, options Options) (*ClusterOverview, error) {
 		return nil, errors.Wrap(err, "create module object path generator")
 	}
 
+	if options.PrintRegistry == nil {
+		return nil, errors.New("print registry is nil")
+	}
+
 	co := &ClusterOverview{
 		ObjectPath:  objectPath,
 		pathMatcher: pathMatcher,
-		Options:     options,
+		printer: printer.NewResource(options.DashConfig,
+			printer.WithRegistry(options.PrintRegistry),
+			printer.WithMetrics(metrics.NewClient(options.DashConfig))),
+		Options: options,
 	}
 
 	crdWatcher := options.DashConfig.CRDWatcher()
@@ -168,11 +179,6 @@ func (co *ClusterOverview) Content(ctx context.Context, contentPath string, opts
 
 	q := queryer.New(objectStore, discoveryInterface)
 
-	p := printer.NewResource(co.DashConfig)
-	if err := printer.AddHandlers(p); err != nil {
-		return component.EmptyContentResponse, errors.Wrap(err, "add print handlers")
-	}
-
 	linkGenerator, err := link.NewFromDashConfig(co.DashConfig)
 	if err != nil {
 		return component.EmptyContentResponse, err
@@ -183,8 +189,10 @@ func (co *ClusterOverview) Content(ctx context.Context, contentPath string, opts
 	options := describer.Options{
 		Queryer:  q,
 		Fields:   pf.Fields(contentPath),
-		Printer:  p,
+		Printer:  co.printer,
 		LabelSet: opts.LabelSet,
+		Page:     opts.Page,
+		Query:    opts.Query,
 		Dash:     co.DashConfig,
 		Link:     linkGenerator,
 
@@ -210,19 +218,31 @@ func (co *ClusterOverview) Navigation(ctx context.Context, namespace string, roo
 			"Custom Resources": "custom-resources",
 			"RBAC":             "rbac",
 			"Nodes":            "nodes",
+			"Namespaces":       "namespaces",
+			"Storage":          "storage",
 			"Port Forwards":    "port-forward",
+			"Inventory":        "inventory",
+			"Capacity":         "capacity",
 		},
 		EntriesFuncs: map[string]octant.EntriesFunc{
 			"Custom Resources": navigation.CRDEntries,
 			"RBAC":             rbacEntries,
 			"Nodes":            nil,
+			"Namespaces":       nil,
+			"Storage":          storageEntries,
 			"Port Forwards":    nil,
+			"Inventory":        nil,
+			"Capacity":         nil,
 		},
 		Order: []string{
 			"Custom Resources",
 			"RBAC",
 			"Nodes",
+			"Namespaces",
+			"Storage",
 			"Port Forwards",
+			"Inventory",
+			"Capacity",
 		},
 	}
 
@@ -256,6 +276,17 @@ func (co *ClusterOverview) Generators() []octant.Generator {
 	return []octant.Generator{}
 }
 
+// ActionPaths contain the actions this module is responsible for.
+func (co *ClusterOverview) ActionPaths() map[string]action.DispatcherFunc {
+	dispatchers := action.Dispatchers{
+		octant.NewNodeCordoner(co.DashConfig.ObjectStore()),
+		octant.NewNodeUncordoner(co.DashConfig.ObjectStore()),
+		octant.NewNodeDrainer(co.DashConfig.ObjectStore(), co.DashConfig.ClusterClient()),
+	}
+
+	return dispatchers.ToActionPaths()
+}
+
 func rbacEntries(ctx context.Context, prefix, namespace string, objectStore store.Store, _ bool) ([]navigation.Navigation, bool, error) {
 	neh := navigation.EntriesHelper{}
 	neh.Add("Cluster Roles", "cluster-roles", icon.ClusterOverviewClusterRole,
@@ -271,6 +302,21 @@ func rbacEntries(ctx context.Context, prefix, namespace string, objectStore stor
 	return children, false, nil
 }
 
+func storageEntries(ctx context.Context, prefix, namespace string, objectStore store.Store, _ bool) ([]navigation.Navigation, bool, error) {
+	neh := navigation.EntriesHelper{}
+	neh.Add("Persistent Volumes", "persistent-volumes", icon.ClusterOverviewPersistentVolume,
+		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.PersistentVolume), objectStore))
+	neh.Add("Storage Classes", "storage-classes", icon.ClusterOverviewStorageClass,
+		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.StorageClass), objectStore))
+
+	children, err := neh.Generate(prefix)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return children, false, nil
+}
+
 func (co *ClusterOverview) SetContext(ctx context.Context, contextName string) error {
 	co.mu.Lock()
 	defer co.mu.Unlock()
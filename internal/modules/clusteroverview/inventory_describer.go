@@ -0,0 +1,149 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clusteroverview
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware/octant/internal/describer"
+	"github.com/vmware/octant/internal/inventory"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// InventoryDescriber describes a census of the objects in the cluster.
+type InventoryDescriber struct {
+}
+
+// NewInventoryDescriber creates an instance of InventoryDescriber.
+func NewInventoryDescriber() *InventoryDescriber {
+	return &InventoryDescriber{}
+}
+
+var _ describer.Describer = (*InventoryDescriber)(nil)
+
+// Describe describes the inventory report as content.
+func (d *InventoryDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	discoveryClient, err := options.Dash.ClusterClient().DiscoveryClient()
+	if err != nil {
+		return component.EmptyContentResponse, errors.Wrap(err, "get discovery client")
+	}
+
+	report, err := inventory.Generate(ctx, options.Dash.ObjectStore(), discoveryClient)
+	if err != nil {
+		return component.EmptyContentResponse, errors.Wrap(err, "generate inventory")
+	}
+
+	list := component.NewList("Inventory", nil)
+
+	summary := component.NewSummary("Summary",
+		component.SummarySection{
+			Header:  "Objects",
+			Content: component.NewText(strconv.Itoa(report.Total())),
+		},
+		component.SummarySection{
+			Header:  "Generated",
+			Content: component.NewTimestamp(report.GeneratedAt),
+		},
+		component.SummarySection{
+			Header: "Export",
+			Content: component.NewList("", []component.Component{
+				component.NewDownloadLink("", "JSON", "inventory?format=json"),
+				component.NewDownloadLink("", "CSV", "inventory?format=csv"),
+			}),
+		},
+	)
+	list.Add(summary)
+
+	list.Add(createInventoryCountsTable(report))
+	list.Add(createInventoryLabelKeysTable(report))
+	list.Add(createInventoryCRDsTable(report))
+
+	if len(report.Errors) > 0 {
+		list.Add(createInventoryErrorsTable(report))
+	}
+
+	return component.ContentResponse{
+		Components: []component.Component{list},
+	}, nil
+}
+
+func createInventoryCountsTable(report *inventory.Report) *component.Table {
+	cols := component.NewTableCols("Namespace", "API Version", "Kind", "Count")
+	table := component.NewTable("Objects", "There are no objects!", cols)
+
+	for _, count := range report.Counts {
+		namespace := count.Namespace
+		if namespace == "" {
+			namespace = "(cluster)"
+		}
+
+		table.Add(component.TableRow{
+			"Namespace":   component.NewText(namespace),
+			"API Version": component.NewText(count.APIVersion),
+			"Kind":        component.NewText(count.Kind),
+			"Count":       component.NewText(strconv.Itoa(count.Count)),
+		})
+	}
+
+	return table
+}
+
+func createInventoryLabelKeysTable(report *inventory.Report) *component.Table {
+	cols := component.NewTableCols("Key", "Objects")
+	table := component.NewTable("Top Label Keys", "There are no labels!", cols)
+
+	for _, labelKey := range report.LabelKeys {
+		table.Add(component.TableRow{
+			"Key":     component.NewText(labelKey.Key),
+			"Objects": component.NewText(strconv.Itoa(labelKey.Count)),
+		})
+	}
+
+	return table
+}
+
+func createInventoryCRDsTable(report *inventory.Report) *component.Table {
+	cols := component.NewTableCols("Name", "Kind", "Objects")
+	table := component.NewTable("Custom Resource Definitions", "There are no custom resource definitions!", cols)
+
+	for _, crd := range report.CRDs {
+		table.Add(component.TableRow{
+			"Name":    component.NewText(crd.Name),
+			"Kind":    component.NewText(fmt.Sprintf("%s.%s", crd.Kind, crd.Group)),
+			"Objects": component.NewText(strconv.Itoa(crd.Count)),
+		})
+	}
+
+	return table
+}
+
+func createInventoryErrorsTable(report *inventory.Report) *component.Table {
+	cols := component.NewTableCols("Error")
+	table := component.NewTable("Not Included", "", cols)
+
+	for _, message := range report.Errors {
+		table.Add(component.TableRow{
+			"Error": component.NewText(message),
+		})
+	}
+
+	return table
+}
+
+// PathFilters returns the path filters for the inventory.
+func (d *InventoryDescriber) PathFilters() []describer.PathFilter {
+	filter := describer.NewPathFilter("/inventory", d)
+	return []describer.PathFilter{*filter}
+}
+
+// Reset does nothing.
+func (d *InventoryDescriber) Reset(ctx context.Context) error {
+	return nil
+}
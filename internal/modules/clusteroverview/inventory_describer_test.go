@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clusteroverview
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	clusterFake "github.com/vmware/octant/internal/cluster/fake"
+	configFake "github.com/vmware/octant/internal/config/fake"
+	"github.com/vmware/octant/internal/describer"
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/store"
+	storeFake "github.com/vmware/octant/pkg/store/fake"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func TestInventoryDescriber_Describe(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	resourceLists := []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Kind: "Node", Verbs: metav1.Verbs{"list"}},
+			},
+		},
+	}
+
+	discoveryClient := clusterFake.NewMockDiscoveryInterface(controller)
+	discoveryClient.EXPECT().ServerPreferredResources().Return(resourceLists, nil)
+
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	clusterClient.EXPECT().DiscoveryClient().Return(discoveryClient, nil)
+
+	node := testutil.CreateNode("node")
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Node"}).
+		Return(testutil.ToUnstructuredList(t, node), false, nil)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: "apiextensions.k8s.io/v1beta1", Kind: "CustomResourceDefinition"}).
+		Return(&unstructured.UnstructuredList{}, false, nil)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ClusterClient().Return(clusterClient)
+	dashConfig.EXPECT().ObjectStore().Return(objectStore)
+
+	d := NewInventoryDescriber()
+	got, err := d.Describe(context.Background(), "", describer.Options{Dash: dashConfig})
+	require.NoError(t, err)
+
+	require.Len(t, got.Components, 1)
+	list, ok := got.Components[0].(*component.List)
+	require.True(t, ok)
+	require.Len(t, list.Config.Items, 4)
+
+	expectedCounts := component.NewTableWithRows("Objects", "There are no objects!",
+		component.NewTableCols("Namespace", "API Version", "Kind", "Count"),
+		[]component.TableRow{
+			{
+				"Namespace":   component.NewText("(cluster)"),
+				"API Version": component.NewText("v1"),
+				"Kind":        component.NewText("Node"),
+				"Count":       component.NewText("1"),
+			},
+		})
+	component.AssertEqual(t, expectedCounts, list.Config.Items[1])
+
+	expectedCRDs := component.NewTable("Custom Resource Definitions", "There are no custom resource definitions!",
+		component.NewTableCols("Name", "Kind", "Objects"))
+	assert.Equal(t, expectedCRDs, list.Config.Items[3])
+}
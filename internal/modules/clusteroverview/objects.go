@@ -8,6 +8,7 @@ package clusteroverview
 import (
 	v1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	storagev1 "k8s.io/api/storage/v1"
 
 	"github.com/vmware/octant/internal/describer"
 	"github.com/vmware/octant/pkg/icon"
@@ -58,14 +59,59 @@ var (
 		IconName:              icon.ClusterOverviewNode,
 	})
 
+	namespacesDescriber = describer.NewResource(describer.ResourceOptions{
+		Path:           "/namespaces",
+		ObjectStoreKey: store.Key{APIVersion: "v1", Kind: "Namespace"},
+		ListType:       &v1.NamespaceList{},
+		ObjectType:     &v1.Namespace{},
+		Titles:         describer.ResourceTitle{List: "Namespaces", Object: "Namespace"},
+		ClusterWide:    true,
+		IconName:       icon.ClusterOverviewNamespace,
+	})
+
+	storagePersistentVolumes = describer.NewResource(describer.ResourceOptions{
+		Path:           "/storage/persistent-volumes",
+		ObjectStoreKey: store.Key{APIVersion: "v1", Kind: "PersistentVolume"},
+		ListType:       &v1.PersistentVolumeList{},
+		ObjectType:     &v1.PersistentVolume{},
+		Titles:         describer.ResourceTitle{List: "Storage / Persistent Volumes", Object: "Persistent Volume"},
+		ClusterWide:    true,
+		IconName:       icon.ClusterOverviewPersistentVolume,
+	})
+
+	storageStorageClasses = describer.NewResource(describer.ResourceOptions{
+		Path:           "/storage/storage-classes",
+		ObjectStoreKey: store.Key{APIVersion: "storage.k8s.io/v1", Kind: "StorageClass"},
+		ListType:       &storagev1.StorageClassList{},
+		ObjectType:     &storagev1.StorageClass{},
+		Titles:         describer.ResourceTitle{List: "Storage / Storage Classes", Object: "Storage Class"},
+		ClusterWide:    true,
+		IconName:       icon.ClusterOverviewStorageClass,
+	})
+
+	storageDescriber = describer.NewSection(
+		"/storage",
+		"Storage",
+		storagePersistentVolumes,
+		storageStorageClasses,
+	)
+
 	portForwardDescriber = NewPortForwardListDescriber()
 
+	inventoryDescriber = NewInventoryDescriber()
+
+	capacityDescriber = NewCapacityDescriber()
+
 	rootDescriber = describer.NewSection(
 		"/",
 		"Cluster Overview",
 		customResourcesDescriber,
 		rbacDescriber,
 		nodesDescriber,
+		namespacesDescriber,
+		storageDescriber,
 		portForwardDescriber,
+		inventoryDescriber,
+		capacityDescriber,
 	)
 )
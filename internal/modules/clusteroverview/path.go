@@ -18,11 +18,17 @@ var (
 	supportedGVKs = []schema.GroupVersionKind{
 		gvk.ClusterRoleBinding,
 		gvk.ClusterRole,
+		gvk.Namespace,
 		gvk.Node,
+		gvk.PersistentVolume,
+		gvk.StorageClass,
 	}
 )
 
-const rbacAPIVersion = "rbac.authorization.k8s.io/v1"
+const (
+	rbacAPIVersion    = "rbac.authorization.k8s.io/v1"
+	storageAPIVersion = "storage.k8s.io/v1"
+)
 
 func crdPath(namespace, crdName, name string) (string, error) {
 	return path.Join("/cluster-overview/custom-resources", crdName, name), nil
@@ -38,6 +44,12 @@ func gvkPath(namespace, apiVersion, kind, name string) (string, error) {
 		p = "/rbac/cluster-role-bindings"
 	case apiVersion == "v1" && kind == "Node":
 		p = "/nodes"
+	case apiVersion == "v1" && kind == "Namespace":
+		p = "/namespaces"
+	case apiVersion == "v1" && kind == "PersistentVolume":
+		p = "/storage/persistent-volumes"
+	case apiVersion == storageAPIVersion && kind == "StorageClass":
+		p = "/storage/storage-classes"
 	default:
 		return "", errors.Errorf("unknown object %s %s", apiVersion, kind)
 	}
@@ -44,6 +44,27 @@ func Test_gvk_path(t *testing.T) {
 			objectName: "cluster-role-binding",
 			expected:   path.Join("/cluster-overview", "rbac", "cluster-role-bindings", "cluster-role-binding"),
 		},
+		{
+			name:       "Namespace",
+			apiVersion: "v1",
+			kind:       "Namespace",
+			objectName: "default",
+			expected:   path.Join("/cluster-overview", "namespaces", "default"),
+		},
+		{
+			name:       "PersistentVolume",
+			apiVersion: "v1",
+			kind:       "PersistentVolume",
+			objectName: "pv",
+			expected:   path.Join("/cluster-overview", "storage", "persistent-volumes", "pv"),
+		},
+		{
+			name:       "StorageClass",
+			apiVersion: storageAPIVersion,
+			kind:       "StorageClass",
+			objectName: "standard",
+			expected:   path.Join("/cluster-overview", "storage", "storage-classes", "standard"),
+		},
 		{
 			name:       "unknown",
 			apiVersion: "unknown",
@@ -0,0 +1,73 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/vmware/octant/internal/audit"
+	"github.com/vmware/octant/internal/describer"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// AuditLogDescriber describes the actions run from the dashboard.
+type AuditLogDescriber struct {
+	auditLog *audit.Log
+}
+
+var _ describer.Describer = (*AuditLogDescriber)(nil)
+
+// NewAuditLogDescriber creates an instance of AuditLogDescriber.
+func NewAuditLogDescriber(auditLog *audit.Log) *AuditLogDescriber {
+	return &AuditLogDescriber{
+		auditLog: auditLog,
+	}
+}
+
+// Describe describes the audit log, newest entries first.
+func (d *AuditLogDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	list := component.NewList("Audit Log", nil)
+	tableCols := component.NewTableCols("Time", "User", "Address", "Action", "Object", "Result", "Message", "Payload")
+	tbl := component.NewTable("Audit Log", "No actions have been run!", tableCols)
+	list.Add(tbl)
+
+	for _, entry := range d.auditLog.List() {
+		user := entry.User
+		if user == "" {
+			user = "anonymous"
+		}
+
+		payload, err := json.Marshal(entry.Payload)
+		if err != nil {
+			return component.EmptyContentResponse, err
+		}
+
+		tbl.Add(component.TableRow{
+			"Time":    component.NewTimestamp(entry.Time),
+			"User":    component.NewText(user),
+			"Address": component.NewText(entry.RemoteAddr),
+			"Action":  component.NewText(entry.Action),
+			"Object":  component.NewText(entry.ObjectName()),
+			"Result":  component.NewText(string(entry.Result)),
+			"Message": component.NewText(entry.Message),
+			"Payload": component.NewText(string(payload)),
+		})
+	}
+
+	return component.ContentResponse{
+		Components: []component.Component{list},
+	}, nil
+}
+
+func (d *AuditLogDescriber) PathFilters() []describer.PathFilter {
+	filter := describer.NewPathFilter("/audit-log", d)
+	return []describer.PathFilter{*filter}
+}
+
+func (d *AuditLogDescriber) Reset(ctx context.Context) error {
+	return nil
+}
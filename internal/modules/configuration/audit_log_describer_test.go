@@ -0,0 +1,60 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/octant/internal/audit"
+	"github.com/vmware/octant/internal/describer"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func TestAuditLogDescriber(t *testing.T) {
+	now := time.Unix(1547211430, 0)
+
+	auditLog := audit.NewLog(audit.DefaultSize)
+	auditLog.Add(audit.Entry{
+		Time:       now,
+		SessionID:  "id",
+		RemoteAddr: "127.0.0.1:5000",
+		Action:     "overview/deleteObject",
+		Object:     store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Name: "pod"},
+		Payload:    action.Payload{"kind": "Pod", "name": "pod"},
+		Result:     audit.ResultSucceeded,
+		Message:    "Deleted Pod \"pod\"",
+	})
+
+	d := NewAuditLogDescriber(auditLog)
+
+	ctx := context.Background()
+	cResponse, err := d.Describe(ctx, "", describer.Options{})
+	require.NoError(t, err)
+
+	list := component.NewList("Audit Log", nil)
+	tableCols := component.NewTableCols("Time", "User", "Address", "Action", "Object", "Result", "Message", "Payload")
+	table := component.NewTable("Audit Log", "No actions have been run!", tableCols)
+	table.Add(component.TableRow{
+		"Time":    component.NewTimestamp(now),
+		"User":    component.NewText("anonymous"),
+		"Address": component.NewText("127.0.0.1:5000"),
+		"Action":  component.NewText("overview/deleteObject"),
+		"Object":  component.NewText("Pod default/pod"),
+		"Result":  component.NewText("Succeeded"),
+		"Message": component.NewText("Deleted Pod \"pod\""),
+		"Payload": component.NewText(`{"kind":"Pod","name":"pod"}`),
+	})
+	list.Add(table)
+
+	require.Len(t, cResponse.Components, 1)
+	component.AssertEqual(t, list, cResponse.Components[0])
+}
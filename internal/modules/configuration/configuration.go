@@ -14,11 +14,13 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/vmware/octant/internal/api"
+	"github.com/vmware/octant/internal/audit"
 	"github.com/vmware/octant/internal/config"
 	"github.com/vmware/octant/internal/describer"
 	"github.com/vmware/octant/internal/event"
 	"github.com/vmware/octant/internal/module"
 	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/internal/session"
 	"github.com/vmware/octant/pkg/action"
 	"github.com/vmware/octant/pkg/icon"
 	"github.com/vmware/octant/pkg/navigation"
@@ -28,6 +30,10 @@ import (
 type Options struct {
 	DashConfig     config.Dash
 	KubeConfigPath string
+	// Sessions tracks the clients connected to the dashboard.
+	Sessions *session.Registry
+	// AuditLog records the actions run from the dashboard.
+	AuditLog *audit.Log
 }
 
 type Configuration struct {
@@ -41,8 +47,16 @@ var _ module.Module = (*Configuration)(nil)
 var _ module.ActionReceiver = (*Configuration)(nil)
 
 func New(ctx context.Context, options Options) *Configuration {
+	if options.Sessions == nil {
+		options.Sessions = session.NewRegistry()
+	}
+
+	if options.AuditLog == nil {
+		options.AuditLog = audit.NewLog(audit.DefaultSize)
+	}
+
 	pm := describer.NewPathMatcher("configuration")
-	for _, pf := range rootDescriber.PathFilters() {
+	for _, pf := range newRootDescriber(options.Sessions, options.AuditLog).PathFilters() {
 		pm.Register(ctx, pf)
 	}
 
@@ -77,6 +91,8 @@ func (c *Configuration) Content(ctx context.Context, contentPath string, opts mo
 	options := describer.Options{
 		Fields:   pf.Fields(contentPath),
 		LabelSet: opts.LabelSet,
+		Page:     opts.Page,
+		Query:    opts.Query,
 		Dash:     c.DashConfig,
 	}
 
@@ -104,6 +120,21 @@ func (c *Configuration) Navigation(ctx context.Context, namespace, root string)
 					Path:     path.Join(c.ContentPath(), "plugins"),
 					IconName: icon.ConfigurationPlugin,
 				},
+				{
+					Title:    "Sessions",
+					Path:     path.Join(c.ContentPath(), "sessions"),
+					IconName: icon.ConfigurationSessions,
+				},
+				{
+					Title:    "Port Forwards",
+					Path:     path.Join(c.ContentPath(), "port-forwards"),
+					IconName: icon.ConfigurationPortForwards,
+				},
+				{
+					Title:    "Audit Log",
+					Path:     path.Join(c.ContentPath(), "audit-log"),
+					IconName: icon.ConfigurationAuditLog,
+				},
 			},
 		},
 	}, nil
@@ -149,8 +180,16 @@ func (c Configuration) Generators() []octant.Generator {
 
 func (c *Configuration) ActionPaths() map[string]action.DispatcherFunc {
 	objectDeleter := NewObjectDeleter(c.DashConfig.Logger(), c.DashConfig.ObjectStore())
+	sessionTerminator := NewSessionTerminator(c.DashConfig.Logger(), c.Sessions)
+	portForwardStopper := NewPortForwardStopper(c.DashConfig.Logger(), c.DashConfig.PortForwarder())
+	pluginRestarter := NewPluginRestarter(c.DashConfig.Logger(), c.DashConfig.PluginManager())
+	pluginDisabler := NewPluginDisabler(c.DashConfig.Logger(), c.DashConfig.PluginManager())
 
 	return map[string]action.DispatcherFunc{
-		objectDeleter.ActionName(): objectDeleter.Handle,
+		objectDeleter.ActionName():      objectDeleter.Handle,
+		sessionTerminator.ActionName():  sessionTerminator.Handle,
+		portForwardStopper.ActionName(): portForwardStopper.Handle,
+		pluginRestarter.ActionName():    pluginRestarter.Handle,
+		pluginDisabler.ActionName():     pluginDisabler.Handle,
 	}
 }
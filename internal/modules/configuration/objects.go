@@ -5,14 +5,24 @@ SPDX-License-Identifier: Apache-2.0
 
 package configuration
 
-import "github.com/vmware/octant/internal/describer"
+import (
+	"github.com/vmware/octant/internal/audit"
+	"github.com/vmware/octant/internal/describer"
+	"github.com/vmware/octant/internal/session"
+)
 
 var (
 	pluginDescriber = &PluginListDescriber{}
+)
 
-	rootDescriber = describer.NewSection(
+func newRootDescriber(sessions *session.Registry, auditLog *audit.Log) *describer.Section {
+	return describer.NewSection(
 		"/",
 		"Configuration",
 		pluginDescriber,
+		NewPluginDescriber(),
+		NewSessionListDescriber(sessions),
+		NewPortForwardListDescriber(),
+		NewAuditLogDescriber(auditLog),
 	)
-)
+}
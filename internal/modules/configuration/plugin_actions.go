@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/plugin"
+)
+
+// PluginRestarter restarts plugins.
+type PluginRestarter struct {
+	logger        log.Logger
+	pluginManager plugin.ManagerInterface
+}
+
+// NewPluginRestarter creates an instance of PluginRestarter.
+func NewPluginRestarter(logger log.Logger, pluginManager plugin.ManagerInterface) *PluginRestarter {
+	return &PluginRestarter{
+		logger:        logger.With("action", octant.ActionRestartPlugin),
+		pluginManager: pluginManager,
+	}
+}
+
+// ActionName returns the name of the action.
+func (r *PluginRestarter) ActionName() string {
+	return octant.ActionRestartPlugin
+}
+
+// Handle restarts the plugin in the payload. Disabled plugins are enabled.
+func (r *PluginRestarter) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	name, err := payload.String("name")
+	if err != nil {
+		return err
+	}
+
+	r.logger.With("plugin-name", name).Infof("restarting plugin")
+
+	alertType := action.AlertTypeInfo
+	message := fmt.Sprintf("Restarted plugin %q", name)
+	if err := r.pluginManager.Restart(ctx, name); err != nil {
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to restart plugin: %s", err)
+	}
+
+	alert := action.CreateAlert(alertType, message, action.DefaultAlertExpiration)
+	alerter.SendAlert(alert)
+
+	return nil
+}
+
+// PluginDisabler disables plugins.
+type PluginDisabler struct {
+	logger        log.Logger
+	pluginManager plugin.ManagerInterface
+}
+
+// NewPluginDisabler creates an instance of PluginDisabler.
+func NewPluginDisabler(logger log.Logger, pluginManager plugin.ManagerInterface) *PluginDisabler {
+	return &PluginDisabler{
+		logger:        logger.With("action", octant.ActionDisablePlugin),
+		pluginManager: pluginManager,
+	}
+}
+
+// ActionName returns the name of the action.
+func (d *PluginDisabler) ActionName() string {
+	return octant.ActionDisablePlugin
+}
+
+// Handle disables the plugin in the payload.
+func (d *PluginDisabler) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	name, err := payload.String("name")
+	if err != nil {
+		return err
+	}
+
+	d.logger.With("plugin-name", name).Infof("disabling plugin")
+
+	alertType := action.AlertTypeInfo
+	message := fmt.Sprintf("Disabled plugin %q", name)
+	if err := d.pluginManager.Disable(ctx, name); err != nil {
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to disable plugin: %s", err)
+	}
+
+	alert := action.CreateAlert(alertType, message, action.DefaultAlertExpiration)
+	alerter.SendAlert(alert)
+
+	return nil
+}
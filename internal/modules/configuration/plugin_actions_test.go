@@ -0,0 +1,126 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/pkg/action"
+	actionFake "github.com/vmware/octant/pkg/action/fake"
+	pluginFake "github.com/vmware/octant/pkg/plugin/fake"
+)
+
+func TestPluginRestarter_ActionName(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	r := NewPluginRestarter(log.NopLogger(), pluginFake.NewMockManagerInterface(controller))
+	require.Equal(t, octant.ActionRestartPlugin, r.ActionName())
+}
+
+func TestPluginRestarter_Handle(t *testing.T) {
+	tests := []struct {
+		name            string
+		restartErr      error
+		expectedType    action.AlertType
+		expectedMessage string
+	}{
+		{
+			name:            "restart plugin",
+			expectedType:    action.AlertTypeInfo,
+			expectedMessage: `Restarted plugin "plugin1"`,
+		},
+		{
+			name:            "restart fails",
+			restartErr:      errors.New(`plugin "plugin1" is not loaded`),
+			expectedType:    action.AlertTypeWarning,
+			expectedMessage: `Unable to restart plugin: plugin "plugin1" is not loaded`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			pluginManager := pluginFake.NewMockManagerInterface(controller)
+			pluginManager.EXPECT().Restart(gomock.Any(), "plugin1").Return(test.restartErr)
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				DoAndReturn(func(alert action.Alert) {
+					assert.Equal(t, test.expectedType, alert.Type)
+					assert.Equal(t, test.expectedMessage, alert.Message)
+				})
+
+			r := NewPluginRestarter(log.NopLogger(), pluginManager)
+
+			err := r.Handle(context.Background(), alerter, action.Payload{"name": "plugin1"})
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPluginDisabler_ActionName(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	d := NewPluginDisabler(log.NopLogger(), pluginFake.NewMockManagerInterface(controller))
+	require.Equal(t, octant.ActionDisablePlugin, d.ActionName())
+}
+
+func TestPluginDisabler_Handle(t *testing.T) {
+	tests := []struct {
+		name            string
+		disableErr      error
+		expectedType    action.AlertType
+		expectedMessage string
+	}{
+		{
+			name:            "disable plugin",
+			expectedType:    action.AlertTypeInfo,
+			expectedMessage: `Disabled plugin "plugin1"`,
+		},
+		{
+			name:            "disable fails",
+			disableErr:      errors.New(`plugin "plugin1" is not loaded`),
+			expectedType:    action.AlertTypeWarning,
+			expectedMessage: `Unable to disable plugin: plugin "plugin1" is not loaded`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			pluginManager := pluginFake.NewMockManagerInterface(controller)
+			pluginManager.EXPECT().Disable(gomock.Any(), "plugin1").Return(test.disableErr)
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				DoAndReturn(func(alert action.Alert) {
+					assert.Equal(t, test.expectedType, alert.Type)
+					assert.Equal(t, test.expectedMessage, alert.Message)
+				})
+
+			d := NewPluginDisabler(log.NopLogger(), pluginManager)
+
+			err := d.Handle(context.Background(), alerter, action.Payload{"name": "plugin1"})
+			require.NoError(t, err)
+		})
+	}
+}
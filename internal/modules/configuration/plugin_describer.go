@@ -8,15 +8,20 @@ package configuration
 import (
 	"context"
 	"fmt"
+	"path"
 	"strings"
 
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/vmware/octant/internal/describer"
+	dashPlugin "github.com/vmware/octant/pkg/plugin"
 	"github.com/vmware/octant/pkg/view/component"
 )
 
+// pluginsPath is the path of the plugin list.
+const pluginsPath = "/configuration/plugins"
+
 // PluginListDescriber describes a list of plugins
 type PluginListDescriber struct {
 }
@@ -28,11 +33,14 @@ func (d *PluginListDescriber) Describe(ctx context.Context, namespace string, op
 	pluginStore := options.PluginManager().Store()
 
 	list := component.NewList("Plugins", nil)
-	tableCols := component.NewTableCols("Name", "Description", "Capabilities")
+	tableCols := component.NewTableCols("Name", "Description", "Capabilities", "Status", "Errors", "Last Error")
 	tbl := component.NewTable("Plugins", "There are no plugins!", tableCols)
 	list.Add(tbl)
 
-	for _, n := range pluginStore.ClientNames() {
+	statuses := pluginStore.Statuses()
+	clientNames := pluginStore.ClientNames()
+
+	for _, n := range clientNames {
 		metadata, err := pluginStore.GetMetadata(n)
 		if err != nil {
 			return component.EmptyContentResponse, errors.New("metadata is nil")
@@ -48,18 +56,7 @@ func (d *PluginListDescriber) Describe(ctx context.Context, namespace string, op
 				strings.Join(actionNames, ", ")))
 		}
 
-		in := []struct {
-			name string
-			list []schema.GroupVersionKind
-		}{
-			{name: "Object Status", list: metadata.Capabilities.SupportsObjectStatus},
-			{name: "Printer Config", list: metadata.Capabilities.SupportsPrinterConfig},
-			{name: "Printer Items", list: metadata.Capabilities.SupportsPrinterItems},
-			{name: "Printer Status", list: metadata.Capabilities.SupportsPrinterStatus},
-			{name: "Tab", list: metadata.Capabilities.SupportsTab},
-		}
-
-		for _, item := range in {
+		for _, item := range supportLists(metadata.Capabilities) {
 			support, ok := summarizeSupports(item.name, item.list)
 			if ok {
 				summaryItems = append(summaryItems, support)
@@ -75,10 +72,25 @@ func (d *PluginListDescriber) Describe(ctx context.Context, namespace string, op
 		}
 
 		row := component.TableRow{
-			"Name":         component.NewText(metadata.Name),
+			"Name":         pluginLink(n, metadata.Name),
 			"Description":  component.NewText(metadata.Description),
 			"Capabilities": component.NewText(sb.String()),
 		}
+		addPluginStatus(row, statuses[n])
+		tbl.Add(row)
+
+		delete(statuses, n)
+	}
+
+	// Plugins which are not registered, e.g. because they failed to
+	// start, only have a status.
+	for n, status := range statuses {
+		row := component.TableRow{
+			"Name":         pluginLink(n, n),
+			"Description":  component.NewText(""),
+			"Capabilities": component.NewText(""),
+		}
+		addPluginStatus(row, status)
 		tbl.Add(row)
 	}
 
@@ -102,18 +114,65 @@ func NewPluginListDescriber() *PluginListDescriber {
 	return &PluginListDescriber{}
 }
 
+// pluginLink links to the detail page of a plugin.
+func pluginLink(name, title string) *component.Link {
+	return component.NewLink("", title, path.Join(pluginsPath, name))
+}
+
+// addPluginStatus adds the lifecycle status of a plugin to its row.
+func addPluginStatus(row component.TableRow, status dashPlugin.Status) {
+	row["Status"] = pluginStatus(status)
+	row["Errors"] = component.NewText(fmt.Sprintf("%d", status.Errors))
+	row["Last Error"] = component.NewText(status.LastError)
+}
+
+// pluginStatus describes the lifecycle state of a plugin.
+func pluginStatus(status dashPlugin.Status) component.Component {
+	state := status.State
+	if state == "" {
+		state = dashPlugin.StateRunning
+	}
+
+	text := string(state)
+	if status.Restarts > 0 {
+		text = fmt.Sprintf("%s (restarts: %d)", text, status.Restarts)
+	}
+
+	return component.NewText(text)
+}
+
+type supportList struct {
+	name string
+	list []schema.GroupVersionKind
+}
+
+// supportLists returns the resources a plugin supports for each of its
+// capabilities.
+func supportLists(capabilities dashPlugin.Capabilities) []supportList {
+	return []supportList{
+		{name: "Object Status", list: capabilities.SupportsObjectStatus},
+		{name: "Printer Config", list: capabilities.SupportsPrinterConfig},
+		{name: "Printer Items", list: capabilities.SupportsPrinterItems},
+		{name: "Printer Status", list: capabilities.SupportsPrinterStatus},
+		{name: "Tab", list: capabilities.SupportsTab},
+	}
+}
+
 func summarizeSupports(name string, list []schema.GroupVersionKind) (string, bool) {
 	if len(list) < 1 {
 		return "", false
 	}
 
+	return fmt.Sprintf("%s: %s", name, gvkList(list)), true
+}
+
+// gvkList describes a list of resources, e.g. "v1 Pod, apps/v1 Deployment".
+func gvkList(list []schema.GroupVersionKind) string {
 	var items []string
 	for _, groupVersionKind := range list {
 		apiVersion, kind := groupVersionKind.ToAPIVersionAndKind()
 		items = append(items, fmt.Sprintf("%s %s", apiVersion, kind))
 	}
 
-	return fmt.Sprintf("%s: %s",
-		name, strings.Join(items, ", "),
-	), true
+	return strings.Join(items, ", ")
 }
@@ -46,6 +46,12 @@ func TestPluginDescriber(t *testing.T) {
 	store := dashPlugin.NewDefaultStore()
 	client := newFakePluginClient(name, controller)
 	require.NoError(t, store.Store(name, client, metadata, "cmd"))
+	store.SetStatus("crashed", dashPlugin.Status{
+		State:     dashPlugin.StateRestarting,
+		Restarts:  2,
+		Errors:    1,
+		LastError: "exit status 1",
+	})
 
 	pluginManager := pluginFake.NewMockManagerInterface(controller)
 	pluginManager.EXPECT().Store().Return(store).AnyTimes()
@@ -66,12 +72,23 @@ func TestPluginDescriber(t *testing.T) {
 	capabilitiesData := "[Module], [Actions: action], [Object Status: v1 Pod], [Printer Config: v1 Pod], [Printer Items: v1 Pod], [Printer Status: v1 Pod], [Tab: v1 Pod]"
 
 	list := component.NewList("Plugins", nil)
-	tableCols := component.NewTableCols("Name", "Description", "Capabilities")
+	tableCols := component.NewTableCols("Name", "Description", "Capabilities", "Status", "Errors", "Last Error")
 	table := component.NewTable("Plugins", "There are no plugins!", tableCols)
 	table.Add(component.TableRow{
-		"Name":         component.NewText(name),
+		"Name":         component.NewLink("", "crashed", "/configuration/plugins/crashed"),
+		"Description":  component.NewText(""),
+		"Capabilities": component.NewText(""),
+		"Status":       component.NewText("Restarting (restarts: 2)"),
+		"Errors":       component.NewText("1"),
+		"Last Error":   component.NewText("exit status 1"),
+	})
+	table.Add(component.TableRow{
+		"Name":         component.NewLink("", name, "/configuration/plugins/"+name),
 		"Description":  component.NewText("this is a test"),
 		"Capabilities": component.NewText(capabilitiesData),
+		"Status":       component.NewText("Running"),
+		"Errors":       component.NewText("0"),
+		"Last Error":   component.NewText(""),
 	})
 
 	list.Add(table)
@@ -0,0 +1,189 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware/octant/internal/api"
+	"github.com/vmware/octant/internal/describer"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/pkg/action"
+	dashPlugin "github.com/vmware/octant/pkg/plugin"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// PluginDescriber describes a plugin: its capabilities, its health, and
+// actions which restart or disable it.
+type PluginDescriber struct {
+}
+
+var _ describer.Describer = (*PluginDescriber)(nil)
+
+// NewPluginDescriber creates an instance of PluginDescriber.
+func NewPluginDescriber() *PluginDescriber {
+	return &PluginDescriber{}
+}
+
+// Describe describes the plugin named in the path.
+func (d *PluginDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	name := options.Fields["name"]
+	pluginStore := options.PluginManager().Store()
+
+	status, hasStatus := pluginStore.Statuses()[name]
+	metadata, err := pluginStore.GetMetadata(name)
+	if err != nil {
+		if !hasStatus {
+			return component.EmptyContentResponse, api.NewNotFoundError(path.Join("plugins", name))
+		}
+
+		// plugins which are not running only have a status.
+		metadata = &dashPlugin.Metadata{Name: name}
+	}
+
+	command, _ := pluginStore.GetCommand(name)
+
+	// the API version is only known for plugins which registered.
+	apiVersion := ""
+	if metadata.APIVersion > 0 {
+		apiVersion = fmt.Sprintf("%d", metadata.APIVersion)
+	}
+
+	summary := component.NewSummary("Plugin", []component.SummarySection{
+		{Header: "Name", Content: component.NewText(metadata.Name)},
+		{Header: "Description", Content: component.NewText(metadata.Description)},
+		{Header: "Command", Content: component.NewText(command)},
+		{Header: "API Version", Content: component.NewText(apiVersion)},
+		{Header: "Status", Content: pluginStatus(status)},
+		{Header: "Errors", Content: component.NewText(fmt.Sprintf("%d", status.Errors))},
+		{Header: "Last Error", Content: component.NewText(status.LastError)},
+	}...)
+
+	layout := component.NewFlexLayout("")
+	layout.SetButtonGroup(pluginButtons(name, status))
+	layout.AddSections(
+		component.FlexLayoutSection{
+			{Width: component.WidthHalf, View: summary},
+			{Width: component.WidthHalf, View: pluginCapabilities(metadata.Capabilities)},
+		},
+		component.FlexLayoutSection{
+			{Width: component.WidthFull, View: pluginHealthHistory(status.History)},
+		},
+	)
+
+	return component.ContentResponse{
+		Title: component.Title(
+			component.NewLink("", "Plugins", pluginsPath),
+			component.NewText(name)),
+		Components: []component.Component{layout},
+	}, nil
+}
+
+// PathFilters returns the path filter for a plugin. The path for a plugin
+// is /plugins/name.
+func (d *PluginDescriber) PathFilters() []describer.PathFilter {
+	filter := describer.NewPathFilter("/plugins/(?P<name>[^/]+)", d)
+	return []describer.PathFilter{*filter}
+}
+
+// Reset does nothing.
+func (d *PluginDescriber) Reset(ctx context.Context) error {
+	return nil
+}
+
+// pluginButtons creates the buttons which restart or disable a plugin.
+// Disabled plugins can be enabled, which restarts them.
+func pluginButtons(name string, status dashPlugin.Status) *component.ButtonGroup {
+	payload := action.Payload{"name": name}
+
+	buttonGroup := component.NewButtonGroup()
+	if status.State == dashPlugin.StateDisabled {
+		buttonGroup.AddButton(component.NewButton("Enable",
+			action.CreatePayload(octant.ActionRestartPlugin, payload)))
+		return buttonGroup
+	}
+
+	buttonGroup.AddButton(component.NewButton("Restart",
+		action.CreatePayload(octant.ActionRestartPlugin, payload)))
+	buttonGroup.AddButton(component.NewButton("Disable",
+		action.CreatePayload(octant.ActionDisablePlugin, payload),
+		component.WithButtonConfirmation(
+			"Disable Plugin",
+			fmt.Sprintf("Are you sure you want to disable plugin **%s**? It won't run until it is enabled.", name)),
+		component.WithButtonDanger(component.DangerLevelWarning)))
+
+	return buttonGroup
+}
+
+// pluginCapabilities lists what a plugin can do, and the resources it
+// supports.
+func pluginCapabilities(capabilities dashPlugin.Capabilities) *component.Table {
+	cols := component.NewTableCols("Capability", "Resources")
+	table := component.NewTable("Capabilities", "This plugin has no capabilities", cols)
+
+	if capabilities.IsModule {
+		table.Add(component.TableRow{
+			"Capability": component.NewText("Module"),
+			"Resources":  component.NewText(""),
+		})
+	}
+
+	if actionNames := capabilities.ActionNames; len(actionNames) > 0 {
+		table.Add(component.TableRow{
+			"Capability": component.NewText("Actions"),
+			"Resources":  component.NewText(strings.Join(actionNames, ", ")),
+		})
+	}
+
+	for _, item := range supportLists(capabilities) {
+		if len(item.list) > 0 {
+			table.Add(component.TableRow{
+				"Capability": component.NewText(item.name),
+				"Resources":  component.NewText(gvkList(item.list)),
+			})
+		}
+	}
+
+	var relationships []string
+	for _, relationship := range capabilities.Relationships {
+		source := gvkList([]schema.GroupVersionKind{relationship.Source})
+		target := gvkList([]schema.GroupVersionKind{relationship.Target})
+		relationships = append(relationships,
+			fmt.Sprintf("%s to %s (%s)", source, target, relationship.Path))
+	}
+
+	if len(relationships) > 0 {
+		table.Add(component.TableRow{
+			"Capability": component.NewText("Relationships"),
+			"Resources":  component.NewText(strings.Join(relationships, ", ")),
+		})
+	}
+
+	return table
+}
+
+// pluginHealthHistory lists a plugin's changes of state and errors, newest
+// first.
+func pluginHealthHistory(history []dashPlugin.HealthEvent) *component.Table {
+	cols := component.NewTableCols("Time", "State", "Error")
+	table := component.NewTable("Health History", "This plugin has no health history", cols)
+
+	for i := len(history) - 1; i >= 0; i-- {
+		event := history[i]
+		table.Add(component.TableRow{
+			"Time":  component.NewTimestamp(event.Time),
+			"State": component.NewText(string(event.State)),
+			"Error": component.NewText(event.Error),
+		})
+	}
+
+	return table
+}
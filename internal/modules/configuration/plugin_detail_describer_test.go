@@ -0,0 +1,209 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware/octant/internal/api"
+	configFake "github.com/vmware/octant/internal/config/fake"
+	"github.com/vmware/octant/internal/describer"
+	"github.com/vmware/octant/internal/gvk"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/pkg/action"
+	dashPlugin "github.com/vmware/octant/pkg/plugin"
+	pluginFake "github.com/vmware/octant/pkg/plugin/fake"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func TestPluginDescriber_Describe(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	now := time.Unix(1547211430, 0)
+	metadata := &dashPlugin.Metadata{
+		Name:        "plugin1",
+		Description: "a plugin",
+		Capabilities: dashPlugin.Capabilities{
+			SupportsPrinterConfig: []schema.GroupVersionKind{gvk.Pod},
+			ActionNames:           []string{"plugin1/action"},
+			Relationships: []dashPlugin.Relationship{
+				{Source: gvk.Pod, Target: gvk.Secret, Path: ".spec.volumes"},
+			},
+		},
+		APIVersion: 2,
+	}
+	status := dashPlugin.Status{
+		State:     dashPlugin.StateRunning,
+		Restarts:  1,
+		Errors:    1,
+		LastError: "exit status 1",
+		History: []dashPlugin.HealthEvent{
+			{Time: now, State: dashPlugin.StateRestarting, Error: "exit status 1"},
+			{Time: now.Add(time.Minute), State: dashPlugin.StateRunning},
+		},
+	}
+
+	store := pluginFake.NewMockManagerStore(controller)
+	store.EXPECT().Statuses().Return(map[string]dashPlugin.Status{"plugin1": status})
+	store.EXPECT().GetMetadata("plugin1").Return(metadata, nil)
+	store.EXPECT().GetCommand("plugin1").Return("/plugins/plugin1", nil)
+
+	pluginManager := pluginFake.NewMockManagerInterface(controller)
+	pluginManager.EXPECT().Store().Return(store)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().PluginManager().Return(pluginManager)
+
+	d := NewPluginDescriber()
+	options := describer.Options{
+		Dash:   dashConfig,
+		Fields: map[string]string{"name": "plugin1"},
+	}
+
+	got, err := d.Describe(context.Background(), "", options)
+	require.NoError(t, err)
+
+	summary := component.NewSummary("Plugin", []component.SummarySection{
+		{Header: "Name", Content: component.NewText("plugin1")},
+		{Header: "Description", Content: component.NewText("a plugin")},
+		{Header: "Command", Content: component.NewText("/plugins/plugin1")},
+		{Header: "API Version", Content: component.NewText("2")},
+		{Header: "Status", Content: component.NewText("Running (restarts: 1)")},
+		{Header: "Errors", Content: component.NewText("1")},
+		{Header: "Last Error", Content: component.NewText("exit status 1")},
+	}...)
+
+	capabilities := component.NewTable("Capabilities", "This plugin has no capabilities",
+		component.NewTableCols("Capability", "Resources"))
+	capabilities.Add(
+		component.TableRow{
+			"Capability": component.NewText("Actions"),
+			"Resources":  component.NewText("plugin1/action"),
+		},
+		component.TableRow{
+			"Capability": component.NewText("Printer Config"),
+			"Resources":  component.NewText("v1 Pod"),
+		},
+		component.TableRow{
+			"Capability": component.NewText("Relationships"),
+			"Resources":  component.NewText("v1 Pod to v1 Secret (.spec.volumes)"),
+		},
+	)
+
+	history := component.NewTable("Health History", "This plugin has no health history",
+		component.NewTableCols("Time", "State", "Error"))
+	history.Add(
+		component.TableRow{
+			"Time":  component.NewTimestamp(now.Add(time.Minute)),
+			"State": component.NewText("Running"),
+			"Error": component.NewText(""),
+		},
+		component.TableRow{
+			"Time":  component.NewTimestamp(now),
+			"State": component.NewText("Restarting"),
+			"Error": component.NewText("exit status 1"),
+		},
+	)
+
+	buttonGroup := component.NewButtonGroup()
+	buttonGroup.AddButton(component.NewButton("Restart",
+		action.CreatePayload(octant.ActionRestartPlugin, action.Payload{"name": "plugin1"})))
+	buttonGroup.AddButton(component.NewButton("Disable",
+		action.CreatePayload(octant.ActionDisablePlugin, action.Payload{"name": "plugin1"}),
+		component.WithButtonConfirmation(
+			"Disable Plugin",
+			"Are you sure you want to disable plugin **plugin1**? It won't run until it is enabled."),
+		component.WithButtonDanger(component.DangerLevelWarning)))
+
+	layout := component.NewFlexLayout("")
+	layout.SetButtonGroup(buttonGroup)
+	layout.AddSections(
+		component.FlexLayoutSection{
+			{Width: component.WidthHalf, View: summary},
+			{Width: component.WidthHalf, View: capabilities},
+		},
+		component.FlexLayoutSection{
+			{Width: component.WidthFull, View: history},
+		},
+	)
+
+	expectedTitle := component.Title(
+		component.NewLink("", "Plugins", "/configuration/plugins"),
+		component.NewText("plugin1"))
+	require.Equal(t, expectedTitle, got.Title)
+
+	require.Len(t, got.Components, 1)
+	component.AssertEqual(t, layout, got.Components[0])
+}
+
+func TestPluginDescriber_Describe_disabled(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	status := dashPlugin.Status{State: dashPlugin.StateDisabled}
+
+	store := pluginFake.NewMockManagerStore(controller)
+	store.EXPECT().Statuses().Return(map[string]dashPlugin.Status{"plugin1": status})
+	store.EXPECT().GetMetadata("plugin1").Return(nil, errors.New("not found"))
+	store.EXPECT().GetCommand("plugin1").Return("", errors.New("not found"))
+
+	pluginManager := pluginFake.NewMockManagerInterface(controller)
+	pluginManager.EXPECT().Store().Return(store)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().PluginManager().Return(pluginManager)
+
+	options := describer.Options{
+		Dash:   dashConfig,
+		Fields: map[string]string{"name": "plugin1"},
+	}
+
+	got, err := NewPluginDescriber().Describe(context.Background(), "", options)
+	require.NoError(t, err)
+
+	require.Len(t, got.Components, 1)
+	layout, ok := got.Components[0].(*component.FlexLayout)
+	require.True(t, ok)
+
+	expected := []component.Button{
+		component.NewButton("Enable",
+			action.CreatePayload(octant.ActionRestartPlugin, action.Payload{"name": "plugin1"})),
+	}
+	require.Equal(t, expected, layout.Config.ButtonGroup.Config.Buttons)
+}
+
+func TestPluginDescriber_Describe_missing(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	store := pluginFake.NewMockManagerStore(controller)
+	store.EXPECT().Statuses().Return(nil)
+	store.EXPECT().GetMetadata("missing").Return(nil, errors.New("not found"))
+
+	pluginManager := pluginFake.NewMockManagerInterface(controller)
+	pluginManager.EXPECT().Store().Return(store)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().PluginManager().Return(pluginManager)
+
+	options := describer.Options{
+		Dash:   dashConfig,
+		Fields: map[string]string{"name": "missing"},
+	}
+
+	_, err := NewPluginDescriber().Describe(context.Background(), "", options)
+	require.Error(t, err)
+	_, ok := err.(*api.NotFoundError)
+	require.True(t, ok)
+}
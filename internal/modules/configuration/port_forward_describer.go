@@ -0,0 +1,83 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/vmware/octant/internal/describer"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/internal/portforward"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// PortForwardListDescriber describes the active port forwards.
+type PortForwardListDescriber struct {
+}
+
+var _ describer.Describer = (*PortForwardListDescriber)(nil)
+
+// NewPortForwardListDescriber creates an instance of PortForwardListDescriber.
+func NewPortForwardListDescriber() *PortForwardListDescriber {
+	return &PortForwardListDescriber{}
+}
+
+// Describe describes a list of port forwards.
+func (d *PortForwardListDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	list := component.NewList("Port Forwards", nil)
+	tableCols := component.NewTableCols("Name", "Kind", "Namespace", "Pod", "Ports", "Age", "Expires")
+	tbl := component.NewTable("Port Forwards", "There are no port forwards!", tableCols)
+	list.Add(tbl)
+
+	for _, pf := range options.PortForwarder().List(ctx) {
+		expires := component.Component(component.NewText("Never"))
+		if !pf.ExpiresAt.IsZero() {
+			expires = component.NewTimestamp(pf.ExpiresAt)
+		}
+
+		row := component.TableRow{
+			"Name":      component.NewText(pf.Target.Name),
+			"Kind":      component.NewText(pf.Target.GVK.Kind),
+			"Namespace": component.NewText(pf.Target.Namespace),
+			"Pod":       component.NewText(pf.Pod.Name),
+			"Ports":     component.NewText(describeForwardedPorts(pf.Ports)),
+			"Age":       component.NewTimestamp(pf.CreatedAt),
+			"Expires":   expires,
+		}
+
+		row.AddAction(component.NewButton("Stop",
+			action.CreatePayload(octant.ActionStopPortForward, action.Payload{"id": pf.ID}),
+			component.WithButtonConfirmation(
+				"Stop Port Forward",
+				fmt.Sprintf("Are you sure you want to stop forwarding to %s **%s**?", pf.Target.GVK.Kind, pf.Target.Name))))
+
+		tbl.Add(row)
+	}
+
+	return component.ContentResponse{
+		Components: []component.Component{list},
+	}, nil
+}
+
+func (d *PortForwardListDescriber) PathFilters() []describer.PathFilter {
+	filter := describer.NewPathFilter("/port-forwards", d)
+	return []describer.PathFilter{*filter}
+}
+
+func (d *PortForwardListDescriber) Reset(ctx context.Context) error {
+	return nil
+}
+
+func describeForwardedPorts(ports []portforward.ForwardedPort) string {
+	out := make([]string, len(ports))
+	for i, p := range ports {
+		out[i] = fmt.Sprintf("localhost:%d -> %d", p.Local, p.Remote)
+	}
+	return strings.Join(out, ", ")
+}
@@ -0,0 +1,102 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	configFake "github.com/vmware/octant/internal/config/fake"
+	"github.com/vmware/octant/internal/describer"
+	"github.com/vmware/octant/internal/gvk"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/internal/portforward"
+	portForwardFake "github.com/vmware/octant/internal/portforward/fake"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func TestPortForwardListDescriber(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	now := time.Unix(1547211430, 0)
+
+	states := []portforward.State{
+		{
+			ID:        "service-id",
+			CreatedAt: now,
+			ExpiresAt: now.Add(time.Hour),
+			Ports:     []portforward.ForwardedPort{{Local: 45000, Remote: 80}},
+			Target:    portforward.Target{GVK: gvk.Service, Namespace: "default", Name: "service"},
+			Pod:       portforward.Target{GVK: gvk.Pod, Namespace: "default", Name: "pod-1"},
+		},
+		{
+			ID:        "pod-id",
+			CreatedAt: now,
+			Ports:     []portforward.ForwardedPort{{Local: 8080, Remote: 8080}},
+			Target:    portforward.Target{GVK: gvk.Pod, Namespace: "default", Name: "pod-2"},
+			Pod:       portforward.Target{GVK: gvk.Pod, Namespace: "default", Name: "pod-2"},
+		},
+	}
+
+	portForwarder := portForwardFake.NewMockPortForwarder(controller)
+	portForwarder.EXPECT().List(gomock.Any()).Return(states)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().PortForwarder().Return(portForwarder)
+
+	d := NewPortForwardListDescriber()
+
+	ctx := context.Background()
+	cResponse, err := d.Describe(ctx, "", describer.Options{Dash: dashConfig})
+	require.NoError(t, err)
+
+	list := component.NewList("Port Forwards", nil)
+	tableCols := component.NewTableCols("Name", "Kind", "Namespace", "Pod", "Ports", "Age", "Expires")
+	table := component.NewTable("Port Forwards", "There are no port forwards!", tableCols)
+
+	serviceRow := component.TableRow{
+		"Name":      component.NewText("service"),
+		"Kind":      component.NewText("Service"),
+		"Namespace": component.NewText("default"),
+		"Pod":       component.NewText("pod-1"),
+		"Ports":     component.NewText("localhost:45000 -> 80"),
+		"Age":       component.NewTimestamp(now),
+		"Expires":   component.NewTimestamp(now.Add(time.Hour)),
+	}
+	serviceRow.AddAction(component.NewButton("Stop",
+		action.CreatePayload(octant.ActionStopPortForward, action.Payload{"id": "service-id"}),
+		component.WithButtonConfirmation(
+			"Stop Port Forward",
+			"Are you sure you want to stop forwarding to Service **service**?")))
+	table.Add(serviceRow)
+
+	podRow := component.TableRow{
+		"Name":      component.NewText("pod-2"),
+		"Kind":      component.NewText("Pod"),
+		"Namespace": component.NewText("default"),
+		"Pod":       component.NewText("pod-2"),
+		"Ports":     component.NewText("localhost:8080 -> 8080"),
+		"Age":       component.NewTimestamp(now),
+		"Expires":   component.NewText("Never"),
+	}
+	podRow.AddAction(component.NewButton("Stop",
+		action.CreatePayload(octant.ActionStopPortForward, action.Payload{"id": "pod-id"}),
+		component.WithButtonConfirmation(
+			"Stop Port Forward",
+			"Are you sure you want to stop forwarding to Pod **pod-2**?")))
+	table.Add(podRow)
+
+	list.Add(table)
+
+	require.Len(t, cResponse.Components, 1)
+	component.AssertEqual(t, list, cResponse.Components[0])
+}
@@ -0,0 +1,75 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+
+	"github.com/vmware/octant/internal/describer"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/internal/session"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// SessionListDescriber describes the clients connected to the dashboard.
+type SessionListDescriber struct {
+	sessions *session.Registry
+}
+
+var _ describer.Describer = (*SessionListDescriber)(nil)
+
+// NewSessionListDescriber creates an instance of SessionListDescriber.
+func NewSessionListDescriber(sessions *session.Registry) *SessionListDescriber {
+	return &SessionListDescriber{
+		sessions: sessions,
+	}
+}
+
+// Describe describes a list of sessions.
+func (d *SessionListDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	list := component.NewList("Sessions", nil)
+	tableCols := component.NewTableCols("User", "Address", "User Agent", "Content Path", "Namespace", "Connected")
+	tbl := component.NewTable("Sessions", "There are no sessions!", tableCols)
+	list.Add(tbl)
+
+	for _, s := range d.sessions.List() {
+		user := s.User
+		if user == "" {
+			user = "anonymous"
+		}
+
+		row := component.TableRow{
+			"User":         component.NewText(user),
+			"Address":      component.NewText(s.RemoteAddr),
+			"User Agent":   component.NewText(s.UserAgent),
+			"Content Path": component.NewText(s.ContentPath),
+			"Namespace":    component.NewText(s.Namespace),
+			"Connected":    component.NewTimestamp(s.Started),
+		}
+
+		row.AddAction(component.NewButton("Terminate",
+			action.CreatePayload(octant.ActionTerminateSession, action.Payload{"id": s.ID}),
+			component.WithButtonConfirmation(
+				"Terminate Session",
+				"Are you sure you want to terminate the session for **"+user+"**? The client will be disconnected.")))
+
+		tbl.Add(row)
+	}
+
+	return component.ContentResponse{
+		Components: []component.Component{list},
+	}, nil
+}
+
+func (d *SessionListDescriber) PathFilters() []describer.PathFilter {
+	filter := describer.NewPathFilter("/sessions", d)
+	return []describer.PathFilter{*filter}
+}
+
+func (d *SessionListDescriber) Reset(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,62 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/octant/internal/describer"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/internal/session"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func TestSessionListDescriber(t *testing.T) {
+	now := time.Unix(1547211430, 0)
+
+	sessions := session.NewRegistry()
+	sessions.Add(session.Session{
+		ID:          "id",
+		RemoteAddr:  "127.0.0.1:5000",
+		UserAgent:   "browser",
+		ContentPath: "overview/namespace/default",
+		Namespace:   "default",
+		Started:     now,
+	}, nil)
+
+	d := NewSessionListDescriber(sessions)
+
+	ctx := context.Background()
+	cResponse, err := d.Describe(ctx, "", describer.Options{})
+	require.NoError(t, err)
+
+	list := component.NewList("Sessions", nil)
+	tableCols := component.NewTableCols("User", "Address", "User Agent", "Content Path", "Namespace", "Connected")
+	table := component.NewTable("Sessions", "There are no sessions!", tableCols)
+	row := component.TableRow{
+		"User":         component.NewText("anonymous"),
+		"Address":      component.NewText("127.0.0.1:5000"),
+		"User Agent":   component.NewText("browser"),
+		"Content Path": component.NewText("overview/namespace/default"),
+		"Namespace":    component.NewText("default"),
+		"Connected":    component.NewTimestamp(now),
+	}
+	row.AddAction(component.NewButton("Terminate",
+		action.CreatePayload(octant.ActionTerminateSession, action.Payload{"id": "id"}),
+		component.WithButtonConfirmation(
+			"Terminate Session",
+			"Are you sure you want to terminate the session for **anonymous**? The client will be disconnected.")))
+	table.Add(row)
+	list.Add(table)
+
+	require.Len(t, cResponse.Components, 1)
+	component.AssertEqual(t, list, cResponse.Components[0])
+}
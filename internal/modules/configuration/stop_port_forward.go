@@ -0,0 +1,60 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/internal/portforward"
+	"github.com/vmware/octant/pkg/action"
+)
+
+// PortForwardStopper stops port forwards.
+type PortForwardStopper struct {
+	logger        log.Logger
+	portForwarder portforward.PortForwarder
+}
+
+// NewPortForwardStopper creates an instance of PortForwardStopper.
+func NewPortForwardStopper(logger log.Logger, portForwarder portforward.PortForwarder) *PortForwardStopper {
+	return &PortForwardStopper{
+		logger:        logger.With("action", octant.ActionStopPortForward),
+		portForwarder: portForwarder,
+	}
+}
+
+// ActionName returns the name of the action.
+func (s *PortForwardStopper) ActionName() string {
+	return octant.ActionStopPortForward
+}
+
+// Handle stops the port forward in the payload.
+func (s *PortForwardStopper) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	id, err := payload.String("id")
+	if err != nil {
+		return err
+	}
+
+	s.logger.With("id", id).Infof("stopping port forward")
+
+	alertType := action.AlertTypeInfo
+	message := "Stopped port forward"
+	if state, ok := s.portForwarder.Get(id); ok {
+		s.portForwarder.StopForwarder(id)
+		message = fmt.Sprintf("Stopped port forward to %s %q", state.Target.GVK.Kind, state.Target.Name)
+	} else {
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to stop port forward: port forward %q does not exist", id)
+	}
+
+	alert := action.CreateAlert(alertType, message, action.DefaultAlertExpiration)
+	alerter.SendAlert(alert)
+
+	return nil
+}
@@ -0,0 +1,83 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/octant/internal/gvk"
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/internal/portforward"
+	portForwardFake "github.com/vmware/octant/internal/portforward/fake"
+	"github.com/vmware/octant/pkg/action"
+	actionFake "github.com/vmware/octant/pkg/action/fake"
+)
+
+func TestPortForwardStopper_ActionName(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	s := NewPortForwardStopper(log.NopLogger(), portForwardFake.NewMockPortForwarder(controller))
+	require.Equal(t, octant.ActionStopPortForward, s.ActionName())
+}
+
+func TestPortForwardStopper_Handle(t *testing.T) {
+	tests := []struct {
+		name            string
+		exists          bool
+		expectedType    action.AlertType
+		expectedMessage string
+	}{
+		{
+			name:            "stop port forward",
+			exists:          true,
+			expectedType:    action.AlertTypeInfo,
+			expectedMessage: `Stopped port forward to Service "service"`,
+		},
+		{
+			name:            "missing port forward",
+			expectedType:    action.AlertTypeWarning,
+			expectedMessage: `Unable to stop port forward: port forward "id" does not exist`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			state := portforward.State{
+				ID:     "id",
+				Target: portforward.Target{GVK: gvk.Service, Namespace: "default", Name: "service"},
+			}
+
+			portForwarder := portForwardFake.NewMockPortForwarder(controller)
+			portForwarder.EXPECT().Get("id").Return(state, test.exists)
+			if test.exists {
+				portForwarder.EXPECT().StopForwarder("id")
+			}
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				DoAndReturn(func(alert action.Alert) {
+					assert.Equal(t, test.expectedType, alert.Type)
+					assert.Equal(t, test.expectedMessage, alert.Message)
+				})
+
+			s := NewPortForwardStopper(log.NopLogger(), portForwarder)
+
+			err := s.Handle(context.Background(), alerter, action.Payload{"id": "id"})
+			require.NoError(t, err)
+		})
+	}
+}
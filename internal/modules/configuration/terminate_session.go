@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/internal/session"
+	"github.com/vmware/octant/pkg/action"
+)
+
+// SessionTerminator terminates sessions.
+type SessionTerminator struct {
+	logger   log.Logger
+	sessions *session.Registry
+}
+
+// NewSessionTerminator creates an instance of SessionTerminator.
+func NewSessionTerminator(logger log.Logger, sessions *session.Registry) *SessionTerminator {
+	return &SessionTerminator{
+		logger:   logger.With("action", octant.ActionTerminateSession),
+		sessions: sessions,
+	}
+}
+
+// ActionName returns the name of the action.
+func (t *SessionTerminator) ActionName() string {
+	return octant.ActionTerminateSession
+}
+
+// Handle terminates the session in the payload. Sessions can only be
+// terminated by sessions allowed to terminate them.
+func (t *SessionTerminator) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	id, err := payload.String("id")
+	if err != nil {
+		return err
+	}
+
+	callerID := session.IDFrom(ctx)
+	t.logger.With("session", id, "caller", callerID).Infof("terminating session")
+
+	alertType := action.AlertTypeInfo
+	message := "Terminated session"
+	if err := t.terminate(callerID, id); err != nil {
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to terminate session: %s", err)
+	}
+	alert := action.CreateAlert(alertType, message, action.DefaultAlertExpiration)
+	alerter.SendAlert(alert)
+
+	return nil
+}
+
+func (t *SessionTerminator) terminate(callerID, id string) error {
+	caller, ok := t.sessions.Get(callerID)
+	if !ok {
+		return errors.New("request was not made by a connected session")
+	}
+
+	target, ok := t.sessions.Get(id)
+	if !ok {
+		return errors.Errorf("session %q does not exist", id)
+	}
+
+	if !caller.CanTerminate(target) {
+		return errors.Errorf("session %q belongs to another user", id)
+	}
+
+	return t.sessions.Terminate(id)
+}
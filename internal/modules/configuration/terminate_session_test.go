@@ -0,0 +1,113 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/internal/session"
+	"github.com/vmware/octant/pkg/action"
+	actionFake "github.com/vmware/octant/pkg/action/fake"
+)
+
+func TestSessionTerminator_ActionName(t *testing.T) {
+	st := NewSessionTerminator(log.NopLogger(), session.NewRegistry())
+	require.Equal(t, octant.ActionTerminateSession, st.ActionName())
+}
+
+func TestSessionTerminator_Handle(t *testing.T) {
+	tests := []struct {
+		name            string
+		caller          session.Session
+		id              string
+		expectedType    action.AlertType
+		expectedMessage string
+		terminated      bool
+	}{
+		{
+			name:            "terminate own session",
+			caller:          session.Session{ID: "id"},
+			id:              "id",
+			expectedType:    action.AlertTypeInfo,
+			expectedMessage: "Terminated session",
+			terminated:      true,
+		},
+		{
+			name:            "terminate session of same user",
+			caller:          session.Session{ID: "caller", User: "user"},
+			id:              "id",
+			expectedType:    action.AlertTypeInfo,
+			expectedMessage: "Terminated session",
+			terminated:      true,
+		},
+		{
+			name:            "terminate another session",
+			caller:          session.Session{ID: "caller"},
+			id:              "id",
+			expectedType:    action.AlertTypeWarning,
+			expectedMessage: `Unable to terminate session: session "id" belongs to another user`,
+		},
+		{
+			name:            "terminate session of another user",
+			caller:          session.Session{ID: "caller", User: "other"},
+			id:              "id",
+			expectedType:    action.AlertTypeWarning,
+			expectedMessage: `Unable to terminate session: session "id" belongs to another user`,
+		},
+		{
+			name:            "caller is not a session",
+			id:              "id",
+			expectedType:    action.AlertTypeWarning,
+			expectedMessage: "Unable to terminate session: request was not made by a connected session",
+		},
+		{
+			name:            "missing session",
+			caller:          session.Session{ID: "id"},
+			id:              "missing",
+			expectedType:    action.AlertTypeWarning,
+			expectedMessage: `Unable to terminate session: session "missing" does not exist`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			terminated := false
+			sessions := session.NewRegistry()
+			sessions.Add(session.Session{ID: "id", User: "user"}, func() {
+				terminated = true
+			})
+			if test.caller.ID != "" && test.caller.ID != "id" {
+				sessions.Add(test.caller, func() {})
+			}
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				DoAndReturn(func(alert action.Alert) {
+					assert.Equal(t, test.expectedType, alert.Type)
+					assert.Equal(t, test.expectedMessage, alert.Message)
+				})
+
+			st := NewSessionTerminator(log.NopLogger(), sessions)
+
+			ctx := session.WithID(context.Background(), test.caller.ID)
+			err := st.Handle(ctx, alerter, action.Payload{"id": test.id})
+			require.NoError(t, err)
+
+			assert.Equal(t, test.terminated, terminated)
+		})
+	}
+}
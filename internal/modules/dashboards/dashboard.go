@@ -0,0 +1,230 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package dashboards
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// PanelType is the built-in query a panel shows.
+type PanelType string
+
+const (
+	// PanelPods lists the pods matched by a selector.
+	PanelPods PanelType = "pods"
+	// PanelEvents lists events, optionally only those with a reason or type.
+	PanelEvents PanelType = "events"
+	// PanelQuota shows the usage of the resource quotas in a namespace.
+	PanelQuota PanelType = "quota"
+	// PanelCount counts the pods matched by a selector, the pods which are
+	// ready, and their container restarts.
+	PanelCount PanelType = "count"
+	// PanelMetric graphs the resource usage of the pods matched by a
+	// selector. Usage comes from metrics-server.
+	PanelMetric PanelType = "metric"
+)
+
+// Metric is a resource usage which a metric panel graphs over time.
+type Metric string
+
+const (
+	// MetricCPU is the CPU usage of the matched pods in millicores.
+	MetricCPU Metric = "cpu"
+	// MetricMemory is the memory usage of the matched pods in mebibytes.
+	MetricMemory Metric = "memory"
+)
+
+// Panel is an item on a dashboard.
+type Panel struct {
+	// Title is the panel's title.
+	Title string `json:"title"`
+	// Type is the query the panel shows.
+	Type PanelType `json:"type"`
+	// Width is the panel's width. It is one of quarter, half, or full, and
+	// defaults to half.
+	Width string `json:"width,omitempty"`
+	// Namespace overrides the current namespace.
+	Namespace string `json:"namespace,omitempty"`
+	// Selector is a label selector, e.g. "app=web,tier!=db", for pods,
+	// count, and metric panels.
+	Selector string `json:"selector,omitempty"`
+	// Reason limits an events panel to events with the reason.
+	Reason string `json:"reason,omitempty"`
+	// EventType limits an events panel to events of the type, e.g. Warning.
+	EventType string `json:"eventType,omitempty"`
+	// Metric is the value a metric panel graphs.
+	Metric Metric `json:"metric,omitempty"`
+}
+
+// Row is a row of panels.
+type Row struct {
+	Panels []Panel `json:"panels"`
+}
+
+// Dashboard is a page composed of panels. Dashboards are defined in YAML:
+//
+//	title: Web
+//	rows:
+//	  - panels:
+//	      - title: Web Pods
+//	        type: pods
+//	        selector: app=web
+//	      - title: Back-offs
+//	        type: events
+//	        reason: BackOff
+type Dashboard struct {
+	// ID identifies the dashboard in content paths. It is the name of the
+	// file the dashboard was loaded from.
+	ID    string `json:"-"`
+	Title string `json:"title"`
+	Rows  []Row  `json:"rows"`
+}
+
+var dashboardExtensions = map[string]bool{
+	".yaml": true,
+	".yml":  true,
+}
+
+// LoadDashboards loads the dashboards defined in a directory. Dashboards are
+// sorted by title.
+func LoadDashboards(root string) ([]Dashboard, error) {
+	fileInfos, err := ioutil.ReadDir(root)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read dashboards from %s", root)
+	}
+
+	var dashboards []Dashboard
+	for _, fi := range fileInfos {
+		ext := strings.ToLower(filepath.Ext(fi.Name()))
+		if fi.IsDir() || !dashboardExtensions[ext] {
+			continue
+		}
+
+		dashboard, err := LoadDashboard(filepath.Join(root, fi.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		dashboards = append(dashboards, dashboard)
+	}
+
+	sort.Slice(dashboards, func(i, j int) bool {
+		return dashboards[i].Title < dashboards[j].Title
+	})
+
+	return dashboards, nil
+}
+
+// LoadDashboard loads a dashboard from a file.
+func LoadDashboard(name string) (Dashboard, error) {
+	data, err := ioutil.ReadFile(name)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return Dashboard{}, errors.Errorf("dashboard %s does not exist", name)
+		}
+		return Dashboard{}, errors.Wrapf(err, "read dashboard %s", name)
+	}
+
+	dashboard, err := decodeDashboard(data)
+	if err != nil {
+		return Dashboard{}, errors.Wrapf(err, "decode dashboard %s", name)
+	}
+
+	base := filepath.Base(name)
+	dashboard.ID = strings.TrimSuffix(base, filepath.Ext(base))
+
+	if dashboard.Title == "" {
+		dashboard.Title = dashboard.ID
+	}
+
+	if err := dashboard.Validate(); err != nil {
+		return Dashboard{}, errors.Wrapf(err, "dashboard %s is invalid", name)
+	}
+
+	return dashboard, nil
+}
+
+// decodeDashboard decodes a dashboard. Unknown fields are errors so typos in
+// panel options aren't silently ignored.
+func decodeDashboard(data []byte) (Dashboard, error) {
+	data, err := k8syaml.ToJSON(data)
+	if err != nil {
+		return Dashboard{}, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var dashboard Dashboard
+	if err := decoder.Decode(&dashboard); err != nil {
+		return Dashboard{}, err
+	}
+
+	return dashboard, nil
+}
+
+// Validate validates the dashboard's panels.
+func (d Dashboard) Validate() error {
+	for i, row := range d.Rows {
+		for j, panel := range row.Panels {
+			if err := panel.Validate(); err != nil {
+				return errors.Wrapf(err, "row %d panel %d", i+1, j+1)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Validate validates the panel's options for its type.
+func (p Panel) Validate() error {
+	if _, err := p.width(); err != nil {
+		return err
+	}
+
+	if _, err := labels.Parse(p.Selector); err != nil {
+		return errors.Wrapf(err, "parse selector %q", p.Selector)
+	}
+
+	switch p.Type {
+	case PanelPods, PanelEvents, PanelQuota, PanelCount:
+	case PanelMetric:
+		switch p.Metric {
+		case MetricCPU, MetricMemory:
+		default:
+			return errors.Errorf("unknown metric %q", p.Metric)
+		}
+	default:
+		return errors.Errorf("unknown panel type %q", p.Type)
+	}
+
+	return nil
+}
+
+func (p Panel) width() (int, error) {
+	switch p.Width {
+	case "quarter":
+		return component.WidthQuarter, nil
+	case "", "half":
+		return component.WidthHalf, nil
+	case "full":
+		return component.WidthFull, nil
+	default:
+		return 0, errors.Errorf("unknown width %q", p.Width)
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package dashboards
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadDashboards(t *testing.T) {
+	dashboards, err := LoadDashboards("testdata")
+	require.NoError(t, err)
+
+	require.Len(t, dashboards, 2)
+
+	assert.Equal(t, "quota", dashboards[0].ID)
+	assert.Equal(t, "Quota", dashboards[0].Title)
+
+	web := dashboards[1]
+	assert.Equal(t, "web", web.ID)
+	assert.Equal(t, "Web", web.Title)
+	require.Len(t, web.Rows, 2)
+	require.Len(t, web.Rows[0].Panels, 2)
+
+	expected := Panel{
+		Title:    "Web CPU",
+		Type:     PanelMetric,
+		Metric:   MetricCPU,
+		Selector: "app=web",
+		Width:    "quarter",
+	}
+	assert.Equal(t, expected, web.Rows[1].Panels[0])
+}
+
+func TestLoadDashboards_missing_directory(t *testing.T) {
+	_, err := LoadDashboards("does-not-exist")
+	require.Error(t, err)
+}
+
+func Test_decodeDashboard_unknown_field(t *testing.T) {
+	data := []byte(`
+rows:
+  - panels:
+      - title: Pods
+        type: pods
+        selectr: app=web
+`)
+
+	_, err := decodeDashboard(data)
+	require.Error(t, err)
+}
+
+func TestPanel_Validate(t *testing.T) {
+	cases := []struct {
+		name  string
+		panel Panel
+		isErr bool
+	}{
+		{
+			name:  "pods",
+			panel: Panel{Type: PanelPods, Selector: "app=web,tier!=db"},
+		},
+		{
+			name:  "count",
+			panel: Panel{Type: PanelCount, Selector: "app=web"},
+		},
+		{
+			name:  "metric",
+			panel: Panel{Type: PanelMetric, Metric: MetricMemory, Width: "full"},
+		},
+		{
+			name:  "unknown type",
+			panel: Panel{Type: "chart"},
+			isErr: true,
+		},
+		{
+			name:  "unknown metric",
+			panel: Panel{Type: PanelMetric, Metric: "readyPods"},
+			isErr: true,
+		},
+		{
+			name:  "unknown width",
+			panel: Panel{Type: PanelQuota, Width: "wide"},
+			isErr: true,
+		},
+		{
+			name:  "invalid selector",
+			panel: Panel{Type: PanelPods, Selector: "app in (web"},
+			isErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.panel.Validate()
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
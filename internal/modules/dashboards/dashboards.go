@@ -0,0 +1,256 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package dashboards
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware/octant/internal/config"
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/internal/metrics"
+	"github.com/vmware/octant/internal/module"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/pkg/navigation"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// Options are options for configuring Dashboards.
+type Options struct {
+	// Root is the directory containing dashboard definitions.
+	Root       string
+	Namespace  string
+	DashConfig config.Dash
+}
+
+// Dashboards is a module which renders user defined dashboards. Each
+// dashboard is a YAML file in a directory which composes panels showing
+// built-in queries. Definitions are re-read whenever content is generated,
+// so edits are shown on the next refresh.
+type Dashboards struct {
+	root       string
+	dashConfig config.Dash
+	renderer   *panelRenderer
+
+	mu        sync.Mutex
+	namespace string
+}
+
+var _ module.Module = (*Dashboards)(nil)
+
+// New creates an instance of Dashboards.
+func New(options Options) *Dashboards {
+	return &Dashboards{
+		root:       options.Root,
+		namespace:  options.Namespace,
+		dashConfig: options.DashConfig,
+		renderer: &panelRenderer{
+			dashConfig: options.DashConfig,
+			metrics:    metrics.NewClient(options.DashConfig),
+			history:    newHistory(defaultRetention),
+			now:        time.Now,
+		},
+	}
+}
+
+// Name returns the name of the module.
+func (d *Dashboards) Name() string {
+	return "dashboards"
+}
+
+// ClientRequestHandlers returns nil.
+func (d *Dashboards) ClientRequestHandlers() []octant.ClientRequestHandler {
+	return nil
+}
+
+// Content generates content for a path.
+func (d *Dashboards) Content(ctx context.Context, contentPath string, opts module.ContentOptions) (component.ContentResponse, error) {
+	contentPath = strings.Trim(contentPath, "/")
+
+	dashboards, err := LoadDashboards(d.root)
+	if err != nil {
+		return component.ContentResponse{}, err
+	}
+
+	if contentPath == "" {
+		return d.list(dashboards)
+	}
+
+	for _, dashboard := range dashboards {
+		if dashboard.ID == contentPath {
+			return d.dashboard(ctx, dashboard)
+		}
+	}
+
+	return component.ContentResponse{}, errors.Errorf("dashboard %s was not found", contentPath)
+}
+
+func (d *Dashboards) list(dashboards []Dashboard) (component.ContentResponse, error) {
+	cols := component.NewTableCols("Name", "Panels")
+	table := component.NewTable("Dashboards", "We couldn't find any dashboards!", cols)
+
+	for _, dashboard := range dashboards {
+		panels := 0
+		for _, row := range dashboard.Rows {
+			panels += len(row.Panels)
+		}
+
+		table.Add(component.TableRow{
+			"Name":   component.NewLink("", dashboard.Title, path.Join("/", d.ContentPath(), dashboard.ID)),
+			"Panels": component.NewText(fmt.Sprintf("%d", panels)),
+		})
+	}
+
+	return component.ContentResponse{
+		Title:      component.Title(component.NewText("Dashboards")),
+		Components: []component.Component{table},
+	}, nil
+}
+
+func (d *Dashboards) dashboard(ctx context.Context, dashboard Dashboard) (component.ContentResponse, error) {
+	namespace := d.currentNamespace()
+
+	layout := component.NewFlexLayout(dashboard.Title)
+
+	for i, row := range dashboard.Rows {
+		var section component.FlexLayoutSection
+
+		for j, panel := range row.Panels {
+			width, err := panel.width()
+			if err != nil {
+				return component.ContentResponse{}, err
+			}
+
+			id := fmt.Sprintf("%s/%s/%d/%d", namespace, dashboard.ID, i, j)
+
+			// a panel which can't be rendered shows its error so the rest of
+			// the dashboard is still useful.
+			view, err := d.renderer.render(ctx, id, namespace, panel)
+			if err != nil {
+				log.From(ctx).WithErr(err).Errorf("render dashboard %s panel %q", dashboard.ID, panel.Title)
+				view = component.NewError(component.TitleFromString(panel.Title), err)
+			}
+
+			section = append(section, component.FlexLayoutItem{
+				Width: width,
+				View:  view,
+			})
+		}
+
+		layout.AddSections(section)
+	}
+
+	return component.ContentResponse{
+		Title: component.Title(
+			component.NewLink("", "Dashboards", path.Join("/", d.ContentPath())),
+			component.NewText(dashboard.Title)),
+		Components: []component.Component{layout},
+	}, nil
+}
+
+// ContentPath returns the content path for the module.
+func (d *Dashboards) ContentPath() string {
+	return d.Name()
+}
+
+// Navigation returns navigation entries for the module. Each dashboard has
+// an entry.
+func (d *Dashboards) Navigation(ctx context.Context, namespace, root string) ([]navigation.Navigation, error) {
+	if !strings.HasSuffix(root, "/") {
+		root = fmt.Sprintf("%s/", root)
+	}
+
+	entry := navigation.Navigation{
+		Title: "Dashboards",
+		Path:  root,
+	}
+
+	dashboards, err := LoadDashboards(d.root)
+	if err != nil {
+		// the dashboards page shows the error.
+		log.From(ctx).WithErr(err).Errorf("load dashboards for navigation")
+		return []navigation.Navigation{entry}, nil
+	}
+
+	for _, dashboard := range dashboards {
+		entry.Children = append(entry.Children, navigation.Navigation{
+			Title: dashboard.Title,
+			Path:  path.Join(root, dashboard.ID),
+		})
+	}
+
+	return []navigation.Navigation{entry}, nil
+}
+
+// SetNamespace sets the namespace panels show.
+func (d *Dashboards) SetNamespace(namespace string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.namespace = namespace
+	return nil
+}
+
+func (d *Dashboards) currentNamespace() string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.namespace
+}
+
+// Start starts the module.
+func (d *Dashboards) Start() error {
+	return nil
+}
+
+// Stop stops the module.
+func (d *Dashboards) Stop() {
+}
+
+// SetContext sets the current context name. Metric samples from the
+// previous context are discarded.
+func (d *Dashboards) SetContext(ctx context.Context, contextName string) error {
+	d.renderer.history.reset()
+	return nil
+}
+
+// Generators allow modules to send events to the frontend.
+func (d *Dashboards) Generators() []octant.Generator {
+	return []octant.Generator{}
+}
+
+// SupportedGroupVersionKind returns an empty list.
+func (d *Dashboards) SupportedGroupVersionKind() []schema.GroupVersionKind {
+	return []schema.GroupVersionKind{}
+}
+
+// GroupVersionKindPath returns an error since dashboards are not routed by GVK.
+func (d *Dashboards) GroupVersionKindPath(namespace, apiVersion, kind, name string) (string, error) {
+	return "", errors.Errorf("dashboards can't create paths for %s %s", apiVersion, kind)
+}
+
+// AddCRD is a no-op.
+func (d *Dashboards) AddCRD(ctx context.Context, crd *unstructured.Unstructured) error {
+	return nil
+}
+
+// RemoveCRD is a no-op.
+func (d *Dashboards) RemoveCRD(ctx context.Context, crd *unstructured.Unstructured) error {
+	return nil
+}
+
+// ResetCRDs is a no-op.
+func (d *Dashboards) ResetCRDs(ctx context.Context) error {
+	return nil
+}
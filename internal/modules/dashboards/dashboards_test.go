@@ -0,0 +1,184 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package dashboards
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	configFake "github.com/vmware/octant/internal/config/fake"
+	"github.com/vmware/octant/internal/metrics"
+	metricsFake "github.com/vmware/octant/internal/metrics/fake"
+	"github.com/vmware/octant/internal/module"
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/navigation"
+	"github.com/vmware/octant/pkg/store"
+	storefake "github.com/vmware/octant/pkg/store/fake"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func TestDashboards_Content_list(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+
+	d := New(Options{Root: "testdata", Namespace: "default", DashConfig: dashConfig})
+
+	ctx := context.Background()
+	content, err := d.Content(ctx, "/", module.ContentOptions{})
+	require.NoError(t, err)
+
+	require.Len(t, content.Components, 1)
+	table, ok := content.Components[0].(*component.Table)
+	require.True(t, ok)
+
+	require.Len(t, table.Rows(), 2)
+	assert.Equal(t, component.NewLink("", "Web", "/dashboards/web"), table.Rows()[1]["Name"])
+	assert.Equal(t, component.NewText("4"), table.Rows()[1]["Panels"])
+}
+
+func TestDashboards_Content_dashboard(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	webPod := testutil.CreatePod("web")
+	webPod.Namespace = "default"
+	webPod.Labels = map[string]string{"app": "web"}
+	webPod.Spec.Containers = []corev1.Container{{Name: "nginx"}}
+	webPod.Status.ContainerStatuses = []corev1.ContainerStatus{{Name: "nginx", Ready: true, RestartCount: 2}}
+
+	podKey := store.Key{
+		Namespace:  "default",
+		APIVersion: "v1",
+		Kind:       "Pod",
+		LabelSelector: &metav1.LabelSelector{
+			MatchLabels:      map[string]string{"app": "web"},
+			MatchExpressions: []metav1.LabelSelectorRequirement{},
+		},
+	}
+
+	objectStore := storefake.NewMockStore(controller)
+	objectStore.EXPECT().
+		List(gomock.Any(), podKey).
+		Return(testutil.ToUnstructuredList(t, webPod), false, nil).
+		Times(2)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "default", APIVersion: "v1", Kind: "Event"}).
+		Return(nil, false, errors.New("forbidden"))
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectStore().Return(objectStore).AnyTimes()
+	dashConfig.EXPECT().
+		ObjectPath("default", "v1", "Pod", "web").
+		Return("/overview/namespace/default/workloads/pods/web", nil)
+
+	metricsClient := metricsFake.NewMockInterface(controller)
+	metricsClient.EXPECT().Available().Return(true)
+	metricsClient.EXPECT().
+		PodMetricsList(gomock.Any(), "default", labels.SelectorFromSet(labels.Set{"app": "web"})).
+		Return([]metrics.PodMetrics{
+			{
+				Containers: []metrics.ContainerMetrics{
+					{Name: "nginx", Usage: metrics.Usage{CPU: resource.MustParse("150m")}},
+				},
+			},
+		}, nil)
+
+	d := New(Options{Root: "testdata", Namespace: "default", DashConfig: dashConfig})
+	d.renderer.metrics = metricsClient
+	now := time.Unix(1000, 0)
+	d.renderer.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	content, err := d.Content(ctx, "/web", module.ContentOptions{})
+	require.NoError(t, err)
+
+	require.Len(t, content.Components, 1)
+	layout, ok := content.Components[0].(*component.FlexLayout)
+	require.True(t, ok)
+	require.Len(t, layout.Config.Sections, 2)
+
+	first := layout.Config.Sections[0]
+	require.Len(t, first, 2)
+	assert.Equal(t, component.WidthHalf, first[0].Width)
+
+	pods, ok := first[0].View.(*component.Table)
+	require.True(t, ok)
+	require.Len(t, pods.Rows(), 1)
+	assert.Equal(t, component.NewLink("", "web", "/overview/namespace/default/workloads/pods/web"), pods.Rows()[0]["Name"])
+	assert.Equal(t, component.NewText("1/1"), pods.Rows()[0]["Ready"])
+	assert.Equal(t, component.NewText("2"), pods.Rows()[0]["Restarts"])
+
+	assert.Equal(t, component.WidthQuarter, first[1].Width)
+	expectedCount := component.NewSummary("Web Pod Counts", []component.SummarySection{
+		{Header: "Pods", Content: component.NewText("1")},
+		{Header: "Ready", Content: component.NewText("1")},
+		{Header: "Restarts", Content: component.NewText("2")},
+	}...)
+	component.AssertEqual(t, expectedCount, first[1].View)
+
+	second := layout.Config.Sections[1]
+	require.Len(t, second, 2)
+
+	expectedMetric := component.NewTimeseries("Web CPU", "millicores")
+	expectedMetric.AddPoint("cpu", now, 150)
+	component.AssertEqual(t, expectedMetric, second[0].View)
+
+	// a panel which fails shows its error instead of failing the dashboard
+	assert.Equal(t, component.WidthFull, second[1].Width)
+	_, ok = second[1].View.(*component.Error)
+	require.True(t, ok)
+}
+
+func TestDashboards_Content_unknown(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+
+	d := New(Options{Root: "testdata", Namespace: "default", DashConfig: dashConfig})
+
+	ctx := context.Background()
+	_, err := d.Content(ctx, "/missing", module.ContentOptions{})
+	require.Error(t, err)
+}
+
+func TestDashboards_Navigation(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+
+	d := New(Options{Root: "testdata", Namespace: "default", DashConfig: dashConfig})
+
+	ctx := context.Background()
+	got, err := d.Navigation(ctx, "default", "dashboards")
+	require.NoError(t, err)
+
+	expected := []navigation.Navigation{
+		{
+			Title: "Dashboards",
+			Path:  "dashboards/",
+			Children: []navigation.Navigation{
+				{Title: "Quota", Path: "dashboards/quota"},
+				{Title: "Web", Path: "dashboards/web"},
+			},
+		},
+	}
+
+	assert.Equal(t, expected, got)
+}
@@ -0,0 +1,72 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package dashboards
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// defaultRetention is how long metric samples are kept.
+	defaultRetention = time.Hour
+	// minSampleInterval is the minimum time between samples. Dashboards are
+	// rendered every time content refreshes, which is more often than a
+	// graph needs.
+	minSampleInterval = 10 * time.Second
+)
+
+type sample struct {
+	timestamp time.Time
+	value     float64
+}
+
+// history keeps the samples recorded for metric panels.
+type history struct {
+	retention time.Duration
+	samples   map[string][]sample
+
+	mu sync.Mutex
+}
+
+func newHistory(retention time.Duration) *history {
+	return &history{
+		retention: retention,
+		samples:   make(map[string][]sample),
+	}
+}
+
+// record records a sample for a panel and returns the panel's samples.
+func (h *history) record(id string, now time.Time, value float64) []sample {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	samples := h.samples[id]
+
+	if n := len(samples); n > 0 && now.Sub(samples[n-1].timestamp) < minSampleInterval {
+		samples[n-1].value = value
+	} else {
+		samples = append(samples, sample{timestamp: now, value: value})
+	}
+
+	cutoff := now.Add(-h.retention)
+	for len(samples) > 0 && samples[0].timestamp.Before(cutoff) {
+		samples = samples[1:]
+	}
+
+	h.samples[id] = samples
+
+	out := make([]sample, len(samples))
+	copy(out, samples)
+	return out
+}
+
+func (h *history) reset() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.samples = make(map[string][]sample)
+}
@@ -0,0 +1,44 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package dashboards
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_history(t *testing.T) {
+	h := newHistory(time.Minute)
+
+	now := time.Unix(1000, 0)
+
+	samples := h.record("panel", now, 1)
+	require.Len(t, samples, 1)
+
+	// samples closer together than the minimum interval replace the last one
+	samples = h.record("panel", now.Add(time.Second), 2)
+	require.Len(t, samples, 1)
+	assert.Equal(t, float64(2), samples[0].value)
+
+	samples = h.record("panel", now.Add(30*time.Second), 3)
+	require.Len(t, samples, 2)
+
+	// samples older than the retention are dropped
+	samples = h.record("panel", now.Add(75*time.Second), 4)
+	require.Len(t, samples, 2)
+	assert.Equal(t, float64(3), samples[0].value)
+	assert.Equal(t, float64(4), samples[1].value)
+
+	samples = h.record("other", now, 5)
+	require.Len(t, samples, 1)
+
+	h.reset()
+	samples = h.record("panel", now, 6)
+	require.Len(t, samples, 1)
+}
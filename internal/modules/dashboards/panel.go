@@ -0,0 +1,290 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package dashboards
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware/octant/internal/config"
+	"github.com/vmware/octant/internal/metrics"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// panelRenderer renders the panels of a dashboard.
+type panelRenderer struct {
+	dashConfig config.Dash
+	metrics    metrics.Interface
+	history    *history
+	now        func() time.Time
+}
+
+// render renders a panel. id identifies the panel so metric samples are
+// kept between renders.
+func (r *panelRenderer) render(ctx context.Context, id, namespace string, panel Panel) (component.Component, error) {
+	if panel.Namespace != "" {
+		namespace = panel.Namespace
+	}
+
+	switch panel.Type {
+	case PanelPods:
+		return r.pods(ctx, namespace, panel)
+	case PanelEvents:
+		return r.events(ctx, namespace, panel)
+	case PanelQuota:
+		return r.quota(ctx, namespace, panel)
+	case PanelCount:
+		return r.count(ctx, namespace, panel)
+	case PanelMetric:
+		return r.metric(ctx, id, namespace, panel)
+	default:
+		return nil, errors.Errorf("unknown panel type %q", panel.Type)
+	}
+}
+
+func (r *panelRenderer) pods(ctx context.Context, namespace string, panel Panel) (component.Component, error) {
+	pods, err := r.listPods(ctx, namespace, panel.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	cols := component.NewTableCols("Name", "Ready", "Phase", "Restarts", "Age")
+	table := component.NewTable(panel.Title, "We couldn't find any pods!", cols)
+
+	for i := range pods {
+		pod := pods[i]
+
+		ready, total := readyContainers(pod)
+
+		table.Add(component.TableRow{
+			"Name":     r.objectLink(pod.Namespace, "v1", "Pod", pod.Name),
+			"Ready":    component.NewText(fmt.Sprintf("%d/%d", ready, total)),
+			"Phase":    component.NewText(string(pod.Status.Phase)),
+			"Restarts": component.NewText(fmt.Sprintf("%d", restarts(pod))),
+			"Age":      component.NewTimestamp(pod.CreationTimestamp.Time),
+		})
+	}
+
+	table.Sort("Name", false)
+
+	return table, nil
+}
+
+func (r *panelRenderer) events(ctx context.Context, namespace string, panel Panel) (component.Component, error) {
+	key := store.Key{Namespace: namespace, APIVersion: "v1", Kind: "Event"}
+	list, _, err := r.dashConfig.ObjectStore().List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list events in %s", namespace)
+	}
+
+	var events []corev1.Event
+	for i := range list.Items {
+		event := corev1.Event{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(list.Items[i].Object, &event); err != nil {
+			return nil, errors.Wrap(err, "convert event")
+		}
+
+		if panel.Reason != "" && event.Reason != panel.Reason {
+			continue
+		}
+
+		if panel.EventType != "" && event.Type != panel.EventType {
+			continue
+		}
+
+		events = append(events, event)
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].LastTimestamp.After(events[j].LastTimestamp.Time)
+	})
+
+	cols := component.NewTableCols("Object", "Reason", "Message", "Count", "Last Seen")
+	table := component.NewTable(panel.Title, "We couldn't find any events!", cols)
+
+	for _, event := range events {
+		involved := event.InvolvedObject
+
+		table.Add(component.TableRow{
+			"Object":    r.objectLink(involved.Namespace, involved.APIVersion, involved.Kind, involved.Name),
+			"Reason":    component.NewText(event.Reason),
+			"Message":   component.NewText(event.Message),
+			"Count":     component.NewText(fmt.Sprintf("%d", event.Count)),
+			"Last Seen": component.NewTimestamp(event.LastTimestamp.Time),
+		})
+	}
+
+	return table, nil
+}
+
+func (r *panelRenderer) quota(ctx context.Context, namespace string, panel Panel) (component.Component, error) {
+	key := store.Key{Namespace: namespace, APIVersion: "v1", Kind: "ResourceQuota"}
+	list, _, err := r.dashConfig.ObjectStore().List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list resource quotas in %s", namespace)
+	}
+
+	cols := component.NewTableCols("Quota", "Resource", "Used", "Hard")
+	table := component.NewTable(panel.Title, "We couldn't find any resource quotas!", cols)
+
+	for i := range list.Items {
+		quota := corev1.ResourceQuota{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(list.Items[i].Object, &quota); err != nil {
+			return nil, errors.Wrap(err, "convert resource quota")
+		}
+
+		var names []string
+		for name := range quota.Status.Hard {
+			names = append(names, string(name))
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			hard := quota.Status.Hard[corev1.ResourceName(name)]
+			used := quota.Status.Used[corev1.ResourceName(name)]
+
+			table.Add(component.TableRow{
+				"Quota":    component.NewText(quota.Name),
+				"Resource": component.NewText(name),
+				"Used":     component.NewText(used.String()),
+				"Hard":     component.NewText(hard.String()),
+			})
+		}
+	}
+
+	return table, nil
+}
+
+func (r *panelRenderer) count(ctx context.Context, namespace string, panel Panel) (component.Component, error) {
+	pods, err := r.listPods(ctx, namespace, panel.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	readyPods := 0
+	var podRestarts int32
+	for i := range pods {
+		if ready, total := readyContainers(pods[i]); total > 0 && ready == total {
+			readyPods++
+		}
+		podRestarts += restarts(pods[i])
+	}
+
+	var sections component.SummarySections
+	sections.AddText("Pods", fmt.Sprintf("%d", len(pods)))
+	sections.AddText("Ready", fmt.Sprintf("%d", readyPods))
+	sections.AddText("Restarts", fmt.Sprintf("%d", podRestarts))
+
+	return component.NewSummary(panel.Title, sections...), nil
+}
+
+// metric samples the current usage of the matched pods from metrics-server
+// and graphs the samples recorded for the panel.
+func (r *panelRenderer) metric(ctx context.Context, id, namespace string, panel Panel) (component.Component, error) {
+	if r.metrics == nil || !r.metrics.Available() {
+		return nil, metrics.ErrNotAvailable
+	}
+
+	selector, err := labels.Parse(panel.Selector)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse selector %q", panel.Selector)
+	}
+
+	podMetrics, err := r.metrics.PodMetricsList(ctx, namespace, selector)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list pod metrics in %s", namespace)
+	}
+
+	var usage metrics.Usage
+	for i := range podMetrics {
+		usage.Add(podMetrics[i].Total())
+	}
+
+	var value float64
+	var unit string
+	switch panel.Metric {
+	case MetricCPU:
+		value = float64(usage.CPU.MilliValue())
+		unit = "millicores"
+	case MetricMemory:
+		value = float64(usage.Memory.Value()) / (1024 * 1024)
+		unit = "MiB"
+	default:
+		return nil, errors.Errorf("unknown metric %q", panel.Metric)
+	}
+
+	samples := r.history.record(id, r.now(), value)
+
+	timeseries := component.NewTimeseries(panel.Title, unit)
+	for _, sample := range samples {
+		timeseries.AddPoint(string(panel.Metric), sample.timestamp, sample.value)
+	}
+
+	return timeseries, nil
+}
+
+func (r *panelRenderer) listPods(ctx context.Context, namespace, selector string) ([]*corev1.Pod, error) {
+	labelSelector, err := metav1.ParseToLabelSelector(selector)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse selector %q", selector)
+	}
+
+	key := store.Key{Namespace: namespace, APIVersion: "v1", Kind: "Pod", LabelSelector: labelSelector}
+	list, _, err := r.dashConfig.ObjectStore().List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list pods in %s", namespace)
+	}
+
+	var pods []*corev1.Pod
+	for i := range list.Items {
+		pod := &corev1.Pod{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(list.Items[i].Object, pod); err != nil {
+			return nil, errors.Wrap(err, "convert pod")
+		}
+
+		pods = append(pods, pod)
+	}
+
+	return pods, nil
+}
+
+func (r *panelRenderer) objectLink(namespace, apiVersion, kind, name string) component.Component {
+	objectPath, err := r.dashConfig.ObjectPath(namespace, apiVersion, kind, name)
+	if err != nil {
+		return component.NewText(name)
+	}
+
+	return component.NewLink("", name, objectPath)
+}
+
+func readyContainers(pod *corev1.Pod) (int, int) {
+	ready := 0
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Ready {
+			ready++
+		}
+	}
+
+	return ready, len(pod.Spec.Containers)
+}
+
+func restarts(pod *corev1.Pod) int32 {
+	var count int32
+	for _, status := range pod.Status.ContainerStatuses {
+		count += status.RestartCount
+	}
+
+	return count
+}
@@ -0,0 +1,307 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package events
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware/octant/internal/api"
+	"github.com/vmware/octant/internal/config"
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/internal/module"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/internal/printer"
+	"github.com/vmware/octant/pkg/navigation"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+const (
+	// allNamespacesPath is the content path for events in every namespace.
+	allNamespacesPath = "all-namespaces"
+)
+
+var (
+	eventKey = store.Key{APIVersion: "v1", Kind: "Event"}
+
+	eventTypes = []string{corev1.EventTypeNormal, corev1.EventTypeWarning}
+)
+
+// Options are options for configuring Events.
+type Options struct {
+	Namespace  string
+	DashConfig config.Dash
+}
+
+// Events is a module which tails events in the current namespace or in
+// every namespace. Events are delivered by an object store watch, so each
+// content refresh shows the latest events.
+type Events struct {
+	ctx        context.Context
+	dashConfig config.Dash
+	stream     *stream
+
+	mu        sync.Mutex
+	namespace string
+	// watched are the namespaces events are watched in. A blank namespace
+	// means events are watched cluster wide.
+	watched map[string]bool
+}
+
+var _ module.Module = (*Events)(nil)
+
+// New creates an instance of Events. The context is used for the lifetime
+// of the object store watches.
+func New(ctx context.Context, options Options) *Events {
+	return &Events{
+		ctx:        ctx,
+		dashConfig: options.DashConfig,
+		stream:     newStream(defaultStreamLimit),
+		namespace:  options.Namespace,
+		watched:    make(map[string]bool),
+	}
+}
+
+// Name returns the name of the module.
+func (e *Events) Name() string {
+	return "events"
+}
+
+// ClientRequestHandlers returns nil.
+func (e *Events) ClientRequestHandlers() []octant.ClientRequestHandler {
+	return nil
+}
+
+// Content generates content for a path. The root path shows events in the
+// current namespace, and all-namespaces shows events in every namespace.
+func (e *Events) Content(ctx context.Context, contentPath string, opts module.ContentOptions) (component.ContentResponse, error) {
+	contentPath = strings.Trim(contentPath, "/")
+
+	namespace := e.currentNamespace()
+	title := fmt.Sprintf("Events in %s", namespace)
+
+	switch contentPath {
+	case "":
+	case allNamespacesPath:
+		namespace = ""
+		title = "Events in all namespaces"
+	default:
+		return component.EmptyContentResponse, api.NewNotFoundError(contentPath)
+	}
+
+	if err := e.watch(namespace); err != nil {
+		return component.EmptyContentResponse, err
+	}
+
+	table, err := createEventsView(e.stream.list(namespace))
+	if err != nil {
+		return component.EmptyContentResponse, err
+	}
+
+	table.Query(opts.Query)
+	table.Paginate(opts.Page)
+
+	return component.ContentResponse{
+		Title:      component.TitleFromString(title),
+		Components: []component.Component{table},
+	}, nil
+}
+
+// watch starts watching events in a namespace unless they are already
+// watched. Events are watched cluster wide if possible, since that covers
+// every namespace.
+func (e *Events) watch(namespace string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.watched[""] || e.watched[namespace] {
+		return nil
+	}
+
+	objectStore := e.dashConfig.ObjectStore()
+
+	err := objectStore.Watch(e.ctx, eventKey, e.stream)
+	if err == nil {
+		e.watched[""] = true
+		return nil
+	}
+
+	if namespace == "" {
+		return errors.Wrap(err, "watch events in all namespaces")
+	}
+
+	log.From(e.ctx).WithErr(err).Debugf("unable to watch events in all namespaces")
+
+	key := eventKey
+	key.Namespace = namespace
+	if err := objectStore.Watch(e.ctx, key, e.stream); err != nil {
+		return errors.Wrapf(err, "watch events in %s", namespace)
+	}
+
+	e.watched[namespace] = true
+	return nil
+}
+
+func createEventsView(events []corev1.Event) (*component.Table, error) {
+	cols := component.NewTableCols("Type", "Reason", "Kind", "Object", "Namespace", "Message", "Count", "Last Seen")
+	table := component.NewTable("Events", "We couldn't find any events!", cols)
+	table.SetSearchable(true)
+
+	reasons := make(map[string]bool)
+	kinds := make(map[string]bool)
+
+	for _, event := range events {
+		objectPath, err := printer.ObjectReferencePath(event.InvolvedObject)
+		if err != nil {
+			return nil, err
+		}
+
+		var object component.Component = component.NewText(event.InvolvedObject.Name)
+		if objectPath != "" {
+			object = component.NewLink("", event.InvolvedObject.Name, objectPath)
+		}
+
+		table.Add(component.TableRow{
+			"Type":      component.NewText(event.Type),
+			"Reason":    component.NewText(event.Reason),
+			"Kind":      component.NewText(event.InvolvedObject.Kind),
+			"Object":    object,
+			"Namespace": component.NewText(event.Namespace),
+			"Message":   component.NewText(event.Message),
+			"Count":     component.NewText(fmt.Sprintf("%d", event.Count)),
+			"Last Seen": component.NewTimestamp(event.LastTimestamp.Time),
+		})
+
+		reasons[event.Reason] = true
+		kinds[event.InvolvedObject.Kind] = true
+	}
+
+	table.AddFilter("Type", component.TableFilter{
+		Values:   eventTypes,
+		Selected: eventTypes,
+	})
+	table.AddFilter("Reason", component.TableFilter{
+		Values:   sortedKeys(reasons),
+		Selected: sortedKeys(reasons),
+	})
+	table.AddFilter("Kind", component.TableFilter{
+		Values:   sortedKeys(kinds),
+		Selected: sortedKeys(kinds),
+	})
+
+	return table, nil
+}
+
+func sortedKeys(m map[string]bool) []string {
+	var list []string
+	for key := range m {
+		list = append(list, key)
+	}
+	sort.Strings(list)
+
+	return list
+}
+
+// ContentPath returns the content path for the module.
+func (e *Events) ContentPath() string {
+	return e.Name()
+}
+
+// Navigation returns navigation entries for the module.
+func (e *Events) Navigation(ctx context.Context, namespace, root string) ([]navigation.Navigation, error) {
+	if !strings.HasSuffix(root, "/") {
+		root = fmt.Sprintf("%s/", root)
+	}
+
+	return []navigation.Navigation{
+		{
+			Title: "Events",
+			Path:  root,
+			Children: []navigation.Navigation{
+				{
+					Title: "All Namespaces",
+					Path:  path.Join(root, allNamespacesPath),
+				},
+			},
+		},
+	}, nil
+}
+
+// SetNamespace sets the namespace events are shown for.
+func (e *Events) SetNamespace(namespace string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.namespace = namespace
+	return nil
+}
+
+func (e *Events) currentNamespace() string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.namespace
+}
+
+// Start starts the module. Events are watched when they are first shown.
+func (e *Events) Start() error {
+	return nil
+}
+
+// Stop stops the module.
+func (e *Events) Stop() {
+}
+
+// SetContext discards events from the previous context. Events are
+// watched again when they are next shown.
+func (e *Events) SetContext(ctx context.Context, contextName string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.watched = make(map[string]bool)
+	e.stream.reset()
+	return nil
+}
+
+// Generators allow modules to send events to the frontend.
+func (e *Events) Generators() []octant.Generator {
+	return []octant.Generator{}
+}
+
+// SupportedGroupVersionKind returns an empty list.
+func (e *Events) SupportedGroupVersionKind() []schema.GroupVersionKind {
+	return []schema.GroupVersionKind{}
+}
+
+// GroupVersionKindPath returns an error since events are not routed by GVK.
+func (e *Events) GroupVersionKindPath(namespace, apiVersion, kind, name string) (string, error) {
+	return "", errors.Errorf("events can't create paths for %s %s", apiVersion, kind)
+}
+
+// AddCRD is a no-op.
+func (e *Events) AddCRD(ctx context.Context, crd *unstructured.Unstructured) error {
+	return nil
+}
+
+// RemoveCRD is a no-op.
+func (e *Events) RemoveCRD(ctx context.Context, crd *unstructured.Unstructured) error {
+	return nil
+}
+
+// ResetCRDs is a no-op.
+func (e *Events) ResetCRDs(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,145 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware/octant/internal/api"
+	configFake "github.com/vmware/octant/internal/config/fake"
+	"github.com/vmware/octant/internal/module"
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/store"
+	storeFake "github.com/vmware/octant/pkg/store/fake"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func TestEvents_Content(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	now := testutil.Time()
+
+	warning := createEvent(t, "warning", "default", now)
+	warning.Type = corev1.EventTypeWarning
+	warning.Reason = "BackOff"
+	warning.Message = "Back-off restarting failed container"
+	warning.Count = 3
+	warning.InvolvedObject = corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Name: "web", Namespace: "default"}
+
+	normal := createEvent(t, "normal", "default", now.Add(-time.Minute))
+	normal.Type = corev1.EventTypeNormal
+	normal.Reason = "Scheduled"
+	normal.Count = 1
+	normal.InvolvedObject = corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Name: "web", Namespace: "default"}
+
+	other := createEvent(t, "other", "other", now)
+	other.Type = corev1.EventTypeNormal
+	other.Reason = "Created"
+	other.InvolvedObject = corev1.ObjectReference{Kind: "Node", Name: "node"}
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Watch(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Event"}, gomock.Any()).
+		Return(nil)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectStore().Return(objectStore)
+
+	ctx := context.Background()
+	e := New(ctx, Options{Namespace: "default", DashConfig: dashConfig})
+
+	content, err := e.Content(ctx, "/", module.ContentOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, component.TitleFromString("Events in default"), content.Title)
+
+	// the watch delivers events to the stream.
+	e.stream.OnAdd(testutil.ToUnstructured(t, warning))
+	e.stream.OnAdd(testutil.ToUnstructured(t, normal))
+	e.stream.OnAdd(testutil.ToUnstructured(t, other))
+
+	content, err = e.Content(ctx, "/", module.ContentOptions{})
+	require.NoError(t, err)
+	require.Len(t, content.Components, 1)
+
+	table, ok := content.Components[0].(*component.Table)
+	require.True(t, ok)
+	require.Len(t, table.Rows(), 2)
+
+	assert.Equal(t, component.TableRow{
+		"Type":      component.NewText("Warning"),
+		"Reason":    component.NewText("BackOff"),
+		"Kind":      component.NewText("Pod"),
+		"Object":    component.NewLink("", "web", "/overview/namespace/default/workloads/pods/web"),
+		"Namespace": component.NewText("default"),
+		"Message":   component.NewText("Back-off restarting failed container"),
+		"Count":     component.NewText("3"),
+		"Last Seen": component.NewTimestamp(now),
+	}, table.Rows()[0])
+	assert.Equal(t, []string{"BackOff", "Scheduled"}, table.Config.Filters["Reason"].Values)
+
+	query := component.TableQuery{Filters: map[string][]string{"Type": {"Warning"}}}
+	content, err = e.Content(ctx, "/", module.ContentOptions{Query: query})
+	require.NoError(t, err)
+	table = content.Components[0].(*component.Table)
+	require.Len(t, table.Rows(), 1)
+	assert.Equal(t, component.NewText("BackOff"), table.Rows()[0]["Reason"])
+
+	content, err = e.Content(ctx, "/all-namespaces", module.ContentOptions{})
+	require.NoError(t, err)
+	table = content.Components[0].(*component.Table)
+	require.Len(t, table.Rows(), 3)
+	assert.Equal(t, component.NewText("node"), table.Rows()[0]["Object"])
+
+	_, err = e.Content(ctx, "/unknown", module.ContentOptions{})
+	assert.IsType(t, &api.NotFoundError{}, err)
+}
+
+func TestEvents_watch_namespace(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Watch(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Event"}, gomock.Any()).
+		Return(errors.New("forbidden")).
+		Times(3)
+	objectStore.EXPECT().
+		Watch(gomock.Any(), store.Key{Namespace: "default", APIVersion: "v1", Kind: "Event"}, gomock.Any()).
+		Return(nil)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectStore().Return(objectStore).AnyTimes()
+
+	ctx := context.Background()
+	e := New(ctx, Options{Namespace: "default", DashConfig: dashConfig})
+
+	_, err := e.Content(ctx, "/", module.ContentOptions{})
+	require.NoError(t, err)
+
+	// events in the namespace are already watched.
+	_, err = e.Content(ctx, "/", module.ContentOptions{})
+	require.NoError(t, err)
+
+	_, err = e.Content(ctx, "/all-namespaces", module.ContentOptions{})
+	require.Error(t, err)
+
+	require.NoError(t, e.SetContext(ctx, "other"))
+	objectStore.EXPECT().
+		Watch(gomock.Any(), store.Key{Namespace: "default", APIVersion: "v1", Kind: "Event"}, gomock.Any()).
+		Return(nil)
+
+	_, err = e.Content(ctx, "/", module.ContentOptions{})
+	require.NoError(t, err)
+}
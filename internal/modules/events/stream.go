@@ -0,0 +1,139 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package events
+
+import (
+	"sort"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/vmware/octant/internal/util/kubernetes"
+)
+
+// defaultStreamLimit is the number of events a stream keeps.
+const defaultStreamLimit = 1000
+
+// stream keeps the events delivered by an object store watch. When it is
+// full, the least recently seen event is dropped.
+type stream struct {
+	limit int
+
+	mu     sync.Mutex
+	events map[types.UID]corev1.Event
+}
+
+var _ kcache.ResourceEventHandler = (*stream)(nil)
+
+func newStream(limit int) *stream {
+	return &stream{
+		limit:  limit,
+		events: make(map[types.UID]corev1.Event),
+	}
+}
+
+// OnAdd stores an added event.
+func (s *stream) OnAdd(obj interface{}) {
+	s.store(obj)
+}
+
+// OnUpdate stores an updated event.
+func (s *stream) OnUpdate(oldObj, newObj interface{}) {
+	s.store(newObj)
+}
+
+// OnDelete removes a deleted event.
+func (s *stream) OnDelete(obj interface{}) {
+	if tombstone, ok := obj.(kcache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+
+	event, ok := toEvent(obj)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.events, event.UID)
+}
+
+func (s *stream) store(obj interface{}) {
+	event, ok := toEvent(obj)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.events[event.UID] = event
+
+	if len(s.events) > s.limit {
+		var oldest *corev1.Event
+		for uid := range s.events {
+			e := s.events[uid]
+			if oldest == nil || e.LastTimestamp.Before(&oldest.LastTimestamp) {
+				oldest = &e
+			}
+		}
+		delete(s.events, oldest.UID)
+	}
+}
+
+// list returns the events in a namespace, most recently seen first. All
+// events are returned if namespace is blank.
+func (s *stream) list(namespace string) []corev1.Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var list []corev1.Event
+	for _, event := range s.events {
+		if namespace == "" || event.Namespace == namespace {
+			list = append(list, event)
+		}
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		a, b := list[i].LastTimestamp, list[j].LastTimestamp
+		if a.Equal(&b) {
+			return list[i].Name < list[j].Name
+		}
+		return b.Before(&a)
+	})
+
+	return list
+}
+
+// reset removes all events.
+func (s *stream) reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.events = make(map[types.UID]corev1.Event)
+}
+
+func toEvent(obj interface{}) (corev1.Event, bool) {
+	var event corev1.Event
+
+	switch o := obj.(type) {
+	case *corev1.Event:
+		event = *o
+	case *unstructured.Unstructured:
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.Object, &event); err != nil {
+			return corev1.Event{}, false
+		}
+	default:
+		return corev1.Event{}, false
+	}
+
+	return kubernetes.NormalizeEvent(event), true
+}
@@ -0,0 +1,73 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/vmware/octant/internal/testutil"
+)
+
+func createEvent(t *testing.T, name, namespace string, lastSeen time.Time) *corev1.Event {
+	event := testutil.CreateEvent(name)
+	event.Namespace = namespace
+	event.UID = types.UID(namespace + "/" + name)
+	event.LastTimestamp = metav1.Time{Time: lastSeen}
+	return event
+}
+
+func Test_stream(t *testing.T) {
+	now := testutil.Time()
+
+	first := createEvent(t, "first", "default", now.Add(-time.Minute))
+	second := createEvent(t, "second", "default", now)
+	other := createEvent(t, "other", "other", now)
+
+	s := newStream(defaultStreamLimit)
+	s.OnAdd(testutil.ToUnstructured(t, first))
+	s.OnAdd(testutil.ToUnstructured(t, second))
+	s.OnAdd(other)
+
+	assert.Equal(t, []string{"second", "first"}, eventNames(s.list("default")))
+	assert.Equal(t, []string{"other", "second", "first"}, eventNames(s.list("")))
+
+	updated := first.DeepCopy()
+	updated.LastTimestamp = metav1.Time{Time: now.Add(time.Minute)}
+	s.OnUpdate(first, testutil.ToUnstructured(t, updated))
+	assert.Equal(t, []string{"first", "second"}, eventNames(s.list("default")))
+
+	s.OnDelete(kcache.DeletedFinalStateUnknown{Obj: testutil.ToUnstructured(t, second)})
+	assert.Equal(t, []string{"first"}, eventNames(s.list("default")))
+
+	s.reset()
+	assert.Empty(t, s.list(""))
+}
+
+func Test_stream_limit(t *testing.T) {
+	now := testutil.Time()
+
+	s := newStream(2)
+	s.OnAdd(createEvent(t, "b", "default", now.Add(-time.Minute)))
+	s.OnAdd(createEvent(t, "a", "default", now.Add(-2*time.Minute)))
+	s.OnAdd(createEvent(t, "c", "default", now))
+
+	assert.Equal(t, []string{"c", "b"}, eventNames(s.list("")))
+}
+
+func eventNames(events []corev1.Event) []string {
+	var names []string
+	for _, event := range events {
+		names = append(names, event.Name)
+	}
+	return names
+}
@@ -0,0 +1,367 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package manifests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/vmware/octant/pkg/store"
+)
+
+// Status is the state of a local manifest compared to the cluster.
+type Status string
+
+const (
+	// StatusNotInCluster means the manifest's object does not exist in the cluster.
+	StatusNotInCluster Status = "Not in cluster"
+	// StatusInSync means the cluster object matches the manifest.
+	StatusInSync Status = "In sync"
+	// StatusDiffers means applying the manifest would change the cluster object.
+	StatusDiffers Status = "Differs"
+)
+
+// Manifest is an object loaded from a local manifest file.
+type Manifest struct {
+	// File is the path of the manifest file relative to the manifest root.
+	File string
+	// Index is the position of the object within a multi-document file.
+	Index int
+	// Object is the object described by the manifest.
+	Object *unstructured.Unstructured
+}
+
+// ID returns an identifier for the manifest which can be used in content paths.
+func (m Manifest) ID() string {
+	return fmt.Sprintf("%s/%d", filepath.ToSlash(m.File), m.Index)
+}
+
+// Difference is a field whose local value differs from the cluster value.
+type Difference struct {
+	// Path is the dotted path to the field.
+	Path string
+	// Local is the manifest's value.
+	Local interface{}
+	// Cluster is the cluster's value. It is nil if the field is not set in the cluster.
+	Cluster interface{}
+	// InCluster is true if the field is set in the cluster.
+	InCluster bool
+}
+
+// Comparison is the result of comparing a manifest to the cluster.
+type Comparison struct {
+	Manifest    Manifest
+	Status      Status
+	Live        *unstructured.Unstructured
+	Differences []Difference
+}
+
+var manifestExtensions = map[string]bool{
+	".yaml": true,
+	".yml":  true,
+	".json": true,
+}
+
+// FileError is a manifest file which could not be read or decoded.
+type FileError struct {
+	// File is the path of the manifest file relative to the manifest root.
+	File string
+	Err  error
+}
+
+// LoadManifests loads all manifests found in a directory and its
+// subdirectories. Files may contain multiple YAML documents. It returns an
+// error if any file can't be loaded.
+func LoadManifests(root string) ([]Manifest, error) {
+	manifests, fileErrors, err := NewLoader(root).Load()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fileErrors) > 0 {
+		return nil, errors.Wrapf(fileErrors[0].Err, "load manifests from %s: %s", root, fileErrors[0].File)
+	}
+
+	return manifests, nil
+}
+
+// loadedFile is a manifest file as it was when it was last read.
+type loadedFile struct {
+	modTime time.Time
+	size    int64
+	objects []*unstructured.Unstructured
+	err     error
+}
+
+// Loader loads manifests from a directory and its subdirectories. Files are
+// only read again when their modification time or size changes, so the
+// directory can be loaded every time content is generated.
+type Loader struct {
+	root string
+
+	mu    sync.Mutex
+	files map[string]loadedFile
+}
+
+// NewLoader creates an instance of Loader.
+func NewLoader(root string) *Loader {
+	return &Loader{
+		root:  root,
+		files: make(map[string]loadedFile),
+	}
+}
+
+// Load loads the manifests in the directory. Files which can't be read or
+// decoded are returned as file errors, so they don't prevent other files
+// from being loaded. It returns an error if the directory can't be walked.
+func (l *Loader) Load() ([]Manifest, []FileError, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var manifests []Manifest
+	var fileErrors []FileError
+	seen := make(map[string]bool)
+
+	err := filepath.Walk(l.root, func(name string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if fi.IsDir() || !manifestExtensions[strings.ToLower(filepath.Ext(name))] {
+			return nil
+		}
+
+		rel, err := filepath.Rel(l.root, name)
+		if err != nil {
+			return errors.Wrapf(err, "find relative path for %s", name)
+		}
+
+		seen[rel] = true
+
+		file, ok := l.files[rel]
+		if !ok || !file.modTime.Equal(fi.ModTime()) || file.size != fi.Size() {
+			file = readManifestFile(name, fi)
+			l.files[rel] = file
+		}
+
+		if file.err != nil {
+			fileErrors = append(fileErrors, FileError{File: rel, Err: file.err})
+			return nil
+		}
+
+		for i := range file.objects {
+			manifests = append(manifests, Manifest{
+				File:   rel,
+				Index:  i,
+				Object: file.objects[i].DeepCopy(),
+			})
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "load manifests from %s", l.root)
+	}
+
+	for rel := range l.files {
+		if !seen[rel] {
+			delete(l.files, rel)
+		}
+	}
+
+	return manifests, fileErrors, nil
+}
+
+func readManifestFile(name string, fi os.FileInfo) loadedFile {
+	file := loadedFile{
+		modTime: fi.ModTime(),
+		size:    fi.Size(),
+	}
+
+	data, err := ioutil.ReadFile(name)
+	if err != nil {
+		file.err = errors.Wrap(err, "read manifest")
+		return file
+	}
+
+	file.objects, file.err = decodeManifest(data)
+	if file.err != nil {
+		file.err = errors.Wrap(file.err, "decode manifest")
+	}
+
+	return file
+}
+
+func decodeManifest(data []byte) ([]*unstructured.Unstructured, error) {
+	decoder := k8syaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+
+	var objects []*unstructured.Unstructured
+
+	for {
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+
+		raw = bytes.TrimSpace(raw)
+		if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+			continue
+		}
+
+		object := &unstructured.Unstructured{}
+		if err := object.UnmarshalJSON(raw); err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, object)
+	}
+
+	return objects, nil
+}
+
+// Compare compares a manifest to the object with the same identity in the
+// cluster. Manifests without a namespace are looked up in the default
+// namespace first, and then as cluster scoped objects.
+func Compare(ctx context.Context, objectStore store.Store, defaultNamespace string, manifest Manifest) (Comparison, error) {
+	comparison := Comparison{Manifest: manifest}
+
+	object := manifest.Object
+
+	key := store.Key{
+		Namespace:  object.GetNamespace(),
+		APIVersion: object.GetAPIVersion(),
+		Kind:       object.GetKind(),
+		Name:       object.GetName(),
+	}
+
+	if key.Namespace == "" {
+		key.Namespace = defaultNamespace
+	}
+
+	live, found, err := objectStore.Get(ctx, key)
+	if err != nil {
+		return Comparison{}, errors.Wrapf(err, "get %s from cluster", key)
+	}
+
+	if !found && object.GetNamespace() == "" && key.Namespace != "" {
+		key.Namespace = ""
+		live, found, err = objectStore.Get(ctx, key)
+		if err != nil {
+			return Comparison{}, errors.Wrapf(err, "get %s from cluster", key)
+		}
+	}
+
+	if !found || live == nil {
+		comparison.Status = StatusNotInCluster
+		return comparison, nil
+	}
+
+	comparison.Live = live
+	comparison.Differences = Diff(object.Object, live.Object)
+
+	comparison.Status = StatusInSync
+	if len(comparison.Differences) > 0 {
+		comparison.Status = StatusDiffers
+	}
+
+	return comparison, nil
+}
+
+// Diff lists the fields set in a manifest whose values are different in the
+// live object. Fields which are only set in the live object (e.g. defaults
+// and status) are ignored, as are server managed metadata fields.
+func Diff(local, live map[string]interface{}) []Difference {
+	var differences []Difference
+
+	for _, key := range sortedKeys(local) {
+		switch key {
+		case "apiVersion", "kind", "status":
+			continue
+		case "metadata":
+			localMetadata, _ := local[key].(map[string]interface{})
+			liveMetadata, _ := live[key].(map[string]interface{})
+			for _, field := range []string{"labels", "annotations"} {
+				value, ok := localMetadata[field]
+				if !ok {
+					continue
+				}
+				liveValue, inCluster := liveMetadata[field]
+				differences = append(differences, diffValue("metadata."+field, value, liveValue, inCluster)...)
+			}
+			continue
+		}
+
+		liveValue, inCluster := live[key]
+		differences = append(differences, diffValue(key, local[key], liveValue, inCluster)...)
+	}
+
+	return differences
+}
+
+func diffValue(path string, local, live interface{}, inCluster bool) []Difference {
+	if !inCluster {
+		return []Difference{{Path: path, Local: local}}
+	}
+
+	switch localValue := local.(type) {
+	case map[string]interface{}:
+		liveValue, ok := live.(map[string]interface{})
+		if !ok {
+			break
+		}
+
+		var differences []Difference
+		for _, key := range sortedKeys(localValue) {
+			value, ok := liveValue[key]
+			differences = append(differences, diffValue(path+"."+key, localValue[key], value, ok)...)
+		}
+		return differences
+	case []interface{}:
+		liveValue, ok := live.([]interface{})
+		if !ok || len(liveValue) != len(localValue) {
+			break
+		}
+
+		var differences []Difference
+		for i := range localValue {
+			differences = append(differences, diffValue(fmt.Sprintf("%s[%d]", path, i), localValue[i], liveValue[i], true)...)
+		}
+		return differences
+	default:
+		if fmt.Sprint(local) == fmt.Sprint(live) {
+			return nil
+		}
+	}
+
+	return []Difference{{Path: path, Local: local, Cluster: live, InCluster: true}}
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
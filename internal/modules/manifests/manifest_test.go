@@ -0,0 +1,218 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package manifests
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware/octant/pkg/store"
+	storefake "github.com/vmware/octant/pkg/store/fake"
+)
+
+func TestLoadManifests(t *testing.T) {
+	manifests, err := LoadManifests("testdata")
+	require.NoError(t, err)
+
+	var got []string
+	for _, manifest := range manifests {
+		got = append(got, manifest.ID()+" "+manifest.Object.GetKind())
+	}
+
+	expected := []string{
+		"app.yaml/0 Deployment",
+		"app.yaml/1 Service",
+		filepath.ToSlash(filepath.Join("nested", "namespace.json")) + "/0 Namespace",
+	}
+
+	assert.Equal(t, expected, got)
+}
+
+func TestLoader_Load(t *testing.T) {
+	dir, err := ioutil.TempDir("", "manifests")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	configMap := filepath.Join(dir, "configmap.yaml")
+	invalid := filepath.Join(dir, "invalid.yaml")
+
+	writeManifest := func(name, data string, modTime time.Time) {
+		require.NoError(t, ioutil.WriteFile(name, []byte(data), 0644))
+		require.NoError(t, os.Chtimes(name, modTime, modTime))
+	}
+
+	modTime := time.Unix(1548424410, 0)
+	writeManifest(configMap, "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a\n", modTime)
+	writeManifest(invalid, "kind: [", modTime)
+
+	loader := NewLoader(dir)
+
+	names := func() []string {
+		manifests, _, err := loader.Load()
+		require.NoError(t, err)
+
+		var got []string
+		for _, manifest := range manifests {
+			got = append(got, manifest.Object.GetName())
+		}
+		return got
+	}
+
+	manifests, fileErrors, err := loader.Load()
+	require.NoError(t, err)
+	require.Len(t, manifests, 1)
+	require.Len(t, fileErrors, 1)
+	assert.Equal(t, "invalid.yaml", fileErrors[0].File)
+
+	// a file is not read again while its modification time and size are unchanged.
+	writeManifest(configMap, "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: b\n", modTime)
+	assert.Equal(t, []string{"a"}, names())
+
+	writeManifest(configMap, "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: b\n", modTime.Add(time.Second))
+	assert.Equal(t, []string{"b"}, names())
+
+	require.NoError(t, os.Remove(invalid))
+	_, fileErrors, err = loader.Load()
+	require.NoError(t, err)
+	assert.Empty(t, fileErrors)
+	assert.Len(t, loader.files, 1)
+}
+
+func TestDiff(t *testing.T) {
+	local := map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":   "app",
+			"labels": map[string]interface{}{"app": "app"},
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(2),
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "app", "image": "app:2"},
+					},
+				},
+			},
+		},
+	}
+
+	live := map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":            "app",
+			"resourceVersion": "1",
+		},
+		"spec": map[string]interface{}{
+			"replicas":             int64(1),
+			"revisionHistoryLimit": int64(10),
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "app", "image": "app:1", "imagePullPolicy": "IfNotPresent"},
+					},
+				},
+			},
+		},
+		"status": map[string]interface{}{"replicas": int64(1)},
+	}
+
+	expected := []Difference{
+		{Path: "metadata.labels", Local: map[string]interface{}{"app": "app"}},
+		{Path: "spec.replicas", Local: int64(2), Cluster: int64(1), InCluster: true},
+		{Path: "spec.template.spec.containers[0].image", Local: "app:2", Cluster: "app:1", InCluster: true},
+	}
+
+	assert.Equal(t, expected, Diff(local, live))
+}
+
+func TestCompare(t *testing.T) {
+	deployment := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]interface{}{"name": "app"},
+			"spec":       map[string]interface{}{"replicas": int64(2)},
+		},
+	}
+
+	namespace := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Namespace",
+			"metadata":   map[string]interface{}{"name": "app"},
+		},
+	}
+
+	liveDeployment := deployment.DeepCopy()
+	liveDeployment.SetNamespace("default")
+
+	deploymentKey := store.Key{Namespace: "default", APIVersion: "apps/v1", Kind: "Deployment", Name: "app"}
+	namespaceKey := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Namespace", Name: "app"}
+	clusterNamespaceKey := store.Key{APIVersion: "v1", Kind: "Namespace", Name: "app"}
+
+	tests := []struct {
+		name     string
+		manifest Manifest
+		init     func(o *storefake.MockStore)
+		expected Status
+	}{
+		{
+			name:     "in sync",
+			manifest: Manifest{Object: deployment},
+			init: func(o *storefake.MockStore) {
+				o.EXPECT().Get(gomock.Any(), deploymentKey).Return(liveDeployment, true, nil)
+			},
+			expected: StatusInSync,
+		},
+		{
+			name:     "not in cluster",
+			manifest: Manifest{Object: deployment},
+			init: func(o *storefake.MockStore) {
+				o.EXPECT().Get(gomock.Any(), deploymentKey).Return(nil, false, nil)
+				o.EXPECT().Get(gomock.Any(), store.Key{APIVersion: "apps/v1", Kind: "Deployment", Name: "app"}).
+					Return(nil, false, nil)
+			},
+			expected: StatusNotInCluster,
+		},
+		{
+			name:     "cluster scoped",
+			manifest: Manifest{Object: namespace},
+			init: func(o *storefake.MockStore) {
+				o.EXPECT().Get(gomock.Any(), namespaceKey).Return(nil, false, nil)
+				o.EXPECT().Get(gomock.Any(), clusterNamespaceKey).Return(namespace, true, nil)
+			},
+			expected: StatusInSync,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			o := storefake.NewMockStore(controller)
+			test.init(o)
+
+			ctx := context.Background()
+			got, err := Compare(ctx, o, "default", test.manifest)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, got.Status)
+		})
+	}
+}
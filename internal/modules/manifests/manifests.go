@@ -0,0 +1,308 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package manifests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware/octant/internal/config"
+	"github.com/vmware/octant/internal/module"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/pkg/navigation"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// Options are options for configuring Manifests.
+type Options struct {
+	// Root is the directory containing manifests.
+	Root       string
+	Namespace  string
+	DashConfig config.Dash
+}
+
+// Manifests is a module which renders manifests from a local directory
+// alongside the objects they describe in the cluster. Files which changed
+// are re-read whenever content is generated, so edits to manifests are
+// shown on the next refresh.
+type Manifests struct {
+	loader     *Loader
+	dashConfig config.Dash
+
+	mu        sync.Mutex
+	namespace string
+}
+
+var _ module.Module = (*Manifests)(nil)
+
+// New creates an instance of Manifests.
+func New(options Options) *Manifests {
+	return &Manifests{
+		loader:     NewLoader(options.Root),
+		namespace:  options.Namespace,
+		dashConfig: options.DashConfig,
+	}
+}
+
+// Name returns the name of the module.
+func (m *Manifests) Name() string {
+	return "manifests"
+}
+
+// ClientRequestHandlers returns nil.
+func (m *Manifests) ClientRequestHandlers() []octant.ClientRequestHandler {
+	return nil
+}
+
+// Content generates content for a path.
+func (m *Manifests) Content(ctx context.Context, contentPath string, opts module.ContentOptions) (component.ContentResponse, error) {
+	contentPath = strings.Trim(contentPath, "/")
+
+	comparisons, fileErrors, err := m.compare(ctx)
+	if err != nil {
+		return component.ContentResponse{}, err
+	}
+
+	if contentPath == "" {
+		return m.list(comparisons, fileErrors)
+	}
+
+	for _, comparison := range comparisons {
+		if comparison.Manifest.ID() == contentPath {
+			return m.detail(comparison)
+		}
+	}
+
+	return component.ContentResponse{}, errors.Errorf("manifest %s was not found", contentPath)
+}
+
+func (m *Manifests) compare(ctx context.Context) ([]Comparison, []FileError, error) {
+	manifests, fileErrors, err := m.loader.Load()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	namespace := m.currentNamespace()
+	objectStore := m.dashConfig.ObjectStore()
+
+	var comparisons []Comparison
+	for _, manifest := range manifests {
+		comparison, err := Compare(ctx, objectStore, namespace, manifest)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		comparisons = append(comparisons, comparison)
+	}
+
+	return comparisons, fileErrors, nil
+}
+
+// list lists the manifests. Files which could not be loaded are listed
+// separately with the reason.
+func (m *Manifests) list(comparisons []Comparison, fileErrors []FileError) (component.ContentResponse, error) {
+	cols := component.NewTableCols("Name", "Kind", "Namespace", "File", "Status")
+	table := component.NewTable("Local Manifests", "We couldn't find any local manifests!", cols)
+
+	for _, comparison := range comparisons {
+		object := comparison.Manifest.Object
+
+		table.Add(component.TableRow{
+			"Name":      component.NewLink("", object.GetName(), path.Join("/", m.ContentPath(), comparison.Manifest.ID())),
+			"Kind":      component.NewText(object.GetKind()),
+			"Namespace": component.NewText(object.GetNamespace()),
+			"File":      component.NewText(comparison.Manifest.File),
+			"Status":    component.NewText(statusText(comparison)),
+		})
+	}
+
+	components := []component.Component{table}
+
+	if len(fileErrors) > 0 {
+		errorCols := component.NewTableCols("File", "Error")
+		errorTable := component.NewTable("Invalid Manifests", "", errorCols)
+
+		for _, fileError := range fileErrors {
+			errorTable.Add(component.TableRow{
+				"File":  component.NewText(fileError.File),
+				"Error": component.NewText(fileError.Err.Error()),
+			})
+		}
+
+		components = append(components, errorTable)
+	}
+
+	return component.ContentResponse{
+		Title:      component.Title(component.NewText("Local Manifests")),
+		Components: components,
+	}, nil
+}
+
+func (m *Manifests) detail(comparison Comparison) (component.ContentResponse, error) {
+	object := comparison.Manifest.Object
+
+	var sections component.SummarySections
+	sections.AddText("File", comparison.Manifest.File)
+	sections.AddText("Kind", object.GetKind())
+	sections.AddText("API Version", object.GetAPIVersion())
+	sections.AddText("Status", statusText(comparison))
+
+	if comparison.Live != nil {
+		sections.Add("Cluster Object", m.liveLink(comparison.Live))
+	}
+
+	summary := component.NewSummary("Manifest", sections...)
+
+	cols := component.NewTableCols("Field", "Local", "Cluster")
+	table := component.NewTable("Differences", "The cluster matches this manifest.", cols)
+	if comparison.Status == StatusNotInCluster {
+		table = component.NewTable("Differences", "Applying this manifest will create a new object.", cols)
+	}
+
+	for _, difference := range comparison.Differences {
+		clusterValue := "<not set>"
+		if difference.InCluster {
+			clusterValue = formatValue(difference.Cluster)
+		}
+
+		table.Add(component.TableRow{
+			"Field":   component.NewText(difference.Path),
+			"Local":   component.NewText(formatValue(difference.Local)),
+			"Cluster": component.NewText(clusterValue),
+		})
+	}
+
+	manifestYAML := component.NewYAML(component.TitleFromString("Local Manifest"), "")
+	if err := manifestYAML.Data(object); err != nil {
+		return component.ContentResponse{}, errors.Wrap(err, "convert manifest to YAML")
+	}
+
+	return component.ContentResponse{
+		Title: component.Title(
+			component.NewLink("", "Local Manifests", path.Join("/", m.ContentPath())),
+			component.NewText(object.GetName())),
+		Components: []component.Component{summary, table, manifestYAML},
+	}, nil
+}
+
+func (m *Manifests) liveLink(live *unstructured.Unstructured) component.Component {
+	objectPath, err := m.dashConfig.ObjectPath(live.GetNamespace(), live.GetAPIVersion(), live.GetKind(), live.GetName())
+	if err != nil {
+		return component.NewText(live.GetName())
+	}
+
+	return component.NewLink("", live.GetName(), objectPath)
+}
+
+func statusText(comparison Comparison) string {
+	if comparison.Status == StatusDiffers {
+		return fmt.Sprintf("%s (%d fields)", comparison.Status, len(comparison.Differences))
+	}
+
+	return string(comparison.Status)
+}
+
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case map[string]interface{}, []interface{}:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// ContentPath returns the content path for the module.
+func (m *Manifests) ContentPath() string {
+	return m.Name()
+}
+
+// Navigation returns navigation entries for the module.
+func (m *Manifests) Navigation(ctx context.Context, namespace, root string) ([]navigation.Navigation, error) {
+	if !strings.HasSuffix(root, "/") {
+		root = fmt.Sprintf("%s/", root)
+	}
+
+	return []navigation.Navigation{
+		{
+			Title: "Local Manifests",
+			Path:  root,
+		},
+	}, nil
+}
+
+// SetNamespace sets the namespace used for manifests which don't specify one.
+func (m *Manifests) SetNamespace(namespace string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.namespace = namespace
+	return nil
+}
+
+func (m *Manifests) currentNamespace() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.namespace
+}
+
+// Start starts the module.
+func (m *Manifests) Start() error {
+	return nil
+}
+
+// Stop stops the module.
+func (m *Manifests) Stop() {
+}
+
+// SetContext sets the current context name.
+func (m *Manifests) SetContext(ctx context.Context, contextName string) error {
+	return nil
+}
+
+// Generators allow modules to send events to the frontend.
+func (m *Manifests) Generators() []octant.Generator {
+	return []octant.Generator{}
+}
+
+// SupportedGroupVersionKind returns an empty list.
+func (m *Manifests) SupportedGroupVersionKind() []schema.GroupVersionKind {
+	return []schema.GroupVersionKind{}
+}
+
+// GroupVersionKindPath returns an error since manifests are not routed by GVK.
+func (m *Manifests) GroupVersionKindPath(namespace, apiVersion, kind, name string) (string, error) {
+	return "", errors.Errorf("manifests can't create paths for %s %s", apiVersion, kind)
+}
+
+// AddCRD is a no-op.
+func (m *Manifests) AddCRD(ctx context.Context, crd *unstructured.Unstructured) error {
+	return nil
+}
+
+// RemoveCRD is a no-op.
+func (m *Manifests) RemoveCRD(ctx context.Context, crd *unstructured.Unstructured) error {
+	return nil
+}
+
+// ResetCRDs is a no-op.
+func (m *Manifests) ResetCRDs(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,106 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package manifests
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	configFake "github.com/vmware/octant/internal/config/fake"
+	"github.com/vmware/octant/internal/module"
+	"github.com/vmware/octant/pkg/navigation"
+	storefake "github.com/vmware/octant/pkg/store/fake"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func TestManifests_Content_list(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := storefake.NewMockStore(controller)
+	objectStore.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, false, nil).AnyTimes()
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectStore().Return(objectStore)
+
+	m := New(Options{Root: "testdata", Namespace: "default", DashConfig: dashConfig})
+
+	ctx := context.Background()
+	content, err := m.Content(ctx, "/", module.ContentOptions{})
+	require.NoError(t, err)
+
+	require.Len(t, content.Components, 1)
+	table, ok := content.Components[0].(*component.Table)
+	require.True(t, ok)
+
+	require.Len(t, table.Rows(), 3)
+	assert.Equal(t, component.NewLink("", "app", "/manifests/app.yaml/0"), table.Rows()[0]["Name"])
+	assert.Equal(t, component.NewText("Not in cluster"), table.Rows()[0]["Status"])
+}
+
+func TestManifests_Content_list_invalid(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dir, err := ioutil.TempDir("", "manifests")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "invalid.yaml"), []byte("kind: ["), 0644))
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectStore().Return(storefake.NewMockStore(controller))
+
+	m := New(Options{Root: dir, Namespace: "default", DashConfig: dashConfig})
+
+	ctx := context.Background()
+	content, err := m.Content(ctx, "/", module.ContentOptions{})
+	require.NoError(t, err)
+
+	require.Len(t, content.Components, 2)
+	table, ok := content.Components[1].(*component.Table)
+	require.True(t, ok)
+
+	require.Len(t, table.Rows(), 1)
+	assert.Equal(t, component.NewText("invalid.yaml"), table.Rows()[0]["File"])
+}
+
+func TestManifests_Content_unknown(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := storefake.NewMockStore(controller)
+	objectStore.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, false, nil).AnyTimes()
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectStore().Return(objectStore)
+
+	m := New(Options{Root: "testdata", Namespace: "default", DashConfig: dashConfig})
+
+	ctx := context.Background()
+	_, err := m.Content(ctx, "/app.yaml/5", module.ContentOptions{})
+	require.Error(t, err)
+}
+
+func TestManifests_Navigation(t *testing.T) {
+	m := New(Options{Root: "testdata"})
+
+	ctx := context.Background()
+	got, err := m.Navigation(ctx, "default", "/manifests")
+	require.NoError(t, err)
+
+	expected := []navigation.Navigation{
+		{Title: "Local Manifests", Path: "/manifests/"},
+	}
+	assert.Equal(t, expected, got)
+}
@@ -0,0 +1,151 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package container
+
+import (
+	"context"
+	"regexp"
+	"sync"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// LogFilter filters log entries by their message.
+type LogFilter struct {
+	include *regexp.Regexp
+	exclude *regexp.Regexp
+}
+
+// NewLogFilter creates an instance of LogFilter. Entries must match include
+// and must not match exclude. Blank expressions are ignored.
+func NewLogFilter(include, exclude string) (*LogFilter, error) {
+	f := &LogFilter{}
+
+	if include != "" {
+		re, err := regexp.Compile(include)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid include expression")
+		}
+		f.include = re
+	}
+
+	if exclude != "" {
+		re, err := regexp.Compile(exclude)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid exclude expression")
+		}
+		f.exclude = re
+	}
+
+	return f, nil
+}
+
+// Matches returns true if an entry passes the filter.
+func (f *LogFilter) Matches(entry component.LogEntry) bool {
+	if f == nil {
+		return true
+	}
+
+	if f.include != nil && !f.include.MatchString(entry.Message) {
+		return false
+	}
+
+	if f.exclude != nil && f.exclude.MatchString(entry.Message) {
+		return false
+	}
+
+	return true
+}
+
+// LogEntries sends the entries a container logged to entryCh. Lines which
+// can't be parsed are skipped. entryCh is closed when the stream ends.
+func LogEntries(ctx context.Context, client kubernetes.Interface, namespace, podName, container string, options LogOptions, entryCh chan<- component.LogEntry) error {
+	if entryCh == nil {
+		return errors.New("channel is nil")
+	}
+	defer close(entryCh)
+
+	lines := make(chan string)
+	done := make(chan bool)
+
+	go func() {
+		for line := range lines {
+			entry, err := ParseLogLine(line)
+			if err == nil {
+				entryCh <- entry
+			}
+		}
+
+		done <- true
+	}()
+
+	err := Logs(ctx, client, namespace, podName, container, options, lines)
+	<-done
+
+	return err
+}
+
+// PodContainer is a container in a pod.
+type PodContainer struct {
+	Pod       string
+	Container string
+}
+
+// AggregateLogs merges the entries logged by containers in a namespace and
+// sends them to entryCh. Entries are labeled with their pod, container, and
+// the pod's color key. Entries which don't match the filter are dropped.
+// entryCh is closed when every stream has ended. The first stream error is
+// returned.
+func AggregateLogs(ctx context.Context, client kubernetes.Interface, namespace string, containers []PodContainer, options LogOptions, filter *LogFilter, entryCh chan<- component.LogEntry) error {
+	if entryCh == nil {
+		return errors.New("channel is nil")
+	}
+	defer close(entryCh)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var firstErr error
+
+	for i := range containers {
+		pc := containers[i]
+		color := component.LogColor(pc.Pod)
+
+		ch := make(chan component.LogEntry)
+
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for entry := range ch {
+				if !filter.Matches(entry) {
+					continue
+				}
+
+				entry.Pod = pc.Pod
+				entry.Container = pc.Container
+				entry.Color = color
+				entryCh <- entry
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+			if err := LogEntries(ctx, client, namespace, pc.Pod, pc.Container, options, ch); err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = errors.Wrapf(err, "logs for %s/%s", pc.Pod, pc.Container)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return firstErr
+}
@@ -0,0 +1,72 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package container
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func TestLogFilter_Matches(t *testing.T) {
+	cases := []struct {
+		name     string
+		include  string
+		exclude  string
+		message  string
+		expected bool
+	}{
+		{
+			name:     "no expressions",
+			message:  "anything",
+			expected: true,
+		},
+		{
+			name:     "included",
+			include:  "^GET ",
+			message:  "GET /healthz",
+			expected: true,
+		},
+		{
+			name:    "not included",
+			include: "^GET ",
+			message: "POST /api",
+		},
+		{
+			name:     "included and excluded",
+			include:  "^GET ",
+			exclude:  "healthz",
+			message:  "GET /healthz",
+			expected: false,
+		},
+		{
+			name:     "not excluded",
+			exclude:  "healthz",
+			message:  "GET /api",
+			expected: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			f, err := NewLogFilter(tc.include, tc.exclude)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, f.Matches(component.LogEntry{Message: tc.message}))
+		})
+	}
+}
+
+func TestNewLogFilter_invalid(t *testing.T) {
+	_, err := NewLogFilter("(", "")
+	require.Error(t, err)
+
+	_, err = NewLogFilter("", "[")
+	require.Error(t, err)
+}
@@ -23,12 +23,27 @@ var (
 	durContainerUpWait = 1 * time.Second
 )
 
-func Logs(ctx context.Context, client kubernetes.Interface, namespace, podName, container string, logCh chan<- string) error {
+// LogOptions are options for streaming a container's logs.
+type LogOptions struct {
+	// SinceSeconds limits logs to recent entries. Zero includes all entries.
+	SinceSeconds int64
+	// Follow keeps streaming entries as they are logged until the context
+	// is done.
+	Follow bool
+	// Previous streams the logs of the container's previous instance, e.g.
+	// before it crashed and was restarted.
+	Previous bool
+}
+
+// Logs sends the lines a container logged to logCh. logCh is closed when the
+// stream ends.
+func Logs(ctx context.Context, client kubernetes.Interface, namespace, podName, container string, options LogOptions, logCh chan<- string) error {
 	lp := logPrinter{
 		client:    client,
 		namespace: namespace,
 		podName:   podName,
 		container: container,
+		options:   options,
 	}
 
 	return lp.logs(ctx, logCh)
@@ -40,6 +55,8 @@ type logPrinter struct {
 	namespace string
 	podName   string
 	container string
+
+	options LogOptions
 }
 
 func (lp *logPrinter) logs(ctx context.Context, ch chan<- string) error {
@@ -49,7 +66,9 @@ func (lp *logPrinter) logs(ctx context.Context, ch chan<- string) error {
 
 	defer close(ch)
 
-	for ctx.Err() == nil {
+	// A previous instance has already terminated, so there is no need to
+	// wait for it.
+	for ctx.Err() == nil && !lp.options.Previous {
 		hasStarted, err := lp.containerHasStarted()
 		if err != nil {
 			return errors.Wrap(err, "check if container has started")
@@ -62,7 +81,7 @@ func (lp *logPrinter) logs(ctx context.Context, ch chan<- string) error {
 		time.Sleep(durContainerUpWait)
 	}
 
-	stream, err := lp.stream()
+	stream, err := lp.stream(ctx)
 	if err != nil {
 		return errors.Wrap(err, "stream container logs")
 	}
@@ -73,7 +92,7 @@ func (lp *logPrinter) logs(ctx context.Context, ch chan<- string) error {
 		ch <- scanner.Text()
 	}
 
-	if scanner.Err() != nil {
+	if err := scanner.Err(); err != nil {
 		return errors.Wrap(err, "scanner error")
 	}
 
@@ -95,10 +114,23 @@ func (lp *logPrinter) containerHasStarted() (bool, error) {
 	return false, nil
 }
 
-func (lp *logPrinter) stream() (io.ReadCloser, error) {
-	return lp.client.CoreV1().Pods(lp.namespace).GetLogs(lp.podName, &corev1.PodLogOptions{
-		Container:  lp.container,
-		Follow:     false,
+func (lp *logPrinter) stream(ctx context.Context) (io.ReadCloser, error) {
+	options := podLogOptions(lp.container, lp.options)
+	return lp.client.CoreV1().Pods(lp.namespace).GetLogs(lp.podName, options).Context(ctx).Stream()
+}
+
+func podLogOptions(container string, options LogOptions) *corev1.PodLogOptions {
+	podLogOptions := &corev1.PodLogOptions{
+		Container:  container,
+		Follow:     options.Follow,
+		Previous:   options.Previous,
 		Timestamps: true,
-	}).Stream()
+	}
+
+	if options.SinceSeconds > 0 {
+		sinceSeconds := options.SinceSeconds
+		podLogOptions.SinceSeconds = &sinceSeconds
+	}
+
+	return podLogOptions
 }
@@ -0,0 +1,45 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package container
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func Test_podLogOptions(t *testing.T) {
+	sinceSeconds := int64(60)
+
+	cases := []struct {
+		name     string
+		options  LogOptions
+		expected *corev1.PodLogOptions
+	}{
+		{
+			name:     "defaults",
+			expected: &corev1.PodLogOptions{Container: "app", Timestamps: true},
+		},
+		{
+			name:    "follow previous since",
+			options: LogOptions{SinceSeconds: 60, Follow: true, Previous: true},
+			expected: &corev1.PodLogOptions{
+				Container:    "app",
+				Follow:       true,
+				Previous:     true,
+				SinceSeconds: &sinceSeconds,
+				Timestamps:   true,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, podLogOptions("app", tc.options))
+		})
+	}
+}
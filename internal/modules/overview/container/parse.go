@@ -0,0 +1,240 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package container
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+var (
+	// klogRe matches the klog header: Lmmdd hh:mm:ss.uuuuuu threadid file:line] msg
+	klogRe = regexp.MustCompile(`^([IWEF])\d{4} \d{2}:\d{2}:\d{2}\.\d+\s+(\d+) ([^\]\s]+)\] ?(.*)$`)
+
+	klogLevels = map[string]component.LogLevel{
+		"I": component.LogLevelInfo,
+		"W": component.LogLevelWarning,
+		"E": component.LogLevelError,
+		"F": component.LogLevelFatal,
+	}
+
+	logLevelNames = map[string]component.LogLevel{
+		"trace":    component.LogLevelDebug,
+		"debug":    component.LogLevelDebug,
+		"dbg":      component.LogLevelDebug,
+		"info":     component.LogLevelInfo,
+		"notice":   component.LogLevelInfo,
+		"warn":     component.LogLevelWarning,
+		"warning":  component.LogLevelWarning,
+		"error":    component.LogLevelError,
+		"err":      component.LogLevelError,
+		"fatal":    component.LogLevelFatal,
+		"panic":    component.LogLevelFatal,
+		"critical": component.LogLevelFatal,
+		"crit":     component.LogLevelFatal,
+	}
+
+	levelKeys   = []string{"level", "lvl", "severity", "loglevel"}
+	messageKeys = []string{"msg", "message", "log"}
+)
+
+// ParseLogLine parses a line from a container log stream. Lines are
+// expected to be prefixed with a RFC3339 timestamp. The remainder of the
+// line is parsed as JSON, klog, or logfmt to find the entry's level,
+// message, and fields. Lines in other formats are returned as a message
+// with an unknown level.
+func ParseLogLine(line string) (component.LogEntry, error) {
+	parts := strings.SplitN(line, " ", 2)
+	timestamp, err := time.Parse(time.RFC3339, parts[0])
+	if err != nil {
+		return component.LogEntry{}, errors.Wrap(err, "parse log timestamp")
+	}
+
+	var body string
+	if len(parts) > 1 {
+		body = parts[1]
+	}
+
+	entry, ok := parseJSONLog(body)
+	if !ok {
+		entry, ok = parseKlog(body)
+	}
+	if !ok {
+		entry, ok = parseLogfmt(body)
+	}
+	if !ok {
+		entry = component.LogEntry{Message: body}
+	}
+
+	entry.Timestamp = timestamp
+	return entry, nil
+}
+
+func parseJSONLog(body string) (component.LogEntry, bool) {
+	if !strings.HasPrefix(strings.TrimSpace(body), "{") {
+		return component.LogEntry{}, false
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(body), &m); err != nil {
+		return component.LogEntry{}, false
+	}
+
+	fields := make(map[string]string)
+	for k, v := range m {
+		switch v := v.(type) {
+		case string:
+			fields[k] = v
+		case nil:
+			fields[k] = ""
+		case map[string]interface{}, []interface{}:
+			data, err := json.Marshal(v)
+			if err != nil {
+				return component.LogEntry{}, false
+			}
+			fields[k] = string(data)
+		default:
+			fields[k] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	return entryFromFields(fields), true
+}
+
+func parseKlog(body string) (component.LogEntry, bool) {
+	match := klogRe.FindStringSubmatch(body)
+	if match == nil {
+		return component.LogEntry{}, false
+	}
+
+	return component.LogEntry{
+		Level:   klogLevels[match[1]],
+		Message: match[4],
+		Fields: map[string]string{
+			"thread": match[2],
+			"source": match[3],
+		},
+	}, true
+}
+
+func parseLogfmt(body string) (component.LogEntry, bool) {
+	fields, ok := splitLogfmt(body)
+	if !ok {
+		return component.LogEntry{}, false
+	}
+
+	// Plain text can contain a key=value pair, so require a level or
+	// message key before treating a line as logfmt.
+	if _, ok := firstField(fields, levelKeys); !ok {
+		if _, ok := firstField(fields, messageKeys); !ok {
+			return component.LogEntry{}, false
+		}
+	}
+
+	return entryFromFields(fields), true
+}
+
+// splitLogfmt splits a logfmt line into its fields. It returns false
+// if the line contains anything other than key=value pairs.
+func splitLogfmt(body string) (map[string]string, bool) {
+	fields := make(map[string]string)
+
+	s := strings.TrimSpace(body)
+	for s != "" {
+		eq := strings.IndexAny(s, "= ")
+		if eq <= 0 || s[eq] != '=' {
+			return nil, false
+		}
+
+		key := s[:eq]
+		s = s[eq+1:]
+
+		var value string
+		if strings.HasPrefix(s, `"`) {
+			end := 1
+			for end < len(s) && (s[end] != '"' || s[end-1] == '\\') {
+				end++
+			}
+			if end == len(s) {
+				return nil, false
+			}
+
+			var err error
+			if value, err = unquoteLogfmt(s[:end+1]); err != nil {
+				return nil, false
+			}
+			s = s[end+1:]
+		} else {
+			end := strings.IndexByte(s, ' ')
+			if end < 0 {
+				end = len(s)
+			}
+			value = s[:end]
+			s = s[end:]
+		}
+
+		if s != "" && s[0] != ' ' {
+			return nil, false
+		}
+
+		fields[key] = value
+		s = strings.TrimLeft(s, " ")
+	}
+
+	return fields, len(fields) > 0
+}
+
+func unquoteLogfmt(s string) (string, error) {
+	var value string
+	if err := json.Unmarshal([]byte(s), &value); err != nil {
+		return "", err
+	}
+
+	return value, nil
+}
+
+// entryFromFields creates a log entry from structured fields. The level
+// and message are removed from the fields.
+func entryFromFields(fields map[string]string) component.LogEntry {
+	entry := component.LogEntry{}
+
+	if key, ok := firstField(fields, levelKeys); ok {
+		entry.Level = parseLogLevel(fields[key])
+		delete(fields, key)
+	}
+
+	if key, ok := firstField(fields, messageKeys); ok {
+		entry.Message = fields[key]
+		delete(fields, key)
+	}
+
+	if len(fields) > 0 {
+		entry.Fields = fields
+	}
+
+	return entry
+}
+
+func firstField(fields map[string]string, keys []string) (string, bool) {
+	for _, key := range keys {
+		if _, ok := fields[key]; ok {
+			return key, true
+		}
+	}
+
+	return "", false
+}
+
+func parseLogLevel(s string) component.LogLevel {
+	return logLevelNames[strings.ToLower(strings.TrimSpace(s))]
+}
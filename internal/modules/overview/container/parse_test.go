@@ -0,0 +1,136 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package container
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func TestParseLogLine(t *testing.T) {
+	timestamp := time.Date(2019, 10, 16, 12, 30, 0, 500, time.UTC)
+	prefix := timestamp.Format(time.RFC3339Nano) + " "
+
+	tests := []struct {
+		name     string
+		line     string
+		expected component.LogEntry
+		isErr    bool
+	}{
+		{
+			name: "json",
+			line: prefix + `{"level":"WARN","msg":"disk almost full","used":0.95,"tags":["a"],"node":"node-1"}`,
+			expected: component.LogEntry{
+				Timestamp: timestamp,
+				Level:     component.LogLevelWarning,
+				Message:   "disk almost full",
+				Fields: map[string]string{
+					"used": "0.95",
+					"tags": `["a"]`,
+					"node": "node-1",
+				},
+			},
+		},
+		{
+			name: "json with severity",
+			line: prefix + `{"severity":"critical","message":"shutting down"}`,
+			expected: component.LogEntry{
+				Timestamp: timestamp,
+				Level:     component.LogLevelFatal,
+				Message:   "shutting down",
+			},
+		},
+		{
+			name: "klog",
+			line: prefix + `E1016 12:30:00.000500       1 controller.go:114] unable to sync "default/app"`,
+			expected: component.LogEntry{
+				Timestamp: timestamp,
+				Level:     component.LogLevelError,
+				Message:   `unable to sync "default/app"`,
+				Fields: map[string]string{
+					"thread": "1",
+					"source": "controller.go:114",
+				},
+			},
+		},
+		{
+			name: "logfmt",
+			line: prefix + `level=info msg="request complete" status=200 path=/healthz`,
+			expected: component.LogEntry{
+				Timestamp: timestamp,
+				Level:     component.LogLevelInfo,
+				Message:   "request complete",
+				Fields: map[string]string{
+					"status": "200",
+					"path":   "/healthz",
+				},
+			},
+		},
+		{
+			name: "logfmt with escaped quote",
+			line: prefix + `lvl=dbg msg="said \"hi\""`,
+			expected: component.LogEntry{
+				Timestamp: timestamp,
+				Level:     component.LogLevelDebug,
+				Message:   `said "hi"`,
+			},
+		},
+		{
+			name: "plain text with key value pair",
+			line: prefix + "listening on port=8080",
+			expected: component.LogEntry{
+				Timestamp: timestamp,
+				Message:   "listening on port=8080",
+			},
+		},
+		{
+			name: "key value pairs without level or message",
+			line: prefix + "a=b c=d",
+			expected: component.LogEntry{
+				Timestamp: timestamp,
+				Message:   "a=b c=d",
+			},
+		},
+		{
+			name: "invalid json",
+			line: prefix + "{not json",
+			expected: component.LogEntry{
+				Timestamp: timestamp,
+				Message:   "{not json",
+			},
+		},
+		{
+			name: "timestamp only",
+			line: timestamp.Format(time.RFC3339Nano),
+			expected: component.LogEntry{
+				Timestamp: timestamp,
+			},
+		},
+		{
+			name:  "missing timestamp",
+			line:  "message",
+			isErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseLogLine(test.line)
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
@@ -0,0 +1,148 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package overview
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/vmware/octant/internal/api"
+	"github.com/vmware/octant/internal/exec"
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/pkg/action"
+)
+
+// copyOptionsFromPayload returns the file in a container a payload
+// references.
+func copyOptionsFromPayload(payload action.Payload) (exec.CopyOptions, error) {
+	var options exec.CopyOptions
+
+	fields := map[string]*string{
+		"namespace":     &options.Namespace,
+		"name":          &options.Pod,
+		"containerName": &options.Container,
+		"path":          &options.Path,
+	}
+	for key, dest := range fields {
+		value, err := payload.String(key)
+		if err != nil {
+			return exec.CopyOptions{}, err
+		}
+		*dest = strings.TrimSpace(value)
+	}
+
+	return options, nil
+}
+
+// copyURL returns the API path a file in a container is downloaded from.
+func copyURL(options exec.CopyOptions) string {
+	p := path.Join(api.PathPrefix, "copy",
+		"namespace", options.Namespace,
+		"pod", options.Pod,
+		"container", options.Container)
+
+	return p + "?" + url.Values{"path": []string{options.Path}}.Encode()
+}
+
+// FileUploader uploads files to containers.
+type FileUploader struct {
+	executor exec.Interface
+}
+
+var _ action.Dispatcher = (*FileUploader)(nil)
+
+// NewFileUploader creates an instance of FileUploader.
+func NewFileUploader(executor exec.Interface) *FileUploader {
+	return &FileUploader{
+		executor: executor,
+	}
+}
+
+// ActionName returns the name of the action.
+func (u *FileUploader) ActionName() string {
+	return octant.ActionUploadFile
+}
+
+// Handle writes the file in the payload to a path in a container.
+func (u *FileUploader) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", u.ActionName())
+
+	options, err := copyOptionsFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	content, err := payload.String("file")
+	if err != nil {
+		return err
+	}
+
+	logger.With("pod", options.Pod, "container", options.Container, "path", options.Path).
+		Infof("uploading file")
+
+	alertType := action.AlertTypeInfo
+	message := fmt.Sprintf("Uploaded %s to container %q", options.Path, options.Container)
+
+	r := strings.NewReader(content)
+	if err := exec.CopyToContainer(ctx, u.executor, options, r, r.Size()); err != nil {
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to upload %s: %s", options.Path, err)
+	}
+
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+
+	return nil
+}
+
+// FileDownloader checks that files in containers can be downloaded.
+type FileDownloader struct {
+	executor exec.Interface
+}
+
+var _ action.Dispatcher = (*FileDownloader)(nil)
+
+// NewFileDownloader creates an instance of FileDownloader.
+func NewFileDownloader(executor exec.Interface) *FileDownloader {
+	return &FileDownloader{
+		executor: executor,
+	}
+}
+
+// ActionName returns the name of the action.
+func (d *FileDownloader) ActionName() string {
+	return octant.ActionDownloadFile
+}
+
+// Handle reads the file in the payload from a container, and sends an
+// alert with the path the file is downloaded from.
+func (d *FileDownloader) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", d.ActionName())
+
+	options, err := copyOptionsFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	logger.With("pod", options.Pod, "container", options.Container, "path", options.Path).
+		Infof("checking file download")
+
+	alertType := action.AlertTypeInfo
+	message := fmt.Sprintf("Download %s from %s", options.Path, copyURL(options))
+
+	if err := exec.CopyFromContainer(ctx, d.executor, options, ioutil.Discard); err != nil {
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to download %s: %s", options.Path, err)
+	}
+
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+
+	return nil
+}
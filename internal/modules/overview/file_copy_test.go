@@ -0,0 +1,137 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package overview
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/octant/internal/exec"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/pkg/action"
+	actionFake "github.com/vmware/octant/pkg/action/fake"
+)
+
+// fileExecutor archives or extracts a single file.
+type fileExecutor struct {
+	files map[string]string
+}
+
+func (e *fileExecutor) Stream(ctx context.Context, options exec.Options) error {
+	dir := options.Command[4]
+
+	if options.Stdin != nil {
+		tr := tar.NewReader(options.Stdin)
+		header, err := tr.Next()
+		if err != nil {
+			return err
+		}
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return err
+		}
+		e.files[dir+"/"+header.Name] = string(data)
+		return nil
+	}
+
+	name := options.Command[5]
+	content, ok := e.files[dir+"/"+name]
+	if !ok {
+		_, _ = fmt.Fprintf(options.Stderr, "tar: %s: No such file or directory", name)
+		return &exec.ExitError{Code: 2}
+	}
+
+	tw := tar.NewWriter(options.Stdout)
+	header := &tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+	if _, err := tw.Write([]byte(content)); err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+func filePayload(path string) action.Payload {
+	return action.Payload{
+		"namespace":     "default",
+		"name":          "pod",
+		"containerName": "app",
+		"path":          path,
+	}
+}
+
+func expectAlert(t *testing.T, controller *gomock.Controller, alertType action.AlertType, message string) *actionFake.MockAlerter {
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		DoAndReturn(func(alert action.Alert) {
+			assert.Equal(t, alertType, alert.Type)
+			assert.Equal(t, message, alert.Message)
+		})
+	return alerter
+}
+
+func TestFileUploader_Handle(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	executor := &fileExecutor{files: map[string]string{}}
+	uploader := NewFileUploader(executor)
+	require.Equal(t, octant.ActionUploadFile, uploader.ActionName())
+
+	alerter := expectAlert(t, controller, action.AlertTypeInfo, `Uploaded /tmp/upload.txt to container "app"`)
+
+	payload := filePayload("/tmp/upload.txt")
+	payload["file"] = "uploaded"
+
+	require.NoError(t, uploader.Handle(context.Background(), alerter, payload))
+	assert.Equal(t, map[string]string{"/tmp/upload.txt": "uploaded"}, executor.files)
+}
+
+func TestFileDownloader_Handle(t *testing.T) {
+	tests := []struct {
+		name         string
+		path         string
+		expectedType action.AlertType
+		expected     string
+	}{
+		{
+			name:         "file exists",
+			path:         "/etc/app/config.yaml",
+			expectedType: action.AlertTypeInfo,
+			expected:     "Download /etc/app/config.yaml from /api/v1/copy/namespace/default/pod/pod/container/app?path=%2Fetc%2Fapp%2Fconfig.yaml",
+		},
+		{
+			name:         "file does not exist",
+			path:         "/etc/app/missing.yaml",
+			expectedType: action.AlertTypeWarning,
+			expected:     "Unable to download /etc/app/missing.yaml: command terminated with exit code 2: tar: missing.yaml: No such file or directory",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			executor := &fileExecutor{files: map[string]string{"/etc/app/config.yaml": "key: value\n"}}
+			downloader := NewFileDownloader(executor)
+			require.Equal(t, octant.ActionDownloadFile, downloader.ActionName())
+
+			alerter := expectAlert(t, controller, test.expectedType, test.expected)
+
+			require.NoError(t, downloader.Handle(context.Background(), alerter, filePayload(test.path)))
+		})
+	}
+}
@@ -49,5 +49,30 @@ func ToComponent(object runtime.Object) (component.Component, error) {
 
 	logsComponent := component.NewLogs(pod.Namespace, pod.Name, containerNames)
 
+	// Show the first app container's logs until another is selected.
+	if len(pod.Spec.Containers) > 0 {
+		name := pod.Spec.Containers[0].Name
+		logsComponent.Config.Container = name
+		logsComponent.Config.Token = component.LogStreamToken(pod.Namespace, pod.Name, name, component.LogStreamOptions{})
+	}
+
+	logsComponent.Config.PreviousContainers = restartedContainers(pod)
+
 	return logsComponent, nil
 }
+
+// restartedContainers returns the names of a pod's containers which have a
+// previous instance.
+func restartedContainers(pod *corev1.Pod) []string {
+	var names []string
+
+	statuses := append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...)
+	statuses = append(statuses, pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		if status.RestartCount > 0 {
+			names = append(names, status.Name)
+		}
+	}
+
+	return names
+}
@@ -38,7 +38,7 @@ func Test_ToComponent(t *testing.T) {
 					},
 				},
 			},
-			expected: component.NewLogs("default", "pod", []string{"one", "two"}),
+			expected: newLogs([]string{"one", "two"}, nil),
 		},
 		{
 			name: "with init containers",
@@ -56,8 +56,14 @@ func Test_ToComponent(t *testing.T) {
 						{Name: "two"},
 					},
 				},
+				Status: corev1.PodStatus{
+					ContainerStatuses: []corev1.ContainerStatus{
+						{Name: "one", RestartCount: 2},
+						{Name: "two"},
+					},
+				},
 			},
-			expected: component.NewLogs("default", "pod", []string{"init", "one", "two"}),
+			expected: newLogs([]string{"init", "one", "two"}, []string{"one"}),
 		},
 		{
 			name:   "nil",
@@ -85,3 +91,11 @@ func Test_ToComponent(t *testing.T) {
 	}
 
 }
+
+func newLogs(containers, previousContainers []string) *component.Logs {
+	logs := component.NewLogs("default", "pod", containers)
+	logs.Config.Container = "one"
+	logs.Config.Token = "namespace/default/pod/pod/container/one"
+	logs.Config.PreviousContainers = previousContainers
+	return logs
+}
@@ -23,6 +23,8 @@ var (
 		"Custom Resources":             "custom-resources",
 		"RBAC":                         "rbac",
 		"Events":                       "events",
+		"Cleanup":                      "cleanup",
+		"Create":                       "create",
 	}
 )
 
@@ -35,6 +37,8 @@ func workloadEntries(ctx context.Context, prefix, namespace string, objectStore
 		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.DaemonSet), objectStore))
 	neh.Add("Deployments", "deployments", icon.OverviewDeployment,
 		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.Deployment), objectStore))
+	neh.Add("Horizontal Pod Autoscalers", "horizontal-pod-autoscalers", icon.OverviewHorizontalPodAutoscaler,
+		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.HorizontalPodAutoscaler), objectStore))
 	neh.Add("Jobs", "jobs", icon.OverviewJob,
 		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.Job), objectStore))
 	neh.Add("Pods", "pods", icon.OverviewPod,
@@ -18,10 +18,12 @@ import (
 	"github.com/vmware/octant/internal/api"
 	"github.com/vmware/octant/internal/config"
 	"github.com/vmware/octant/internal/describer"
+	"github.com/vmware/octant/internal/exec"
 	"github.com/vmware/octant/internal/generator"
 	"github.com/vmware/octant/internal/log"
 	"github.com/vmware/octant/internal/module"
 	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/internal/printer"
 	"github.com/vmware/octant/pkg/action"
 	"github.com/vmware/octant/pkg/icon"
 	"github.com/vmware/octant/pkg/navigation"
@@ -32,17 +34,20 @@ import (
 type Options struct {
 	Namespace  string
 	DashConfig config.Dash
+	// PrintRegistry holds the handlers objects are printed with.
+	PrintRegistry *printer.Registry
 }
 
 // Overview is an API for generating a cluster overview.
 type Overview struct {
 	*octant.ObjectPath
 
-	generator   generator.Interface
-	dashConfig  config.Dash
-	contextName string
-	pathMatcher *describer.PathMatcher
-	logger      log.Logger
+	generator     generator.Interface
+	dashConfig    config.Dash
+	printRegistry *printer.Registry
+	contextName   string
+	pathMatcher   *describer.PathMatcher
+	logger        log.Logger
 
 	watchedCRDs []*unstructured.Unstructured
 
@@ -63,8 +68,9 @@ func New(ctx context.Context, options Options) (*Overview, error) {
 	}
 
 	co := &Overview{
-		dashConfig: options.DashConfig,
-		logger:     options.DashConfig.Logger().With("module", "overview"),
+		dashConfig:    options.DashConfig,
+		printRegistry: options.PrintRegistry,
+		logger:        options.DashConfig.Logger().With("module", "overview"),
 	}
 
 	if err := co.bootstrap(ctx); err != nil {
@@ -110,7 +116,7 @@ func (co *Overview) bootstrap(ctx context.Context) error {
 		pathMatcher.Register(ctx, pf)
 	}
 
-	g, err := generator.NewGenerator(pathMatcher, co.dashConfig)
+	g, err := generator.NewGenerator(pathMatcher, co.dashConfig, co.printRegistry)
 	if err != nil {
 		return errors.Wrap(err, "create overview generator")
 	}
@@ -204,6 +210,8 @@ func (co *Overview) Navigation(ctx context.Context, namespace, root string) ([]n
 			"Custom Resources":             navigation.CRDEntries,
 			"RBAC":                         rbacEntries,
 			"Events":                       nil,
+			"Cleanup":                      nil,
+			"Create":                       nil,
 		},
 		Order: []string{
 			"Workloads",
@@ -212,6 +220,8 @@ func (co *Overview) Navigation(ctx context.Context, namespace, root string) ([]n
 			"Custom Resources",
 			"RBAC",
 			"Events",
+			"Cleanup",
+			"Create",
 		},
 	}
 
@@ -255,6 +265,8 @@ func (co *Overview) Content(ctx context.Context, contentPath string, opts module
 	ctx = log.WithLoggerContext(ctx, co.dashConfig.Logger())
 	genOpts := generator.Options{
 		LabelSet: opts.LabelSet,
+		Page:     opts.Page,
+		Query:    opts.Query,
 	}
 	return co.generator.Generate(ctx, contentPath, genOpts)
 }
@@ -327,9 +339,31 @@ func (co *Overview) portForwardHandler() http.HandlerFunc {
 // ActionPaths contain the actions this module is responsible for.
 func (co *Overview) ActionPaths() map[string]action.DispatcherFunc {
 	dispatchers := action.Dispatchers{
-		octant.NewDeploymentConfigurationEditor(co.logger, co.dashConfig.ObjectStore()),
+		octant.NewScaler(co.dashConfig.ObjectStore(), co.dashConfig.ClusterClient()),
+		octant.NewHorizontalPodAutoscalerEditor(co.dashConfig.ObjectStore()),
+		octant.NewRolloutRestarter(co.dashConfig.ObjectStore()),
+		octant.NewRolloutPauser(co.dashConfig.ObjectStore()),
+		octant.NewRolloutResumer(co.dashConfig.ObjectStore()),
+		octant.NewRollback(co.dashConfig.ObjectStore()),
+		octant.NewCronJobTrigger(co.dashConfig.ObjectStore()),
+		octant.NewCronJobSuspender(co.dashConfig.ObjectStore()),
+		octant.NewCronJobResumer(co.dashConfig.ObjectStore()),
 		octant.NewContainerEditor(co.dashConfig.ObjectStore()),
 		octant.NewServiceConfigurationEditor(co.dashConfig.ObjectStore()),
+		octant.NewPortForwardCreator(co.dashConfig.PortForwarder()),
+		octant.NewConfigMapDataEditor(co.dashConfig.ObjectStore()),
+		octant.NewDataKeySetter(co.dashConfig.ObjectStore()),
+		octant.NewDataKeyRemover(co.dashConfig.ObjectStore()),
+		octant.NewSecretCreator(co.dashConfig.ObjectStore()),
+		octant.NewObjectApplier(co.dashConfig.ClusterClient()),
+		octant.NewManifestApplier(co.dashConfig.ObjectStore(), co.dashConfig.ClusterClient()),
+		octant.NewReachabilityChecker(co.dashConfig.ObjectStore()),
+		octant.NewNotesEditor(co.dashConfig.NotesStorage(), co.dashConfig.ObjectStore()),
+	}
+
+	if co.dashConfig.ExecEnabled() {
+		executor := exec.NewExecutor(co.dashConfig)
+		dispatchers = append(dispatchers, NewFileUploader(executor), NewFileDownloader(executor))
 	}
 
 	return dispatchers.ToActionPaths()
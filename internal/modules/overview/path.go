@@ -20,6 +20,7 @@ var (
 		gvk.CronJob,
 		gvk.DaemonSet,
 		gvk.Deployment,
+		gvk.HorizontalPodAutoscaler,
 		gvk.ExtReplicaSet,
 		gvk.Job,
 		gvk.Pod,
@@ -65,6 +66,8 @@ func gvkPath(namespace, apiVersion, kind, name string) (string, error) {
 		p = "/workloads/deployments"
 	case apiVersion == "apps/v1" && kind == "Deployment":
 		p = "/workloads/deployments"
+	case apiVersion == "autoscaling/v1" && kind == "HorizontalPodAutoscaler":
+		p = "/workloads/horizontal-pod-autoscalers"
 	case apiVersion == "batch/v1beta1" && kind == "CronJob":
 		p = "/workloads/cron-jobs"
 	case (apiVersion == "batch/v1beta1" || apiVersion == "batch/v1") && kind == "Job":
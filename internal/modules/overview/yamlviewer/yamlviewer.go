@@ -6,13 +6,17 @@ SPDX-License-Identifier: Apache-2.0
 package yamlviewer
 
 import (
-	"github.com/vmware/octant/pkg/view/component"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/view/component"
 )
 
-// ToComponent converts an object into a YAML component.
-func ToComponent(object runtime.Object) (*component.YAML, error) {
+// ToComponent converts an object into a YAML code component. Named
+// objects are editable, and edits are applied to the object.
+func ToComponent(object runtime.Object) (*component.Code, error) {
 	yv, err := new(object)
 	if err != nil {
 		return nil, errors.Wrap(err, "create YAML viewer")
@@ -38,11 +42,26 @@ func new(object runtime.Object) (*yamlViewer, error) {
 }
 
 // ToComponent converts the YAMLViewer to a component.
-func (yv *yamlViewer) ToComponent() (*component.YAML, error) {
-	y := component.NewYAML(component.TitleFromString("YAML"), "")
+func (yv *yamlViewer) ToComponent() (*component.Code, error) {
+	y := component.NewYAML(nil, "")
 	if err := y.Data(yv.object); err != nil {
 		return nil, errors.Wrap(err, "add YAML data")
 	}
 
-	return y, nil
+	code := component.NewCode("YAML", "yaml", y.Config.Data)
+	code.Config.LineNumbers = true
+
+	key, err := store.KeyFromObject(yv.object)
+	if err != nil {
+		return nil, errors.Wrap(err, "create key for object")
+	}
+
+	if key.Name != "" {
+		payload := key.ToActionPayload()
+		payload["action"] = octant.ActionApplyObject
+		code.SetEditable(payload, octant.ApplyManifestField)
+		code.Config.Edit.ID = octant.ApplyEditID(key)
+	}
+
+	return code, nil
 }
@@ -10,10 +10,13 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/store"
 	"github.com/vmware/octant/pkg/view/component"
-
-	corev1 "k8s.io/api/core/v1"
 )
 
 func Test_ToComponent(t *testing.T) {
@@ -23,7 +26,29 @@ func Test_ToComponent(t *testing.T) {
 	require.NoError(t, err)
 
 	data := "---\nmetadata:\n  creationTimestamp: null\nspec:\n  containers: null\nstatus: {}\n"
-	expected := component.NewYAML(component.TitleFromString("YAML"), data)
+	expected := component.NewCode("YAML", "yaml", data)
+	expected.Config.LineNumbers = true
 
 	assert.Equal(t, expected, got)
 }
+
+func Test_ToComponent_editable(t *testing.T) {
+	object := testutil.CreatePod("pod")
+
+	got, err := ToComponent(object)
+	require.NoError(t, err)
+
+	require.True(t, got.IsEditable())
+	assert.Equal(t, action.Payload{
+		"action":     octant.ActionApplyObject,
+		"namespace":  "namespace",
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"name":       "pod",
+	}, got.Config.Edit.Payload)
+	assert.Equal(t, octant.ApplyManifestField, got.Config.Edit.Field)
+
+	key, err := store.KeyFromObject(object)
+	require.NoError(t, err)
+	assert.Equal(t, octant.ApplyEditID(key), got.Config.Edit.ID)
+}
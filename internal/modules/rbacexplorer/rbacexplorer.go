@@ -0,0 +1,324 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rbacexplorer
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware/octant/internal/api"
+	"github.com/vmware/octant/internal/config"
+	"github.com/vmware/octant/internal/link"
+	"github.com/vmware/octant/internal/module"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/internal/rbac"
+	"github.com/vmware/octant/pkg/navigation"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// subjectPaths are the content path segments for each kind of subject.
+var subjectPaths = map[string]string{
+	rbacv1.UserKind:           "users",
+	rbacv1.GroupKind:          "groups",
+	rbacv1.ServiceAccountKind: "service-accounts",
+}
+
+// Options are options for configuring Explorer.
+type Options struct {
+	Namespace  string
+	DashConfig config.Dash
+}
+
+// Explorer is a module which shows the access users, groups, and service
+// accounts have. Access is resolved from the role bindings in the current
+// namespace and from cluster role bindings.
+type Explorer struct {
+	dashConfig config.Dash
+
+	mu        sync.Mutex
+	namespace string
+}
+
+var _ module.Module = (*Explorer)(nil)
+
+// New creates an instance of Explorer.
+func New(options Options) *Explorer {
+	return &Explorer{
+		namespace:  options.Namespace,
+		dashConfig: options.DashConfig,
+	}
+}
+
+// Name returns the name of the module.
+func (e *Explorer) Name() string {
+	return "rbac-explorer"
+}
+
+// ClientRequestHandlers returns nil.
+func (e *Explorer) ClientRequestHandlers() []octant.ClientRequestHandler {
+	return nil
+}
+
+// Content generates content for a path. The root path lists subjects, and
+// each subject has a page with its bindings and access matrix.
+func (e *Explorer) Content(ctx context.Context, contentPath string, opts module.ContentOptions) (component.ContentResponse, error) {
+	contentPath = strings.Trim(contentPath, "/")
+	namespace := e.currentNamespace()
+	resolver := rbac.NewResolver(e.dashConfig.ObjectStore())
+
+	if contentPath == "" {
+		return e.subjects(ctx, resolver, namespace)
+	}
+
+	subject, ok := subjectFromPath(contentPath)
+	if !ok {
+		return component.EmptyContentResponse, api.NewNotFoundError(contentPath)
+	}
+
+	return e.subject(ctx, resolver, namespace, subject)
+}
+
+func (e *Explorer) subjects(ctx context.Context, resolver *rbac.Resolver, namespace string) (component.ContentResponse, error) {
+	subjects, err := resolver.Subjects(ctx, namespace)
+	if err != nil {
+		return component.EmptyContentResponse, errors.Wrap(err, "list subjects")
+	}
+
+	cols := component.NewTableCols("Name", "Kind", "Namespace")
+	table := component.NewTable("Subjects", "We couldn't find any bound subjects!", cols)
+
+	for _, subject := range subjects {
+		subjectPath, ok := e.subjectPath(subject)
+		if !ok {
+			continue
+		}
+
+		table.Add(component.TableRow{
+			"Name":      component.NewLink("", subject.Name, subjectPath),
+			"Kind":      component.NewText(subject.Kind),
+			"Namespace": component.NewText(subject.Namespace),
+		})
+	}
+
+	return component.ContentResponse{
+		Title:      component.TitleFromString("RBAC Explorer"),
+		Components: []component.Component{table},
+	}, nil
+}
+
+func (e *Explorer) subject(ctx context.Context, resolver *rbac.Resolver, namespace string, subject rbac.Subject) (component.ContentResponse, error) {
+	bindings, err := resolver.Bindings(ctx, subject, namespace)
+	if err != nil {
+		return component.EmptyContentResponse, errors.Wrapf(err, "list bindings for %s", subject)
+	}
+
+	policyRules, err := resolver.PolicyRules(ctx, bindings)
+	if err != nil {
+		return component.EmptyContentResponse, errors.Wrapf(err, "resolve policy rules for %s", subject)
+	}
+
+	linker, err := link.NewFromDashConfig(e.dashConfig)
+	if err != nil {
+		return component.EmptyContentResponse, err
+	}
+
+	bindingsTable, err := createBindingsView(bindings, linker)
+	if err != nil {
+		return component.EmptyContentResponse, err
+	}
+
+	return component.ContentResponse{
+		Title: component.Title(
+			component.NewLink("", "RBAC Explorer", path.Join("/", e.ContentPath())),
+			component.NewText(subject.Name)),
+		Components: []component.Component{
+			bindingsTable,
+			createAccessMatrixView(rbac.NewMatrix(policyRules), namespace),
+		},
+	}, nil
+}
+
+func createBindingsView(bindings []rbac.Binding, linker link.Interface) (*component.Table, error) {
+	cols := component.NewTableCols("Name", "Kind", "Role")
+	table := component.NewTable("Bindings", "There are no bindings for this subject!", cols)
+
+	for _, binding := range bindings {
+		bindingLink, err := linker.ForGVK(binding.Namespace, "rbac.authorization.k8s.io/v1", binding.Kind, binding.Name, binding.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		var roleNamespace string
+		if binding.RoleRef.Kind == "Role" {
+			roleNamespace = binding.Namespace
+		}
+
+		roleLink, err := linker.ForGVK(roleNamespace, "rbac.authorization.k8s.io/v1", binding.RoleRef.Kind, binding.RoleRef.Name,
+			fmt.Sprintf("%s %s", binding.RoleRef.Kind, binding.RoleRef.Name))
+		if err != nil {
+			return nil, err
+		}
+
+		table.Add(component.TableRow{
+			"Name": bindingLink,
+			"Kind": component.NewText(binding.Kind),
+			"Role": roleLink,
+		})
+	}
+
+	return table, nil
+}
+
+func createAccessMatrixView(matrix *rbac.Matrix, namespace string) *component.Table {
+	cols := component.NewTableCols(append([]string{"Resource"}, rbac.Verbs...)...)
+	title := fmt.Sprintf("Access in %s", namespace)
+	table := component.NewTable(title, "There are no resources!", cols)
+
+	for _, resource := range matrix.Resources {
+		row := component.TableRow{
+			"Resource": component.NewText(resource.String()),
+		}
+
+		for _, verb := range rbac.Verbs {
+			access := ""
+			if matrix.Allows(verb, resource) {
+				access = "✓"
+			}
+			row[verb] = component.NewText(access)
+		}
+
+		table.Add(row)
+	}
+
+	return table
+}
+
+// subjectPath returns the content path for a subject.
+func (e *Explorer) subjectPath(subject rbac.Subject) (string, bool) {
+	kindPath, ok := subjectPaths[subject.Kind]
+	if !ok {
+		return "", false
+	}
+
+	if subject.Kind == rbacv1.ServiceAccountKind {
+		return path.Join("/", e.ContentPath(), kindPath, subject.Namespace, subject.Name), true
+	}
+
+	return path.Join("/", e.ContentPath(), kindPath, subject.Name), true
+}
+
+// subjectFromPath converts a content path to a subject. Users and groups
+// are kind/name and service accounts are kind/namespace/name.
+func subjectFromPath(contentPath string) (rbac.Subject, bool) {
+	parts := strings.Split(contentPath, "/")
+
+	for kind, kindPath := range subjectPaths {
+		if parts[0] != kindPath {
+			continue
+		}
+
+		if kind == rbacv1.ServiceAccountKind {
+			if len(parts) != 3 {
+				return rbac.Subject{}, false
+			}
+			return rbac.Subject{Kind: kind, Namespace: parts[1], Name: parts[2]}, true
+		}
+
+		if len(parts) != 2 {
+			return rbac.Subject{}, false
+		}
+		return rbac.Subject{Kind: kind, Name: parts[1]}, true
+	}
+
+	return rbac.Subject{}, false
+}
+
+// ContentPath returns the content path for the module.
+func (e *Explorer) ContentPath() string {
+	return e.Name()
+}
+
+// Navigation returns navigation entries for the module.
+func (e *Explorer) Navigation(ctx context.Context, namespace, root string) ([]navigation.Navigation, error) {
+	if !strings.HasSuffix(root, "/") {
+		root = fmt.Sprintf("%s/", root)
+	}
+
+	return []navigation.Navigation{
+		{
+			Title: "RBAC Explorer",
+			Path:  root,
+		},
+	}, nil
+}
+
+// SetNamespace sets the namespace role bindings are resolved in.
+func (e *Explorer) SetNamespace(namespace string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.namespace = namespace
+	return nil
+}
+
+func (e *Explorer) currentNamespace() string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.namespace
+}
+
+// Start starts the module.
+func (e *Explorer) Start() error {
+	return nil
+}
+
+// Stop stops the module.
+func (e *Explorer) Stop() {
+}
+
+// SetContext is a no-op.
+func (e *Explorer) SetContext(ctx context.Context, contextName string) error {
+	return nil
+}
+
+// Generators allow modules to send events to the frontend.
+func (e *Explorer) Generators() []octant.Generator {
+	return []octant.Generator{}
+}
+
+// SupportedGroupVersionKind returns an empty list.
+func (e *Explorer) SupportedGroupVersionKind() []schema.GroupVersionKind {
+	return []schema.GroupVersionKind{}
+}
+
+// GroupVersionKindPath returns an error since the explorer is not routed by GVK.
+func (e *Explorer) GroupVersionKindPath(namespace, apiVersion, kind, name string) (string, error) {
+	return "", errors.Errorf("rbac explorer can't create paths for %s %s", apiVersion, kind)
+}
+
+// AddCRD is a no-op.
+func (e *Explorer) AddCRD(ctx context.Context, crd *unstructured.Unstructured) error {
+	return nil
+}
+
+// RemoveCRD is a no-op.
+func (e *Explorer) RemoveCRD(ctx context.Context, crd *unstructured.Unstructured) error {
+	return nil
+}
+
+// ResetCRDs is a no-op.
+func (e *Explorer) ResetCRDs(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,148 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rbacexplorer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	rbacv1 "k8s.io/api/rbac/v1"
+
+	"github.com/vmware/octant/internal/api"
+	configFake "github.com/vmware/octant/internal/config/fake"
+	"github.com/vmware/octant/internal/module"
+	"github.com/vmware/octant/internal/rbac"
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/store"
+	storeFake "github.com/vmware/octant/pkg/store/fake"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+const rbacAPIVersion = "rbac.authorization.k8s.io/v1"
+
+func TestExplorer_Content(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	role := testutil.CreateRole("pod-reader")
+	role.Rules = []rbacv1.PolicyRule{
+		{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get", "list"}},
+	}
+
+	roleBinding := testutil.CreateRoleBinding("read-pods", role.Name, []rbacv1.Subject{
+		{Kind: "ServiceAccount", Name: "sa", Namespace: "namespace"},
+		{Kind: "User", Name: "jane"},
+	})
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: rbacAPIVersion, Kind: "RoleBinding"}).
+		Return(testutil.ToUnstructuredList(t, roleBinding), false, nil).
+		AnyTimes()
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: rbacAPIVersion, Kind: "ClusterRoleBinding"}).
+		Return(testutil.ToUnstructuredList(t), false, nil).
+		AnyTimes()
+	objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: rbacAPIVersion, Kind: "Role", Name: "pod-reader"}).
+		Return(testutil.ToUnstructured(t, role), true, nil).
+		AnyTimes()
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectStore().Return(objectStore).AnyTimes()
+	dashConfig.EXPECT().
+		ObjectPath("namespace", rbacAPIVersion, "RoleBinding", "read-pods").
+		Return("/rb", nil).
+		AnyTimes()
+	dashConfig.EXPECT().
+		ObjectPath("namespace", rbacAPIVersion, "Role", "pod-reader").
+		Return("/role", nil).
+		AnyTimes()
+
+	e := New(Options{Namespace: "namespace", DashConfig: dashConfig})
+	ctx := context.Background()
+
+	t.Run("subjects", func(t *testing.T) {
+		content, err := e.Content(ctx, "/", module.ContentOptions{})
+		require.NoError(t, err)
+
+		cols := component.NewTableCols("Name", "Kind", "Namespace")
+		expected := component.NewTableWithRows("Subjects", "We couldn't find any bound subjects!", cols, []component.TableRow{
+			{
+				"Name":      component.NewLink("", "sa", "/rbac-explorer/service-accounts/namespace/sa"),
+				"Kind":      component.NewText("ServiceAccount"),
+				"Namespace": component.NewText("namespace"),
+			},
+			{
+				"Name":      component.NewLink("", "jane", "/rbac-explorer/users/jane"),
+				"Kind":      component.NewText("User"),
+				"Namespace": component.NewText(""),
+			},
+		})
+
+		require.Len(t, content.Components, 1)
+		component.AssertEqual(t, expected, content.Components[0])
+	})
+
+	t.Run("subject", func(t *testing.T) {
+		content, err := e.Content(ctx, "/service-accounts/namespace/sa", module.ContentOptions{})
+		require.NoError(t, err)
+		require.Len(t, content.Components, 2)
+
+		cols := component.NewTableCols("Name", "Kind", "Role")
+		expected := component.NewTableWithRows("Bindings", "There are no bindings for this subject!", cols, []component.TableRow{
+			{
+				"Name": component.NewLink("", "read-pods", "/rb"),
+				"Kind": component.NewText("RoleBinding"),
+				"Role": component.NewLink("", "Role pod-reader", "/role"),
+			},
+		})
+		component.AssertEqual(t, expected, content.Components[0])
+
+		matrix, ok := content.Components[1].(*component.Table)
+		require.True(t, ok)
+		assert.Equal(t, "Access in namespace", matrix.Title[0].String())
+		for _, row := range matrix.Rows() {
+			if row["Resource"].String() != "pods" {
+				continue
+			}
+			assert.Equal(t, component.NewText("✓"), row["get"])
+			assert.Equal(t, component.NewText("✓"), row["list"])
+			assert.Equal(t, component.NewText(""), row["delete"])
+		}
+	})
+
+	t.Run("unknown subject", func(t *testing.T) {
+		_, err := e.Content(ctx, "/robots/r2", module.ContentOptions{})
+		require.Error(t, err)
+		assert.IsType(t, &api.NotFoundError{}, err)
+	})
+}
+
+func Test_subjectFromPath(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected rbac.Subject
+		ok       bool
+	}{
+		{path: "users/jane", expected: rbac.Subject{Kind: "User", Name: "jane"}, ok: true},
+		{path: "groups/system:masters", expected: rbac.Subject{Kind: "Group", Name: "system:masters"}, ok: true},
+		{path: "service-accounts/default/sa", expected: rbac.Subject{Kind: "ServiceAccount", Namespace: "default", Name: "sa"}, ok: true},
+		{path: "service-accounts/sa"},
+		{path: "users"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			got, ok := subjectFromPath(test.path)
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
@@ -0,0 +1,191 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package notes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware/octant/pkg/store"
+)
+
+// Storage is where notes are stored.
+type Storage string
+
+const (
+	// StorageAnnotation stores notes in an annotation on the object.
+	StorageAnnotation Storage = "annotation"
+	// StorageConfigMap stores notes in a config map in the object's namespace.
+	StorageConfigMap Storage = "configmap"
+
+	// Annotation is the annotation notes are stored in.
+	Annotation = "octant.dev/notes"
+	// ConfigMapName is the name of the config map notes are stored in.
+	ConfigMapName = "octant-notes"
+)
+
+// ParseStorage parses a storage name.
+func ParseStorage(s string) (Storage, error) {
+	switch storage := Storage(strings.ToLower(s)); storage {
+	case StorageAnnotation, StorageConfigMap:
+		return storage, nil
+	case "":
+		return StorageAnnotation, nil
+	default:
+		return "", errors.Errorf("unknown notes storage %q", s)
+	}
+}
+
+// Notes reads and writes notes for objects.
+type Notes struct {
+	storage     Storage
+	objectStore store.Store
+}
+
+// New creates an instance of Notes.
+func New(storage Storage, objectStore store.Store) *Notes {
+	return &Notes{
+		storage:     storage,
+		objectStore: objectStore,
+	}
+}
+
+// Get returns the notes for an object.
+func (n *Notes) Get(ctx context.Context, object runtime.Object) (string, error) {
+	if object == nil {
+		return "", errors.New("object is nil")
+	}
+
+	key, err := store.KeyFromObject(object)
+	if err != nil {
+		return "", err
+	}
+
+	if !n.usesConfigMap(key) {
+		accessor, err := meta.Accessor(object)
+		if err != nil {
+			return "", err
+		}
+
+		return accessor.GetAnnotations()[Annotation], nil
+	}
+
+	configMap, found, err := n.objectStore.Get(ctx, configMapKey(key.Namespace))
+	if err != nil {
+		return "", errors.Wrap(err, "get notes config map")
+	}
+	if !found {
+		return "", nil
+	}
+
+	data, _, err := unstructured.NestedStringMap(configMap.Object, "data")
+	if err != nil {
+		return "", errors.Wrap(err, "read notes config map")
+	}
+
+	return data[ConfigMapKey(key)], nil
+}
+
+// Set sets the notes for an object. Empty notes are removed.
+func (n *Notes) Set(ctx context.Context, key store.Key, text string) error {
+	var value interface{}
+	if text != "" {
+		value = text
+	}
+
+	if !n.usesConfigMap(key) {
+		patch := map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					Annotation: value,
+				},
+			},
+		}
+		return n.patch(ctx, key, patch)
+	}
+
+	cmKey := configMapKey(key.Namespace)
+	_, found, err := n.objectStore.Get(ctx, cmKey)
+	if err != nil {
+		return errors.Wrap(err, "get notes config map")
+	}
+
+	if found {
+		patch := map[string]interface{}{
+			"data": map[string]interface{}{
+				ConfigMapKey(key): value,
+			},
+		}
+		return n.patch(ctx, cmKey, patch)
+	}
+
+	if text == "" {
+		return nil
+	}
+
+	configMap := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ConfigMapName,
+			Namespace: key.Namespace,
+		},
+		Data: map[string]string{
+			ConfigMapKey(key): text,
+		},
+	}
+
+	object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(configMap)
+	if err != nil {
+		return errors.Wrap(err, "convert notes config map")
+	}
+
+	return n.objectStore.Create(ctx, &unstructured.Unstructured{Object: object})
+}
+
+func (n *Notes) patch(ctx context.Context, key store.Key, patch map[string]interface{}) error {
+	data, err := json.Marshal(patch)
+	if err != nil {
+		return err
+	}
+
+	return n.objectStore.Patch(ctx, key, types.MergePatchType, data)
+}
+
+// usesConfigMap returns true if notes for an object are stored in a config
+// map. Cluster scoped objects do not have a namespace for a config map, so
+// their notes are always stored in an annotation.
+func (n *Notes) usesConfigMap(key store.Key) bool {
+	return n.storage == StorageConfigMap && key.Namespace != ""
+}
+
+func configMapKey(namespace string) store.Key {
+	return store.Key{
+		Namespace:  namespace,
+		APIVersion: "v1",
+		Kind:       "ConfigMap",
+		Name:       ConfigMapName,
+	}
+}
+
+// ConfigMapKey returns the notes config map key for an object.
+func ConfigMapKey(key store.Key) string {
+	gvk := key.GroupVersionKind()
+	if gvk.Group == "" {
+		return fmt.Sprintf("%s.%s", gvk.Kind, key.Name)
+	}
+
+	return fmt.Sprintf("%s.%s.%s", gvk.Kind, gvk.Group, key.Name)
+}
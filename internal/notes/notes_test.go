@@ -0,0 +1,209 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package notes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/store/fake"
+)
+
+func TestParseStorage(t *testing.T) {
+	tests := []struct {
+		name     string
+		storage  string
+		expected Storage
+		isErr    bool
+	}{
+		{name: "empty", storage: "", expected: StorageAnnotation},
+		{name: "annotation", storage: "annotation", expected: StorageAnnotation},
+		{name: "config map", storage: "ConfigMap", expected: StorageConfigMap},
+		{name: "unknown", storage: "secret", isErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseStorage(test.storage)
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func TestNotes_Get_annotation(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.Annotations = map[string]string{Annotation: "notes"}
+
+	n := New(StorageAnnotation, fake.NewMockStore(controller))
+
+	got, err := n.Get(context.Background(), deployment)
+	require.NoError(t, err)
+	assert.Equal(t, "notes", got)
+}
+
+func TestNotes_Get_configMap(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	deployment := testutil.CreateDeployment("deployment")
+
+	configMap := testutil.CreateConfigMap(ConfigMapName)
+	configMap.Data = map[string]string{"Deployment.apps.deployment": "notes"}
+
+	objectStore := fake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), configMapKey(deployment.Namespace)).
+		Return(testutil.ToUnstructured(t, configMap), true, nil)
+
+	n := New(StorageConfigMap, objectStore)
+
+	got, err := n.Get(context.Background(), deployment)
+	require.NoError(t, err)
+	assert.Equal(t, "notes", got)
+}
+
+func TestNotes_Get_configMap_cluster_scoped(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	node := testutil.CreateNode("node")
+	node.Annotations = map[string]string{Annotation: "notes"}
+
+	n := New(StorageConfigMap, fake.NewMockStore(controller))
+
+	got, err := n.Get(context.Background(), node)
+	require.NoError(t, err)
+	assert.Equal(t, "notes", got)
+}
+
+func TestNotes_Set_annotation(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{
+			name:     "set",
+			text:     "notes",
+			expected: `{"metadata":{"annotations":{"octant.dev/notes":"notes"}}}`,
+		},
+		{
+			name:     "remove",
+			text:     "",
+			expected: `{"metadata":{"annotations":{"octant.dev/notes":null}}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			key, err := store.KeyFromObject(testutil.CreateDeployment("deployment"))
+			require.NoError(t, err)
+
+			objectStore := fake.NewMockStore(controller)
+			objectStore.EXPECT().
+				Patch(gomock.Any(), key, types.MergePatchType, []byte(test.expected)).
+				Return(nil)
+
+			n := New(StorageAnnotation, objectStore)
+			require.NoError(t, n.Set(context.Background(), key, test.text))
+		})
+	}
+}
+
+func TestNotes_Set_configMap(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	key, err := store.KeyFromObject(testutil.CreateDeployment("deployment"))
+	require.NoError(t, err)
+
+	configMap := testutil.CreateConfigMap(ConfigMapName)
+
+	objectStore := fake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), configMapKey(key.Namespace)).
+		Return(testutil.ToUnstructured(t, configMap), true, nil)
+	objectStore.EXPECT().
+		Patch(gomock.Any(), configMapKey(key.Namespace), types.MergePatchType,
+			[]byte(`{"data":{"Deployment.apps.deployment":"notes"}}`)).
+		Return(nil)
+
+	n := New(StorageConfigMap, objectStore)
+	require.NoError(t, n.Set(context.Background(), key, "notes"))
+}
+
+func TestNotes_Set_configMap_create(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	key, err := store.KeyFromObject(testutil.CreateDeployment("deployment"))
+	require.NoError(t, err)
+
+	objectStore := fake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), configMapKey(key.Namespace)).
+		Return(nil, false, nil)
+	objectStore.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, object *unstructured.Unstructured) error {
+			configMap := &corev1.ConfigMap{}
+			require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, configMap))
+
+			assert.Equal(t, ConfigMapName, configMap.Name)
+			assert.Equal(t, key.Namespace, configMap.Namespace)
+			assert.Equal(t, map[string]string{"Deployment.apps.deployment": "notes"}, configMap.Data)
+			return nil
+		})
+
+	n := New(StorageConfigMap, objectStore)
+	require.NoError(t, n.Set(context.Background(), key, "notes"))
+}
+
+func TestConfigMapKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      store.Key
+		expected string
+	}{
+		{
+			name:     "core group",
+			key:      store.Key{APIVersion: "v1", Kind: "Pod", Name: "pod"},
+			expected: "Pod.pod",
+		},
+		{
+			name:     "named group",
+			key:      store.Key{APIVersion: "apps/v1", Kind: "Deployment", Name: "deployment"},
+			expected: "Deployment.apps.deployment",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, ConfigMapKey(test.key))
+		})
+	}
+}
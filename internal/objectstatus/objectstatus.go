@@ -63,6 +63,15 @@ func (os *ObjectStatus) SetWarning() {
 	}
 }
 
+// Contribute adds the status a plugin computed for the object. Its details
+// are added, and its status, if set, overrides the computed status.
+func (os *ObjectStatus) Contribute(summary component.PodSummary) {
+	os.Details = append(os.Details, summary.Details...)
+	if summary.Status != "" {
+		os.nodeStatus = summary.Status
+	}
+}
+
 func (os *ObjectStatus) Status() component.NodeStatus {
 	switch os.nodeStatus {
 	case component.NodeStatusWarning,
@@ -113,6 +113,23 @@ func Test_ObjectStatus_SetWarning(t *testing.T) {
 	assert.Equal(t, component.NodeStatusError, os.Status())
 }
 
+func Test_ObjectStatus_Contribute(t *testing.T) {
+	os := ObjectStatus{}
+	os.AddDetail("detail")
+	os.SetError()
+
+	os.Contribute(component.PodSummary{
+		Details: []component.Component{component.NewText("plugin detail")},
+	})
+
+	expected := []component.Component{component.NewText("detail"), component.NewText("plugin detail")}
+	assert.Equal(t, expected, os.Details)
+	assert.Equal(t, component.NodeStatusError, os.Status())
+
+	os.Contribute(component.PodSummary{Status: component.NodeStatusOK})
+	assert.Equal(t, component.NodeStatusOK, os.Status())
+}
+
 func Test_ObjectStatus_Default(t *testing.T) {
 	os := ObjectStatus{}
 
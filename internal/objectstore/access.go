@@ -15,6 +15,7 @@ import (
 	authorizationv1 "k8s.io/api/authorization/v1"
 
 	"github.com/vmware/octant/internal/cluster"
+	octantErrors "github.com/vmware/octant/internal/errors"
 	"github.com/vmware/octant/pkg/store"
 )
 
@@ -22,17 +23,33 @@ type AccessError struct {
 	Key AccessKey
 }
 
+// Code returns the error's code.
+func (ae *AccessError) Code() octantErrors.Code {
+	return octantErrors.CodeForbidden
+}
+
 func (ae *AccessError) Error() string {
-	return fmt.Sprintf("access denied: no %s access in %s to %s/%s",
-		ae.Key.Verb, ae.Key.Namespace, ae.Key.Group, ae.Key.Resource)
+	resource := ae.Key.Group + "/" + ae.Key.Resource
+	if ae.Key.Subresource != "" {
+		resource += "/" + ae.Key.Subresource
+	}
+	if ae.Key.Name != "" {
+		resource += " " + ae.Key.Name
+	}
+
+	return fmt.Sprintf("access denied: no %s access in %s to %s",
+		ae.Key.Verb, ae.Key.Namespace, resource)
 }
 
 // AccessKey is used at a key in an access map. It is made up of a Namespace, Group, Resource, and Verb.
+// Name and Subresource narrow the key to a single object or a subresource.
 type AccessKey struct {
-	Namespace string
-	Group     string
-	Resource  string
-	Verb      string
+	Namespace   string
+	Group       string
+	Resource    string
+	Subresource string
+	Name        string
+	Verb        string
 }
 
 type accessMap map[AccessKey]bool
@@ -71,6 +88,7 @@ func (c *accessCache) get(key AccessKey) (v, ok bool) {
 
 type ResourceAccess interface {
 	HasAccess(context.Context, store.Key, string) error
+	HasSubresourceAccess(context.Context, store.Key, string, string) error
 	Reset()
 	Get(AccessKey) (bool, bool)
 	Set(AccessKey, bool)
@@ -118,6 +136,12 @@ func (r *resourceAccess) Set(key AccessKey, v bool) {
 // HasAccess returns an error if the current user does not have access to perform the verb action
 // for the given key.
 func (r *resourceAccess) HasAccess(ctx context.Context, key store.Key, verb string) error {
+	return r.HasSubresourceAccess(ctx, key, "", verb)
+}
+
+// HasSubresourceAccess returns an error if the current user does not have access to perform the verb
+// action on a subresource, e.g. the eviction subresource of pods, for the given key.
+func (r *resourceAccess) HasSubresourceAccess(ctx context.Context, key store.Key, subresource, verb string) error {
 	_, span := trace.StartSpan(ctx, "resourceAccessHasAccess")
 	defer span.End()
 
@@ -125,6 +149,7 @@ func (r *resourceAccess) HasAccess(ctx context.Context, key store.Key, verb stri
 	if err != nil {
 		return err
 	}
+	aKey.Subresource = subresource
 
 	access, ok := r.cache.get(aKey)
 
@@ -168,6 +193,16 @@ func (r *resourceAccess) keyToAccessKey(key store.Key, verb string) (AccessKey,
 		Resource:  gvr.Resource,
 		Verb:      verb,
 	}
+
+	// objects are read from informers, which need access to every object,
+	// so only the verbs which act on a single object are checked by name.
+	// This lets RBAC rules with resourceNames allow them.
+	switch verb {
+	case "get", "list", "watch":
+	default:
+		aKey.Name = key.Name
+	}
+
 	return aKey, nil
 }
 
@@ -184,10 +219,12 @@ func (r *resourceAccess) fetchAccess(key AccessKey, verb string) (bool, error) {
 	sar := &authorizationv1.SelfSubjectAccessReview{
 		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
 			ResourceAttributes: &authorizationv1.ResourceAttributes{
-				Namespace: key.Namespace,
-				Group:     key.Group,
-				Resource:  key.Resource,
-				Verb:      verb,
+				Namespace:   key.Namespace,
+				Group:       key.Group,
+				Resource:    key.Resource,
+				Subresource: key.Subresource,
+				Name:        key.Name,
+				Verb:        verb,
 			},
 		},
 	}
@@ -7,6 +7,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	clusterfake "github.com/vmware/octant/internal/cluster/fake"
+	octantErrors "github.com/vmware/octant/internal/errors"
 	"github.com/vmware/octant/pkg/store"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -128,3 +129,33 @@ func Test_ResourceAccess_HasAccess(t *testing.T) {
 		})
 	}
 }
+
+func TestAccessError_Code(t *testing.T) {
+	err := &AccessError{Key: AccessKey{Namespace: "test", Resource: "pods", Verb: "list"}}
+	require.Equal(t, octantErrors.CodeForbidden, octantErrors.CodeOf(err))
+}
+
+func Test_ResourceAccess_HasAccess_name(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	client := clusterfake.NewMockClientInterface(controller)
+	client.EXPECT().Resource(gomock.Any()).
+		Return(schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}, nil).
+		AnyTimes()
+
+	r := NewResourceAccess(client)
+	r.Set(AccessKey{Namespace: "default", Group: "apps", Resource: "deployments", Name: "web", Verb: "delete"}, true)
+	r.Set(AccessKey{Namespace: "default", Group: "apps", Resource: "deployments", Verb: "get"}, true)
+	r.Set(AccessKey{Namespace: "default", Group: "apps", Resource: "deployments", Subresource: "scale", Name: "web", Verb: "update"}, false)
+
+	ctx := context.Background()
+	key := store.Key{Namespace: "default", APIVersion: "apps/v1", Kind: "Deployment", Name: "web"}
+
+	require.NoError(t, r.HasAccess(ctx, key, "delete"))
+	require.NoError(t, r.HasAccess(ctx, key, "get"))
+
+	err := r.HasSubresourceAccess(ctx, key, "scale", "update")
+	require.Error(t, err)
+	require.Equal(t, "access denied: no update access in default to apps/deployments/scale web", err.Error())
+}
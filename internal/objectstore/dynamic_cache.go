@@ -19,6 +19,8 @@ import (
 	kLabels "k8s.io/apimachinery/pkg/labels"
 	kruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/informers"
 	kcache "k8s.io/client-go/tools/cache"
 	kretry "k8s.io/client-go/util/retry"
@@ -61,6 +63,8 @@ type DynamicCache struct {
 	informerSynced  *informerSynced
 	client          cluster.ClientInterface
 	seenGVKs        *seenGVKsCache
+	selectorIndex   *selectorIndex
+	watchers        *watchers
 	access          ResourceAccess
 	updateFns       []store.UpdateFn
 	updateMu        sync.Mutex
@@ -93,6 +97,9 @@ func waitForSync(ctx context.Context, key store.Key, dc *DynamicCache, informer
 }
 
 var _ store.Store = (*DynamicCache)(nil)
+var _ store.MatchCounter = (*DynamicCache)(nil)
+var _ store.AccessChecker = (*DynamicCache)(nil)
+var _ store.SubresourceAccessChecker = (*DynamicCache)(nil)
 
 // NewDynamicCache creates an instance of DynamicCache.
 func NewDynamicCache(ctx context.Context, client cluster.ClientInterface, options ...DynamicCacheOpt) (*DynamicCache, error) {
@@ -102,6 +109,8 @@ func NewDynamicCache(ctx context.Context, client cluster.ClientInterface, option
 		waitForSyncFunc: waitForSync,
 		client:          client,
 		seenGVKs:        initSeenGVKsCache(),
+		selectorIndex:   initSelectorIndex(),
+		watchers:        initWatchers(),
 		informerSynced:  initInformerSynced(),
 	}
 
@@ -132,6 +141,18 @@ func (dc *DynamicCache) setResourceAccess(resourceAccess ResourceAccess) {
 	dc.access = resourceAccess
 }
 
+// HasAccess returns an error if the current credentials can't perform verb
+// on the objects for key. Access is cached until the cluster client changes.
+func (dc *DynamicCache) HasAccess(ctx context.Context, key store.Key, verb string) error {
+	return dc.access.HasAccess(ctx, key, verb)
+}
+
+// HasSubresourceAccess returns an error if the current credentials can't
+// perform verb on a subresource of the objects for key.
+func (dc *DynamicCache) HasSubresourceAccess(ctx context.Context, key store.Key, subresource, verb string) error {
+	return dc.access.HasSubresourceAccess(ctx, key, subresource, verb)
+}
+
 func (dc *DynamicCache) currentInformer(ctx context.Context, key store.Key) (informers.GenericInformer, bool, error) {
 	if dc.client == nil {
 		return nil, false, errors.New("cluster client is nil")
@@ -212,7 +233,9 @@ func (dc *DynamicCache) listFromInformer(ctx context.Context, key store.Key) (*u
 		return nil, false, errors.Wrapf(err, "retrieving informer for %+v", key)
 	}
 
-	if !hasSynced {
+	// Objects are listed from the cluster until the informer has synced,
+	// and to continue pages started by the API server.
+	if !hasSynced || (key.Continue != "" && !isCacheContinue(key.Continue)) {
 		list, err := dc.listFromDynamicClient(ctx, key)
 		return list, false, err
 	}
@@ -224,10 +247,11 @@ func (dc *DynamicCache) listFromInformer(ctx context.Context, key store.Key) (*u
 		l = informer.Lister().ByNamespace(key.Namespace)
 	}
 
-	var selector = kLabels.Everything()
-	if key.Selector != nil {
-		selector = key.Selector.AsSelector()
+	selector, err := key.ResolveLabelSelector()
+	if err != nil {
+		return nil, false, err
 	}
+	fieldSelector := key.ResolveFieldSelector()
 
 	objects, err := l.List(selector)
 	if err != nil {
@@ -236,7 +260,16 @@ func (dc *DynamicCache) listFromInformer(ctx context.Context, key store.Key) (*u
 
 	list := &unstructured.UnstructuredList{}
 	for i := range objects {
-		list.Items = append(list.Items, *objects[i].(*unstructured.Unstructured))
+		object := objects[i].(*unstructured.Unstructured)
+		if !matchesFields(fieldSelector, object) {
+			continue
+		}
+		list.Items = append(list.Items, *object)
+	}
+
+	list, err = paginate(list, key)
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "listing %v", key)
 	}
 
 	return list, !dc.informerSynced.hasSynced(key), nil
@@ -246,9 +279,9 @@ func (dc *DynamicCache) listFromDynamicClient(ctx context.Context, key store.Key
 	_, span := trace.StartSpan(ctx, "dynamicCache:list:informer")
 	defer span.End()
 
-	var selector = kLabels.Everything()
-	if key.Selector != nil {
-		selector = key.Selector.AsSelector()
+	selector, err := key.ResolveLabelSelector()
+	if err != nil {
+		return nil, err
 	}
 
 	dynamicClient, err := dc.client.DynamicClient()
@@ -263,12 +296,113 @@ func (dc *DynamicCache) listFromDynamicClient(ctx context.Context, key store.Key
 
 	listOptions := metav1.ListOptions{
 		LabelSelector: selector.String(),
+		FieldSelector: key.ResolveFieldSelector().String(),
+		Limit:         key.Limit,
+		Continue:      key.Continue,
+	}
+
+	// Pages started from the cache are continued from a full list, since
+	// the API server can't read their continue tokens.
+	cacheContinue := isCacheContinue(key.Continue)
+	if cacheContinue {
+		listOptions.Limit = 0
+		listOptions.Continue = ""
+	}
+
+	var resourceClient dynamic.ResourceInterface = dynamicClient.Resource(gvr)
+	if key.Namespace != "" {
+		resourceClient = dynamicClient.Resource(gvr).Namespace(key.Namespace)
+	}
+
+	list, err := resourceClient.List(listOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	if cacheContinue {
+		return paginate(list, key)
+	}
+
+	return list, nil
+}
+
+// CountMatching counts the objects for a key which are matched by a selector.
+// Counts are cached until the key's informer sees a change, so lists which
+// show matched object counts for each row don't list the matched objects
+// once per row.
+func (dc *DynamicCache) CountMatching(ctx context.Context, key store.Key, selector kLabels.Selector) (int, error) {
+	ctx, span := trace.StartSpan(ctx, "dynamicCache:countMatching")
+	defer span.End()
+
+	key.Name = ""
+	key.Selector = nil
+	key.LabelSelector = nil
+	key.FieldSelector = nil
+	key.Limit = 0
+	key.Continue = ""
+
+	if _, selectable := selector.Requirements(); !selectable {
+		return 0, nil
+	}
+
+	if err := dc.access.HasAccess(ctx, key, "list"); err != nil {
+		if meta.IsNoMatchError(err) {
+			return 0, nil
+		}
+		return 0, errors.Wrapf(err, "list access forbidden to %+v", key)
+	}
+
+	count, generation, ok := dc.selectorIndex.get(key, selector.String())
+	if ok {
+		return count, nil
+	}
+
+	informer, hasSynced, err := dc.currentInformer(ctx, key)
+	if err != nil {
+		return 0, errors.Wrapf(err, "retrieving informer for %+v", key)
 	}
+
+	if !hasSynced {
+		// counts are only cached once the informer can report changes.
+		list, err := dc.listFromDynamicClient(ctx, key)
+		if err != nil {
+			return 0, err
+		}
+
+		count := 0
+		for i := range list.Items {
+			if selector.Matches(kLabels.Set(list.Items[i].GetLabels())) {
+				count++
+			}
+		}
+
+		return count, nil
+	}
+
+	if dc.selectorIndex.watch(key) {
+		invalidate := func() { dc.selectorIndex.invalidate(key) }
+		informer.Informer().AddEventHandler(kcache.ResourceEventHandlerFuncs{
+			AddFunc:    func(interface{}) { invalidate() },
+			UpdateFunc: func(interface{}, interface{}) { invalidate() },
+			DeleteFunc: func(interface{}) { invalidate() },
+		})
+	}
+
+	var l lister
 	if key.Namespace == "" {
-		return dynamicClient.Resource(gvr).List(listOptions)
+		l = informer.Lister()
+	} else {
+		l = informer.Lister().ByNamespace(key.Namespace)
+	}
+
+	objects, err := l.List(selector)
+	if err != nil {
+		return 0, errors.Wrapf(err, "listing %v", key)
 	}
 
-	return dynamicClient.Resource(gvr).Namespace(key.Namespace).List(listOptions)
+	dc.selectorIndex.set(key, selector.String(), generation, len(objects))
+
+	return len(objects), nil
 }
 
 type getter interface {
@@ -353,7 +487,9 @@ func (dc *DynamicCache) getFromDynamicClient(ctx context.Context, key store.Key)
 }
 
 // Watch watches the cluster for an event and performs actions with the
-// supplied handler.
+// supplied handler. The handler is called for objects in the namespace
+// which match the key's name and selectors, starting with an add for each
+// object which is already cached, until ctx is done.
 func (dc *DynamicCache) Watch(ctx context.Context, key store.Key, handler kcache.ResourceEventHandler) error {
 	if err := dc.access.HasAccess(ctx, key, "watch"); err != nil {
 		return err
@@ -364,7 +500,17 @@ func (dc *DynamicCache) Watch(ctx context.Context, key store.Key, handler kcache
 		return errors.Wrapf(err, "retrieving informer for %s", key)
 	}
 
-	informer.Informer().AddEventHandler(handler)
+	gvr, err := dc.client.Resource(key.GroupVersionKind().GroupKind())
+	if err != nil {
+		return errors.Wrap(err, "client resource")
+	}
+
+	watch, err := watchHandler(ctx, key, handler)
+	if err != nil {
+		return err
+	}
+
+	dc.watchers.add(ctx, gvr, informer.Informer(), watch)
 	return nil
 }
 
@@ -379,11 +525,14 @@ func (dc *DynamicCache) Unwatch(ctx context.Context, groupVersionKinds ...schema
 					return errors.Wrap(err, "get resource for key")
 				}
 				factory.Delete(gvr)
+				dc.watchers.forget(gvr)
 			}
 		}
 
 	}
 
+	dc.selectorIndex.reset()
+
 	return nil
 }
 
@@ -429,6 +578,8 @@ func (dc *DynamicCache) UpdateClusterClient(ctx context.Context, client cluster.
 	dc.client = client
 	dc.factories.reset()
 	dc.seenGVKs.reset()
+	dc.selectorIndex.reset()
+	dc.watchers.reset()
 	dc.informerSynced.reset()
 	dc.access.Reset()
 	dc.access.UpdateClient(client)
@@ -487,6 +638,58 @@ func (dc *DynamicCache) Update(ctx context.Context, key store.Key, updater func(
 	return err
 }
 
+// Patch patches an object.
+func (dc *DynamicCache) Patch(ctx context.Context, key store.Key, patchType types.PatchType, data []byte) error {
+	_, span := trace.StartSpan(ctx, "dynamicCache:patch")
+	defer span.End()
+
+	dynamicClient, err := dc.client.DynamicClient()
+	if err != nil {
+		return err
+	}
+
+	gvr, err := dc.client.Resource(key.GroupVersionKind().GroupKind())
+	if err != nil {
+		return err
+	}
+
+	if key.Namespace == "" {
+		_, err = dynamicClient.Resource(gvr).Patch(key.Name, patchType, data, metav1.PatchOptions{})
+		return err
+	}
+
+	_, err = dynamicClient.Resource(gvr).Namespace(key.Namespace).Patch(key.Name, patchType, data, metav1.PatchOptions{})
+	return err
+}
+
+// Create creates an object.
+func (dc *DynamicCache) Create(ctx context.Context, object *unstructured.Unstructured) error {
+	_, span := trace.StartSpan(ctx, "dynamicCache:create")
+	defer span.End()
+
+	if object == nil {
+		return errors.New("object is nil")
+	}
+
+	dynamicClient, err := dc.client.DynamicClient()
+	if err != nil {
+		return err
+	}
+
+	gvr, err := dc.client.Resource(object.GroupVersionKind().GroupKind())
+	if err != nil {
+		return err
+	}
+
+	if object.GetNamespace() == "" {
+		_, err = dynamicClient.Resource(gvr).Create(object, metav1.CreateOptions{})
+		return err
+	}
+
+	_, err = dynamicClient.Resource(gvr).Namespace(object.GetNamespace()).Create(object, metav1.CreateOptions{})
+	return err
+}
+
 func (dc *DynamicCache) IsLoading(ctx context.Context, key store.Key) bool {
 	return !dc.informerSynced.hasSynced(key)
 }
@@ -15,9 +15,11 @@ import (
 	authorizationv1 "k8s.io/api/authorization/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	kLabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	dynamicFake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/informers"
 	clientGoTesting "k8s.io/client-go/testing"
@@ -101,6 +103,83 @@ func Test_DynamicCache_List(t *testing.T) {
 	assert.Equal(t, expected, got)
 }
 
+func Test_DynamicCache_List_field_selector(t *testing.T) {
+	h := initDynamicCacheTestHarness(t)
+	defer h.finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pod1 := testutil.CreatePod("pod1")
+	pod1.Spec.NodeName = "node1"
+	pod2 := testutil.CreatePod("pod2")
+	pod2.Spec.NodeName = "node2"
+
+	objects := []runtime.Object{testutil.ToUnstructured(t, pod1), testutil.ToUnstructured(t, pod2)}
+
+	l := &fakeLister{listObjects: objects}
+	h.setupLister(podGVR, l)
+
+	h.mapResources(pod1.GroupVersionKind(), podGVR)
+
+	c, err := h.factory(ctx)
+	require.NoError(t, err)
+
+	h.setSynced(t, c, pod1)
+
+	key := store.Key{
+		Namespace:     pod1.Namespace,
+		APIVersion:    "v1",
+		Kind:          "Pod",
+		FieldSelector: &fields.Set{"spec.nodeName": "node1"},
+	}
+
+	got, isLoading, err := c.List(ctx, key)
+	require.NoError(t, err)
+	require.False(t, isLoading)
+
+	expected := testutil.ToUnstructuredList(t, pod1)
+	assert.Equal(t, expected, got)
+}
+
+func Test_DynamicCache_List_limit(t *testing.T) {
+	h := initDynamicCacheTestHarness(t)
+	defer h.finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pod1 := testutil.CreatePod("pod1")
+	pod2 := testutil.CreatePod("pod2")
+
+	objects := []runtime.Object{testutil.ToUnstructured(t, pod2), testutil.ToUnstructured(t, pod1)}
+
+	l := &fakeLister{listObjects: objects}
+	h.setupLister(podGVR, l)
+
+	h.mapResources(pod1.GroupVersionKind(), podGVR)
+
+	c, err := h.factory(ctx)
+	require.NoError(t, err)
+
+	h.setSynced(t, c, pod1)
+
+	key := store.Key{
+		Namespace:  pod1.Namespace,
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Limit:      1,
+	}
+
+	got, isLoading, err := c.List(ctx, key)
+	require.NoError(t, err)
+	require.False(t, isLoading)
+
+	require.Len(t, got.Items, 1)
+	assert.Equal(t, "pod1", got.Items[0].GetName())
+	assert.Equal(t, "cache:namespace/pod1", got.GetContinue())
+}
+
 func Test_DynamicCache_Get(t *testing.T) {
 	h := initDynamicCacheTestHarness(t)
 	defer h.finish()
@@ -204,6 +283,68 @@ func TestDynamicCache_Delete(t *testing.T) {
 	assert.Equal(t, expected, got)
 }
 
+func TestDynamicCache_Patch(t *testing.T) {
+	h := initDynamicCacheTestHarness(t)
+	defer h.finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pod := testutil.ToUnstructured(t, testutil.CreatePod("pod"))
+	h.mapResources(pod.GroupVersionKind(), podGVR)
+
+	scheme := runtime.NewScheme()
+
+	dc := dynamicFake.NewSimpleDynamicClient(scheme, pod)
+	h.client.EXPECT().DynamicClient().Return(dc, nil)
+
+	c, err := h.factory(ctx)
+	require.NoError(t, err)
+
+	key := h.keyFromObject(t, pod)
+
+	data := []byte(`{"metadata":{"labels":{"app":"patched"}}}`)
+	err = c.Patch(ctx, key, types.MergePatchType, data)
+	require.NoError(t, err)
+
+	require.Len(t, dc.Actions(), 1)
+
+	got, ok := dc.Actions()[0].(clientGoTesting.PatchAction)
+	require.True(t, ok)
+	assert.Equal(t, pod.GetName(), got.GetName())
+	assert.Equal(t, types.MergePatchType, got.GetPatchType())
+	assert.Equal(t, data, got.GetPatch())
+}
+
+func TestDynamicCache_Create(t *testing.T) {
+	h := initDynamicCacheTestHarness(t)
+	defer h.finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pod := testutil.ToUnstructured(t, testutil.CreatePod("pod"))
+	h.mapResources(pod.GroupVersionKind(), podGVR)
+
+	scheme := runtime.NewScheme()
+
+	dc := dynamicFake.NewSimpleDynamicClient(scheme)
+	h.client.EXPECT().DynamicClient().Return(dc, nil)
+
+	c, err := h.factory(ctx)
+	require.NoError(t, err)
+
+	err = c.Create(ctx, pod)
+	require.NoError(t, err)
+
+	require.Len(t, dc.Actions(), 1)
+
+	got, ok := dc.Actions()[0].(clientGoTesting.CreateAction)
+	require.True(t, ok)
+	assert.Equal(t, pod.GetNamespace(), got.GetNamespace())
+	assert.Equal(t, pod, got.GetObject())
+}
+
 func TestDynamicCache_Unwatch(t *testing.T) {
 	h := initDynamicCacheTestHarness(t)
 	defer h.finish()
@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+// matchesFields returns true if the fields of object match selector. The
+// API server applies field selectors to lists from the cluster, but objects
+// listed from an informer's cache are matched here.
+func matchesFields(selector fields.Selector, object *unstructured.Unstructured) bool {
+	if selector.Empty() {
+		return true
+	}
+
+	return selector.Matches(objectFields(selector, object))
+}
+
+// objectFields returns the values in object of the fields used by selector.
+// Missing fields have empty values.
+func objectFields(selector fields.Selector, object *unstructured.Unstructured) fields.Set {
+	set := fields.Set{}
+
+	for _, requirement := range selector.Requirements() {
+		value, found, err := unstructured.NestedFieldNoCopy(object.Object, strings.Split(requirement.Field, ".")...)
+		if err != nil || !found || value == nil {
+			set[requirement.Field] = ""
+			continue
+		}
+
+		set[requirement.Field] = fmt.Sprint(value)
+	}
+
+	return set
+}
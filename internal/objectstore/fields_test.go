@@ -0,0 +1,57 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/fields"
+
+	"github.com/vmware/octant/internal/testutil"
+)
+
+func Test_matchesFields(t *testing.T) {
+	pod := testutil.CreatePod("pod")
+	pod.Spec.NodeName = "node"
+	object := testutil.ToUnstructured(t, pod)
+
+	tests := []struct {
+		name     string
+		selector fields.Selector
+		expected bool
+	}{
+		{
+			name:     "everything",
+			selector: fields.Everything(),
+			expected: true,
+		},
+		{
+			name:     "matching field",
+			selector: fields.Set{"metadata.name": "pod", "spec.nodeName": "node"}.AsSelector(),
+			expected: true,
+		},
+		{
+			name:     "field does not match",
+			selector: fields.Set{"spec.nodeName": "other"}.AsSelector(),
+		},
+		{
+			name:     "missing field",
+			selector: fields.Set{"spec.missing": "value"}.AsSelector(),
+		},
+		{
+			name:     "missing field is not a value",
+			selector: fields.OneTermNotEqualSelector("spec.missing", "value"),
+			expected: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, matchesFields(test.selector, object))
+		})
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware/octant/pkg/store"
+)
+
+// cacheContinuePrefix starts continue tokens for pages of objects listed
+// from an informer's cache. Tokens from the API server are base64 encoded,
+// so they never start with it.
+const cacheContinuePrefix = "cache:"
+
+// isCacheContinue returns true if token is a continue token for a page of
+// objects listed from an informer's cache.
+func isCacheContinue(token string) bool {
+	return strings.HasPrefix(token, cacheContinuePrefix)
+}
+
+// paginate returns the page of list for key's limit and continue token.
+// Objects are sorted by namespace and name, and the continue token is the
+// last object returned, so the next page starts after it even if objects
+// are added or deleted in between.
+func paginate(list *unstructured.UnstructuredList, key store.Key) (*unstructured.UnstructuredList, error) {
+	if key.Limit < 1 && key.Continue == "" {
+		return list, nil
+	}
+
+	items := list.Items
+	sort.Slice(items, func(i, j int) bool {
+		return objectPosition(&items[i]) < objectPosition(&items[j])
+	})
+
+	if key.Continue != "" {
+		if !isCacheContinue(key.Continue) {
+			return nil, errors.Errorf("continue token %q was not created by the cache", key.Continue)
+		}
+
+		start := strings.TrimPrefix(key.Continue, cacheContinuePrefix)
+		i := sort.Search(len(items), func(i int) bool {
+			return objectPosition(&items[i]) > start
+		})
+		items = items[i:]
+	}
+
+	page := &unstructured.UnstructuredList{Object: runtime.DeepCopyJSON(list.Object)}
+	if key.Limit < 1 || int64(len(items)) <= key.Limit {
+		page.Items = items
+		return page, nil
+	}
+
+	page.Items = items[:key.Limit]
+	page.SetContinue(cacheContinuePrefix + objectPosition(&page.Items[key.Limit-1]))
+
+	remaining := int64(len(items)) - key.Limit
+	page.SetRemainingItemCount(&remaining)
+
+	return page, nil
+}
+
+// objectPosition is the position of object in a paginated list.
+func objectPosition(object *unstructured.Unstructured) string {
+	return object.GetNamespace() + "/" + object.GetName()
+}
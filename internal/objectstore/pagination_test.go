@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/store"
+)
+
+func Test_paginate(t *testing.T) {
+	podList := func(names ...string) *unstructured.UnstructuredList {
+		list := &unstructured.UnstructuredList{}
+		for _, name := range names {
+			list.Items = append(list.Items, *testutil.ToUnstructured(t, testutil.CreatePod(name)))
+		}
+		return list
+	}
+
+	names := func(list *unstructured.UnstructuredList) []string {
+		var got []string
+		for i := range list.Items {
+			got = append(got, list.Items[i].GetName())
+		}
+		return got
+	}
+
+	key := store.Key{APIVersion: "v1", Kind: "Pod", Limit: 2}
+
+	first, err := paginate(podList("c", "a", "e", "b", "d"), key)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, names(first))
+	assert.Equal(t, "cache:namespace/b", first.GetContinue())
+	require.NotNil(t, first.GetRemainingItemCount())
+	assert.Equal(t, int64(3), *first.GetRemainingItemCount())
+
+	// b is deleted before the next page is listed.
+	key.Continue = first.GetContinue()
+	second, err := paginate(podList("c", "a", "e", "d"), key)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c", "d"}, names(second))
+	assert.Equal(t, "cache:namespace/d", second.GetContinue())
+
+	key.Continue = second.GetContinue()
+	third, err := paginate(podList("c", "a", "e", "d"), key)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"e"}, names(third))
+	assert.Empty(t, third.GetContinue())
+	assert.Nil(t, third.GetRemainingItemCount())
+}
+
+func Test_paginate_without_limit(t *testing.T) {
+	list := &unstructured.UnstructuredList{
+		Items: []unstructured.Unstructured{
+			*testutil.ToUnstructured(t, testutil.CreatePod("b")),
+			*testutil.ToUnstructured(t, testutil.CreatePod("a")),
+		},
+	}
+
+	got, err := paginate(list, store.Key{APIVersion: "v1", Kind: "Pod"})
+	require.NoError(t, err)
+	assert.Equal(t, list, got)
+}
+
+func Test_paginate_invalid_continue(t *testing.T) {
+	list := &unstructured.UnstructuredList{}
+
+	_, err := paginate(list, store.Key{APIVersion: "v1", Kind: "Pod", Limit: 1, Continue: "server-token"})
+	require.Error(t, err)
+}
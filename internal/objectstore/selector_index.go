@@ -0,0 +1,103 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"sync"
+
+	"github.com/vmware/octant/pkg/store"
+)
+
+// selectorIndex caches the number of objects matched by label selectors.
+// Counts for a key are invalidated when the key's informer sees a change.
+type selectorIndex struct {
+	counts      map[string]map[string]int
+	generations map[string]int
+	watched     map[string]bool
+
+	mu sync.Mutex
+}
+
+func initSelectorIndex() *selectorIndex {
+	return &selectorIndex{
+		counts:      make(map[string]map[string]int),
+		generations: make(map[string]int),
+		watched:     make(map[string]bool),
+	}
+}
+
+func selectorIndexKey(key store.Key) string {
+	key.Name = ""
+	key.Selector = nil
+	key.LabelSelector = nil
+	key.FieldSelector = nil
+	return key.String()
+}
+
+// get returns the cached count for a selector and the key's generation.
+// The generation is passed to set so counts which were computed while the
+// key changed are not cached.
+func (c *selectorIndex) get(key store.Key, selector string) (int, int, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	indexKey := selectorIndexKey(key)
+	count, ok := c.counts[indexKey][selector]
+	return count, c.generations[indexKey], ok
+}
+
+func (c *selectorIndex) set(key store.Key, selector string, generation, count int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	indexKey := selectorIndexKey(key)
+	if c.generations[indexKey] != generation {
+		return
+	}
+
+	cur, ok := c.counts[indexKey]
+	if !ok {
+		cur = make(map[string]int)
+		c.counts[indexKey] = cur
+	}
+
+	cur[selector] = count
+}
+
+func (c *selectorIndex) invalidate(key store.Key) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	indexKey := selectorIndexKey(key)
+	delete(c.counts, indexKey)
+	c.generations[indexKey]++
+}
+
+// watch marks the key as watched. It returns true if the key was not
+// watched previously.
+func (c *selectorIndex) watch(key store.Key) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	indexKey := selectorIndexKey(key)
+	if c.watched[indexKey] {
+		return false
+	}
+
+	c.watched[indexKey] = true
+	return true
+}
+
+func (c *selectorIndex) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.counts = make(map[string]map[string]int)
+	c.watched = make(map[string]bool)
+	for k := range c.generations {
+		c.generations[k]++
+	}
+}
@@ -0,0 +1,45 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/octant/pkg/store"
+)
+
+func Test_selectorIndex(t *testing.T) {
+	c := initSelectorIndex()
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}
+	otherKey := store.Key{Namespace: "other", APIVersion: "v1", Kind: "Pod"}
+
+	_, generation, ok := c.get(key, "app=nginx")
+	require.False(t, ok)
+
+	c.set(key, "app=nginx", generation, 3)
+	count, _, ok := c.get(key, "app=nginx")
+	require.True(t, ok)
+	require.Equal(t, 3, count)
+
+	_, _, ok = c.get(otherKey, "app=nginx")
+	require.False(t, ok)
+
+	c.invalidate(key)
+	_, _, ok = c.get(key, "app=nginx")
+	require.False(t, ok)
+
+	// counts from a previous generation are not cached
+	c.set(key, "app=nginx", generation, 3)
+	_, _, ok = c.get(key, "app=nginx")
+	require.False(t, ok)
+
+	require.True(t, c.watch(key))
+	require.False(t, c.watch(key))
+	c.reset()
+	require.True(t, c.watch(key))
+}
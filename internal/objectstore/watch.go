@@ -0,0 +1,213 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	kLabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/vmware/octant/pkg/store"
+)
+
+// watchHandler wraps an informer event handler so it is only called for
+// objects which match key, and only until ctx is done. Informers are shared
+// by namespace and kind, so they send events for objects outside key.
+// Objects which are updated to no longer match key are deleted.
+func watchHandler(ctx context.Context, key store.Key, handler kcache.ResourceEventHandler) (kcache.ResourceEventHandler, error) {
+	selector, err := key.ResolveLabelSelector()
+	if err != nil {
+		return nil, err
+	}
+	fieldSelector := key.ResolveFieldSelector()
+
+	return kcache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			if ctx.Err() != nil {
+				return false
+			}
+
+			return matchesKey(key, selector, fieldSelector, obj)
+		},
+		Handler: handler,
+	}, nil
+}
+
+// matchesKey returns true if obj matches the namespace, name, and selectors
+// of key.
+func matchesKey(key store.Key, selector kLabels.Selector, fieldSelector fields.Selector, obj interface{}) bool {
+	if tombstone, ok := obj.(kcache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+
+	object, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return false
+	}
+
+	if key.Namespace != "" && object.GetNamespace() != key.Namespace {
+		return false
+	}
+
+	if key.Name != "" && object.GetName() != key.Name {
+		return false
+	}
+
+	if !selector.Matches(kLabels.Set(object.GetLabels())) {
+		return false
+	}
+
+	return matchesFields(fieldSelector, object)
+}
+
+// watchers fans informer events out to the handlers registered with Watch.
+// Each informer gets a single event handler, since handlers can't be removed
+// from a shared informer, and handlers are removed from the fan out when
+// their context is done.
+type watchers struct {
+	fanOuts map[kcache.SharedIndexInformer]*fanOut
+	nextID  int
+
+	mu sync.Mutex
+}
+
+func initWatchers() *watchers {
+	return &watchers{
+		fanOuts: make(map[kcache.SharedIndexInformer]*fanOut),
+	}
+}
+
+// add registers handler with the informer for gvr until ctx is done. The
+// handler is sent an add for each object which is already in the informer's
+// store.
+func (w *watchers) add(ctx context.Context, gvr schema.GroupVersionResource, informer kcache.SharedIndexInformer, handler kcache.ResourceEventHandler) {
+	w.mu.Lock()
+	id := w.nextID
+	w.nextID++
+
+	f, ok := w.fanOuts[informer]
+	if !ok {
+		f = &fanOut{gvr: gvr, handlers: map[int]kcache.ResourceEventHandler{id: handler}}
+		w.fanOuts[informer] = f
+		w.mu.Unlock()
+
+		// The informer sends the new handler an add for each cached object.
+		informer.AddEventHandler(f)
+	} else {
+		w.mu.Unlock()
+		f.add(id, handler, informer.GetStore())
+	}
+
+	go func() {
+		<-ctx.Done()
+		f.remove(id)
+	}()
+}
+
+// count returns the number of handlers registered with informer.
+func (w *watchers) count(informer kcache.SharedIndexInformer) int {
+	w.mu.Lock()
+	f, ok := w.fanOuts[informer]
+	w.mu.Unlock()
+
+	if !ok {
+		return 0
+	}
+
+	return f.count()
+}
+
+// forget forgets the informers for gvr. It is called when the informers are
+// deleted, so they and their fan outs can be released.
+func (w *watchers) forget(gvr schema.GroupVersionResource) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for informer, f := range w.fanOuts {
+		if f.gvr == gvr {
+			delete(w.fanOuts, informer)
+		}
+	}
+}
+
+// reset forgets all informers. It is called when the cluster client changes.
+func (w *watchers) reset() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.fanOuts = make(map[kcache.SharedIndexInformer]*fanOut)
+}
+
+// fanOut is the event handler registered with an informer. It sends each
+// event to the handlers watching the informer.
+type fanOut struct {
+	gvr      schema.GroupVersionResource
+	handlers map[int]kcache.ResourceEventHandler
+
+	mu sync.RWMutex
+}
+
+var _ kcache.ResourceEventHandler = (*fanOut)(nil)
+
+// add adds a handler and sends it an add for each object in objectStore.
+// Events are held until the replay finishes so the handler doesn't see an
+// update before the add for an object.
+func (f *fanOut) add(id int, handler kcache.ResourceEventHandler, objectStore kcache.Store) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, obj := range objectStore.List() {
+		handler.OnAdd(obj)
+	}
+
+	f.handlers[id] = handler
+}
+
+func (f *fanOut) remove(id int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.handlers, id)
+}
+
+func (f *fanOut) count() int {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	return len(f.handlers)
+}
+
+func (f *fanOut) each(fn func(handler kcache.ResourceEventHandler)) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	for _, handler := range f.handlers {
+		fn(handler)
+	}
+}
+
+func (f *fanOut) OnAdd(obj interface{}) {
+	f.each(func(handler kcache.ResourceEventHandler) {
+		handler.OnAdd(obj)
+	})
+}
+
+func (f *fanOut) OnUpdate(oldObj, newObj interface{}) {
+	f.each(func(handler kcache.ResourceEventHandler) {
+		handler.OnUpdate(oldObj, newObj)
+	})
+}
+
+func (f *fanOut) OnDelete(obj interface{}) {
+	f.each(func(handler kcache.ResourceEventHandler) {
+		handler.OnDelete(obj)
+	})
+}
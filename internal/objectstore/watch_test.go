@@ -0,0 +1,266 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/store"
+)
+
+func Test_watchHandler(t *testing.T) {
+	newPod := func(namespace, name string, podLabels map[string]string) *unstructured.Unstructured {
+		pod := testutil.CreatePod(name)
+		pod.Namespace = namespace
+		pod.Labels = podLabels
+		pod.Spec.NodeName = "node"
+		return testutil.ToUnstructured(t, pod)
+	}
+
+	app := map[string]string{"app": "app"}
+	other := map[string]string{"app": "other"}
+
+	tests := []struct {
+		name     string
+		key      store.Key
+		object   *unstructured.Unstructured
+		expected bool
+	}{
+		{
+			name:     "all namespaces",
+			key:      store.Key{APIVersion: "v1", Kind: "Pod"},
+			object:   newPod("other", "pod", nil),
+			expected: true,
+		},
+		{
+			name:     "same namespace",
+			key:      store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"},
+			object:   newPod("default", "pod", nil),
+			expected: true,
+		},
+		{
+			name:   "different namespace",
+			key:    store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"},
+			object: newPod("other", "pod", nil),
+		},
+		{
+			name:     "same name",
+			key:      store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Name: "pod"},
+			object:   newPod("default", "pod", nil),
+			expected: true,
+		},
+		{
+			name:   "different name",
+			key:    store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Name: "pod"},
+			object: newPod("default", "other", nil),
+		},
+		{
+			name:     "matching selector",
+			key:      store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Selector: (*labels.Set)(&app)},
+			object:   newPod("default", "pod", app),
+			expected: true,
+		},
+		{
+			name:   "selector does not match",
+			key:    store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Selector: (*labels.Set)(&app)},
+			object: newPod("default", "pod", other),
+		},
+		{
+			name: "matching label selector",
+			key: store.Key{
+				Namespace:  "default",
+				APIVersion: "v1",
+				Kind:       "Pod",
+				LabelSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "app", Operator: metav1.LabelSelectorOpIn, Values: []string{"app", "web"}},
+					},
+				},
+			},
+			object:   newPod("default", "pod", app),
+			expected: true,
+		},
+		{
+			name: "label selector does not match",
+			key: store.Key{
+				Namespace:  "default",
+				APIVersion: "v1",
+				Kind:       "Pod",
+				LabelSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "app", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"other"}},
+					},
+				},
+			},
+			object: newPod("default", "pod", other),
+		},
+		{
+			name:     "matching field selector",
+			key:      store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", FieldSelector: &fields.Set{"spec.nodeName": "node"}},
+			object:   newPod("default", "pod", nil),
+			expected: true,
+		},
+		{
+			name:   "field selector does not match",
+			key:    store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", FieldSelector: &fields.Set{"spec.nodeName": "other"}},
+			object: newPod("default", "pod", nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var added, updated, deleted []interface{}
+			handler, err := watchHandler(context.Background(), test.key, kcache.ResourceEventHandlerFuncs{
+				AddFunc:    func(obj interface{}) { added = append(added, obj) },
+				UpdateFunc: func(_, obj interface{}) { updated = append(updated, obj) },
+				DeleteFunc: func(obj interface{}) { deleted = append(deleted, obj) },
+			})
+			require.NoError(t, err)
+
+			tombstone := kcache.DeletedFinalStateUnknown{Key: "key", Obj: test.object}
+
+			handler.OnAdd(test.object)
+			handler.OnUpdate(test.object, test.object)
+			handler.OnDelete(tombstone)
+
+			if test.expected {
+				assert.Equal(t, []interface{}{test.object}, added)
+				assert.Equal(t, []interface{}{test.object}, updated)
+				assert.Equal(t, []interface{}{tombstone}, deleted)
+				return
+			}
+
+			assert.Empty(t, added)
+			assert.Empty(t, updated)
+			assert.Empty(t, deleted)
+		})
+	}
+}
+
+func Test_watchHandler_update_out_of_key(t *testing.T) {
+	app := map[string]string{"app": "app"}
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Selector: (*labels.Set)(&app)}
+
+	oldPod := testutil.CreatePod("pod")
+	oldPod.Namespace = "default"
+	oldPod.Labels = app
+	newPod := oldPod.DeepCopy()
+	newPod.Labels = map[string]string{"app": "other"}
+
+	oldObject := testutil.ToUnstructured(t, oldPod)
+	newObject := testutil.ToUnstructured(t, newPod)
+
+	var added, deleted []interface{}
+	handler, err := watchHandler(context.Background(), key, kcache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { added = append(added, obj) },
+		DeleteFunc: func(obj interface{}) { deleted = append(deleted, obj) },
+	})
+	require.NoError(t, err)
+
+	handler.OnUpdate(oldObject, newObject)
+	assert.Equal(t, []interface{}{oldObject}, deleted)
+
+	handler.OnUpdate(newObject, oldObject)
+	assert.Equal(t, []interface{}{oldObject}, added)
+}
+
+func Test_watchHandler_context_done(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var added []interface{}
+	handler, err := watchHandler(ctx, store.Key{APIVersion: "v1", Kind: "Pod"}, kcache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) { added = append(added, obj) },
+	})
+	require.NoError(t, err)
+
+	object := testutil.ToUnstructured(t, testutil.CreatePod("pod"))
+
+	handler.OnAdd(object)
+	cancel()
+	handler.OnAdd(object)
+
+	assert.Equal(t, []interface{}{object}, added)
+}
+
+type fakeSharedIndexInformer struct {
+	kcache.SharedIndexInformer
+
+	store    kcache.Store
+	handlers []kcache.ResourceEventHandler
+}
+
+func (i *fakeSharedIndexInformer) AddEventHandler(handler kcache.ResourceEventHandler) {
+	i.handlers = append(i.handlers, handler)
+	for _, obj := range i.store.List() {
+		handler.OnAdd(obj)
+	}
+}
+
+func (i *fakeSharedIndexInformer) GetStore() kcache.Store {
+	return i.store
+}
+
+func Test_watchers(t *testing.T) {
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+	informer := &fakeSharedIndexInformer{store: kcache.NewStore(kcache.MetaNamespaceKeyFunc)}
+
+	existing := testutil.ToUnstructured(t, testutil.CreatePod("existing"))
+	require.NoError(t, informer.store.Add(existing))
+
+	w := initWatchers()
+
+	var firstAdded, secondAdded []interface{}
+
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	w.add(ctx1, gvr, informer, kcache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) { firstAdded = append(firstAdded, obj) },
+	})
+
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	w.add(ctx2, gvr, informer, kcache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) { secondAdded = append(secondAdded, obj) },
+	})
+
+	require.Len(t, informer.handlers, 1)
+	assert.Equal(t, 2, w.count(informer))
+	assert.Equal(t, []interface{}{existing}, firstAdded)
+	assert.Equal(t, []interface{}{existing}, secondAdded)
+
+	added := testutil.ToUnstructured(t, testutil.CreatePod("added"))
+	informer.handlers[0].OnAdd(added)
+	assert.Equal(t, []interface{}{existing, added}, firstAdded)
+	assert.Equal(t, []interface{}{existing, added}, secondAdded)
+
+	cancel1()
+	cancel2()
+	deadline := time.Now().Add(time.Second)
+	for w.count(informer) > 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, 0, w.count(informer))
+
+	ctx3, cancel3 := context.WithCancel(context.Background())
+	defer cancel3()
+	w.add(ctx3, gvr, informer, kcache.ResourceEventHandlerFuncs{})
+
+	assert.Len(t, informer.handlers, 1)
+	assert.Equal(t, 1, w.count(informer))
+
+	w.forget(gvr)
+	assert.Equal(t, 0, w.count(informer))
+}
@@ -67,6 +67,14 @@ func SetTypedVisitors(list []TypedVisitor) DefaultVisitorOption {
 	}
 }
 
+// SetRelationships sets the visitor for relationships declared by plugins and
+// CRD annotations.
+func SetRelationships(relationships DefaultTypedVisitor) DefaultVisitorOption {
+	return func(dv *DefaultVisitor) {
+		dv.relationships = relationships
+	}
+}
+
 // DefaultVisitor is the default implementation of Visitor.
 type DefaultVisitor struct {
 	queryer   queryer.Queryer
@@ -75,6 +83,7 @@ type DefaultVisitor struct {
 
 	typedVisitors  []TypedVisitor
 	defaultHandler DefaultTypedVisitor
+	relationships  DefaultTypedVisitor
 }
 
 var _ Visitor = (*DefaultVisitor)(nil)
@@ -153,7 +162,8 @@ func (dv *DefaultVisitor) Visit(ctx context.Context, object *unstructured.Unstru
 }
 
 // visitObject visits an object. If the object is a service, ingress, or pod, it
-// also runs custom visitor code for them.
+// also runs custom visitor code for them. Objects referenced through declared
+// relationships are visited as well.
 func (dv *DefaultVisitor) visitObject(ctx context.Context, object runtime.Object, handler ObjectHandler, visitDescendants bool) error {
 	ctx, span := trace.StartSpan(ctx, "visitObject")
 	defer span.End()
@@ -186,6 +196,12 @@ func (dv *DefaultVisitor) visitObject(ctx context.Context, object runtime.Object
 		}
 	}
 
+	if dv.relationships != nil {
+		if err := dv.relationships.Visit(ctx, u, handler, dv, visitDescendants); err != nil {
+			return err
+		}
+	}
+
 	return dv.defaultHandler.Visit(ctx, u, handler, dv, visitDescendants)
 }
 
@@ -35,7 +35,8 @@ func (p *Pod) Supports() schema.GroupVersionKind {
 	return gvk.Pod
 }
 
-// Visit visits a pod. It looks for service accounts and services.
+// Visit visits a pod. It looks for service accounts, services, and the
+// config maps and secrets the pod references.
 func (p *Pod) Visit(ctx context.Context, object *unstructured.Unstructured, handler ObjectHandler, visitor Visitor, visitDescendants bool) error {
 	ctx, span := trace.StartSpan(ctx, "visitPod")
 	defer span.End()
@@ -101,5 +102,55 @@ func (p *Pod) Visit(ctx context.Context, object *unstructured.Unstructured, hand
 		return nil
 	})
 
+	g.Go(func() error {
+		configMaps, err := p.queryer.ConfigMapsForPod(ctx, pod)
+		if err != nil {
+			return err
+		}
+
+		var objects []runtime.Object
+		for i := range configMaps {
+			objects = append(objects, configMaps[i])
+		}
+
+		return p.visitReferences(ctx, object, pod, objects, handler, visitor)
+	})
+	g.Go(func() error {
+		secrets, err := p.queryer.SecretsForPod(ctx, pod)
+		if err != nil {
+			return err
+		}
+
+		var objects []runtime.Object
+		for i := range secrets {
+			objects = append(objects, secrets[i])
+		}
+
+		return p.visitReferences(ctx, object, pod, objects, handler, visitor)
+	})
+
 	return g.Wait()
 }
+
+// visitReferences visits objects a pod references, and adds an edge from
+// the pod to each of them.
+func (p *Pod) visitReferences(ctx context.Context, object *unstructured.Unstructured, pod *corev1.Pod, objects []runtime.Object, handler ObjectHandler, visitor Visitor) error {
+	for _, referenced := range objects {
+		m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(referenced)
+		if err != nil {
+			return err
+		}
+		u := &unstructured.Unstructured{Object: m}
+
+		if err := visitor.Visit(ctx, u, handler, true); err != nil {
+			return errors.Wrapf(err, "pod %s visit %s",
+				kubernetes.PrintObject(pod), kubernetes.PrintObject(referenced))
+		}
+
+		if err := handler.AddEdge(ctx, object, u); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -33,6 +33,14 @@ func TestPod_Visit(t *testing.T) {
 	q.EXPECT().
 		ServiceAccountForPod(gomock.Any(), object).
 		Return(serviceAccount, nil)
+	configMap := testutil.CreateConfigMap("config-map")
+	q.EXPECT().
+		ConfigMapsForPod(gomock.Any(), object).
+		Return([]*corev1.ConfigMap{configMap}, nil)
+	secret := testutil.CreateSecret("secret")
+	q.EXPECT().
+		SecretsForPod(gomock.Any(), object).
+		Return([]*corev1.Secret{secret}, nil)
 
 	handler := fake.NewMockObjectHandler(controller)
 	handler.EXPECT().
@@ -41,6 +49,12 @@ func TestPod_Visit(t *testing.T) {
 	handler.EXPECT().
 		AddEdge(gomock.Any(), u, testutil.ToUnstructured(t, serviceAccount)).
 		Return(nil)
+	handler.EXPECT().
+		AddEdge(gomock.Any(), u, testutil.ToUnstructured(t, configMap)).
+		Return(nil)
+	handler.EXPECT().
+		AddEdge(gomock.Any(), u, testutil.ToUnstructured(t, secret)).
+		Return(nil)
 
 	var visited []unstructured.Unstructured
 	visitor := fake.NewMockVisitor(controller)
@@ -58,7 +72,7 @@ func TestPod_Visit(t *testing.T) {
 
 	sortObjectsByName(t, visited)
 
-	expected := testutil.ToUnstructuredList(t, service, serviceAccount)
+	expected := testutil.ToUnstructuredList(t, configMap, secret, service, serviceAccount)
 	assert.Equal(t, expected.Items, visited)
 	assert.NoError(t, err)
 }
@@ -0,0 +1,238 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectvisitor
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/trace"
+	"golang.org/x/sync/errgroup"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/internal/util/kubernetes"
+	"github.com/vmware/octant/pkg/navigation"
+	"github.com/vmware/octant/pkg/plugin"
+	"github.com/vmware/octant/pkg/store"
+)
+
+const (
+	// RelationshipsAnnotation is a CRD annotation which declares the objects
+	// its custom resources reference. It is a JSON list of rules, e.g.
+	// [{"apiVersion": "v1", "kind": "Secret", "path": ".spec.secretName"}].
+	RelationshipsAnnotation = "octant.dev/relationships"
+)
+
+// relationshipRule is a rule declared with RelationshipsAnnotation.
+type relationshipRule struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Path       string `json:"path"`
+}
+
+// Relationships visits objects referenced by an object through relationships
+// declared by plugins or by CRD annotations. It allows the resource viewer to
+// include edges which are specific to an operator.
+type Relationships struct {
+	objectStore store.Store
+	pluginStore plugin.ManagerStore
+}
+
+// NewRelationships creates an instance of Relationships. pluginStore can be
+// nil if plugin relationships should not be used.
+func NewRelationships(objectStore store.Store, pluginStore plugin.ManagerStore) *Relationships {
+	return &Relationships{
+		objectStore: objectStore,
+		pluginStore: pluginStore,
+	}
+}
+
+// Visit visits the objects referenced by object and adds an edge to each.
+func (r *Relationships) Visit(ctx context.Context, object *unstructured.Unstructured, handler ObjectHandler, visitor Visitor, visitDescendants bool) error {
+	ctx, span := trace.StartSpan(ctx, "visitRelationships")
+	defer span.End()
+
+	relationships, err := r.Find(ctx, object.GroupVersionKind())
+	if err != nil {
+		return err
+	}
+
+	var g errgroup.Group
+
+	for _, relationship := range relationships {
+		names, err := RelationshipNames(object.Object, relationship.Path)
+		if err != nil {
+			log.From(ctx).
+				With("object", kubernetes.PrintObject(object), "path", relationship.Path).
+				Debugf("unable to read relationship: %v", err)
+			continue
+		}
+
+		apiVersion, kind := relationship.Target.ToAPIVersionAndKind()
+
+		for _, name := range names {
+			if name == "" {
+				continue
+			}
+
+			key := store.Key{
+				Namespace:  object.GetNamespace(),
+				APIVersion: apiVersion,
+				Kind:       kind,
+				Name:       name,
+			}
+
+			g.Go(func() error {
+				target, found, err := r.objectStore.Get(ctx, key)
+				if err != nil {
+					return errors.Wrapf(err, "get %s referenced by %s", key, kubernetes.PrintObject(object))
+				}
+
+				if !found {
+					return nil
+				}
+
+				if err := visitor.Visit(ctx, target, handler, false); err != nil {
+					return errors.Wrapf(err, "visit %s referenced by %s",
+						kubernetes.PrintObject(target), kubernetes.PrintObject(object))
+				}
+
+				return handler.AddEdge(ctx, object, target)
+			})
+		}
+	}
+
+	return g.Wait()
+}
+
+// Find finds the relationships whose source is a group and kind. Plugin
+// relationships are listed before CRD relationships.
+func (r *Relationships) Find(ctx context.Context, groupVersionKind schema.GroupVersionKind) ([]plugin.Relationship, error) {
+	var list []plugin.Relationship
+
+	if r.pluginStore != nil {
+		for _, name := range r.pluginStore.ClientNames() {
+			metadata, err := r.pluginStore.GetMetadata(name)
+			if err != nil {
+				return nil, errors.Wrapf(err, "get metadata for plugin %s", name)
+			}
+
+			for _, relationship := range metadata.Capabilities.Relationships {
+				if isSameGroupKind(relationship.Source, groupVersionKind) {
+					list = append(list, relationship)
+				}
+			}
+		}
+	}
+
+	crds, _, err := navigation.CustomResourceDefinitions(ctx, r.objectStore)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, crd := range crds {
+		if crd.Spec.Group != groupVersionKind.Group || crd.Spec.Names.Kind != groupVersionKind.Kind {
+			continue
+		}
+
+		value, ok := crd.Annotations[RelationshipsAnnotation]
+		if !ok {
+			continue
+		}
+
+		var rules []relationshipRule
+		if err := json.Unmarshal([]byte(value), &rules); err != nil {
+			log.From(ctx).
+				With("crd", crd.Name).
+				Warnf("invalid %s annotation: %v", RelationshipsAnnotation, err)
+			continue
+		}
+
+		for _, rule := range rules {
+			list = append(list, plugin.Relationship{
+				Source: groupVersionKind,
+				Target: schema.FromAPIVersionAndKind(rule.APIVersion, rule.Kind),
+				Path:   rule.Path,
+			})
+		}
+	}
+
+	return list, nil
+}
+
+// RelationshipNames returns the names found at a relationship path in an
+// object. The value at the end of the path can be a string or a list of
+// strings. A missing field results in no names.
+func RelationshipNames(object map[string]interface{}, path string) ([]string, error) {
+	segments := strings.Split(strings.TrimPrefix(path, "."), ".")
+	if path == "" || segments[0] == "" {
+		return nil, errors.Errorf("path %q is empty", path)
+	}
+
+	values := []interface{}{object}
+
+	for _, segment := range segments {
+		isList := strings.HasSuffix(segment, "[]")
+		field := strings.TrimSuffix(segment, "[]")
+		if field == "" {
+			return nil, errors.Errorf("path %q has an empty segment", path)
+		}
+
+		var next []interface{}
+		for _, value := range values {
+			m, ok := value.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			v, ok := m[field]
+			if !ok {
+				continue
+			}
+
+			if !isList {
+				next = append(next, v)
+				continue
+			}
+
+			items, ok := v.([]interface{})
+			if !ok {
+				return nil, errors.Errorf("%s in path %q is not a list", field, path)
+			}
+			next = append(next, items...)
+		}
+
+		values = next
+	}
+
+	var names []string
+	for _, value := range values {
+		switch v := value.(type) {
+		case string:
+			names = append(names, v)
+		case []interface{}:
+			for _, item := range v {
+				s, ok := item.(string)
+				if !ok {
+					return nil, errors.Errorf("path %q contains a non string value", path)
+				}
+				names = append(names, s)
+			}
+		default:
+			return nil, errors.Errorf("path %q contains a non string value", path)
+		}
+	}
+
+	return names, nil
+}
+
+func isSameGroupKind(a, b schema.GroupVersionKind) bool {
+	return a.GroupKind() == b.GroupKind()
+}
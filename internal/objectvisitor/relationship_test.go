@@ -0,0 +1,211 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectvisitor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware/octant/internal/objectvisitor"
+	"github.com/vmware/octant/internal/objectvisitor/fake"
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/plugin"
+	pluginFake "github.com/vmware/octant/pkg/plugin/fake"
+	"github.com/vmware/octant/pkg/store"
+	storeFake "github.com/vmware/octant/pkg/store/fake"
+)
+
+var crdKey = store.Key{APIVersion: "apiextensions.k8s.io/v1beta1", Kind: "CustomResourceDefinition"}
+
+func TestRelationships_Visit(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cronTab := testutil.CreateCustomResource("crontab")
+	cronTab.Object["spec"].(map[string]interface{})["secretName"] = "secret"
+
+	crd := testutil.CreateCRD("crontabs.stable.example.com")
+	crd.Spec.Group = "stable.example.com"
+	crd.Spec.Names.Kind = "CronTab"
+	crd.Annotations = map[string]string{
+		objectvisitor.RelationshipsAnnotation: `[{"apiVersion":"v1","kind":"Secret","path":".spec.secretName"}]`,
+	}
+
+	secret := testutil.ToUnstructured(t, testutil.CreateSecret("secret"))
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		List(gomock.Any(), crdKey).
+		Return(testutil.ToUnstructuredList(t, crd), false, nil)
+	objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{Namespace: testutil.DefaultNamespace, APIVersion: "v1", Kind: "Secret", Name: "secret"}).
+		Return(secret, true, nil)
+
+	handler := fake.NewMockObjectHandler(controller)
+	handler.EXPECT().AddEdge(gomock.Any(), cronTab, secret).Return(nil)
+
+	visitor := fake.NewMockVisitor(controller)
+	visitor.EXPECT().Visit(gomock.Any(), secret, handler, false).Return(nil)
+
+	relationships := objectvisitor.NewRelationships(objectStore, nil)
+
+	ctx := context.Background()
+	err := relationships.Visit(ctx, cronTab, handler, visitor, true)
+	require.NoError(t, err)
+}
+
+func TestRelationships_Find(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	source := schema.GroupVersionKind{Group: "stable.example.com", Version: "v1", Kind: "CronTab"}
+	secretGVK := schema.GroupVersionKind{Version: "v1", Kind: "Secret"}
+	configMapGVK := schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}
+
+	pluginStore := pluginFake.NewMockManagerStore(controller)
+	pluginStore.EXPECT().ClientNames().Return([]string{"plugin"})
+	pluginStore.EXPECT().GetMetadata("plugin").Return(&plugin.Metadata{
+		Capabilities: plugin.Capabilities{
+			Relationships: []plugin.Relationship{
+				{
+					Source: schema.GroupVersionKind{Group: "stable.example.com", Version: "v2", Kind: "CronTab"},
+					Target: configMapGVK,
+					Path:   ".spec.configMaps",
+				},
+				{
+					Source: schema.GroupVersionKind{Group: "other.example.com", Version: "v1", Kind: "CronTab"},
+					Target: configMapGVK,
+					Path:   ".spec.other",
+				},
+			},
+		},
+	}, nil)
+
+	crd := testutil.CreateCRD("crontabs.stable.example.com")
+	crd.Spec.Group = "stable.example.com"
+	crd.Spec.Names.Kind = "CronTab"
+	crd.Annotations = map[string]string{
+		objectvisitor.RelationshipsAnnotation: `[{"apiVersion":"v1","kind":"Secret","path":".spec.secretName"}]`,
+	}
+
+	invalid := testutil.CreateCRD("invalid.stable.example.com")
+	invalid.Spec.Group = "stable.example.com"
+	invalid.Spec.Names.Kind = "CronTab"
+	invalid.Annotations = map[string]string{
+		objectvisitor.RelationshipsAnnotation: `{`,
+	}
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		List(gomock.Any(), crdKey).
+		Return(testutil.ToUnstructuredList(t, crd, invalid), false, nil)
+
+	relationships := objectvisitor.NewRelationships(objectStore, pluginStore)
+
+	ctx := context.Background()
+	got, err := relationships.Find(ctx, source)
+	require.NoError(t, err)
+
+	expected := []plugin.Relationship{
+		{
+			Source: schema.GroupVersionKind{Group: "stable.example.com", Version: "v2", Kind: "CronTab"},
+			Target: configMapGVK,
+			Path:   ".spec.configMaps",
+		},
+		{
+			Source: source,
+			Target: secretGVK,
+			Path:   ".spec.secretName",
+		},
+	}
+	assert.Equal(t, expected, got)
+}
+
+func TestRelationshipNames(t *testing.T) {
+	object := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"secretName": "secret",
+			"configMaps": []interface{}{"a", "b"},
+			"replicas":   int64(1),
+			"volumes": []interface{}{
+				map[string]interface{}{"secretName": "volume-a"},
+				map[string]interface{}{"configMap": "config"},
+				map[string]interface{}{"secretName": "volume-b"},
+			},
+		},
+	}}
+
+	tests := []struct {
+		name     string
+		path     string
+		expected []string
+		isErr    bool
+	}{
+		{
+			name:     "string",
+			path:     ".spec.secretName",
+			expected: []string{"secret"},
+		},
+		{
+			name:     "without leading dot",
+			path:     "spec.secretName",
+			expected: []string{"secret"},
+		},
+		{
+			name:     "list of strings",
+			path:     ".spec.configMaps",
+			expected: []string{"a", "b"},
+		},
+		{
+			name:     "field in list items",
+			path:     ".spec.volumes[].secretName",
+			expected: []string{"volume-a", "volume-b"},
+		},
+		{
+			name: "missing field",
+			path: ".spec.missing",
+		},
+		{
+			name:  "not a string",
+			path:  ".spec.replicas",
+			isErr: true,
+		},
+		{
+			name:  "not a list",
+			path:  ".spec.secretName[].name",
+			isErr: true,
+		},
+		{
+			name:  "empty path",
+			path:  "",
+			isErr: true,
+		},
+		{
+			name:  "empty segment",
+			path:  ".spec..secretName",
+			isErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := objectvisitor.RelationshipNames(object.Object, test.path)
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
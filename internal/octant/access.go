@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"fmt"
+
+	octantErrors "github.com/vmware/octant/internal/errors"
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/pkg/store"
+)
+
+// AccessRequirement is a verb an action needs to perform on the objects
+// for a key, or on a subresource of them.
+type AccessRequirement struct {
+	Key         store.Key
+	Subresource string
+	Verb        string
+}
+
+// actionVerbs are the verbs actions need on the object they act on.
+var actionVerbs = map[string]string{
+	ActionDeleteObject:      "delete",
+	ActionScale:             "patch",
+	ActionRestartRollout:    "update",
+	ActionPauseRollout:      "update",
+	ActionResumeRollout:     "update",
+	ActionRollback:          "update",
+	ActionEditAutoscaler:    "update",
+	ActionSuspendCronJob:    "update",
+	ActionResumeCronJob:     "update",
+	ActionCordonNode:        "update",
+	ActionUncordonNode:      "update",
+	ActionEditConfigMapData: "patch",
+	ActionSetDataKey:        "patch",
+	ActionRemoveDataKey:     "patch",
+	ActionEditContainer:     "update",
+	ActionEditService:       "update",
+	ActionApplyObject:       "patch",
+	ActionApplyManifest:     "patch",
+}
+
+// RequiredAccess returns the access an action needs to act on the object
+// with key. Actions which are not known, or which only change local state,
+// need no access.
+func RequiredAccess(actionName string, key store.Key) []AccessRequirement {
+	switch actionName {
+	case ActionTriggerCronJob:
+		return []AccessRequirement{
+			{Key: store.Key{Namespace: key.Namespace, APIVersion: "batch/v1", Kind: "Job"}, Verb: "create"},
+		}
+	case ActionDrainNode:
+		return []AccessRequirement{
+			{Key: key, Verb: "update"},
+			{Key: store.Key{APIVersion: "v1", Kind: "Pod"}, Verb: "list"},
+			{Key: store.Key{APIVersion: "v1", Kind: "Pod"}, Subresource: "eviction", Verb: "create"},
+		}
+	}
+
+	verb, ok := actionVerbs[actionName]
+	if !ok {
+		return nil
+	}
+
+	return []AccessRequirement{{Key: key, Verb: verb}}
+}
+
+// AccessDeniedReason returns why the current credentials can't perform an
+// action on the object with key, or a blank string if they can. Access
+// which can't be checked is not denied, since the API server still enforces
+// access when the action runs.
+func AccessDeniedReason(ctx context.Context, checker store.AccessChecker, actionName string, key store.Key) string {
+	if checker == nil {
+		return ""
+	}
+
+	for _, requirement := range RequiredAccess(actionName, key) {
+		var err error
+		if requirement.Subresource == "" {
+			err = checker.HasAccess(ctx, requirement.Key, requirement.Verb)
+		} else if subresourceChecker, ok := checker.(store.SubresourceAccessChecker); ok {
+			err = subresourceChecker.HasSubresourceAccess(ctx, requirement.Key, requirement.Subresource, requirement.Verb)
+		}
+		if err == nil {
+			continue
+		}
+
+		if octantErrors.CodeOf(err) != octantErrors.CodeForbidden {
+			log.From(ctx).WithErr(err).Debugf("check access for action %s", actionName)
+			continue
+		}
+
+		target := fmt.Sprintf("%s objects", requirement.Key.Kind)
+		if requirement.Key.Name != "" {
+			target = fmt.Sprintf("%s %q", requirement.Key.Kind, requirement.Key.Name)
+		}
+		if requirement.Subresource != "" {
+			target = fmt.Sprintf("the %s of %s", requirement.Subresource, target)
+		}
+
+		return fmt.Sprintf("Your credentials can't %s %s", requirement.Verb, target)
+	}
+
+	return ""
+}
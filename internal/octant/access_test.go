@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	octantErrors "github.com/vmware/octant/internal/errors"
+	"github.com/vmware/octant/pkg/store"
+)
+
+func TestRequiredAccess(t *testing.T) {
+	deployment := store.Key{Namespace: "default", APIVersion: "apps/v1", Kind: "Deployment", Name: "web"}
+	cronJob := store.Key{Namespace: "default", APIVersion: "batch/v1beta1", Kind: "CronJob", Name: "backup"}
+	node := store.Key{APIVersion: "v1", Kind: "Node", Name: "node"}
+
+	tests := []struct {
+		name       string
+		actionName string
+		key        store.Key
+		expected   []AccessRequirement
+	}{
+		{
+			name:       "delete",
+			actionName: ActionDeleteObject,
+			key:        deployment,
+			expected:   []AccessRequirement{{Key: deployment, Verb: "delete"}},
+		},
+		{
+			name:       "scale",
+			actionName: ActionScale,
+			key:        deployment,
+			expected:   []AccessRequirement{{Key: deployment, Verb: "patch"}},
+		},
+		{
+			name:       "trigger cron job",
+			actionName: ActionTriggerCronJob,
+			key:        cronJob,
+			expected: []AccessRequirement{
+				{Key: store.Key{Namespace: "default", APIVersion: "batch/v1", Kind: "Job"}, Verb: "create"},
+			},
+		},
+		{
+			name:       "drain node",
+			actionName: ActionDrainNode,
+			key:        node,
+			expected: []AccessRequirement{
+				{Key: node, Verb: "update"},
+				{Key: store.Key{APIVersion: "v1", Kind: "Pod"}, Verb: "list"},
+				{Key: store.Key{APIVersion: "v1", Kind: "Pod"}, Subresource: "eviction", Verb: "create"},
+			},
+		},
+		{
+			name:       "local action",
+			actionName: ActionEditNotes,
+			key:        deployment,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, RequiredAccess(test.actionName, test.key))
+		})
+	}
+}
+
+// subresourceAccessChecker denies access to the subresources in denied.
+type subresourceAccessChecker struct {
+	denied map[string]bool
+}
+
+func (c *subresourceAccessChecker) HasAccess(ctx context.Context, key store.Key, verb string) error {
+	return nil
+}
+
+func (c *subresourceAccessChecker) HasSubresourceAccess(ctx context.Context, key store.Key, subresource, verb string) error {
+	if c.denied[subresource] {
+		return octantErrors.NewForbidden("no %s access to %s", verb, subresource)
+	}
+	return nil
+}
+
+func TestAccessDeniedReason(t *testing.T) {
+	node := store.Key{APIVersion: "v1", Kind: "Node", Name: "node"}
+	ctx := context.Background()
+
+	assert.Empty(t, AccessDeniedReason(ctx, nil, ActionDrainNode, node))
+	assert.Empty(t, AccessDeniedReason(ctx, &subresourceAccessChecker{}, ActionDrainNode, node))
+	assert.Equal(t, "Your credentials can't create the eviction of Pod objects",
+		AccessDeniedReason(ctx, &subresourceAccessChecker{denied: map[string]bool{"eviction": true}}, ActionDrainNode, node))
+}
@@ -1,5 +1,34 @@
 package octant
 
 const (
-	ActionDeleteObject = "octant/deleteObject"
+	ActionDeleteObject      = "octant/deleteObject"
+	ActionStartPortForward  = "overview/startPortForward"
+	ActionEditConfigMapData = "overview/configMapDataEditor"
+	ActionSetDataKey        = "overview/setDataKey"
+	ActionRemoveDataKey     = "overview/removeDataKey"
+	ActionCreateSecret      = "overview/createSecret"
+	ActionCheckReachability = "overview/checkReachability"
+	ActionEditNotes         = "overview/editNotes"
+	ActionUploadFile        = "overview/uploadFile"
+	ActionDownloadFile      = "overview/downloadFile"
+	ActionApplyObject       = "overview/applyObject"
+	ActionEditContainer     = "overview/containerEditor"
+	ActionEditService       = "overview/serviceEditor"
+	ActionApplyManifest     = "overview/applyManifest"
+	ActionScale             = "overview/scale"
+	ActionEditAutoscaler    = "overview/horizontalPodAutoscalerEditor"
+	ActionRestartRollout    = "overview/restartRollout"
+	ActionPauseRollout      = "overview/pauseRollout"
+	ActionResumeRollout     = "overview/resumeRollout"
+	ActionRollback          = "overview/rollback"
+	ActionTriggerCronJob    = "overview/triggerCronJob"
+	ActionSuspendCronJob    = "overview/suspendCronJob"
+	ActionResumeCronJob     = "overview/resumeCronJob"
+	ActionCordonNode        = "clusterOverview/cordonNode"
+	ActionUncordonNode      = "clusterOverview/uncordonNode"
+	ActionDrainNode         = "clusterOverview/drainNode"
+	ActionTerminateSession  = "configuration/terminateSession"
+	ActionStopPortForward   = "configuration/stopPortForward"
+	ActionRestartPlugin     = "configuration/restartPlugin"
+	ActionDisablePlugin     = "configuration/disablePlugin"
 )
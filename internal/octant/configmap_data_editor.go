@@ -0,0 +1,157 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/store"
+)
+
+const (
+	// ConfigMapEditedKeysAnnotation records the data keys changed by the last edit.
+	ConfigMapEditedKeysAnnotation = "octant.dev/last-edited-keys"
+	// ConfigMapEditedAtAnnotation records the time of the last edit.
+	ConfigMapEditedAtAnnotation = "octant.dev/last-edited-at"
+
+	configMapDataFieldPrefix = "data."
+)
+
+// ConfigMapDataField returns the form field name for a config map data key.
+func ConfigMapDataField(key string) string {
+	return configMapDataFieldPrefix + key
+}
+
+// ConfigMapDataEditor edits the data of a config map.
+type ConfigMapDataEditor struct {
+	store store.Store
+	now   func() time.Time
+}
+
+var _ action.Dispatcher = (*ConfigMapDataEditor)(nil)
+
+// NewConfigMapDataEditor creates an instance of ConfigMapDataEditor.
+func NewConfigMapDataEditor(objectStore store.Store) *ConfigMapDataEditor {
+	return &ConfigMapDataEditor{
+		store: objectStore,
+		now:   time.Now,
+	}
+}
+
+// ActionName returns the name of this action.
+func (e *ConfigMapDataEditor) ActionName() string {
+	return ActionEditConfigMapData
+}
+
+// Handle edits a config map's data. Only keys whose values were changed
+// are patched, and the change is recorded in the config map's annotations.
+func (e *ConfigMapDataEditor) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", e.ActionName())
+	logger.With("payload", payload).Debugf("received action payload")
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	object, found, err := e.store.Get(ctx, key)
+	if err != nil {
+		return errors.Wrapf(err, "get config map %q", key.Name)
+	}
+	if !found {
+		return errors.Errorf("config map %q not found", key.Name)
+	}
+
+	changed, err := changedConfigMapData(object, payload)
+	if err != nil {
+		return err
+	}
+
+	if len(changed) == 0 {
+		message := fmt.Sprintf("ConfigMap %q was not changed", key.Name)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	patch, err := configMapDataPatch(changed, e.now())
+	if err != nil {
+		return err
+	}
+
+	alertType := action.AlertTypeInfo
+	message := fmt.Sprintf("Updated ConfigMap %q keys: %s", key.Name, strings.Join(sortedKeys(changed), ", "))
+	if err := e.store.Patch(ctx, key, types.StrategicMergePatchType, patch); err != nil {
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to update ConfigMap %q: %s", key.Name, err)
+		logger.WithErr(err).Errorf("patch config map")
+	}
+
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+
+	return nil
+}
+
+// changedConfigMapData returns the data keys with values in the payload
+// which differ from the config map's current values.
+func changedConfigMapData(object *unstructured.Unstructured, payload action.Payload) (map[string]string, error) {
+	data, _, err := unstructured.NestedStringMap(object.Object, "data")
+	if err != nil {
+		return nil, errors.Wrap(err, "read config map data")
+	}
+
+	changed := make(map[string]string)
+	for dataKey, current := range data {
+		field := ConfigMapDataField(dataKey)
+		if _, ok := payload[field]; !ok {
+			continue
+		}
+
+		value, err := payload.String(field)
+		if err != nil {
+			return nil, err
+		}
+
+		if value != current {
+			changed[dataKey] = value
+		}
+	}
+
+	return changed, nil
+}
+
+func configMapDataPatch(changed map[string]string, editedAt time.Time) ([]byte, error) {
+	patch := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				ConfigMapEditedKeysAnnotation: strings.Join(sortedKeys(changed), ","),
+				ConfigMapEditedAtAnnotation:   editedAt.UTC().Format(time.RFC3339),
+			},
+		},
+		"data": changed,
+	}
+
+	return json.Marshal(patch)
+}
+
+func sortedKeys(m map[string]string) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,138 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/action"
+	actionFake "github.com/vmware/octant/pkg/action/fake"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/store/fake"
+)
+
+func TestConfigMapDataEditor(t *testing.T) {
+	tests := []struct {
+		name          string
+		payloadData   map[string]interface{}
+		expectedPatch string
+		alertType     action.AlertType
+		alertMessage  string
+	}{
+		{
+			name: "changed keys",
+			payloadData: map[string]interface{}{
+				ConfigMapDataField("a"): "1",
+				ConfigMapDataField("b"): "updated",
+				ConfigMapDataField("c"): "added",
+			},
+			expectedPatch: `{
+				"metadata": {
+					"annotations": {
+						"octant.dev/last-edited-keys": "b",
+						"octant.dev/last-edited-at": "2019-10-16T12:00:00Z"
+					}
+				},
+				"data": {"b": "updated"}
+			}`,
+			alertType:    action.AlertTypeInfo,
+			alertMessage: `Updated ConfigMap "configmap" keys: b`,
+		},
+		{
+			name: "unchanged",
+			payloadData: map[string]interface{}{
+				ConfigMapDataField("a"): "1",
+			},
+			alertType:    action.AlertTypeInfo,
+			alertMessage: `ConfigMap "configmap" was not changed`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			configMap := testutil.CreateConfigMap("configmap")
+			configMap.Data = map[string]string{"a": "1", "b": "2"}
+
+			key, err := store.KeyFromObject(configMap)
+			require.NoError(t, err)
+
+			objectStore := fake.NewMockStore(controller)
+			objectStore.EXPECT().
+				Get(gomock.Any(), key).
+				Return(testutil.ToUnstructured(t, configMap), true, nil)
+
+			if test.expectedPatch != "" {
+				objectStore.EXPECT().
+					Patch(gomock.Any(), key, types.StrategicMergePatchType, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, key store.Key, patchType types.PatchType, data []byte) error {
+						assert.JSONEq(t, test.expectedPatch, string(data))
+						return nil
+					})
+			}
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				DoAndReturn(func(alert action.Alert) {
+					assert.Equal(t, test.alertType, alert.Type)
+					assert.Equal(t, test.alertMessage, alert.Message)
+				})
+
+			editor := NewConfigMapDataEditor(objectStore)
+			editor.now = func() time.Time {
+				return time.Date(2019, 10, 16, 12, 0, 0, 0, time.UTC)
+			}
+			assert.Equal(t, ActionEditConfigMapData, editor.ActionName())
+
+			payload := action.Payload{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"namespace":  configMap.Namespace,
+				"name":       configMap.Name,
+			}
+			for k, v := range test.payloadData {
+				payload[k] = v
+			}
+
+			ctx := context.Background()
+			require.NoError(t, editor.Handle(ctx, alerter, payload))
+		})
+	}
+}
+
+func TestConfigMapDataEditor_not_found(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := fake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		Return(nil, false, nil)
+
+	editor := NewConfigMapDataEditor(objectStore)
+
+	payload := action.Payload{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"namespace":  "default",
+		"name":       "configmap",
+	}
+
+	ctx := context.Background()
+	err := editor.Handle(ctx, actionFake.NewMockAlerter(controller), payload)
+	require.Error(t, err)
+}
@@ -20,7 +20,8 @@ import (
 
 // ContainerEditor edits containers.
 type ContainerEditor struct {
-	store store.Store
+	store   store.Store
+	tracker *ScaleTracker
 }
 
 var _ action.Dispatcher = (*ContainerEditor)(nil)
@@ -28,7 +29,8 @@ var _ action.Dispatcher = (*ContainerEditor)(nil)
 // NewContainerEditor creates an instance of ContainerEditor.
 func NewContainerEditor(objectStore store.Store) *ContainerEditor {
 	editor := &ContainerEditor{
-		store: objectStore,
+		store:   objectStore,
+		tracker: NewScaleTracker(objectStore),
 	}
 
 	return editor
@@ -36,11 +38,13 @@ func NewContainerEditor(objectStore store.Store) *ContainerEditor {
 
 // ActionName returns name of this action.
 func (e *ContainerEditor) ActionName() string {
-	return "overview/containerEditor"
+	return ActionEditContainer
 }
 
 // Handle edits a container. Supported edits:
 //   * image
+// Progress of a deployment's rollout is sent to the client if it can
+// display it.
 func (e *ContainerEditor) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
 	logger := log.From(ctx).With("actionName", e.ActionName())
 	logger.With("payload", payload).Infof("received action payload")
@@ -79,6 +83,8 @@ func (e *ContainerEditor) Handle(ctx context.Context, alerter action.Alerter, pa
 		alertType = action.AlertTypeWarning
 		logger := log.From(ctx)
 		logger.WithErr(err).Errorf("update container")
+	} else {
+		trackScale(ctx, e.tracker, alerter, key)
 	}
 	alert := action.CreateAlert(alertType, message, action.DefaultAlertExpiration)
 
@@ -0,0 +1,209 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/store"
+)
+
+const (
+	// instantiateAnnotation marks jobs created from a cron job by hand. It
+	// is the same annotation kubectl create job --from sets.
+	instantiateAnnotation = "cronjob.kubernetes.io/instantiate"
+
+	// maxJobNameLength is the longest name a job can have, since its name
+	// is used as a pod label value.
+	maxJobNameLength = 63
+)
+
+// CronJobTrigger creates a job from a cron job's job template, so the cron
+// job runs immediately.
+type CronJobTrigger struct {
+	store store.Store
+	now   func() time.Time
+}
+
+var _ action.Dispatcher = (*CronJobTrigger)(nil)
+
+// NewCronJobTrigger creates an instance of CronJobTrigger.
+func NewCronJobTrigger(objectStore store.Store) *CronJobTrigger {
+	return &CronJobTrigger{
+		store: objectStore,
+		now:   time.Now,
+	}
+}
+
+// ActionName returns the name of this action.
+func (c *CronJobTrigger) ActionName() string {
+	return ActionTriggerCronJob
+}
+
+// Handle creates a job for the cron job in the payload.
+func (c *CronJobTrigger) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	log.From(ctx).With("actionName", c.ActionName()).Debugf("received action payload")
+
+	key, err := cronJobKey(payload)
+	if err != nil {
+		return err
+	}
+
+	job, err := c.createJob(ctx, key)
+	if err != nil {
+		message := fmt.Sprintf("Unable to run CronJob %q: %s", key.Name, err)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	message := fmt.Sprintf("Created Job %q from CronJob %q", job.GetName(), key.Name)
+	alerter.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
+
+	return nil
+}
+
+func (c *CronJobTrigger) createJob(ctx context.Context, key store.Key) (*unstructured.Unstructured, error) {
+	object, found, err := c.store.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errors.New("it was not found")
+	}
+
+	cronJob := &batchv1beta1.CronJob{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, cronJob); err != nil {
+		return nil, err
+	}
+
+	job, err := runtime.DefaultUnstructuredConverter.ToUnstructured(jobFromCronJob(cronJob, c.now()))
+	if err != nil {
+		return nil, err
+	}
+
+	u := &unstructured.Unstructured{Object: job}
+	if err := c.store.Create(ctx, u); err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// jobFromCronJob creates a job from a cron job's job template. The job is
+// owned by the cron job, so it is listed with the cron job's jobs.
+func jobFromCronJob(cronJob *batchv1beta1.CronJob, now time.Time) *batchv1.Job {
+	suffix := fmt.Sprintf("-manual-%d", now.Unix())
+	name := cronJob.Name
+	if len(name)+len(suffix) > maxJobNameLength {
+		name = name[:maxJobNameLength-len(suffix)]
+	}
+
+	annotations := map[string]string{instantiateAnnotation: "manual"}
+	for k, v := range cronJob.Spec.JobTemplate.Annotations {
+		annotations[k] = v
+	}
+
+	return &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{APIVersion: "batch/v1", Kind: "Job"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name + suffix,
+			Namespace:   cronJob.Namespace,
+			Labels:      cronJob.Spec.JobTemplate.Labels,
+			Annotations: annotations,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(cronJob, batchv1beta1.SchemeGroupVersion.WithKind("CronJob")),
+			},
+		},
+		Spec: cronJob.Spec.JobTemplate.Spec,
+	}
+}
+
+// CronJobSuspender suspends or resumes a cron job by setting spec.suspend.
+type CronJobSuspender struct {
+	store   store.Store
+	suspend bool
+}
+
+var _ action.Dispatcher = (*CronJobSuspender)(nil)
+
+// NewCronJobSuspender creates a CronJobSuspender which suspends cron jobs.
+func NewCronJobSuspender(objectStore store.Store) *CronJobSuspender {
+	return &CronJobSuspender{
+		store:   objectStore,
+		suspend: true,
+	}
+}
+
+// NewCronJobResumer creates a CronJobSuspender which resumes cron jobs.
+func NewCronJobResumer(objectStore store.Store) *CronJobSuspender {
+	return &CronJobSuspender{
+		store: objectStore,
+	}
+}
+
+// ActionName returns the name of this action.
+func (s *CronJobSuspender) ActionName() string {
+	if s.suspend {
+		return ActionSuspendCronJob
+	}
+
+	return ActionResumeCronJob
+}
+
+// Handle suspends or resumes the cron job in the payload.
+func (s *CronJobSuspender) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	log.From(ctx).With("actionName", s.ActionName()).Debugf("received action payload")
+
+	key, err := cronJobKey(payload)
+	if err != nil {
+		return err
+	}
+
+	fn := func(object *unstructured.Unstructured) error {
+		return unstructured.SetNestedField(object.Object, s.suspend, "spec", "suspend")
+	}
+
+	verb, past := "resume", "Resumed"
+	if s.suspend {
+		verb, past = "suspend", "Suspended"
+	}
+
+	alertType := action.AlertTypeInfo
+	message := fmt.Sprintf("%s CronJob %q", past, key.Name)
+	if err := s.store.Update(ctx, key, fn); err != nil {
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to %s CronJob %q: %s", verb, key.Name, err)
+	}
+
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+
+	return nil
+}
+
+// cronJobKey returns the key for the cron job in a payload.
+func cronJobKey(payload action.Payload) (store.Key, error) {
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return store.Key{}, err
+	}
+
+	if key.APIVersion != "batch/v1beta1" || key.Kind != "CronJob" {
+		return store.Key{}, errors.Errorf("%s %s is not a cron job", key.APIVersion, key.Kind)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,156 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/action"
+	actionFake "github.com/vmware/octant/pkg/action/fake"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/store/fake"
+)
+
+var cronJobStoreKey = store.Key{Namespace: "namespace", APIVersion: "batch/v1beta1", Kind: "CronJob", Name: "cronjob"}
+
+func cronJobPayload() action.Payload {
+	return action.Payload{
+		"apiVersion": "batch/v1beta1",
+		"kind":       "CronJob",
+		"namespace":  "namespace",
+		"name":       "cronjob",
+	}
+}
+
+func TestCronJobTrigger(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cronJob := testutil.CreateCronJob("cronjob")
+	cronJob.Spec.JobTemplate = batchv1beta1.JobTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{"app": "report"},
+		},
+	}
+	cronJob.Spec.JobTemplate.Spec.Template.Spec.Containers = []corev1.Container{{Name: "report", Image: "report:1"}}
+
+	objectStore := fake.NewMockStore(controller)
+	objectStore.EXPECT().Get(gomock.Any(), cronJobStoreKey).Return(testutil.ToUnstructured(t, cronJob), true, nil)
+
+	var created *unstructured.Unstructured
+	objectStore.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, object *unstructured.Unstructured) error {
+			created = object
+			return nil
+		})
+
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		DoAndReturn(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeInfo, alert.Type)
+			assert.Equal(t, `Created Job "cronjob-manual-1569931200" from CronJob "cronjob"`, alert.Message)
+		})
+
+	trigger := NewCronJobTrigger(objectStore)
+	trigger.now = func() time.Time {
+		return time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+	}
+	assert.Equal(t, ActionTriggerCronJob, trigger.ActionName())
+
+	require.NoError(t, trigger.Handle(context.Background(), alerter, cronJobPayload()))
+
+	require.NotNil(t, created)
+	assert.Equal(t, "batch/v1", created.GetAPIVersion())
+	assert.Equal(t, "Job", created.GetKind())
+	assert.Equal(t, "namespace", created.GetNamespace())
+	assert.Equal(t, map[string]string{"app": "report"}, created.GetLabels())
+	assert.Equal(t, "manual", created.GetAnnotations()[instantiateAnnotation])
+	require.Len(t, created.GetOwnerReferences(), 1)
+	assert.Equal(t, "CronJob", created.GetOwnerReferences()[0].Kind)
+
+	containers, _, err := unstructured.NestedSlice(created.Object, "spec", "template", "spec", "containers")
+	require.NoError(t, err)
+	assert.Len(t, containers, 1)
+}
+
+func Test_jobFromCronJob_longName(t *testing.T) {
+	cronJob := testutil.CreateCronJob(strings.Repeat("a", 60))
+
+	job := jobFromCronJob(cronJob, time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC))
+	assert.Len(t, job.Name, maxJobNameLength)
+	assert.True(t, strings.HasSuffix(job.Name, "-manual-1569931200"))
+}
+
+func TestCronJobSuspender(t *testing.T) {
+	tests := []struct {
+		name         string
+		suspender    func(objectStore store.Store) *CronJobSuspender
+		actionName   string
+		suspend      bool
+		alertMessage string
+	}{
+		{
+			name:         "suspend",
+			suspender:    NewCronJobSuspender,
+			actionName:   ActionSuspendCronJob,
+			suspend:      true,
+			alertMessage: `Suspended CronJob "cronjob"`,
+		},
+		{
+			name:         "resume",
+			suspender:    NewCronJobResumer,
+			actionName:   ActionResumeCronJob,
+			alertMessage: `Resumed CronJob "cronjob"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			cronJob := testutil.ToUnstructured(t, testutil.CreateCronJob("cronjob"))
+
+			objectStore := fake.NewMockStore(controller)
+			objectStore.EXPECT().
+				Update(gomock.Any(), cronJobStoreKey, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, key store.Key, fn func(object *unstructured.Unstructured) error) error {
+					return fn(cronJob)
+				})
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				DoAndReturn(func(alert action.Alert) {
+					assert.Equal(t, action.AlertTypeInfo, alert.Type)
+					assert.Equal(t, test.alertMessage, alert.Message)
+				})
+
+			suspender := test.suspender(objectStore)
+			assert.Equal(t, test.actionName, suspender.ActionName())
+
+			require.NoError(t, suspender.Handle(context.Background(), alerter, cronJobPayload()))
+
+			got, _, err := unstructured.NestedBool(cronJob.Object, "spec", "suspend")
+			require.NoError(t, err)
+			assert.Equal(t, test.suspend, got)
+		})
+	}
+}
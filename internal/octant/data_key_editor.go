@@ -0,0 +1,228 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+const (
+	// DataKeyField is the payload field for the data key to edit.
+	DataKeyField = "key"
+	// DataValueField is the payload field for the data key's new value.
+	DataValueField = "value"
+	// ResourceVersionField is the payload field for the resource version
+	// of the object when its form was created.
+	ResourceVersionField = "resourceVersion"
+)
+
+// ConflictError is returned when an object changed after the form which
+// edits it was created.
+type ConflictError struct {
+	Key store.Key
+	// Expected is the resource version the edit was based on.
+	Expected string
+	// Current is the object's resource version, if it is known.
+	Current string
+}
+
+func (e *ConflictError) Error() string {
+	message := fmt.Sprintf("%s %q was changed by someone else (expected resource version %s",
+		e.Key.Kind, e.Key.Name, e.Expected)
+	if e.Current != "" {
+		message += fmt.Sprintf(", found %s", e.Current)
+	}
+
+	return message + "). Reload it and try again"
+}
+
+// DataKeyValidation returns the rules for the data key field. Keys have
+// the same format for config maps and secrets.
+func DataKeyValidation() *component.FormFieldValidation {
+	return component.NewFormFieldValidation(
+		component.ValidateRequired(),
+		component.ValidatePattern(`[-._a-zA-Z0-9]+`),
+	)
+}
+
+// DataKeyEditor sets or removes a single data key of a config map or
+// secret. Edits are only applied if the object has the resource version
+// in the payload. Secret values are encoded here, so forms send plain
+// text.
+type DataKeyEditor struct {
+	store  store.Store
+	remove bool
+}
+
+var _ action.Dispatcher = (*DataKeyEditor)(nil)
+var _ action.PayloadValidator = (*DataKeyEditor)(nil)
+
+// NewDataKeySetter creates a DataKeyEditor which adds or updates data keys.
+func NewDataKeySetter(objectStore store.Store) *DataKeyEditor {
+	return &DataKeyEditor{
+		store: objectStore,
+	}
+}
+
+// NewDataKeyRemover creates a DataKeyEditor which removes data keys.
+func NewDataKeyRemover(objectStore store.Store) *DataKeyEditor {
+	return &DataKeyEditor{
+		store:  objectStore,
+		remove: true,
+	}
+}
+
+// ActionName returns the name of this action.
+func (e *DataKeyEditor) ActionName() string {
+	if e.remove {
+		return ActionRemoveDataKey
+	}
+
+	return ActionSetDataKey
+}
+
+// ValidatePayload checks the data key in a payload.
+func (e *DataKeyEditor) ValidatePayload(payload action.Payload) error {
+	if err := DataKeyValidation().Validate(payload[DataKeyField]); err != nil {
+		return errors.Wrap(err, "Key")
+	}
+
+	return nil
+}
+
+// Handle sets or removes the data key in the payload. If the object was
+// changed since the payload's resource version, the edit is not applied
+// and a *ConflictError is returned after the user is alerted.
+func (e *DataKeyEditor) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", e.ActionName())
+	logger.Debugf("received action payload")
+
+	key, err := dataObjectKey(payload)
+	if err != nil {
+		return err
+	}
+
+	dataKey, err := payload.String(DataKeyField)
+	if err != nil {
+		return err
+	}
+
+	resourceVersion, err := payload.String(ResourceVersionField)
+	if err != nil {
+		return err
+	}
+
+	var value string
+	if !e.remove {
+		if value, err = payload.OptionalString(DataValueField); err != nil {
+			return err
+		}
+	}
+
+	verb, past := "set", "Set"
+	if e.remove {
+		verb, past = "remove", "Removed"
+	}
+
+	err = e.edit(ctx, key, resourceVersion, dataKey, value)
+	if err == nil {
+		message := fmt.Sprintf("%s key %q in %s %q", past, dataKey, key.Kind, key.Name)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	message := fmt.Sprintf("Unable to %s key %q in %s %q: %s", verb, dataKey, key.Kind, key.Name, err)
+	alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+
+	if conflict, ok := err.(*ConflictError); ok {
+		return conflict
+	}
+
+	logger.WithErr(err).Errorf("%s data key", verb)
+	return nil
+}
+
+// edit patches a single data key. The patch includes the resource
+// version, so the API server rejects it if the object was changed after
+// the store's copy was read.
+func (e *DataKeyEditor) edit(ctx context.Context, key store.Key, resourceVersion, dataKey, value string) error {
+	object, found, err := e.store.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errors.New("it was not found")
+	}
+
+	if current := object.GetResourceVersion(); current != resourceVersion {
+		return &ConflictError{Key: key, Expected: resourceVersion, Current: current}
+	}
+
+	data, _, err := unstructured.NestedStringMap(object.Object, "data")
+	if err != nil {
+		return err
+	}
+
+	var patchValue interface{}
+	if e.remove {
+		if _, ok := data[dataKey]; !ok {
+			return errors.New("it does not exist")
+		}
+	} else {
+		patchValue = value
+		if key.Kind == "Secret" {
+			patchValue = base64.StdEncoding.EncodeToString([]byte(value))
+		}
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"resourceVersion": resourceVersion,
+		},
+		"data": map[string]interface{}{
+			dataKey: patchValue,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := e.store.Patch(ctx, key, types.MergePatchType, patch); err != nil {
+		if kerrors.IsConflict(err) {
+			return &ConflictError{Key: key, Expected: resourceVersion}
+		}
+		return err
+	}
+
+	return nil
+}
+
+// dataObjectKey returns the key for the config map or secret in a payload.
+func dataObjectKey(payload action.Payload) (store.Key, error) {
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return store.Key{}, err
+	}
+
+	if key.APIVersion != "v1" || (key.Kind != "ConfigMap" && key.Kind != "Secret") {
+		return store.Key{}, errors.Errorf("%s %s does not have data keys", key.APIVersion, key.Kind)
+	}
+
+	return key, nil
+}
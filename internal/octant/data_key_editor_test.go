@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/action"
+	actionFake "github.com/vmware/octant/pkg/action/fake"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/store/fake"
+)
+
+func TestDataKeyEditor(t *testing.T) {
+	configMap := testutil.CreateConfigMap("configmap")
+	configMap.ResourceVersion = "7"
+	configMap.Data = map[string]string{"a": "1"}
+
+	secret := testutil.CreateSecret("secret")
+	secret.ResourceVersion = "7"
+	secret.Data = map[string][]byte{"a": []byte("1")}
+
+	conflict := kerrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, "configmap", nil)
+
+	tests := []struct {
+		name            string
+		editor          func(objectStore store.Store) *DataKeyEditor
+		object          runtime.Object
+		dataKey         string
+		resourceVersion string
+		patchErr        error
+		expectedPatch   string
+		isConflict      bool
+		alertType       action.AlertType
+		alertMessage    string
+	}{
+		{
+			name:            "set config map key",
+			editor:          NewDataKeySetter,
+			object:          configMap,
+			dataKey:         "b",
+			resourceVersion: "7",
+			expectedPatch:   `{"metadata":{"resourceVersion":"7"},"data":{"b":"value"}}`,
+			alertType:       action.AlertTypeInfo,
+			alertMessage:    `Set key "b" in ConfigMap "configmap"`,
+		},
+		{
+			name:            "set secret key",
+			editor:          NewDataKeySetter,
+			object:          secret,
+			dataKey:         "a",
+			resourceVersion: "7",
+			expectedPatch:   `{"metadata":{"resourceVersion":"7"},"data":{"a":"dmFsdWU="}}`,
+			alertType:       action.AlertTypeInfo,
+			alertMessage:    `Set key "a" in Secret "secret"`,
+		},
+		{
+			name:            "remove key",
+			editor:          NewDataKeyRemover,
+			object:          configMap,
+			dataKey:         "a",
+			resourceVersion: "7",
+			expectedPatch:   `{"metadata":{"resourceVersion":"7"},"data":{"a":null}}`,
+			alertType:       action.AlertTypeInfo,
+			alertMessage:    `Removed key "a" in ConfigMap "configmap"`,
+		},
+		{
+			name:            "remove missing key",
+			editor:          NewDataKeyRemover,
+			object:          configMap,
+			dataKey:         "b",
+			resourceVersion: "7",
+			alertType:       action.AlertTypeWarning,
+			alertMessage:    `Unable to remove key "b" in ConfigMap "configmap": it does not exist`,
+		},
+		{
+			name:            "stale resource version",
+			editor:          NewDataKeySetter,
+			object:          configMap,
+			dataKey:         "a",
+			resourceVersion: "6",
+			isConflict:      true,
+			alertType:       action.AlertTypeWarning,
+			alertMessage: `Unable to set key "a" in ConfigMap "configmap": ` +
+				`ConfigMap "configmap" was changed by someone else (expected resource version 6, found 7). Reload it and try again`,
+		},
+		{
+			name:            "conflict from server",
+			editor:          NewDataKeySetter,
+			object:          configMap,
+			dataKey:         "a",
+			resourceVersion: "7",
+			patchErr:        conflict,
+			expectedPatch:   `{"metadata":{"resourceVersion":"7"},"data":{"a":"value"}}`,
+			isConflict:      true,
+			alertType:       action.AlertTypeWarning,
+			alertMessage: `Unable to set key "a" in ConfigMap "configmap": ` +
+				`ConfigMap "configmap" was changed by someone else (expected resource version 7). Reload it and try again`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			key, err := store.KeyFromObject(test.object)
+			require.NoError(t, err)
+
+			objectStore := fake.NewMockStore(controller)
+			objectStore.EXPECT().
+				Get(gomock.Any(), key).
+				Return(testutil.ToUnstructured(t, test.object), true, nil)
+
+			if test.expectedPatch != "" {
+				objectStore.EXPECT().
+					Patch(gomock.Any(), key, types.MergePatchType, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, key store.Key, patchType types.PatchType, data []byte) error {
+						assert.JSONEq(t, test.expectedPatch, string(data))
+						return test.patchErr
+					})
+			}
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				DoAndReturn(func(alert action.Alert) {
+					assert.Equal(t, test.alertType, alert.Type)
+					assert.Equal(t, test.alertMessage, alert.Message)
+				})
+
+			payload := key.ToActionPayload()
+			payload[DataKeyField] = test.dataKey
+			payload[DataValueField] = "value"
+			payload[ResourceVersionField] = test.resourceVersion
+
+			err = test.editor(objectStore).Handle(context.Background(), alerter, payload)
+			if test.isConflict {
+				conflictErr, ok := err.(*ConflictError)
+				require.True(t, ok, "expected a *ConflictError; got %v", err)
+				assert.Equal(t, key, conflictErr.Key)
+				assert.Equal(t, test.resourceVersion, conflictErr.Expected)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDataKeyEditor_ValidatePayload(t *testing.T) {
+	editor := NewDataKeySetter(nil)
+	assert.Equal(t, ActionSetDataKey, editor.ActionName())
+	assert.Equal(t, ActionRemoveDataKey, NewDataKeyRemover(nil).ActionName())
+
+	require.NoError(t, editor.ValidatePayload(action.Payload{DataKeyField: "app.properties"}))
+	require.EqualError(t, editor.ValidatePayload(action.Payload{DataKeyField: ""}), "Key: is required")
+	require.EqualError(t, editor.ValidatePayload(action.Payload{DataKeyField: "a/b"}),
+		`Key: "a/b" does not match [-._a-zA-Z0-9]+`)
+}
@@ -0,0 +1,37 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+// EditResult is the result of submitting edited code, e.g. applying an
+// edited manifest. It is shown with the code which was edited.
+type EditResult struct {
+	// ID identifies the edit. It is the edit ID of the code component.
+	ID string `json:"id"`
+	// Applied is true if the edit was applied.
+	Applied bool `json:"applied"`
+	// Message summarizes the result.
+	Message string `json:"message"`
+	// Causes are the reasons the edit was not applied.
+	Causes []EditCause `json:"causes,omitempty"`
+	// Conflict is true if the edit changes fields another manager owns.
+	// It can be resubmitted with force to take ownership of them.
+	Conflict bool `json:"conflict,omitempty"`
+}
+
+// EditCause is a reason an edit was not applied.
+type EditCause struct {
+	// Field is the path of the field the cause applies to. It is blank if
+	// the cause applies to the whole edit.
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// EditResultSender sends the result of an edit to the client which
+// submitted it. The alerter passed to an action handler implements it if
+// the client can display edit results.
+type EditResultSender interface {
+	SendEditResult(result EditResult)
+}
@@ -43,6 +43,18 @@ const (
 
 	// EventTypeAlert is an alert event.
 	EventTypeAlert EventType = "alert"
+
+	// EventTypeEventFilters is an event filters event.
+	EventTypeEventFilters EventType = "eventFilters"
+
+	// EventTypeContentError is a content error event.
+	EventTypeContentError EventType = "contentError"
+
+	// EventTypeOperationProgress is an operation progress event.
+	EventTypeOperationProgress EventType = "operationProgress"
+
+	// EventTypeEditResult is an edit result event.
+	EventTypeEditResult EventType = "editResult"
 )
 
 // Event is an event for the dash frontend.
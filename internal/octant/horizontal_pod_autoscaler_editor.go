@@ -0,0 +1,175 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+const (
+	// HPAMinReplicasField is the payload field for minimum replicas.
+	HPAMinReplicasField = "minReplicas"
+	// HPAMaxReplicasField is the payload field for maximum replicas.
+	HPAMaxReplicasField = "maxReplicas"
+	// HPATargetCPUField is the payload field for the target CPU
+	// utilization percentage.
+	HPATargetCPUField = "targetCPUUtilizationPercentage"
+)
+
+// HPAReplicasValidation returns the rules for the minimum and maximum
+// replicas fields of the horizontal pod autoscaler form.
+func HPAReplicasValidation() *component.FormFieldValidation {
+	return component.NewFormFieldValidation(
+		component.ValidateRequired(),
+		component.ValidateMin(1),
+	)
+}
+
+// HPATargetCPUValidation returns the rules for the target CPU utilization
+// field of the horizontal pod autoscaler form. The field is optional, since
+// the autoscaler uses a default policy when it is not set.
+func HPATargetCPUValidation() *component.FormFieldValidation {
+	return component.NewFormFieldValidation(
+		component.ValidateMin(1),
+	)
+}
+
+// HorizontalPodAutoscalerEditor edits the replica bounds and target CPU
+// utilization of a horizontal pod autoscaler.
+type HorizontalPodAutoscalerEditor struct {
+	store store.Store
+}
+
+var _ action.Dispatcher = (*HorizontalPodAutoscalerEditor)(nil)
+var _ action.PayloadValidator = (*HorizontalPodAutoscalerEditor)(nil)
+
+// NewHorizontalPodAutoscalerEditor creates an instance of HorizontalPodAutoscalerEditor.
+func NewHorizontalPodAutoscalerEditor(objectStore store.Store) *HorizontalPodAutoscalerEditor {
+	return &HorizontalPodAutoscalerEditor{
+		store: objectStore,
+	}
+}
+
+// ActionName returns the name of this action.
+func (e *HorizontalPodAutoscalerEditor) ActionName() string {
+	return ActionEditAutoscaler
+}
+
+// ValidatePayload checks a payload against the form's validation rules.
+// The maximum replicas can't be less than the minimum replicas.
+func (e *HorizontalPodAutoscalerEditor) ValidatePayload(payload action.Payload) error {
+	if err := HPAReplicasValidation().Validate(payload[HPAMinReplicasField]); err != nil {
+		return errors.Wrap(err, "Min Replicas")
+	}
+
+	if err := HPAReplicasValidation().Validate(payload[HPAMaxReplicasField]); err != nil {
+		return errors.Wrap(err, "Max Replicas")
+	}
+
+	if err := HPATargetCPUValidation().Validate(payload[HPATargetCPUField]); err != nil {
+		return errors.Wrap(err, "Target CPU Utilization")
+	}
+
+	minReplicas, err := payload.Float64(HPAMinReplicasField)
+	if err != nil {
+		return err
+	}
+
+	maxReplicas, err := payload.Float64(HPAMaxReplicasField)
+	if err != nil {
+		return err
+	}
+
+	if maxReplicas < minReplicas {
+		return errors.New("Max Replicas: must be at least Min Replicas")
+	}
+
+	return nil
+}
+
+// Handle updates the horizontal pod autoscaler in the payload. A blank
+// target CPU utilization removes it from the autoscaler.
+func (e *HorizontalPodAutoscalerEditor) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	log.From(ctx).With("actionName", e.ActionName()).Debugf("received action payload")
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	if key.APIVersion != "autoscaling/v1" || key.Kind != "HorizontalPodAutoscaler" {
+		return errors.Errorf("%s %s is not a horizontal pod autoscaler", key.APIVersion, key.Kind)
+	}
+
+	minReplicas, err := payload.Float64(HPAMinReplicasField)
+	if err != nil {
+		return err
+	}
+
+	maxReplicas, err := payload.Float64(HPAMaxReplicasField)
+	if err != nil {
+		return err
+	}
+
+	targetCPU, err := optionalInt(payload, HPATargetCPUField)
+	if err != nil {
+		return err
+	}
+
+	fn := func(object *unstructured.Unstructured) error {
+		if err := unstructured.SetNestedField(object.Object, roundToInt(minReplicas), "spec", "minReplicas"); err != nil {
+			return err
+		}
+
+		if err := unstructured.SetNestedField(object.Object, roundToInt(maxReplicas), "spec", "maxReplicas"); err != nil {
+			return err
+		}
+
+		if targetCPU == nil {
+			unstructured.RemoveNestedField(object.Object, "spec", "targetCPUUtilizationPercentage")
+			return nil
+		}
+
+		return unstructured.SetNestedField(object.Object, *targetCPU, "spec", "targetCPUUtilizationPercentage")
+	}
+
+	alertType := action.AlertTypeInfo
+	message := fmt.Sprintf("Updated HorizontalPodAutoscaler %q", key.Name)
+	if err := e.store.Update(ctx, key, fn); err != nil {
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to update HorizontalPodAutoscaler %q: %s", key.Name, err)
+	}
+
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+
+	return nil
+}
+
+// optionalInt returns an integer from the payload, or nil if the value is
+// missing or blank.
+func optionalInt(payload action.Payload, key string) (*int64, error) {
+	if s, ok := payload[key].(string); payload[key] == nil || (ok && strings.TrimSpace(s) == "") {
+		return nil, nil
+	}
+
+	f, err := payload.Float64(key)
+	if err != nil {
+		return nil, err
+	}
+
+	i := roundToInt(f)
+	return &i, nil
+}
@@ -0,0 +1,150 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/pointer"
+
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/action"
+	actionFake "github.com/vmware/octant/pkg/action/fake"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/store/fake"
+)
+
+func autoscalerPayload(minReplicas, maxReplicas, targetCPU interface{}) action.Payload {
+	return action.Payload{
+		"apiVersion":        "autoscaling/v1",
+		"kind":              "HorizontalPodAutoscaler",
+		"namespace":         "default",
+		"name":              "hpa",
+		HPAMinReplicasField: minReplicas,
+		HPAMaxReplicasField: maxReplicas,
+		HPATargetCPUField:   targetCPU,
+	}
+}
+
+func TestHorizontalPodAutoscalerEditor_ValidatePayload(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload action.Payload
+		wantErr string
+	}{
+		{
+			name:    "valid",
+			payload: autoscalerPayload("2", "10", "80"),
+		},
+		{
+			name:    "blank target",
+			payload: autoscalerPayload("2", "10", ""),
+		},
+		{
+			name:    "min replicas below 1",
+			payload: autoscalerPayload("0", "10", "80"),
+			wantErr: "Min Replicas: must be at least 1",
+		},
+		{
+			name:    "max replicas missing",
+			payload: autoscalerPayload("2", "", "80"),
+			wantErr: "Max Replicas: is required",
+		},
+		{
+			name:    "max replicas below min replicas",
+			payload: autoscalerPayload("5", "3", "80"),
+			wantErr: "Max Replicas: must be at least Min Replicas",
+		},
+		{
+			name:    "target below 1",
+			payload: autoscalerPayload("2", "10", "0"),
+			wantErr: "Target CPU Utilization: must be at least 1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			editor := NewHorizontalPodAutoscalerEditor(nil)
+
+			err := editor.ValidatePayload(test.payload)
+			if test.wantErr != "" {
+				require.EqualError(t, err, test.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestHorizontalPodAutoscalerEditor_Handle(t *testing.T) {
+	tests := []struct {
+		name      string
+		payload   action.Payload
+		targetCPU interface{}
+	}{
+		{
+			name:      "set target",
+			payload:   autoscalerPayload("3", float64(12), "60"),
+			targetCPU: int64(60),
+		},
+		{
+			name:    "remove target",
+			payload: autoscalerPayload("3", float64(12), ""),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			hpa := testutil.ToUnstructured(t, &autoscalingv1.HorizontalPodAutoscaler{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "autoscaling/v1", Kind: "HorizontalPodAutoscaler"},
+				ObjectMeta: metav1.ObjectMeta{Name: "hpa", Namespace: "default"},
+				Spec: autoscalingv1.HorizontalPodAutoscalerSpec{
+					MinReplicas:                    pointer.Int32Ptr(1),
+					MaxReplicas:                    5,
+					TargetCPUUtilizationPercentage: pointer.Int32Ptr(80),
+				},
+			})
+
+			key := store.Key{Namespace: "default", APIVersion: "autoscaling/v1", Kind: "HorizontalPodAutoscaler", Name: "hpa"}
+
+			objectStore := fake.NewMockStore(controller)
+			objectStore.EXPECT().
+				Update(gomock.Any(), key, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, key store.Key, fn func(object *unstructured.Unstructured) error) error {
+					return fn(hpa)
+				})
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				DoAndReturn(func(alert action.Alert) {
+					assert.Equal(t, action.AlertTypeInfo, alert.Type)
+					assert.Equal(t, `Updated HorizontalPodAutoscaler "hpa"`, alert.Message)
+				})
+
+			editor := NewHorizontalPodAutoscalerEditor(objectStore)
+			assert.Equal(t, ActionEditAutoscaler, editor.ActionName())
+
+			require.NoError(t, editor.Handle(context.Background(), alerter, test.payload))
+
+			spec, _, err := unstructured.NestedMap(hpa.Object, "spec")
+			require.NoError(t, err)
+			assert.Equal(t, int64(3), spec["minReplicas"])
+			assert.Equal(t, int64(12), spec["maxReplicas"])
+			assert.Equal(t, test.targetCPU, spec["targetCPUUtilizationPercentage"])
+		})
+	}
+}
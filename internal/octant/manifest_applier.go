@@ -0,0 +1,449 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sJSON "k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"k8s.io/apimachinery/pkg/util/wait"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/vmware/octant/internal/cluster"
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// ManifestApplier creates or updates the objects in a manifest using
+// server-side apply.
+type ManifestApplier struct {
+	store  store.Store
+	client cluster.ClientInterface
+
+	retryInterval time.Duration
+	retryTimeout  time.Duration
+}
+
+var _ action.Dispatcher = (*ManifestApplier)(nil)
+
+// NewManifestApplier creates an instance of ManifestApplier. Objects are
+// checked for access with the object store if it can check access.
+func NewManifestApplier(objectStore store.Store, client cluster.ClientInterface) *ManifestApplier {
+	return &ManifestApplier{
+		store:         objectStore,
+		client:        client,
+		retryInterval: time.Second,
+		retryTimeout:  30 * time.Second,
+	}
+}
+
+// ActionName returns the name of this action.
+func (a *ManifestApplier) ActionName() string {
+	return ActionApplyManifest
+}
+
+// Handle applies the objects in the payload's manifest. The manifest may
+// contain multiple YAML documents or JSON objects. If the payload has a
+// namespace, namespaced objects are created in it. Objects are applied in
+// dependency order: namespaces, then custom resource definitions, then
+// everything else. Every object is checked for access and validated with
+// a dry run before any is applied, except objects in a namespace or of a
+// custom resource which the manifest creates. If dryRun is set, the dry
+// run results are sent to the client instead of applying the objects.
+func (a *ManifestApplier) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", a.ActionName())
+	logger.Debugf("received action payload")
+
+	manifest, err := payload.String(ApplyManifestField)
+	if err != nil {
+		return err
+	}
+
+	namespace, err := payload.OptionalString("namespace")
+	if err != nil {
+		return err
+	}
+
+	dryRun, err := payload.Bool("dryRun")
+	if err != nil {
+		return err
+	}
+
+	var dependencies manifestDependencies
+	objects, err := decodeManifest(manifest)
+	if err == nil {
+		sortForApply(objects)
+		dependencies = newManifestDependencies(objects)
+		err = a.setNamespaces(objects, namespace, dependencies)
+	}
+	if err != nil {
+		message := fmt.Sprintf("Unable to apply manifest: %s", err)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	var names, failures []string
+	var previews []component.Component
+	checker, _ := a.store.(store.AccessChecker)
+	for _, object := range objects {
+		key, err := store.KeyFromObject(object)
+		if err != nil {
+			return err
+		}
+		if reason := AccessDeniedReason(ctx, checker, ActionApplyManifest, key); reason != "" {
+			failures = append(failures, fmt.Sprintf("%s: %s", objectName(object), reason))
+			continue
+		}
+
+		names = append(names, objectName(object))
+
+		// the namespace or custom resource definition the object needs
+		// doesn't exist until the manifest is applied.
+		if dependency := dependencies.dependency(object); dependency != "" {
+			previews = append(previews, objectPreview(object, fmt.Sprintf("%s (not validated until %s is applied)", objectName(object), dependency)))
+			continue
+		}
+
+		validated, err := a.apply(object, dependencies, true)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %s", objectName(object), applyErrorMessage(err)))
+			continue
+		}
+		if validated == nil {
+			validated = object
+		}
+		previews = append(previews, objectPreview(validated, objectName(object)))
+	}
+
+	if len(failures) > 0 {
+		message := fmt.Sprintf("Manifest is invalid: %s", strings.Join(failures, "; "))
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	if dryRun {
+		sender, ok := alerter.(ProgressSender)
+		if !ok {
+			message := fmt.Sprintf("Dry run would apply %s", strings.Join(names, ", "))
+			alerter.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
+			return nil
+		}
+
+		sender.SendProgress(OperationProgress{
+			ID:   fmt.Sprintf("manifest/%d", time.Now().UnixNano()),
+			Done: true,
+			View: component.NewList("Dry run of manifest", previews),
+		})
+		return nil
+	}
+
+	names = nil
+	for _, object := range objects {
+		if _, err := a.apply(object, dependencies, false); err != nil {
+			logger.WithErr(err).Errorf("apply object")
+			failures = append(failures, fmt.Sprintf("%s: %s", objectName(object), applyErrorMessage(err)))
+			continue
+		}
+		names = append(names, objectName(object))
+	}
+
+	alertType := action.AlertTypeInfo
+	message := fmt.Sprintf("Applied %s", strings.Join(names, ", "))
+	if len(failures) > 0 {
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to apply %s", strings.Join(failures, "; "))
+		if len(names) > 0 {
+			message = fmt.Sprintf("Applied %s. %s", strings.Join(names, ", "), message)
+		}
+	}
+
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+
+	return nil
+}
+
+// apply applies an object. Custom resources whose definition is in the
+// manifest are applied to the resource it defines, and are retried until
+// the API server serves it.
+func (a *ManifestApplier) apply(object *unstructured.Unstructured, dependencies manifestDependencies, dryRun bool) (*unstructured.Unstructured, error) {
+	gvr, ok := dependencies.resource(object)
+	if !ok {
+		return applyObject(a.client, object, applyOptions{dryRun: dryRun})
+	}
+
+	var applied *unstructured.Unstructured
+	var applyErr error
+	err := wait.PollImmediate(a.retryInterval, a.retryTimeout, func() (bool, error) {
+		applied, applyErr = applyResource(a.client, gvr, object, applyOptions{dryRun: dryRun})
+		if kerrors.IsNotFound(applyErr) {
+			return false, nil
+		}
+		return true, applyErr
+	})
+	if err == wait.ErrWaitTimeout {
+		return nil, applyErr
+	}
+
+	return applied, err
+}
+
+// setNamespaces sets the namespace of namespaced objects to namespace. If
+// namespace is blank, objects keep the namespace in the manifest, and
+// objects without one are created in the default namespace. Custom
+// resources whose definition is in the manifest are scoped by it.
+func (a *ManifestApplier) setNamespaces(objects []*unstructured.Unstructured, namespace string, dependencies manifestDependencies) error {
+	discoveryClient, err := a.client.DiscoveryClient()
+	if err != nil {
+		return err
+	}
+
+	namespaced := make(map[string]map[string]bool)
+
+	for _, object := range objects {
+		apiVersion := object.GetAPIVersion()
+
+		kinds, ok := namespaced[apiVersion]
+		if !ok {
+			kinds = make(map[string]bool)
+
+			resourceList, err := discoveryClient.ServerResourcesForGroupVersion(apiVersion)
+			if err != nil && !dependencies.definesGroupVersion(apiVersion) {
+				return errors.Wrapf(err, "find resources for %s", apiVersion)
+			}
+			if err == nil {
+				for _, resource := range resourceList.APIResources {
+					if !strings.Contains(resource.Name, "/") {
+						kinds[resource.Kind] = resource.Namespaced
+					}
+				}
+			}
+			namespaced[apiVersion] = kinds
+		}
+
+		isNamespaced, ok := kinds[object.GetKind()]
+		if !ok {
+			isNamespaced, ok = dependencies.namespaced(object)
+		}
+		if !ok {
+			return errors.Errorf("%s %s is not served by the cluster", apiVersion, object.GetKind())
+		}
+
+		switch {
+		case !isNamespaced:
+			object.SetNamespace("")
+		case namespace != "":
+			object.SetNamespace(namespace)
+		case object.GetNamespace() == "":
+			object.SetNamespace(a.client.DefaultNamespace())
+		}
+	}
+
+	return nil
+}
+
+// applyRank is the position of objects of a kind in the apply order.
+// Namespaces and custom resource definitions are applied first, since
+// other objects in the manifest may need them.
+func applyRank(object *unstructured.Unstructured) int {
+	switch object.GroupVersionKind().GroupKind() {
+	case schema.GroupKind{Kind: "Namespace"}:
+		return 0
+	case crdGroupKind:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// sortForApply sorts objects in the order they are applied. Objects of
+// the same rank keep their order in the manifest.
+func sortForApply(objects []*unstructured.Unstructured) {
+	sort.SliceStable(objects, func(i, j int) bool {
+		return applyRank(objects[i]) < applyRank(objects[j])
+	})
+}
+
+var crdGroupKind = schema.GroupKind{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}
+
+// definedResource is a resource defined by a custom resource definition.
+type definedResource struct {
+	definition string
+	plural     string
+	namespaced bool
+}
+
+// manifestDependencies are the namespaces and custom resources created by
+// a manifest, which other objects in it may depend on.
+type manifestDependencies struct {
+	namespaces map[string]bool
+	resources  map[schema.GroupKind]definedResource
+}
+
+func newManifestDependencies(objects []*unstructured.Unstructured) manifestDependencies {
+	dependencies := manifestDependencies{
+		namespaces: make(map[string]bool),
+		resources:  make(map[schema.GroupKind]definedResource),
+	}
+
+	for _, object := range objects {
+		switch object.GroupVersionKind().GroupKind() {
+		case schema.GroupKind{Kind: "Namespace"}:
+			dependencies.namespaces[object.GetName()] = true
+		case crdGroupKind:
+			group, _, _ := unstructured.NestedString(object.Object, "spec", "group")
+			kind, _, _ := unstructured.NestedString(object.Object, "spec", "names", "kind")
+			plural, _, _ := unstructured.NestedString(object.Object, "spec", "names", "plural")
+			scope, _, _ := unstructured.NestedString(object.Object, "spec", "scope")
+			if kind == "" || plural == "" {
+				continue
+			}
+
+			dependencies.resources[schema.GroupKind{Group: group, Kind: kind}] = definedResource{
+				definition: object.GetName(),
+				plural:     plural,
+				namespaced: scope != "Cluster",
+			}
+		}
+	}
+
+	return dependencies
+}
+
+// dependency describes the object in the manifest which object needs, or
+// returns a blank string if it needs none.
+func (d manifestDependencies) dependency(object *unstructured.Unstructured) string {
+	if resource, ok := d.resources[object.GroupVersionKind().GroupKind()]; ok {
+		return fmt.Sprintf("CustomResourceDefinition %q", resource.definition)
+	}
+
+	if namespace := object.GetNamespace(); namespace != "" && d.namespaces[namespace] {
+		return fmt.Sprintf("Namespace %q", namespace)
+	}
+
+	return ""
+}
+
+// resource returns the resource for a custom resource whose definition is
+// in the manifest.
+func (d manifestDependencies) resource(object *unstructured.Unstructured) (schema.GroupVersionResource, bool) {
+	gvk := object.GroupVersionKind()
+	resource, ok := d.resources[gvk.GroupKind()]
+	if !ok {
+		return schema.GroupVersionResource{}, false
+	}
+
+	return gvk.GroupVersion().WithResource(resource.plural), true
+}
+
+// namespaced returns true if object is a namespaced custom resource whose
+// definition is in the manifest.
+func (d manifestDependencies) namespaced(object *unstructured.Unstructured) (bool, bool) {
+	resource, ok := d.resources[object.GroupVersionKind().GroupKind()]
+	return resource.namespaced, ok
+}
+
+// definesGroupVersion returns true if a custom resource definition in the
+// manifest defines a kind in the group of apiVersion.
+func (d manifestDependencies) definesGroupVersion(apiVersion string) bool {
+	groupVersion, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return false
+	}
+
+	for groupKind := range d.resources {
+		if groupKind.Group == groupVersion.Group {
+			return true
+		}
+	}
+
+	return false
+}
+
+// objectPreview shows an object as YAML. Managed fields are left out since
+// they are noise in a preview.
+func objectPreview(object *unstructured.Unstructured, title string) component.Component {
+	object = object.DeepCopy()
+	object.SetManagedFields(nil)
+
+	var sb strings.Builder
+	serializer := k8sJSON.NewYAMLSerializer(k8sJSON.DefaultMetaFactory, nil, nil)
+	if err := serializer.Encode(object, &sb); err != nil {
+		return component.NewError(component.TitleFromString(title), err)
+	}
+
+	return component.NewCode(title, "yaml", sb.String())
+}
+
+// decodeManifest decodes the objects in a manifest. Lists are expanded
+// into their items.
+func decodeManifest(manifest string) ([]*unstructured.Unstructured, error) {
+	decoder := k8syaml.NewYAMLOrJSONDecoder(bytes.NewReader([]byte(manifest)), 4096)
+
+	var objects []*unstructured.Unstructured
+	for {
+		object := &unstructured.Unstructured{}
+		if err := decoder.Decode(&object.Object); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, errors.Wrap(err, "invalid manifest")
+		}
+
+		if len(object.Object) == 0 {
+			continue
+		}
+
+		if object.IsList() {
+			list, err := object.ToList()
+			if err != nil {
+				return nil, errors.Wrap(err, "invalid list")
+			}
+			for i := range list.Items {
+				objects = append(objects, &list.Items[i])
+			}
+			continue
+		}
+
+		objects = append(objects, object)
+	}
+
+	if len(objects) == 0 {
+		return nil, errors.New("manifest has no objects")
+	}
+
+	for _, object := range objects {
+		if object.GetAPIVersion() == "" || object.GetKind() == "" {
+			return nil, errors.New("objects must have an apiVersion and kind")
+		}
+		if object.GetName() == "" {
+			return nil, errors.Errorf("%s has no name", object.GetKind())
+		}
+	}
+
+	return objects, nil
+}
+
+// objectName returns an object's kind and name, including its namespace
+// if it has one.
+func objectName(object *unstructured.Unstructured) string {
+	if object.GetNamespace() == "" {
+		return fmt.Sprintf("%s %q", object.GetKind(), object.GetName())
+	}
+
+	return fmt.Sprintf("%s %q", object.GetKind(), object.GetNamespace()+"/"+object.GetName())
+}
@@ -0,0 +1,389 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	clusterFake "github.com/vmware/octant/internal/cluster/fake"
+	octantErrors "github.com/vmware/octant/internal/errors"
+	"github.com/vmware/octant/pkg/action"
+	actionFake "github.com/vmware/octant/pkg/action/fake"
+	"github.com/vmware/octant/pkg/store"
+	storeFake "github.com/vmware/octant/pkg/store/fake"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// accessCheckingStore is a store which denies the verbs in denied.
+type accessCheckingStore struct {
+	*storeFake.MockStore
+
+	denied map[string]bool
+}
+
+var _ store.AccessChecker = (*accessCheckingStore)(nil)
+
+func (s *accessCheckingStore) HasAccess(ctx context.Context, key store.Key, verb string) error {
+	if s.denied[verb] {
+		return octantErrors.NewForbidden("no %s access", verb)
+	}
+	return nil
+}
+
+func TestManifestApplier_access_denied(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	discoveryClient := clusterFake.NewMockDiscoveryInterface(controller)
+	discoveryClient.EXPECT().
+		ServerResourcesForGroupVersion("v1").
+		Return(&metav1.APIResourceList{
+			APIResources: []metav1.APIResource{{Name: "configmaps", Kind: "ConfigMap", Namespaced: true}},
+		}, nil)
+	clusterClient.EXPECT().DiscoveryClient().Return(discoveryClient, nil)
+
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		DoAndReturn(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeWarning, alert.Type)
+			assert.Equal(t, `Manifest is invalid: ConfigMap "default/configmap": Your credentials can't patch ConfigMap "configmap"`, alert.Message)
+		})
+
+	objectStore := &accessCheckingStore{MockStore: storeFake.NewMockStore(controller), denied: map[string]bool{"patch": true}}
+	applier := NewManifestApplier(objectStore, clusterClient)
+
+	payload := action.Payload{
+		ApplyManifestField: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: configmap\n",
+		"namespace":        "default",
+		"dryRun":           true,
+	}
+
+	require.NoError(t, applier.Handle(context.Background(), alerter, payload))
+}
+
+func TestManifestApplier(t *testing.T) {
+	manifest := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: configmap
+  namespace: manifest
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: namespace
+`
+
+	tests := []struct {
+		name         string
+		manifest     string
+		namespace    string
+		dryRun       bool
+		dryRunErr    error
+		applies      int
+		namespaces   []string
+		alertType    action.AlertType
+		alertMessage string
+	}{
+		{
+			name:         "applied with namespace override",
+			manifest:     manifest,
+			namespace:    "default",
+			applies:      4,
+			namespaces:   []string{"default"},
+			alertType:    action.AlertTypeInfo,
+			alertMessage: `Applied Namespace "namespace", ConfigMap "default/configmap"`,
+		},
+		{
+			name:         "dry run keeps manifest namespace",
+			manifest:     manifest,
+			dryRun:       true,
+			applies:      2,
+			namespaces:   []string{"manifest"},
+			alertType:    action.AlertTypeInfo,
+			alertMessage: `Dry run would apply Namespace "namespace", ConfigMap "manifest/configmap"`,
+		},
+		{
+			name:         "invalid",
+			manifest:     manifest,
+			dryRunErr:    errors.New("denied"),
+			applies:      2,
+			namespaces:   []string{"manifest"},
+			alertType:    action.AlertTypeWarning,
+			alertMessage: `Manifest is invalid: Namespace "namespace": denied; ConfigMap "manifest/configmap": denied`,
+		},
+		{
+			name:         "no objects",
+			manifest:     "---\n",
+			alertType:    action.AlertTypeWarning,
+			alertMessage: "Unable to apply manifest: manifest has no objects",
+		},
+		{
+			name:         "no name",
+			manifest:     "apiVersion: v1\nkind: ConfigMap\n",
+			alertType:    action.AlertTypeWarning,
+			alertMessage: "Unable to apply manifest: ConfigMap has no name",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			clusterClient := clusterFake.NewMockClientInterface(controller)
+
+			if test.applies > 0 {
+				discoveryClient := clusterFake.NewMockDiscoveryInterface(controller)
+				discoveryClient.EXPECT().
+					ServerResourcesForGroupVersion("v1").
+					Return(&metav1.APIResourceList{
+						GroupVersion: "v1",
+						APIResources: []metav1.APIResource{
+							{Name: "configmaps", Kind: "ConfigMap", Namespaced: true},
+							{Name: "namespaces", Kind: "Namespace"},
+							{Name: "namespaces/status", Kind: "Namespace", Namespaced: true},
+						},
+					}, nil)
+				clusterClient.EXPECT().DiscoveryClient().Return(discoveryClient, nil)
+
+				configMaps := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+				namespaces := schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+				clusterClient.EXPECT().Resource(schema.GroupKind{Kind: "ConfigMap"}).Return(configMaps, nil).AnyTimes()
+				clusterClient.EXPECT().Resource(schema.GroupKind{Kind: "Namespace"}).Return(namespaces, nil).AnyTimes()
+
+				patch := func(name string, pt types.PatchType, data []byte, options metav1.PatchOptions, _ ...string) (interface{}, error) {
+					assert.Equal(t, types.ApplyPatchType, pt)
+					if len(options.DryRun) > 0 {
+						return nil, test.dryRunErr
+					}
+					return nil, nil
+				}
+
+				configMapClient := clusterFake.NewMockResourceInterface(controller)
+				configMapClient.EXPECT().
+					Patch("configmap", types.ApplyPatchType, gomock.Any(), gomock.Any()).
+					DoAndReturn(patch).
+					Times(test.applies / 2)
+
+				namespaceableConfigMapClient := clusterFake.NewMockNamespaceableResourceInterface(controller)
+				for _, namespace := range test.namespaces {
+					namespaceableConfigMapClient.EXPECT().Namespace(namespace).Return(configMapClient).Times(test.applies / 2)
+				}
+
+				namespaceClient := clusterFake.NewMockNamespaceableResourceInterface(controller)
+				namespaceClient.EXPECT().
+					Patch("namespace", types.ApplyPatchType, gomock.Any(), gomock.Any()).
+					DoAndReturn(patch).
+					Times(test.applies / 2)
+
+				dynamicClient := clusterFake.NewMockDynamicInterface(controller)
+				dynamicClient.EXPECT().Resource(configMaps).Return(namespaceableConfigMapClient).AnyTimes()
+				dynamicClient.EXPECT().Resource(namespaces).Return(namespaceClient).AnyTimes()
+				clusterClient.EXPECT().DynamicClient().Return(dynamicClient, nil).Times(test.applies)
+			}
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				DoAndReturn(func(alert action.Alert) {
+					assert.Equal(t, test.alertType, alert.Type)
+					assert.Equal(t, test.alertMessage, alert.Message)
+				})
+
+			applier := NewManifestApplier(storeFake.NewMockStore(controller), clusterClient)
+			assert.Equal(t, ActionApplyManifest, applier.ActionName())
+
+			payload := action.Payload{
+				ApplyManifestField: test.manifest,
+				"namespace":        test.namespace,
+				"dryRun":           test.dryRun,
+			}
+
+			ctx := context.Background()
+			require.NoError(t, applier.Handle(ctx, alerter, payload))
+		})
+	}
+}
+
+// progressAlerter is an alerter which can display progress.
+type progressAlerter struct {
+	*actionFake.MockAlerter
+	fakeProgressSender
+}
+
+func TestManifestApplier_dependencies(t *testing.T) {
+	manifest := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: configmap
+  namespace: created
+---
+apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: widget
+---
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: widgets.example.com
+spec:
+  group: example.com
+  scope: Namespaced
+  names:
+    kind: Widget
+    plural: widgets
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: created
+`
+
+	tests := []struct {
+		name   string
+		dryRun bool
+	}{
+		{name: "dry run", dryRun: true},
+		{name: "apply"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			clusterClient := clusterFake.NewMockClientInterface(controller)
+			clusterClient.EXPECT().DefaultNamespace().Return("default").AnyTimes()
+
+			discoveryClient := clusterFake.NewMockDiscoveryInterface(controller)
+			discoveryClient.EXPECT().
+				ServerResourcesForGroupVersion("v1").
+				Return(&metav1.APIResourceList{
+					APIResources: []metav1.APIResource{
+						{Name: "configmaps", Kind: "ConfigMap", Namespaced: true},
+						{Name: "namespaces", Kind: "Namespace"},
+					},
+				}, nil)
+			discoveryClient.EXPECT().
+				ServerResourcesForGroupVersion("apiextensions.k8s.io/v1beta1").
+				Return(&metav1.APIResourceList{
+					APIResources: []metav1.APIResource{{Name: "customresourcedefinitions", Kind: "CustomResourceDefinition"}},
+				}, nil)
+			discoveryClient.EXPECT().
+				ServerResourcesForGroupVersion("example.com/v1").
+				Return(nil, errors.New("not found"))
+			clusterClient.EXPECT().DiscoveryClient().Return(discoveryClient, nil)
+
+			configMaps := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+			namespaces := schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+			crds := schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1beta1", Resource: "customresourcedefinitions"}
+			widgets := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+			clusterClient.EXPECT().Resource(schema.GroupKind{Kind: "ConfigMap"}).Return(configMaps, nil).AnyTimes()
+			clusterClient.EXPECT().Resource(schema.GroupKind{Kind: "Namespace"}).Return(namespaces, nil).AnyTimes()
+			clusterClient.EXPECT().Resource(crdGroupKind).Return(crds, nil).AnyTimes()
+
+			var applied []string
+			patch := func(name string) func(string, types.PatchType, []byte, metav1.PatchOptions, ...string) (*unstructured.Unstructured, error) {
+				return func(_ string, _ types.PatchType, data []byte, options metav1.PatchOptions, _ ...string) (*unstructured.Unstructured, error) {
+					if len(options.DryRun) == 0 {
+						applied = append(applied, name)
+					}
+					object := &unstructured.Unstructured{}
+					require.NoError(t, object.UnmarshalJSON(data))
+					return object, nil
+				}
+			}
+
+			dynamicClient := clusterFake.NewMockDynamicInterface(controller)
+			for _, resource := range []struct {
+				gvr       schema.GroupVersionResource
+				name      string
+				namespace string
+			}{
+				{gvr: configMaps, name: "configmap", namespace: "created"},
+				{gvr: namespaces, name: "created"},
+				{gvr: crds, name: "widgets.example.com"},
+				{gvr: widgets, name: "widget", namespace: "default"},
+			} {
+				resourceClient := clusterFake.NewMockResourceInterface(controller)
+				resourceClient.EXPECT().
+					Patch(resource.name, types.ApplyPatchType, gomock.Any(), gomock.Any()).
+					DoAndReturn(patch(resource.name)).
+					AnyTimes()
+
+				namespaceableClient := clusterFake.NewMockNamespaceableResourceInterface(controller)
+				namespaceableClient.EXPECT().
+					Patch(resource.name, types.ApplyPatchType, gomock.Any(), gomock.Any()).
+					DoAndReturn(patch(resource.name)).
+					AnyTimes()
+				namespaceableClient.EXPECT().Namespace(resource.namespace).Return(resourceClient).AnyTimes()
+
+				dynamicClient.EXPECT().Resource(resource.gvr).Return(namespaceableClient).AnyTimes()
+			}
+			clusterClient.EXPECT().DynamicClient().Return(dynamicClient, nil).AnyTimes()
+
+			alerter := &progressAlerter{MockAlerter: actionFake.NewMockAlerter(controller)}
+			if !test.dryRun {
+				alerter.MockAlerter.EXPECT().
+					SendAlert(gomock.Any()).
+					DoAndReturn(func(alert action.Alert) {
+						assert.Equal(t, action.AlertTypeInfo, alert.Type)
+					})
+			}
+
+			applier := NewManifestApplier(storeFake.NewMockStore(controller), clusterClient)
+
+			payload := action.Payload{
+				ApplyManifestField: manifest,
+				"dryRun":           test.dryRun,
+			}
+			require.NoError(t, applier.Handle(context.Background(), alerter, payload))
+
+			if !test.dryRun {
+				assert.Equal(t, []string{"created", "widgets.example.com", "configmap", "widget"}, applied)
+				assert.Empty(t, alerter.progress)
+				return
+			}
+
+			assert.Empty(t, applied)
+			require.Len(t, alerter.progress, 1)
+			list, ok := alerter.progress[0].View.(*component.List)
+			require.True(t, ok)
+
+			var titles []string
+			for _, item := range list.Config.Items {
+				code, ok := item.(*component.Code)
+				require.True(t, ok)
+				title, err := component.TitleFromTitleComponent(code.Title)
+				require.NoError(t, err)
+				titles = append(titles, title)
+				assert.Contains(t, code.Config.Value, "name:")
+			}
+			assert.Equal(t, []string{
+				`Namespace "created"`,
+				`CustomResourceDefinition "widgets.example.com"`,
+				`ConfigMap "created/configmap" (not validated until Namespace "created" is applied)`,
+				`Widget "default/widget" (not validated until CustomResourceDefinition "widgets.example.com" is applied)`,
+			}, titles)
+		})
+	}
+}
@@ -0,0 +1,443 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware/octant/internal/cluster"
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+const (
+	// defaultDrainTimeout is how long evictions are retried before a drain
+	// times out.
+	defaultDrainTimeout = 5 * time.Minute
+	// defaultDrainInterval is how often evictions blocked by a pod
+	// disruption budget or a transient error are retried.
+	defaultDrainInterval = 5 * time.Second
+
+	// mirrorPodAnnotation is set on static pods the kubelet mirrors to the
+	// API server. They can't be evicted.
+	mirrorPodAnnotation = "kubernetes.io/config.mirror"
+)
+
+// NodeCordoner cordons or uncordons a node by setting spec.unschedulable.
+type NodeCordoner struct {
+	store         store.Store
+	unschedulable bool
+}
+
+var _ action.Dispatcher = (*NodeCordoner)(nil)
+
+// NewNodeCordoner creates a NodeCordoner which cordons nodes.
+func NewNodeCordoner(objectStore store.Store) *NodeCordoner {
+	return &NodeCordoner{
+		store:         objectStore,
+		unschedulable: true,
+	}
+}
+
+// NewNodeUncordoner creates a NodeCordoner which uncordons nodes.
+func NewNodeUncordoner(objectStore store.Store) *NodeCordoner {
+	return &NodeCordoner{
+		store: objectStore,
+	}
+}
+
+// ActionName returns the name of this action.
+func (c *NodeCordoner) ActionName() string {
+	if c.unschedulable {
+		return ActionCordonNode
+	}
+
+	return ActionUncordonNode
+}
+
+// Handle cordons or uncordons the node in the payload.
+func (c *NodeCordoner) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	log.From(ctx).With("actionName", c.ActionName()).Debugf("received action payload")
+
+	key, err := nodeKey(payload)
+	if err != nil {
+		return err
+	}
+
+	verb, past := "uncordon", "Uncordoned"
+	if c.unschedulable {
+		verb, past = "cordon", "Cordoned"
+	}
+
+	alertType := action.AlertTypeInfo
+	message := fmt.Sprintf("%s Node %q", past, key.Name)
+	if err := setUnschedulable(ctx, c.store, key, c.unschedulable); err != nil {
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to %s Node %q: %s", verb, key.Name, err)
+	}
+
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+
+	return nil
+}
+
+// evictFunc evicts a pod.
+type evictFunc func(pod corev1.Pod) error
+
+// NodeDrainer cordons a node and evicts its pods. Evictions respect pod
+// disruption budgets, so evictions they block, and evictions which fail with
+// a transient error, are retried until the drain times out.
+type NodeDrainer struct {
+	store    store.Store
+	evict    evictFunc
+	timeout  time.Duration
+	interval time.Duration
+}
+
+var _ action.Dispatcher = (*NodeDrainer)(nil)
+
+// NewNodeDrainer creates an instance of NodeDrainer.
+func NewNodeDrainer(objectStore store.Store, client cluster.ClientInterface) *NodeDrainer {
+	return &NodeDrainer{
+		store: objectStore,
+		evict: func(pod corev1.Pod) error {
+			return evictPod(client, pod)
+		},
+		timeout:  defaultDrainTimeout,
+		interval: defaultDrainInterval,
+	}
+}
+
+// ActionName returns the name of this action.
+func (d *NodeDrainer) ActionName() string {
+	return ActionDrainNode
+}
+
+// Handle cordons the node in the payload and evicts its pods in the
+// background. Daemon set pods and mirror pods are not evicted, and the
+// drain is refused if a pod is not managed by a controller, since it would
+// not be recreated. Progress of the drain is sent to the client if it can
+// display it.
+func (d *NodeDrainer) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", d.ActionName())
+	logger.Debugf("received action payload")
+
+	key, err := nodeKey(payload)
+	if err != nil {
+		return err
+	}
+
+	// the node is only cordoned if its pods can be evicted too.
+	checker, _ := d.store.(store.AccessChecker)
+	if reason := AccessDeniedReason(ctx, checker, ActionDrainNode, key); reason != "" {
+		message := fmt.Sprintf("Unable to drain Node %q: %s", key.Name, reason)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	pods, err := d.drainablePods(ctx, key.Name)
+	if err != nil {
+		message := fmt.Sprintf("Unable to drain Node %q: %s", key.Name, err)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	if err := setUnschedulable(ctx, d.store, key, true); err != nil {
+		message := fmt.Sprintf("Unable to drain Node %q: %s", key.Name, err)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	message := fmt.Sprintf("Draining Node %q: evicting %d pods", key.Name, len(pods))
+	alerter.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
+
+	sender, ok := alerter.(ProgressSender)
+	if !ok {
+		sender = nopProgressSender{}
+	}
+
+	go func() {
+		if err := d.drain(ctx, sender, key.Name, pods); err != nil {
+			logger.WithErr(err).Errorf("drain node")
+		}
+	}()
+
+	return nil
+}
+
+// drainablePods returns the pods on a node which a drain evicts.
+func (d *NodeDrainer) drainablePods(ctx context.Context, nodeName string) ([]corev1.Pod, error) {
+	key := store.Key{
+		APIVersion:    "v1",
+		Kind:          "Pod",
+		FieldSelector: &fields.Set{"spec.nodeName": nodeName},
+	}
+
+	list, _, err := d.store.List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "list pods")
+	}
+
+	var pods []corev1.Pod
+	var unmanaged []string
+	for i := range list.Items {
+		pod := corev1.Pod{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(list.Items[i].Object, &pod); err != nil {
+			return nil, errors.Wrap(err, "convert pod")
+		}
+
+		if _, ok := pod.Annotations[mirrorPodAnnotation]; ok {
+			continue
+		}
+
+		controllerRef := metav1.GetControllerOf(&pod)
+		if controllerRef != nil && controllerRef.Kind == "DaemonSet" {
+			continue
+		}
+
+		finished := pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed
+		if controllerRef == nil && !finished {
+			unmanaged = append(unmanaged, podName(pod))
+			continue
+		}
+
+		pods = append(pods, pod)
+	}
+
+	if len(unmanaged) > 0 {
+		sort.Strings(unmanaged)
+		return nil, errors.Errorf("pods are not managed by a controller: %s", strings.Join(unmanaged, ", "))
+	}
+
+	return pods, nil
+}
+
+// drain evicts pods until every pod is evicted or failed, or the drain times
+// out.
+func (d *NodeDrainer) drain(ctx context.Context, sender ProgressSender, nodeName string, pods []corev1.Pod) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	id := fmt.Sprintf("Node/%s/drain/%d", nodeName, time.Now().UnixNano())
+	progress := newDrainProgress(nodeName, pods)
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		for _, pod := range pods {
+			if !progress.pending(pod) {
+				continue
+			}
+
+			err := d.evict(pod)
+			switch {
+			case err == nil, kerrors.IsNotFound(err):
+				progress.evicted(pod)
+			case isTransientEvictionError(err):
+				progress.blocked(pod, err)
+			default:
+				progress.failed(pod, err)
+			}
+		}
+
+		if progress.complete() {
+			if len(progress.failures) > 0 {
+				sender.SendProgress(OperationProgress{ID: id, Done: true, View: progress.view("Completed with failures")})
+				return errors.Errorf("unable to evict %d pods from node %s", len(progress.failures), nodeName)
+			}
+
+			sender.SendProgress(OperationProgress{ID: id, Done: true, View: progress.view("Drained")})
+			return nil
+		}
+
+		sender.SendProgress(OperationProgress{ID: id, View: progress.view("Evicting pods")})
+
+		select {
+		case <-ctx.Done():
+			sender.SendProgress(OperationProgress{ID: id, Done: true, View: progress.view("Timed out")})
+			return errors.Errorf("timed out draining node %s", nodeName)
+		case <-ticker.C:
+		}
+	}
+}
+
+// isTransientEvictionError returns true if an eviction may succeed when it
+// is retried. A pod disruption budget which does not allow a pod to be
+// evicted yet is reported as too many requests. Errors which are not API
+// errors, e.g. connection errors, are treated as transient.
+func isTransientEvictionError(err error) bool {
+	if _, ok := err.(kerrors.APIStatus); !ok {
+		return true
+	}
+
+	return kerrors.IsTooManyRequests(err) ||
+		kerrors.IsConflict(err) ||
+		kerrors.IsServerTimeout(err) ||
+		kerrors.IsTimeout(err) ||
+		kerrors.IsInternalError(err) ||
+		kerrors.IsServiceUnavailable(err) ||
+		kerrors.IsUnexpectedServerError(err)
+}
+
+// drainProgress is the progress of a node drain.
+type drainProgress struct {
+	nodeName string
+	total    int
+	// waiting are the reasons pods could not be evicted yet, by pod. They
+	// are retried.
+	waiting map[string]string
+	// failures are the reasons pods could not be evicted, by pod. They are
+	// not retried.
+	failures map[string]string
+	// done are the pods which were evicted or failed.
+	done map[string]bool
+}
+
+func newDrainProgress(nodeName string, pods []corev1.Pod) *drainProgress {
+	return &drainProgress{
+		nodeName: nodeName,
+		total:    len(pods),
+		waiting:  make(map[string]string),
+		failures: make(map[string]string),
+		done:     make(map[string]bool),
+	}
+}
+
+func (p *drainProgress) pending(pod corev1.Pod) bool {
+	return !p.done[podName(pod)]
+}
+
+func (p *drainProgress) evicted(pod corev1.Pod) {
+	name := podName(pod)
+	delete(p.waiting, name)
+	p.done[name] = true
+}
+
+func (p *drainProgress) blocked(pod corev1.Pod, err error) {
+	p.waiting[podName(pod)] = err.Error()
+}
+
+func (p *drainProgress) failed(pod corev1.Pod, err error) {
+	name := podName(pod)
+	delete(p.waiting, name)
+	p.failures[name] = err.Error()
+	p.done[name] = true
+}
+
+// complete returns true if there are no pods left to evict. Pods may have
+// failed to be evicted.
+func (p *drainProgress) complete() bool {
+	return len(p.done) == p.total
+}
+
+func (p *drainProgress) view(status string) component.Component {
+	evicted := len(p.done) - len(p.failures)
+
+	summary := component.NewSummary(fmt.Sprintf("Drain Node %s", p.nodeName),
+		component.SummarySection{
+			Header:  "Status",
+			Content: component.NewText(status),
+		},
+		component.SummarySection{
+			Header:  "Evicted",
+			Content: component.NewText(fmt.Sprintf("%d of %d", evicted, p.total)),
+		},
+	)
+
+	if len(p.waiting) > 0 {
+		summary.Add(component.SummarySection{
+			Header:  "Waiting",
+			Content: reasonList(p.waiting),
+		})
+	}
+
+	if len(p.failures) > 0 {
+		summary.Add(component.SummarySection{
+			Header:  "Failed",
+			Content: reasonList(p.failures),
+		})
+	}
+
+	return summary
+}
+
+func reasonList(reasons map[string]string) *component.List {
+	var names []string
+	for name := range reasons {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var items []component.Component
+	for _, name := range names {
+		items = append(items, component.NewText(fmt.Sprintf("%s: %s", name, reasons[name])))
+	}
+
+	return component.NewList("", items)
+}
+
+// nopProgressSender discards progress for clients which can't display it.
+type nopProgressSender struct{}
+
+func (nopProgressSender) SendProgress(OperationProgress) {}
+
+// evictPod evicts a pod using the eviction subresource, which respects pod
+// disruption budgets.
+func evictPod(client cluster.ClientInterface, pod corev1.Pod) error {
+	kubernetesClient, err := client.KubernetesClient()
+	if err != nil {
+		return err
+	}
+
+	eviction := &policyv1beta1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pod.Name,
+			Namespace: pod.Namespace,
+		},
+	}
+
+	return kubernetesClient.PolicyV1beta1().Evictions(pod.Namespace).Evict(eviction)
+}
+
+func setUnschedulable(ctx context.Context, objectStore store.Store, key store.Key, unschedulable bool) error {
+	return objectStore.Update(ctx, key, func(object *unstructured.Unstructured) error {
+		return unstructured.SetNestedField(object.Object, unschedulable, "spec", "unschedulable")
+	})
+}
+
+// nodeKey returns the key for the node in a payload.
+func nodeKey(payload action.Payload) (store.Key, error) {
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return store.Key{}, err
+	}
+
+	if key.APIVersion != "v1" || key.Kind != "Node" {
+		return store.Key{}, errors.Errorf("%s %s is not a node", key.APIVersion, key.Kind)
+	}
+
+	return key, nil
+}
+
+func podName(pod corev1.Pod) string {
+	return fmt.Sprintf("%s/%s", pod.Namespace, pod.Name)
+}
@@ -0,0 +1,322 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/pointer"
+
+	clusterFake "github.com/vmware/octant/internal/cluster/fake"
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/action"
+	actionFake "github.com/vmware/octant/pkg/action/fake"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/store/fake"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+var nodeStoreKey = store.Key{APIVersion: "v1", Kind: "Node", Name: "node"}
+
+func nodePayload() action.Payload {
+	return action.Payload{
+		"apiVersion": "v1",
+		"kind":       "Node",
+		"name":       "node",
+	}
+}
+
+func createNodePod(name, nodeName, controllerKind string) *corev1.Pod {
+	pod := testutil.CreatePod(name)
+	pod.Spec.NodeName = nodeName
+	if controllerKind != "" {
+		pod.OwnerReferences = []metav1.OwnerReference{
+			{Kind: controllerKind, Name: "owner", Controller: pointer.BoolPtr(true)},
+		}
+	}
+	return pod
+}
+
+func TestNodeCordoner(t *testing.T) {
+	tests := []struct {
+		name          string
+		cordoner      func(objectStore store.Store) *NodeCordoner
+		actionName    string
+		unschedulable bool
+		alertMessage  string
+	}{
+		{
+			name:          "cordon",
+			cordoner:      NewNodeCordoner,
+			actionName:    ActionCordonNode,
+			unschedulable: true,
+			alertMessage:  `Cordoned Node "node"`,
+		},
+		{
+			name:         "uncordon",
+			cordoner:     NewNodeUncordoner,
+			actionName:   ActionUncordonNode,
+			alertMessage: `Uncordoned Node "node"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			node := testutil.ToUnstructured(t, testutil.CreateNode("node"))
+
+			objectStore := fake.NewMockStore(controller)
+			objectStore.EXPECT().
+				Update(gomock.Any(), nodeStoreKey, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, key store.Key, fn func(object *unstructured.Unstructured) error) error {
+					return fn(node)
+				})
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				DoAndReturn(func(alert action.Alert) {
+					assert.Equal(t, action.AlertTypeInfo, alert.Type)
+					assert.Equal(t, test.alertMessage, alert.Message)
+				})
+
+			cordoner := test.cordoner(objectStore)
+			assert.Equal(t, test.actionName, cordoner.ActionName())
+
+			require.NoError(t, cordoner.Handle(context.Background(), alerter, nodePayload()))
+
+			got, _, err := unstructured.NestedBool(node.Object, "spec", "unschedulable")
+			require.NoError(t, err)
+			assert.Equal(t, test.unschedulable, got)
+		})
+	}
+}
+
+func TestNodeDrainer_Handle(t *testing.T) {
+	tests := []struct {
+		name         string
+		pods         []*corev1.Pod
+		cordons      bool
+		alertType    action.AlertType
+		alertMessage string
+	}{
+		{
+			name: "drain",
+			pods: []*corev1.Pod{
+				createNodePod("daemon", "node", "DaemonSet"),
+			},
+			cordons:      true,
+			alertType:    action.AlertTypeInfo,
+			alertMessage: `Draining Node "node": evicting 0 pods`,
+		},
+		{
+			name: "unmanaged pods",
+			pods: []*corev1.Pod{
+				createNodePod("unmanaged", "node", ""),
+			},
+			alertType:    action.AlertTypeWarning,
+			alertMessage: `Unable to drain Node "node": pods are not managed by a controller: namespace/unmanaged`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			list := &unstructured.UnstructuredList{}
+			for _, pod := range test.pods {
+				list.Items = append(list.Items, *testutil.ToUnstructured(t, pod))
+			}
+
+			podKey := store.Key{
+				APIVersion:    "v1",
+				Kind:          "Pod",
+				FieldSelector: &fields.Set{"spec.nodeName": "node"},
+			}
+
+			objectStore := fake.NewMockStore(controller)
+			objectStore.EXPECT().
+				List(gomock.Any(), podKey).
+				Return(list, false, nil)
+			if test.cordons {
+				objectStore.EXPECT().Update(gomock.Any(), nodeStoreKey, gomock.Any()).Return(nil)
+			}
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				DoAndReturn(func(alert action.Alert) {
+					assert.Equal(t, test.alertType, alert.Type)
+					assert.Equal(t, test.alertMessage, alert.Message)
+				})
+
+			drainer := NewNodeDrainer(objectStore, clusterFake.NewMockClientInterface(controller))
+			assert.Equal(t, ActionDrainNode, drainer.ActionName())
+
+			require.NoError(t, drainer.Handle(context.Background(), alerter, nodePayload()))
+		})
+	}
+}
+
+func TestNodeDrainer_drain(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	guarded := *createNodePod("guarded", "node", "ReplicaSet")
+	plain := *createNodePod("plain", "node", "ReplicaSet")
+
+	attempts := make(map[string]int)
+
+	drainer := NewNodeDrainer(fake.NewMockStore(controller), clusterFake.NewMockClientInterface(controller))
+	drainer.interval = time.Millisecond
+	drainer.evict = func(pod corev1.Pod) error {
+		attempts[pod.Name]++
+		if pod.Name == "guarded" && attempts[pod.Name] == 1 {
+			return kerrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 0)
+		}
+		return nil
+	}
+
+	sender := &fakeProgressSender{}
+	require.NoError(t, drainer.drain(context.Background(), sender, "node", []corev1.Pod{guarded, plain}))
+
+	assert.Equal(t, map[string]int{"guarded": 2, "plain": 1}, attempts)
+
+	require.Len(t, sender.progress, 2)
+	assert.False(t, sender.progress[0].Done)
+	assert.True(t, sender.progress[1].Done)
+
+	summary, ok := sender.progress[1].View.(*component.Summary)
+	require.True(t, ok)
+	assert.Equal(t, component.NewText("Drained"), summary.Config.Sections[0].Content)
+	assert.Equal(t, component.NewText("2 of 2"), summary.Config.Sections[1].Content)
+
+	waiting, ok := sender.progress[0].View.(*component.Summary)
+	require.True(t, ok)
+	require.Len(t, waiting.Config.Sections, 3)
+	assert.Equal(t, "Waiting", waiting.Config.Sections[2].Header)
+}
+
+func TestNodeDrainer_drain_failures(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	flaky := *createNodePod("flaky", "node", "ReplicaSet")
+	forbidden := *createNodePod("forbidden", "node", "ReplicaSet")
+
+	attempts := make(map[string]int)
+
+	drainer := NewNodeDrainer(fake.NewMockStore(controller), clusterFake.NewMockClientInterface(controller))
+	drainer.interval = time.Millisecond
+	drainer.evict = func(pod corev1.Pod) error {
+		attempts[pod.Name]++
+		switch {
+		case pod.Name == "forbidden":
+			return kerrors.NewForbidden(schema.GroupResource{Resource: "pods"}, pod.Name, errors.New("denied"))
+		case attempts[pod.Name] == 1:
+			return kerrors.NewServiceUnavailable("try again")
+		}
+		return nil
+	}
+
+	sender := &fakeProgressSender{}
+	require.Error(t, drainer.drain(context.Background(), sender, "node", []corev1.Pod{flaky, forbidden}))
+
+	assert.Equal(t, map[string]int{"flaky": 2, "forbidden": 1}, attempts)
+
+	require.Len(t, sender.progress, 2)
+	summary, ok := sender.progress[1].View.(*component.Summary)
+	require.True(t, ok)
+	assert.True(t, sender.progress[1].Done)
+	assert.Equal(t, component.NewText("Completed with failures"), summary.Config.Sections[0].Content)
+	assert.Equal(t, component.NewText("1 of 2"), summary.Config.Sections[1].Content)
+	assert.Equal(t, "Failed", summary.Config.Sections[2].Header)
+}
+
+func Test_isTransientEvictionError(t *testing.T) {
+	pods := schema.GroupResource{Resource: "pods"}
+
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "disruption budget",
+			err:      kerrors.NewTooManyRequests("budget", 0),
+			expected: true,
+		},
+		{
+			name:     "internal error",
+			err:      kerrors.NewInternalError(errors.New("etcd")),
+			expected: true,
+		},
+		{
+			name:     "connection error",
+			err:      errors.New("connection refused"),
+			expected: true,
+		},
+		{
+			name: "forbidden",
+			err:  kerrors.NewForbidden(pods, "pod", errors.New("denied")),
+		},
+		{
+			name: "bad request",
+			err:  kerrors.NewBadRequest("invalid"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, isTransientEvictionError(test.err))
+		})
+	}
+}
+
+func TestNodeDrainer_access_denied(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		DoAndReturn(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeWarning, alert.Type)
+			assert.Equal(t, `Unable to drain Node "node": Your credentials can't update Node "node"`, alert.Message)
+		})
+
+	objectStore := &accessCheckingStore{MockStore: fake.NewMockStore(controller), denied: map[string]bool{"update": true}}
+	drainer := NewNodeDrainer(objectStore, clusterFake.NewMockClientInterface(controller))
+
+	require.NoError(t, drainer.Handle(context.Background(), alerter, nodePayload()))
+}
+
+func TestNodeDrainer_invalidKind(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	drainer := NewNodeDrainer(fake.NewMockStore(controller), clusterFake.NewMockClientInterface(controller))
+
+	payload := nodePayload()
+	payload["kind"] = "Pod"
+
+	require.Error(t, drainer.Handle(context.Background(), actionFake.NewMockAlerter(controller), payload))
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/internal/notes"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/store"
+)
+
+// NotesEditor edits the notes for an object.
+type NotesEditor struct {
+	notes *notes.Notes
+}
+
+var _ action.Dispatcher = (*NotesEditor)(nil)
+
+// NewNotesEditor creates an instance of NotesEditor.
+func NewNotesEditor(storage notes.Storage, objectStore store.Store) *NotesEditor {
+	return &NotesEditor{
+		notes: notes.New(storage, objectStore),
+	}
+}
+
+// ActionName returns the name of this action.
+func (e *NotesEditor) ActionName() string {
+	return ActionEditNotes
+}
+
+// Handle saves the notes for an object.
+func (e *NotesEditor) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", e.ActionName())
+	logger.With("payload", payload).Debugf("received action payload")
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	text, err := payload.String("notes")
+	if err != nil {
+		return err
+	}
+
+	alertType := action.AlertTypeInfo
+	message := fmt.Sprintf("Saved notes for %s %q", key.Kind, key.Name)
+	if err := e.notes.Set(ctx, key, text); err != nil {
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to save notes for %s %q: %s", key.Kind, key.Name, err)
+		logger.WithErr(err).Errorf("save notes")
+	}
+
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+
+	return nil
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware/octant/internal/notes"
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/action"
+	actionFake "github.com/vmware/octant/pkg/action/fake"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/store/fake"
+)
+
+func TestNotesEditor(t *testing.T) {
+	tests := []struct {
+		name         string
+		patchErr     error
+		alertType    action.AlertType
+		alertMessage string
+	}{
+		{
+			name:         "saved",
+			alertType:    action.AlertTypeInfo,
+			alertMessage: `Saved notes for Deployment "deployment"`,
+		},
+		{
+			name:         "patch failed",
+			patchErr:     errors.New("forbidden"),
+			alertType:    action.AlertTypeWarning,
+			alertMessage: `Unable to save notes for Deployment "deployment": forbidden`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			key, err := store.KeyFromObject(testutil.CreateDeployment("deployment"))
+			require.NoError(t, err)
+
+			objectStore := fake.NewMockStore(controller)
+			objectStore.EXPECT().
+				Patch(gomock.Any(), key, types.MergePatchType,
+					[]byte(`{"metadata":{"annotations":{"octant.dev/notes":"notes"}}}`)).
+				Return(test.patchErr)
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				DoAndReturn(func(alert action.Alert) {
+					assert.Equal(t, test.alertType, alert.Type)
+					assert.Equal(t, test.alertMessage, alert.Message)
+				})
+
+			editor := NewNotesEditor(notes.StorageAnnotation, objectStore)
+			assert.Equal(t, ActionEditNotes, editor.ActionName())
+
+			payload := key.ToActionPayload()
+			payload["notes"] = "notes"
+
+			ctx := context.Background()
+			require.NoError(t, editor.Handle(ctx, alerter, payload))
+		})
+	}
+}
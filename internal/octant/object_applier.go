@@ -0,0 +1,261 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/vmware/octant/internal/cluster"
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/store"
+)
+
+const (
+	// ApplyFieldManager is the field manager for objects applied by Octant.
+	ApplyFieldManager = "octant"
+
+	// ApplyManifestField is the payload field containing the manifest.
+	ApplyManifestField = "manifest"
+	// ApplyForceField is the optional payload field which forces an apply
+	// to take ownership of fields other managers own.
+	ApplyForceField = "force"
+)
+
+// serverPopulatedFields are the fields of an object the API server sets.
+// They are not applied, so an edited manifest does not fail because the
+// object changed since it was viewed, or take ownership of its status.
+var serverPopulatedFields = [][]string{
+	{"status"},
+	{"metadata", "creationTimestamp"},
+	{"metadata", "deletionGracePeriodSeconds"},
+	{"metadata", "deletionTimestamp"},
+	{"metadata", "generation"},
+	{"metadata", "managedFields"},
+	{"metadata", "resourceVersion"},
+	{"metadata", "selfLink"},
+	{"metadata", "uid"},
+}
+
+// ObjectApplier applies edited manifests using server-side apply.
+type ObjectApplier struct {
+	client cluster.ClientInterface
+}
+
+var _ action.Dispatcher = (*ObjectApplier)(nil)
+
+// NewObjectApplier creates an instance of ObjectApplier.
+func NewObjectApplier(client cluster.ClientInterface) *ObjectApplier {
+	return &ObjectApplier{
+		client: client,
+	}
+}
+
+// ActionName returns the name of this action.
+func (a *ObjectApplier) ActionName() string {
+	return ActionApplyObject
+}
+
+// ApplyEditID returns the edit ID of the code an object's manifest is
+// edited in.
+func ApplyEditID(key store.Key) string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s", ActionApplyObject, key.APIVersion, key.Kind, key.Namespace, key.Name)
+}
+
+// Handle applies the manifest in the payload to the object in the payload.
+// The manifest is validated by a dry run against the API server before it
+// is applied. If the client can display edit results, errors are sent to
+// it field by field. Otherwise, they are sent as an alert.
+func (a *ObjectApplier) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", a.ActionName())
+	logger.Debugf("received action payload")
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	manifest, err := payload.String(ApplyManifestField)
+	if err != nil {
+		return err
+	}
+
+	var options applyOptions
+	if _, ok := payload[ApplyForceField]; ok {
+		if options.force, err = payload.Bool(ApplyForceField); err != nil {
+			return err
+		}
+	}
+
+	object, err := manifestForKey(manifest, key)
+	if err != nil {
+		sendApplyError(alerter, key, err)
+		return nil
+	}
+
+	options.dryRun = true
+	if _, err := applyObject(a.client, object, options); err != nil {
+		sendApplyError(alerter, key, err)
+		return nil
+	}
+
+	options.dryRun = false
+	if _, err := applyObject(a.client, object, options); err != nil {
+		logger.WithErr(err).Errorf("apply object")
+		sendApplyError(alerter, key, err)
+		return nil
+	}
+
+	message := fmt.Sprintf("Applied %s %q", key.Kind, key.Name)
+	if sender, ok := alerter.(EditResultSender); ok {
+		sender.SendEditResult(EditResult{ID: ApplyEditID(key), Applied: true, Message: message})
+	}
+	alerter.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
+
+	return nil
+}
+
+// sendApplyError sends the reasons an object could not be applied to the
+// client.
+func sendApplyError(alerter action.Alerter, key store.Key, err error) {
+	message := fmt.Sprintf("Unable to apply %s %q", key.Kind, key.Name)
+
+	sender, ok := alerter.(EditResultSender)
+	if !ok {
+		message = fmt.Sprintf("%s: %s", message, applyErrorMessage(err))
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+		return
+	}
+
+	sender.SendEditResult(EditResult{
+		ID:       ApplyEditID(key),
+		Message:  message,
+		Causes:   applyErrorCauses(err),
+		Conflict: kerrors.IsConflict(err),
+	})
+}
+
+// applyOptions configure how an object is applied.
+type applyOptions struct {
+	// dryRun validates the object without applying it.
+	dryRun bool
+	// force takes ownership of fields other managers own instead of
+	// failing with a conflict.
+	force bool
+}
+
+// applyObject applies an object using server-side apply and returns the
+// object the API server stores.
+func applyObject(client cluster.ClientInterface, object *unstructured.Unstructured, options applyOptions) (*unstructured.Unstructured, error) {
+	gvr, err := client.Resource(object.GroupVersionKind().GroupKind())
+	if err != nil {
+		return nil, err
+	}
+
+	return applyResource(client, gvr, object, options)
+}
+
+// applyResource applies an object to a resource using server-side apply.
+// Fields the API server populates are not applied.
+func applyResource(client cluster.ClientInterface, gvr schema.GroupVersionResource, object *unstructured.Unstructured, options applyOptions) (*unstructured.Unstructured, error) {
+	object = object.DeepCopy()
+	for _, fields := range serverPopulatedFields {
+		unstructured.RemoveNestedField(object.Object, fields...)
+	}
+
+	data, err := json.Marshal(object)
+	if err != nil {
+		return nil, errors.Wrap(err, "encode manifest")
+	}
+
+	dynamicClient, err := client.DynamicClient()
+	if err != nil {
+		return nil, err
+	}
+
+	patchOptions := metav1.PatchOptions{FieldManager: ApplyFieldManager}
+	if options.dryRun {
+		patchOptions.DryRun = []string{metav1.DryRunAll}
+	}
+	if options.force {
+		patchOptions.Force = &options.force
+	}
+
+	if object.GetNamespace() == "" {
+		return dynamicClient.Resource(gvr).Patch(object.GetName(), types.ApplyPatchType, data, patchOptions)
+	}
+
+	return dynamicClient.Resource(gvr).Namespace(object.GetNamespace()).Patch(object.GetName(), types.ApplyPatchType, data, patchOptions)
+}
+
+// manifestForKey decodes a manifest and verifies it describes the object
+// with key. The namespace defaults to the key's namespace.
+func manifestForKey(manifest string, key store.Key) (*unstructured.Unstructured, error) {
+	decoder := k8syaml.NewYAMLOrJSONDecoder(bytes.NewReader([]byte(manifest)), 4096)
+
+	object := &unstructured.Unstructured{}
+	if err := decoder.Decode(&object.Object); err != nil {
+		return nil, errors.Wrap(err, "invalid manifest")
+	}
+
+	if len(object.Object) == 0 {
+		return nil, errors.New("manifest is empty")
+	}
+
+	if object.GetNamespace() == "" {
+		object.SetNamespace(key.Namespace)
+	}
+
+	if object.GetAPIVersion() != key.APIVersion || object.GetKind() != key.Kind ||
+		object.GetName() != key.Name || object.GetNamespace() != key.Namespace {
+		return nil, errors.Errorf("manifest must describe %s %q", key.Kind, key.Name)
+	}
+
+	return object, nil
+}
+
+// applyErrorCauses returns the causes of an apply error. Invalid fields and
+// conflicting fields are causes of their own.
+func applyErrorCauses(err error) []EditCause {
+	statusErr, ok := err.(*kerrors.StatusError)
+	if !ok || statusErr.ErrStatus.Details == nil || len(statusErr.ErrStatus.Details.Causes) == 0 {
+		return []EditCause{{Message: err.Error()}}
+	}
+
+	var causes []EditCause
+	for _, cause := range statusErr.ErrStatus.Details.Causes {
+		causes = append(causes, EditCause{Field: cause.Field, Message: cause.Message})
+	}
+
+	return causes
+}
+
+// applyErrorMessage returns a message for an apply error. Invalid fields
+// are listed individually.
+func applyErrorMessage(err error) string {
+	var messages []string
+	for _, cause := range applyErrorCauses(err) {
+		if cause.Field == "" {
+			messages = append(messages, cause.Message)
+			continue
+		}
+		messages = append(messages, fmt.Sprintf("%s: %s", cause.Field, cause.Message))
+	}
+
+	return strings.Join(messages, "; ")
+}
@@ -0,0 +1,249 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	clusterFake "github.com/vmware/octant/internal/cluster/fake"
+	"github.com/vmware/octant/pkg/action"
+	actionFake "github.com/vmware/octant/pkg/action/fake"
+	"github.com/vmware/octant/pkg/store"
+)
+
+func TestObjectApplier(t *testing.T) {
+	manifest := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: configmap
+  resourceVersion: "12"
+  uid: 7d3b4b0c
+  creationTimestamp: "2019-10-01T00:00:00Z"
+  managedFields:
+  - manager: kubectl
+data:
+  a: "1"
+status:
+  phase: Active
+`
+
+	invalid := kerrors.NewInvalid(schema.GroupKind{Kind: "ConfigMap"}, "configmap", field.ErrorList{
+		field.Invalid(field.NewPath("data"), "x", "is invalid"),
+	})
+
+	tests := []struct {
+		name         string
+		manifest     string
+		dryRunErr    error
+		applyErr     error
+		applies      int
+		alertType    action.AlertType
+		alertMessage string
+	}{
+		{
+			name:         "applied",
+			manifest:     manifest,
+			applies:      2,
+			alertType:    action.AlertTypeInfo,
+			alertMessage: `Applied ConfigMap "configmap"`,
+		},
+		{
+			name:         "dry run fails",
+			manifest:     manifest,
+			dryRunErr:    invalid,
+			applies:      1,
+			alertType:    action.AlertTypeWarning,
+			alertMessage: `Unable to apply ConfigMap "configmap": data: Invalid value: "x": is invalid`,
+		},
+		{
+			name:         "different object",
+			manifest:     "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: other\n",
+			alertType:    action.AlertTypeWarning,
+			alertMessage: `Unable to apply ConfigMap "configmap": manifest must describe ConfigMap "configmap"`,
+		},
+		{
+			name:         "empty manifest",
+			manifest:     "{}",
+			alertType:    action.AlertTypeWarning,
+			alertMessage: `Unable to apply ConfigMap "configmap": manifest is empty`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			gvr := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+
+			resourceClient := clusterFake.NewMockResourceInterface(controller)
+			namespaceableClient := clusterFake.NewMockNamespaceableResourceInterface(controller)
+			dynamicClient := clusterFake.NewMockDynamicInterface(controller)
+			clusterClient := clusterFake.NewMockClientInterface(controller)
+
+			if test.applies > 0 {
+				clusterClient.EXPECT().Resource(schema.GroupKind{Kind: "ConfigMap"}).Return(gvr, nil).Times(test.applies)
+				clusterClient.EXPECT().DynamicClient().Return(dynamicClient, nil).Times(test.applies)
+				dynamicClient.EXPECT().Resource(gvr).Return(namespaceableClient).Times(test.applies)
+				namespaceableClient.EXPECT().Namespace("default").Return(resourceClient).Times(test.applies)
+
+				resourceClient.EXPECT().
+					Patch("configmap", types.ApplyPatchType, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(name string, pt types.PatchType, data []byte, options metav1.PatchOptions, _ ...string) (interface{}, error) {
+						assert.Equal(t, ApplyFieldManager, options.FieldManager)
+						assert.JSONEq(t, `{
+							"apiVersion": "v1",
+							"kind": "ConfigMap",
+							"metadata": {"name": "configmap", "namespace": "default"},
+							"data": {"a": "1"}
+						}`, string(data))
+
+						if len(options.DryRun) > 0 {
+							return nil, test.dryRunErr
+						}
+						return nil, test.applyErr
+					}).
+					Times(test.applies)
+			}
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				DoAndReturn(func(alert action.Alert) {
+					assert.Equal(t, test.alertType, alert.Type)
+					assert.Equal(t, test.alertMessage, alert.Message)
+				})
+
+			applier := NewObjectApplier(clusterClient)
+			assert.Equal(t, ActionApplyObject, applier.ActionName())
+
+			payload := action.Payload{
+				"apiVersion":       "v1",
+				"kind":             "ConfigMap",
+				"namespace":        "default",
+				"name":             "configmap",
+				ApplyManifestField: test.manifest,
+			}
+
+			ctx := context.Background()
+			require.NoError(t, applier.Handle(ctx, alerter, payload))
+		})
+	}
+}
+
+// editResultAlerter is an alerter for clients which display edit results.
+type editResultAlerter struct {
+	*actionFake.MockAlerter
+	results []EditResult
+}
+
+func (a *editResultAlerter) SendEditResult(result EditResult) {
+	a.results = append(a.results, result)
+}
+
+func TestObjectApplier_editResult(t *testing.T) {
+	conflict := &kerrors.StatusError{ErrStatus: metav1.Status{
+		Status: metav1.StatusFailure,
+		Code:   409,
+		Reason: metav1.StatusReasonConflict,
+		Details: &metav1.StatusDetails{
+			Causes: []metav1.StatusCause{
+				{Type: metav1.CauseTypeFieldManagerConflict, Field: ".data.a", Message: `conflict with "kubectl"`},
+			},
+		},
+	}}
+
+	tests := []struct {
+		name     string
+		force    bool
+		applyErr error
+		applies  int
+		expected EditResult
+	}{
+		{
+			name:     "conflict",
+			applyErr: conflict,
+			applies:  1,
+			expected: EditResult{
+				Message:  `Unable to apply ConfigMap "configmap"`,
+				Causes:   []EditCause{{Field: ".data.a", Message: `conflict with "kubectl"`}},
+				Conflict: true,
+			},
+		},
+		{
+			name:    "forced",
+			force:   true,
+			applies: 2,
+			expected: EditResult{
+				Applied: true,
+				Message: `Applied ConfigMap "configmap"`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			gvr := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+
+			resourceClient := clusterFake.NewMockResourceInterface(controller)
+			namespaceableClient := clusterFake.NewMockNamespaceableResourceInterface(controller)
+			dynamicClient := clusterFake.NewMockDynamicInterface(controller)
+			clusterClient := clusterFake.NewMockClientInterface(controller)
+
+			clusterClient.EXPECT().Resource(schema.GroupKind{Kind: "ConfigMap"}).Return(gvr, nil).Times(test.applies)
+			clusterClient.EXPECT().DynamicClient().Return(dynamicClient, nil).Times(test.applies)
+			dynamicClient.EXPECT().Resource(gvr).Return(namespaceableClient).Times(test.applies)
+			namespaceableClient.EXPECT().Namespace("default").Return(resourceClient).Times(test.applies)
+			resourceClient.EXPECT().
+				Patch("configmap", types.ApplyPatchType, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(name string, pt types.PatchType, data []byte, options metav1.PatchOptions, _ ...string) (interface{}, error) {
+					if test.force {
+						require.NotNil(t, options.Force)
+						assert.True(t, *options.Force)
+					} else {
+						assert.Nil(t, options.Force)
+					}
+					return nil, test.applyErr
+				}).
+				Times(test.applies)
+
+			alerter := &editResultAlerter{MockAlerter: actionFake.NewMockAlerter(controller)}
+			if test.expected.Applied {
+				alerter.EXPECT().SendAlert(gomock.Any())
+			}
+
+			payload := action.Payload{
+				"apiVersion":       "v1",
+				"kind":             "ConfigMap",
+				"namespace":        "default",
+				"name":             "configmap",
+				ApplyManifestField: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: configmap\ndata:\n  a: \"2\"\n",
+				ApplyForceField:    test.force,
+			}
+
+			applier := NewObjectApplier(clusterClient)
+			require.NoError(t, applier.Handle(context.Background(), alerter, payload))
+
+			key := store.Key{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "configmap"}
+			test.expected.ID = ApplyEditID(key)
+			assert.Equal(t, []EditResult{test.expected}, alerter.results)
+		})
+	}
+}
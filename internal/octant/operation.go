@@ -0,0 +1,29 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// OperationProgress is the progress of a long running operation which a
+// client started with an action, e.g. scaling a deployment.
+type OperationProgress struct {
+	// ID identifies the operation. Each update for an operation has the
+	// same ID.
+	ID string `json:"id"`
+	// Done is true when this is the last update for the operation.
+	Done bool `json:"done"`
+	// View describes the progress.
+	View component.Component `json:"view"`
+}
+
+// ProgressSender sends operation progress to the client which started the
+// operation. The alerter passed to an action handler implements it if the
+// client can display progress.
+type ProgressSender interface {
+	SendProgress(progress OperationProgress)
+}
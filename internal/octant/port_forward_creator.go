@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/internal/portforward"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/store"
+)
+
+// PortForwardCreator creates port forwards.
+type PortForwardCreator struct {
+	portForwarder portforward.PortForwarder
+}
+
+var _ action.Dispatcher = (*PortForwardCreator)(nil)
+
+// NewPortForwardCreator creates an instance of PortForwardCreator.
+func NewPortForwardCreator(portForwarder portforward.PortForwarder) *PortForwardCreator {
+	return &PortForwardCreator{
+		portForwarder: portForwarder,
+	}
+}
+
+// ActionName returns name of this action.
+func (c *PortForwardCreator) ActionName() string {
+	return ActionStartPortForward
+}
+
+// Handle starts a port forward to a port on an object.
+func (c *PortForwardCreator) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", c.ActionName())
+	logger.With("payload", payload).Infof("received action payload")
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	port, err := payload.Uint16("port")
+	if err != nil {
+		return err
+	}
+
+	gvk := schema.FromAPIVersionAndKind(key.APIVersion, key.Kind)
+
+	message := fmt.Sprintf("Forwarding port %d of %s %q", port, key.Kind, key.Name)
+	alertType := action.AlertTypeInfo
+
+	resp, err := c.portForwarder.Create(ctx, gvk, key.Name, key.Namespace, port)
+	if err != nil {
+		message = fmt.Sprintf("Unable to forward port %d of %s %q: %s", port, key.Kind, key.Name, err)
+		alertType = action.AlertTypeWarning
+		logger.WithErr(err).Errorf("create port forward")
+	} else if len(resp.Ports) > 0 {
+		message = fmt.Sprintf("%s to localhost:%d", message, resp.Ports[0].Local)
+	}
+
+	alert := action.CreateAlert(alertType, message, action.DefaultAlertExpiration)
+	alerter.SendAlert(alert)
+
+	return nil
+}
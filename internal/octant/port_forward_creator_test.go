@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware/octant/internal/portforward"
+	portForwardFake "github.com/vmware/octant/internal/portforward/fake"
+	"github.com/vmware/octant/pkg/action"
+	actionFake "github.com/vmware/octant/pkg/action/fake"
+)
+
+func TestPortForwardCreator(t *testing.T) {
+	gvk := schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
+
+	tests := []struct {
+		name            string
+		createErr       error
+		expectedType    action.AlertType
+		expectedMessage string
+	}{
+		{
+			name:            "in general",
+			expectedType:    action.AlertTypeInfo,
+			expectedMessage: `Forwarding port 8080 of Pod "pod" to localhost:50000`,
+		},
+		{
+			name:            "create failed",
+			createErr:       errors.New("failed"),
+			expectedType:    action.AlertTypeWarning,
+			expectedMessage: `Unable to forward port 8080 of Pod "pod": failed`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			portForwarder := portForwardFake.NewMockPortForwarder(controller)
+			resp := portforward.CreateResponse{
+				Ports: []portforward.PortForwardPortSpec{{Remote: 8080, Local: 50000}},
+			}
+			portForwarder.EXPECT().
+				Create(gomock.Any(), gvk, "pod", "default", uint16(8080)).
+				Return(resp, test.createErr)
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				DoAndReturn(func(alert action.Alert) {
+					assert.Equal(t, test.expectedType, alert.Type)
+					assert.Equal(t, test.expectedMessage, alert.Message)
+				})
+
+			creator := NewPortForwardCreator(portForwarder)
+
+			payload := action.CreatePayload(ActionStartPortForward, map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"namespace":  "default",
+				"name":       "pod",
+				"port":       float64(8080),
+			})
+
+			ctx := context.Background()
+			require.NoError(t, creator.Handle(ctx, alerter, payload))
+		})
+	}
+}
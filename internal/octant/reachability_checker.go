@@ -0,0 +1,242 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	extv1beta1 "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/store"
+)
+
+const (
+	reachabilityTimeout = 5 * time.Second
+)
+
+// reachabilityTarget is an external host and port for an object.
+type reachabilityTarget struct {
+	host string
+	port int32
+}
+
+func (t reachabilityTarget) String() string {
+	return net.JoinHostPort(t.host, strconv.Itoa(int(t.port)))
+}
+
+// probeFunc checks if a target can be reached.
+type probeFunc func(ctx context.Context, target reachabilityTarget) error
+
+// ReachabilityChecker checks if the external hosts of load balancer
+// services and ingresses can be reached from the octant host.
+type ReachabilityChecker struct {
+	store store.Store
+	probe probeFunc
+}
+
+var _ action.Dispatcher = (*ReachabilityChecker)(nil)
+
+// NewReachabilityChecker creates an instance of ReachabilityChecker.
+func NewReachabilityChecker(objectStore store.Store) *ReachabilityChecker {
+	return &ReachabilityChecker{
+		store: objectStore,
+		probe: probeTarget,
+	}
+}
+
+// ActionName returns the name of this action.
+func (c *ReachabilityChecker) ActionName() string {
+	return ActionCheckReachability
+}
+
+// Handle checks each external host of an object, and sends an alert with
+// the result for each host.
+func (c *ReachabilityChecker) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", c.ActionName())
+	logger.With("payload", payload).Debugf("received action payload")
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	object, found, err := c.store.Get(ctx, key)
+	if err != nil {
+		return errors.Wrapf(err, "get %s %q", key.Kind, key.Name)
+	}
+	if !found {
+		return errors.Errorf("%s %q not found", key.Kind, key.Name)
+	}
+
+	targets, err := reachabilityTargets(object)
+	if err != nil {
+		return err
+	}
+
+	if len(targets) == 0 {
+		message := fmt.Sprintf("%s %q does not have any external hosts", key.Kind, key.Name)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, reachabilityTimeout)
+	defer cancel()
+
+	results := make([]error, len(targets))
+
+	var wg sync.WaitGroup
+	for i := range targets {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = c.probe(ctx, targets[i])
+		}(i)
+	}
+	wg.Wait()
+
+	for i, target := range targets {
+		alertType := action.AlertTypeInfo
+		message := fmt.Sprintf("%s is reachable", target)
+		if err := results[i]; err != nil {
+			alertType = action.AlertTypeWarning
+			message = fmt.Sprintf("%s is not reachable: %s", target, err)
+		}
+
+		alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+	}
+
+	return nil
+}
+
+// reachabilityTargets returns the external hosts for a load balancer
+// service or an ingress.
+func reachabilityTargets(object *unstructured.Unstructured) ([]reachabilityTarget, error) {
+	switch object.GetKind() {
+	case "Service":
+		service := &corev1.Service{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, service); err != nil {
+			return nil, errors.Wrap(err, "convert object to service")
+		}
+		return serviceReachabilityTargets(service), nil
+	case "Ingress":
+		ingress := &extv1beta1.Ingress{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, ingress); err != nil {
+			return nil, errors.Wrap(err, "convert object to ingress")
+		}
+		return ingressReachabilityTargets(ingress), nil
+	default:
+		return nil, errors.Errorf("unable to check reachability for %s", object.GetKind())
+	}
+}
+
+func serviceReachabilityTargets(service *corev1.Service) []reachabilityTarget {
+	if service.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return nil
+	}
+
+	var targets []reachabilityTarget
+	for _, host := range loadBalancerHosts(service.Status.LoadBalancer) {
+		for _, port := range service.Spec.Ports {
+			if port.Protocol != "" && port.Protocol != corev1.ProtocolTCP {
+				continue
+			}
+			targets = append(targets, reachabilityTarget{host: host, port: port.Port})
+		}
+	}
+
+	return targets
+}
+
+func ingressReachabilityTargets(ingress *extv1beta1.Ingress) []reachabilityTarget {
+	// A TLS entry without hosts applies to every host served by the
+	// ingress controller.
+	tlsHosts := make(map[string]bool)
+	tlsAllHosts := false
+	for _, tls := range ingress.Spec.TLS {
+		if len(tls.Hosts) == 0 {
+			tlsAllHosts = true
+		}
+		for _, host := range tls.Hosts {
+			tlsHosts[host] = true
+		}
+	}
+	portFor := func(host string) int32 {
+		if tlsAllHosts || tlsHosts[host] {
+			return 443
+		}
+		return 80
+	}
+
+	var targets []reachabilityTarget
+	seen := make(map[reachabilityTarget]bool)
+	add := func(target reachabilityTarget) {
+		if !seen[target] {
+			seen[target] = true
+			targets = append(targets, target)
+		}
+	}
+
+	for _, rule := range ingress.Spec.Rules {
+		if rule.Host == "" {
+			continue
+		}
+
+		add(reachabilityTarget{host: rule.Host, port: portFor(rule.Host)})
+	}
+
+	// Load balancer addresses serve TLS when the ingress has any.
+	lbPort := int32(80)
+	if len(ingress.Spec.TLS) > 0 {
+		lbPort = 443
+	}
+	for _, host := range loadBalancerHosts(ingress.Status.LoadBalancer) {
+		add(reachabilityTarget{host: host, port: lbPort})
+	}
+
+	return targets
+}
+
+func loadBalancerHosts(status corev1.LoadBalancerStatus) []string {
+	var hosts []string
+	for _, ingress := range status.Ingress {
+		switch {
+		case ingress.Hostname != "":
+			hosts = append(hosts, ingress.Hostname)
+		case ingress.IP != "":
+			hosts = append(hosts, ingress.IP)
+		}
+	}
+
+	return hosts
+}
+
+// probeTarget resolves a target's host and opens a TCP connection to it.
+func probeTarget(ctx context.Context, target reachabilityTarget) error {
+	if net.ParseIP(target.host) == nil {
+		if _, err := net.DefaultResolver.LookupHost(ctx, target.host); err != nil {
+			return errors.Wrap(err, "resolve host")
+		}
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", target.String())
+	if err != nil {
+		return errors.Wrap(err, "connect")
+	}
+
+	return conn.Close()
+}
@@ -0,0 +1,161 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	extv1beta1 "k8s.io/api/extensions/v1beta1"
+
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/action"
+	actionFake "github.com/vmware/octant/pkg/action/fake"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/store/fake"
+)
+
+func TestReachabilityChecker(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	service := testutil.CreateService("service")
+	service.Spec.Type = corev1.ServiceTypeLoadBalancer
+	service.Spec.Ports = []corev1.ServicePort{{Port: 80, Protocol: corev1.ProtocolTCP}}
+	service.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{
+		{IP: "192.0.2.1"},
+		{Hostname: "lb.example.com"},
+	}
+
+	key, err := store.KeyFromObject(service)
+	require.NoError(t, err)
+
+	objectStore := fake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), key).
+		Return(testutil.ToUnstructured(t, service), true, nil)
+
+	var got []action.Alert
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		DoAndReturn(func(alert action.Alert) {
+			got = append(got, alert)
+		}).
+		Times(2)
+
+	checker := NewReachabilityChecker(objectStore)
+	checker.probe = func(ctx context.Context, target reachabilityTarget) error {
+		if target.host == "lb.example.com" {
+			return errors.New("resolve host: no such host")
+		}
+		return nil
+	}
+	assert.Equal(t, ActionCheckReachability, checker.ActionName())
+
+	ctx := context.Background()
+	require.NoError(t, checker.Handle(ctx, alerter, key.ToActionPayload()))
+
+	require.Len(t, got, 2)
+	assert.Equal(t, action.AlertTypeInfo, got[0].Type)
+	assert.Equal(t, "192.0.2.1:80 is reachable", got[0].Message)
+	assert.Equal(t, action.AlertTypeWarning, got[1].Type)
+	assert.Equal(t, "lb.example.com:80 is not reachable: resolve host: no such host", got[1].Message)
+}
+
+func TestReachabilityChecker_no_hosts(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	service := testutil.CreateService("service")
+
+	key, err := store.KeyFromObject(service)
+	require.NoError(t, err)
+
+	objectStore := fake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), key).
+		Return(testutil.ToUnstructured(t, service), true, nil)
+
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		DoAndReturn(func(alert action.Alert) {
+			assert.Equal(t, `Service "service" does not have any external hosts`, alert.Message)
+		})
+
+	checker := NewReachabilityChecker(objectStore)
+
+	ctx := context.Background()
+	require.NoError(t, checker.Handle(ctx, alerter, key.ToActionPayload()))
+}
+
+func Test_ingressReachabilityTargets(t *testing.T) {
+	tests := []struct {
+		name     string
+		tls      []extv1beta1.IngressTLS
+		expected []reachabilityTarget
+	}{
+		{
+			name: "no tls",
+			expected: []reachabilityTarget{
+				{host: "www.example.com", port: 80},
+				{host: "secure.example.com", port: 80},
+				{host: "192.0.2.1", port: 80},
+			},
+		},
+		{
+			name: "tls for host",
+			tls:  []extv1beta1.IngressTLS{{Hosts: []string{"secure.example.com"}}},
+			expected: []reachabilityTarget{
+				{host: "www.example.com", port: 80},
+				{host: "secure.example.com", port: 443},
+				{host: "192.0.2.1", port: 443},
+			},
+		},
+		{
+			name: "tls for all hosts",
+			tls:  []extv1beta1.IngressTLS{{SecretName: "secret"}},
+			expected: []reachabilityTarget{
+				{host: "www.example.com", port: 443},
+				{host: "secure.example.com", port: 443},
+				{host: "192.0.2.1", port: 443},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ingress := testutil.CreateIngress("ingress")
+			ingress.Spec.TLS = test.tls
+			ingress.Spec.Rules = []extv1beta1.IngressRule{
+				{Host: "www.example.com"},
+				{Host: "secure.example.com"},
+				{},
+			}
+			ingress.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{IP: "192.0.2.1"}}
+
+			assert.Equal(t, test.expected, ingressReachabilityTargets(ingress))
+		})
+	}
+}
+
+func Test_probeTarget(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	port := listener.Addr().(*net.TCPAddr).Port
+
+	ctx := context.Background()
+	require.NoError(t, probeTarget(ctx, reachabilityTarget{host: "127.0.0.1", port: int32(port)}))
+}
@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/internal/revision"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/store"
+)
+
+const (
+	// RollbackRevisionField is the payload field containing the revision
+	// to roll back to.
+	RollbackRevisionField = "revision"
+)
+
+// Rollback rolls deployments and daemon sets back to an earlier revision
+// by applying the revision's pod template to the workload.
+type Rollback struct {
+	store   store.Store
+	tracker *ScaleTracker
+}
+
+var _ action.Dispatcher = (*Rollback)(nil)
+
+// NewRollback creates an instance of Rollback.
+func NewRollback(objectStore store.Store) *Rollback {
+	return &Rollback{
+		store:   objectStore,
+		tracker: NewScaleTracker(objectStore),
+	}
+}
+
+// ActionName returns the name of this action.
+func (r *Rollback) ActionName() string {
+	return ActionRollback
+}
+
+// Handle rolls the workload in the payload back to the revision in the
+// payload. Progress of deployment rollbacks is sent to the client if it
+// can display it.
+func (r *Rollback) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	log.From(ctx).With("actionName", r.ActionName()).Debugf("received action payload")
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	value, err := payload.String(RollbackRevisionField)
+	if err != nil {
+		return err
+	}
+
+	number, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "invalid revision %q", value)
+	}
+
+	template, err := r.template(ctx, key, number)
+	if err != nil {
+		message := fmt.Sprintf("Unable to roll back %s %q: %s", key.Kind, key.Name, err)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	fn := func(object *unstructured.Unstructured) error {
+		return unstructured.SetNestedField(object.Object, template, "spec", "template")
+	}
+
+	alertType := action.AlertTypeInfo
+	message := fmt.Sprintf("Rolled back %s %q to revision %d", key.Kind, key.Name, number)
+	if err := r.store.Update(ctx, key, fn); err != nil {
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to roll back %s %q: %s", key.Kind, key.Name, err)
+	} else {
+		trackScale(ctx, r.tracker, alerter, key)
+	}
+
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+
+	return nil
+}
+
+// template returns the pod template of a workload's revision.
+func (r *Rollback) template(ctx context.Context, key store.Key, number int64) (map[string]interface{}, error) {
+	var object runtime.Object
+	switch {
+	case key.APIVersion == "apps/v1" && key.Kind == "Deployment":
+		object = &appsv1.Deployment{}
+	case key.APIVersion == "apps/v1" && key.Kind == "DaemonSet":
+		object = &appsv1.DaemonSet{}
+	default:
+		return nil, errors.Errorf("%s %s can't be rolled back", key.APIVersion, key.Kind)
+	}
+
+	u, found, err := r.store.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errors.New("it was not found")
+	}
+
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, object); err != nil {
+		return nil, err
+	}
+
+	revisions, err := revision.List(ctx, r.store, object)
+	if err != nil {
+		return nil, err
+	}
+
+	if number == revision.Current(object, revisions) {
+		return nil, errors.Errorf("revision %d is the current revision", number)
+	}
+
+	selected, ok := revision.Find(revisions, number)
+	if !ok {
+		return nil, errors.Errorf("revision %d was not found", number)
+	}
+
+	return runtime.DefaultUnstructuredConverter.ToUnstructured(&selected.Template)
+}
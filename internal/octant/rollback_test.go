@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/action"
+	actionFake "github.com/vmware/octant/pkg/action/fake"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/store/fake"
+)
+
+func TestRollback(t *testing.T) {
+	tests := []struct {
+		name         string
+		revision     string
+		updates      bool
+		alertType    action.AlertType
+		alertMessage string
+	}{
+		{
+			name:         "earlier revision",
+			revision:     "1",
+			updates:      true,
+			alertType:    action.AlertTypeInfo,
+			alertMessage: `Rolled back Deployment "deployment" to revision 1`,
+		},
+		{
+			name:         "current revision",
+			revision:     "2",
+			alertType:    action.AlertTypeWarning,
+			alertMessage: `Unable to roll back Deployment "deployment": revision 2 is the current revision`,
+		},
+		{
+			name:         "missing revision",
+			revision:     "5",
+			alertType:    action.AlertTypeWarning,
+			alertMessage: `Unable to roll back Deployment "deployment": revision 5 was not found`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			deployment := testutil.CreateDeployment("deployment")
+			deployment.Namespace = "default"
+			deployment.Annotations = map[string]string{"deployment.kubernetes.io/revision": "2"}
+
+			replicaSet := testutil.CreateAppReplicaSet("rs-1")
+			replicaSet.Namespace = "default"
+			replicaSet.Annotations = map[string]string{"deployment.kubernetes.io/revision": "1"}
+			replicaSet.OwnerReferences = []metav1.OwnerReference{
+				*metav1.NewControllerRef(deployment, deployment.GroupVersionKind()),
+			}
+			replicaSet.Spec.Template.Spec.Containers = []corev1.Container{{Name: "app", Image: "app:1"}}
+
+			key := store.Key{Namespace: "default", APIVersion: "apps/v1", Kind: "Deployment", Name: "deployment"}
+			object := testutil.ToUnstructured(t, deployment)
+
+			objectStore := fake.NewMockStore(controller)
+			objectStore.EXPECT().Get(gomock.Any(), key).Return(object, true, nil)
+			objectStore.EXPECT().
+				List(gomock.Any(), store.Key{Namespace: "default", APIVersion: "apps/v1", Kind: "ReplicaSet"}).
+				Return(testutil.ToUnstructuredList(t, replicaSet), false, nil)
+
+			if test.updates {
+				objectStore.EXPECT().
+					Update(gomock.Any(), key, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, key store.Key, fn func(object *unstructured.Unstructured) error) error {
+						return fn(object)
+					})
+			}
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				DoAndReturn(func(alert action.Alert) {
+					assert.Equal(t, test.alertType, alert.Type)
+					assert.Equal(t, test.alertMessage, alert.Message)
+				})
+
+			rollback := NewRollback(objectStore)
+			assert.Equal(t, ActionRollback, rollback.ActionName())
+
+			payload := action.Payload{
+				"apiVersion":          "apps/v1",
+				"kind":                "Deployment",
+				"namespace":           "default",
+				"name":                "deployment",
+				RollbackRevisionField: test.revision,
+			}
+
+			require.NoError(t, rollback.Handle(context.Background(), alerter, payload))
+
+			if test.updates {
+				containers, _, err := unstructured.NestedSlice(object.Object, "spec", "template", "spec", "containers")
+				require.NoError(t, err)
+				require.Len(t, containers, 1)
+				assert.Equal(t, "app:1", containers[0].(map[string]interface{})["image"])
+			}
+		})
+	}
+}
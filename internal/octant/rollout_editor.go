@@ -0,0 +1,162 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/store"
+)
+
+const (
+	// RestartedAtAnnotation is the pod template annotation which is set to
+	// restart a rollout. It is the same annotation kubectl rollout restart sets.
+	RestartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+)
+
+// RolloutRestarter restarts the rollout of a deployment by setting the
+// restartedAt annotation on its pod template.
+type RolloutRestarter struct {
+	store   store.Store
+	tracker *ScaleTracker
+	now     func() time.Time
+}
+
+var _ action.Dispatcher = (*RolloutRestarter)(nil)
+
+// NewRolloutRestarter creates an instance of RolloutRestarter.
+func NewRolloutRestarter(objectStore store.Store) *RolloutRestarter {
+	return &RolloutRestarter{
+		store:   objectStore,
+		tracker: NewScaleTracker(objectStore),
+		now:     time.Now,
+	}
+}
+
+// ActionName returns the name of this action.
+func (r *RolloutRestarter) ActionName() string {
+	return ActionRestartRollout
+}
+
+// Handle restarts the rollout of the deployment in the payload. Progress of
+// the rollout is sent to the client if it can display it.
+func (r *RolloutRestarter) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	log.From(ctx).With("actionName", r.ActionName()).Debugf("received action payload")
+
+	key, err := rolloutKey(payload)
+	if err != nil {
+		return err
+	}
+
+	restartedAt := r.now().Format(time.RFC3339)
+	fn := func(object *unstructured.Unstructured) error {
+		return unstructured.SetNestedField(object.Object, restartedAt,
+			"spec", "template", "metadata", "annotations", RestartedAtAnnotation)
+	}
+
+	alertType := action.AlertTypeInfo
+	message := fmt.Sprintf("Restarted rollout of Deployment %q", key.Name)
+	if err := r.store.Update(ctx, key, fn); err != nil {
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to restart rollout of Deployment %q: %s", key.Name, err)
+	} else {
+		trackScale(ctx, r.tracker, alerter, key)
+	}
+
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+
+	return nil
+}
+
+// RolloutPauser pauses or resumes the rollout of a deployment by setting
+// spec.paused.
+type RolloutPauser struct {
+	store   store.Store
+	tracker *ScaleTracker
+	paused  bool
+}
+
+var _ action.Dispatcher = (*RolloutPauser)(nil)
+
+// NewRolloutPauser creates a RolloutPauser which pauses rollouts.
+func NewRolloutPauser(objectStore store.Store) *RolloutPauser {
+	return &RolloutPauser{
+		store:   objectStore,
+		tracker: NewScaleTracker(objectStore),
+		paused:  true,
+	}
+}
+
+// NewRolloutResumer creates a RolloutPauser which resumes rollouts.
+func NewRolloutResumer(objectStore store.Store) *RolloutPauser {
+	return &RolloutPauser{
+		store:   objectStore,
+		tracker: NewScaleTracker(objectStore),
+	}
+}
+
+// ActionName returns the name of this action.
+func (p *RolloutPauser) ActionName() string {
+	if p.paused {
+		return ActionPauseRollout
+	}
+
+	return ActionResumeRollout
+}
+
+// Handle pauses or resumes the rollout of the deployment in the payload.
+// Progress of a resumed rollout is sent to the client if it can display it.
+func (p *RolloutPauser) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	log.From(ctx).With("actionName", p.ActionName()).Debugf("received action payload")
+
+	key, err := rolloutKey(payload)
+	if err != nil {
+		return err
+	}
+
+	fn := func(object *unstructured.Unstructured) error {
+		return unstructured.SetNestedField(object.Object, p.paused, "spec", "paused")
+	}
+
+	verb, past := "resume", "Resumed"
+	if p.paused {
+		verb, past = "pause", "Paused"
+	}
+
+	alertType := action.AlertTypeInfo
+	message := fmt.Sprintf("%s rollout of Deployment %q", past, key.Name)
+	if err := p.store.Update(ctx, key, fn); err != nil {
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to %s rollout of Deployment %q: %s", verb, key.Name, err)
+	} else if !p.paused {
+		trackScale(ctx, p.tracker, alerter, key)
+	}
+
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+
+	return nil
+}
+
+// rolloutKey returns the key for the deployment in a payload.
+func rolloutKey(payload action.Payload) (store.Key, error) {
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return store.Key{}, err
+	}
+
+	if key.APIVersion != "apps/v1" || key.Kind != "Deployment" {
+		return store.Key{}, errors.Errorf("rollouts of %s %s can't be managed", key.APIVersion, key.Kind)
+	}
+
+	return key, nil
+}
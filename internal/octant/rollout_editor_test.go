@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/action"
+	actionFake "github.com/vmware/octant/pkg/action/fake"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/store/fake"
+)
+
+func deploymentPayload() action.Payload {
+	return action.Payload{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"namespace":  "default",
+		"name":       "deployment",
+	}
+}
+
+func TestRolloutRestarter(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	deployment := testutil.ToUnstructured(t, testutil.CreateDeployment("deployment"))
+	key := store.Key{Namespace: "default", APIVersion: "apps/v1", Kind: "Deployment", Name: "deployment"}
+
+	objectStore := fake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Update(gomock.Any(), key, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, key store.Key, fn func(object *unstructured.Unstructured) error) error {
+			return fn(deployment)
+		})
+
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		DoAndReturn(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeInfo, alert.Type)
+			assert.Equal(t, `Restarted rollout of Deployment "deployment"`, alert.Message)
+		})
+
+	restarter := NewRolloutRestarter(objectStore)
+	restarter.now = func() time.Time {
+		return time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+	}
+	assert.Equal(t, ActionRestartRollout, restarter.ActionName())
+
+	require.NoError(t, restarter.Handle(context.Background(), alerter, deploymentPayload()))
+
+	got, _, err := unstructured.NestedString(deployment.Object,
+		"spec", "template", "metadata", "annotations", RestartedAtAnnotation)
+	require.NoError(t, err)
+	assert.Equal(t, "2019-10-01T12:00:00Z", got)
+}
+
+func TestRolloutPauser(t *testing.T) {
+	tests := []struct {
+		name         string
+		pauser       func(objectStore store.Store) *RolloutPauser
+		actionName   string
+		paused       bool
+		alertMessage string
+	}{
+		{
+			name:         "pause",
+			pauser:       NewRolloutPauser,
+			actionName:   ActionPauseRollout,
+			paused:       true,
+			alertMessage: `Paused rollout of Deployment "deployment"`,
+		},
+		{
+			name:         "resume",
+			pauser:       NewRolloutResumer,
+			actionName:   ActionResumeRollout,
+			alertMessage: `Resumed rollout of Deployment "deployment"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			deployment := testutil.ToUnstructured(t, testutil.CreateDeployment("deployment"))
+			key := store.Key{Namespace: "default", APIVersion: "apps/v1", Kind: "Deployment", Name: "deployment"}
+
+			objectStore := fake.NewMockStore(controller)
+			objectStore.EXPECT().
+				Update(gomock.Any(), key, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, key store.Key, fn func(object *unstructured.Unstructured) error) error {
+					return fn(deployment)
+				})
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				DoAndReturn(func(alert action.Alert) {
+					assert.Equal(t, action.AlertTypeInfo, alert.Type)
+					assert.Equal(t, test.alertMessage, alert.Message)
+				})
+
+			pauser := test.pauser(objectStore)
+			assert.Equal(t, test.actionName, pauser.ActionName())
+
+			require.NoError(t, pauser.Handle(context.Background(), alerter, deploymentPayload()))
+
+			got, _, err := unstructured.NestedBool(deployment.Object, "spec", "paused")
+			require.NoError(t, err)
+			assert.Equal(t, test.paused, got)
+		})
+	}
+}
+
+func TestRolloutPauser_invalidKind(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pauser := NewRolloutPauser(fake.NewMockStore(controller))
+
+	payload := deploymentPayload()
+	payload["kind"] = "StatefulSet"
+
+	err := pauser.Handle(context.Background(), actionFake.NewMockAlerter(controller), payload)
+	require.Error(t, err)
+}
@@ -0,0 +1,278 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+const (
+	// defaultScaleTimeout is how long a scale is tracked before it times out.
+	defaultScaleTimeout = 5 * time.Minute
+	// defaultScaleInterval is how often progress is checked if pods and
+	// events have not changed.
+	defaultScaleInterval = 5 * time.Second
+	// scaleEventCount is the number of pod events included in progress.
+	scaleEventCount = 5
+)
+
+// ScaleTracker sends the progress of a deployment scale or rollout to the
+// client which started it, until the deployment's pods are ready or the
+// tracker times out. It checks progress when pods or events in the
+// deployment's namespace change.
+type ScaleTracker struct {
+	store    store.Store
+	timeout  time.Duration
+	interval time.Duration
+}
+
+// NewScaleTracker creates an instance of ScaleTracker.
+func NewScaleTracker(objectStore store.Store) *ScaleTracker {
+	return &ScaleTracker{
+		store:    objectStore,
+		timeout:  defaultScaleTimeout,
+		interval: defaultScaleInterval,
+	}
+}
+
+// Track sends progress for the deployment with the key. It blocks until the
+// deployment's pods are ready, the tracker times out, or the context is
+// canceled.
+func (t *ScaleTracker) Track(ctx context.Context, sender ProgressSender, key store.Key) error {
+	logger := log.From(ctx).With("deployment", key.Name, "namespace", key.Namespace)
+
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+	defer cancel()
+
+	id := fmt.Sprintf("%s/%s/%s/%d", key.Namespace, key.Kind, key.Name, time.Now().UnixNano())
+
+	// the watches are removed when ctx is canceled.
+	changed := make(chan struct{}, 1)
+	notify := func() {
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	}
+	handler := kcache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { notify() },
+		UpdateFunc: func(interface{}, interface{}) { notify() },
+		DeleteFunc: func(interface{}) { notify() },
+	}
+
+	for _, kind := range []string{"Pod", "Event"} {
+		watchKey := store.Key{Namespace: key.Namespace, APIVersion: "v1", Kind: kind}
+		if err := t.store.Watch(ctx, watchKey, handler); err != nil {
+			logger.WithErr(err).Debugf("unable to watch %s; progress will be polled", kind)
+		}
+	}
+
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		progress, err := t.progress(ctx, key)
+		if err != nil {
+			sender.SendProgress(OperationProgress{
+				ID:   id,
+				Done: true,
+				View: component.NewError(component.TitleFromString(key.Name), err),
+			})
+			return err
+		}
+
+		complete := progress.complete()
+		status := "In progress"
+		if complete {
+			status = "Complete"
+		}
+		sender.SendProgress(OperationProgress{ID: id, Done: complete, View: progress.view(status)})
+
+		if complete {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			sender.SendProgress(OperationProgress{ID: id, Done: true, View: progress.view("Timed out")})
+			return nil
+		case <-changed:
+		case <-ticker.C:
+		}
+	}
+}
+
+// trackScale tracks a deployment in the background if the client which
+// started the action can display progress.
+func trackScale(ctx context.Context, tracker *ScaleTracker, alerter action.Alerter, key store.Key) {
+	sender, ok := alerter.(ProgressSender)
+	if !ok || key.Kind != "Deployment" {
+		return
+	}
+
+	go func() {
+		if err := tracker.Track(ctx, sender, key); err != nil {
+			log.From(ctx).WithErr(err).Errorf("track deployment progress")
+		}
+	}()
+}
+
+// scaleProgress is a deployment's progress.
+type scaleProgress struct {
+	name      string
+	desired   int32
+	updated   int32
+	ready     int32
+	total     int32
+	observed  bool
+	podEvents []string
+}
+
+func (p scaleProgress) complete() bool {
+	return p.observed &&
+		p.updated == p.desired &&
+		p.ready == p.desired &&
+		p.total == p.desired
+}
+
+func (p scaleProgress) view(status string) component.Component {
+	summary := component.NewSummary(fmt.Sprintf("Deployment %s", p.name),
+		component.SummarySection{
+			Header:  "Status",
+			Content: component.NewText(status),
+		},
+		component.SummarySection{
+			Header:  "Ready",
+			Content: component.NewText(fmt.Sprintf("%d of %d", p.ready, p.desired)),
+		},
+		component.SummarySection{
+			Header:  "Updated",
+			Content: component.NewText(fmt.Sprintf("%d of %d", p.updated, p.desired)),
+		},
+	)
+
+	if len(p.podEvents) > 0 {
+		var items []component.Component
+		for _, event := range p.podEvents {
+			items = append(items, component.NewText(event))
+		}
+		summary.Add(component.SummarySection{
+			Header:  "Recent Events",
+			Content: component.NewList("", items),
+		})
+	}
+
+	return summary
+}
+
+func (t *ScaleTracker) progress(ctx context.Context, key store.Key) (scaleProgress, error) {
+	object, found, err := t.store.Get(ctx, key)
+	if err != nil {
+		return scaleProgress{}, errors.Wrapf(err, "get deployment %s", key.Name)
+	}
+	if !found {
+		return scaleProgress{}, errors.Errorf("deployment %s was not found", key.Name)
+	}
+
+	deployment := &appsv1.Deployment{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, deployment); err != nil {
+		return scaleProgress{}, errors.Wrap(err, "convert deployment")
+	}
+
+	desired := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desired = *deployment.Spec.Replicas
+	}
+
+	podEvents, err := t.podEvents(ctx, deployment)
+	if err != nil {
+		return scaleProgress{}, err
+	}
+
+	return scaleProgress{
+		name:      deployment.Name,
+		desired:   desired,
+		updated:   deployment.Status.UpdatedReplicas,
+		ready:     deployment.Status.ReadyReplicas,
+		total:     deployment.Status.Replicas,
+		observed:  deployment.Status.ObservedGeneration >= deployment.Generation,
+		podEvents: podEvents,
+	}, nil
+}
+
+// podEvents returns the most recent events for the deployment's pods.
+func (t *ScaleTracker) podEvents(ctx context.Context, deployment *appsv1.Deployment) ([]string, error) {
+	if deployment.Spec.Selector == nil {
+		return nil, nil
+	}
+
+	selector := labels.Set(deployment.Spec.Selector.MatchLabels)
+	podList, _, err := t.store.List(ctx, store.Key{
+		Namespace:  deployment.Namespace,
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Selector:   &selector,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "list pods")
+	}
+
+	pods := make(map[string]bool)
+	for i := range podList.Items {
+		pods[podList.Items[i].GetName()] = true
+	}
+
+	eventList, _, err := t.store.List(ctx, store.Key{
+		Namespace:  deployment.Namespace,
+		APIVersion: "v1",
+		Kind:       "Event",
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "list events")
+	}
+
+	var events []corev1.Event
+	for i := range eventList.Items {
+		event := corev1.Event{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(eventList.Items[i].Object, &event); err != nil {
+			return nil, errors.Wrap(err, "convert event")
+		}
+
+		if event.InvolvedObject.Kind == "Pod" && pods[event.InvolvedObject.Name] {
+			events = append(events, event)
+		}
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].LastTimestamp.After(events[j].LastTimestamp.Time)
+	})
+
+	if len(events) > scaleEventCount {
+		events = events[:scaleEventCount]
+	}
+
+	var messages []string
+	for _, event := range events {
+		messages = append(messages, fmt.Sprintf("%s: %s", event.InvolvedObject.Name, event.Message))
+	}
+
+	return messages, nil
+}
@@ -0,0 +1,153 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/pointer"
+
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/store/fake"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+type fakeProgressSender struct {
+	mu       sync.Mutex
+	progress []OperationProgress
+}
+
+func (s *fakeProgressSender) SendProgress(progress OperationProgress) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.progress = append(s.progress, progress)
+}
+
+func TestScaleTracker_Track(t *testing.T) {
+	cases := []struct {
+		name     string
+		status   appsv1.DeploymentStatus
+		expected string
+	}{
+		{
+			name:     "complete",
+			status:   appsv1.DeploymentStatus{Replicas: 2, UpdatedReplicas: 2, ReadyReplicas: 2},
+			expected: "Complete",
+		},
+		{
+			name:     "timed out",
+			status:   appsv1.DeploymentStatus{Replicas: 2, UpdatedReplicas: 2, ReadyReplicas: 1},
+			expected: "Timed out",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			deployment := testutil.CreateDeployment("deployment")
+			deployment.Namespace = "default"
+			deployment.Spec.Replicas = pointer.Int32Ptr(2)
+			deployment.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}
+			deployment.Status = tc.status
+
+			pod := testutil.CreatePod("pod")
+			pod.Namespace = "default"
+
+			podEvent := testutil.CreateEvent("pod-event")
+			podEvent.Namespace = "default"
+			podEvent.InvolvedObject = corev1.ObjectReference{Kind: "Pod", Name: "pod"}
+			podEvent.Message = "Started container"
+
+			otherEvent := testutil.CreateEvent("other-event")
+			otherEvent.Namespace = "default"
+			otherEvent.InvolvedObject = corev1.ObjectReference{Kind: "Pod", Name: "other"}
+
+			key := store.Key{Namespace: "default", APIVersion: "apps/v1", Kind: "Deployment", Name: "deployment"}
+			selector := labels.Set{"app": "web"}
+
+			objectStore := fake.NewMockStore(controller)
+			objectStore.EXPECT().
+				Watch(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil).Times(2)
+			objectStore.EXPECT().
+				Get(gomock.Any(), key).
+				Return(testutil.ToUnstructured(t, deployment), true, nil).MinTimes(1)
+			objectStore.EXPECT().
+				List(gomock.Any(), store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Selector: &selector}).
+				Return(testutil.ToUnstructuredList(t, pod), false, nil).MinTimes(1)
+			objectStore.EXPECT().
+				List(gomock.Any(), store.Key{Namespace: "default", APIVersion: "v1", Kind: "Event"}).
+				Return(testutil.ToUnstructuredList(t, podEvent, otherEvent), false, nil).MinTimes(1)
+
+			tracker := NewScaleTracker(objectStore)
+			tracker.timeout = 50 * time.Millisecond
+			tracker.interval = 10 * time.Millisecond
+
+			sender := &fakeProgressSender{}
+
+			ctx := context.Background()
+			require.NoError(t, tracker.Track(ctx, sender, key))
+
+			require.NotEmpty(t, sender.progress)
+			last := sender.progress[len(sender.progress)-1]
+			assert.True(t, last.Done)
+			for _, progress := range sender.progress {
+				assert.Equal(t, last.ID, progress.ID)
+			}
+
+			summary, ok := last.View.(*component.Summary)
+			require.True(t, ok)
+
+			sections := summary.Sections()
+			require.Len(t, sections, 4)
+			assert.Equal(t, component.NewText(tc.expected), sections[0].Content)
+			assert.Equal(t, component.NewText("2 of 2"), sections[2].Content)
+
+			expectedEvents := component.NewList("", []component.Component{
+				component.NewText("pod: Started container"),
+			})
+			assert.Equal(t, expectedEvents, sections[3].Content)
+		})
+	}
+}
+
+func TestScaleTracker_Track_not_found(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	key := store.Key{Namespace: "default", APIVersion: "apps/v1", Kind: "Deployment", Name: "deployment"}
+
+	objectStore := fake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Watch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).Times(2)
+	objectStore.EXPECT().
+		Get(gomock.Any(), key).
+		Return(nil, false, nil)
+
+	tracker := NewScaleTracker(objectStore)
+	sender := &fakeProgressSender{}
+
+	ctx := context.Background()
+	require.Error(t, tracker.Track(ctx, sender, key))
+
+	require.Len(t, sender.progress, 1)
+	assert.True(t, sender.progress[0].Done)
+}
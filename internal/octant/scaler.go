@@ -0,0 +1,200 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware/octant/internal/cluster"
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// scalableKinds are the kinds which can be scaled using the scale action.
+var scalableKinds = []schema.GroupVersionKind{
+	{Group: "apps", Version: "v1", Kind: "Deployment"},
+	{Group: "apps", Version: "v1", Kind: "ReplicaSet"},
+	{Group: "apps", Version: "v1", Kind: "StatefulSet"},
+	{Version: "v1", Kind: "ReplicationController"},
+}
+
+// Scaler scales workloads using their scale subresource.
+type Scaler struct {
+	store   store.Store
+	client  cluster.ClientInterface
+	tracker *ScaleTracker
+}
+
+var _ action.Dispatcher = (*Scaler)(nil)
+var _ action.PayloadValidator = (*Scaler)(nil)
+
+// ReplicasValidation returns the rules for the replicas field of the
+// scale form.
+func ReplicasValidation() *component.FormFieldValidation {
+	return component.NewFormFieldValidation(
+		component.ValidateRequired(),
+		component.ValidateMin(0),
+	)
+}
+
+// NewScaler creates an instance of Scaler.
+func NewScaler(objectStore store.Store, client cluster.ClientInterface) *Scaler {
+	return &Scaler{
+		store:   objectStore,
+		client:  client,
+		tracker: NewScaleTracker(objectStore),
+	}
+}
+
+// ActionName returns the name of this action.
+func (s *Scaler) ActionName() string {
+	return ActionScale
+}
+
+// ValidatePayload checks a payload against the scale form's validation
+// rules.
+func (s *Scaler) ValidatePayload(payload action.Payload) error {
+	if err := ReplicasValidation().Validate(payload["replicas"]); err != nil {
+		return errors.Wrap(err, "Replicas")
+	}
+
+	return nil
+}
+
+// Handle sets the replicas of a workload. If a horizontal pod autoscaler
+// targets the workload, the alert warns that the autoscaler may override
+// the change. Progress of deployment scales is sent to the client if it can
+// display it.
+func (s *Scaler) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", s.ActionName())
+	logger.Debugf("received action payload")
+
+	replicaCountFloat, err := payload.Float64("replicas")
+	if err != nil {
+		return err
+	}
+	replicaCount := roundToInt(replicaCountFloat)
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	if !isScalable(key) {
+		return errors.Errorf("%s %s can't be scaled", key.APIVersion, key.Kind)
+	}
+
+	if err := s.scale(key, replicaCount); err != nil {
+		message := fmt.Sprintf("Unable to scale %s %q: %s", key.Kind, key.Name, err)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	trackScale(ctx, s.tracker, alerter, key)
+
+	alertType := action.AlertTypeInfo
+	message := fmt.Sprintf("Scaled %s %q to %d", key.Kind, key.Name, replicaCount)
+
+	autoscaler, err := s.autoscaler(ctx, key)
+	if err != nil {
+		logger.WithErr(err).Errorf("find horizontal pod autoscaler")
+	} else if autoscaler != "" {
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("%s. HorizontalPodAutoscaler %q manages its replicas and may override this change",
+			message, autoscaler)
+	}
+
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+
+	return nil
+}
+
+// scale patches the scale subresource of the object with key.
+func (s *Scaler) scale(key store.Key, replicas int64) error {
+	gv, err := schema.ParseGroupVersion(key.APIVersion)
+	if err != nil {
+		return err
+	}
+
+	gvr, err := s.client.Resource(gv.WithKind(key.Kind).GroupKind())
+	if err != nil {
+		return err
+	}
+
+	dynamicClient, err := s.client.DynamicClient()
+	if err != nil {
+		return err
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"replicas": replicas,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = dynamicClient.Resource(gvr).Namespace(key.Namespace).
+		Patch(key.Name, types.MergePatchType, patch, metav1.PatchOptions{}, "scale")
+	return err
+}
+
+// autoscaler returns the name of the horizontal pod autoscaler which
+// targets the object with key, or a blank string if there is none.
+func (s *Scaler) autoscaler(ctx context.Context, key store.Key) (string, error) {
+	list, _, err := s.store.List(ctx, store.Key{
+		Namespace:  key.Namespace,
+		APIVersion: "autoscaling/v1",
+		Kind:       "HorizontalPodAutoscaler",
+	})
+	if err != nil {
+		return "", err
+	}
+
+	for i := range list.Items {
+		hpa := &autoscalingv1.HorizontalPodAutoscaler{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(list.Items[i].Object, hpa); err != nil {
+			return "", err
+		}
+
+		target := hpa.Spec.ScaleTargetRef
+		if target.Kind == key.Kind && target.Name == key.Name {
+			return hpa.Name, nil
+		}
+	}
+
+	return "", nil
+}
+
+func isScalable(key store.Key) bool {
+	for _, gvk := range scalableKinds {
+		apiVersion, kind := gvk.ToAPIVersionAndKind()
+		if apiVersion == key.APIVersion && kind == key.Kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+func roundToInt(val float64) int64 {
+	if val < 0 {
+		return int64(val - 0.5)
+	}
+	return int64(val + 0.5)
+}
@@ -0,0 +1,190 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	clusterFake "github.com/vmware/octant/internal/cluster/fake"
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/action"
+	actionFake "github.com/vmware/octant/pkg/action/fake"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/store/fake"
+)
+
+func TestScaler(t *testing.T) {
+	hpa := &autoscalingv1.HorizontalPodAutoscaler{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "autoscaling/v1", Kind: "HorizontalPodAutoscaler"},
+		ObjectMeta: metav1.ObjectMeta{Name: "hpa", Namespace: "default"},
+		Spec: autoscalingv1.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "StatefulSet",
+				Name:       "web",
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		autoscalers  []*autoscalingv1.HorizontalPodAutoscaler
+		scaleErr     error
+		alertType    action.AlertType
+		alertMessage string
+	}{
+		{
+			name:         "scaled",
+			alertType:    action.AlertTypeInfo,
+			alertMessage: `Scaled StatefulSet "web" to 5`,
+		},
+		{
+			name:        "managed by autoscaler",
+			autoscalers: []*autoscalingv1.HorizontalPodAutoscaler{hpa},
+			alertType:   action.AlertTypeWarning,
+			alertMessage: `Scaled StatefulSet "web" to 5. ` +
+				`HorizontalPodAutoscaler "hpa" manages its replicas and may override this change`,
+		},
+		{
+			name:         "scale fails",
+			scaleErr:     kerrors.NewForbidden(schema.GroupResource{Group: "apps", Resource: "statefulsets"}, "web", nil),
+			alertType:    action.AlertTypeWarning,
+			alertMessage: `Unable to scale StatefulSet "web": statefulsets.apps "web" is forbidden: <nil>`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "statefulsets"}
+
+			resourceClient := clusterFake.NewMockResourceInterface(controller)
+			namespaceableClient := clusterFake.NewMockNamespaceableResourceInterface(controller)
+			dynamicClient := clusterFake.NewMockDynamicInterface(controller)
+			clusterClient := clusterFake.NewMockClientInterface(controller)
+
+			clusterClient.EXPECT().Resource(schema.GroupKind{Group: "apps", Kind: "StatefulSet"}).Return(gvr, nil)
+			clusterClient.EXPECT().DynamicClient().Return(dynamicClient, nil)
+			dynamicClient.EXPECT().Resource(gvr).Return(namespaceableClient)
+			namespaceableClient.EXPECT().Namespace("default").Return(resourceClient)
+			resourceClient.EXPECT().
+				Patch("web", types.MergePatchType, []byte(`{"spec":{"replicas":5}}`), metav1.PatchOptions{}, "scale").
+				Return(nil, test.scaleErr)
+
+			objectStore := fake.NewMockStore(controller)
+			if test.scaleErr == nil {
+				list := &unstructured.UnstructuredList{}
+				for _, autoscaler := range test.autoscalers {
+					list.Items = append(list.Items, *testutil.ToUnstructured(t, autoscaler))
+				}
+
+				hpaKey := store.Key{Namespace: "default", APIVersion: "autoscaling/v1", Kind: "HorizontalPodAutoscaler"}
+				objectStore.EXPECT().List(gomock.Any(), hpaKey).Return(list, false, nil)
+			}
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				DoAndReturn(func(alert action.Alert) {
+					assert.Equal(t, test.alertType, alert.Type)
+					assert.Equal(t, test.alertMessage, alert.Message)
+					assert.NotNil(t, alert.Expiration)
+				})
+
+			scaler := NewScaler(objectStore, clusterClient)
+			assert.Equal(t, ActionScale, scaler.ActionName())
+
+			payload := action.Payload{
+				"apiVersion": "apps/v1",
+				"kind":       "StatefulSet",
+				"namespace":  "default",
+				"name":       "web",
+				"replicas":   "5",
+			}
+
+			ctx := context.Background()
+			require.NoError(t, scaler.Handle(ctx, alerter, payload))
+		})
+	}
+}
+
+func TestScaler_unscalable(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	scaler := NewScaler(fake.NewMockStore(controller), clusterFake.NewMockClientInterface(controller))
+
+	payload := action.Payload{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"namespace":  "default",
+		"name":       "pod",
+		"replicas":   "5",
+	}
+
+	ctx := context.Background()
+	require.Error(t, scaler.Handle(ctx, actionFake.NewMockAlerter(controller), payload))
+}
+
+func TestScaler_ValidatePayload(t *testing.T) {
+	tests := []struct {
+		name     string
+		replicas interface{}
+		isErr    bool
+	}{
+		{
+			name:     "valid replicas",
+			replicas: "5",
+		},
+		{
+			name:     "zero replicas",
+			replicas: float64(0),
+		},
+		{
+			name:     "negative replicas",
+			replicas: "-1",
+			isErr:    true,
+		},
+		{
+			name:  "missing replicas",
+			isErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			scaler := NewScaler(fake.NewMockStore(controller), clusterFake.NewMockClientInterface(controller))
+
+			payload := action.Payload{}
+			if test.replicas != nil {
+				payload["replicas"] = test.replicas
+			}
+
+			err := scaler.ValidatePayload(payload)
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
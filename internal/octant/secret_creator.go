@@ -0,0 +1,244 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/store"
+)
+
+// SecretCreator creates secrets.
+type SecretCreator struct {
+	store store.Store
+}
+
+var _ action.Dispatcher = (*SecretCreator)(nil)
+
+// NewSecretCreator creates an instance of SecretCreator.
+func NewSecretCreator(objectStore store.Store) *SecretCreator {
+	return &SecretCreator{
+		store: objectStore,
+	}
+}
+
+// ActionName returns the name of this action.
+func (c *SecretCreator) ActionName() string {
+	return ActionCreateSecret
+}
+
+// Handle creates a secret. The type of secret is determined by the
+// secretType field in the payload.
+func (c *SecretCreator) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", c.ActionName())
+	logger.Debugf("received action payload")
+
+	secret, err := secretFromPayload(payload)
+	if err != nil {
+		message := fmt.Sprintf("Unable to create Secret: %s", err)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(secret)
+	if err != nil {
+		return errors.Wrap(err, "convert secret to unstructured")
+	}
+
+	alertType := action.AlertTypeInfo
+	message := fmt.Sprintf("Created Secret %q", secret.Name)
+	if err := c.store.Create(ctx, &unstructured.Unstructured{Object: object}); err != nil {
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to create Secret %q: %s", secret.Name, err)
+		logger.WithErr(err).Errorf("create secret")
+	}
+
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+
+	return nil
+}
+
+// secretFromPayload creates a secret from a payload. The payload's values
+// are plain text and are encoded when the secret is serialized.
+func secretFromPayload(payload action.Payload) (*corev1.Secret, error) {
+	namespace, err := payload.String("namespace")
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := payload.String("name")
+	if err != nil {
+		return nil, err
+	}
+	name = strings.TrimSpace(name)
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return nil, errors.Errorf("invalid name %q: %s", name, strings.Join(errs, ", "))
+	}
+
+	secretType, err := payload.String("secretType")
+	if err != nil {
+		return nil, err
+	}
+
+	var data map[string][]byte
+
+	switch corev1.SecretType(secretType) {
+	case corev1.SecretTypeOpaque:
+		data, err = genericSecretData(payload)
+	case corev1.SecretTypeDockerConfigJson:
+		data, err = dockerConfigSecretData(payload)
+	case corev1.SecretTypeTLS:
+		data, err = tlsSecretData(payload)
+	default:
+		err = errors.Errorf("unsupported secret type %q", secretType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Type: corev1.SecretType(secretType),
+		Data: data,
+	}, nil
+}
+
+// genericSecretData parses data entered as key=value lines.
+func genericSecretData(payload action.Payload) (map[string][]byte, error) {
+	text, err := payload.String("data")
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[string][]byte)
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			return nil, errors.Errorf("data %q is not in key=value format", line)
+		}
+
+		key := strings.TrimSpace(parts[0])
+		if errs := validation.IsConfigMapKey(key); len(errs) > 0 {
+			return nil, errors.Errorf("invalid key %q: %s", key, strings.Join(errs, ", "))
+		}
+		if _, ok := data[key]; ok {
+			return nil, errors.Errorf("duplicate key %q", key)
+		}
+
+		data[key] = []byte(parts[1])
+	}
+
+	if len(data) == 0 {
+		return nil, errors.New("secret has no data")
+	}
+
+	return data, nil
+}
+
+// dockerConfigSecretData creates a .dockerconfigjson entry for a registry.
+func dockerConfigSecretData(payload action.Payload) (map[string][]byte, error) {
+	server, err := payload.String("server")
+	if err != nil {
+		return nil, err
+	}
+
+	username, err := payload.String("username")
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := payload.String("password")
+	if err != nil {
+		return nil, err
+	}
+
+	email, err := payload.OptionalString("email")
+	if err != nil {
+		return nil, err
+	}
+
+	if server == "" || username == "" || password == "" {
+		return nil, errors.New("server, username, and password are required")
+	}
+
+	config, err := dockerConfigJSON(server, username, password, email)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string][]byte{corev1.DockerConfigJsonKey: config}, nil
+}
+
+type dockerConfigEntry struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Email    string `json:"email,omitempty"`
+	Auth     string `json:"auth"`
+}
+
+// dockerConfigJSON creates docker config JSON with credentials for a registry.
+func dockerConfigJSON(server, username, password, email string) ([]byte, error) {
+	config := struct {
+		Auths map[string]dockerConfigEntry `json:"auths"`
+	}{
+		Auths: map[string]dockerConfigEntry{
+			server: {
+				Username: username,
+				Password: password,
+				Email:    email,
+				Auth:     base64.StdEncoding.EncodeToString([]byte(username + ":" + password)),
+			},
+		},
+	}
+
+	return json.Marshal(config)
+}
+
+// tlsSecretData creates TLS secret data after verifying the certificate and
+// key are a matching pair.
+func tlsSecretData(payload action.Payload) (map[string][]byte, error) {
+	cert, err := payload.String("certificate")
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := payload.String("key")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tls.X509KeyPair([]byte(cert), []byte(key)); err != nil {
+		return nil, errors.Wrap(err, "invalid certificate and key")
+	}
+
+	return map[string][]byte{
+		corev1.TLSCertKey:       []byte(cert),
+		corev1.TLSPrivateKeyKey: []byte(key),
+	}, nil
+}
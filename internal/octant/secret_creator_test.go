@@ -0,0 +1,187 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware/octant/pkg/action"
+	actionFake "github.com/vmware/octant/pkg/action/fake"
+	"github.com/vmware/octant/pkg/store/fake"
+)
+
+func TestSecretCreator(t *testing.T) {
+	cert, key := createTestCertificate(t)
+	_, otherKey := createTestCertificate(t)
+
+	tests := []struct {
+		name         string
+		payload      action.Payload
+		expectedType corev1.SecretType
+		expectedData map[string][]byte
+		alertType    action.AlertType
+		alertMessage string
+	}{
+		{
+			name: "generic",
+			payload: action.Payload{
+				"secretType": "Opaque",
+				"data":       "username=admin\npassword=a=b\n\n",
+			},
+			expectedType: corev1.SecretTypeOpaque,
+			expectedData: map[string][]byte{
+				"username": []byte("admin"),
+				"password": []byte("a=b"),
+			},
+			alertType:    action.AlertTypeInfo,
+			alertMessage: `Created Secret "secret"`,
+		},
+		{
+			name: "generic with invalid line",
+			payload: action.Payload{
+				"secretType": "Opaque",
+				"data":       "username",
+			},
+			alertType:    action.AlertTypeWarning,
+			alertMessage: `Unable to create Secret: data "username" is not in key=value format`,
+		},
+		{
+			name: "docker config",
+			payload: action.Payload{
+				"secretType": "kubernetes.io/dockerconfigjson",
+				"server":     "registry.example.com",
+				"username":   "user",
+				"password":   "pass",
+				"email":      "",
+			},
+			expectedType: corev1.SecretTypeDockerConfigJson,
+			expectedData: map[string][]byte{
+				".dockerconfigjson": []byte(`{"auths":{"registry.example.com":{"username":"user","password":"pass","auth":"dXNlcjpwYXNz"}}}`),
+			},
+			alertType:    action.AlertTypeInfo,
+			alertMessage: `Created Secret "secret"`,
+		},
+		{
+			name: "tls",
+			payload: action.Payload{
+				"secretType":  "kubernetes.io/tls",
+				"certificate": cert,
+				"key":         key,
+			},
+			expectedType: corev1.SecretTypeTLS,
+			expectedData: map[string][]byte{
+				"tls.crt": []byte(cert),
+				"tls.key": []byte(key),
+			},
+			alertType:    action.AlertTypeInfo,
+			alertMessage: `Created Secret "secret"`,
+		},
+		{
+			name: "tls with mismatched key",
+			payload: action.Payload{
+				"secretType":  "kubernetes.io/tls",
+				"certificate": cert,
+				"key":         otherKey,
+			},
+			alertType:    action.AlertTypeWarning,
+			alertMessage: "Unable to create Secret: invalid certificate and key: tls: private key does not match public key",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			objectStore := fake.NewMockStore(controller)
+			if test.expectedData != nil {
+				objectStore.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, object *unstructured.Unstructured) error {
+						secret := &corev1.Secret{}
+						require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, secret))
+
+						assert.Equal(t, "secret", secret.Name)
+						assert.Equal(t, "default", secret.Namespace)
+						assert.Equal(t, test.expectedType, secret.Type)
+						assert.Equal(t, test.expectedData, secret.Data)
+						return nil
+					})
+			}
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				DoAndReturn(func(alert action.Alert) {
+					assert.Equal(t, test.alertType, alert.Type)
+					assert.Equal(t, test.alertMessage, alert.Message)
+				})
+
+			creator := NewSecretCreator(objectStore)
+			assert.Equal(t, ActionCreateSecret, creator.ActionName())
+
+			payload := action.Payload{
+				"namespace": "default",
+				"name":      "secret",
+			}
+			for k, v := range test.payload {
+				payload[k] = v
+			}
+
+			ctx := context.Background()
+			require.NoError(t, creator.Handle(ctx, alerter, payload))
+		})
+	}
+}
+
+func Test_secretFromPayload_invalid_name(t *testing.T) {
+	payload := action.Payload{
+		"namespace":  "default",
+		"name":       "Not_Valid",
+		"secretType": "Opaque",
+		"data":       "a=b",
+	}
+
+	_, err := secretFromPayload(payload)
+	require.Error(t, err)
+}
+
+func createTestCertificate(t *testing.T) (string, string) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	certData, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	require.NoError(t, err)
+
+	keyData, err := x509.MarshalECPrivateKey(privateKey)
+	require.NoError(t, err)
+
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certData})
+	key := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyData})
+
+	return string(cert), string(key)
+}
@@ -8,6 +8,7 @@ package octant
 import (
 	"context"
 
+	"github.com/vmware/octant/internal/eventfilter"
 	"github.com/vmware/octant/pkg/action"
 )
 
@@ -43,6 +44,10 @@ type State interface {
 	// SetFilters replaces the current filters with a slice of filters.
 	// The slice can be empty.
 	SetFilters(filters []Filter)
+	// SetEventFilters sets the event filter presets.
+	SetEventFilters(presets eventfilter.Presets)
+	// GetEventFilters returns the event filter presets.
+	GetEventFilters() eventfilter.Presets
 	// SetContext sets the current context.
 	SetContext(requestedContext string)
 	// Dispatch dispatches a payload for an action.
@@ -8,6 +8,7 @@ package portforward
 import (
 	"context"
 	"os"
+	"time"
 
 	"github.com/vmware/octant/internal/cluster"
 	"github.com/vmware/octant/internal/log"
@@ -16,8 +17,9 @@ import (
 	"github.com/pkg/errors"
 )
 
-// Default create a port forward instance.
-func Default(ctx context.Context, client cluster.ClientInterface, objectStore store.Store) (PortForwarder, error) {
+// Default create a port forward instance. Port forwards are stopped after expiry
+// unless it is zero.
+func Default(ctx context.Context, client cluster.ClientInterface, objectStore store.Store, expiry time.Duration) (PortForwarder, error) {
 	logger := log.From(ctx)
 	restClient, err := client.RESTClient()
 	if err != nil {
@@ -39,6 +41,7 @@ func Default(ctx context.Context, client cluster.ClientInterface, objectStore st
 				ErrOut: os.Stderr,
 			},
 		},
+		Expiry: expiry,
 	}
 
 	// FIXME: logger is in context
@@ -8,6 +8,7 @@ package portforward
 import (
 	"context"
 	"fmt"
+	"net"
 	"sort"
 	"sync"
 	"time"
@@ -15,7 +16,10 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/rest"
 	restclient "k8s.io/client-go/rest"
 
@@ -53,6 +57,7 @@ type PortForwardSpec struct {
 	Message   string                `json:"message"`
 	Ports     []PortForwardPortSpec `json:"ports"`
 	CreatedAt time.Time             `json:"createdAt"`
+	ExpiresAt time.Time             `json:"expiresAt,omitempty"`
 }
 
 type CreateRequest struct {
@@ -79,6 +84,9 @@ type State struct {
 	Ports     []ForwardedPort
 	Target    Target
 	Pod       Target
+	// ExpiresAt is when the port forward is stopped. It is zero if the
+	// port forward doesn't expire.
+	ExpiresAt time.Time
 
 	cancel context.CancelFunc
 }
@@ -91,6 +99,7 @@ func (pf *State) Clone() State {
 		Ports:     make([]ForwardedPort, len(pf.Ports)),
 		Target:    pf.Target,
 		Pod:       pf.Pod,
+		ExpiresAt: pf.ExpiresAt,
 		cancel:    pf.cancel,
 	}
 	copy(pfCpy.Ports, pf.Ports)
@@ -109,6 +118,9 @@ type ServiceOptions struct {
 	Config        *restclient.Config
 	ObjectStore   store.Store
 	PortForwarder portForwarder
+	// Expiry is how long port forwards run before they are stopped. Port
+	// forwards run until they are stopped if it is zero.
+	Expiry time.Duration
 }
 
 type forwarderEvent struct {
@@ -159,8 +171,8 @@ func (s *Service) validateCreateRequest(r CreateRequest) error {
 		return errors.New("name field required")
 	}
 
-	if r.APIVersion != "v1" || r.Kind != "Pod" {
-		return errors.Errorf("port forwards only work with pods")
+	if r.APIVersion != "v1" || (r.Kind != "Pod" && r.Kind != "Service") {
+		return errors.Errorf("port forwards only work with pods and services")
 	}
 
 	for _, p := range r.Ports {
@@ -172,27 +184,137 @@ func (s *Service) validateCreateRequest(r CreateRequest) error {
 	return nil
 }
 
+// resolvedPod is the pod a port forward request resolves to.
+type resolvedPod struct {
+	name string
+	// ports maps the requested remote ports to ports on the pod.
+	ports map[uint16]uint16
+}
+
 // resolvePod attempts to resolve a port forward request into an active pod we can
-// forward to. Service/deployments selectors will be resolved into pods and a random
-// one will be chosen. A pod has to be active.
-// Returns: pod name or error.
-func (s *Service) resolvePod(ctx context.Context, r CreateRequest) (string, error) {
+// forward to. Services are resolved to the first running pod their selector
+// matches, and service ports are resolved to the pod's target ports.
+func (s *Service) resolvePod(ctx context.Context, r CreateRequest) (resolvedPod, error) {
 	o := s.opts.ObjectStore
 	if o == nil {
-		return "", errors.New("nil objectstore")
+		return resolvedPod{}, errors.New("nil objectstore")
 	}
 
 	switch {
 	case r.APIVersion == "v1" && r.Kind == "Pod":
 		// Verify pod exists and status is running
 		if ok, err := s.verifyPod(ctx, r.Namespace, r.Name); !ok || err != nil {
-			return "", errors.Errorf("verifying pod %q: %v", r.Name, err)
+			return resolvedPod{}, errors.Errorf("verifying pod %q: %v", r.Name, err)
 		}
-		return r.Name, nil
+
+		ports := make(map[uint16]uint16)
+		for _, p := range r.Ports {
+			ports[p.Remote] = p.Remote
+		}
+
+		return resolvedPod{name: r.Name, ports: ports}, nil
+	case r.APIVersion == "v1" && r.Kind == "Service":
+		return s.resolveServicePod(ctx, r)
 	default:
-		return "", errors.New("not implemented")
+		return resolvedPod{}, errors.New("not implemented")
+	}
+
+}
+
+// resolveServicePod resolves a port forward request for a service into a
+// running pod selected by the service.
+func (s *Service) resolveServicePod(ctx context.Context, r CreateRequest) (resolvedPod, error) {
+	o := s.opts.ObjectStore
+
+	key := store.Key{
+		APIVersion: "v1",
+		Kind:       "Service",
+		Namespace:  r.Namespace,
+		Name:       r.Name,
+	}
+	var service corev1.Service
+	found, err := store.GetAs(ctx, o, key, &service)
+	if err != nil {
+		return resolvedPod{}, err
+	}
+	if !found {
+		return resolvedPod{}, errors.Errorf("service %q not found", r.Name)
+	}
+
+	if len(service.Spec.Selector) == 0 {
+		return resolvedPod{}, errors.Errorf("service %q does not select pods", r.Name)
+	}
+
+	selector := labels.Set(service.Spec.Selector)
+	podKey := store.Key{
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Namespace:  r.Namespace,
+		Selector:   &selector,
+	}
+	list, _, err := o.List(ctx, podKey)
+	if err != nil {
+		return resolvedPod{}, errors.Wrapf(err, "list pods for service %q", r.Name)
+	}
+
+	var pods []corev1.Pod
+	for i := range list.Items {
+		var pod corev1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(list.Items[i].Object, &pod); err != nil {
+			return resolvedPod{}, errors.Wrap(err, "convert pod")
+		}
+		if pod.Status.Phase == corev1.PodRunning {
+			pods = append(pods, pod)
+		}
+	}
+
+	if len(pods) == 0 {
+		return resolvedPod{}, errors.Errorf("service %q has no running pods", r.Name)
+	}
+
+	sort.Slice(pods, func(i, j int) bool {
+		return pods[i].Name < pods[j].Name
+	})
+	pod := &pods[0]
+
+	ports := make(map[uint16]uint16)
+	for _, p := range r.Ports {
+		podPort, err := servicePodPort(&service, pod, p.Remote)
+		if err != nil {
+			return resolvedPod{}, err
+		}
+		ports[p.Remote] = podPort
 	}
 
+	return resolvedPod{name: pod.Name, ports: ports}, nil
+}
+
+// servicePodPort returns the port on a pod a service's port targets.
+func servicePodPort(service *corev1.Service, pod *corev1.Pod, port uint16) (uint16, error) {
+	for _, servicePort := range service.Spec.Ports {
+		if servicePort.Port != int32(port) {
+			continue
+		}
+
+		targetPort := servicePort.TargetPort
+		switch {
+		case targetPort.Type == intstr.String:
+			for _, c := range pod.Spec.Containers {
+				for _, containerPort := range c.Ports {
+					if containerPort.Name == targetPort.StrVal {
+						return uint16(containerPort.ContainerPort), nil
+					}
+				}
+			}
+			return 0, errors.Errorf("pod %q does not have a port named %q", pod.Name, targetPort.StrVal)
+		case targetPort.IntVal != 0:
+			return uint16(targetPort.IntVal), nil
+		default:
+			return port, nil
+		}
+	}
+
+	return 0, errors.Errorf("service %q does not have port %d", service.Name, port)
 }
 
 // verifyPod returns true if the specified pod can be found and is in the running phase.
@@ -229,10 +351,10 @@ func (s *Service) verifyPod(ctx context.Context, namespace, name string) (bool,
 	return true, nil
 }
 
-// createForwarder creates a port forwarder, forwards traffic, and blocks until
+// createForwarder creates a port forwarder to a pod, forwards traffic, and blocks until
 // port state information is populated.
 // Returns forwarder id.
-func (s *Service) createForwarder(r CreateRequest) (string, error) {
+func (s *Service) createForwarder(r CreateRequest, pod resolvedPod) (string, error) {
 	logger := s.logger.With("context", "PortForwardService.createForwarder")
 
 	if s.opts.PortForwarder == nil {
@@ -247,8 +369,20 @@ func (s *Service) createForwarder(r CreateRequest) (string, error) {
 	logger = logger.With("id", forwarderID)
 
 	var ports []string
+	// remotePorts maps ports on the pod back to the requested remote ports.
+	remotePorts := make(map[uint16]uint16)
 	for _, p := range r.Ports {
-		ports = append(ports, fmt.Sprintf("%d:%d", p.Local, p.Remote))
+		podPort, ok := pod.ports[p.Remote]
+		if !ok {
+			return "", errors.Errorf("remote port %d was not resolved", p.Remote)
+		}
+		remotePorts[podPort] = p.Remote
+
+		local := p.Local
+		if local == 0 {
+			local = localPortFor(podPort)
+		}
+		ports = append(ports, fmt.Sprintf("%d:%d", local, podPort))
 	}
 
 	// Target coordinates to preserve in state
@@ -262,9 +396,8 @@ func (s *Service) createForwarder(r CreateRequest) (string, error) {
 	ctx, cancel := context.WithCancel(s.ctx)
 
 	// Spawns goroutine to update state as ports become available
-	portsChannel, portsReady := s.localPortsHandler(ctx, forwarderID)
+	portsChannel, portsReady := s.localPortsHandler(ctx, forwarderID, remotePorts)
 
-	// TODO resolve request gvk/name to pod name
 	o := &s.opts
 	opts := Options{
 		Config:        o.Config,
@@ -287,15 +420,19 @@ func (s *Service) createForwarder(r CreateRequest) (string, error) {
 			Namespace: r.Namespace,
 			Name:      r.Name,
 		},
-		// TODO Target and Pod may be different
 		Pod: Target{
-			GVK:       gvk,
+			GVK:       corev1.SchemeGroupVersion.WithKind("Pod"),
 			Namespace: r.Namespace,
-			Name:      r.Name,
+			Name:      pod.name,
 		},
 		cancel: cancel,
 	}
 
+	if o.Expiry > 0 {
+		forwardState.ExpiresAt = forwardState.CreatedAt.Add(o.Expiry)
+		go s.expireForwarder(ctx, forwarderID, o.Expiry)
+	}
+
 	s.state.Lock()
 	s.state.portForwards[forwarderID] = forwardState
 	s.state.Unlock()
@@ -303,7 +440,7 @@ func (s *Service) createForwarder(r CreateRequest) (string, error) {
 	req := o.RESTClient.Post().
 		Resource("pods").
 		Namespace(r.Namespace).
-		Name(r.Name).
+		Name(pod.name).
 		SubResource("portforward")
 
 	go func() {
@@ -337,6 +474,36 @@ func (s *Service) createForwarder(r CreateRequest) (string, error) {
 	return forwarderID, nil
 }
 
+// expireForwarder stops a port forward once it expires.
+func (s *Service) expireForwarder(ctx context.Context, id string, expiry time.Duration) {
+	timer := time.NewTimer(expiry)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+		s.logger.With("id", id).Debugf("port-forward expired")
+		s.StopForwarder(id)
+	}
+}
+
+// localPortFor returns the remote port if it is free on localhost, so the
+// forward uses the port users expect. Otherwise it returns 0 and a free
+// port is allocated by the forwarder. Privileged ports are never reused.
+func localPortFor(remote uint16) uint16 {
+	if remote < 1024 {
+		return 0
+	}
+
+	l, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", remote))
+	if err != nil {
+		return 0
+	}
+	_ = l.Close()
+
+	return remote
+}
+
 // responseForCreate creates a create response based on the state for the specified forward (by id)
 func (s *Service) responseForCreate(id string) (CreateResponse, error) {
 	var response CreateResponse
@@ -357,10 +524,13 @@ func (s *Service) responseForCreate(id string) (CreateResponse, error) {
 	}
 	response.Ports = rp
 	response.Status = "ok"
+	response.ExpiresAt = state.ExpiresAt
 	return response, nil
 }
 
-func (s *Service) localPortsHandler(ctx context.Context, id string) (portsChan chan []ForwardedPort, portsReady <-chan struct{}) {
+// localPortsHandler updates the state of a port forward once its ports are
+// available. Ports on the pod are reported as the requested remote ports.
+func (s *Service) localPortsHandler(ctx context.Context, id string, remotePorts map[uint16]uint16) (portsChan chan []ForwardedPort, portsReady <-chan struct{}) {
 	logger := s.logger.With("context", "PortForwardService.localPortsHandler", "id", id)
 	portsChan = make(chan []ForwardedPort, 1)
 	readyChan := make(chan struct{})
@@ -370,6 +540,11 @@ func (s *Service) localPortsHandler(ctx context.Context, id string) (portsChan c
 		select {
 		case p := <-portsChan:
 			logger.With("ports", p).Debugf("received ports for port-forward")
+			for i := range p {
+				if remote, ok := remotePorts[p[i].Remote]; ok {
+					p[i].Remote = remote
+				}
+			}
 			if err := s.updatePorts(id, p); err != nil {
 				logger.Warnf("%s", err.Error())
 			}
@@ -447,15 +622,13 @@ func (s *Service) Create(ctx context.Context, gvk schema.GroupVersionKind, name
 		"name", req.Name,
 		"namespace", req.Namespace,
 	).Debugf("resolving pod from object")
-	podName, err := s.resolvePod(ctx, req)
+	pod, err := s.resolvePod(ctx, req)
 	if err != nil {
 		return emptyPortForwardResponse, errors.Wrap(err, "resolving pod")
 	}
-	logger.Debugf("resolved to pod %q", podName)
-	podReq := req
-	podReq.Name = podName
+	logger.Debugf("resolved to pod %q", pod.name)
 
-	id, err := s.createForwarder(req)
+	id, err := s.createForwarder(req, pod)
 	if err != nil {
 		return emptyPortForwardResponse, errors.Wrap(err, "creating forwarder")
 	}
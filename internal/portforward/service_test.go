@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package portforward
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func Test_servicePodPort(t *testing.T) {
+	service := &corev1.Service{
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{Port: 80, TargetPort: intstr.FromInt(8080)},
+				{Port: 443, TargetPort: intstr.FromString("https")},
+				{Port: 9090},
+				{Port: 9091, TargetPort: intstr.FromString("missing")},
+			},
+		},
+	}
+	service.Name = "service"
+
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:  "app",
+					Ports: []corev1.ContainerPort{{Name: "https", ContainerPort: 8443}},
+				},
+			},
+		},
+	}
+	pod.Name = "pod"
+
+	cases := []struct {
+		name     string
+		port     uint16
+		expected uint16
+		errText  string
+	}{
+		{
+			name:     "numeric target port",
+			port:     80,
+			expected: 8080,
+		},
+		{
+			name:     "named target port",
+			port:     443,
+			expected: 8443,
+		},
+		{
+			name:     "no target port",
+			port:     9090,
+			expected: 9090,
+		},
+		{
+			name:    "named target port not on pod",
+			port:    9091,
+			errText: `pod "pod" does not have a port named "missing"`,
+		},
+		{
+			name:    "service does not have port",
+			port:    22,
+			errText: `service "service" does not have port 22`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := servicePodPort(service, pod, tc.port)
+			if tc.errText != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.errText, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func Test_localPortFor(t *testing.T) {
+	l, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	inUse := uint16(l.Addr().(*net.TCPAddr).Port)
+	assert.Equal(t, uint16(0), localPortFor(inUse), "port in use")
+	assert.Equal(t, uint16(0), localPortFor(80), "privileged port")
+}
@@ -0,0 +1,74 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// restrictActions disables the buttons and actions in a flex layout which
+// the current credentials can't perform, and explains why. Nothing is
+// disabled if access can't be checked, since the API server still enforces
+// access when the action runs.
+func restrictActions(ctx context.Context, checker store.AccessChecker, fl *component.FlexLayout) {
+	if checker == nil || fl == nil {
+		return
+	}
+
+	if fl.Config.ButtonGroup != nil {
+		buttons := fl.Config.ButtonGroup.Config.Buttons
+		for i := range buttons {
+			buttons[i].DisabledReason = actionDeniedReason(ctx, checker, buttons[i].Payload)
+		}
+	}
+
+	for _, section := range fl.Config.Sections {
+		for _, item := range section {
+			var actions []component.Action
+			switch view := item.View.(type) {
+			case *component.Summary:
+				actions = view.Config.Actions
+			case *component.Card:
+				actions = view.Config.Actions
+			}
+
+			for i := range actions {
+				actions[i].DisabledReason = actionDeniedReason(ctx, checker, formPayload(actions[i].Form))
+			}
+		}
+	}
+}
+
+// actionDeniedReason returns why the action in a payload can't be
+// performed, or a blank string if it can.
+func actionDeniedReason(ctx context.Context, checker store.AccessChecker, payload action.Payload) string {
+	actionName, err := payload.String("action")
+	if err != nil {
+		return ""
+	}
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return ""
+	}
+
+	return octant.AccessDeniedReason(ctx, checker, actionName, key)
+}
+
+// formPayload returns the payload a form submits with its initial values.
+func formPayload(form component.Form) action.Payload {
+	payload := action.Payload{}
+	for _, field := range form.Fields {
+		payload[field.Name()] = field.Value()
+	}
+
+	return payload
+}
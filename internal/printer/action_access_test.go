@@ -0,0 +1,105 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	octantErrors "github.com/vmware/octant/internal/errors"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/view/component"
+	"github.com/vmware/octant/pkg/view/flexlayout"
+)
+
+// fakeAccessChecker denies the verbs in denied, and fails checks for the
+// verbs in failed.
+type fakeAccessChecker struct {
+	denied map[string]bool
+	failed map[string]bool
+}
+
+func (c *fakeAccessChecker) HasAccess(ctx context.Context, key store.Key, verb string) error {
+	if c.denied[verb] {
+		return octantErrors.NewForbidden("no %s access", verb)
+	}
+	if c.failed[verb] {
+		return errors.New("access review failed")
+	}
+	return nil
+}
+
+func Test_restrictActions(t *testing.T) {
+	deployment := testutil.CreateDeployment("deployment")
+	key, err := store.KeyFromObject(deployment)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	scaleForm, err := component.CreateFormForObject(octant.ActionScale, deployment)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name              string
+		checker           store.AccessChecker
+		expectedDelete    string
+		expectedRestart   string
+		expectedScale     string
+		expectedUnchecked string
+	}{
+		{
+			name:    "allowed",
+			checker: &fakeAccessChecker{},
+		},
+		{
+			name:           "denied",
+			checker:        &fakeAccessChecker{denied: map[string]bool{"delete": true, "patch": true}},
+			expectedDelete: `Your credentials can't delete Deployment "deployment"`,
+			expectedScale:  `Your credentials can't patch Deployment "deployment"`,
+		},
+		{
+			name:    "check fails",
+			checker: &fakeAccessChecker{failed: map[string]bool{"update": true}},
+		},
+		{
+			name: "no checker",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fl := flexlayout.New()
+			fl.AddButton("Delete", action.CreatePayload(octant.ActionDeleteObject, key.ToActionPayload()))
+			fl.AddButton("Restart", action.CreatePayload(octant.ActionRestartRollout, key.ToActionPayload()))
+			fl.AddButton("Unchecked", action.CreatePayload(octant.ActionCheckReachability, key.ToActionPayload()))
+
+			summary := component.NewSummary("Configuration")
+			summary.AddAction(component.Action{Name: "Scale", Form: scaleForm})
+
+			section := fl.AddSection()
+			if err := section.Add(summary, component.WidthHalf); err != nil {
+				t.Fatal(err)
+			}
+
+			view := fl.ToComponent("Summary")
+			restrictActions(context.Background(), test.checker, view)
+
+			buttons := view.Config.ButtonGroup.Config.Buttons
+			assert.Equal(t, test.expectedDelete, buttons[0].DisabledReason)
+			assert.Equal(t, test.expectedRestart, buttons[1].DisabledReason)
+			assert.Equal(t, test.expectedUnchecked, buttons[2].DisabledReason)
+			assert.Equal(t, test.expectedScale, summary.Config.Actions[0].DisabledReason)
+		})
+	}
+}
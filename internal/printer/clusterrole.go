@@ -7,10 +7,15 @@ package printer
 
 import (
 	"context"
+	"fmt"
 	"sort"
 
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kLabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware/octant/pkg/store"
 	"github.com/vmware/octant/pkg/view/component"
 
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -34,12 +39,18 @@ func ClusterRoleListHandler(_ context.Context, list *rbacv1.ClusterRoleList, opt
 
 		row["Name"] = nameLink
 		ts := clusterRole.CreationTimestamp.Time
-		row["Age"] = component.NewTimestamp(ts)
+		row["Age"] = options.Timestamp(ts)
+
+		if err := options.TableActions.AddRowActions(row, &clusterRole); err != nil {
+			return nil, err
+		}
 
 		tbl.Add(row)
 	}
 
-	return tbl, nil
+	options.TableActions.AddBulkActions(tbl)
+
+	return options.Profile.Apply(tbl), nil
 }
 
 // ClusterRoleHandler is a printFunc that prints a cluster role
@@ -59,6 +70,10 @@ func ClusterRoleHandler(ctx context.Context, clusterRole *rbacv1.ClusterRole, op
 		return nil, errors.Wrap(err, "print clusterrole policy rules")
 	}
 
+	if err := ch.Aggregation(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print clusterrole aggregation")
+	}
+
 	return o.ToComponent(ctx, options)
 }
 
@@ -114,6 +129,123 @@ func createClusterRolePolicyRulesView(clusterRole *rbacv1.ClusterRole) (*compone
 	return printPolicyRules(clusterRole.Rules)
 }
 
+// createClusterRoleAggregationView creates a table listing the cluster roles
+// which are aggregated into an aggregated cluster role.
+func createClusterRoleAggregationView(ctx context.Context, clusterRole *rbacv1.ClusterRole, options Options) (*component.Table, error) {
+	sources, err := aggregatedClusterRoles(ctx, clusterRole, options.DashConfig.ObjectStore())
+	if err != nil {
+		return nil, err
+	}
+
+	cols := component.NewTableCols("Name", "Rules", "Age")
+	table := component.NewTable("Aggregated Cluster Roles",
+		"There are no cluster roles matching the aggregation rule!", cols)
+
+	for i := range sources {
+		nameLink, err := options.Link.ForObject(&sources[i], sources[i].Name)
+		if err != nil {
+			return nil, err
+		}
+
+		table.Add(component.TableRow{
+			"Name":  nameLink,
+			"Rules": component.NewText(fmt.Sprintf("%d", len(sources[i].Rules))),
+			"Age":   options.Timestamp(sources[i].CreationTimestamp.Time),
+		})
+	}
+
+	return table, nil
+}
+
+// createClusterRoleAggregatedRulesView creates a table of the rules merged from
+// the cluster roles aggregated into an aggregated cluster role. Verbs are grouped per resource.
+func createClusterRoleAggregatedRulesView(ctx context.Context, clusterRole *rbacv1.ClusterRole, options Options) (*component.Table, error) {
+	sources, err := aggregatedClusterRoles(ctx, clusterRole, options.DashConfig.ObjectStore())
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []rbacv1.PolicyRule
+	for _, source := range sources {
+		rules = append(rules, source.Rules...)
+	}
+
+	cols := component.NewTableCols("Resources", "Non-Resource URLs", "Resource Names", "Verbs")
+	table := component.NewTable("Aggregated Policy Rules", "There are no aggregated policy rules!", cols)
+
+	for _, r := range groupRulesByResource(rules) {
+		table.Add(component.TableRow{
+			"Resources":         component.NewText(CombineResourceGroup(r.Resources, r.APIGroups)),
+			"Non-Resource URLs": component.NewText(printSlice(r.NonResourceURLs)),
+			"Resource Names":    component.NewText(printSlice(r.ResourceNames)),
+			"Verbs":             component.NewText(printSlice(r.Verbs)),
+		})
+	}
+
+	return table, nil
+}
+
+// aggregatedClusterRoles returns the cluster roles matching any of a cluster role's
+// aggregation selectors, sorted by name.
+func aggregatedClusterRoles(ctx context.Context, clusterRole *rbacv1.ClusterRole, objectStore store.Store) ([]rbacv1.ClusterRole, error) {
+	if clusterRole == nil {
+		return nil, errors.New("cluster role is nil")
+	}
+
+	if objectStore == nil {
+		return nil, errors.New("object store is nil")
+	}
+
+	if clusterRole.AggregationRule == nil {
+		return nil, nil
+	}
+
+	var selectors []kLabels.Selector
+	for i := range clusterRole.AggregationRule.ClusterRoleSelectors {
+		selector, err := metav1.LabelSelectorAsSelector(&clusterRole.AggregationRule.ClusterRoleSelectors[i])
+		if err != nil {
+			return nil, errors.Wrap(err, "convert aggregation rule selector")
+		}
+		selectors = append(selectors, selector)
+	}
+
+	key := store.Key{
+		APIVersion: "rbac.authorization.k8s.io/v1",
+		Kind:       "ClusterRole",
+	}
+
+	list, _, err := objectStore.List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list all objects for key %s", key)
+	}
+
+	var sources []rbacv1.ClusterRole
+
+	for i := range list.Items {
+		source := rbacv1.ClusterRole{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(list.Items[i].Object, &source); err != nil {
+			return nil, errors.Wrap(err, "convert unstructured cluster role")
+		}
+
+		if source.Name == clusterRole.Name {
+			continue
+		}
+
+		for _, selector := range selectors {
+			if selector.Matches(kLabels.Set(source.Labels)) {
+				sources = append(sources, source)
+				break
+			}
+		}
+	}
+
+	sort.Slice(sources, func(i, j int) bool {
+		return sources[i].Name < sources[j].Name
+	})
+
+	return sources, nil
+}
+
 func printPolicyRules(rules []rbacv1.PolicyRule) (*component.Table, error) {
 	breakdownRules := []rbacv1.PolicyRule{}
 	for _, rule := range rules {
@@ -148,13 +280,16 @@ func printPolicyRules(rules []rbacv1.PolicyRule) (*component.Table, error) {
 type clusterRoleObject interface {
 	Config(options Options) error
 	PolicyRules(options Options) error
+	Aggregation(ctx context.Context, options Options) error
 }
 
 type clusterRoleHandler struct {
-	clusterRole     *rbacv1.ClusterRole
-	configFunc      func(*rbacv1.ClusterRole, Options) (*component.Summary, error)
-	policyRulesFunc func(*rbacv1.ClusterRole, Options) (*component.Table, error)
-	object          *Object
+	clusterRole         *rbacv1.ClusterRole
+	configFunc          func(*rbacv1.ClusterRole, Options) (*component.Summary, error)
+	policyRulesFunc     func(*rbacv1.ClusterRole, Options) (*component.Table, error)
+	aggregationFunc     func(context.Context, *rbacv1.ClusterRole, Options) (*component.Table, error)
+	aggregatedRulesFunc func(context.Context, *rbacv1.ClusterRole, Options) (*component.Table, error)
+	object              *Object
 }
 
 var _ clusterRoleObject = (*clusterRoleHandler)(nil)
@@ -169,10 +304,12 @@ func newClusterRoleHandler(clusterRole *rbacv1.ClusterRole, object *Object) (*cl
 	}
 
 	ch := &clusterRoleHandler{
-		clusterRole:     clusterRole,
-		configFunc:      defaultClusterRoleConfig,
-		policyRulesFunc: defaultClusterRolePolicyRules,
-		object:          object,
+		clusterRole:         clusterRole,
+		configFunc:          defaultClusterRoleConfig,
+		policyRulesFunc:     defaultClusterRolePolicyRules,
+		aggregationFunc:     createClusterRoleAggregationView,
+		aggregatedRulesFunc: createClusterRoleAggregatedRulesView,
+		object:              object,
 	}
 	return ch, nil
 }
@@ -208,3 +345,29 @@ func (c *clusterRoleHandler) PolicyRules(options Options) error {
 func defaultClusterRolePolicyRules(clusterRole *rbacv1.ClusterRole, options Options) (*component.Table, error) {
 	return createClusterRolePolicyRulesView(clusterRole)
 }
+
+func (c *clusterRoleHandler) Aggregation(ctx context.Context, options Options) error {
+	if c.clusterRole == nil {
+		return errors.New("can't display aggregation for nil clusterrole")
+	}
+
+	if c.clusterRole.AggregationRule == nil {
+		return nil
+	}
+
+	c.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return c.aggregationFunc(ctx, c.clusterRole, options)
+		},
+	})
+
+	c.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return c.aggregatedRulesFunc(ctx, c.clusterRole, options)
+		},
+	})
+
+	return nil
+}
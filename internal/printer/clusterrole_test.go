@@ -15,6 +15,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/store"
 	"github.com/vmware/octant/pkg/view/component"
 )
 
@@ -118,3 +119,103 @@ func Test_createClusterRolePolicyRulesView(t *testing.T) {
 
 	component.AssertEqual(t, expected, observed)
 }
+
+func Test_createClusterRoleAggregationViews(t *testing.T) {
+	now := testutil.Time()
+
+	aggregated := testutil.CreateClusterRole("monitoring")
+	aggregated.Rules = nil
+	aggregated.Labels = map[string]string{"rbac.example.com/aggregate-to-monitoring": "true"}
+	aggregated.AggregationRule = &rbacv1.AggregationRule{
+		ClusterRoleSelectors: []metav1.LabelSelector{
+			{MatchLabels: map[string]string{"rbac.example.com/aggregate-to-monitoring": "true"}},
+		},
+	}
+
+	source1 := testutil.CreateClusterRole("monitoring-endpoints")
+	source1.CreationTimestamp = metav1.Time{Time: now}
+	source1.Labels = map[string]string{"rbac.example.com/aggregate-to-monitoring": "true"}
+	source1.Rules = []rbacv1.PolicyRule{
+		{APIGroups: []string{""}, Resources: []string{"services", "pods"}, Verbs: []string{"list", "get"}},
+	}
+
+	source2 := testutil.CreateClusterRole("monitoring-pods")
+	source2.CreationTimestamp = metav1.Time{Time: now}
+	source2.Labels = map[string]string{"rbac.example.com/aggregate-to-monitoring": "true"}
+	source2.Rules = []rbacv1.PolicyRule{
+		{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"watch", "get"}},
+		{NonResourceURLs: []string{"/metrics"}, Verbs: []string{"get"}},
+	}
+
+	other := testutil.CreateClusterRole("other")
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	key := store.Key{
+		APIVersion: "rbac.authorization.k8s.io/v1",
+		Kind:       "ClusterRole",
+	}
+
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), gomock.Eq(key)).
+		Return(testutil.ToUnstructuredList(t, source2, aggregated, other, source1), false, nil).
+		Times(2)
+
+	tpo.PathForObject(source1, source1.Name, "/source1")
+	tpo.PathForObject(source2, source2.Name, "/source2")
+
+	ctx := context.Background()
+
+	got, err := createClusterRoleAggregationView(ctx, aggregated, printOptions)
+	require.NoError(t, err)
+
+	cols := component.NewTableCols("Name", "Rules", "Age")
+	expected := component.NewTable("Aggregated Cluster Roles",
+		"There are no cluster roles matching the aggregation rule!", cols)
+	expected.Add([]component.TableRow{
+		{
+			"Name":  component.NewLink("", source1.Name, "/source1"),
+			"Rules": component.NewText("1"),
+			"Age":   component.NewTimestamp(now),
+		},
+		{
+			"Name":  component.NewLink("", source2.Name, "/source2"),
+			"Rules": component.NewText("2"),
+			"Age":   component.NewTimestamp(now),
+		},
+	}...)
+
+	component.AssertEqual(t, expected, got)
+
+	got, err = createClusterRoleAggregatedRulesView(ctx, aggregated, printOptions)
+	require.NoError(t, err)
+
+	cols = component.NewTableCols("Resources", "Non-Resource URLs", "Resource Names", "Verbs")
+	expected = component.NewTable("Aggregated Policy Rules", "There are no aggregated policy rules!", cols)
+	expected.Add([]component.TableRow{
+		{
+			"Resources":         component.NewText("pods"),
+			"Non-Resource URLs": component.NewText(""),
+			"Resource Names":    component.NewText(""),
+			"Verbs":             component.NewText("['get', 'list', 'watch']"),
+		},
+		{
+			"Resources":         component.NewText("services"),
+			"Non-Resource URLs": component.NewText(""),
+			"Resource Names":    component.NewText(""),
+			"Verbs":             component.NewText("['get', 'list']"),
+		},
+		{
+			"Resources":         component.NewText(""),
+			"Non-Resource URLs": component.NewText("['/metrics']"),
+			"Resource Names":    component.NewText(""),
+			"Verbs":             component.NewText("['get']"),
+		},
+	}...)
+
+	component.AssertEqual(t, expected, got)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/pkg/errors"
 	rbacv1 "k8s.io/api/rbac/v1"
 
+	"github.com/vmware/octant/internal/gvk"
 	"github.com/vmware/octant/pkg/view/component"
 )
 
@@ -34,8 +35,8 @@ func ClusterRoleBindingListHandler(_ context.Context, clusterRoleBindingList *rb
 
 		row["Name"] = nameLink
 
-		row["Labels"] = component.NewLabels(roleBinding.Labels)
-		row["Age"] = component.NewTimestamp(roleBinding.CreationTimestamp.Time)
+		row["Labels"] = options.LabelLinks.Labels(roleBinding.Labels, "", gvk.ClusterRoleBinding)
+		row["Age"] = options.Timestamp(roleBinding.CreationTimestamp.Time)
 		row["Role kind"] = component.NewText(roleBinding.RoleRef.Kind)
 
 		roleName, err := roleLinkFromClusterRoleBinding(&roleBinding, options)
@@ -45,10 +46,16 @@ func ClusterRoleBindingListHandler(_ context.Context, clusterRoleBindingList *rb
 
 		row["Role name"] = roleName
 
+		if err := options.TableActions.AddRowActions(row, &roleBinding); err != nil {
+			return nil, err
+		}
+
 		table.Add(row)
 	}
 
-	return table, nil
+	options.TableActions.AddBulkActions(table)
+
+	return options.Profile.Apply(table), nil
 }
 
 func roleLinkFromClusterRoleBinding(clusterRoleBinding *rbacv1.ClusterRoleBinding, options Options) (*component.Link, error) {
@@ -80,6 +87,10 @@ func ClusterRoleBindingHandler(ctx context.Context, clusterRoleBinding *rbacv1.C
 		return nil, errors.Wrap(err, "print clusterrolebinding subjects")
 	}
 
+	if err := ch.PolicyRules(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print clusterrolebinding policy rules")
+	}
+
 	return o.ToComponent(ctx, options)
 }
 
@@ -116,35 +127,25 @@ func (c *ClusterRoleBindingConfiguration) Create(ctx context.Context, options Op
 	return summary, nil
 }
 
-func createClusterRoleBindingSubjectsView(clusterRoleBinding *rbacv1.ClusterRoleBinding) (component.Component, error) {
+func createClusterRoleBindingSubjectsView(ctx context.Context, clusterRoleBinding *rbacv1.ClusterRoleBinding, options Options) (component.Component, error) {
 	if clusterRoleBinding == nil {
 		return nil, errors.New("cluster role binding is nil")
 	}
 
-	columns := component.NewTableCols("Kind", "Name", "Namespace")
-	table := component.NewTable("Subjects", "There are no subjects!", columns)
-
-	for _, subject := range clusterRoleBinding.Subjects {
-		row := component.TableRow{}
-		row["Kind"] = component.NewText(subject.Kind)
-		row["Name"] = component.NewText(subject.Name)
-		row["Namespace"] = component.NewText(subject.Namespace)
-
-		table.Add(row)
-	}
-
-	return table, nil
+	return createSubjectsView(ctx, clusterRoleBinding.Subjects, "", options)
 }
 
 type clusterRoleBindingObject interface {
 	Config(ctx context.Context, options Options) error
 	Subjects(ctx context.Context, options Options) error
+	PolicyRules(ctx context.Context, options Options) error
 }
 
 type clusterRoleBindingHandler struct {
 	clusterRoleBinding *rbacv1.ClusterRoleBinding
 	configFunc         func(context.Context, *rbacv1.ClusterRoleBinding, Options) (*component.Summary, error)
 	subjectsFunc       func(context.Context, *rbacv1.ClusterRoleBinding, Options) (component.Component, error)
+	policyRulesFunc    func(context.Context, *rbacv1.ClusterRoleBinding, Options) (component.Component, error)
 	object             *Object
 }
 
@@ -152,7 +153,7 @@ var _ clusterRoleBindingObject = (*clusterRoleBindingHandler)(nil)
 
 func newClusterRoleBindingHandler(clusterRoleBinding *rbacv1.ClusterRoleBinding, object *Object) (*clusterRoleBindingHandler, error) {
 	if clusterRoleBinding == nil {
-		return nil, errors.New("can't print a nil rolebinding")
+		return nil, errors.New("can't print a nil clusterrolebinding")
 	}
 
 	if object == nil {
@@ -163,6 +164,7 @@ func newClusterRoleBindingHandler(clusterRoleBinding *rbacv1.ClusterRoleBinding,
 		clusterRoleBinding: clusterRoleBinding,
 		configFunc:         defaultClusterRoleBindingConfig,
 		subjectsFunc:       defaultClusterRoleBindingSubjects,
+		policyRulesFunc:    defaultClusterRoleBindingPolicyRules,
 		object:             object,
 	}
 
@@ -184,7 +186,7 @@ func defaultClusterRoleBindingConfig(ctx context.Context, clusterRoleBinding *rb
 
 func (c *clusterRoleBindingHandler) Subjects(ctx context.Context, options Options) error {
 	if c.clusterRoleBinding == nil {
-		return errors.New("can't display subjects for nil rolebinding")
+		return errors.New("can't display subjects for nil clusterrolebinding")
 	}
 
 	c.object.RegisterItems(ItemDescriptor{
@@ -197,5 +199,23 @@ func (c *clusterRoleBindingHandler) Subjects(ctx context.Context, options Option
 }
 
 func defaultClusterRoleBindingSubjects(ctx context.Context, clusterRoleBinding *rbacv1.ClusterRoleBinding, options Options) (component.Component, error) {
-	return createClusterRoleBindingSubjectsView(clusterRoleBinding)
+	return createClusterRoleBindingSubjectsView(ctx, clusterRoleBinding, options)
+}
+
+func (c *clusterRoleBindingHandler) PolicyRules(ctx context.Context, options Options) error {
+	if c.clusterRoleBinding == nil {
+		return errors.New("can't display policy rules for nil clusterrolebinding")
+	}
+
+	c.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return c.policyRulesFunc(ctx, c.clusterRoleBinding, options)
+		},
+	})
+	return nil
+}
+
+func defaultClusterRoleBindingPolicyRules(ctx context.Context, clusterRoleBinding *rbacv1.ClusterRoleBinding, options Options) (component.Component, error) {
+	return createRoleRefPolicyRulesView(ctx, clusterRoleBinding.RoleRef, "", options)
 }
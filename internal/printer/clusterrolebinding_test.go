@@ -138,16 +138,24 @@ func Test_createClusterRoleBindingSubjectsView(t *testing.T) {
 	clusterRoleBinding.Labels = labels
 	clusterRoleBinding.CreationTimestamp = metav1.Time{Time: now}
 
-	observed, err := createClusterRoleBindingSubjectsView(clusterRoleBinding)
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	ctx := context.Background()
+	observed, err := createClusterRoleBindingSubjectsView(ctx, clusterRoleBinding, printOptions)
 	require.NoError(t, err)
 
-	columns := component.NewTableCols("Kind", "Name", "Namespace")
+	columns := component.NewTableCols("Kind", "Name", "Namespace", "Status")
 	expected := component.NewTable("Subjects", "There are no subjects!", columns)
 
 	row := component.TableRow{}
 	row["Kind"] = component.NewText("User")
 	row["Name"] = component.NewText("test@example.com")
 	row["Namespace"] = component.NewText("")
+	row["Status"] = component.NewText("")
 
 	expected.Add(row)
 
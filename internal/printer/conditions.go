@@ -0,0 +1,90 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+var (
+	conditionColumns = component.NewTableCols("Type", "Status", "Reason", "Message", "Last Update", "Last Transition")
+
+	// abnormalWhenTrue are condition types which describe a problem, so
+	// they are abnormal when their status is true.
+	abnormalWhenTrue = map[string]bool{
+		"ReplicaFailure":     true,
+		"Failed":             true,
+		"MemoryPressure":     true,
+		"DiskPressure":       true,
+		"PIDPressure":        true,
+		"NetworkUnavailable": true,
+		"OutOfDisk":          true,
+	}
+)
+
+// condition is a resource condition. Resources have their own condition
+// types, so they are converted to this type before they are printed.
+type condition struct {
+	Type    string
+	Status  corev1.ConditionStatus
+	Reason  string
+	Message string
+	// LastUpdate is the last time the condition was updated or probed.
+	LastUpdate     metav1.Time
+	LastTransition metav1.Time
+}
+
+// severity returns the severity of a condition. Conditions with an unknown
+// status are warnings, and conditions in an abnormal state are errors.
+func (c condition) severity() component.NodeStatus {
+	switch c.Status {
+	case corev1.ConditionTrue, corev1.ConditionFalse:
+		isTrue := c.Status == corev1.ConditionTrue
+		if isTrue != abnormalWhenTrue[c.Type] {
+			return component.NodeStatusOK
+		}
+		return component.NodeStatusError
+	default:
+		return component.NodeStatusWarning
+	}
+}
+
+// createConditionsTable creates a table for conditions. The most recently
+// transitioned conditions are listed first, and the status of each condition
+// is highlighted by its severity.
+func createConditionsTable(kind string, conditions []condition, options Options) *component.Table {
+	placeholder := fmt.Sprintf("There are no %s conditions!", kind)
+	table := component.NewTable("Conditions", placeholder, conditionColumns)
+
+	sorted := make([]condition, len(conditions))
+	copy(sorted, conditions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i].LastTransition.Time, sorted[j].LastTransition.Time
+		if a.Equal(b) {
+			return sorted[i].Type < sorted[j].Type
+		}
+		return a.After(b)
+	})
+
+	for _, c := range sorted {
+		table.Add(component.TableRow{
+			"Type":            component.NewText(c.Type),
+			"Status":          component.NewStatusText(string(c.Status), c.severity()),
+			"Reason":          component.NewText(c.Reason),
+			"Message":         component.NewText(c.Message),
+			"Last Update":     options.Timestamp(c.LastUpdate.Time),
+			"Last Transition": options.Timestamp(c.LastTransition.Time),
+		})
+	}
+
+	return table
+}
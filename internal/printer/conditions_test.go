@@ -0,0 +1,99 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func Test_condition_severity(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition condition
+		expected  component.NodeStatus
+	}{
+		{
+			name:      "true",
+			condition: condition{Type: "Ready", Status: corev1.ConditionTrue},
+			expected:  component.NodeStatusOK,
+		},
+		{
+			name:      "false",
+			condition: condition{Type: "Ready", Status: corev1.ConditionFalse},
+			expected:  component.NodeStatusError,
+		},
+		{
+			name:      "unknown",
+			condition: condition{Type: "Ready", Status: corev1.ConditionUnknown},
+			expected:  component.NodeStatusWarning,
+		},
+		{
+			name:      "abnormal when true",
+			condition: condition{Type: "MemoryPressure", Status: corev1.ConditionTrue},
+			expected:  component.NodeStatusError,
+		},
+		{
+			name:      "abnormal when true is false",
+			condition: condition{Type: "MemoryPressure", Status: corev1.ConditionFalse},
+			expected:  component.NodeStatusOK,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.condition.severity())
+		})
+	}
+}
+
+func Test_createConditionsTable(t *testing.T) {
+	older := metav1.Time{Time: time.Date(2019, 10, 16, 12, 0, 0, 0, time.UTC)}
+	newer := metav1.Time{Time: older.Add(time.Minute)}
+
+	conditions := []condition{
+		{Type: "Ready", Status: corev1.ConditionTrue, LastTransition: older},
+		{Type: "DiskPressure", Status: corev1.ConditionTrue, Reason: "reason", LastTransition: newer},
+		{Type: "MemoryPressure", Status: corev1.ConditionFalse, LastTransition: older},
+	}
+
+	got := createConditionsTable("node", conditions, Options{})
+
+	expected := component.NewTableWithRows("Conditions", "There are no node conditions!", conditionColumns, []component.TableRow{
+		{
+			"Type":            component.NewText("DiskPressure"),
+			"Status":          component.NewStatusText("True", component.NodeStatusError),
+			"Reason":          component.NewText("reason"),
+			"Message":         component.NewText(""),
+			"Last Update":     component.NewTimestamp(time.Time{}),
+			"Last Transition": component.NewTimestamp(newer.Time),
+		},
+		{
+			"Type":            component.NewText("MemoryPressure"),
+			"Status":          component.NewStatusText("False", component.NodeStatusOK),
+			"Reason":          component.NewText(""),
+			"Message":         component.NewText(""),
+			"Last Update":     component.NewTimestamp(time.Time{}),
+			"Last Transition": component.NewTimestamp(older.Time),
+		},
+		{
+			"Type":            component.NewText("Ready"),
+			"Status":          component.NewStatusText("True", component.NodeStatusOK),
+			"Reason":          component.NewText(""),
+			"Message":         component.NewText(""),
+			"Last Update":     component.NewTimestamp(time.Time{}),
+			"Last Transition": component.NewTimestamp(older.Time),
+		},
+	})
+
+	component.AssertEqual(t, expected, got)
+}
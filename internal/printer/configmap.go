@@ -9,9 +9,12 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/pkg/errors"
 
+	"github.com/vmware/octant/internal/gvk"
+	"github.com/vmware/octant/internal/octant"
 	"github.com/vmware/octant/pkg/view/component"
 
 	corev1 "k8s.io/api/core/v1"
@@ -37,18 +40,24 @@ func ConfigMapListHandler(_ context.Context, list *corev1.ConfigMapList, opts Op
 
 		row["Name"] = nameLink
 
-		row["Labels"] = component.NewLabels(c.Labels)
+		row["Labels"] = opts.LabelLinks.Labels(c.Labels, c.Namespace, gvk.ConfigMap)
 
 		data := fmt.Sprintf("%d", len(c.Data))
 		row["Data"] = component.NewText(data)
 
 		ts := c.CreationTimestamp.Time
-		row["Age"] = component.NewTimestamp(ts)
+		row["Age"] = opts.Timestamp(ts)
+
+		if err := opts.TableActions.AddRowActions(row, &c); err != nil {
+			return nil, err
+		}
 
 		tbl.Add(row)
 	}
 
-	return tbl, nil
+	opts.TableActions.AddBulkActions(tbl)
+
+	return opts.Profile.Apply(tbl), nil
 }
 
 // ConfigMapHandler is a printFunc that prints a ConfigMap
@@ -95,13 +104,62 @@ func (c *ConfigMapConfiguration) Create(options Options) (*component.Summary, er
 	creationTimestamp := configMap.CreationTimestamp.Time
 	sections = append(sections, component.SummarySection{
 		Header:  "Age",
-		Content: component.NewTimestamp(creationTimestamp),
+		Content: options.Timestamp(creationTimestamp),
 	})
 
 	summary := component.NewSummary("Configuration", sections...)
+
+	if len(configMap.Data) > 0 {
+		action, err := editConfigMapDataAction(configMap)
+		if err != nil {
+			return nil, errors.Wrap(err, "create config map data editor")
+		}
+		summary.AddAction(action)
+	}
+
+	var keys []string
+	for k := range configMap.Data {
+		keys = append(keys, k)
+	}
+
+	actions, err := dataKeyActions(configMap, keys)
+	if err != nil {
+		return nil, errors.Wrap(err, "create config map data key actions")
+	}
+	for _, action := range actions {
+		summary.AddAction(action)
+	}
+
 	return summary, nil
 }
 
+// editConfigMapDataAction creates an action which edits each of a config
+// map's data keys.
+func editConfigMapDataAction(configMap *corev1.ConfigMap) (component.Action, error) {
+	var keys []string
+	for k := range configMap.Data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var fields []component.FormField
+	for _, k := range keys {
+		fields = append(fields, component.NewFormFieldTextarea(k, octant.ConfigMapDataField(k), configMap.Data[k]))
+	}
+
+	form, err := component.CreateFormForObject(octant.ActionEditConfigMapData, configMap, fields...)
+	if err != nil {
+		return component.Action{}, err
+	}
+
+	return component.Action{
+		Name:           "Edit Data",
+		Title:          "ConfigMap Data Editor",
+		Form:           form,
+		ConfirmChanges: true,
+	}, nil
+}
+
 // describeDataTable returns a table containing configmap data
 func describeConfigMapData(cm *corev1.ConfigMap) (*component.Table, error) {
 	if cm == nil {
@@ -144,7 +202,9 @@ func describeConfigMapDataRows(cm *corev1.ConfigMap) ([]component.TableRow, erro
 
 		row["Key"] = component.NewText(k)
 
-		row["Value"] = component.NewText(data[k])
+		value := component.NewCode("", component.CodeLanguageForName(k), data[k])
+		value.Config.LineNumbers = strings.Contains(data[k], "\n")
+		row["Value"] = value
 	}
 
 	return rows, nil
@@ -78,6 +78,8 @@ func Test_ConfigMapConfiguration(t *testing.T) {
 		},
 	}
 
+	configMapWithoutData := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.Time{Time: testutil.Time()}}}
+
 	cases := []struct {
 		name      string
 		configMap *corev1.ConfigMap
@@ -87,12 +89,45 @@ func Test_ConfigMapConfiguration(t *testing.T) {
 		{
 			name:      "configmap",
 			configMap: validConfigMap,
-			expected: component.NewSummary("Configuration", []component.SummarySection{
-				{
-					Header:  "Age",
-					Content: component.NewTimestamp(testutil.Time()),
-				},
-			}...),
+			expected: func() *component.Summary {
+				summary := component.NewSummary("Configuration", []component.SummarySection{
+					{
+						Header:  "Age",
+						Content: component.NewTimestamp(testutil.Time()),
+					},
+				}...)
+				summary.AddAction(component.Action{
+					Name:  "Edit Data",
+					Title: "ConfigMap Data Editor",
+					Form: component.Form{
+						Fields: []component.FormField{
+							component.NewFormFieldTextarea("log_level", "data.log_level", "INFO"),
+							component.NewFormFieldHidden("apiVersion", "v1"),
+							component.NewFormFieldHidden("kind", "ConfigMap"),
+							component.NewFormFieldHidden("name", "env-config"),
+							component.NewFormFieldHidden("namespace", ""),
+							component.NewFormFieldHidden("action", "overview/configMapDataEditor"),
+						},
+					},
+					ConfirmChanges: true,
+				})
+				addDataKeyActions(t, summary, validConfigMap, "log_level")
+				return summary
+			}(),
+		},
+		{
+			name:      "configmap without data",
+			configMap: configMapWithoutData,
+			expected: func() *component.Summary {
+				summary := component.NewSummary("Configuration", []component.SummarySection{
+					{
+						Header:  "Age",
+						Content: component.NewTimestamp(testutil.Time()),
+					},
+				}...)
+				addDataKeyActions(t, summary, configMapWithoutData)
+				return summary
+			}(),
 		},
 		{
 			name:      "configmap is nil",
@@ -126,7 +161,8 @@ func Test_ConfigMapConfiguration(t *testing.T) {
 func Test_describeConfigMapData(t *testing.T) {
 	configMap := testutil.CreateConfigMap("configmap")
 	configMap.Data = map[string]string{
-		"foo": "bar",
+		"foo":         "bar",
+		"config.yaml": "a: 1\nb: 2",
 	}
 
 	got, err := describeConfigMapData(configMap)
@@ -134,10 +170,16 @@ func Test_describeConfigMapData(t *testing.T) {
 
 	cols := component.NewTableCols("Key", "Value")
 	expected := component.NewTable("Data", "No data has been configured for this config map!", cols)
+	configValue := component.NewCode("", "yaml", "a: 1\nb: 2")
+	configValue.Config.LineNumbers = true
 	expected.Add([]component.TableRow{
+		{
+			"Key":   component.NewText("config.yaml"),
+			"Value": configValue,
+		},
 		{
 			"Key":   component.NewText("foo"),
-			"Value": component.NewText("bar"),
+			"Value": component.NewCode("", "", "bar"),
 		},
 	}...)
 
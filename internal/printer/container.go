@@ -6,20 +6,25 @@ SPDX-License-Identifier: Apache-2.0
 package printer
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"path"
+	"sort"
 	"strings"
 
+	"github.com/vmware/octant/internal/octant"
 	"github.com/vmware/octant/internal/portforward"
 
 	"github.com/pkg/errors"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	"github.com/vmware/octant/pkg/store"
 	"github.com/vmware/octant/pkg/view/component"
 )
 
@@ -44,7 +49,7 @@ func NewContainerConfiguration(parent runtime.Object, c *corev1.Container, pfs p
 }
 
 // Create creates a deployment configuration summary.
-func (cc *ContainerConfiguration) Create() (*component.Summary, error) {
+func (cc *ContainerConfiguration) Create(ctx context.Context) (*component.Summary, error) {
 	if cc == nil || cc.container == nil {
 		return nil, errors.New("container is nil")
 	}
@@ -92,7 +97,7 @@ func (cc *ContainerConfiguration) Create() (*component.Summary, error) {
 		actions = append(actions, editAction)
 	}
 
-	envTbl, err := describeContainerEnv(cc.parent, c, cc.options)
+	envTbl, err := describeContainerEnv(ctx, cc.parent, c, cc.options)
 	if err != nil {
 		return nil, errors.Wrap(err, "describing environment")
 	}
@@ -263,8 +268,11 @@ func describeContainerHostPorts(cPorts []corev1.ContainerPort) string {
 	return strings.Join(ports, ", ")
 }
 
-// describeContainerEnv returns a table describing a container environment
-func describeContainerEnv(parent runtime.Object, c *corev1.Container, options Options) (*component.Table, error) {
+// describeContainerEnv returns a table describing a container environment.
+// Values from config maps are resolved, and references to config maps,
+// secrets, or keys which do not exist are flagged. Secret values are
+// never shown.
+func describeContainerEnv(ctx context.Context, parent runtime.Object, c *corev1.Container, options Options) (*component.Table, error) {
 	if c == nil {
 		return nil, errors.New("container is nil")
 	}
@@ -281,12 +289,14 @@ func describeContainerEnv(parent runtime.Object, c *corev1.Container, options Op
 	cols := component.NewTableCols("Name", "Value", "Source")
 	tbl := component.NewTable("Environment", "There are no defined environment variables!", cols)
 
-	envRows, err := describeEnvRows(ns, c.Env, options)
+	sources := newEnvSources(ns, options)
+
+	envRows, err := describeEnvRows(ctx, sources, c.Env, options)
 	if err != nil {
 		return nil, err
 	}
 	tbl.Add(envRows...)
-	envFromRows, err := describeEnvFromRows(ns, c.EnvFrom, options)
+	envFromRows, err := describeEnvFromRows(ctx, sources, c.EnvFrom, options)
 	if err != nil {
 		return nil, err
 	}
@@ -296,7 +306,7 @@ func describeContainerEnv(parent runtime.Object, c *corev1.Container, options Op
 
 // describeEnvRows renders container environment variables as table rows.
 // Expected columns: Name, Value, Source
-func describeEnvRows(namespace string, vars []corev1.EnvVar, options Options) ([]component.TableRow, error) {
+func describeEnvRows(ctx context.Context, sources *envSources, vars []corev1.EnvVar, options Options) ([]component.TableRow, error) {
 	rows := make([]component.TableRow, 0)
 	for _, e := range vars {
 		row := component.TableRow{}
@@ -306,7 +316,7 @@ func describeEnvRows(namespace string, vars []corev1.EnvVar, options Options) ([
 		row["Source"] = component.NewText("")
 
 		row["Name"] = component.NewText(e.Name)
-		row["Value"] = component.NewText(e.Value) // TODO should we resolve values when source is a reference (valueFrom)?
+		row["Value"] = component.NewText(e.Value)
 		if e.Value != "" || e.ValueFrom == nil {
 			continue
 		}
@@ -320,20 +330,37 @@ func describeEnvRows(namespace string, vars []corev1.EnvVar, options Options) ([
 			row["Source"] = component.NewText(ref.Resource)
 		case e.ValueFrom.SecretKeyRef != nil:
 			ref := e.ValueFrom.SecretKeyRef
-			source, err := options.Link.ForGVK(namespace, "v1", "Secret", ref.Name,
+			source, err := options.Link.ForGVK(sources.namespace, "v1", "Secret", ref.Name,
 				fmt.Sprintf("%s:%s", ref.Name, ref.Key))
 			if err != nil {
 				return nil, err
 			}
 			row["Source"] = source
+
+			data, found, err := sources.load(ctx, "Secret", ref.Name)
+			if err != nil {
+				return nil, err
+			}
+			row["Value"] = component.NewText(missingKeyRefText("Secret", ref.Name, ref.Key, ref.Optional, data, found))
 		case e.ValueFrom.ConfigMapKeyRef != nil:
 			ref := e.ValueFrom.ConfigMapKeyRef
-			source, err := options.Link.ForGVK(namespace, "v1", "ConfigMap", ref.Name,
+			source, err := options.Link.ForGVK(sources.namespace, "v1", "ConfigMap", ref.Name,
 				fmt.Sprintf("%s:%s", ref.Name, ref.Key))
 			if err != nil {
 				return nil, err
 			}
 			row["Source"] = source
+
+			data, found, err := sources.load(ctx, "ConfigMap", ref.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			value := missingKeyRefText("ConfigMap", ref.Name, ref.Key, ref.Optional, data, found)
+			if value == "" {
+				value = data[ref.Key]
+			}
+			row["Value"] = component.NewText(value)
 		}
 	}
 
@@ -341,35 +368,167 @@ func describeEnvRows(namespace string, vars []corev1.EnvVar, options Options) ([
 }
 
 // describeEnvFromRows renders container environmentFrom references as table rows.
+// Each key in the referenced config map or secret is expanded to a row.
 // Expected columns: Name, Value, Source
-// TODO: Consider expanding variables from referenced config map / secret
-func describeEnvFromRows(namespace string, vars []corev1.EnvFromSource, options Options) ([]component.TableRow, error) {
+func describeEnvFromRows(ctx context.Context, sources *envSources, vars []corev1.EnvFromSource, options Options) ([]component.TableRow, error) {
 	rows := make([]component.TableRow, 0)
 	for _, e := range vars {
-		row := component.TableRow{}
-		rows = append(rows, row)
+		var kind, name string
+		var optional *bool
 
 		switch {
 		case e.SecretRef != nil:
-			ref := e.SecretRef
-			source, err := options.Link.ForGVK(namespace, "v1", "Secret", ref.Name, ref.Name)
-			if err != nil {
-				return nil, err
-			}
-			row["Source"] = source
+			kind, name, optional = "Secret", e.SecretRef.Name, e.SecretRef.Optional
 		case e.ConfigMapRef != nil:
-			ref := e.ConfigMapRef
-			source, err := options.Link.ForGVK(namespace, "v1", "ConfigMap", ref.Name, ref.Name)
-			if err != nil {
-				return nil, err
+			kind, name, optional = "ConfigMap", e.ConfigMapRef.Name, e.ConfigMapRef.Optional
+		default:
+			rows = append(rows, component.TableRow{})
+			continue
+		}
+
+		source, err := options.Link.ForGVK(sources.namespace, "v1", kind, name, name)
+		if err != nil {
+			return nil, err
+		}
+
+		data, found, err := sources.load(ctx, kind, name)
+		if err != nil {
+			return nil, err
+		}
+
+		if !found {
+			rows = append(rows, component.TableRow{
+				"Name":   component.NewText(e.Prefix),
+				"Value":  component.NewText(missingRefText(kind, name, optional)),
+				"Source": source,
+			})
+			continue
+		}
+
+		var keys []string
+		for key := range data {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			var value string
+			if kind == "ConfigMap" {
+				value = data[key]
 			}
-			row["Source"] = source
+
+			rows = append(rows, component.TableRow{
+				"Name":   component.NewText(e.Prefix + key),
+				"Value":  component.NewText(value),
+				"Source": source,
+			})
 		}
 	}
 
 	return rows, nil
 }
 
+// envSources loads the config maps and secrets referenced by a container's
+// environment. Each source is only loaded once.
+type envSources struct {
+	namespace   string
+	objectStore store.Store
+	cache       map[store.Key]map[string]string
+}
+
+func newEnvSources(namespace string, options Options) *envSources {
+	var objectStore store.Store
+	if options.DashConfig != nil {
+		objectStore = options.DashConfig.ObjectStore()
+	}
+
+	return &envSources{
+		namespace:   namespace,
+		objectStore: objectStore,
+		cache:       make(map[store.Key]map[string]string),
+	}
+}
+
+// load returns the data for a config map or secret. Secret values are
+// returned base64 encoded. It returns false if the source does not exist.
+func (s *envSources) load(ctx context.Context, kind, name string) (map[string]string, bool, error) {
+	key := store.Key{
+		Namespace:  s.namespace,
+		APIVersion: "v1",
+		Kind:       kind,
+		Name:       name,
+	}
+
+	if data, ok := s.cache[key]; ok {
+		return data, data != nil, nil
+	}
+
+	if s.objectStore == nil {
+		return nil, false, errors.New("object store is nil")
+	}
+
+	object, found, err := s.objectStore.Get(ctx, key)
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "get %s", key)
+	}
+
+	if !found || object == nil {
+		s.cache[key] = nil
+		return nil, false, nil
+	}
+
+	raw, _, err := unstructured.NestedMap(object.Object, "data")
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "get data for %s", key)
+	}
+
+	data := make(map[string]string)
+	for k, v := range raw {
+		data[k] = fmt.Sprintf("%v", v)
+	}
+
+	if kind == "ConfigMap" {
+		binaryData, _, err := unstructured.NestedMap(object.Object, "binaryData")
+		if err != nil {
+			return nil, false, errors.Wrapf(err, "get binary data for %s", key)
+		}
+		for k := range binaryData {
+			data[k] = "<binary>"
+		}
+	}
+
+	s.cache[key] = data
+	return data, true, nil
+}
+
+// missingKeyRefText returns a description of a problem with a key
+// reference. It returns an empty string if the key exists.
+func missingKeyRefText(kind, name, key string, optional *bool, data map[string]string, found bool) string {
+	if !found {
+		return missingRefText(kind, name, optional)
+	}
+
+	if _, ok := data[key]; ok {
+		return ""
+	}
+
+	if optional != nil && *optional {
+		return fmt.Sprintf("<optional key %q not found>", key)
+	}
+
+	return fmt.Sprintf("<key %q not found>", key)
+}
+
+// missingRefText returns a description of a reference to a config map or
+// secret which does not exist.
+func missingRefText(kind, name string, optional *bool) string {
+	if optional != nil && *optional {
+		return fmt.Sprintf("<optional %s %q not found>", kind, name)
+	}
+
+	return fmt.Sprintf("<%s %q not found>", kind, name)
+}
+
 // printSlice returns a string representation of a string slice, in a format similar
 // to ['a', 'b', 'c']. An empty slice will be returned as an empty string, rather than [].
 func printSlice(s []string) string {
@@ -428,7 +587,7 @@ func editContainerAction(owner runtime.Object, container *corev1.Container) (com
 		return component.Action{}, err
 	}
 
-	form, err := component.CreateFormForObject("overview/containerEditor", owner,
+	form, err := component.CreateFormForObject(octant.ActionEditContainer, owner,
 		component.NewFormFieldText("Image", "containerImage", container.Image),
 		component.NewFormFieldHidden("containersPath", string(containersPathData)),
 		component.NewFormFieldHidden("containerName", container.Name),
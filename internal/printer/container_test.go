@@ -6,6 +6,7 @@ SPDX-License-Identifier: Apache-2.0
 package printer
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
@@ -24,6 +25,7 @@ import (
 
 	pffake "github.com/vmware/octant/internal/portforward/fake"
 	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/store"
 	"github.com/vmware/octant/pkg/view/component"
 )
 
@@ -111,6 +113,7 @@ func Test_ContainerConfiguration(t *testing.T) {
 			},
 			EnvFrom: []corev1.EnvFromSource{
 				{
+					Prefix: "CONFIG_",
 					ConfigMapRef: &corev1.ConfigMapEnvSource{
 						LocalObjectReference: corev1.LocalObjectReference{Name: "fromconfig"},
 					},
@@ -152,23 +155,55 @@ func Test_ContainerConfiguration(t *testing.T) {
 		},
 		component.TableRow{
 			"Name":   component.NewText("configmapref"),
-			"Value":  component.NewText(""),
+			"Value":  component.NewText("somevalue"),
 			"Source": component.NewLink("", "myconfig:somekey", "/configMap"),
 		},
 		component.TableRow{
 			"Name":   component.NewText("secretref"),
-			"Value":  component.NewText(""),
+			"Value":  component.NewText(`<key "somesecretkey" not found>`),
 			"Source": component.NewLink("", "mysecret:somesecretkey", "/secret"),
 		},
 		// EnvFromSource
 		component.TableRow{
+			"Name":   component.NewText("CONFIG_a"),
+			"Value":  component.NewText("1"),
+			"Source": component.NewLink("", "fromconfig", "/fromConfig"),
+		},
+		component.TableRow{
+			"Name":   component.NewText("CONFIG_b"),
+			"Value":  component.NewText("2"),
 			"Source": component.NewLink("", "fromconfig", "/fromConfig"),
 		},
 		component.TableRow{
+			"Name":   component.NewText(""),
+			"Value":  component.NewText(`<Secret "fromsecret" not found>`),
 			"Source": component.NewLink("", "fromsecret", "/fromSecret"),
 		},
 	)
 
+	configMapKey := func(name string) store.Key {
+		return store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "ConfigMap", Name: name}
+	}
+	secretKey := func(name string) store.Key {
+		return store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Secret", Name: name}
+	}
+
+	myConfig := &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: "myconfig", Namespace: "namespace"},
+		Data:       map[string]string{"somekey": "somevalue"},
+	}
+	fromConfig := &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: "fromconfig", Namespace: "namespace"},
+		Data:       map[string]string{"b": "2", "a": "1"},
+	}
+	mySecret := &corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{Name: "mysecret", Namespace: "namespace"},
+		Data:       map[string][]byte{"otherkey": []byte("secret")},
+	}
+
 	volTable := component.NewTable("Volume Mounts", "There are no volume mounts!",
 		component.NewTableCols("Name", "Mount Path", "Propagation"))
 	volTable.Add(
@@ -289,6 +324,15 @@ func Test_ContainerConfiguration(t *testing.T) {
 			tpo.PathForGVK("namespace", "v1", "Secret", "fromsecret", "fromsecret", "/fromSecret")
 			tpo.PathForGVK("namespace", "v1", "ConfigMap", "fromconfig", "fromconfig", "/fromConfig")
 
+			tpo.objectStore.EXPECT().Get(gomock.Any(), configMapKey("myconfig")).
+				Return(testutil.ToUnstructured(t, myConfig), true, nil).AnyTimes()
+			tpo.objectStore.EXPECT().Get(gomock.Any(), configMapKey("fromconfig")).
+				Return(testutil.ToUnstructured(t, fromConfig), true, nil).AnyTimes()
+			tpo.objectStore.EXPECT().Get(gomock.Any(), secretKey("mysecret")).
+				Return(testutil.ToUnstructured(t, mySecret), true, nil).AnyTimes()
+			tpo.objectStore.EXPECT().Get(gomock.Any(), secretKey("fromsecret")).
+				Return(nil, false, nil).AnyTimes()
+
 			parentPod := testutil.CreatePod("pod")
 			parentPod.Namespace = "namespace"
 			parentPod.Status = corev1.PodStatus{
@@ -314,7 +358,8 @@ func Test_ContainerConfiguration(t *testing.T) {
 			}
 
 			cc := NewContainerConfiguration(parentPod, tc.container, pf, tc.isInit, printOptions)
-			summary, err := cc.Create()
+			ctx := context.Background()
+			summary, err := cc.Create(ctx)
 			if tc.isErr {
 				require.Error(t, err)
 				return
@@ -435,3 +480,30 @@ func Test_editContainerAction(t *testing.T) {
 	}
 	require.Equal(t, expected, got)
 }
+
+func Test_missingKeyRefText(t *testing.T) {
+	optional := true
+	data := map[string]string{"key": "value"}
+
+	tests := []struct {
+		name     string
+		key      string
+		optional *bool
+		data     map[string]string
+		found    bool
+		expected string
+	}{
+		{name: "key exists", key: "key", data: data, found: true, expected: ""},
+		{name: "missing key", key: "other", data: data, found: true, expected: `<key "other" not found>`},
+		{name: "missing optional key", key: "other", optional: &optional, data: data, found: true, expected: `<optional key "other" not found>`},
+		{name: "missing source", key: "key", expected: `<ConfigMap "config" not found>`},
+		{name: "missing optional source", key: "key", optional: &optional, expected: `<optional ConfigMap "config" not found>`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := missingKeyRefText("ConfigMap", "config", test.key, test.optional, test.data, test.found)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
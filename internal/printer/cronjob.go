@@ -12,6 +12,10 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/vmware/octant/internal/gvk"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/store"
 	"github.com/vmware/octant/pkg/view/component"
 
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
@@ -24,7 +28,7 @@ func CronJobListHandler(ctx context.Context, list *batchv1beta1.CronJobList, opt
 		return nil, errors.New("nil list")
 	}
 
-	cols := component.NewTableCols("Name", "Labels", "Schedule", "Age")
+	cols := component.NewTableCols("Name", "Labels", "Schedule", "Age", "Containers")
 	tbl := component.NewTable("CronJobs", "We couldn't find any cron jobs!", cols)
 
 	for _, c := range list.Items {
@@ -37,17 +41,25 @@ func CronJobListHandler(ctx context.Context, list *batchv1beta1.CronJobList, opt
 
 		row["Name"] = nameLink
 
-		row["Labels"] = component.NewLabels(c.Labels)
+		row["Labels"] = opts.LabelLinks.Labels(c.Labels, c.Namespace, gvk.CronJob)
 
 		row["Schedule"] = component.NewText(c.Spec.Schedule)
 
 		ts := c.CreationTimestamp.Time
-		row["Age"] = component.NewTimestamp(ts)
+		row["Age"] = opts.Timestamp(ts)
+
+		row["Containers"] = printContainers(c.Spec.JobTemplate.Spec.Template.Spec.Containers)
+
+		if err := opts.TableActions.AddRowActions(row, &c); err != nil {
+			return nil, err
+		}
 
 		tbl.Add(row)
 	}
 
-	return tbl, nil
+	opts.TableActions.AddBulkActions(tbl)
+
+	return opts.Profile.Apply(tbl), nil
 }
 
 // CronJobHandler is a printFunc that prints a CronJob
@@ -55,6 +67,10 @@ func CronJobHandler(ctx context.Context, cronJob *batchv1beta1.CronJob, options
 	o := NewObject(cronJob)
 	o.EnableEvents()
 
+	if err := addCronJobButtons(o, cronJob); err != nil {
+		return nil, errors.Wrap(err, "add cronjob buttons")
+	}
+
 	ch, err := newCronJobHandler(cronJob, o)
 	if err != nil {
 		return nil, err
@@ -71,6 +87,29 @@ func CronJobHandler(ctx context.Context, cronJob *batchv1beta1.CronJob, options
 	return o.ToComponent(ctx, options)
 }
 
+// addCronJobButtons adds buttons which run a cron job immediately, and
+// suspend or resume it.
+func addCronJobButtons(o ObjectInterface, cronJob *batchv1beta1.CronJob) error {
+	key, err := store.KeyFromObject(cronJob)
+	if err != nil {
+		return err
+	}
+
+	o.AddButton("Run Now", action.CreatePayload(octant.ActionTriggerCronJob, key.ToActionPayload()),
+		component.WithButtonConfirmation(
+			"Run CronJob",
+			fmt.Sprintf("Are you sure you want to create a Job from CronJob **%s** now?", cronJob.Name),
+		))
+
+	if cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend {
+		o.AddButton("Resume", action.CreatePayload(octant.ActionResumeCronJob, key.ToActionPayload()))
+	} else {
+		o.AddButton("Suspend", action.CreatePayload(octant.ActionSuspendCronJob, key.ToActionPayload()))
+	}
+
+	return nil
+}
+
 // CronJobConfiguration generates cronjob configuration
 type CronJobConfiguration struct {
 	cronjob *batchv1beta1.CronJob
@@ -84,7 +123,7 @@ func NewCronJobConfiguration(c *batchv1beta1.CronJob) *CronJobConfiguration {
 }
 
 // Create creates a cronjob configuration summary
-func (cc *CronJobConfiguration) Create() (*component.Summary, error) {
+func (cc *CronJobConfiguration) Create(options Options) (*component.Summary, error) {
 	if cc == nil || cc.cronjob == nil {
 		return nil, errors.New("cronjob is nil")
 	}
@@ -102,7 +141,7 @@ func (cc *CronJobConfiguration) Create() (*component.Summary, error) {
 	if lastScheduleTime := cc.cronjob.Status.LastScheduleTime; lastScheduleTime != nil {
 		sections = append(sections, component.SummarySection{
 			Header:  "Last Schedule Time",
-			Content: component.NewTimestamp(lastScheduleTime.Time),
+			Content: options.Timestamp(lastScheduleTime.Time),
 		})
 	}
 
@@ -172,7 +211,7 @@ func (c *cronJobHandler) Config(options Options) error {
 }
 
 func defaultCronJobConfig(cronJob *batchv1beta1.CronJob, options Options) (*component.Summary, error) {
-	return NewCronJobConfiguration(cronJob).Create()
+	return NewCronJobConfiguration(cronJob).Create(options)
 }
 
 func (c *cronJobHandler) Jobs(ctx context.Context, object runtime.Object, options Options) error {
@@ -14,7 +14,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/vmware/octant/internal/conversion"
+	"github.com/vmware/octant/internal/octant"
+	printerFake "github.com/vmware/octant/internal/printer/fake"
 	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/action"
 	"github.com/vmware/octant/pkg/store"
 	"github.com/vmware/octant/pkg/view/component"
 
@@ -66,13 +69,14 @@ func Test_CronJobListHandler(t *testing.T) {
 	got, err := CronJobListHandler(ctx, object, printOptions)
 	require.NoError(t, err)
 
-	cols := component.NewTableCols("Name", "Labels", "Schedule", "Age")
+	cols := component.NewTableCols("Name", "Labels", "Schedule", "Age", "Containers")
 	expected := component.NewTable("CronJobs", "We couldn't find any cron jobs!", cols)
 	expected.Add(component.TableRow{
-		"Name":     component.NewLink("", "cron", "/cron"),
-		"Labels":   component.NewLabels(labels),
-		"Schedule": component.NewText("*/1 * * * *"),
-		"Age":      component.NewTimestamp(now),
+		"Name":       component.NewLink("", "cron", "/cron"),
+		"Labels":     component.NewLabels(labels),
+		"Schedule":   component.NewText("*/1 * * * *"),
+		"Age":        component.NewTimestamp(now),
+		"Containers": component.NewContainers(),
 	})
 
 	component.AssertEqual(t, expected, got)
@@ -157,7 +161,7 @@ func Test_CronJobConfiguration(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			cc := NewCronJobConfiguration(tc.cronjob)
 
-			summary, err := cc.Create()
+			summary, err := cc.Create(Options{})
 			if tc.isErr {
 				require.Error(t, err)
 				return
@@ -215,15 +219,55 @@ func Test_createJobListView(t *testing.T) {
 	got, err := createJobListView(ctx, cronJob, printOptions)
 	require.NoError(t, err)
 
-	cols := component.NewTableCols("Name", "Labels", "Completions", "Successful", "Age")
+	cols := component.NewTableCols("Name", "Completions", "Successful", "Age", "Containers")
 	expected := component.NewTable("Jobs", "We couldn't find any jobs!", cols)
 	expected.Add(component.TableRow{
 		"Name":        component.NewLink("", "job", "/job"),
-		"Labels":      component.NewLabels(labels),
 		"Completions": component.NewText("1"),
 		"Successful":  component.NewText("1"),
 		"Age":         component.NewTimestamp(now),
+		"Containers":  component.NewContainers(),
 	})
 
 	component.AssertEqual(t, expected, got)
 }
+
+func Test_addCronJobButtons(t *testing.T) {
+	tests := []struct {
+		name    string
+		suspend *bool
+		button  string
+		action  string
+	}{
+		{
+			name:   "active",
+			button: "Suspend",
+			action: octant.ActionSuspendCronJob,
+		},
+		{
+			name:    "suspended",
+			suspend: conversion.PtrBool(true),
+			button:  "Resume",
+			action:  octant.ActionResumeCronJob,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			cronJob := testutil.CreateCronJob("cronjob")
+			cronJob.Spec.Suspend = test.suspend
+
+			key, err := store.KeyFromObject(cronJob)
+			require.NoError(t, err)
+
+			o := printerFake.NewMockObjectInterface(controller)
+			o.EXPECT().AddButton("Run Now", action.CreatePayload(octant.ActionTriggerCronJob, key.ToActionPayload()), gomock.Any())
+			o.EXPECT().AddButton(test.button, action.CreatePayload(test.action, key.ToActionPayload()))
+
+			require.NoError(t, addCronJobButtons(o, cronJob))
+		})
+	}
+}
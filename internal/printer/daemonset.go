@@ -13,6 +13,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware/octant/internal/gvk"
 	"github.com/vmware/octant/pkg/view/component"
 )
 
@@ -23,7 +24,7 @@ func DaemonSetListHandler(_ context.Context, list *appsv1.DaemonSetList, opts Op
 	}
 
 	cols := component.NewTableCols("Name", "Labels", "Desired", "Current", "Ready",
-		"Up-To-Date", "Age", "Node Selector")
+		"Up-To-Date", "Age", "Containers", "Node Selector")
 	table := component.NewTable("Daemon Sets", "We couldn't find any daemon sets!", cols)
 
 	for _, daemonSet := range list.Items {
@@ -34,18 +35,26 @@ func DaemonSetListHandler(_ context.Context, list *appsv1.DaemonSetList, opts Op
 		}
 
 		row["Name"] = nameLink
-		row["Labels"] = component.NewLabels(daemonSet.Labels)
+		row["Labels"] = opts.LabelLinks.Labels(daemonSet.Labels, daemonSet.Namespace, gvk.DaemonSet)
 		row["Desired"] = component.NewText(fmt.Sprintf("%d", daemonSet.Status.DesiredNumberScheduled))
 		row["Current"] = component.NewText(fmt.Sprintf("%d", daemonSet.Status.CurrentNumberScheduled))
-		row["Ready"] = component.NewText(fmt.Sprintf("%d", daemonSet.Status.NumberReady))
+		row["Ready"] = printReplicaCount(daemonSet.Status.NumberReady,
+			daemonSet.Status.NumberReady, daemonSet.Status.DesiredNumberScheduled)
 		row["Up-To-Date"] = component.NewText(fmt.Sprintf("%d", daemonSet.Status.UpdatedNumberScheduled))
-		row["Age"] = component.NewTimestamp(daemonSet.ObjectMeta.CreationTimestamp.Time)
+		row["Age"] = opts.Timestamp(daemonSet.ObjectMeta.CreationTimestamp.Time)
+		row["Containers"] = printContainers(daemonSet.Spec.Template.Spec.Containers)
 		row["Node Selector"] = printSelectorMap(daemonSet.Spec.Template.Spec.NodeSelector)
 
+		if err := opts.TableActions.AddRowActions(row, &daemonSet); err != nil {
+			return nil, err
+		}
+
 		table.Add(row)
 	}
 
-	return table, nil
+	opts.TableActions.AddBulkActions(table)
+
+	return opts.Profile.Apply(table), nil
 }
 
 // DaemonSetHandler is a printFunc that prints a daemon set
@@ -66,6 +75,13 @@ func DaemonSetHandler(ctx context.Context, daemonSet *appsv1.DaemonSet, options
 		return nil, errors.Wrap(err, "print daemonset status")
 	}
 
+	if err := registerWorkloadSLO(o, daemonSet, options); err != nil {
+		return nil, errors.Wrap(err, "print daemonset service level objective")
+	}
+
+	registerWorkloadLogs(ctx, o, daemonSet.Namespace, daemonSet.Spec.Selector, options)
+	registerRevisions(ctx, o, daemonSet, daemonSet.Spec.Template, options)
+
 	if err := dsh.Pods(ctx, daemonSet, options); err != nil {
 		return nil, errors.Wrap(err, "print daemonset pods")
 	}
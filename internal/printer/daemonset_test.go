@@ -50,7 +50,7 @@ func Test_DaemonSetListHandler(t *testing.T) {
 	require.NoError(t, err)
 
 	cols := component.NewTableCols("Name", "Labels", "Desired", "Current", "Ready",
-		"Up-To-Date", "Age", "Node Selector")
+		"Up-To-Date", "Age", "Containers", "Node Selector")
 	expected := component.NewTable("Daemon Sets", "We couldn't find any daemon sets!", cols)
 	expected.Add(component.TableRow{
 		"Name":          component.NewLink("", object.Name, "/path"),
@@ -58,8 +58,9 @@ func Test_DaemonSetListHandler(t *testing.T) {
 		"Age":           component.NewTimestamp(now),
 		"Desired":       component.NewText("1"),
 		"Current":       component.NewText("1"),
-		"Ready":         component.NewText("1"),
+		"Ready":         component.NewStatusText("1", component.NodeStatusOK),
 		"Up-To-Date":    component.NewText("1"),
+		"Containers":    component.NewContainers(),
 		"Node Selector": component.NewSelectors(nil),
 	})
 
@@ -209,22 +210,21 @@ func Test_DaemonSetPods(t *testing.T) {
 	tpo.objectStore.EXPECT().List(gomock.Any(), gomock.Eq(key)).Return(podList, false, nil)
 
 	printOptions := tpo.ToOptions()
-	printOptions.DisableLabels = false
+	printOptions.Profile = ProfileFull
 
 	got, err := createPodListView(ctx, daemonSet, printOptions)
 	require.NoError(t, err)
 
 	cols := component.NewTableCols("Name", "Ready", "Phase", "Restarts", "Node", "Age")
 	expected := component.NewTable("Pods", "We couldn't find any pods!", cols)
-	expected.Add(component.TableRow{
+	expected.Add(podRowWithActions(t, pod, component.TableRow{
 		"Name":     component.NewLink("", "fluentd-elasticsearch-dvskv", "/pod"),
 		"Ready":    component.NewText("0/1"),
 		"Phase":    component.NewText("Pending"),
-		"Restarts": component.NewText("0"),
+		"Restarts": component.NewNumberText("0", 0),
 		"Node":     nodeLink,
 		"Age":      component.NewTimestamp(now),
-	})
-	addPodTableFilters(expected)
+	}))
 
 	component.AssertEqual(t, expected, got)
 }
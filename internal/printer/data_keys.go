@@ -0,0 +1,74 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// dataKeyActions creates actions which set and remove a single data key of
+// a config map or secret. The forms include the object's resource version,
+// so edits to an object which changed since it was printed are rejected.
+func dataKeyActions(object runtime.Object, keys []string) ([]component.Action, error) {
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return nil, err
+	}
+
+	kind := object.GetObjectKind().GroupVersionKind().Kind
+	resourceVersion := component.NewFormFieldHidden(octant.ResourceVersionField, accessor.GetResourceVersion())
+
+	keyField := component.NewFormFieldText("Key", octant.DataKeyField, "")
+	keyField.SetValidation(octant.DataKeyValidation())
+
+	setForm, err := component.CreateFormForObject(octant.ActionSetDataKey, object,
+		keyField,
+		component.NewFormFieldTextarea("Value", octant.DataValueField, ""),
+		resourceVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	actions := []component.Action{
+		{
+			Name:  "Set Key",
+			Title: fmt.Sprintf("Add or Update %s Key", kind),
+			Form:  setForm,
+		},
+	}
+
+	if len(keys) == 0 {
+		return actions, nil
+	}
+
+	sort.Strings(keys)
+
+	removeField := component.NewFormFieldSelect("Key", octant.DataKeyField, component.NewInputChoices(keys), false)
+	removeField.SetValidation(component.NewFormFieldValidation(component.ValidateRequired()))
+
+	removeForm, err := component.CreateFormForObject(octant.ActionRemoveDataKey, object, removeField, resourceVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	actions = append(actions, component.Action{
+		Name:             "Remove Key",
+		Title:            fmt.Sprintf("Remove %s Key", kind),
+		Form:             removeForm,
+		ConfirmChanges:   true,
+		Danger:           component.DangerLevelDanger,
+		ConfirmationText: accessor.GetName(),
+	})
+
+	return actions, nil
+}
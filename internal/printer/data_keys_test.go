@@ -0,0 +1,102 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func Test_dataKeyActions(t *testing.T) {
+	configMap := testutil.CreateConfigMap("configmap")
+	configMap.ResourceVersion = "7"
+
+	setKey := component.NewFormFieldText("Key", "key", "")
+	setKey.SetValidation(component.NewFormFieldValidation(
+		component.ValidateRequired(),
+		component.ValidatePattern(`[-._a-zA-Z0-9]+`),
+	))
+
+	setAction := component.Action{
+		Name:  "Set Key",
+		Title: "Add or Update ConfigMap Key",
+		Form: component.Form{
+			Fields: []component.FormField{
+				setKey,
+				component.NewFormFieldTextarea("Value", "value", ""),
+				component.NewFormFieldHidden("resourceVersion", "7"),
+				component.NewFormFieldHidden("apiVersion", "v1"),
+				component.NewFormFieldHidden("kind", "ConfigMap"),
+				component.NewFormFieldHidden("name", "configmap"),
+				component.NewFormFieldHidden("namespace", "namespace"),
+				component.NewFormFieldHidden("action", "overview/setDataKey"),
+			},
+		},
+	}
+
+	removeKey := component.NewFormFieldSelect("Key", "key", component.NewInputChoices([]string{"a", "b"}), false)
+	removeKey.SetValidation(component.NewFormFieldValidation(component.ValidateRequired()))
+
+	removeAction := component.Action{
+		Name:  "Remove Key",
+		Title: "Remove ConfigMap Key",
+		Form: component.Form{
+			Fields: []component.FormField{
+				removeKey,
+				component.NewFormFieldHidden("resourceVersion", "7"),
+				component.NewFormFieldHidden("apiVersion", "v1"),
+				component.NewFormFieldHidden("kind", "ConfigMap"),
+				component.NewFormFieldHidden("name", "configmap"),
+				component.NewFormFieldHidden("namespace", "namespace"),
+				component.NewFormFieldHidden("action", "overview/removeDataKey"),
+			},
+		},
+		ConfirmChanges:   true,
+		Danger:           component.DangerLevelDanger,
+		ConfirmationText: "configmap",
+	}
+
+	tests := []struct {
+		name     string
+		keys     []string
+		expected []component.Action
+	}{
+		{
+			name:     "with keys",
+			keys:     []string{"b", "a"},
+			expected: []component.Action{setAction, removeAction},
+		},
+		{
+			name:     "without keys",
+			expected: []component.Action{setAction},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := dataKeyActions(configMap, test.keys)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+// addDataKeyActions adds the data key actions for an object to a summary.
+func addDataKeyActions(t *testing.T, summary *component.Summary, object runtime.Object, keys ...string) {
+	actions, err := dataKeyActions(object, keys)
+	require.NoError(t, err)
+
+	for _, action := range actions {
+		summary.AddAction(action)
+	}
+}
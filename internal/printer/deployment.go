@@ -13,15 +13,16 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/kubernetes/staging/src/k8s.io/apimachinery/pkg/util/duration"
 
+	"github.com/vmware/octant/internal/gvk"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/internal/rollout"
+	"github.com/vmware/octant/pkg/action"
 	"github.com/vmware/octant/pkg/store"
 	"github.com/vmware/octant/pkg/view/component"
 )
 
-var (
-	deploymentConditionColumns = component.NewTableCols("Type", "Reason", "Status", "Message", "Last Update", "Last Transition")
-)
-
 // DeploymentListHandler is a printFunc that lists deployments
 func DeploymentListHandler(_ context.Context, list *appsv1.DeploymentList, opts Options) (component.Component, error) {
 	if list == nil {
@@ -39,25 +40,26 @@ func DeploymentListHandler(_ context.Context, list *appsv1.DeploymentList, opts
 		}
 
 		row["Name"] = nameLink
-		row["Labels"] = component.NewLabels(d.Labels)
+		row["Labels"] = opts.LabelLinks.Labels(d.Labels, d.Namespace, gvk.Deployment)
 
-		status := fmt.Sprintf("%d/%d", d.Status.AvailableReplicas, d.Status.AvailableReplicas+d.Status.UnavailableReplicas)
-		row["Status"] = component.NewText(status)
+		row["Status"] = printReplicaStatus(d.Status.AvailableReplicas, d.Status.AvailableReplicas+d.Status.UnavailableReplicas)
 
 		ts := d.CreationTimestamp.Time
-		row["Age"] = component.NewTimestamp(ts)
+		row["Age"] = opts.Timestamp(ts)
 
-		containers := component.NewContainers()
-		for i := range d.Spec.Template.Spec.Containers {
-			c := d.Spec.Template.Spec.Containers[i]
-			containers.Add(c.Name, c.Image)
-		}
-		row["Containers"] = containers
+		row["Containers"] = printContainers(d.Spec.Template.Spec.Containers)
 		row["Selector"] = printSelector(d.Spec.Selector)
 
+		if err := opts.TableActions.AddRowActions(row, &d); err != nil {
+			return nil, err
+		}
+
 		tbl.Add(row)
 	}
-	return tbl, nil
+
+	opts.TableActions.AddBulkActions(tbl)
+
+	return opts.Profile.Apply(tbl), nil
 }
 
 // DeploymentHandler is a printFunc that prints a Deployments.
@@ -65,6 +67,10 @@ func DeploymentHandler(ctx context.Context, deployment *appsv1.Deployment, optio
 	o := NewObject(deployment)
 	o.EnableEvents()
 
+	if err := addRolloutButtons(o, deployment); err != nil {
+		return nil, errors.Wrap(err, "add rollout buttons")
+	}
+
 	dh, err := newDeploymentHandler(deployment, o)
 	if err != nil {
 		return nil, err
@@ -73,27 +79,67 @@ func DeploymentHandler(ctx context.Context, deployment *appsv1.Deployment, optio
 	if err := dh.Config(); err != nil {
 		return nil, errors.Wrap(err, "print deployment configuration")
 	}
-	if err := dh.Status(); err != nil {
+	if err := dh.Status(options); err != nil {
 		return nil, errors.Wrap(err, "print deployment status")
 	}
+	if err := registerWorkloadSLO(o, deployment, options); err != nil {
+		return nil, errors.Wrap(err, "print deployment service level objective")
+	}
+	registerWorkloadMetrics(ctx, o, deployment.Namespace, deployment.Spec.Selector, options)
+	registerDeploymentMetricsHistory(ctx, o, deployment, options)
+	registerWorkloadLogs(ctx, o, deployment.Namespace, deployment.Spec.Selector, options)
+	registerRevisions(ctx, o, deployment, deployment.Spec.Template, options)
 	if err := dh.Pods(ctx, deployment, options); err != nil {
 		return nil, errors.Wrap(err, "print deployment pods")
 	}
-	if err := dh.Conditions(); err != nil {
+	if err := dh.Conditions(options); err != nil {
 		return nil, errors.Wrap(err, "print deployment conditions")
 	}
 
 	return o.ToComponent(ctx, options)
 }
 
-func createDeploymentSummaryStatus(deployment *appsv1.Deployment) (*component.Summary, error) {
+// addRolloutButtons adds buttons which restart the deployment's rollout,
+// and pause or resume it.
+func addRolloutButtons(o ObjectInterface, deployment *appsv1.Deployment) error {
+	key, err := store.KeyFromObject(deployment)
+	if err != nil {
+		return err
+	}
+
+	o.AddButton("Restart", action.CreatePayload(octant.ActionRestartRollout, key.ToActionPayload()),
+		component.WithButtonConfirmation(
+			"Restart Rollout",
+			fmt.Sprintf("Are you sure you want to restart the pods of Deployment **%s**?", deployment.Name),
+		))
+
+	if deployment.Spec.Paused {
+		o.AddButton("Resume", action.CreatePayload(octant.ActionResumeRollout, key.ToActionPayload()))
+	} else {
+		o.AddButton("Pause", action.CreatePayload(octant.ActionPauseRollout, key.ToActionPayload()))
+	}
+
+	return nil
+}
+
+func createDeploymentSummaryStatus(deployment *appsv1.Deployment, options Options) (*component.Summary, error) {
 	if deployment == nil {
 		return nil, errors.New("unable to generate status from a nil deployment")
 	}
 
 	status := deployment.Status
 
+	replicas := component.NewDonutChart()
+	replicas.SetLabels("replicas", "replica")
+	replicas.SetSize(component.DonutChartSizeSmall)
+	replicas.AddSegment(int(status.AvailableReplicas), component.NodeStatusOK)
+	replicas.AddSegment(int(status.UnavailableReplicas), component.NodeStatusError)
+
 	summary := component.NewSummary("Status", []component.SummarySection{
+		{
+			Header:  "Replicas",
+			Content: replicas,
+		},
 		{
 			Header:  "Available Replicas",
 			Content: component.NewText(fmt.Sprintf("%d", status.AvailableReplicas)),
@@ -116,32 +162,35 @@ func createDeploymentSummaryStatus(deployment *appsv1.Deployment) (*component.Su
 		},
 	}...)
 
+	if since, ok := rollout.StuckSince(deployment); ok {
+		message := fmt.Sprintf("Deployment has been failing its progress deadline for %s",
+			duration.HumanDuration(options.Now().Sub(since)))
+		alert := component.NewAlert(component.AlertTypeError, message)
+		alert.Dismissible = true
+		summary.SetAlert(alert)
+	}
+
 	return summary, nil
 }
 
-func createDeploymentConditionsView(deployment *appsv1.Deployment) (*component.Table, error) {
+func createDeploymentConditionsView(deployment *appsv1.Deployment, options Options) (*component.Table, error) {
 	if deployment == nil {
 		return nil, errors.New("unable to generate conditions from a nil deployment")
 	}
 
-	table := component.NewTable("Conditions", "There are no deployment conditions!", deploymentConditionColumns)
-
-	for _, condition := range deployment.Status.Conditions {
-		row := component.TableRow{
-			"Type":            component.NewText(string(condition.Type)),
-			"Reason":          component.NewText(condition.Reason),
-			"Status":          component.NewText(string(condition.Status)),
-			"Message":         component.NewText(condition.Message),
-			"Last Update":     component.NewTimestamp(condition.LastUpdateTime.Time),
-			"Last Transition": component.NewTimestamp(condition.LastTransitionTime.Time),
-		}
-
-		table.Add(row)
+	var conditions []condition
+	for _, c := range deployment.Status.Conditions {
+		conditions = append(conditions, condition{
+			Type:           string(c.Type),
+			Status:         c.Status,
+			Reason:         c.Reason,
+			Message:        c.Message,
+			LastUpdate:     c.LastUpdateTime,
+			LastTransition: c.LastTransitionTime,
+		})
 	}
 
-	table.Sort("Type", false)
-
-	return table, nil
+	return createConditionsTable("deployment", conditions, options), nil
 }
 
 type actionGeneratorFunction func(*appsv1.Deployment) ([]component.Action, error)
@@ -156,7 +205,7 @@ type DeploymentConfiguration struct {
 func NewDeploymentConfiguration(d *appsv1.Deployment) *DeploymentConfiguration {
 	return &DeploymentConfiguration{
 		deployment:       d,
-		actionGenerators: []actionGeneratorFunction{editDeploymentAction},
+		actionGenerators: []actionGeneratorFunction{scaleDeploymentAction},
 	}
 }
 
@@ -255,41 +304,23 @@ func (dc *DeploymentConfiguration) Create() (*component.Summary, error) {
 	return summary, nil
 }
 
-func editDeploymentAction(deployment *appsv1.Deployment) ([]component.Action, error) {
-	replicas := deployment.Spec.Replicas
-	if replicas == nil {
-		return []component.Action{}, nil
-	}
-
-	form, err := component.CreateFormForObject("deployment/configuration", deployment,
-		component.NewFormFieldNumber("Replicas", "replicas", fmt.Sprintf("%d", *replicas)),
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	action := component.Action{
-		Name:  "Edit",
-		Title: "Deployment Editor",
-		Form:  form,
-	}
-
-	return []component.Action{action}, nil
+func scaleDeploymentAction(deployment *appsv1.Deployment) ([]component.Action, error) {
+	return scaleAction(deployment, deployment.Spec.Replicas)
 }
 
 type deploymentObject interface {
 	Config() error
-	Status() error
+	Status(options Options) error
 	Pods(ctx context.Context, object runtime.Object, options Options) error
-	Conditions() error
+	Conditions(options Options) error
 }
 
 type deploymentHandler struct {
 	deployment     *appsv1.Deployment
 	configFunc     func(*appsv1.Deployment) (*component.Summary, error)
-	summaryFunc    func(*appsv1.Deployment) (*component.Summary, error)
+	summaryFunc    func(*appsv1.Deployment, Options) (*component.Summary, error)
 	podFunc        func(context.Context, []runtime.Object, Options) (component.Component, error)
-	conditionsFunc func(*appsv1.Deployment) (*component.Table, error)
+	conditionsFunc func(*appsv1.Deployment, Options) (*component.Table, error)
 	object         *Object
 }
 
@@ -330,8 +361,8 @@ func defaultDeploymentConfig(deployment *appsv1.Deployment) (*component.Summary,
 	return NewDeploymentConfiguration(deployment).Create()
 }
 
-func (d *deploymentHandler) Status() error {
-	out, err := d.summaryFunc(d.deployment)
+func (d *deploymentHandler) Status(options Options) error {
+	out, err := d.summaryFunc(d.deployment, options)
 	if err != nil {
 		return err
 	}
@@ -340,11 +371,11 @@ func (d *deploymentHandler) Status() error {
 	return nil
 }
 
-func defaultDeploymentSummary(deployment *appsv1.Deployment) (*component.Summary, error) {
-	return createDeploymentSummaryStatus(deployment)
+func defaultDeploymentSummary(deployment *appsv1.Deployment, options Options) (*component.Summary, error) {
+	return createDeploymentSummaryStatus(deployment, options)
 }
 
-func (d *deploymentHandler) Conditions() error {
+func (d *deploymentHandler) Conditions(options Options) error {
 	if d.deployment == nil {
 		return errors.New("can't display conditions for nil deployment")
 	}
@@ -352,15 +383,15 @@ func (d *deploymentHandler) Conditions() error {
 	d.object.RegisterItems(ItemDescriptor{
 		Width: component.WidthFull,
 		Func: func() (component.Component, error) {
-			return d.conditionsFunc(d.deployment)
+			return d.conditionsFunc(d.deployment, options)
 		},
 	})
 
 	return nil
 }
 
-func defaultDeploymentConditions(deployment *appsv1.Deployment) (*component.Table, error) {
-	return createDeploymentConditionsView(deployment)
+func defaultDeploymentConditions(deployment *appsv1.Deployment, options Options) (*component.Table, error) {
+	return createDeploymentConditionsView(deployment, options)
 }
 
 func (d *deploymentHandler) Pods(ctx context.Context, object runtime.Object, options Options) error {
@@ -11,7 +11,6 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/util/intstr"
-	"k8s.io/utils/pointer"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -19,9 +18,13 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
 
 	"github.com/vmware/octant/internal/conversion"
+	"github.com/vmware/octant/internal/octant"
+	printerFake "github.com/vmware/octant/internal/printer/fake"
 	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/action"
 	"github.com/vmware/octant/pkg/store"
 	"github.com/vmware/octant/pkg/view/component"
 )
@@ -92,8 +95,22 @@ func Test_DeploymentListHandler(t *testing.T) {
 	require.NoError(t, err)
 
 	containers := component.NewContainers()
-	containers.Add("nginx", "nginx:1.15")
-	containers.Add("kuard", "gcr.io/kuar-demo/kuard-amd64:1")
+	containers.AddContainerDef(component.ContainerDef{
+		Name:       "nginx",
+		Image:      "nginx:1.15",
+		Registry:   "docker.io",
+		Repository: "library/nginx",
+		Tag:        "1.15",
+		Warnings:   []string{"Image is not pinned to a digest"},
+	})
+	containers.AddContainerDef(component.ContainerDef{
+		Name:       "kuard",
+		Image:      "gcr.io/kuar-demo/kuard-amd64:1",
+		Registry:   "gcr.io",
+		Repository: "kuar-demo/kuard-amd64",
+		Tag:        "1",
+		Warnings:   []string{"Image is not pinned to a digest"},
+	})
 
 	cols := component.NewTableCols("Name", "Labels", "Status", "Age", "Containers", "Selector")
 	expected := component.NewTable("Deployments", "We couldn't find any deployments!", cols)
@@ -102,7 +119,7 @@ func Test_DeploymentListHandler(t *testing.T) {
 		"Labels":     component.NewLabels(objectLabels),
 		"Age":        component.NewTimestamp(now),
 		"Selector":   component.NewSelectors([]component.Selector{component.NewLabelSelector("app", "my_app")}),
-		"Status":     component.NewText("2/3"),
+		"Status":     component.NewStatusText("2/3", component.NodeStatusWarning),
 		"Containers": containers,
 	})
 
@@ -223,10 +240,17 @@ func Test_createDeploymentSummaryStatus(t *testing.T) {
 	deployment.Status.UnavailableReplicas = 4
 	deployment.Status.UpdatedReplicas = 5
 
-	got, err := createDeploymentSummaryStatus(deployment)
+	got, err := createDeploymentSummaryStatus(deployment, Options{})
 	require.NoError(t, err)
 
+	replicas := component.NewDonutChart()
+	replicas.SetLabels("replicas", "replica")
+	replicas.SetSize(component.DonutChartSizeSmall)
+	replicas.AddSegment(1, component.NodeStatusOK)
+	replicas.AddSegment(4, component.NodeStatusError)
+
 	sections := component.SummarySections{
+		{Header: "Replicas", Content: replicas},
 		{Header: "Available Replicas", Content: component.NewText("1")},
 		{Header: "Ready Replicas", Content: component.NewText("2")},
 		{Header: "Total Replicas", Content: component.NewText("3")},
@@ -238,6 +262,29 @@ func Test_createDeploymentSummaryStatus(t *testing.T) {
 	assert.Equal(t, expected, got)
 }
 
+func Test_createDeploymentSummaryStatus_stuck(t *testing.T) {
+	now := testutil.Time()
+
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.Status.Conditions = []appsv1.DeploymentCondition{
+		{
+			Type:               appsv1.DeploymentProgressing,
+			Status:             corev1.ConditionFalse,
+			Reason:             "ProgressDeadlineExceeded",
+			LastTransitionTime: metav1.NewTime(now.Add(-20 * time.Minute)),
+		},
+	}
+
+	options := Options{Clock: clock.NewFakeClock(now)}
+	got, err := createDeploymentSummaryStatus(deployment, options)
+	require.NoError(t, err)
+
+	require.NotNil(t, got.Config.Alert)
+	assert.Equal(t, component.AlertTypeError, got.Config.Alert.Type)
+	assert.Equal(t, "Deployment has been failing its progress deadline for 20m", got.Config.Alert.Message)
+	assert.True(t, got.Config.Alert.Dismissible)
+}
+
 func Test_createDeploymentConditionsView(t *testing.T) {
 	now := metav1.Time{Time: time.Now()}
 
@@ -253,16 +300,15 @@ func Test_createDeploymentConditionsView(t *testing.T) {
 		},
 	}
 
-	got, err := createDeploymentConditionsView(deployment)
+	got, err := createDeploymentConditionsView(deployment, Options{})
 	require.NoError(t, err)
 
-	cols := component.NewTableCols("Type", "Reason", "Status", "Message", "Last Update", "Last Transition")
-	expected := component.NewTable("Conditions", "There are no deployment conditions!", cols)
+	expected := component.NewTable("Conditions", "There are no deployment conditions!", conditionColumns)
 	expected.Add([]component.TableRow{
 		{
 			"Type":            component.NewText("Available"),
 			"Reason":          component.NewText("reason"),
-			"Status":          component.NewText("True"),
+			"Status":          component.NewStatusText("True", component.NodeStatusOK),
 			"Message":         component.NewText("message"),
 			"Last Update":     component.NewTimestamp(now.Time),
 			"Last Transition": component.NewTimestamp(now.Time),
@@ -334,47 +380,57 @@ func Test_DeploymentPods(t *testing.T) {
 	got, err := createRollingPodListView(ctx, replicaSets, printOptions)
 	require.NoError(t, err)
 
-	expected := component.NewTableWithRows("Pods", "We couldn't find any pods!", podColsWithOutLabels, []component.TableRow{
-		{
+	cols := component.NewTableCols("Name", "Ready", "Phase", "Restarts", "Node", "Age")
+	expected := component.NewTableWithRows("Pods", "We couldn't find any pods!", cols, []component.TableRow{
+		podRowWithActions(t, pod, component.TableRow{
 			"Name":     component.NewLink("", pod.Name, "/pod"),
 			"Age":      component.NewTimestamp(now),
 			"Ready":    component.NewText("1/1"),
-			"Restarts": component.NewText("0"),
+			"Restarts": component.NewNumberText("0", 0),
 			"Phase":    component.NewText("Running"),
 			"Node":     component.NewText("<not scheduled>"),
-		},
+		}),
 	})
-	addPodTableFilters(expected)
 
 	component.AssertEqual(t, expected, got)
 }
 
-func Test_editDeploymentAction(t *testing.T) {
-	deployment := testutil.CreateDeployment("deployment")
-	deployment.Spec.Replicas = pointer.Int32Ptr(3)
-
-	actions, err := editDeploymentAction(deployment)
-	require.NoError(t, err)
-	assert.Len(t, actions, 1)
-
-	got := actions[0]
-
-	apiVersion, kind := deployment.GroupVersionKind().ToAPIVersionAndKind()
-
-	expected := component.Action{
-		Name:  "Edit",
-		Title: "Deployment Editor",
-		Form: component.Form{
-			Fields: []component.FormField{
-				component.NewFormFieldNumber("Replicas", "replicas", "3"),
-				component.NewFormFieldHidden("apiVersion", apiVersion),
-				component.NewFormFieldHidden("kind", kind),
-				component.NewFormFieldHidden("name", deployment.Name),
-				component.NewFormFieldHidden("namespace", deployment.Namespace),
-				component.NewFormFieldHidden("action", "deployment/configuration"),
-			},
+func Test_addRolloutButtons(t *testing.T) {
+	tests := []struct {
+		name   string
+		paused bool
+		button string
+		action string
+	}{
+		{
+			name:   "running",
+			button: "Pause",
+			action: octant.ActionPauseRollout,
+		},
+		{
+			name:   "paused",
+			paused: true,
+			button: "Resume",
+			action: octant.ActionResumeRollout,
 		},
 	}
 
-	assert.Equal(t, expected, got)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			deployment := testutil.CreateDeployment("deployment")
+			deployment.Spec.Paused = test.paused
+
+			key, err := store.KeyFromObject(deployment)
+			require.NoError(t, err)
+
+			o := printerFake.NewMockObjectInterface(controller)
+			o.EXPECT().AddButton("Restart", action.CreatePayload(octant.ActionRestartRollout, key.ToActionPayload()), gomock.Any())
+			o.EXPECT().AddButton(test.button, action.CreatePayload(test.action, key.ToActionPayload()))
+
+			require.NoError(t, addRolloutButtons(o, deployment))
+		})
+	}
 }
@@ -6,6 +6,7 @@ SPDX-License-Identifier: Apache-2.0
 package printer
 
 import (
+	"context"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -15,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/internal/util/kubernetes"
 	"github.com/vmware/octant/pkg/store"
 	storefake "github.com/vmware/octant/pkg/store/fake"
 	"github.com/vmware/octant/pkg/view/flexlayout"
@@ -39,6 +41,14 @@ func mockObjectsEvents(t *testing.T, appObjectStore *storefake.MockStore, namesp
 		List(gomock.Any(), key).
 		Return(objects, false, nil).
 		AnyTimes()
+
+	eventsAPIKey := kubernetes.EventsAPIEventKey
+	eventsAPIKey.Namespace = namespace
+
+	appObjectStore.EXPECT().
+		List(gomock.Any(), eventsAPIKey).
+		Return(&unstructured.UnstructuredList{}, false, nil).
+		AnyTimes()
 }
 
 func stubMetadataForObject(t *testing.T, object runtime.Object, fl *flexlayout.FlexLayout) {
@@ -47,7 +57,7 @@ func stubMetadataForObject(t *testing.T, object runtime.Object, fl *flexlayout.F
 
 	tpo := newTestPrinterOptions(controller)
 
-	metadata, err := NewMetadata(object, tpo.link)
+	metadata, err := NewMetadata(object, tpo.ToOptions())
 	require.NoError(t, err)
-	require.NoError(t, metadata.AddToFlexLayout(fl))
+	require.NoError(t, metadata.AddToFlexLayout(context.Background(), fl))
 }
@@ -16,6 +16,8 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware/octant/internal/eventfilter"
+	"github.com/vmware/octant/internal/util/kubernetes"
 	"github.com/vmware/octant/pkg/store"
 	"github.com/vmware/octant/pkg/view/component"
 	"github.com/vmware/octant/pkg/view/flexlayout"
@@ -35,9 +37,11 @@ func EventListHandler(ctx context.Context, list *corev1.EventList, opts Options)
 		"First Seen", "Last Seen")
 	table := component.NewTable("Events", "We couldn't find any events!", cols)
 
-	for _, event := range list.Items {
+	for _, event := range eventfilter.From(ctx).Filter(list.Items) {
 		row := component.TableRow{}
 
+		event = kubernetes.NormalizeEvent(event)
+
 		objectPath, err := ObjectReferencePath(event.InvolvedObject)
 		if err != nil {
 			return nil, err
@@ -60,15 +64,15 @@ func EventListHandler(ctx context.Context, list *corev1.EventList, opts Options)
 		row["Message"] = messageLink
 		row["Reason"] = component.NewText(event.Reason)
 		row["Type"] = component.NewText(event.Type)
-		row["First Seen"] = component.NewTimestamp(event.FirstTimestamp.Time)
-		row["Last Seen"] = component.NewTimestamp(event.LastTimestamp.Time)
+		row["First Seen"] = opts.Timestamp(event.FirstTimestamp.Time)
+		row["Last Seen"] = opts.Timestamp(event.LastTimestamp.Time)
 
 		table.Add(row)
 	}
 
 	table.Sort("Last Seen", true)
 
-	return table, nil
+	return opts.Profile.Apply(table), nil
 }
 
 func EventHandler(ctx context.Context, event *corev1.Event, opts Options) (component.Component, error) {
@@ -76,16 +80,19 @@ func EventHandler(ctx context.Context, event *corev1.Event, opts Options) (compo
 		return nil, errors.New("event can not be nil")
 	}
 
+	normalized := kubernetes.NormalizeEvent(*event)
+	event = &normalized
+
 	var detailSections []component.SummarySection
 
 	detailSections = append(detailSections, component.SummarySection{
 		Header:  "Last Seen",
-		Content: component.NewTimestamp(event.LastTimestamp.Time),
+		Content: opts.Timestamp(event.LastTimestamp.Time),
 	})
 
 	detailSections = append(detailSections, component.SummarySection{
 		Header:  "First Seen",
-		Content: component.NewTimestamp(event.FirstTimestamp.Time),
+		Content: opts.Timestamp(event.FirstTimestamp.Time),
 	})
 
 	detailSections = append(detailSections, component.SummarySection{
@@ -163,8 +170,8 @@ func PrintEvents(list *corev1.EventList, opts Options) (component.Component, err
 		row["Reason"] = component.NewText(event.Reason)
 		row["Type"] = component.NewText(event.Type)
 
-		row["First Seen"] = component.NewTimestamp(event.FirstTimestamp.Time)
-		row["Last Seen"] = component.NewTimestamp(event.LastTimestamp.Time)
+		row["First Seen"] = opts.Timestamp(event.FirstTimestamp.Time)
+		row["Last Seen"] = opts.Timestamp(event.LastTimestamp.Time)
 
 		row["From"] = component.NewText(formatEventSource(event.Source))
 
@@ -193,13 +200,15 @@ func createEventsForObject(ctx context.Context, fl *flexlayout.FlexLayout, objec
 		return errors.Wrap(err, "list events for object")
 	}
 
+	eventList.Items = eventfilter.From(ctx).Filter(eventList.Items)
+
 	if len(eventList.Items) > 0 {
 		eventTable, err := PrintEvents(eventList, opts)
 		if err != nil {
 			return errors.Wrap(err, "create event table for object")
 		}
 
-		eventsSection := fl.AddSection()
+		eventsSection := fl.AddNamedSection(SectionEvents, "")
 		if err := eventsSection.Add(eventTable, 24); err != nil {
 			return errors.Wrap(err, "add event table to layout")
 		}
@@ -231,32 +240,20 @@ func eventsForObject(ctx context.Context, object runtime.Object, o store.Store)
 		return nil, errors.Wrap(err, "get name for object")
 	}
 
-	key := store.Key{
-		Namespace:  namespace,
-		APIVersion: "v1",
-		Kind:       "Event",
-	}
-
-	list, _, err := o.List(ctx, key)
+	events, err := kubernetes.ListEvents(ctx, o, namespace)
 	if err != nil {
 		return nil, errors.Wrap(err, "list events for object")
 	}
 
 	eventList := &corev1.EventList{}
 
-	for _, unstructuredEvent := range list.Items {
-		event := &corev1.Event{}
-		err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredEvent.Object, event)
-		if err != nil {
-			return nil, err
-		}
-
+	for _, event := range events {
 		involvedObject := event.InvolvedObject
 		if involvedObject.Namespace == namespace &&
 			involvedObject.APIVersion == apiVersion &&
 			involvedObject.Kind == kind &&
 			involvedObject.Name == name {
-			eventList.Items = append(eventList.Items, *event)
+			eventList.Items = append(eventList.Items, event)
 		}
 	}
 
@@ -17,6 +17,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"github.com/vmware/octant/internal/eventfilter"
 	"github.com/vmware/octant/pkg/store"
 	storefake "github.com/vmware/octant/pkg/store/fake"
 	"github.com/vmware/octant/pkg/view/component"
@@ -348,6 +349,27 @@ func Test_eventsForObject(t *testing.T) {
 
 	o.EXPECT().List(gomock.Any(), gomock.Eq(key)).Return(events, false, nil)
 
+	eventsAPIKey := store.Key{
+		Namespace:  "default",
+		APIVersion: "events.k8s.io/v1beta1",
+		Kind:       "Event",
+	}
+
+	eventsAPIEvents := &unstructured.UnstructuredList{}
+	eventsAPIEvents.Items = append(eventsAPIEvents.Items, unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"regarding": map[string]interface{}{
+				"namespace":  "default",
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"name":       "pod",
+			},
+			"note": "events api",
+		},
+	})
+
+	o.EXPECT().List(gomock.Any(), gomock.Eq(eventsAPIKey)).Return(eventsAPIEvents, false, nil)
+
 	ctx := context.Background()
 	got, err := eventsForObject(ctx, object, o)
 	require.NoError(t, err)
@@ -363,8 +385,76 @@ func Test_eventsForObject(t *testing.T) {
 				},
 				Message: "pod",
 			},
+			{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "v1",
+					Kind:       "Event",
+				},
+				InvolvedObject: corev1.ObjectReference{
+					Namespace:  "default",
+					APIVersion: "v1",
+					Kind:       "Pod",
+					Name:       "pod",
+				},
+				Message: "events api",
+			},
 		},
 	}
 
 	assert.Equal(t, expected.Items, got.Items)
 }
+
+func Test_EventListHandler_filtered(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	newEvent := func(name, eventType, reason string) corev1.Event {
+		return corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Event"},
+			InvolvedObject: corev1.ObjectReference{
+				APIVersion: "v1",
+				Kind:       "Pod",
+				Name:       "pod",
+				Namespace:  "default",
+			},
+			Count:          1,
+			Message:        name,
+			Reason:         reason,
+			Type:           eventType,
+			FirstTimestamp: metav1.Time{Time: time.Unix(1548424410, 0)},
+			LastTimestamp:  metav1.Time{Time: time.Unix(1548424410, 0)},
+		}
+	}
+
+	object := &corev1.EventList{
+		Items: []corev1.Event{
+			newEvent("pulled", corev1.EventTypeNormal, "Pulled"),
+			newEvent("failed", corev1.EventTypeWarning, "Failed"),
+		},
+	}
+
+	tpo.PathForObject(&object.Items[1], "failed", "/failed")
+
+	ctx := eventfilter.WithPresets(context.Background(), eventfilter.Presets{eventfilter.PresetHideImagePull})
+	got, err := EventListHandler(ctx, object, printOptions)
+	require.NoError(t, err)
+
+	cols := component.NewTableCols("Kind", "Message", "Reason", "Type",
+		"First Seen", "Last Seen")
+	expected := component.NewTableWithRows("Events", "We couldn't find any events!", cols, []component.TableRow{
+		{
+			"Kind":       component.NewLink("", "pod (1)", "/overview/namespace/default/workloads/pods/pod"),
+			"Message":    component.NewLink("", "failed", "/failed"),
+			"Reason":     component.NewText("Failed"),
+			"Type":       component.NewText(corev1.EventTypeWarning),
+			"First Seen": component.NewTimestamp(time.Unix(1548424410, 0)),
+			"Last Seen":  component.NewTimestamp(time.Unix(1548424410, 0)),
+		},
+	})
+
+	component.AssertEqual(t, expected, got)
+}
@@ -10,6 +10,14 @@ type Handler interface {
 	Handler(printFunc interface{}) error
 }
 
+// HandlerRegistrar is implemented by modules which print objects with their
+// own handlers. It is called with the registry shared by every printer, so
+// handlers registered with WithPriority or WithOverride replace the built in
+// handlers everywhere objects are printed.
+type HandlerRegistrar interface {
+	RegisterPrintHandlers(registry *Registry) error
+}
+
 // AddHandlers adds print handlers to a printer.
 func AddHandlers(p Handler) error {
 	handlers := []interface{}{
@@ -27,10 +35,14 @@ func AddHandlers(p Handler) error {
 		DaemonSetHandler,
 		DeploymentHandler,
 		DeploymentListHandler,
+		HorizontalPodAutoscalerListHandler,
+		HorizontalPodAutoscalerHandler,
 		IngressListHandler,
 		IngressHandler,
 		JobListHandler,
 		JobHandler,
+		NamespaceListHandler,
+		NamespaceHandler,
 		NodeHandler,
 		NodeListHandler,
 		ReplicaSetHandler,
@@ -39,6 +51,8 @@ func AddHandlers(p Handler) error {
 		ReplicationControllerListHandler,
 		PodHandler,
 		PodListHandler,
+		PersistentVolumeHandler,
+		PersistentVolumeListHandler,
 		PersistentVolumeClaimHandler,
 		PersistentVolumeClaimListHandler,
 		ServiceAccountListHandler,
@@ -49,6 +63,8 @@ func AddHandlers(p Handler) error {
 		SecretListHandler,
 		StatefulSetHandler,
 		StatefulSetListHandler,
+		StorageClassHandler,
+		StorageClassListHandler,
 		RoleBindingListHandler,
 		RoleBindingHandler,
 		RoleListHandler,
@@ -6,12 +6,17 @@ SPDX-License-Identifier: Apache-2.0
 package printer
 
 import (
+	"testing"
+
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	configFake "github.com/vmware/octant/internal/config/fake"
 	linkFake "github.com/vmware/octant/internal/link/fake"
+	"github.com/vmware/octant/internal/notes"
 	portForwardFake "github.com/vmware/octant/internal/portforward/fake"
 	pluginFake "github.com/vmware/octant/pkg/plugin/fake"
 	objectStoreFake "github.com/vmware/octant/pkg/store/fake"
@@ -28,6 +33,7 @@ type testPrinterOptions struct {
 
 	objectStore   *objectStoreFake.MockStore
 	pluginManager *pluginFake.MockManagerInterface
+	portForwarder *portForwardFake.MockPortForwarder
 }
 
 func newTestPrinterOptions(controller *gomock.Controller) *testPrinterOptions {
@@ -41,12 +47,16 @@ func newTestPrinterOptions(controller *gomock.Controller) *testPrinterOptions {
 	dashConfig.EXPECT().ObjectStore().Return(objectStore).AnyTimes()
 	dashConfig.EXPECT().PluginManager().Return(pluginManager).AnyTimes()
 	dashConfig.EXPECT().PortForwarder().Return(portForwarder).AnyTimes()
+	dashConfig.EXPECT().NotesStorage().Return(notes.StorageAnnotation).AnyTimes()
+	dashConfig.EXPECT().MetricsProvider().Return(nil).AnyTimes()
+	dashConfig.EXPECT().ExecEnabled().Return(false).AnyTimes()
 
 	tpo := &testPrinterOptions{
 		dashConfig:    dashConfig,
 		link:          linkFake.NewMockInterface(controller),
 		objectStore:   objectStore,
 		pluginManager: pluginManager,
+		portForwarder: portForwarder,
 	}
 
 	tpo.dashConfig.EXPECT().Validate().Return(nil).AnyTimes()
@@ -75,3 +85,10 @@ func (o *testPrinterOptions) PathForOwner(parent runtime.Object, ownerReference
 	l := component.NewLink("", ownerReference.Name, ref)
 	o.link.EXPECT().ForOwner(parent, ownerReference).Return(l, nil)
 }
+
+// podRowWithActions adds the actions a pod list adds to each row to an
+// expected row.
+func podRowWithActions(t *testing.T, pod *corev1.Pod, row component.TableRow) component.TableRow {
+	require.NoError(t, addPodRowActions(row, pod))
+	return row
+}
@@ -0,0 +1,228 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+
+	"github.com/vmware/octant/internal/gvk"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// HorizontalPodAutoscalerListHandler is a printFunc that lists horizontal pod autoscalers
+func HorizontalPodAutoscalerListHandler(ctx context.Context, list *autoscalingv1.HorizontalPodAutoscalerList, options Options) (component.Component, error) {
+	if list == nil {
+		return nil, errors.New("nil list")
+	}
+
+	cols := component.NewTableCols("Name", "Labels", "Reference", "Targets", "Min Pods", "Max Pods", "Replicas", "Age")
+	tbl := component.NewTable("Horizontal Pod Autoscalers",
+		"We couldn't find any horizontal pod autoscalers!", cols)
+
+	for _, hpa := range list.Items {
+		row := component.TableRow{}
+
+		nameLink, err := options.Link.ForObject(&hpa, hpa.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		row["Name"] = nameLink
+
+		row["Labels"] = options.LabelLinks.Labels(hpa.Labels, hpa.Namespace, gvk.HorizontalPodAutoscaler)
+
+		reference, err := autoscalerReference(hpa, options)
+		if err != nil {
+			return nil, err
+		}
+
+		row["Reference"] = reference
+		row["Targets"] = component.NewText(autoscalerTargets(hpa))
+		row["Min Pods"] = component.NewText(fmt.Sprintf("%d", autoscalerMinReplicas(hpa)))
+		row["Max Pods"] = component.NewText(fmt.Sprintf("%d", hpa.Spec.MaxReplicas))
+		row["Replicas"] = component.NewText(fmt.Sprintf("%d", hpa.Status.CurrentReplicas))
+
+		ts := hpa.CreationTimestamp.Time
+		row["Age"] = options.Timestamp(ts)
+
+		if err := options.TableActions.AddRowActions(row, &hpa); err != nil {
+			return nil, err
+		}
+
+		tbl.Add(row)
+	}
+
+	options.TableActions.AddBulkActions(tbl)
+
+	return options.Profile.Apply(tbl), nil
+}
+
+// HorizontalPodAutoscalerHandler is a printFunc that prints a horizontal pod autoscaler
+func HorizontalPodAutoscalerHandler(ctx context.Context, hpa *autoscalingv1.HorizontalPodAutoscaler, options Options) (component.Component, error) {
+	if hpa == nil {
+		return nil, errors.New("can't print a nil horizontal pod autoscaler")
+	}
+
+	o := NewObject(hpa)
+	o.EnableEvents()
+
+	configGen := NewHorizontalPodAutoscalerConfiguration(hpa)
+	configView, err := configGen.Create(options)
+	if err != nil {
+		return nil, errors.Wrap(err, "print horizontal pod autoscaler configuration")
+	}
+
+	o.RegisterConfig(configView)
+
+	o.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createAutoscalerStatusView(hpa, options)
+		},
+	})
+
+	return o.ToComponent(ctx, options)
+}
+
+// HorizontalPodAutoscalerConfiguration generates horizontal pod autoscaler configuration
+type HorizontalPodAutoscalerConfiguration struct {
+	hpa *autoscalingv1.HorizontalPodAutoscaler
+}
+
+// NewHorizontalPodAutoscalerConfiguration creates an instance of HorizontalPodAutoscalerConfiguration
+func NewHorizontalPodAutoscalerConfiguration(hpa *autoscalingv1.HorizontalPodAutoscaler) *HorizontalPodAutoscalerConfiguration {
+	return &HorizontalPodAutoscalerConfiguration{
+		hpa: hpa,
+	}
+}
+
+// Create creates a horizontal pod autoscaler configuration summary
+func (hc *HorizontalPodAutoscalerConfiguration) Create(options Options) (*component.Summary, error) {
+	if hc == nil || hc.hpa == nil {
+		return nil, errors.New("horizontal pod autoscaler is nil")
+	}
+
+	hpa := hc.hpa
+
+	sections := component.SummarySections{}
+
+	reference, err := autoscalerReference(*hpa, options)
+	if err != nil {
+		return nil, err
+	}
+
+	sections = append(sections, component.SummarySection{
+		Header:  "Reference",
+		Content: reference,
+	})
+
+	sections.AddText("Min Replicas", fmt.Sprintf("%d", autoscalerMinReplicas(*hpa)))
+	sections.AddText("Max Replicas", fmt.Sprintf("%d", hpa.Spec.MaxReplicas))
+
+	if target := hpa.Spec.TargetCPUUtilizationPercentage; target != nil {
+		sections.AddText("Target CPU Utilization", fmt.Sprintf("%d%%", *target))
+	}
+
+	summary := component.NewSummary("Configuration", sections...)
+
+	editAction, err := editAutoscalerAction(hpa)
+	if err != nil {
+		return nil, errors.Wrap(err, "generate edit action")
+	}
+	summary.AddAction(editAction)
+
+	return summary, nil
+}
+
+// editAutoscalerAction creates an action which edits the replica bounds
+// and target CPU utilization of a horizontal pod autoscaler.
+func editAutoscalerAction(hpa *autoscalingv1.HorizontalPodAutoscaler) (component.Action, error) {
+	minReplicas := component.NewFormFieldNumber("Min Replicas", octant.HPAMinReplicasField,
+		fmt.Sprintf("%d", autoscalerMinReplicas(*hpa)))
+	minReplicas.SetValidation(octant.HPAReplicasValidation())
+
+	maxReplicas := component.NewFormFieldNumber("Max Replicas", octant.HPAMaxReplicasField,
+		fmt.Sprintf("%d", hpa.Spec.MaxReplicas))
+	maxReplicas.SetValidation(octant.HPAReplicasValidation())
+
+	var target string
+	if hpa.Spec.TargetCPUUtilizationPercentage != nil {
+		target = fmt.Sprintf("%d", *hpa.Spec.TargetCPUUtilizationPercentage)
+	}
+
+	targetCPU := component.NewFormFieldNumber("Target CPU Utilization (%)", octant.HPATargetCPUField, target)
+	targetCPU.SetValidation(octant.HPATargetCPUValidation())
+
+	form, err := component.CreateFormForObject(octant.ActionEditAutoscaler, hpa, minReplicas, maxReplicas, targetCPU)
+	if err != nil {
+		return component.Action{}, err
+	}
+
+	return component.Action{
+		Name:  "Edit",
+		Title: "Horizontal Pod Autoscaler Editor",
+		Form:  form,
+	}, nil
+}
+
+func createAutoscalerStatusView(hpa *autoscalingv1.HorizontalPodAutoscaler, options Options) (*component.Summary, error) {
+	sections := component.SummarySections{}
+
+	sections.AddText("Current Replicas", fmt.Sprintf("%d", hpa.Status.CurrentReplicas))
+	sections.AddText("Desired Replicas", fmt.Sprintf("%d", hpa.Status.DesiredReplicas))
+
+	if current := hpa.Status.CurrentCPUUtilizationPercentage; current != nil {
+		sections.AddText("Current CPU Utilization", fmt.Sprintf("%d%%", *current))
+	}
+
+	if lastScaleTime := hpa.Status.LastScaleTime; lastScaleTime != nil {
+		sections = append(sections, component.SummarySection{
+			Header:  "Last Scale Time",
+			Content: options.Timestamp(lastScaleTime.Time),
+		})
+	}
+
+	return component.NewSummary("Status", sections...), nil
+}
+
+// autoscalerReference links to the object a horizontal pod autoscaler scales.
+func autoscalerReference(hpa autoscalingv1.HorizontalPodAutoscaler, options Options) (component.Component, error) {
+	ref := hpa.Spec.ScaleTargetRef
+	text := fmt.Sprintf("%s/%s", ref.Kind, ref.Name)
+
+	return options.Link.ForGVK(hpa.Namespace, ref.APIVersion, ref.Kind, ref.Name, text)
+}
+
+// autoscalerTargets describes the current and target CPU utilization of a
+// horizontal pod autoscaler, e.g. 40%/80%.
+func autoscalerTargets(hpa autoscalingv1.HorizontalPodAutoscaler) string {
+	current := "<unknown>"
+	if hpa.Status.CurrentCPUUtilizationPercentage != nil {
+		current = fmt.Sprintf("%d%%", *hpa.Status.CurrentCPUUtilizationPercentage)
+	}
+
+	target := "<auto>"
+	if hpa.Spec.TargetCPUUtilizationPercentage != nil {
+		target = fmt.Sprintf("%d%%", *hpa.Spec.TargetCPUUtilizationPercentage)
+	}
+
+	return fmt.Sprintf("%s/%s", current, target)
+}
+
+// autoscalerMinReplicas returns the minimum replicas of a horizontal pod
+// autoscaler. The API server defaults it to 1.
+func autoscalerMinReplicas(hpa autoscalingv1.HorizontalPodAutoscaler) int32 {
+	if hpa.Spec.MinReplicas == nil {
+		return 1
+	}
+
+	return *hpa.Spec.MinReplicas
+}
@@ -0,0 +1,174 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func createHorizontalPodAutoscaler(name string) *autoscalingv1.HorizontalPodAutoscaler {
+	return &autoscalingv1.HorizontalPodAutoscaler{
+		TypeMeta: metav1.TypeMeta{APIVersion: "autoscaling/v1", Kind: "HorizontalPodAutoscaler"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			CreationTimestamp: metav1.Time{Time: testutil.Time()},
+			Labels:            map[string]string{"app": "web"},
+		},
+		Spec: autoscalingv1.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       "web",
+			},
+			MinReplicas:                    pointer.Int32Ptr(2),
+			MaxReplicas:                    10,
+			TargetCPUUtilizationPercentage: pointer.Int32Ptr(80),
+		},
+		Status: autoscalingv1.HorizontalPodAutoscalerStatus{
+			CurrentReplicas:                 3,
+			DesiredReplicas:                 3,
+			CurrentCPUUtilizationPercentage: pointer.Int32Ptr(40),
+		},
+	}
+}
+
+func Test_HorizontalPodAutoscalerListHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	hpa := createHorizontalPodAutoscaler("hpa")
+	unmanaged := createHorizontalPodAutoscaler("unmanaged")
+	unmanaged.Spec.MinReplicas = nil
+	unmanaged.Spec.TargetCPUUtilizationPercentage = nil
+	unmanaged.Status.CurrentCPUUtilizationPercentage = nil
+
+	list := &autoscalingv1.HorizontalPodAutoscalerList{
+		Items: []autoscalingv1.HorizontalPodAutoscaler{*hpa, *unmanaged},
+	}
+
+	tpo.PathForObject(hpa, "hpa", "/hpa")
+	tpo.PathForObject(unmanaged, "unmanaged", "/unmanaged")
+	tpo.PathForGVK("default", "apps/v1", "Deployment", "web", "Deployment/web", "/web")
+
+	got, err := HorizontalPodAutoscalerListHandler(context.Background(), list, printOptions)
+	require.NoError(t, err)
+
+	cols := component.NewTableCols("Name", "Labels", "Reference", "Targets", "Min Pods", "Max Pods", "Replicas", "Age")
+	expected := component.NewTable("Horizontal Pod Autoscalers", "We couldn't find any horizontal pod autoscalers!", cols)
+	expected.Add(component.TableRow{
+		"Name":      component.NewLink("", "hpa", "/hpa"),
+		"Labels":    component.NewLabels(map[string]string{"app": "web"}),
+		"Reference": component.NewLink("", "Deployment/web", "/web"),
+		"Targets":   component.NewText("40%/80%"),
+		"Min Pods":  component.NewText("2"),
+		"Max Pods":  component.NewText("10"),
+		"Replicas":  component.NewText("3"),
+		"Age":       component.NewTimestamp(testutil.Time()),
+	})
+	expected.Add(component.TableRow{
+		"Name":      component.NewLink("", "unmanaged", "/unmanaged"),
+		"Labels":    component.NewLabels(map[string]string{"app": "web"}),
+		"Reference": component.NewLink("", "Deployment/web", "/web"),
+		"Targets":   component.NewText("<unknown>/<auto>"),
+		"Min Pods":  component.NewText("1"),
+		"Max Pods":  component.NewText("10"),
+		"Replicas":  component.NewText("3"),
+		"Age":       component.NewTimestamp(testutil.Time()),
+	})
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_HorizontalPodAutoscalerConfiguration(t *testing.T) {
+	hpa := createHorizontalPodAutoscaler("hpa")
+
+	minReplicas := component.NewFormFieldNumber("Min Replicas", octant.HPAMinReplicasField, "2")
+	minReplicas.SetValidation(octant.HPAReplicasValidation())
+	maxReplicas := component.NewFormFieldNumber("Max Replicas", octant.HPAMaxReplicasField, "10")
+	maxReplicas.SetValidation(octant.HPAReplicasValidation())
+	targetCPU := component.NewFormFieldNumber("Target CPU Utilization (%)", octant.HPATargetCPUField, "80")
+	targetCPU.SetValidation(octant.HPATargetCPUValidation())
+
+	form, err := component.CreateFormForObject(octant.ActionEditAutoscaler, hpa, minReplicas, maxReplicas, targetCPU)
+	require.NoError(t, err)
+
+	expected := component.NewSummary("Configuration", []component.SummarySection{
+		{
+			Header:  "Reference",
+			Content: component.NewLink("", "Deployment/web", "/web"),
+		},
+		{
+			Header:  "Min Replicas",
+			Content: component.NewText("2"),
+		},
+		{
+			Header:  "Max Replicas",
+			Content: component.NewText("10"),
+		},
+		{
+			Header:  "Target CPU Utilization",
+			Content: component.NewText("80%"),
+		},
+	}...)
+	expected.AddAction(component.Action{
+		Name:  "Edit",
+		Title: "Horizontal Pod Autoscaler Editor",
+		Form:  form,
+	})
+
+	cases := []struct {
+		name     string
+		hpa      *autoscalingv1.HorizontalPodAutoscaler
+		isErr    bool
+		expected *component.Summary
+	}{
+		{
+			name:     "horizontal pod autoscaler",
+			hpa:      hpa,
+			expected: expected,
+		},
+		{
+			name:  "horizontal pod autoscaler is nil",
+			hpa:   nil,
+			isErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			tpo := newTestPrinterOptions(controller)
+			printOptions := tpo.ToOptions()
+			tpo.PathForGVK("default", "apps/v1", "Deployment", "web", "Deployment/web", "/web")
+
+			summary, err := NewHorizontalPodAutoscalerConfiguration(tc.hpa).Create(printOptions)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			component.AssertEqual(t, tc.expected, summary)
+		})
+	}
+}
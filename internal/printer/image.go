@@ -0,0 +1,113 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+const (
+	defaultImageRegistry = "docker.io"
+	defaultImageTag      = "latest"
+)
+
+// imageReference is a container image reference split into its parts.
+type imageReference struct {
+	registry    string
+	repository  string
+	tag         string
+	digest      string
+	implicitTag bool
+}
+
+// parseImageReference parses a container image reference using the same
+// defaults as the container runtime: images without a registry are pulled
+// from Docker Hub, and images without a tag or digest use the latest tag.
+func parseImageReference(image string) imageReference {
+	ref := imageReference{}
+
+	name := image
+	if i := strings.Index(name, "@"); i >= 0 {
+		ref.digest = name[i+1:]
+		name = name[:i]
+	}
+
+	// A tag follows the last colon, as long as it is not part of the
+	// registry host's port.
+	if i := strings.LastIndex(name, ":"); i >= 0 && !strings.Contains(name[i+1:], "/") {
+		ref.tag = name[i+1:]
+		name = name[:i]
+	}
+
+	ref.registry = defaultImageRegistry
+	if i := strings.Index(name, "/"); i >= 0 && isImageRegistry(name[:i]) {
+		ref.registry = name[:i]
+		name = name[i+1:]
+	}
+
+	if ref.registry == defaultImageRegistry && !strings.Contains(name, "/") {
+		name = "library/" + name
+	}
+	ref.repository = name
+
+	if ref.tag == "" && ref.digest == "" {
+		ref.tag = defaultImageTag
+		ref.implicitTag = true
+	}
+
+	return ref
+}
+
+// isImageRegistry returns true if the first component of an image name is
+// a registry host rather than part of a repository.
+func isImageRegistry(s string) bool {
+	return strings.ContainsAny(s, ".:") || s == "localhost"
+}
+
+// warnings returns problems with an image reference which make the image
+// that is run unpredictable.
+func (ref imageReference) warnings() []string {
+	var warnings []string
+
+	switch {
+	case ref.implicitTag:
+		warnings = append(warnings, "Image uses the implicit :latest tag")
+	case ref.tag == defaultImageTag:
+		warnings = append(warnings, "Image uses the :latest tag")
+	}
+
+	if ref.digest == "" {
+		warnings = append(warnings, "Image is not pinned to a digest")
+	}
+
+	return warnings
+}
+
+// printContainers creates a containers component which describes the
+// image reference for each container.
+func printContainers(containers []corev1.Container) *component.Containers {
+	view := component.NewContainers()
+
+	for _, c := range containers {
+		ref := parseImageReference(c.Image)
+
+		view.AddContainerDef(component.ContainerDef{
+			Name:       c.Name,
+			Image:      c.Image,
+			Registry:   ref.registry,
+			Repository: ref.repository,
+			Tag:        ref.tag,
+			Digest:     ref.digest,
+			Warnings:   ref.warnings(),
+		})
+	}
+
+	return view
+}
@@ -0,0 +1,93 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseImageReference(t *testing.T) {
+	digest := "sha256:2a2c5c8e4ec7f7c7d4f0c9e3f5bc8f35b9f1f05cb7e6c86cf7cd42c9a2c8fd8b"
+
+	tests := []struct {
+		name     string
+		image    string
+		expected imageReference
+		warnings []string
+	}{
+		{
+			name:  "implicit latest",
+			image: "nginx",
+			expected: imageReference{
+				registry:    "docker.io",
+				repository:  "library/nginx",
+				tag:         "latest",
+				implicitTag: true,
+			},
+			warnings: []string{"Image uses the implicit :latest tag", "Image is not pinned to a digest"},
+		},
+		{
+			name:  "explicit latest",
+			image: "bitnami/redis:latest",
+			expected: imageReference{
+				registry:   "docker.io",
+				repository: "bitnami/redis",
+				tag:        "latest",
+			},
+			warnings: []string{"Image uses the :latest tag", "Image is not pinned to a digest"},
+		},
+		{
+			name:  "registry with port",
+			image: "localhost:5000/team/app:1.2",
+			expected: imageReference{
+				registry:   "localhost:5000",
+				repository: "team/app",
+				tag:        "1.2",
+			},
+			warnings: []string{"Image is not pinned to a digest"},
+		},
+		{
+			name:  "registry with port without tag",
+			image: "registry.example.com:5000/app",
+			expected: imageReference{
+				registry:    "registry.example.com:5000",
+				repository:  "app",
+				tag:         "latest",
+				implicitTag: true,
+			},
+			warnings: []string{"Image uses the implicit :latest tag", "Image is not pinned to a digest"},
+		},
+		{
+			name:  "digest",
+			image: "gcr.io/kuar-demo/kuard-amd64@" + digest,
+			expected: imageReference{
+				registry:   "gcr.io",
+				repository: "kuar-demo/kuard-amd64",
+				digest:     digest,
+			},
+		},
+		{
+			name:  "tag and digest",
+			image: "quay.io/coreos/etcd:v3.3@" + digest,
+			expected: imageReference{
+				registry:   "quay.io",
+				repository: "coreos/etcd",
+				tag:        "v3.3",
+				digest:     digest,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := parseImageReference(test.image)
+			assert.Equal(t, test.expected, got)
+			assert.Equal(t, test.warnings, got.warnings())
+		})
+	}
+}
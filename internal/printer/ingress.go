@@ -15,6 +15,7 @@ import (
 	extv1beta1 "k8s.io/api/extensions/v1beta1"
 	"k8s.io/apimachinery/pkg/util/sets"
 
+	"github.com/vmware/octant/internal/gvk"
 	"github.com/vmware/octant/pkg/view/component"
 )
 
@@ -40,16 +41,22 @@ func IngressListHandler(_ context.Context, list *extv1beta1.IngressList, options
 		}
 
 		row["Name"] = nameLink
-		row["Labels"] = component.NewLabels(ingress.Labels)
+		row["Labels"] = options.LabelLinks.Labels(ingress.Labels, ingress.Namespace, gvk.Ingress)
 		row["Hosts"] = component.NewText(formatIngressHosts(ingress.Spec.Rules))
 		row["Address"] = component.NewText(loadBalancerStatusStringer(ingress.Status.LoadBalancer))
 		row["Ports"] = component.NewText(ports)
-		row["Age"] = component.NewTimestamp(ingress.CreationTimestamp.Time)
+		row["Age"] = options.Timestamp(ingress.CreationTimestamp.Time)
+
+		if err := options.TableActions.AddRowActions(row, &ingress); err != nil {
+			return nil, err
+		}
 
 		table.Add(row)
 	}
 
-	return table, nil
+	options.TableActions.AddBulkActions(table)
+
+	return options.Profile.Apply(table), nil
 }
 
 // IngressHandler is a printFunc that prints an Ingress
@@ -70,6 +77,10 @@ func IngressHandler(ctx context.Context, ingress *extv1beta1.Ingress, options Op
 		return nil, errors.Wrap(err, "print ingress rules")
 	}
 
+	if err := addReachabilityButton(o, ingress); err != nil {
+		return nil, errors.Wrap(err, "add reachability check")
+	}
+
 	return o.ToComponent(ctx, options)
 }
 
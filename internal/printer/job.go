@@ -15,12 +15,13 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/vmware/octant/internal/conversion"
+	"github.com/vmware/octant/internal/gvk"
 	"github.com/vmware/octant/pkg/store"
 	"github.com/vmware/octant/pkg/view/component"
 )
 
 var (
-	JobCols = component.NewTableCols("Name", "Labels", "Completions", "Successful", "Age")
+	JobCols = component.NewTableCols("Name", "Labels", "Completions", "Successful", "Age", "Containers")
 )
 
 // JobListHandler prints a job list.
@@ -40,16 +41,23 @@ func JobListHandler(_ context.Context, list *batchv1.JobList, opts Options) (com
 		}
 
 		row["Name"] = nameLink
-		row["Labels"] = component.NewLabels(job.Labels)
+		row["Labels"] = opts.LabelLinks.Labels(job.Labels, job.Namespace, gvk.Job)
 		row["Completions"] = component.NewText(conversion.PtrInt32ToString(job.Spec.Completions))
 		succeeded := fmt.Sprintf("%d", job.Status.Succeeded)
 		row["Successful"] = component.NewText(succeeded)
-		row["Age"] = component.NewTimestamp(job.CreationTimestamp.Time)
+		row["Age"] = opts.Timestamp(job.CreationTimestamp.Time)
+		row["Containers"] = printContainers(job.Spec.Template.Spec.Containers)
+
+		if err := opts.TableActions.AddRowActions(row, &job); err != nil {
+			return nil, err
+		}
 
 		table.Add(row)
 	}
 
-	return table, nil
+	opts.TableActions.AddBulkActions(table)
+
+	return opts.Profile.Apply(table), nil
 }
 
 // JobHandler printers a job.
@@ -111,15 +119,15 @@ func (j *JobConfiguration) Create(option Options) (*component.Summary, error) {
 	return summary, nil
 }
 
-func createJobStatus(job batchv1.Job) (*component.Summary, error) {
+func createJobStatus(job batchv1.Job, options Options) (*component.Summary, error) {
 	sections := component.SummarySections{}
 
 	if startTime := job.Status.StartTime; startTime != nil {
-		sections.Add("Started", component.NewTimestamp(startTime.Time))
+		sections.Add("Started", options.Timestamp(startTime.Time))
 	}
 
 	if completionTime := job.Status.CompletionTime; completionTime != nil {
-		sections.Add("Completed", component.NewTimestamp(completionTime.Time))
+		sections.Add("Completed", options.Timestamp(completionTime.Time))
 	}
 
 	sections.Add("Succeeded", component.NewText(fmt.Sprintf("%d", job.Status.Succeeded)))
@@ -128,29 +136,24 @@ func createJobStatus(job batchv1.Job) (*component.Summary, error) {
 	return summary, nil
 }
 
-func createJobConditions(conditions []batchv1.JobCondition) (*component.Table, error) {
-	cols := component.NewTableCols("Type", "Last Probe", "Last Transition",
-		"Status", "Message", "Reason")
-	table := component.NewTable("Conditions", "There are no job conditions!", cols)
-
-	for _, condition := range conditions {
-		row := component.TableRow{}
-
-		row["Type"] = component.NewText(string(condition.Type))
-		row["Last Probe"] = component.NewTimestamp(condition.LastProbeTime.Time)
-		row["Last Transition"] = component.NewTimestamp(condition.LastTransitionTime.Time)
-		row["Status"] = component.NewText(string(condition.Status))
-		row["Message"] = component.NewText(condition.Message)
-		row["Reason"] = component.NewText(condition.Reason)
-
-		table.Add(row)
+func createJobConditions(conditions []batchv1.JobCondition, options Options) (*component.Table, error) {
+	var list []condition
+	for _, c := range conditions {
+		list = append(list, condition{
+			Type:           string(c.Type),
+			Status:         c.Status,
+			Reason:         c.Reason,
+			Message:        c.Message,
+			LastUpdate:     c.LastProbeTime,
+			LastTransition: c.LastTransitionTime,
+		})
 	}
 
-	return table, nil
+	return createConditionsTable("job", list, options), nil
 }
 
 func createJobListView(ctx context.Context, object runtime.Object, options Options) (component.Component, error) {
-	options.DisableLabels = true
+	options.Profile = ProfileEmbedded
 
 	jobList := &batchv1.JobList{}
 
@@ -273,7 +276,7 @@ func (j *jobHandler) Status(options Options) error {
 }
 
 func defaultJobStatus(job *batchv1.Job, options Options) (*component.Summary, error) {
-	return createJobStatus(*job)
+	return createJobStatus(*job, options)
 }
 
 func (j *jobHandler) Pods(ctx context.Context, object runtime.Object, options Options) error {
@@ -308,5 +311,5 @@ func (j *jobHandler) Conditions(options Options) error {
 }
 
 func defaultJobConditions(job *batchv1.Job, options Options) (*component.Table, error) {
-	return createJobConditions(job.Status.Conditions)
+	return createJobConditions(job.Status.Conditions, options)
 }
@@ -6,6 +6,8 @@ SPDX-License-Identifier: Apache-2.0
 package printer
 
 import (
+	"context"
+
 	"github.com/pkg/errors"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -26,7 +28,7 @@ func NewJobTemplate(parent runtime.Object, jobTemplateSpec batchv1beta1.JobTempl
 	}
 }
 
-func (jt *JobTemplate) AddToFlexLayout(fl *flexlayout.FlexLayout, options Options) error {
+func (jt *JobTemplate) AddToFlexLayout(ctx context.Context, fl *flexlayout.FlexLayout, options Options) error {
 	if fl == nil {
 		return errors.New("flex layout is nil")
 	}
@@ -44,11 +46,11 @@ func (jt *JobTemplate) AddToFlexLayout(fl *flexlayout.FlexLayout, options Option
 		return errors.Wrap(err, "add job template header")
 	}
 
-	containerSection := fl.AddSection()
+	containerSection := fl.AddNamedSection(SectionContainers, "")
 
 	for _, container := range jt.jobTemplateSpec.Spec.Template.Spec.Containers {
 		containerConfig := NewContainerConfiguration(jt.parent, &container, portForwarder, false, options)
-		summary, err := containerConfig.Create()
+		summary, err := containerConfig.Create(ctx)
 		if err != nil {
 			return err
 		}
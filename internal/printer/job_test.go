@@ -69,6 +69,7 @@ func Test_JobListHandler(t *testing.T) {
 		"Completions": component.NewText("1"),
 		"Successful":  component.NewText("1"),
 		"Age":         component.NewTimestamp(validJobCreationTime),
+		"Containers":  component.NewContainers(),
 	})
 
 	component.AssertEqual(t, expected, got)
@@ -143,7 +144,7 @@ func Test_createJobStatus(t *testing.T) {
 	job.Status.StartTime = &metav1.Time{Time: testutil.Time()}
 	job.Status.CompletionTime = &metav1.Time{Time: time.Now()}
 
-	got, err := createJobStatus(*job)
+	got, err := createJobStatus(*job, Options{})
 	require.NoError(t, err)
 
 	sections := component.SummarySections{
@@ -171,18 +172,16 @@ func Test_createJobConditions(t *testing.T) {
 		},
 	}
 
-	got, err := createJobConditions(job.Status.Conditions)
+	got, err := createJobConditions(job.Status.Conditions, Options{})
 	require.NoError(t, err)
 
-	cols := component.NewTableCols("Type", "Last Probe", "Last Transition",
-		"Status", "Message", "Reason")
-	expected := component.NewTable("Conditions", "There are no job conditions!", cols)
+	expected := component.NewTable("Conditions", "There are no job conditions!", conditionColumns)
 	expected.Add([]component.TableRow{
 		{
 			"Type":            component.NewText("Complete"),
-			"Last Probe":      component.NewTimestamp(now.Time),
+			"Last Update":     component.NewTimestamp(now.Time),
 			"Last Transition": component.NewTimestamp(now.Time),
-			"Status":          component.NewText("True"),
+			"Status":          component.NewStatusText("True", component.NodeStatusOK),
 			"Message":         component.NewText("message"),
 			"Reason":          component.NewText("reason"),
 		},
@@ -0,0 +1,46 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware/octant/internal/link"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// LabelLinks links labels to the list of objects of the same kind, so
+// clicking a label shows the objects with the label.
+type LabelLinks struct {
+	config link.Config
+}
+
+// NewLabelLinks creates an instance of LabelLinks.
+func NewLabelLinks(config link.Config) *LabelLinks {
+	return &LabelLinks{
+		config: config,
+	}
+}
+
+// Labels creates a labels component for an object. The labels are linked
+// to the list of objects of the object's kind in its namespace. They are
+// not linked if the list does not have a content path or if the LabelLinks
+// is nil.
+func (ll *LabelLinks) Labels(labels map[string]string, namespace string, objectGVK schema.GroupVersionKind) *component.Labels {
+	view := component.NewLabels(labels)
+	if ll == nil || len(labels) == 0 || objectGVK.Kind == "" {
+		return view
+	}
+
+	apiVersion, kind := objectGVK.ToAPIVersionAndKind()
+	contentPath, err := ll.config.ObjectPath(namespace, apiVersion, kind, "")
+	if err != nil || contentPath == "" {
+		return view
+	}
+
+	view.SetLinks(contentPath)
+	return view
+}
@@ -0,0 +1,69 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware/octant/internal/gvk"
+	linkFake "github.com/vmware/octant/internal/link/fake"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func TestLabelLinks_Labels(t *testing.T) {
+	labels := map[string]string{"app": "nginx"}
+
+	linked := component.NewLabels(labels)
+	linked.SetLinks("/overview/namespace/default/workloads/pods")
+
+	tests := []struct {
+		name        string
+		contentPath string
+		err         error
+		expected    *component.Labels
+	}{
+		{
+			name:        "linked",
+			contentPath: "/overview/namespace/default/workloads/pods",
+			expected:    linked,
+		},
+		{
+			name:     "kind without a content path",
+			expected: component.NewLabels(labels),
+		},
+		{
+			name:     "content path error",
+			err:      errors.New("failed"),
+			expected: component.NewLabels(labels),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			config := linkFake.NewMockConfig(controller)
+			config.EXPECT().
+				ObjectPath("default", "v1", "Pod", "").
+				Return(test.contentPath, test.err)
+
+			ll := NewLabelLinks(config)
+			assert.Equal(t, test.expected, ll.Labels(labels, "default", gvk.Pod))
+		})
+	}
+}
+
+func TestLabelLinks_nil(t *testing.T) {
+	var ll *LabelLinks
+
+	labels := map[string]string{"app": "nginx"}
+	assert.Equal(t, component.NewLabels(labels), ll.Labels(labels, "default", gvk.Pod))
+}
@@ -6,43 +6,45 @@ SPDX-License-Identifier: Apache-2.0
 package printer
 
 import (
+	"context"
+
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
-	link2 "github.com/vmware/octant/internal/link"
+	"github.com/vmware/octant/internal/notes"
 	"github.com/vmware/octant/pkg/view/component"
 	"github.com/vmware/octant/pkg/view/flexlayout"
 )
 
 type Metadata struct {
-	object runtime.Object
-	link   link2.Interface
+	object  runtime.Object
+	options Options
 }
 
-func NewMetadata(object runtime.Object, l link2.Interface) (*Metadata, error) {
+func NewMetadata(object runtime.Object, options Options) (*Metadata, error) {
 	if object == nil {
 		return nil, errors.New("object is nil")
 	}
 
-	if l == nil {
+	if options.Link == nil {
 		return nil, errors.New("link generator is nil")
 	}
 
 	return &Metadata{
-		object: object,
-		link:   l,
+		object:  object,
+		options: options,
 	}, nil
 }
 
-func (m *Metadata) AddToFlexLayout(fl *flexlayout.FlexLayout) error {
+func (m *Metadata) AddToFlexLayout(ctx context.Context, fl *flexlayout.FlexLayout) error {
 	if fl == nil {
 		return errors.New("flex layout is nil")
 	}
 
-	section := fl.AddSection()
+	section := fl.AddNamedSection(SectionMetadata, "")
 
-	summary, err := m.createSummary()
+	summary, err := m.createSummary(ctx)
 	if err != nil {
 		return errors.Wrap(err, "create summary")
 	}
@@ -51,10 +53,43 @@ func (m *Metadata) AddToFlexLayout(fl *flexlayout.FlexLayout) error {
 		return errors.Wrap(err, "add summary to layout")
 	}
 
+	if annotations := m.createAnnotations(); annotations != nil {
+		annotationsSection := fl.AddNamedSection(SectionAnnotations, "")
+		if err := annotationsSection.Add(annotations, component.WidthFull); err != nil {
+			return errors.Wrap(err, "add annotations to layout")
+		}
+	}
+
 	return nil
 }
 
-func (m *Metadata) createSummary() (*component.Summary, error) {
+// createAnnotations creates a titled annotations component which is shown
+// separately from the summary, so long annotation values can be collapsed.
+// It returns nil if the object has no annotations. Notes are shown in their
+// own section, so the notes annotation is not included.
+func (m *Metadata) createAnnotations() *component.Annotations {
+	object, ok := m.object.(metav1.Object)
+	if !ok {
+		return nil
+	}
+
+	annotations := make(map[string]string)
+	for k, v := range object.GetAnnotations() {
+		if k != notes.Annotation {
+			annotations[k] = v
+		}
+	}
+	if len(annotations) == 0 {
+		return nil
+	}
+
+	view := component.NewAnnotations(annotations)
+	view.SetTitleText("Annotations")
+
+	return view
+}
+
+func (m *Metadata) createSummary(ctx context.Context) (*component.Summary, error) {
 	sections := component.SummarySections{}
 
 	object, ok := m.object.(metav1.Object)
@@ -62,28 +97,18 @@ func (m *Metadata) createSummary() (*component.Summary, error) {
 		return nil, errors.New("object is a meta v1 object")
 	}
 
-	sections.Add("Age", component.NewTimestamp(object.GetCreationTimestamp().Time))
+	sections.Add("Age", m.options.Timestamp(object.GetCreationTimestamp().Time))
 
 	if labels := object.GetLabels(); len(labels) > 0 {
-		sections.Add("Labels", component.NewLabels(labels))
+		sections.Add("Labels", m.options.LabelLinks.Labels(labels, object.GetNamespace(), m.object.GetObjectKind().GroupVersionKind()))
 	}
 
-	if annotations := object.GetAnnotations(); len(annotations) > 0 {
-		sections.Add("Annotations", component.NewAnnotations(annotations))
+	controlledBy, err := createOwnerChainView(ctx, m.object, m.options)
+	if err != nil {
+		return nil, errors.Wrap(err, "create owner chain")
 	}
 
-	ownerReference := metav1.GetControllerOf(object)
-	if ownerReference != nil {
-		controlledBy, err := m.link.ForGVK(
-			object.GetNamespace(),
-			ownerReference.APIVersion,
-			ownerReference.Kind,
-			ownerReference.Name,
-			ownerReference.Name,
-		)
-		if err != nil {
-			return nil, err
-		}
+	if controlledBy != nil {
 		sections.Add("Controlled By", controlledBy)
 	}
 
@@ -6,12 +6,14 @@ SPDX-License-Identifier: Apache-2.0
 package printer
 
 import (
+	"context"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/vmware/octant/internal/notes"
 	"github.com/vmware/octant/internal/testutil"
 	"github.com/vmware/octant/pkg/view/component"
 	"github.com/vmware/octant/pkg/view/flexlayout"
@@ -26,27 +28,70 @@ func Test_Metadata(t *testing.T) {
 	fl := flexlayout.New()
 
 	deployment := testutil.CreateDeployment("deployment")
-	metadata, err := NewMetadata(deployment, tpo.link)
+	metadata, err := NewMetadata(deployment, tpo.ToOptions())
 	require.NoError(t, err)
 
-	require.NoError(t, metadata.AddToFlexLayout(fl))
+	require.NoError(t, metadata.AddToFlexLayout(context.Background(), fl))
 
 	got := fl.ToComponent("Summary")
 
 	expected := component.NewFlexLayout("Summary")
-	expected.AddSections([]component.FlexLayoutSection{
+	expected.AddNamedSection(SectionMetadata, "", component.FlexLayoutSection{
 		{
-			{
-				Width: component.WidthFull,
-				View: component.NewSummary("Metadata", component.SummarySections{
-					{
-						Header:  "Age",
-						Content: component.NewTimestamp(deployment.CreationTimestamp.Time),
-					},
-				}...),
-			},
+			Width: component.WidthFull,
+			View: component.NewSummary("Metadata", component.SummarySections{
+				{
+					Header:  "Age",
+					Content: component.NewTimestamp(deployment.CreationTimestamp.Time),
+				},
+			}...),
 		},
-	}...)
+	})
+
+	assert.Equal(t, expected, got)
+}
+
+func Test_Metadata_annotations(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	fl := flexlayout.New()
+
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.Annotations = map[string]string{
+		"key":            "value",
+		notes.Annotation: "notes",
+	}
+	metadata, err := NewMetadata(deployment, tpo.ToOptions())
+	require.NoError(t, err)
+
+	require.NoError(t, metadata.AddToFlexLayout(context.Background(), fl))
+
+	got := fl.ToComponent("Summary")
+
+	annotations := component.NewAnnotations(map[string]string{"key": "value"})
+	annotations.SetTitleText("Annotations")
+
+	expected := component.NewFlexLayout("Summary")
+	expected.AddNamedSection(SectionMetadata, "", component.FlexLayoutSection{
+		{
+			Width: component.WidthFull,
+			View: component.NewSummary("Metadata", component.SummarySections{
+				{
+					Header:  "Age",
+					Content: component.NewTimestamp(deployment.CreationTimestamp.Time),
+				},
+			}...),
+		},
+	})
+	expected.AddNamedSection(SectionAnnotations, "", component.FlexLayoutSection{
+		{
+			Width: component.WidthFull,
+			View:  annotations,
+		},
+	})
 
 	assert.Equal(t, expected, got)
 }
@@ -0,0 +1,174 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware/octant/internal/metrics"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+var (
+	podMetricsCols = component.NewTableCols("Container", "CPU", "Memory")
+)
+
+// metricsAvailable returns true if options can query resource usage. Objects
+// are printed without usage when metrics-server isn't installed.
+func metricsAvailable(options Options) bool {
+	return options.Metrics != nil && options.Metrics.Available()
+}
+
+// registerPodMetrics registers the current resource usage of a pod's
+// containers.
+func registerPodMetrics(ctx context.Context, o *Object, pod *corev1.Pod, options Options) {
+	if !metricsAvailable(options) {
+		return
+	}
+
+	o.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createPodMetricsView(ctx, pod, options)
+		},
+	})
+}
+
+func createPodMetricsView(ctx context.Context, pod *corev1.Pod, options Options) (*component.Table, error) {
+	if pod == nil {
+		return nil, errors.New("pod is nil")
+	}
+
+	table := component.NewTable("Resource Usage", "Resource usage is not available yet", podMetricsCols)
+
+	podMetrics, err := options.Metrics.PodMetrics(ctx, pod.Namespace, pod.Name)
+	if err != nil {
+		if kerrors.IsNotFound(errors.Cause(err)) {
+			return table, nil
+		}
+		return nil, errors.Wrap(err, "get pod metrics")
+	}
+
+	for _, container := range pod.Spec.Containers {
+		usage, ok := podMetrics.Container(container.Name)
+		if !ok {
+			continue
+		}
+
+		table.Add(component.TableRow{
+			"Container": component.NewText(container.Name),
+			"CPU":       cpuUsageText(usage.CPU),
+			"Memory":    memoryUsageText(usage.Memory),
+		})
+	}
+
+	return table, nil
+}
+
+// registerWorkloadMetrics registers the current resource usage of the pods
+// selected by a workload.
+func registerWorkloadMetrics(ctx context.Context, o *Object, namespace string, selector *metav1.LabelSelector, options Options) {
+	if !metricsAvailable(options) || selector == nil {
+		return
+	}
+
+	o.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createWorkloadMetricsView(ctx, namespace, selector, options)
+		},
+	})
+}
+
+func createWorkloadMetricsView(ctx context.Context, namespace string, selector *metav1.LabelSelector, options Options) (*component.Summary, error) {
+	labelSelector, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return nil, errors.Wrap(err, "convert label selector")
+	}
+
+	podMetrics, err := options.Metrics.PodMetricsList(ctx, namespace, labelSelector)
+	if err != nil {
+		return nil, errors.Wrap(err, "list pod metrics")
+	}
+
+	var total metrics.Usage
+	for i := range podMetrics {
+		total.Add(podMetrics[i].Total())
+	}
+
+	var sections component.SummarySections
+	sections.Add("CPU", cpuUsageText(total.CPU))
+	sections.Add("Memory", memoryUsageText(total.Memory))
+	sections.AddText("Pods", fmt.Sprintf("%d", len(podMetrics)))
+
+	return component.NewSummary("Resource Usage", sections...), nil
+}
+
+// registerNodeMetrics registers the current resource usage of a node.
+func registerNodeMetrics(ctx context.Context, o *Object, node *corev1.Node, options Options) {
+	if !metricsAvailable(options) {
+		return
+	}
+
+	o.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createNodeMetricsView(ctx, node, options)
+		},
+	})
+}
+
+func createNodeMetricsView(ctx context.Context, node *corev1.Node, options Options) (*component.Summary, error) {
+	if node == nil {
+		return nil, errors.New("node is nil")
+	}
+
+	nodeMetrics, err := options.Metrics.NodeMetrics(ctx, node.Name)
+	if err != nil {
+		if kerrors.IsNotFound(errors.Cause(err)) {
+			return component.NewSummary("Resource Usage"), nil
+		}
+		return nil, errors.Wrap(err, "get node metrics")
+	}
+
+	allocatable := metrics.NewUsage(node.Status.Allocatable)
+
+	var sections component.SummarySections
+	sections.Add("CPU", usageOfText(cpuUsageText(nodeMetrics.Usage.CPU).Config.Text, nodeMetrics.Usage.CPU, allocatable.CPU))
+	sections.Add("Memory", usageOfText(memoryUsageText(nodeMetrics.Usage.Memory).Config.Text, nodeMetrics.Usage.Memory, allocatable.Memory))
+
+	return component.NewSummary("Resource Usage", sections...), nil
+}
+
+// cpuUsageText shows CPU usage in millicores.
+func cpuUsageText(q resource.Quantity) *component.Text {
+	milli := q.MilliValue()
+	return component.NewNumberText(fmt.Sprintf("%dm", milli), float64(milli))
+}
+
+// memoryUsageText shows memory usage in mebibytes.
+func memoryUsageText(q resource.Quantity) *component.Text {
+	mebibytes := q.Value() / (1024 * 1024)
+	return component.NewNumberText(fmt.Sprintf("%dMi", mebibytes), float64(q.Value()))
+}
+
+// usageOfText appends the percentage of capacity used to a usage. The usage
+// is shown as is when capacity is unknown.
+func usageOfText(usage string, used, capacity resource.Quantity) *component.Text {
+	if capacity.IsZero() {
+		return component.NewText(usage)
+	}
+
+	percent := float64(used.MilliValue()) / float64(capacity.MilliValue()) * 100
+	return component.NewNumberText(fmt.Sprintf("%s (%.0f%%)", usage, percent), percent)
+}
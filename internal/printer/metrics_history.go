@@ -0,0 +1,107 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware/octant/internal/metrics"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+const (
+	// metricsHistoryDuration is how far back metrics history is graphed.
+	metricsHistoryDuration = time.Hour
+	// metricsHistoryStep is the resolution of metrics history.
+	metricsHistoryStep = time.Minute
+)
+
+// historyQuery is a query for the history of a metric.
+type historyQuery struct {
+	title string
+	unit  string
+	query string
+}
+
+// metricsProvider returns the provider of metrics history for options. It
+// returns nil if none is configured.
+func metricsProvider(options Options) metrics.Provider {
+	if options.DashConfig == nil {
+		return nil
+	}
+
+	return options.DashConfig.MetricsProvider()
+}
+
+// registerPodMetricsHistory registers graphs of the CPU and memory history
+// of a pod's containers.
+func registerPodMetricsHistory(ctx context.Context, o *Object, pod *corev1.Pod, options Options) {
+	matcher := fmt.Sprintf(`namespace=%q,pod=%q,container!="",container!="POD"`, pod.Namespace, pod.Name)
+	registerMetricsHistory(ctx, o, options, "container", matcher)
+}
+
+// registerDeploymentMetricsHistory registers graphs of the CPU and memory
+// history of a deployment's pods. Pods are matched by the names the
+// deployment's replica sets give them.
+func registerDeploymentMetricsHistory(ctx context.Context, o *Object, deployment *appsv1.Deployment, options Options) {
+	matcher := fmt.Sprintf(`namespace=%q,pod=~%q,container!="",container!="POD"`,
+		deployment.Namespace, deployment.Name+"-[a-z0-9]+-[a-z0-9]+")
+	registerMetricsHistory(ctx, o, options, "pod", matcher)
+}
+
+func registerMetricsHistory(ctx context.Context, o *Object, options Options, by, matcher string) {
+	provider := metricsProvider(options)
+	if provider == nil {
+		return
+	}
+
+	queries := []historyQuery{
+		{
+			title: "CPU History",
+			unit:  "cores",
+			query: fmt.Sprintf("sum(rate(container_cpu_usage_seconds_total{%s}[5m])) by (%s)", matcher, by),
+		},
+		{
+			title: "Memory History",
+			unit:  "bytes",
+			query: fmt.Sprintf("sum(container_memory_working_set_bytes{%s}) by (%s)", matcher, by),
+		},
+	}
+
+	r := metrics.RangeEndingAt(options.Now(), metricsHistoryDuration, metricsHistoryStep)
+
+	for i := range queries {
+		hq := queries[i]
+		o.RegisterItems(ItemDescriptor{
+			Width: component.WidthHalf,
+			Func: func() (component.Component, error) {
+				return createMetricsHistoryView(ctx, provider, hq, r)
+			},
+		})
+	}
+}
+
+func createMetricsHistoryView(ctx context.Context, provider metrics.Provider, hq historyQuery, r metrics.Range) (*component.Timeseries, error) {
+	list, err := provider.QueryRange(ctx, hq.query, r)
+	if err != nil {
+		return nil, errors.Wrapf(err, "query %s", hq.title)
+	}
+
+	timeseries := component.NewTimeseries(hq.title, hq.unit)
+	for _, series := range list {
+		for _, point := range series.Points {
+			timeseries.AddPoint(series.Name, point.Timestamp, point.Value)
+		}
+	}
+
+	return timeseries, nil
+}
@@ -0,0 +1,60 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/octant/internal/metrics"
+	metricsFake "github.com/vmware/octant/internal/metrics/fake"
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func Test_createMetricsHistoryView(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	now := time.Unix(1569931200, 0)
+	r := metrics.RangeEndingAt(now, time.Hour, time.Minute)
+	hq := historyQuery{title: "CPU History", unit: "cores", query: "query"}
+
+	provider := metricsFake.NewMockProvider(controller)
+	provider.EXPECT().QueryRange(gomock.Any(), "query", r).
+		Return([]metrics.Series{
+			{Name: "a", Points: []metrics.Point{{Timestamp: now.Add(-time.Minute), Value: 0.25}, {Timestamp: now, Value: 0.5}}},
+			{Name: "b", Points: []metrics.Point{{Timestamp: now, Value: 1}}},
+		}, nil)
+
+	got, err := createMetricsHistoryView(context.Background(), provider, hq, r)
+	require.NoError(t, err)
+
+	expected := component.NewTimeseries("CPU History", "cores")
+	expected.AddPoint("a", now.Add(-time.Minute), 0.25)
+	expected.AddPoint("a", now, 0.5)
+	expected.AddPoint("b", now, 1)
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_registerPodMetricsHistory_not_configured(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	pod := testutil.CreatePod("pod")
+	o := NewObject(pod)
+	registerPodMetricsHistory(context.Background(), o, pod, tpo.ToOptions())
+	require.Empty(t, o.itemsLists)
+
+	registerPodMetricsHistory(context.Background(), o, pod, Options{})
+	require.Empty(t, o.itemsLists)
+}
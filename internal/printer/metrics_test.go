@@ -0,0 +1,127 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/vmware/octant/internal/metrics"
+	metricsFake "github.com/vmware/octant/internal/metrics/fake"
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func Test_createPodMetricsView(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pod := testutil.CreatePod("pod")
+	pod.Spec.Containers = []corev1.Container{{Name: "a"}, {Name: "b"}}
+
+	metricsClient := metricsFake.NewMockInterface(controller)
+	metricsClient.EXPECT().PodMetrics(gomock.Any(), "namespace", "pod").
+		Return(&metrics.PodMetrics{
+			Namespace: "namespace",
+			Name:      "pod",
+			Containers: []metrics.ContainerMetrics{
+				{Name: "a", Usage: metrics.Usage{CPU: resource.MustParse("250m"), Memory: resource.MustParse("64Mi")}},
+				{Name: "b", Usage: metrics.Usage{CPU: resource.MustParse("1"), Memory: resource.MustParse("1Gi")}},
+			},
+		}, nil)
+
+	got, err := createPodMetricsView(context.Background(), pod, Options{Metrics: metricsClient})
+	require.NoError(t, err)
+
+	expected := component.NewTableWithRows("Resource Usage", "Resource usage is not available yet", podMetricsCols,
+		[]component.TableRow{
+			{
+				"Container": component.NewText("a"),
+				"CPU":       component.NewNumberText("250m", 250),
+				"Memory":    component.NewNumberText("64Mi", 64*1024*1024),
+			},
+			{
+				"Container": component.NewText("b"),
+				"CPU":       component.NewNumberText("1000m", 1000),
+				"Memory":    component.NewNumberText("1024Mi", 1024*1024*1024),
+			},
+		})
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_createWorkloadMetricsView(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "app"}}
+
+	metricsClient := metricsFake.NewMockInterface(controller)
+	metricsClient.EXPECT().PodMetricsList(gomock.Any(), "namespace", labels.SelectorFromSet(selector.MatchLabels)).
+		Return([]metrics.PodMetrics{
+			{Containers: []metrics.ContainerMetrics{{Usage: metrics.Usage{CPU: resource.MustParse("100m"), Memory: resource.MustParse("10Mi")}}}},
+			{Containers: []metrics.ContainerMetrics{{Usage: metrics.Usage{CPU: resource.MustParse("150m"), Memory: resource.MustParse("20Mi")}}}},
+		}, nil)
+
+	got, err := createWorkloadMetricsView(context.Background(), "namespace", selector, Options{Metrics: metricsClient})
+	require.NoError(t, err)
+
+	expected := component.NewSummary("Resource Usage", []component.SummarySection{
+		{Header: "CPU", Content: component.NewNumberText("250m", 250)},
+		{Header: "Memory", Content: component.NewNumberText("30Mi", 30*1024*1024)},
+		{Header: "Pods", Content: component.NewText("2")},
+	}...)
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_createNodeMetricsView(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	node := testutil.CreateNode("node")
+	node.Status.Allocatable = corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("2"),
+		corev1.ResourceMemory: resource.MustParse("4Gi"),
+	}
+
+	metricsClient := metricsFake.NewMockInterface(controller)
+	metricsClient.EXPECT().NodeMetrics(gomock.Any(), "node").
+		Return(&metrics.NodeMetrics{
+			Name:  "node",
+			Usage: metrics.Usage{CPU: resource.MustParse("500m"), Memory: resource.MustParse("1Gi")},
+		}, nil)
+
+	got, err := createNodeMetricsView(context.Background(), node, Options{Metrics: metricsClient})
+	require.NoError(t, err)
+
+	expected := component.NewSummary("Resource Usage", []component.SummarySection{
+		{Header: "CPU", Content: component.NewNumberText("500m (25%)", 25)},
+		{Header: "Memory", Content: component.NewNumberText("1024Mi (25%)", 25)},
+	}...)
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_registerPodMetrics_unavailable(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	metricsClient := metricsFake.NewMockInterface(controller)
+	metricsClient.EXPECT().Available().Return(false)
+
+	pod := testutil.CreatePod("pod")
+	o := NewObject(pod)
+	registerPodMetrics(context.Background(), o, pod, Options{Metrics: metricsClient})
+	require.Empty(t, o.itemsLists)
+
+	registerPodMetrics(context.Background(), o, pod, Options{})
+	require.Empty(t, o.itemsLists)
+}
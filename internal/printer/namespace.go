@@ -0,0 +1,97 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// NamespaceListHandler is a printFunc that prints namespaces
+func NamespaceListHandler(_ context.Context, list *corev1.NamespaceList, options Options) (component.Component, error) {
+	if list == nil {
+		return nil, errors.New("namespace list is nil")
+	}
+
+	cols := component.NewTableCols("Name", "Labels", "Status", "Age")
+	tbl := component.NewTable("Namespaces", "We couldn't find any namespaces!", cols)
+
+	for _, namespace := range list.Items {
+		row := component.TableRow{}
+		nameLink, err := options.Link.ForObject(&namespace, namespace.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		row["Name"] = nameLink
+		row["Labels"] = component.NewLabels(namespace.Labels)
+		row["Status"] = component.NewText(string(namespace.Status.Phase))
+		ts := namespace.CreationTimestamp.Time
+		row["Age"] = options.Timestamp(ts)
+
+		if err := options.TableActions.AddRowActions(row, &namespace); err != nil {
+			return nil, err
+		}
+
+		tbl.Add(row)
+	}
+
+	options.TableActions.AddBulkActions(tbl)
+
+	return options.Profile.Apply(tbl), nil
+}
+
+// NamespaceHandler is a printFunc that prints a namespace
+func NamespaceHandler(ctx context.Context, namespace *corev1.Namespace, options Options) (component.Component, error) {
+	o := NewObject(namespace)
+
+	config, err := NewNamespaceConfiguration(namespace).Create(options)
+	if err != nil {
+		return nil, errors.Wrap(err, "print namespace configuration")
+	}
+
+	o.RegisterConfig(config)
+
+	return o.ToComponent(ctx, options)
+}
+
+// NamespaceConfiguration generates a namespace configuration
+type NamespaceConfiguration struct {
+	namespace *corev1.Namespace
+}
+
+// NewNamespaceConfiguration creates an instance of NamespaceConfiguration
+func NewNamespaceConfiguration(namespace *corev1.Namespace) *NamespaceConfiguration {
+	return &NamespaceConfiguration{
+		namespace: namespace,
+	}
+}
+
+// Create creates a namespace configuration summary
+func (n *NamespaceConfiguration) Create(options Options) (*component.Summary, error) {
+	if n == nil || n.namespace == nil {
+		return nil, errors.New("namespace is nil")
+	}
+
+	namespace := n.namespace
+
+	var sections component.SummarySections
+
+	sections.AddText("Status", string(namespace.Status.Phase))
+
+	if finalizers := namespace.Spec.Finalizers; len(finalizers) > 0 {
+		sections.AddText("Finalizers", fmt.Sprint(finalizers))
+	}
+
+	summary := component.NewSummary("Configuration", sections...)
+
+	return summary, nil
+}
@@ -0,0 +1,110 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func Test_NamespaceListHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	labels := map[string]string{
+		"foo": "bar",
+	}
+
+	now := testutil.Time()
+	object := testutil.CreateNamespace("default")
+	object.CreationTimestamp = metav1.Time{Time: now}
+	object.Labels = labels
+
+	tpo.PathForObject(object, object.Name, "/namespace")
+
+	list := &corev1.NamespaceList{
+		Items: []corev1.Namespace{*object},
+	}
+
+	ctx := context.Background()
+	got, err := NamespaceListHandler(ctx, list, printOptions)
+	require.NoError(t, err)
+
+	cols := component.NewTableCols("Name", "Labels", "Status", "Age")
+	expected := component.NewTable("Namespaces", "We couldn't find any namespaces!", cols)
+	expected.Add(component.TableRow{
+		"Name":   component.NewLink("", object.Name, "/namespace"),
+		"Labels": component.NewLabels(labels),
+		"Status": component.NewText("Active"),
+		"Age":    component.NewTimestamp(now),
+	})
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_NamespaceConfiguration(t *testing.T) {
+	namespace := testutil.CreateNamespace("default")
+	namespace.Spec.Finalizers = []corev1.FinalizerName{corev1.FinalizerKubernetes}
+
+	cases := []struct {
+		name      string
+		namespace *corev1.Namespace
+		isErr     bool
+		expected  *component.Summary
+	}{
+		{
+			name:      "general",
+			namespace: namespace,
+			expected: component.NewSummary("Configuration", []component.SummarySection{
+				{
+					Header:  "Status",
+					Content: component.NewText("Active"),
+				},
+				{
+					Header:  "Finalizers",
+					Content: component.NewText("[kubernetes]"),
+				},
+			}...),
+		},
+		{
+			name:      "namespace is nil",
+			namespace: nil,
+			isErr:     true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			tpo := newTestPrinterOptions(controller)
+			printOptions := tpo.ToOptions()
+
+			nc := NewNamespaceConfiguration(tc.namespace)
+
+			summary, err := nc.Create(printOptions)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			component.AssertEqual(t, tc.expected, summary)
+		})
+	}
+}
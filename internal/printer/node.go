@@ -14,6 +14,10 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 
+	"github.com/vmware/octant/internal/gvk"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/store"
 	"github.com/vmware/octant/pkg/view/component"
 )
 
@@ -46,22 +50,26 @@ func NodeListHandler(ctx context.Context, list *corev1.NodeList, options Options
 		}
 
 		row["Name"] = nameLink
-		row["Labels"] = component.NewLabels(node.Labels)
+		row["Labels"] = options.LabelLinks.Labels(node.Labels, "", gvk.Node)
 		row["Status"] = component.NewText(nodeStatusMessage(node))
 		row["Roles"] = component.NewText(nodeRoles(node))
-		row["Age"] = component.NewTimestamp(node.CreationTimestamp.Time)
+		row["Age"] = options.Timestamp(node.CreationTimestamp.Time)
 		row["Version"] = component.NewText(node.Status.NodeInfo.KubeletVersion)
 
 		table.Add(row)
 	}
 
-	return table, nil
+	return options.Profile.Apply(table), nil
 }
 
 // NodeHandler is a printFunc that prints nodes
 func NodeHandler(ctx context.Context, node *corev1.Node, options Options) (component.Component, error) {
 	o := NewObject(node)
 
+	if err := addNodeButtons(o, node); err != nil {
+		return nil, errors.Wrap(err, "add node buttons")
+	}
+
 	nh, err := newNodeHandler(node, o)
 	if err != nil {
 		return nil, err
@@ -76,6 +84,7 @@ func NodeHandler(ctx context.Context, node *corev1.Node, options Options) (compo
 	if err := nh.Resources(options); err != nil {
 		return nil, errors.Wrap(err, "print node resources")
 	}
+	registerNodeMetrics(ctx, o, node, options)
 	if err := nh.Conditions(options); err != nil {
 		return nil, errors.Wrap(err, "print node conditions")
 	}
@@ -85,6 +94,30 @@ func NodeHandler(ctx context.Context, node *corev1.Node, options Options) (compo
 	return o.ToComponent(ctx, options)
 }
 
+// addNodeButtons adds buttons which cordon or uncordon a node, and drain it.
+func addNodeButtons(o ObjectInterface, node *corev1.Node) error {
+	key, err := store.KeyFromObject(node)
+	if err != nil {
+		return err
+	}
+
+	if node.Spec.Unschedulable {
+		o.AddButton("Uncordon", action.CreatePayload(octant.ActionUncordonNode, key.ToActionPayload()))
+	} else {
+		o.AddButton("Cordon", action.CreatePayload(octant.ActionCordonNode, key.ToActionPayload()))
+	}
+
+	o.AddButton("Drain", action.CreatePayload(octant.ActionDrainNode, key.ToActionPayload()),
+		component.WithButtonTypedConfirmation(
+			"Drain Node",
+			fmt.Sprintf("Are you sure you want to drain Node **%s**? It will be cordoned and its pods will be evicted.", node.Name),
+			node.Name,
+		),
+		component.WithButtonDanger(component.DangerLevelWarning))
+
+	return nil
+}
+
 type nodeResource struct {
 	CPU              string
 	Memory           string
@@ -296,33 +329,24 @@ func (n *NodeConfiguration) Create(options Options) (*component.Summary, error)
 	return summary, nil
 }
 
-var (
-	nodeConditionsColumns = component.NewTableCols("Type", "Reason", "Status", "Message", "Last Heartbeat", "Last Transition")
-)
-
-func createNodeConditionsView(node *corev1.Node) (*component.Table, error) {
+func createNodeConditionsView(node *corev1.Node, options Options) (*component.Table, error) {
 	if node == nil {
 		return nil, errors.New("cannot generate conditions for nil node")
 	}
 
-	table := component.NewTable("Conditions", "There are no node conditions!", nodeConditionsColumns)
-
-	for _, condition := range node.Status.Conditions {
-		row := component.TableRow{
-			"Type":            component.NewText(string(condition.Type)),
-			"Reason":          component.NewText(condition.Reason),
-			"Status":          component.NewText(string(condition.Status)),
-			"Message":         component.NewText(condition.Message),
-			"Last Heartbeat":  component.NewTimestamp(condition.LastHeartbeatTime.Time),
-			"Last Transition": component.NewTimestamp(condition.LastTransitionTime.Time),
-		}
-
-		table.Add(row)
+	var conditions []condition
+	for _, c := range node.Status.Conditions {
+		conditions = append(conditions, condition{
+			Type:           string(c.Type),
+			Status:         c.Status,
+			Reason:         c.Reason,
+			Message:        c.Message,
+			LastUpdate:     c.LastHeartbeatTime,
+			LastTransition: c.LastTransitionTime,
+		})
 	}
 
-	table.Sort("Type", false)
-
-	return table, nil
+	return createConditionsTable("node", conditions, options), nil
 }
 
 var (
@@ -455,7 +479,7 @@ func (n *nodeHandler) Conditions(options Options) error {
 }
 
 func defaultNodeConditions(node *corev1.Node, options Options) (*component.Table, error) {
-	return createNodeConditionsView(node)
+	return createNodeConditionsView(node, options)
 }
 
 func (n *nodeHandler) Images(options Options) error {
@@ -9,7 +9,11 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 
+	"github.com/vmware/octant/internal/octant"
+	printerFake "github.com/vmware/octant/internal/printer/fake"
 	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/store"
 	"github.com/vmware/octant/pkg/view/component"
 )
 
@@ -241,16 +245,16 @@ func Test_createNodeConditionsView(t *testing.T) {
 		},
 	}
 
-	got, err := createNodeConditionsView(node)
+	got, err := createNodeConditionsView(node, Options{})
 	require.NoError(t, err)
 
-	expected := component.NewTableWithRows("Conditions", "There are no node conditions!", nodeConditionsColumns, []component.TableRow{
+	expected := component.NewTableWithRows("Conditions", "There are no node conditions!", conditionColumns, []component.TableRow{
 		{
 			"Type":            component.NewText("type"),
 			"Reason":          component.NewText("reason"),
-			"Status":          component.NewText("status"),
+			"Status":          component.NewStatusText("status", component.NodeStatusWarning),
 			"Message":         component.NewText("message"),
-			"Last Heartbeat":  component.NewTimestamp(node.Status.Conditions[0].LastHeartbeatTime.Time),
+			"Last Update":     component.NewTimestamp(node.Status.Conditions[0].LastHeartbeatTime.Time),
 			"Last Transition": component.NewTimestamp(node.Status.Conditions[0].LastTransitionTime.Time),
 		},
 	})
@@ -288,3 +292,43 @@ func Test_createNodeImagesView(t *testing.T) {
 
 	component.AssertEqual(t, expected, got)
 }
+
+func Test_addNodeButtons(t *testing.T) {
+	tests := []struct {
+		name          string
+		unschedulable bool
+		button        string
+		action        string
+	}{
+		{
+			name:   "schedulable",
+			button: "Cordon",
+			action: octant.ActionCordonNode,
+		},
+		{
+			name:          "cordoned",
+			unschedulable: true,
+			button:        "Uncordon",
+			action:        octant.ActionUncordonNode,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			node := testutil.CreateNode("node")
+			node.Spec.Unschedulable = test.unschedulable
+
+			key, err := store.KeyFromObject(node)
+			require.NoError(t, err)
+
+			o := printerFake.NewMockObjectInterface(controller)
+			o.EXPECT().AddButton(test.button, action.CreatePayload(test.action, key.ToActionPayload()))
+			o.EXPECT().AddButton("Drain", action.CreatePayload(octant.ActionDrainNode, key.ToActionPayload()), gomock.Any())
+
+			require.NoError(t, addNodeButtons(o, node))
+		})
+	}
+}
@@ -0,0 +1,67 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware/octant/internal/notes"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/pkg/view/component"
+	"github.com/vmware/octant/pkg/view/flexlayout"
+)
+
+func defaultNotesGen(ctx context.Context, object runtime.Object, fl *flexlayout.FlexLayout, options Options) error {
+	card, err := createNotesView(ctx, object, options)
+	if err != nil {
+		return errors.Wrap(err, "create notes")
+	}
+
+	section := fl.AddNamedSection(SectionNotes, "")
+	if err := section.Add(card, component.WidthFull); err != nil {
+		return errors.Wrap(err, "add notes to layout")
+	}
+
+	return nil
+}
+
+// createNotesView creates a card which shows an object's notes, and an
+// action for editing them.
+func createNotesView(ctx context.Context, object runtime.Object, options Options) (*component.Card, error) {
+	if options.DashConfig == nil {
+		return nil, errors.New("dash config is nil")
+	}
+
+	objectNotes := notes.New(options.DashConfig.NotesStorage(), options.DashConfig.ObjectStore())
+	text, err := objectNotes.Get(ctx, object)
+	if err != nil {
+		return nil, err
+	}
+
+	card := component.NewCard("Notes")
+	if text == "" {
+		card.SetBody(component.NewText("There are no notes for this object."))
+	} else {
+		card.SetBody(component.NewMarkdownText(text))
+	}
+
+	form, err := component.CreateFormForObject(octant.ActionEditNotes, object,
+		component.NewFormFieldTextarea("Notes (markdown)", "notes", text))
+	if err != nil {
+		return nil, err
+	}
+
+	card.AddAction(component.Action{
+		Name:  "Edit",
+		Title: "Edit Notes",
+		Form:  form,
+	})
+
+	return card, nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/octant/internal/notes"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func Test_createNotesView(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		body        component.Component
+		text        string
+	}{
+		{
+			name: "no notes",
+			body: component.NewText("There are no notes for this object."),
+		},
+		{
+			name:        "with notes",
+			annotations: map[string]string{notes.Annotation: "*notes*"},
+			body:        component.NewMarkdownText("*notes*"),
+			text:        "*notes*",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			tpo := newTestPrinterOptions(controller)
+
+			deployment := testutil.CreateDeployment("deployment")
+			deployment.Annotations = test.annotations
+
+			got, err := createNotesView(context.Background(), deployment, tpo.ToOptions())
+			require.NoError(t, err)
+
+			form, err := component.CreateFormForObject(octant.ActionEditNotes, deployment,
+				component.NewFormFieldTextarea("Notes (markdown)", "notes", test.text))
+			require.NoError(t, err)
+
+			expected := component.NewCard("Notes")
+			expected.SetBody(test.body)
+			expected.AddAction(component.Action{
+				Name:  "Edit",
+				Title: "Edit Notes",
+				Form:  form,
+			})
+
+			component.AssertEqual(t, expected, got)
+		})
+	}
+}
@@ -8,13 +8,16 @@ package printer
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/pkg/errors"
+	"golang.org/x/sync/semaphore"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware/octant/internal/log"
 	"github.com/vmware/octant/internal/octant"
 	"github.com/vmware/octant/pkg/action"
 	"github.com/vmware/octant/pkg/store"
@@ -29,31 +32,31 @@ type ObjectInterface interface {
 	AddButton(name string, payload action.Payload, buttonOptions ...component.ButtonOption)
 }
 
-func defaultMetadataGen(object runtime.Object, fl *flexlayout.FlexLayout, options Options) error {
-	metadata, err := NewMetadata(object, options.Link)
+func defaultMetadataGen(ctx context.Context, object runtime.Object, fl *flexlayout.FlexLayout, options Options) error {
+	metadata, err := NewMetadata(object, options)
 	if err != nil {
 		return errors.Wrap(err, "create metadata generator")
 	}
 
-	if err := metadata.AddToFlexLayout(fl); err != nil {
+	if err := metadata.AddToFlexLayout(ctx, fl); err != nil {
 		return errors.Wrap(err, "add metadata to layout")
 	}
 
 	return nil
 }
 
-func defaultPodTemplateGen(object runtime.Object, template corev1.PodTemplateSpec, fl *flexlayout.FlexLayout, options Options) error {
+func defaultPodTemplateGen(ctx context.Context, object runtime.Object, template corev1.PodTemplateSpec, fl *flexlayout.FlexLayout, options Options) error {
 	podTemplate := NewPodTemplate(object, template)
-	if err := podTemplate.AddToFlexLayout(fl, options); err != nil {
+	if err := podTemplate.AddToFlexLayout(ctx, fl, options); err != nil {
 		return errors.Wrap(err, "add pod template to layout")
 	}
 
 	return nil
 }
 
-func defaultJobTemplateGen(object runtime.Object, template batchv1beta1.JobTemplateSpec, fl *flexlayout.FlexLayout, options Options) error {
+func defaultJobTemplateGen(ctx context.Context, object runtime.Object, template batchv1beta1.JobTemplateSpec, fl *flexlayout.FlexLayout, options Options) error {
 	podTemplate := NewJobTemplate(object, template)
-	if err := podTemplate.AddToFlexLayout(fl, options); err != nil {
+	if err := podTemplate.AddToFlexLayout(ctx, fl, options); err != nil {
 		return errors.Wrap(err, "add job template to layout")
 	}
 
@@ -80,6 +83,14 @@ type ItemDescriptor struct {
 	Width int
 }
 
+// TabDescriptor describes a func to print a tab which is shown after an
+// object's summary.
+type TabDescriptor struct {
+	// Name is the tab's title. It is also the tab's accessor.
+	Name string
+	Func ObjectPrinterFunc
+}
+
 type podTemplateOptions struct {
 	template corev1.PodTemplateSpec
 }
@@ -98,6 +109,7 @@ type Object struct {
 	isEventsEnabled bool
 
 	itemsLists [][]ItemDescriptor
+	tabs       []TabDescriptor
 
 	isPodTemplateEnabled bool
 	podTemplateOptions   podTemplateOptions
@@ -109,12 +121,26 @@ type Object struct {
 
 	flexLayout *flexlayout.FlexLayout
 
-	MetadataGen    func(runtime.Object, *flexlayout.FlexLayout, Options) error
-	PodTemplateGen func(runtime.Object, corev1.PodTemplateSpec, *flexlayout.FlexLayout, Options) error
-	JobTemplateGen func(runtime.Object, batchv1beta1.JobTemplateSpec, *flexlayout.FlexLayout, Options) error
+	MetadataGen    func(context.Context, runtime.Object, *flexlayout.FlexLayout, Options) error
+	PodTemplateGen func(context.Context, runtime.Object, corev1.PodTemplateSpec, *flexlayout.FlexLayout, Options) error
+	JobTemplateGen func(context.Context, runtime.Object, batchv1beta1.JobTemplateSpec, *flexlayout.FlexLayout, Options) error
 	EventsGen      func(ctx context.Context, object runtime.Object, fl *flexlayout.FlexLayout, options Options) error
+	NotesGen       func(ctx context.Context, object runtime.Object, fl *flexlayout.FlexLayout, options Options) error
 }
 
+// Identifiers of the named sections of an object's layout. Plugins and
+// printers can add items to these sections, and they are the sections'
+// anchors on the page.
+const (
+	SectionSummary     = "summary"
+	SectionMetadata    = "metadata"
+	SectionAnnotations = "annotations"
+	SectionNotes       = "notes"
+	SectionPlugins     = "plugins"
+	SectionContainers  = "containers"
+	SectionEvents      = "events"
+)
+
 // NewObject creates an instance of Object.
 func NewObject(object runtime.Object, options ...ObjectOpts) *Object {
 	o := &Object{
@@ -125,6 +151,7 @@ func NewObject(object runtime.Object, options ...ObjectOpts) *Object {
 		PodTemplateGen: defaultPodTemplateGen,
 		JobTemplateGen: defaultJobTemplateGen,
 		EventsGen:      defaultEventsGen,
+		NotesGen:       defaultNotesGen,
 	}
 
 	for _, option := range options {
@@ -167,6 +194,13 @@ func (o *Object) RegisterItems(items ...ItemDescriptor) {
 	o.itemsLists = append(o.itemsLists, items)
 }
 
+// RegisterTab registers one or more tabs to be shown after the object's
+// summary. Tabs are only shown when the object is printed with a context
+// created by WithTabs.
+func (o *Object) RegisterTab(tabs ...TabDescriptor) {
+	o.tabs = append(o.tabs, tabs...)
+}
+
 func (o *Object) summaryComponent(title string, summary *component.Summary, section *flexlayout.Section, additional ...component.SummarySection) error {
 	if section == nil {
 		return errors.Errorf("section is nil")
@@ -191,6 +225,14 @@ func (o *Object) summaryComponent(title string, summary *component.Summary, sect
 	return nil
 }
 
+// typedDeleteKinds are kinds which can only be deleted after their name is
+// typed, since deleting them deletes other objects.
+var typedDeleteKinds = map[string]bool{
+	"Namespace":                true,
+	"CustomResourceDefinition": true,
+	"PersistentVolume":         true,
+}
+
 func deleteObjectConfirmation(object runtime.Object) (component.ButtonOption, error) {
 	if object == nil {
 		return nil, errors.New("object is nil")
@@ -204,6 +246,14 @@ func deleteObjectConfirmation(object runtime.Object) (component.ButtonOption, er
 
 	confirmationTitle := fmt.Sprintf("Delete %s", kind)
 	confirmationBody := fmt.Sprintf("Are you sure you want to delete *%s* **%s**? This action is permanent and cannot be recovered.", kind, accessor.GetName())
+
+	if typedDeleteKinds[kind] {
+		return func(button *component.Button) {
+			component.WithButtonTypedConfirmation(confirmationTitle, confirmationBody, accessor.GetName())(button)
+			component.WithButtonDanger(component.DangerLevelDanger)(button)
+		}, nil
+	}
+
 	return component.WithButtonConfirmation(confirmationTitle, confirmationBody), nil
 }
 
@@ -233,7 +283,7 @@ func (o *Object) ToComponent(ctx context.Context, options Options) (component.Co
 			key.ToActionPayload()), confirmation)
 	}
 
-	summarySection := o.flexLayout.AddSection()
+	summarySection := o.flexLayout.AddNamedSection(SectionSummary, "")
 
 	pluginPrinter := options.DashConfig.PluginManager()
 	if pluginPrinter == nil {
@@ -253,27 +303,27 @@ func (o *Object) ToComponent(ctx context.Context, options Options) (component.Co
 		return nil, errors.Wrap(err, "generate summary component")
 	}
 
-	if err := o.MetadataGen(o.object, o.flexLayout, options); err != nil {
+	if err := o.MetadataGen(ctx, o.object, o.flexLayout, options); err != nil {
 		return nil, errors.Wrap(err, "generate metadata")
 	}
 
-	for _, items := range o.itemsLists {
-		section := o.flexLayout.AddSection()
+	if err := o.NotesGen(ctx, o.object, o.flexLayout, options); err != nil {
+		return nil, errors.Wrap(err, "generate notes")
+	}
 
-		for _, item := range items {
-			vc, err := item.Func()
-			if err != nil {
-				return nil, errors.Wrap(err, "failed to create item view")
-			}
+	itemViews := o.printItems(ctx)
+	for i, items := range o.itemsLists {
+		section := o.flexLayout.AddSection()
 
-			if err := section.Add(vc, item.Width); err != nil {
+		for j, item := range items {
+			if err := section.Add(itemViews[i][j], item.Width); err != nil {
 				return nil, errors.Wrap(err, "unable to add item to layout section in object printer")
 			}
 		}
 	}
 
 	if len(pr.Items) > 0 {
-		section := o.flexLayout.AddSection()
+		section := o.flexLayout.AddNamedSection(SectionPlugins, "")
 
 		for _, item := range pr.Items {
 			if err := section.Add(item.View, item.Width); err != nil {
@@ -283,13 +333,13 @@ func (o *Object) ToComponent(ctx context.Context, options Options) (component.Co
 	}
 
 	if o.isPodTemplateEnabled {
-		if err := o.PodTemplateGen(o.object, o.podTemplateOptions.template, o.flexLayout, options); err != nil {
+		if err := o.PodTemplateGen(ctx, o.object, o.podTemplateOptions.template, o.flexLayout, options); err != nil {
 			return nil, errors.Wrap(err, "generate pod template")
 		}
 	}
 
 	if o.isJobTemplateEnabled {
-		if err := o.JobTemplateGen(o.object, o.jobTemplateOptions.template, o.flexLayout, options); err != nil {
+		if err := o.JobTemplateGen(ctx, o.object, o.jobTemplateOptions.template, o.flexLayout, options); err != nil {
 			return nil, errors.Wrap(err, "generate job template")
 		}
 	}
@@ -300,9 +350,117 @@ func (o *Object) ToComponent(ctx context.Context, options Options) (component.Co
 		}
 	}
 
-	return o.flexLayout.ToComponent("Summary"), nil
+	o.printTabs(ctx, options)
+
+	view := o.flexLayout.ToComponent("Summary")
+	restrictActions(ctx, options.Access, view)
+
+	return view, nil
+}
+
+// maxItemWorkers is the number of registered items an object printer prints
+// at a time.
+const maxItemWorkers = 5
+
+// printItems prints the registered items concurrently. The views are returned
+// in the order the items were registered. An item which can't be printed shows
+// its error in its place so the rest of the object is still useful.
+func (o *Object) printItems(ctx context.Context) [][]component.Component {
+	views := make([][]component.Component, len(o.itemsLists))
+
+	var wg sync.WaitGroup
+	sem := semaphore.NewWeighted(maxItemWorkers)
+
+	for i := range o.itemsLists {
+		views[i] = make([]component.Component, len(o.itemsLists[i]))
+
+		for j := range o.itemsLists[i] {
+			if err := sem.Acquire(ctx, 1); err != nil {
+				views[i][j] = itemError(err)
+				continue
+			}
+
+			wg.Add(1)
+			go func(i, j int) {
+				defer wg.Done()
+				defer sem.Release(1)
+
+				view, err := printItem(o.itemsLists[i][j])
+				if err != nil {
+					log.From(ctx).WithErr(err).Errorf("print object item")
+					view = itemError(err)
+				}
+
+				views[i][j] = view
+			}(i, j)
+		}
+	}
+
+	wg.Wait()
+
+	return views
+}
+
+// printItem prints an item. A panicking item is reported as an error since it
+// is printed on its own goroutine.
+func printItem(item ItemDescriptor) (view component.Component, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("print item panicked: %v", r)
+		}
+	}()
+
+	return item.Func()
+}
+
+func itemError(err error) *component.Error {
+	return component.NewError(component.TitleFromString("Error"), err)
+}
+
+// printTabs prints the registered tabs to the context's tabs. A tab which
+// can't be printed shows its error rather than failing the summary.
+func (o *Object) printTabs(ctx context.Context, options Options) {
+	tabs := TabsFrom(ctx)
+	if tabs == nil {
+		return
+	}
+
+	for _, tab := range o.tabs {
+		title := component.TitleFromString(tab.Name)
+
+		var view component.Component
+		vc, err := tab.Func()
+		if err != nil {
+			view = component.NewError(title, err)
+		} else {
+			fl := flexlayout.New()
+			section := fl.AddSection()
+			if err := section.Add(vc, component.WidthFull); err != nil {
+				view = component.NewError(title, err)
+			} else {
+				tabView := fl.ToComponent(tab.Name)
+				restrictActions(ctx, options.Access, tabView)
+				view = tabView
+			}
+		}
+
+		view.SetAccessor(tab.Name)
+		tabs.Add(view)
+	}
 }
 
 func (o *Object) AddButton(name string, payload action.Payload, buttonOptions ...component.ButtonOption) {
 	o.flexLayout.AddButton(name, payload, buttonOptions...)
 }
+
+// addReachabilityButton adds a button which checks if an object's external
+// hosts can be reached.
+func addReachabilityButton(o *Object, object runtime.Object) error {
+	key, err := store.KeyFromObject(object)
+	if err != nil {
+		return err
+	}
+
+	o.AddButton("Check Reachability", action.CreatePayload(octant.ActionCheckReachability, key.ToActionPayload()))
+	return nil
+}
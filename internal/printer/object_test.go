@@ -7,9 +7,11 @@ package printer
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
@@ -52,7 +54,7 @@ func Test_Object_ToComponent(t *testing.T) {
 	}
 
 	fnMetadata := func(o *Object) {
-		o.MetadataGen = func(object runtime.Object, fl *flexlayout.FlexLayout, options Options) error {
+		o.MetadataGen = func(ctx context.Context, object runtime.Object, fl *flexlayout.FlexLayout, options Options) error {
 			section := fl.AddSection()
 			require.NoError(t, section.Add(component.NewText("metadata"), 12))
 			return nil
@@ -60,7 +62,7 @@ func Test_Object_ToComponent(t *testing.T) {
 	}
 
 	fnPodTemplate := func(o *Object) {
-		o.PodTemplateGen = func(_ runtime.Object, _ corev1.PodTemplateSpec, fl *flexlayout.FlexLayout, options Options) error {
+		o.PodTemplateGen = func(_ context.Context, _ runtime.Object, _ corev1.PodTemplateSpec, fl *flexlayout.FlexLayout, options Options) error {
 			section := fl.AddSection()
 			require.NoError(t, section.Add(component.NewText("pod template"), 12))
 			return nil
@@ -75,6 +77,12 @@ func Test_Object_ToComponent(t *testing.T) {
 		}
 	}
 
+	fnNotes := func(o *Object) {
+		o.NotesGen = func(_ context.Context, _ runtime.Object, _ *flexlayout.FlexLayout, _ Options) error {
+			return nil
+		}
+	}
+
 	stubPlugins := func(pluginPrinter *fake.MockManagerInterface) {
 		printResponse := &plugin.PrintResponse{}
 		pluginPrinter.EXPECT().
@@ -240,7 +248,7 @@ func Test_Object_ToComponent(t *testing.T) {
 			tpo := newTestPrinterOptions(controller)
 			printOptions := tpo.ToOptions()
 
-			o := NewObject(tc.object, fnMetadata, fnPodTemplate, fnEvent)
+			o := NewObject(tc.object, fnMetadata, fnPodTemplate, fnEvent, fnNotes)
 
 			o.RegisterConfig(defaultConfig)
 
@@ -261,7 +269,8 @@ func Test_Object_ToComponent(t *testing.T) {
 			require.NoError(t, err)
 
 			expected := component.NewFlexLayout("Summary")
-			expected.AddSections(tc.sections...)
+			expected.AddNamedSection(SectionSummary, "", tc.sections[0])
+			expected.AddSections(tc.sections[1:]...)
 			buttonGroup := component.NewButtonGroup()
 			if len(tc.buttons) > 0 {
 				for _, button := range tc.buttons {
@@ -286,6 +295,115 @@ func Test_Object_ToComponent(t *testing.T) {
 	}
 }
 
+func Test_Object_ToComponent_item_errors(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+	tpo.pluginManager.EXPECT().
+		Print(gomock.Any(), gomock.Any()).Return(&plugin.PrintResponse{}, nil)
+
+	itemErr := errors.New("failed")
+
+	o := NewObject(testutil.CreateDeployment("deployment"))
+
+	var items []ItemDescriptor
+	for i := 0; i < maxItemWorkers*2; i++ {
+		text := fmt.Sprintf("item%d", i)
+		items = append(items, ItemDescriptor{
+			Func: func() (component.Component, error) {
+				return component.NewText(text), nil
+			},
+			Width: component.WidthQuarter,
+		})
+	}
+	o.RegisterItems(items...)
+	o.RegisterItems(
+		ItemDescriptor{
+			Func: func() (component.Component, error) {
+				return nil, itemErr
+			},
+			Width: component.WidthHalf,
+		},
+		ItemDescriptor{
+			Func: func() (component.Component, error) {
+				panic("broken")
+			},
+			Width: component.WidthHalf,
+		},
+	)
+
+	got, err := o.ToComponent(context.Background(), printOptions)
+	require.NoError(t, err)
+
+	fl, ok := got.(*component.FlexLayout)
+	require.True(t, ok)
+
+	// the registered items are printed after the summary and metadata.
+	require.True(t, len(fl.Config.Sections) > 2)
+	itemSections := fl.Config.Sections[len(fl.Config.Sections)-2:]
+
+	require.Len(t, itemSections[0], maxItemWorkers*2)
+	for i, item := range itemSections[0] {
+		assert.Equal(t, fmt.Sprintf("item%d", i), item.View.String())
+	}
+
+	assert.Equal(t, itemError(itemErr), itemSections[1][0].View)
+
+	panicked, ok := itemSections[1][1].View.(*component.Error)
+	require.True(t, ok)
+	assert.Contains(t, panicked.Config.Data, "print item panicked: broken")
+}
+
+func Test_Object_RegisterTab(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+	tpo.pluginManager.EXPECT().
+		Print(gomock.Any(), gomock.Any()).Return(&plugin.PrintResponse{}, nil)
+
+	tabErr := errors.New("failed")
+
+	o := NewObject(testutil.CreateDeployment("deployment"))
+	o.RegisterTab(
+		TabDescriptor{
+			Name: "Rollout History",
+			Func: func() (component.Component, error) {
+				return component.NewText("history"), nil
+			},
+		},
+		TabDescriptor{
+			Name: "Metrics",
+			Func: func() (component.Component, error) {
+				return nil, tabErr
+			},
+		},
+	)
+
+	tabs := NewTabs()
+	ctx := WithTabs(context.Background(), tabs)
+	_, err := o.ToComponent(ctx, printOptions)
+	require.NoError(t, err)
+
+	history := component.NewFlexLayout("Rollout History")
+	history.AddSections(component.FlexLayoutSection{
+		{Width: component.WidthFull, View: component.NewText("history")},
+	})
+	history.SetButtonGroup(component.NewButtonGroup())
+	history.SetAccessor("Rollout History")
+
+	metrics := component.NewError(component.TitleFromString("Metrics"), tabErr)
+	metrics.SetAccessor("Metrics")
+
+	got := tabs.Components()
+	require.Len(t, got, 2)
+	component.AssertEqual(t, history, got[0])
+	assert.Equal(t, metrics, got[1])
+}
+
 func Test_deleteObjectConfirmation(t *testing.T) {
 	pod := testutil.CreatePod("pod")
 	option, err := deleteObjectConfirmation(pod)
@@ -303,3 +421,23 @@ func Test_deleteObjectConfirmation(t *testing.T) {
 
 	assert.Equal(t, expected, button)
 }
+
+func Test_deleteObjectConfirmation_typed(t *testing.T) {
+	namespace := testutil.CreateNamespace("default")
+	option, err := deleteObjectConfirmation(namespace)
+	require.NoError(t, err)
+
+	button := component.Button{}
+	option(&button)
+
+	expected := component.Button{
+		Confirmation: &component.Confirmation{
+			Title:        "Delete Namespace",
+			Body:         "Are you sure you want to delete *Namespace* **default**? This action is permanent and cannot be recovered.",
+			RequiredText: "default",
+		},
+		Danger: component.DangerLevelDanger,
+	}
+
+	assert.Equal(t, expected, button)
+}
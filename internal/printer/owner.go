@@ -0,0 +1,96 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware/octant/internal/link"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// maxOwnerChainDepth limits how many controllers are followed when
+// building an owner chain.
+const maxOwnerChainDepth = 10
+
+// createOwnerChainView creates a breadcrumb of the controllers which own an
+// object, e.g. Deployment > ReplicaSet for a pod. The top most controller is
+// first. It returns nil if the object does not have a controller.
+func createOwnerChainView(ctx context.Context, object runtime.Object, options Options) (*component.Breadcrumb, error) {
+	links, err := OwnerChain(ctx, options.DashConfig.ObjectStore(), options.Link, object)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(links) == 0 {
+		return nil, nil
+	}
+
+	return component.NewBreadcrumb(links...), nil
+}
+
+// OwnerChain returns links to the controllers which own an object. The top
+// most controller is first. The chain stops at the first controller which
+// can't be found in the object store.
+func OwnerChain(ctx context.Context, objectStore store.Store, linkGenerator link.Interface, object runtime.Object) ([]*component.Link, error) {
+	if object == nil {
+		return nil, errors.New("object is nil")
+	}
+
+	var links []*component.Link
+	seen := make(map[types.UID]bool)
+
+	current := object
+	for i := 0; i < maxOwnerChainDepth; i++ {
+		accessor, err := meta.Accessor(current)
+		if err != nil {
+			return nil, errors.Wrap(err, "get accessor for object")
+		}
+
+		if uid := accessor.GetUID(); uid != "" {
+			seen[uid] = true
+		}
+
+		controllerRef := metav1.GetControllerOf(accessor)
+		if controllerRef == nil || seen[controllerRef.UID] {
+			break
+		}
+
+		controlledBy, err := linkGenerator.ForOwner(current, controllerRef)
+		if err != nil {
+			return nil, err
+		}
+
+		links = append([]*component.Link{controlledBy}, links...)
+
+		key := store.Key{
+			Namespace:  accessor.GetNamespace(),
+			APIVersion: controllerRef.APIVersion,
+			Kind:       controllerRef.Kind,
+			Name:       controllerRef.Name,
+		}
+
+		owner, found, err := objectStore.Get(ctx, key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get controller %s", key)
+		}
+
+		if !found || owner == nil {
+			break
+		}
+
+		current = owner
+	}
+
+	return links, nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func Test_createOwnerChainView(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.UID = "deployment"
+
+	replicaSet := testutil.CreateAppReplicaSet("replicaset")
+	replicaSet.UID = "replicaset"
+	replicaSet.SetOwnerReferences(testutil.ToOwnerReferences(t, deployment))
+
+	pod := testutil.CreatePod("pod")
+	pod.UID = "pod"
+	pod.SetOwnerReferences(testutil.ToOwnerReferences(t, replicaSet))
+
+	replicaSetRef := pod.OwnerReferences[0]
+	deploymentRef := replicaSet.OwnerReferences[0]
+
+	tpo.link.EXPECT().ForOwner(gomock.Any(), &replicaSetRef).
+		Return(component.NewLink("", "replicaset", "/replicaset"), nil)
+	tpo.link.EXPECT().ForOwner(gomock.Any(), &deploymentRef).
+		Return(component.NewLink("", "deployment", "/deployment"), nil)
+
+	replicaSetKey := store.Key{Namespace: "namespace", APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "replicaset"}
+	tpo.objectStore.EXPECT().Get(gomock.Any(), replicaSetKey).
+		Return(testutil.ToUnstructured(t, replicaSet), true, nil)
+
+	deploymentKey := store.Key{Namespace: "namespace", APIVersion: "apps/v1", Kind: "Deployment", Name: "deployment"}
+	tpo.objectStore.EXPECT().Get(gomock.Any(), deploymentKey).
+		Return(testutil.ToUnstructured(t, deployment), true, nil)
+
+	ctx := context.Background()
+	got, err := createOwnerChainView(ctx, pod, printOptions)
+	require.NoError(t, err)
+
+	expected := component.NewBreadcrumb(
+		component.NewLink("", "deployment", "/deployment"),
+		component.NewLink("", "replicaset", "/replicaset"),
+	)
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_createOwnerChainView_not_controlled(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	pod := testutil.CreatePod("pod")
+
+	ctx := context.Background()
+	got, err := createOwnerChainView(ctx, pod, printOptions)
+	require.NoError(t, err)
+
+	assert.Nil(t, got)
+}
@@ -0,0 +1,181 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// PersistentVolumeListHandler is a printFunc that prints persistent volumes
+func PersistentVolumeListHandler(_ context.Context, list *corev1.PersistentVolumeList, options Options) (component.Component, error) {
+	if list == nil {
+		return nil, errors.New("persistent volume list is nil")
+	}
+
+	cols := component.NewTableCols("Name", "Capacity", "Access Modes", "Reclaim Policy", "Status", "Claim", "Storage Class", "Age")
+	tbl := component.NewTable("Persistent Volumes", "We couldn't find any persistent volumes!", cols)
+
+	for _, persistentVolume := range list.Items {
+		row := component.TableRow{}
+		nameLink, err := options.Link.ForObject(&persistentVolume, persistentVolume.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		claim, err := persistentVolumeClaimLink(&persistentVolume, options)
+		if err != nil {
+			return nil, err
+		}
+
+		storage := persistentVolume.Spec.Capacity[corev1.ResourceStorage]
+
+		row["Name"] = nameLink
+		row["Capacity"] = component.NewText(storage.String())
+		row["Access Modes"] = component.NewText(getAccessModesAsString(persistentVolume.Spec.AccessModes))
+		row["Reclaim Policy"] = component.NewText(string(persistentVolume.Spec.PersistentVolumeReclaimPolicy))
+		row["Status"] = component.NewText(string(persistentVolume.Status.Phase))
+		row["Claim"] = claim
+		row["Storage Class"] = component.NewText(persistentVolume.Spec.StorageClassName)
+		ts := persistentVolume.CreationTimestamp.Time
+		row["Age"] = options.Timestamp(ts)
+
+		if err := options.TableActions.AddRowActions(row, &persistentVolume); err != nil {
+			return nil, err
+		}
+
+		tbl.Add(row)
+	}
+
+	options.TableActions.AddBulkActions(tbl)
+
+	return options.Profile.Apply(tbl), nil
+}
+
+// PersistentVolumeHandler is a printFunc that prints a persistent volume
+func PersistentVolumeHandler(ctx context.Context, persistentVolume *corev1.PersistentVolume, options Options) (component.Component, error) {
+	o := NewObject(persistentVolume)
+	o.EnableEvents()
+
+	config, err := NewPersistentVolumeConfiguration(persistentVolume).Create(options)
+	if err != nil {
+		return nil, errors.Wrap(err, "print persistent volume configuration")
+	}
+	o.RegisterConfig(config)
+
+	status, err := createPersistentVolumeStatusView(persistentVolume, options)
+	if err != nil {
+		return nil, errors.Wrap(err, "print persistent volume status")
+	}
+	o.RegisterSummary(status)
+
+	return o.ToComponent(ctx, options)
+}
+
+// PersistentVolumeConfiguration generates a persistent volume configuration
+type PersistentVolumeConfiguration struct {
+	persistentVolume *corev1.PersistentVolume
+}
+
+// NewPersistentVolumeConfiguration creates an instance of PersistentVolumeConfiguration
+func NewPersistentVolumeConfiguration(pv *corev1.PersistentVolume) *PersistentVolumeConfiguration {
+	return &PersistentVolumeConfiguration{
+		persistentVolume: pv,
+	}
+}
+
+// Create creates a persistent volume configuration summary
+func (p *PersistentVolumeConfiguration) Create(options Options) (*component.Summary, error) {
+	if p == nil || p.persistentVolume == nil {
+		return nil, errors.New("persistent volume is nil")
+	}
+
+	persistentVolume := p.persistentVolume
+
+	var sections component.SummarySections
+
+	if storage, ok := persistentVolume.Spec.Capacity[corev1.ResourceStorage]; ok {
+		sections.AddText("Capacity", storage.String())
+	}
+
+	if accessModes := persistentVolume.Spec.AccessModes; len(accessModes) > 0 {
+		sections.AddText("Access Modes", getAccessModesAsString(accessModes))
+	}
+
+	if volumeMode := persistentVolume.Spec.VolumeMode; volumeMode != nil {
+		sections.AddText("Volume Mode", string(*volumeMode))
+	}
+
+	if reclaimPolicy := persistentVolume.Spec.PersistentVolumeReclaimPolicy; reclaimPolicy != "" {
+		sections.AddText("Reclaim Policy", string(reclaimPolicy))
+	}
+
+	if storageClassName := persistentVolume.Spec.StorageClassName; storageClassName != "" {
+		sections.AddText("Storage Class Name", storageClassName)
+	}
+
+	if mountOptions := persistentVolume.Spec.MountOptions; len(mountOptions) > 0 {
+		sections.AddText("Mount Options", strings.Join(mountOptions, ", "))
+	}
+
+	if finalizers := persistentVolume.ObjectMeta.Finalizers; finalizers != nil {
+		sections.AddText("Finalizers", fmt.Sprint(finalizers))
+	}
+
+	summary := component.NewSummary("Configuration", sections...)
+
+	return summary, nil
+}
+
+func createPersistentVolumeStatusView(persistentVolume *corev1.PersistentVolume, options Options) (*component.Summary, error) {
+	if persistentVolume == nil {
+		return nil, errors.New("persistent volume is nil")
+	}
+
+	sections := component.SummarySections{}
+
+	if persistentVolume.Status.Phase != "" {
+		sections.AddText("Volume Status", string(persistentVolume.Status.Phase))
+	}
+
+	if persistentVolume.Spec.ClaimRef != nil {
+		claim, err := persistentVolumeClaimLink(persistentVolume, options)
+		if err != nil {
+			return nil, err
+		}
+
+		sections.Add("Claim", claim)
+	}
+
+	if reason := persistentVolume.Status.Reason; reason != "" {
+		sections.AddText("Reason", reason)
+	}
+
+	if message := persistentVolume.Status.Message; message != "" {
+		sections.AddText("Message", message)
+	}
+
+	summary := component.NewSummary("Status", sections...)
+
+	return summary, nil
+}
+
+// persistentVolumeClaimLink links to the claim bound to a persistent volume.
+func persistentVolumeClaimLink(persistentVolume *corev1.PersistentVolume, options Options) (component.Component, error) {
+	claimRef := persistentVolume.Spec.ClaimRef
+	if claimRef == nil {
+		return component.NewText(""), nil
+	}
+
+	text := fmt.Sprintf("%s/%s", claimRef.Namespace, claimRef.Name)
+	return options.Link.ForGVK(claimRef.Namespace, "v1", "PersistentVolumeClaim", claimRef.Name, text)
+}
@@ -0,0 +1,155 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func Test_PersistentVolumeListHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	now := testutil.Time()
+	object := testutil.CreatePersistentVolume("pv")
+	object.CreationTimestamp = metav1.Time{Time: now}
+
+	tpo.PathForObject(object, object.Name, "/pv")
+
+	claimLink := component.NewLink("", "namespace/pvc", "/pvc")
+	tpo.link.EXPECT().
+		ForGVK("namespace", "v1", "PersistentVolumeClaim", "pvc", "namespace/pvc").
+		Return(claimLink, nil)
+
+	list := &corev1.PersistentVolumeList{
+		Items: []corev1.PersistentVolume{*object},
+	}
+
+	ctx := context.Background()
+	got, err := PersistentVolumeListHandler(ctx, list, printOptions)
+	require.NoError(t, err)
+
+	cols := component.NewTableCols("Name", "Capacity", "Access Modes", "Reclaim Policy", "Status",
+		"Claim", "Storage Class", "Age")
+	expected := component.NewTable("Persistent Volumes", "We couldn't find any persistent volumes!", cols)
+	expected.Add(component.TableRow{
+		"Name":           component.NewLink("", object.Name, "/pv"),
+		"Capacity":       component.NewText("10Gi"),
+		"Access Modes":   component.NewText("RWO"),
+		"Reclaim Policy": component.NewText("Retain"),
+		"Status":         component.NewText("Bound"),
+		"Claim":          claimLink,
+		"Storage Class":  component.NewText("manual"),
+		"Age":            component.NewTimestamp(now),
+	})
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_PersistentVolumeConfiguration(t *testing.T) {
+	pv := testutil.CreatePersistentVolume("pv")
+	pv.Spec.MountOptions = []string{"hard", "nfsvers=4.1"}
+
+	cases := []struct {
+		name             string
+		persistentVolume *corev1.PersistentVolume
+		isErr            bool
+		expected         *component.Summary
+	}{
+		{
+			name:             "general",
+			persistentVolume: pv,
+			expected: component.NewSummary("Configuration", []component.SummarySection{
+				{
+					Header:  "Capacity",
+					Content: component.NewText("10Gi"),
+				},
+				{
+					Header:  "Access Modes",
+					Content: component.NewText("RWO"),
+				},
+				{
+					Header:  "Volume Mode",
+					Content: component.NewText("Filesystem"),
+				},
+				{
+					Header:  "Reclaim Policy",
+					Content: component.NewText("Retain"),
+				},
+				{
+					Header:  "Storage Class Name",
+					Content: component.NewText("manual"),
+				},
+				{
+					Header:  "Mount Options",
+					Content: component.NewText("hard, nfsvers=4.1"),
+				},
+			}...),
+		},
+		{
+			name:             "pv is nil",
+			persistentVolume: nil,
+			isErr:            true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			tpo := newTestPrinterOptions(controller)
+			printOptions := tpo.ToOptions()
+
+			pc := NewPersistentVolumeConfiguration(tc.persistentVolume)
+
+			summary, err := pc.Create(printOptions)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			component.AssertEqual(t, tc.expected, summary)
+		})
+	}
+}
+
+func Test_createPersistentVolumeStatusView(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	claimLink := component.NewLink("", "namespace/pvc", "/pvc")
+	tpo.link.EXPECT().
+		ForGVK("namespace", "v1", "PersistentVolumeClaim", "pvc", "namespace/pvc").
+		Return(claimLink, nil)
+
+	object := testutil.CreatePersistentVolume("pv")
+
+	got, err := createPersistentVolumeStatusView(object, tpo.ToOptions())
+	require.NoError(t, err)
+
+	sections := component.SummarySections{}
+	sections.AddText("Volume Status", "Bound")
+	sections.Add("Claim", claimLink)
+	expected := component.NewSummary("Status", sections...)
+
+	component.AssertEqual(t, expected, got)
+}
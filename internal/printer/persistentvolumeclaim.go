@@ -53,11 +53,18 @@ func PersistentVolumeClaimListHandler(ctx context.Context, list *corev1.Persiste
 		row["Access Modes"] = component.NewText(accessModes)
 		row["Storage Class"] = component.NewText(printPersistentVolumeClaimClass(&persistentVolumeClaim))
 		ts := persistentVolumeClaim.CreationTimestamp.Time
-		row["Age"] = component.NewTimestamp(ts)
+		row["Age"] = options.Timestamp(ts)
+
+		if err := options.TableActions.AddRowActions(row, &persistentVolumeClaim); err != nil {
+			return nil, err
+		}
 
 		tbl.Add(row)
 	}
-	return tbl, nil
+
+	options.TableActions.AddBulkActions(tbl)
+
+	return options.Profile.Apply(tbl), nil
 }
 
 // PersistentVolumeClaimHandler is a printFunc that prints a PersistentVolumeClaim
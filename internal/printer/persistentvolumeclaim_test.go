@@ -21,7 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
-func Test_PersistentVolumeListHandler(t *testing.T) {
+func Test_PersistentVolumeClaimListHandler(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
 
@@ -224,22 +224,21 @@ func Test_PersistentVolumeClaimMountedPodsList(t *testing.T) {
 	tpo.objectStore.EXPECT().List(gomock.Any(), gomock.Eq(key)).Return(podList, false, nil)
 
 	printOptions := tpo.ToOptions()
-	printOptions.DisableLabels = false
+	printOptions.Profile = ProfileFull
 
 	got, err := createMountedPodListView(ctx, pvc.Namespace, pvc.Name, printOptions)
 	require.NoError(t, err)
 
 	cols := component.NewTableCols("Name", "Ready", "Phase", "Restarts", "Node", "Age")
 	expected := component.NewTable("Pods", "We couldn't find any pods!", cols)
-	expected.Add(component.TableRow{
+	expected.Add(podRowWithActions(t, pod, component.TableRow{
 		"Name":     component.NewLink("", "wordpress-mysql-67565bd57-8fzbh", "/pod"),
 		"Ready":    component.NewText("1/1"),
 		"Phase":    component.NewText("Running"),
-		"Restarts": component.NewText("0"),
+		"Restarts": component.NewNumberText("0", 0),
 		"Node":     nodeLink,
 		"Age":      component.NewTimestamp(now),
-	})
-	addPodTableFilters(expected)
+	}))
 
 	component.AssertEqual(t, expected, got)
 }
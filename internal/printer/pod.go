@@ -21,15 +21,17 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 
+	"github.com/vmware/octant/internal/gvk"
 	"github.com/vmware/octant/internal/link"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/pkg/action"
 	"github.com/vmware/octant/pkg/store"
 	"github.com/vmware/octant/pkg/view/component"
 )
 
 var (
-	podColsWithLabels    = component.NewTableCols("Name", "Labels", "Ready", "Phase", "Restarts", "Node", "Age")
-	podColsWithOutLabels = component.NewTableCols("Name", "Ready", "Phase", "Restarts", "Node", "Age")
-	podResourceCols      = component.NewTableCols("Container", "Request: Memory", "Request: CPU", "Limit: Memory", "Limit: CPU")
+	podCols         = component.NewTableCols("Name", "Labels", "Ready", "Phase", "Restarts", "Node", "Age")
+	podResourceCols = component.NewTableCols("Container", "Request: Memory", "Request: CPU", "Limit: Memory", "Limit: CPU")
 )
 
 // PodListHandler is a printFunc that prints pods
@@ -38,12 +40,7 @@ func PodListHandler(_ context.Context, list *corev1.PodList, opts Options) (comp
 		return nil, errors.New("list is nil")
 	}
 
-	cols := podColsWithLabels
-	if opts.DisableLabels {
-		cols = podColsWithOutLabels
-	}
-
-	table := component.NewTable("Pods", "We couldn't find any pods!", cols)
+	table := component.NewTable("Pods", "We couldn't find any pods!", podCols)
 	addPodTableFilters(table)
 
 	for i := range list.Items {
@@ -55,9 +52,7 @@ func PodListHandler(_ context.Context, list *corev1.PodList, opts Options) (comp
 
 		row["Name"] = nameLink
 
-		if !opts.DisableLabels {
-			row["Labels"] = component.NewLabels(list.Items[i].Labels)
-		}
+		row["Labels"] = opts.LabelLinks.Labels(list.Items[i].Labels, list.Items[i].Namespace, gvk.Pod)
 
 		readyCounter := 0
 		for _, c := range list.Items[i].Status.ContainerStatuses {
@@ -75,7 +70,7 @@ func PodListHandler(_ context.Context, list *corev1.PodList, opts Options) (comp
 			restartCounter += int(c.RestartCount)
 		}
 		restarts := fmt.Sprintf("%d", restartCounter)
-		row["Restarts"] = component.NewText(restarts)
+		row["Restarts"] = component.NewNumberText(restarts, float64(restartCounter))
 
 		nodeComponent, err := podNode(&list.Items[i], opts.Link)
 		if err != nil {
@@ -85,14 +80,59 @@ func PodListHandler(_ context.Context, list *corev1.PodList, opts Options) (comp
 		row["Node"] = nodeComponent
 
 		ts := list.Items[i].CreationTimestamp.Time
-		row["Age"] = component.NewTimestamp(ts)
+		row["Age"] = opts.Timestamp(ts)
+
+		if err := addPodRowActions(row, &list.Items[i]); err != nil {
+			return nil, errors.Wrap(err, "add pod row actions")
+		}
 
 		table.Add(row)
 	}
 
 	table.Sort("Name", false)
+	opts.TableActions.AddBulkActions(table)
 
-	return table, nil
+	return opts.Profile.Apply(table), nil
+}
+
+// addPodRowActions adds actions for deleting a pod and forwarding its
+// container ports to a pod table row.
+func addPodRowActions(row component.TableRow, pod *corev1.Pod) error {
+	deleteButton, err := deleteObjectButton(pod)
+	if err != nil {
+		return err
+	}
+
+	if deleteButton == nil {
+		return nil
+	}
+
+	row.AddAction(*deleteButton)
+
+	key, err := store.KeyFromObject(pod)
+	if err != nil {
+		return err
+	}
+
+	if pod.Status.Phase != corev1.PodRunning {
+		return nil
+	}
+
+	for _, c := range pod.Spec.Containers {
+		for _, port := range c.Ports {
+			if port.Protocol != corev1.ProtocolTCP {
+				continue
+			}
+
+			payload := key.ToActionPayload()
+			payload["port"] = port.ContainerPort
+
+			row.AddAction(component.NewButton(fmt.Sprintf("Forward port %d", port.ContainerPort),
+				action.CreatePayload(octant.ActionStartPortForward, payload)))
+		}
+	}
+
+	return nil
 }
 
 func podNode(pod *corev1.Pod, linkGenerator link.Interface) (component.Component, error) {
@@ -122,12 +162,16 @@ func PodHandler(ctx context.Context, pod *corev1.Pod, options Options) (componen
 	if err := ph.Conditions(options); err != nil {
 		return nil, errors.Wrap(err, "print pod conditions")
 	}
-	if err := ph.InitContainers(options); err != nil {
+	if err := ph.InitContainers(ctx, options); err != nil {
 		return nil, errors.Wrap(err, "print pod init containers")
 	}
-	if err := ph.Containers(options); err != nil {
+	if err := ph.Containers(ctx, options); err != nil {
 		return nil, errors.Wrap(err, "print pod containers")
 	}
+	registerPodMetrics(ctx, o, pod, options)
+	registerPodMetricsHistory(ctx, o, pod, options)
+	registerPodTerminal(o, pod, options)
+	registerPodFiles(o, pod, options)
 	if err := ph.Additional(options); err != nil {
 		return nil, errors.Wrap(err, "print pod additional items")
 	}
@@ -255,12 +299,13 @@ func (p *PodConfiguration) Create(options Options) (*component.Summary, error) {
 
 func listPods(ctx context.Context, namespace string, selector *metav1.LabelSelector, uid types.UID, o store.Store) ([]*corev1.Pod, error) {
 	key := store.Key{
-		Namespace:  namespace,
-		APIVersion: "v1",
-		Kind:       "Pod",
+		Namespace:     namespace,
+		APIVersion:    "v1",
+		Kind:          "Pod",
+		LabelSelector: selector,
 	}
 
-	pods, err := loadPods(ctx, key, o, selector)
+	pods, err := loadPods(ctx, key, o, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "load pods")
 	}
@@ -372,7 +417,7 @@ func isEqualSelector(s1, s2 *metav1.LabelSelector) bool {
 }
 
 func createPodListView(ctx context.Context, object runtime.Object, options Options) (component.Component, error) {
-	options.DisableLabels = true
+	options.Profile = ProfileEmbedded
 
 	podList := &corev1.PodList{}
 
@@ -435,7 +480,7 @@ func createPodListView(ctx context.Context, object runtime.Object, options Optio
 }
 
 func createRollingPodListView(ctx context.Context, objects []runtime.Object, options Options) (component.Component, error) {
-	options.DisableLabels = true
+	options.Profile = ProfileEmbedded
 
 	podList := &corev1.PodList{}
 
@@ -470,6 +515,10 @@ func createRollingPodListView(ctx context.Context, objects []runtime.Object, opt
 			Kind:       "Pod",
 		}
 
+		if replicaSet, ok := object.(*appsv1.ReplicaSet); ok {
+			key.LabelSelector = replicaSet.Spec.Selector
+		}
+
 		list, _, err := objectStore.List(ctx, key)
 		if err != nil {
 			return nil, errors.Wrapf(err, "list all objects for key %+v", key)
@@ -500,7 +549,7 @@ func createRollingPodListView(ctx context.Context, objects []runtime.Object, opt
 }
 
 func createMountedPodListView(ctx context.Context, namespace string, persistentVolumeClaimName string, options Options) (component.Component, error) {
-	options.DisableLabels = true
+	options.Profile = ProfileEmbedded
 
 	key := store.Key{
 		Namespace:  namespace,
@@ -536,26 +585,24 @@ func createMountedPodListView(ctx context.Context, namespace string, persistentV
 	return PodListHandler(ctx, mountedPodList, options)
 }
 
-func createPodConditionsView(pod *corev1.Pod) (*component.Table, error) {
+func createPodConditionsView(pod *corev1.Pod, options Options) (*component.Table, error) {
 	if pod == nil {
 		return nil, errors.New("pod is nil")
 	}
 
-	cols := component.NewTableCols("Type", "Last Transition Time", "Message", "Reason")
-	table := component.NewTable("Pod Conditions", "There are no pod conditions!", cols)
-
-	for _, condition := range pod.Status.Conditions {
-		row := component.TableRow{}
-
-		row["Type"] = component.NewText(string(condition.Type))
-		row["Last Transition Time"] = component.NewTimestamp(condition.LastTransitionTime.Time)
-		row["Message"] = component.NewText(condition.Message)
-		row["Reason"] = component.NewText(condition.Reason)
-
-		table.Add(row)
+	var conditions []condition
+	for _, c := range pod.Status.Conditions {
+		conditions = append(conditions, condition{
+			Type:           string(c.Type),
+			Status:         c.Status,
+			Reason:         c.Reason,
+			Message:        c.Message,
+			LastUpdate:     c.LastProbeTime,
+			LastTransition: c.LastTransitionTime,
+		})
 	}
 
-	return table, nil
+	return createConditionsTable("pod", conditions, options), nil
 }
 
 func hasOwnerReference(ownerReferences []metav1.OwnerReference, kind string) bool {
@@ -608,8 +655,8 @@ type podObject interface {
 	Config(options Options) error
 	Status(options Options) error
 	Conditions(options Options) error
-	InitContainers(options Options) error
-	Containers(options Options) error
+	InitContainers(ctx context.Context, options Options) error
+	Containers(ctx context.Context, options Options) error
 	Additional(options Options) error
 }
 
@@ -618,7 +665,7 @@ type podHandler struct {
 	configFunc      func(*corev1.Pod, Options) (*component.Summary, error)
 	summaryFunc     func(*corev1.Pod, Options) (*component.Summary, error)
 	conditionsFunc  func(*corev1.Pod, Options) (*component.Table, error)
-	containerFunc   func(pod *corev1.Pod, container *corev1.Container, isInit bool, options Options) (*component.Summary, error)
+	containerFunc   func(ctx context.Context, pod *corev1.Pod, container *corev1.Container, isInit bool, options Options) (*component.Summary, error)
 	additionalFuncs []func(*corev1.Pod, Options) ObjectPrinterFunc
 	object          *Object
 }
@@ -714,14 +761,14 @@ func (p *podHandler) Conditions(options Options) error {
 }
 
 func defaultPodConditions(pod *corev1.Pod, options Options) (*component.Table, error) {
-	return createPodConditionsView(pod)
+	return createPodConditionsView(pod, options)
 }
 
-func (p *podHandler) InitContainers(options Options) error {
-	return p.containers(p.pod.Spec.InitContainers, true, options)
+func (p *podHandler) InitContainers(ctx context.Context, options Options) error {
+	return p.containers(ctx, p.pod.Spec.InitContainers, true, options)
 }
 
-func (p *podHandler) containers(containers []corev1.Container, isInit bool, options Options) error {
+func (p *podHandler) containers(ctx context.Context, containers []corev1.Container, isInit bool, options Options) error {
 	var itemDescriptors []ItemDescriptor
 
 	for i := range containers {
@@ -730,7 +777,7 @@ func (p *podHandler) containers(containers []corev1.Container, isInit bool, opti
 		itemDescriptors = append(itemDescriptors, ItemDescriptor{
 			Width: component.WidthHalf,
 			Func: func() (component.Component, error) {
-				return p.containerFunc(p.pod, &container, isInit, options)
+				return p.containerFunc(ctx, p.pod, &container, isInit, options)
 			},
 		})
 	}
@@ -740,14 +787,14 @@ func (p *podHandler) containers(containers []corev1.Container, isInit bool, opti
 	return nil
 }
 
-func (p *podHandler) Containers(options Options) error {
-	return p.containers(p.pod.Spec.Containers, false, options)
+func (p *podHandler) Containers(ctx context.Context, options Options) error {
+	return p.containers(ctx, p.pod.Spec.Containers, false, options)
 }
 
-func defaultPodContainers(pod *corev1.Pod, container *corev1.Container, isInit bool, options Options) (*component.Summary, error) {
+func defaultPodContainers(ctx context.Context, pod *corev1.Pod, container *corev1.Container, isInit bool, options Options) (*component.Summary, error) {
 	portForwarder := options.DashConfig.PortForwarder()
 	creator := NewContainerConfiguration(pod, container, portForwarder, isInit, options)
-	return creator.Create()
+	return creator.Create(ctx)
 }
 
 func (p *podHandler) Additional(options Options) error {
@@ -0,0 +1,73 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// registerPodFiles registers a tab with actions which copy files to and
+// from a running pod's containers. The tab is only registered if exec is
+// enabled.
+func registerPodFiles(o *Object, pod *corev1.Pod, options Options) {
+	if options.DashConfig == nil || !options.DashConfig.ExecEnabled() {
+		return
+	}
+
+	if pod.Status.Phase != corev1.PodRunning || len(pod.Spec.Containers) == 0 {
+		return
+	}
+
+	o.RegisterTab(TabDescriptor{
+		Name: "Files",
+		Func: func() (component.Component, error) {
+			return createPodFilesView(pod)
+		},
+	})
+}
+
+func createPodFilesView(pod *corev1.Pod) (*component.Card, error) {
+	card := component.NewCard("Files")
+	card.SetBody(component.NewMarkdownText(
+		"Files are copied with `tar`, which has to be installed in the container's image. " +
+			"Files can be up to 10 MiB. Downloaded files are served from the link in the alert which is shown."))
+
+	var names []string
+	for _, c := range pod.Spec.Containers {
+		names = append(names, c.Name)
+	}
+
+	uploadForm, err := component.CreateFormForObject(octant.ActionUploadFile, pod,
+		component.NewFormFieldRadio("Container", "containerName", component.NewInputChoices(names, names[0])),
+		component.NewFormFieldText("Destination Path", "path", ""),
+		component.NewFormFieldFile("File", "file"))
+	if err != nil {
+		return nil, err
+	}
+
+	downloadForm, err := component.CreateFormForObject(octant.ActionDownloadFile, pod,
+		component.NewFormFieldRadio("Container", "containerName", component.NewInputChoices(names, names[0])),
+		component.NewFormFieldText("Path", "path", ""))
+	if err != nil {
+		return nil, err
+	}
+
+	card.AddAction(component.Action{
+		Name:  "Upload File",
+		Title: "Upload File",
+		Form:  uploadForm,
+	})
+	card.AddAction(component.Action{
+		Name:  "Download File",
+		Title: "Download File",
+		Form:  downloadForm,
+	})
+
+	return card, nil
+}
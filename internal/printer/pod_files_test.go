@@ -0,0 +1,51 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	configFake "github.com/vmware/octant/internal/config/fake"
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func Test_registerPodFiles(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ExecEnabled().Return(true)
+
+	pod := testutil.CreatePod("pod")
+	pod.Spec.Containers = []corev1.Container{{Name: "app"}, {Name: "sidecar"}}
+	pod.Status.Phase = corev1.PodRunning
+
+	o := NewObject(pod)
+	registerPodFiles(o, pod, Options{DashConfig: dashConfig})
+
+	require.Len(t, o.tabs, 1)
+	require.Equal(t, "Files", o.tabs[0].Name)
+
+	got, err := o.tabs[0].Func()
+	require.NoError(t, err)
+
+	card, ok := got.(*component.Card)
+	require.True(t, ok)
+
+	actions := card.Config.Actions
+	require.Len(t, actions, 2)
+	require.Equal(t, "Upload File", actions[0].Name)
+	require.Equal(t, "Download File", actions[1].Name)
+
+	containers := component.NewFormFieldRadio("Container", "containerName",
+		component.NewInputChoices([]string{"app", "sidecar"}, "app"))
+	require.Equal(t, containers, actions[0].Form.Fields[0])
+}
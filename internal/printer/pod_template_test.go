@@ -6,6 +6,7 @@ SPDX-License-Identifier: Apache-2.0
 package printer
 
 import (
+	"context"
 	"testing"
 
 	"github.com/pkg/errors"
@@ -38,14 +39,14 @@ func TestPodTemplateHeader(t *testing.T) {
 }
 
 func stubPodTemplateSection(name string) podTemplateFunc {
-	return func(fl *flexlayout.FlexLayout, options podTemplateLayoutOptions) error {
+	return func(ctx context.Context, fl *flexlayout.FlexLayout, options podTemplateLayoutOptions) error {
 		section := fl.AddSection()
 		return section.Add(component.NewText(name), component.WidthFull)
 	}
 }
 
 func stubPodTemplateSectionWithError() podTemplateFunc {
-	return func(fl *flexlayout.FlexLayout, options podTemplateLayoutOptions) error {
+	return func(ctx context.Context, fl *flexlayout.FlexLayout, options podTemplateLayoutOptions) error {
 		return errors.Errorf("failed")
 	}
 }
@@ -159,7 +160,8 @@ func TestPodTemplate_AddToFlexLayout(t *testing.T) {
 
 			options := Options{}
 
-			err := pt.AddToFlexLayout(tc.flexlayout, options)
+			ctx := context.Background()
+			err := pt.AddToFlexLayout(ctx, tc.flexlayout, options)
 			if tc.isErr {
 				require.Error(t, err)
 				return
@@ -186,7 +188,8 @@ func Test_podTemplateHeader(t *testing.T) {
 		},
 	}
 
-	require.NoError(t, podTemplateHeader(fl, options))
+	ctx := context.Background()
+	require.NoError(t, podTemplateHeader(ctx, fl, options))
 
 	got := fl.ToComponent("Foo")
 
@@ -206,3 +209,51 @@ func Test_podTemplateHeader(t *testing.T) {
 
 	assert.Equal(t, expected, got)
 }
+
+func Test_podTemplateContainers_probes(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	deployment := testutil.CreateDeployment("deployment")
+
+	readinessProbe := &corev1.Probe{
+		Handler: corev1.Handler{
+			Exec: &corev1.ExecAction{Command: []string{"ready"}},
+		},
+	}
+
+	options := podTemplateLayoutOptions{
+		parent: deployment,
+		containers: []corev1.Container{
+			{Name: "ready", ReadinessProbe: readinessProbe},
+			{Name: "unready"},
+		},
+		printOptions: printOptions,
+	}
+
+	fl := flexlayout.New()
+	ctx := context.Background()
+	require.NoError(t, podTemplateContainers(ctx, fl, options))
+
+	got := fl.ToComponent("Pod Template")
+	require.Len(t, got.Config.Sections, 1)
+	require.Len(t, got.Config.Sections[0], 2)
+
+	ready, ok := got.Config.Sections[0][0].View.(*component.Summary)
+	require.True(t, ok)
+	assert.Nil(t, ready.Config.Alert)
+
+	var headers []string
+	for _, section := range ready.Sections() {
+		headers = append(headers, section.Header)
+	}
+	assert.Contains(t, headers, "Probes")
+
+	unready, ok := got.Config.Sections[0][1].View.(*component.Summary)
+	require.True(t, ok)
+	expectedAlert := component.NewAlert(component.AlertTypeWarning, "No readiness probe is defined")
+	assert.Equal(t, &expectedAlert, unready.Config.Alert)
+}
@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+var (
+	// terminalCommand is the command terminals run in containers.
+	terminalCommand = []string{"/bin/sh"}
+)
+
+// registerPodTerminal registers a tab with a terminal attached to a shell in
+// a running pod's first container. The tab is only registered if exec is
+// enabled.
+func registerPodTerminal(o *Object, pod *corev1.Pod, options Options) {
+	if options.DashConfig == nil || !options.DashConfig.ExecEnabled() {
+		return
+	}
+
+	if pod.Status.Phase != corev1.PodRunning || len(pod.Spec.Containers) == 0 {
+		return
+	}
+
+	o.RegisterTab(TabDescriptor{
+		Name: "Terminal",
+		Func: func() (component.Component, error) {
+			return createPodTerminalView(pod), nil
+		},
+	})
+}
+
+func createPodTerminalView(pod *corev1.Pod) *component.Terminal {
+	session := component.TerminalSession{
+		Namespace: pod.Namespace,
+		Pod:       pod.Name,
+		Container: pod.Spec.Containers[0].Name,
+		Command:   terminalCommand,
+		TTY:       true,
+	}
+
+	return component.NewTerminal("Terminal", session)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	configFake "github.com/vmware/octant/internal/config/fake"
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func Test_registerPodTerminal(t *testing.T) {
+	cases := []struct {
+		name        string
+		execEnabled bool
+		phase       corev1.PodPhase
+		expected    bool
+	}{
+		{
+			name:        "exec enabled",
+			execEnabled: true,
+			phase:       corev1.PodRunning,
+			expected:    true,
+		},
+		{
+			name:  "exec disabled",
+			phase: corev1.PodRunning,
+		},
+		{
+			name:        "pod is not running",
+			execEnabled: true,
+			phase:       corev1.PodSucceeded,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			dashConfig := configFake.NewMockDash(controller)
+			dashConfig.EXPECT().ExecEnabled().Return(tc.execEnabled)
+
+			pod := testutil.CreatePod("pod")
+			pod.Spec.Containers = []corev1.Container{{Name: "app"}, {Name: "sidecar"}}
+			pod.Status.Phase = tc.phase
+
+			o := NewObject(pod)
+			registerPodTerminal(o, pod, Options{DashConfig: dashConfig})
+
+			if !tc.expected {
+				require.Empty(t, o.tabs)
+				return
+			}
+
+			require.Len(t, o.tabs, 1)
+			require.Equal(t, "Terminal", o.tabs[0].Name)
+
+			got, err := o.tabs[0].Func()
+			require.NoError(t, err)
+
+			expected := component.NewTerminal("Terminal", component.TerminalSession{
+				Namespace: "namespace",
+				Pod:       "pod",
+				Container: "app",
+				Command:   []string{"/bin/sh"},
+				TTY:       true,
+			})
+			component.AssertEqual(t, expected, got)
+		})
+	}
+}
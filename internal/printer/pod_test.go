@@ -19,6 +19,7 @@ import (
 
 	"github.com/vmware/octant/internal/conversion"
 	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/action"
 	"github.com/vmware/octant/pkg/view/component"
 )
 
@@ -83,15 +84,15 @@ func Test_PodListHandler(t *testing.T) {
 
 	cols := component.NewTableCols("Name", "Labels", "Ready", "Phase", "Restarts", "Node", "Age")
 	expected := component.NewTable("Pods", "We couldn't find any pods!", cols)
-	expected.Add(component.TableRow{
+	expected.Add(podRowWithActions(t, pod, component.TableRow{
 		"Name":     component.NewLink("", "pod", "/pod"),
 		"Labels":   component.NewLabels(labels),
 		"Ready":    component.NewText("1/2"),
 		"Phase":    component.NewText("Pending"),
-		"Restarts": component.NewText("0"),
+		"Restarts": component.NewNumberText("0", 0),
 		"Age":      component.NewTimestamp(now),
 		"Node":     nodeLink,
-	})
+	}))
 	addPodTableFilters(expected)
 
 	component.AssertEqual(t, expected, got)
@@ -108,7 +109,7 @@ func Test_PodListHandlerNoLabel(t *testing.T) {
 		Return(nodeLink, nil)
 	printOptions := tpo.ToOptions()
 
-	printOptions.DisableLabels = true
+	printOptions.Profile = ProfileCompact
 	now := testutil.Time()
 
 	pod := testutil.CreatePod("pi-7xpxr")
@@ -144,14 +145,14 @@ func Test_PodListHandlerNoLabel(t *testing.T) {
 
 	cols := component.NewTableCols("Name", "Ready", "Phase", "Restarts", "Node", "Age")
 	expected := component.NewTable("Pods", "We couldn't find any pods!", cols)
-	expected.Add(component.TableRow{
+	expected.Add(podRowWithActions(t, pod, component.TableRow{
 		"Name":     component.NewLink("", "pi-7xpxr", "/pi-7xpxr"),
 		"Ready":    component.NewText("0/1"),
 		"Phase":    component.NewText("Succeeded"),
-		"Restarts": component.NewText("0"),
+		"Restarts": component.NewNumberText("0", 0),
 		"Age":      component.NewTimestamp(now),
 		"Node":     nodeLink,
-	})
+	}))
 	addPodTableFilters(expected)
 
 	component.AssertEqual(t, expected, got)
@@ -183,24 +184,24 @@ func TestPodListHandler_sorted(t *testing.T) {
 
 	cols := component.NewTableCols("Name", "Labels", "Ready", "Phase", "Restarts", "Node", "Age")
 	expected := component.NewTable("Pods", "We couldn't find any pods!", cols)
-	expected.Add(component.TableRow{
+	expected.Add(podRowWithActions(t, pod1, component.TableRow{
 		"Name":     component.NewLink("", "pod1", "/pod1"),
 		"Labels":   component.NewLabels(make(map[string]string)),
 		"Ready":    component.NewText("0/0"),
 		"Phase":    component.NewText(""),
-		"Restarts": component.NewText("0"),
+		"Restarts": component.NewNumberText("0", 0),
 		"Age":      component.NewTimestamp(pod1.CreationTimestamp.Time),
 		"Node":     component.NewText("<not scheduled>"),
-	})
-	expected.Add(component.TableRow{
+	}))
+	expected.Add(podRowWithActions(t, pod2, component.TableRow{
 		"Name":     component.NewLink("", "pod2", "/pod2"),
 		"Labels":   component.NewLabels(make(map[string]string)),
 		"Ready":    component.NewText("0/0"),
 		"Phase":    component.NewText(""),
-		"Restarts": component.NewText("0"),
+		"Restarts": component.NewNumberText("0", 0),
 		"Age":      component.NewTimestamp(pod1.CreationTimestamp.Time),
 		"Node":     component.NewText("<not scheduled>"),
-	})
+	}))
 	addPodTableFilters(expected)
 
 	component.AssertEqual(t, expected, got)
@@ -345,23 +346,25 @@ func Test_createPodConditionsView(t *testing.T) {
 	pod.Status.Conditions = []corev1.PodCondition{
 		{
 			Type:               corev1.PodInitialized,
+			Status:             corev1.ConditionTrue,
 			LastTransitionTime: now,
 			Message:            "message",
 			Reason:             "reason",
 		},
 	}
 
-	got, err := createPodConditionsView(pod)
+	got, err := createPodConditionsView(pod, Options{})
 	require.NoError(t, err)
 
-	cols := component.NewTableCols("Type", "Last Transition Time", "Message", "Reason")
-	expected := component.NewTable("Pod Conditions", "There are no pod conditions!", cols)
+	expected := component.NewTable("Conditions", "There are no pod conditions!", conditionColumns)
 	expected.Add([]component.TableRow{
 		{
-			"Type":                 component.NewText("Initialized"),
-			"Last Transition Time": component.NewTimestamp(now.Time),
-			"Message":              component.NewText("message"),
-			"Reason":               component.NewText("reason"),
+			"Type":            component.NewText("Initialized"),
+			"Status":          component.NewStatusText("True", component.NodeStatusOK),
+			"Reason":          component.NewText("reason"),
+			"Message":         component.NewText("message"),
+			"Last Update":     component.NewTimestamp(time.Time{}),
+			"Last Transition": component.NewTimestamp(now.Time),
 		},
 	}...)
 
@@ -412,3 +415,54 @@ func Test_printPodResources(t *testing.T) {
 
 	assert.Equal(t, expected, got)
 }
+
+func Test_addPodRowActions(t *testing.T) {
+	pod := testutil.CreatePod("pod")
+	pod.Spec.Containers = []corev1.Container{
+		{
+			Name: "nginx",
+			Ports: []corev1.ContainerPort{
+				{ContainerPort: 80, Protocol: corev1.ProtocolTCP},
+				{ContainerPort: 53, Protocol: corev1.ProtocolUDP},
+			},
+		},
+	}
+	pod.Status.Phase = corev1.PodRunning
+
+	row := component.TableRow{}
+	require.NoError(t, addPodRowActions(row, pod))
+
+	expected := component.NewGridActions()
+	expected.AddAction(component.NewButton("Delete",
+		action.Payload{
+			"action":     "octant/deleteObject",
+			"namespace":  "namespace",
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"name":       "pod",
+		},
+		component.WithButtonConfirmation("Delete Pod",
+			"Are you sure you want to delete *Pod* **pod**? This action is permanent and cannot be recovered.")))
+	expected.AddAction(component.NewButton("Forward port 80",
+		action.Payload{
+			"action":     "overview/startPortForward",
+			"namespace":  "namespace",
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"name":       "pod",
+			"port":       int32(80),
+		}))
+
+	assert.Equal(t, expected, row[component.GridActionKey])
+}
+
+func Test_addPodRowActions_deleting(t *testing.T) {
+	pod := testutil.CreatePod("pod")
+	pod.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+
+	row := component.TableRow{}
+	require.NoError(t, addPodRowActions(row, pod))
+
+	_, ok := row[component.GridActionKey]
+	assert.False(t, ok)
+}
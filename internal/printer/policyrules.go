@@ -7,9 +7,11 @@ package printer
 
 import (
 	"reflect"
+	"sort"
 	"strings"
 
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 type simpleResource struct {
@@ -108,6 +110,63 @@ func BreakdownRule(rule rbacv1.PolicyRule) []rbacv1.PolicyRule {
 	return subrules
 }
 
+// groupRulesByResource merges rules so there is a single rule for each resource,
+// resource name, and non-resource URL. The verbs for each rule are de-duplicated and sorted,
+// and non-resource URL rules are listed after resource rules.
+func groupRulesByResource(rules []rbacv1.PolicyRule) []rbacv1.PolicyRule {
+	verbs := map[simpleResource]sets.String{}
+	urlVerbs := map[string]sets.String{}
+
+	for _, rule := range rules {
+		for _, subrule := range BreakdownRule(rule) {
+			if len(subrule.NonResourceURLs) > 0 {
+				url := subrule.NonResourceURLs[0]
+				if _, ok := urlVerbs[url]; !ok {
+					urlVerbs[url] = sets.NewString()
+				}
+				urlVerbs[url].Insert(subrule.Verbs...)
+				continue
+			}
+
+			resource, _ := isSimpleResourceRule(&subrule)
+			if _, ok := verbs[resource]; !ok {
+				verbs[resource] = sets.NewString()
+			}
+			verbs[resource].Insert(subrule.Verbs...)
+		}
+	}
+
+	var grouped, nonResourceGrouped []rbacv1.PolicyRule
+	for resource, resourceVerbs := range verbs {
+		rule := rbacv1.PolicyRule{
+			APIGroups: []string{resource.Group},
+			Resources: []string{resource.Resource},
+			Verbs:     resourceVerbs.List(),
+		}
+		if resource.ResourceNameExist {
+			rule.ResourceNames = []string{resource.ResourceName}
+		}
+		grouped = append(grouped, rule)
+	}
+
+	for url, resourceVerbs := range urlVerbs {
+		nonResourceGrouped = append(nonResourceGrouped, rbacv1.PolicyRule{
+			NonResourceURLs: []string{url},
+			Verbs:           resourceVerbs.List(),
+		})
+	}
+
+	sort.SliceStable(grouped, func(i, j int) bool {
+		return grouped[i].String() < grouped[j].String()
+	})
+
+	sort.SliceStable(nonResourceGrouped, func(i, j int) bool {
+		return nonResourceGrouped[i].NonResourceURLs[0] < nonResourceGrouped[j].NonResourceURLs[0]
+	})
+
+	return append(grouped, nonResourceGrouped...)
+}
+
 func CombineResourceGroup(resource, group []string) string {
 	if len(resource) == 0 {
 		return ""
@@ -10,15 +10,21 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/vmware/octant/internal/config"
 	"github.com/vmware/octant/internal/link"
+	"github.com/vmware/octant/internal/metrics"
 	"github.com/vmware/octant/pkg/plugin"
+	"github.com/vmware/octant/pkg/store"
 
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/clock"
 
 	"github.com/vmware/octant/pkg/view/component"
 )
@@ -27,9 +33,138 @@ import (
 
 // Options provides options to a print handler
 type Options struct {
-	DisableLabels bool
-	DashConfig    config.Dash
-	Link          link.Interface
+	// Profile controls how list handlers render lists. It defaults to
+	// ProfileFull.
+	Profile    Profile
+	DashConfig config.Dash
+	Link       link.Interface
+	// Page is the page of rows list tables are limited to.
+	Page component.TablePage
+	// Query narrows the rows of list tables.
+	Query component.TableQuery
+	// TableActions are the actions list handlers add to their tables.
+	TableActions *TableActions
+	// TimestampDisplay is how handlers show timestamps, e.g. ages. It
+	// defaults to relative.
+	TimestampDisplay component.TimestampDisplay
+	// Clock is what handlers compute ages and durations against. It
+	// defaults to the real clock.
+	Clock clock.Clock
+	// LabelLinks links labels to lists of the objects with the label.
+	LabelLinks *LabelLinks
+	// Metrics queries current resource usage. Usage is not shown if it is
+	// nil or the cluster does not serve the metrics API.
+	Metrics metrics.Interface
+	// Access checks if the current credentials can perform the actions
+	// handlers add. Actions are not checked if it is nil.
+	Access store.AccessChecker
+}
+
+// Now returns the current time according to the options' clock.
+func (o Options) Now() time.Time {
+	if o.Clock == nil {
+		return time.Now()
+	}
+
+	return o.Clock.Now()
+}
+
+// Timestamp creates a timestamp component which is shown as the options'
+// timestamp display. Its age is computed against the options' clock.
+func (o Options) Timestamp(t time.Time) *component.Timestamp {
+	var timestampOptions []component.TimestampOption
+	if o.TimestampDisplay != "" {
+		timestampOptions = append(timestampOptions, component.WithTimestampDisplay(o.TimestampDisplay))
+	}
+	if o.Clock != nil {
+		timestampOptions = append(timestampOptions, component.WithTimestampRelativeTo(o.Clock.Now()))
+	}
+
+	return component.NewTimestamp(t, timestampOptions...)
+}
+
+type pageKey struct{}
+
+// WithPage returns a context which limits the tables printed with it to a
+// page of rows.
+func WithPage(ctx context.Context, page component.TablePage) context.Context {
+	return context.WithValue(ctx, pageKey{}, page)
+}
+
+// PageFrom returns the page of rows for a context.
+func PageFrom(ctx context.Context) component.TablePage {
+	page, _ := ctx.Value(pageKey{}).(component.TablePage)
+	return page
+}
+
+type queryKey struct{}
+
+// WithQuery returns a context which narrows the rows of the tables printed
+// with it.
+func WithQuery(ctx context.Context, query component.TableQuery) context.Context {
+	return context.WithValue(ctx, queryKey{}, query)
+}
+
+// QueryFrom returns the table query for a context.
+func QueryFrom(ctx context.Context) component.TableQuery {
+	query, _ := ctx.Value(queryKey{}).(component.TableQuery)
+	return query
+}
+
+type timestampDisplayKey struct{}
+
+// WithTimestampDisplay returns a context which shows the timestamps of the
+// tables printed with it as display.
+func WithTimestampDisplay(ctx context.Context, display component.TimestampDisplay) context.Context {
+	return context.WithValue(ctx, timestampDisplayKey{}, display)
+}
+
+// TimestampDisplayFrom returns the timestamp display for a context.
+func TimestampDisplayFrom(ctx context.Context) component.TimestampDisplay {
+	display, _ := ctx.Value(timestampDisplayKey{}).(component.TimestampDisplay)
+	return display
+}
+
+// Tabs collects the tabs object printers register while printing.
+type Tabs struct {
+	mu         sync.Mutex
+	components []component.Component
+}
+
+// NewTabs creates an instance of Tabs.
+func NewTabs() *Tabs {
+	return &Tabs{}
+}
+
+// Add adds a tab.
+func (t *Tabs) Add(tab component.Component) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.components = append(t.components, tab)
+}
+
+// Components returns the tabs in the order they were added.
+func (t *Tabs) Components() []component.Component {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return append([]component.Component(nil), t.components...)
+}
+
+type tabsKey struct{}
+
+// WithTabs returns a context which collects the tabs registered by the
+// object printers printing with it into tabs.
+func WithTabs(ctx context.Context, tabs *Tabs) context.Context {
+	return context.WithValue(ctx, tabsKey{}, tabs)
+}
+
+// TabsFrom returns the tabs for a context. It returns nil if the context
+// doesn't collect tabs.
+func TabsFrom(ctx context.Context) *Tabs {
+	tabs, _ := ctx.Value(tabsKey{}).(*Tabs)
+	return tabs
 }
 
 // Printer is an interface for printing runtime objects.
@@ -40,18 +175,68 @@ type Printer interface {
 
 // Resource prints runtime objects.
 type Resource struct {
-	handlerMap map[reflect.Type]reflect.Value
-	dashConfig config.Dash
+	registry     *Registry
+	dashConfig   config.Dash
+	tableActions *TableActions
+	clock        clock.Clock
+	metrics      metrics.Interface
 }
 
 var _ Printer = (*Resource)(nil)
 
+// ResourceOption is an option for configuring Resource.
+type ResourceOption func(p *Resource)
+
+// WithClock sets the clock the printer's handlers compute ages and durations
+// against.
+func WithClock(c clock.Clock) ResourceOption {
+	return func(p *Resource) {
+		p.clock = c
+	}
+}
+
+// WithRegistry sets the registry the printer looks up handlers in. Printers
+// created with the same registry share its handlers.
+func WithRegistry(registry *Registry) ResourceOption {
+	return func(p *Resource) {
+		p.registry = registry
+	}
+}
+
+// WithMetrics sets the client the printer's handlers query current resource
+// usage with.
+func WithMetrics(m metrics.Interface) ResourceOption {
+	return func(p *Resource) {
+		p.metrics = m
+	}
+}
+
 // NewResource creates an instance of ResourcePrinter.
-func NewResource(dashConfig config.Dash) *Resource {
-	return &Resource{
-		handlerMap: make(map[reflect.Type]reflect.Value),
-		dashConfig: dashConfig,
+func NewResource(dashConfig config.Dash, options ...ResourceOption) *Resource {
+	p := &Resource{
+		registry:     NewRegistry(),
+		dashConfig:   dashConfig,
+		tableActions: DefaultTableActions(),
+		clock:        clock.RealClock{},
+	}
+
+	for _, option := range options {
+		option(p)
 	}
+
+	return p
+}
+
+// Registry returns the handlers the printer prints with. Register handlers
+// with it to print objects of other kinds or to override built in handlers.
+func (p *Resource) Registry() *Registry {
+	return p.registry
+}
+
+// TableActions returns the actions list handlers add to their tables.
+// Register actions with it to add them to every list.
+func (p *Resource) TableActions() *TableActions {
+	return p.tableActions
 }
 
 // Print prints a runtime object. If not handler can be found for the type,
@@ -63,45 +248,94 @@ func (p *Resource) Print(ctx context.Context, object runtime.Object, pluginPrint
 	}
 
 	printOptions := Options{
-		DashConfig: p.dashConfig,
-		Link:       l,
+		DashConfig:       p.dashConfig,
+		Link:             l,
+		Page:             PageFrom(ctx),
+		Query:            QueryFrom(ctx),
+		TableActions:     p.tableActions,
+		TimestampDisplay: TimestampDisplayFrom(ctx),
+		LabelLinks:       NewLabelLinks(p.dashConfig),
+		Clock:            p.clock,
+		Metrics:          p.metrics,
 	}
 
-	t := reflect.TypeOf(object)
-	printFunc, ok := p.handlerMap[t]
-	if ok {
-		args := []reflect.Value{
-			reflect.ValueOf(ctx),
-			reflect.ValueOf(object),
-			reflect.ValueOf(printOptions)}
-		results := printFunc.Call(args)
-		if !results[1].IsNil() {
-			return nil, results[1].Interface().(error)
+	if checker, ok := p.dashConfig.ObjectStore().(store.AccessChecker); ok {
+		printOptions.Access = checker
+		printOptions.TableActions = p.tableActions.withAccess(ctx, checker)
+	}
+
+	viewComponent, err := p.print(ctx, object, printOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	// tables of lists printed on their own can be searched. Embedded lists
+	// are printed by their parent's handler, so they are not.
+	if table, ok := viewComponent.(*component.Table); ok {
+		if meta.IsListType(object) {
+			table.SetSearchable(true)
 		}
+		table.Query(printOptions.Query)
+		table.Paginate(printOptions.Page)
+	}
 
-		viewComponent := results[0].Interface().(component.Component)
-		return viewComponent, nil
+	return viewComponent, nil
+}
+
+func (p *Resource) print(ctx context.Context, object runtime.Object, printOptions Options) (component.Component, error) {
+	// objects without a kind can't have a handler, so they are printed
+	// with the default handler.
+	gvks, err := objectKinds(object)
+	if err == nil {
+		for _, gvk := range gvks {
+			if printFunc, ok := p.registry.Lookup(gvk); ok {
+				return printFunc(ctx, object, printOptions)
+			}
+		}
 	}
 
 	return DefaultPrintFunc(ctx, object, printOptions)
 }
 
-// Handler adds a printer handler.
+// Handler adds a printer handler. It is registered with the default priority
+// for the GroupVersionKinds of the object it prints.
 // See ValidatePrintHandlerFunc for required method signature.
 func (p *Resource) Handler(printFunc interface{}) error {
-	printFuncValue := reflect.ValueOf(printFunc)
-	if err := ValidatePrintHandlerFunc(printFuncValue); err != nil {
-		return err
-	}
+	return p.registry.Handler(printFunc)
+}
 
+// handlerPrintFunc creates a print func which calls a print handler. Objects
+// which aren't of the handler's type, e.g. unstructured objects, are
+// converted to it.
+func handlerPrintFunc(printFuncValue reflect.Value) PrintFunc {
 	objType := printFuncValue.Type().In(1)
-	if _, ok := p.handlerMap[objType]; ok {
-		return errors.Errorf("registered duplicate printer for %v", objType)
-	}
 
-	p.handlerMap[objType] = printFuncValue
+	return func(ctx context.Context, object runtime.Object, options Options) (component.Component, error) {
+		objectValue := reflect.ValueOf(object)
+		if objectValue.Type() != objType {
+			u, ok := object.(*unstructured.Unstructured)
+			if !ok {
+				return nil, errors.Errorf("unable to print %T with a handler for %v", object, objType)
+			}
+
+			objectValue = reflect.New(objType.Elem())
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), objectValue.Interface()); err != nil {
+				return nil, errors.Wrapf(err, "convert unstructured object to %v", objType)
+			}
+		}
 
-	return nil
+		args := []reflect.Value{
+			reflect.ValueOf(ctx),
+			objectValue,
+			reflect.ValueOf(options)}
+		results := printFuncValue.Call(args)
+		if !results[1].IsNil() {
+			return nil, results[1].Interface().(error)
+		}
+
+		viewComponent, _ := results[0].Interface().(component.Component)
+		return viewComponent, nil
+	}
 }
 
 // ValidatePrintHandlerFunc validates print handler signature.
@@ -139,7 +373,7 @@ func ValidatePrintHandlerFunc(printFunc reflect.Value) error {
 // DefaultPrintFunc is a default object printer. It prints Kubernetes resource
 // lists with three columns: name, labels, age. Returns nil if the object
 // should not be printed.
-func DefaultPrintFunc(_ context.Context, object runtime.Object, _ Options) (component.Component, error) {
+func DefaultPrintFunc(_ context.Context, object runtime.Object, options Options) (component.Component, error) {
 	if object == nil {
 		return nil, errors.New("unable to print nil objects")
 	}
@@ -179,8 +413,8 @@ func DefaultPrintFunc(_ context.Context, object runtime.Object, _ Options) (comp
 		u := unstructured.Unstructured{Object: r}
 
 		name := component.NewText(u.GetName())
-		labels := component.NewLabels(u.GetLabels())
-		age := component.NewTimestamp(u.GetCreationTimestamp().Time)
+		labels := options.LabelLinks.Labels(u.GetLabels(), u.GetNamespace(), u.GroupVersionKind())
+		age := options.Timestamp(u.GetCreationTimestamp().Time)
 
 		row := component.TableRow{
 			"Name":   name,
@@ -9,6 +9,7 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
@@ -17,6 +18,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/clock"
 
 	"github.com/vmware/octant/internal/testutil"
 	"github.com/vmware/octant/pkg/plugin/fake"
@@ -96,6 +98,127 @@ func Test_Resource_Print(t *testing.T) {
 
 }
 
+func Test_Resource_Print_page(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	pluginPrinter := fake.NewMockManagerInterface(controller)
+
+	p := NewResource(tpo.dashConfig)
+
+	var gotPage component.TablePage
+	printFunc := func(ctx context.Context, list *appsv1.DeploymentList, options Options) (component.Component, error) {
+		gotPage = options.Page
+
+		table := component.NewTable("Deployments", "placeholder", component.NewTableCols("Name"))
+		for _, name := range []string{"a", "b", "c"} {
+			table.Add(component.TableRow{"Name": component.NewText(name)})
+		}
+		return table, nil
+	}
+	require.NoError(t, p.Handler(printFunc))
+
+	page := component.TablePage{Offset: 1, Limit: 1}
+	ctx := WithPage(context.Background(), page)
+	got, err := p.Print(ctx, &appsv1.DeploymentList{}, pluginPrinter)
+	require.NoError(t, err)
+
+	assert.Equal(t, page, gotPage)
+
+	table, ok := got.(*component.Table)
+	require.True(t, ok)
+	assert.Equal(t, []component.TableRow{{"Name": component.NewText("b")}}, table.Rows())
+	assert.Equal(t, &component.TablePagination{Offset: 1, Limit: 1, Total: 3}, table.Config.Pagination)
+}
+
+func Test_Resource_Print_query(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	pluginPrinter := fake.NewMockManagerInterface(controller)
+
+	p := NewResource(tpo.dashConfig)
+
+	printFunc := func(ctx context.Context, list *appsv1.DeploymentList, options Options) (component.Component, error) {
+		table := component.NewTable("Deployments", "placeholder", component.NewTableCols("Name"))
+		for _, name := range []string{"nginx-1", "redis", "nginx-2"} {
+			table.Add(component.TableRow{"Name": component.NewText(name)})
+		}
+		return table, nil
+	}
+	require.NoError(t, p.Handler(printFunc))
+
+	ctx := WithQuery(context.Background(), component.TableQuery{Search: "nginx"})
+	ctx = WithPage(ctx, component.TablePage{Limit: 1})
+	got, err := p.Print(ctx, &appsv1.DeploymentList{}, pluginPrinter)
+	require.NoError(t, err)
+
+	table, ok := got.(*component.Table)
+	require.True(t, ok)
+	assert.True(t, table.Config.Searchable)
+	assert.Equal(t, []component.TableRow{{"Name": component.NewText("nginx-1")}}, table.Rows())
+	assert.Equal(t, &component.TablePagination{Offset: 0, Limit: 1, Total: 2}, table.Config.Pagination)
+}
+
+func Test_Resource_Print_timestampDisplay(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	pluginPrinter := fake.NewMockManagerInterface(controller)
+
+	p := NewResource(tpo.dashConfig)
+
+	now := testutil.Time()
+	printFunc := func(ctx context.Context, list *appsv1.DeploymentList, options Options) (component.Component, error) {
+		table := component.NewTable("Deployments", "placeholder", component.NewTableCols("Name", "Age"))
+		table.Add(component.TableRow{"Name": component.NewText("nginx"), "Age": options.Timestamp(now)})
+		return table, nil
+	}
+	require.NoError(t, p.Handler(printFunc))
+
+	ctx := WithTimestampDisplay(context.Background(), component.TimestampAbsolute)
+	got, err := p.Print(ctx, &appsv1.DeploymentList{}, pluginPrinter)
+	require.NoError(t, err)
+
+	table, ok := got.(*component.Table)
+	require.True(t, ok)
+
+	expected := component.NewTimestamp(now, component.WithTimestampDisplay(component.TimestampAbsolute))
+	assert.Equal(t, expected, table.Rows()[0]["Age"])
+}
+
+func Test_Resource_Print_clock(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	pluginPrinter := fake.NewMockManagerInterface(controller)
+
+	now := testutil.Time()
+	p := NewResource(tpo.dashConfig, WithClock(clock.NewFakeClock(now)))
+
+	printFunc := func(ctx context.Context, list *appsv1.DeploymentList, options Options) (component.Component, error) {
+		table := component.NewTable("Deployments", "placeholder", component.NewTableCols("Name", "Age"))
+		table.Add(component.TableRow{"Name": component.NewText("nginx"), "Age": options.Timestamp(now.Add(-3 * time.Hour))})
+		return table, nil
+	}
+	require.NoError(t, p.Handler(printFunc))
+
+	got, err := p.Print(context.Background(), &appsv1.DeploymentList{}, pluginPrinter)
+	require.NoError(t, err)
+
+	table, ok := got.(*component.Table)
+	require.True(t, ok)
+	assert.Equal(t, "3h", table.Rows()[0]["Age"].String())
+}
+
 func Test_Resource_Handler(t *testing.T) {
 	cases := []struct {
 		name      string
@@ -104,9 +227,16 @@ func Test_Resource_Handler(t *testing.T) {
 	}{
 		{
 			name: "valid printer",
+			printFunc: func(context.Context, *appsv1.Deployment, Options) (component.Component, error) {
+				return &stubComponent{Type: "type1"}, nil
+			},
+		},
+		{
+			name: "print func for a type which isn't a runtime object",
 			printFunc: func(context.Context, int, Options) (component.Component, error) {
 				return &stubComponent{Type: "type1"}, nil
 			},
+			isErr: true,
 		},
 		{
 			name:      "non function printer",
@@ -147,7 +277,7 @@ func Test_Resource_Handler(t *testing.T) {
 }
 
 func Test_Resource_DuplicateHandler(t *testing.T) {
-	printFunc := func(context.Context, int, Options) (component.Component, error) {
+	printFunc := func(context.Context, *appsv1.Deployment, Options) (component.Component, error) {
 		return &stubComponent{Type: "type1"}, nil
 	}
 
@@ -166,6 +296,40 @@ func Test_Resource_DuplicateHandler(t *testing.T) {
 
 }
 
+func Test_Resource_Print_registry(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	pluginPrinter := fake.NewMockManagerInterface(controller)
+
+	p := NewResource(tpo.dashConfig)
+
+	var gotName string
+	require.NoError(t, p.Handler(func(ctx context.Context, deployment *appsv1.Deployment, options Options) (component.Component, error) {
+		gotName = deployment.Name
+		return &stubComponent{Type: "builtin"}, nil
+	}))
+
+	ctx := context.Background()
+	deployment := testutil.CreateDeployment("deployment")
+
+	got, err := p.Print(ctx, testutil.ToUnstructured(t, deployment), pluginPrinter)
+	require.NoError(t, err)
+	assert.Equal(t, "builtin", got.GetMetadata().Type)
+	assert.Equal(t, "deployment", gotName)
+
+	gvk := appsv1.SchemeGroupVersion.WithKind("Deployment")
+	require.NoError(t, p.Registry().Register(gvk, func(ctx context.Context, object runtime.Object, options Options) (component.Component, error) {
+		return &stubComponent{Type: "override"}, nil
+	}, WithPriority(PriorityOverride)))
+
+	got, err = p.Print(ctx, deployment, pluginPrinter)
+	require.NoError(t, err)
+	assert.Equal(t, "override", got.GetMetadata().Type)
+}
+
 type stubComponent struct {
 	Type string
 }
@@ -0,0 +1,79 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// describeContainerProbes creates a table describing a container's
+// liveness and readiness probes. It returns nil if the container
+// does not define any probes.
+func describeContainerProbes(c *corev1.Container) *component.Table {
+	if c == nil || (c.LivenessProbe == nil && c.ReadinessProbe == nil) {
+		return nil
+	}
+
+	cols := component.NewTableCols("Probe", "Type", "Action", "Initial Delay", "Period", "Timeout",
+		"Success Threshold", "Failure Threshold")
+	tbl := component.NewTable("Probes", "There are no probes!", cols)
+
+	probes := []struct {
+		name  string
+		probe *corev1.Probe
+	}{
+		{name: "Liveness", probe: c.LivenessProbe},
+		{name: "Readiness", probe: c.ReadinessProbe},
+	}
+
+	for _, p := range probes {
+		if p.probe == nil {
+			continue
+		}
+
+		probeType, action := printProbeHandler(p.probe.Handler)
+
+		row := component.TableRow{}
+		row["Probe"] = component.NewText(p.name)
+		row["Type"] = component.NewText(probeType)
+		row["Action"] = component.NewText(action)
+		row["Initial Delay"] = component.NewText(fmt.Sprintf("%ds", p.probe.InitialDelaySeconds))
+		row["Period"] = component.NewText(fmt.Sprintf("%ds", p.probe.PeriodSeconds))
+		row["Timeout"] = component.NewText(fmt.Sprintf("%ds", p.probe.TimeoutSeconds))
+		row["Success Threshold"] = component.NewText(fmt.Sprintf("%d", p.probe.SuccessThreshold))
+		row["Failure Threshold"] = component.NewText(fmt.Sprintf("%d", p.probe.FailureThreshold))
+
+		tbl.Add(row)
+	}
+
+	return tbl
+}
+
+// printProbeHandler returns the type of a probe handler and a description
+// of what it checks.
+func printProbeHandler(handler corev1.Handler) (string, string) {
+	switch {
+	case handler.Exec != nil:
+		return "Exec", strings.Join(handler.Exec.Command, " ")
+	case handler.HTTPGet != nil:
+		scheme := strings.ToLower(string(handler.HTTPGet.Scheme))
+		if scheme == "" {
+			scheme = "http"
+		}
+		host := net.JoinHostPort(handler.HTTPGet.Host, handler.HTTPGet.Port.String())
+		return "HTTP GET", fmt.Sprintf("%s://%s%s", scheme, host, handler.HTTPGet.Path)
+	case handler.TCPSocket != nil:
+		return "TCP Socket", net.JoinHostPort(handler.TCPSocket.Host, handler.TCPSocket.Port.String())
+	default:
+		return "Unknown", ""
+	}
+}
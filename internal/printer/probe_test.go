@@ -0,0 +1,132 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func Test_describeContainerProbes(t *testing.T) {
+	container := &corev1.Container{
+		Name: "nginx",
+		LivenessProbe: &corev1.Probe{
+			Handler: corev1.Handler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path: "/healthz",
+					Port: intstr.FromInt(8080),
+				},
+			},
+			InitialDelaySeconds: 5,
+			PeriodSeconds:       10,
+			TimeoutSeconds:      1,
+			SuccessThreshold:    1,
+			FailureThreshold:    3,
+		},
+		ReadinessProbe: &corev1.Probe{
+			Handler: corev1.Handler{
+				TCPSocket: &corev1.TCPSocketAction{
+					Port: intstr.FromString("http"),
+				},
+			},
+			PeriodSeconds:    5,
+			TimeoutSeconds:   2,
+			SuccessThreshold: 1,
+			FailureThreshold: 6,
+		},
+	}
+
+	got := describeContainerProbes(container)
+
+	cols := component.NewTableCols("Probe", "Type", "Action", "Initial Delay", "Period", "Timeout",
+		"Success Threshold", "Failure Threshold")
+	expected := component.NewTableWithRows("Probes", "There are no probes!", cols, []component.TableRow{
+		{
+			"Probe":             component.NewText("Liveness"),
+			"Type":              component.NewText("HTTP GET"),
+			"Action":            component.NewText("http://:8080/healthz"),
+			"Initial Delay":     component.NewText("5s"),
+			"Period":            component.NewText("10s"),
+			"Timeout":           component.NewText("1s"),
+			"Success Threshold": component.NewText("1"),
+			"Failure Threshold": component.NewText("3"),
+		},
+		{
+			"Probe":             component.NewText("Readiness"),
+			"Type":              component.NewText("TCP Socket"),
+			"Action":            component.NewText(":http"),
+			"Initial Delay":     component.NewText("0s"),
+			"Period":            component.NewText("5s"),
+			"Timeout":           component.NewText("2s"),
+			"Success Threshold": component.NewText("1"),
+			"Failure Threshold": component.NewText("6"),
+		},
+	})
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_describeContainerProbes_none(t *testing.T) {
+	got := describeContainerProbes(&corev1.Container{Name: "nginx"})
+	assert.Nil(t, got)
+}
+
+func Test_printProbeHandler(t *testing.T) {
+	tests := []struct {
+		name           string
+		handler        corev1.Handler
+		expectedType   string
+		expectedAction string
+	}{
+		{
+			name: "exec",
+			handler: corev1.Handler{
+				Exec: &corev1.ExecAction{Command: []string{"cat", "/tmp/healthy"}},
+			},
+			expectedType:   "Exec",
+			expectedAction: "cat /tmp/healthy",
+		},
+		{
+			name: "https get",
+			handler: corev1.Handler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Scheme: corev1.URISchemeHTTPS,
+					Host:   "localhost",
+					Path:   "/ready",
+					Port:   intstr.FromInt(8443),
+				},
+			},
+			expectedType:   "HTTP GET",
+			expectedAction: "https://localhost:8443/ready",
+		},
+		{
+			name: "tcp socket",
+			handler: corev1.Handler{
+				TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt(5432)},
+			},
+			expectedType:   "TCP Socket",
+			expectedAction: ":5432",
+		},
+		{
+			name:         "unknown",
+			handler:      corev1.Handler{},
+			expectedType: "Unknown",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			probeType, action := printProbeHandler(test.handler)
+			assert.Equal(t, test.expectedType, probeType)
+			assert.Equal(t, test.expectedAction, action)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import "github.com/vmware/octant/pkg/view/component"
+
+// Profile is a named set of rendering choices which list handlers honor.
+type Profile string
+
+const (
+	// ProfileFull renders lists with all of their columns. It is the
+	// default profile.
+	ProfileFull Profile = "full"
+	// ProfileCompact renders lists without labels.
+	ProfileCompact Profile = "compact"
+	// ProfileEmbedded renders lists which are embedded in the view of
+	// another object, e.g. pods under a deployment. They are rendered
+	// without labels or filters.
+	ProfileEmbedded Profile = "embedded"
+)
+
+// ShowLabels returns true if lists include a labels column.
+func (p Profile) ShowLabels() bool {
+	return p == "" || p == ProfileFull
+}
+
+// ShowFilters returns true if lists include column filters.
+func (p Profile) ShowFilters() bool {
+	return p != ProfileEmbedded
+}
+
+// Apply adjusts a list table for the profile.
+func (p Profile) Apply(table *component.Table) *component.Table {
+	if table == nil {
+		return nil
+	}
+
+	if !p.ShowLabels() {
+		table.RemoveColumn("Labels")
+	}
+
+	if !p.ShowFilters() {
+		table.ClearFilters()
+	}
+
+	return table
+}
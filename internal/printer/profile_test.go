@@ -0,0 +1,61 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func TestProfile_Apply(t *testing.T) {
+	filter := component.TableFilter{Values: []string{"Running"}}
+
+	cases := []struct {
+		name            string
+		profile         Profile
+		expectedCols    []component.TableCol
+		expectedFilters map[string]component.TableFilter
+	}{
+		{
+			name:            "default",
+			expectedCols:    component.NewTableCols("Name", "Labels", "Phase"),
+			expectedFilters: map[string]component.TableFilter{"Phase": filter},
+		},
+		{
+			name:            "full",
+			profile:         ProfileFull,
+			expectedCols:    component.NewTableCols("Name", "Labels", "Phase"),
+			expectedFilters: map[string]component.TableFilter{"Phase": filter},
+		},
+		{
+			name:            "compact",
+			profile:         ProfileCompact,
+			expectedCols:    component.NewTableCols("Name", "Phase"),
+			expectedFilters: map[string]component.TableFilter{"Phase": filter},
+		},
+		{
+			name:            "embedded",
+			profile:         ProfileEmbedded,
+			expectedCols:    component.NewTableCols("Name", "Phase"),
+			expectedFilters: map[string]component.TableFilter{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			table := component.NewTable("Pods", "placeholder", component.NewTableCols("Name", "Labels", "Phase"))
+			table.AddFilter("Phase", filter)
+
+			got := tc.profile.Apply(table)
+
+			assert.Equal(t, tc.expectedCols, got.Columns())
+			assert.Equal(t, tc.expectedFilters, got.Config.Filters)
+		})
+	}
+}
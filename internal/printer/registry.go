@@ -0,0 +1,218 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// PrintFunc prints an object.
+type PrintFunc func(ctx context.Context, object runtime.Object, options Options) (component.Component, error)
+
+// Handler priorities. When more than one handler is registered for a
+// GroupVersionKind, the one with the highest priority prints its objects.
+const (
+	// PriorityDefault is the priority of the built in handlers.
+	PriorityDefault = 0
+	// PriorityOverride is a priority which takes precedence over the built in
+	// handlers.
+	PriorityOverride = 100
+)
+
+type registeredHandler struct {
+	printFunc PrintFunc
+	priority  int
+	override  bool
+}
+
+// RegistryOption is an option for registering a handler.
+type RegistryOption func(h *registeredHandler)
+
+// WithPriority sets the priority of a handler. It defaults to PriorityDefault.
+func WithPriority(priority int) RegistryOption {
+	return func(h *registeredHandler) {
+		h.priority = priority
+	}
+}
+
+// WithOverride replaces the handler registered for the GroupVersionKind with
+// the same priority instead of failing.
+func WithOverride() RegistryOption {
+	return func(h *registeredHandler) {
+		h.override = true
+	}
+}
+
+// Registry holds print handlers keyed by GroupVersionKind. Handlers can be
+// registered and unregistered while objects are being printed.
+type Registry struct {
+	mu       sync.RWMutex
+	handlers map[schema.GroupVersionKind][]registeredHandler
+}
+
+// NewRegistry creates an instance of Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		handlers: make(map[schema.GroupVersionKind][]registeredHandler),
+	}
+}
+
+// NewDefaultRegistry creates an instance of Registry with the built in
+// handlers registered.
+func NewDefaultRegistry() (*Registry, error) {
+	r := NewRegistry()
+	if err := AddHandlers(r); err != nil {
+		return nil, errors.Wrap(err, "add print handlers")
+	}
+
+	return r, nil
+}
+
+// Register registers a handler for a GroupVersionKind. Registering a second
+// handler with the same priority is an error unless it is an override.
+func (r *Registry) Register(gvk schema.GroupVersionKind, printFunc PrintFunc, options ...RegistryOption) error {
+	if printFunc == nil {
+		return errors.Errorf("print func for %s is nil", gvk)
+	}
+
+	h := registeredHandler{
+		printFunc: printFunc,
+		priority:  PriorityDefault,
+	}
+	for _, option := range options {
+		option(&h)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	handlers := r.handlers[gvk]
+	for i := range handlers {
+		if handlers[i].priority != h.priority {
+			continue
+		}
+
+		if !h.override {
+			return errors.Errorf("registered duplicate printer for %s with priority %d", gvk, h.priority)
+		}
+
+		handlers[i] = h
+		return nil
+	}
+
+	handlers = append(handlers, h)
+	sort.SliceStable(handlers, func(i, j int) bool {
+		return handlers[i].priority > handlers[j].priority
+	})
+	r.handlers[gvk] = handlers
+
+	return nil
+}
+
+// Handler registers a print handler with the default priority for the
+// GroupVersionKinds of the object it prints.
+// See ValidatePrintHandlerFunc for required method signature.
+func (r *Registry) Handler(printFunc interface{}) error {
+	return r.RegisterHandler(printFunc)
+}
+
+// RegisterHandler registers a print handler for the GroupVersionKinds of the
+// object it prints. Use WithPriority or WithOverride to replace the built in
+// handler for a kind.
+// See ValidatePrintHandlerFunc for required method signature.
+func (r *Registry) RegisterHandler(printFunc interface{}, options ...RegistryOption) error {
+	printFuncValue := reflect.ValueOf(printFunc)
+	if err := ValidatePrintHandlerFunc(printFuncValue); err != nil {
+		return err
+	}
+
+	objType := printFuncValue.Type().In(1)
+	if objType.Kind() != reflect.Ptr {
+		return errors.Errorf("invalid print handler. %v is not a runtime object", objType)
+	}
+
+	object, ok := reflect.New(objType.Elem()).Interface().(runtime.Object)
+	if !ok {
+		return errors.Errorf("invalid print handler. %v is not a runtime object", objType)
+	}
+
+	gvks, _, err := scheme.Scheme.ObjectKinds(object)
+	if err != nil {
+		return errors.Wrapf(err, "find kinds for %v", objType)
+	}
+
+	for _, gvk := range gvks {
+		if err := r.Register(gvk, handlerPrintFunc(printFuncValue), options...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Unregister removes the handler registered for a GroupVersionKind with a
+// priority.
+func (r *Registry) Unregister(gvk schema.GroupVersionKind, priority int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var handlers []registeredHandler
+	for _, h := range r.handlers[gvk] {
+		if h.priority != priority {
+			handlers = append(handlers, h)
+		}
+	}
+
+	if len(handlers) == 0 {
+		delete(r.handlers, gvk)
+		return
+	}
+
+	r.handlers[gvk] = handlers
+}
+
+// Lookup returns the handler with the highest priority for a GroupVersionKind.
+func (r *Registry) Lookup(gvk schema.GroupVersionKind) (PrintFunc, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	handlers := r.handlers[gvk]
+	if len(handlers) == 0 {
+		return nil, false
+	}
+
+	return handlers[0].printFunc, true
+}
+
+// objectKinds returns the GroupVersionKinds of an object. Kinds are looked up
+// in the scheme since typed objects often have an empty type meta. Objects the
+// scheme doesn't know are identified by their type meta.
+func objectKinds(object runtime.Object) ([]schema.GroupVersionKind, error) {
+	if object == nil {
+		return nil, errors.New("object is nil")
+	}
+
+	gvks, _, err := scheme.Scheme.ObjectKinds(object)
+	if err == nil {
+		return gvks, nil
+	}
+
+	if gvk := object.GetObjectKind().GroupVersionKind(); !gvk.Empty() {
+		return []schema.GroupVersionKind{gvk}, nil
+	}
+
+	return nil, err
+}
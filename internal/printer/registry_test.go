@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func stubPrintFunc(name string) PrintFunc {
+	return func(context.Context, runtime.Object, Options) (component.Component, error) {
+		return component.NewText(name), nil
+	}
+}
+
+func lookupName(t *testing.T, r *Registry, gvk schema.GroupVersionKind) string {
+	printFunc, ok := r.Lookup(gvk)
+	require.True(t, ok)
+
+	got, err := printFunc(context.Background(), nil, Options{})
+	require.NoError(t, err)
+
+	return got.String()
+}
+
+func TestRegistry(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"}
+
+	r := NewRegistry()
+
+	_, ok := r.Lookup(gvk)
+	assert.False(t, ok)
+
+	require.NoError(t, r.Register(gvk, stubPrintFunc("default")))
+	assert.Equal(t, "default", lookupName(t, r, gvk))
+
+	require.NoError(t, r.Register(gvk, stubPrintFunc("override"), WithPriority(PriorityOverride)))
+	assert.Equal(t, "override", lookupName(t, r, gvk))
+
+	require.Error(t, r.Register(gvk, stubPrintFunc("duplicate")))
+
+	require.NoError(t, r.Register(gvk, stubPrintFunc("replaced"), WithOverride()))
+
+	r.Unregister(gvk, PriorityOverride)
+	assert.Equal(t, "replaced", lookupName(t, r, gvk))
+
+	r.Unregister(gvk, PriorityDefault)
+	_, ok = r.Lookup(gvk)
+	assert.False(t, ok)
+}
+
+func TestRegistry_Register_nil(t *testing.T) {
+	r := NewRegistry()
+	require.Error(t, r.Register(schema.GroupVersionKind{Kind: "Widget"}, nil))
+}
+
+func TestRegistry_RegisterHandler(t *testing.T) {
+	r, err := NewDefaultRegistry()
+	require.NoError(t, err)
+
+	gvk := appsv1.SchemeGroupVersion.WithKind("Deployment")
+	_, ok := r.Lookup(gvk)
+	require.True(t, ok)
+
+	printFunc := func(context.Context, *appsv1.Deployment, Options) (component.Component, error) {
+		return component.NewText("module"), nil
+	}
+
+	require.Error(t, r.Handler(printFunc))
+	require.NoError(t, r.RegisterHandler(printFunc, WithPriority(PriorityOverride)))
+
+	lookup, ok := r.Lookup(gvk)
+	require.True(t, ok)
+
+	got, err := lookup(context.Background(), &appsv1.Deployment{}, Options{})
+	require.NoError(t, err)
+	assert.Equal(t, "module", got.String())
+
+	require.Error(t, r.RegisterHandler(func() {}))
+}
@@ -16,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/vmware/octant/internal/gvk"
 	"github.com/vmware/octant/pkg/store"
 	"github.com/vmware/octant/pkg/view/component"
 )
@@ -37,24 +38,26 @@ func ReplicaSetListHandler(ctx context.Context, list *appsv1.ReplicaSetList, opt
 		}
 
 		row["Name"] = nameLink
-		row["Labels"] = component.NewLabels(rs.Labels)
+		row["Labels"] = opts.LabelLinks.Labels(rs.Labels, rs.Namespace, gvk.AppReplicaSet)
 
-		status := fmt.Sprintf("%d/%d", rs.Status.AvailableReplicas, rs.Status.Replicas)
-		row["Status"] = component.NewText(status)
+		row["Status"] = printReplicaStatus(rs.Status.AvailableReplicas, rs.Status.Replicas)
 
 		ts := rs.CreationTimestamp.Time
-		row["Age"] = component.NewTimestamp(ts)
+		row["Age"] = opts.Timestamp(ts)
 
-		containers := component.NewContainers()
-		for _, c := range rs.Spec.Template.Spec.Containers {
-			containers.Add(c.Name, c.Image)
-		}
-		row["Containers"] = containers
+		row["Containers"] = printContainers(rs.Spec.Template.Spec.Containers)
 		row["Selector"] = printSelector(rs.Spec.Selector)
 
+		if err := opts.TableActions.AddRowActions(row, &rs); err != nil {
+			return nil, err
+		}
+
 		tbl.Add(row)
 	}
-	return tbl, nil
+
+	opts.TableActions.AddBulkActions(tbl)
+
+	return opts.Profile.Apply(tbl), nil
 }
 
 // ReplicaSetHandler is a printFunc that prints a ReplicaSets.
@@ -67,7 +70,7 @@ func ReplicaSetHandler(ctx context.Context, replicaSet *appsv1.ReplicaSet, optio
 		return nil, err
 	}
 
-	if err := rsh.Config(options); err != nil {
+	if err := rsh.Config(ctx, options); err != nil {
 		return nil, errors.Wrap(err, "print replicaset configuration")
 	}
 
@@ -95,7 +98,7 @@ func NewReplicaSetConfiguration(rs *appsv1.ReplicaSet) *ReplicaSetConfiguration
 }
 
 // Create generates a replicaset configuration summary
-func (rc *ReplicaSetConfiguration) Create(options Options) (*component.Summary, error) {
+func (rc *ReplicaSetConfiguration) Create(ctx context.Context, options Options) (*component.Summary, error) {
 	if rc == nil || rc.replicaset == nil {
 		return nil, errors.New("replicaset is nil")
 	}
@@ -104,11 +107,12 @@ func (rc *ReplicaSetConfiguration) Create(options Options) (*component.Summary,
 
 	sections := component.SummarySections{}
 
-	if controllerRef := metav1.GetControllerOf(rs); controllerRef != nil {
-		controlledBy, err := options.Link.ForOwner(rs, controllerRef)
-		if err != nil {
-			return nil, err
-		}
+	controlledBy, err := createOwnerChainView(ctx, rs, options)
+	if err != nil {
+		return nil, errors.Wrap(err, "create owner chain")
+	}
+
+	if controlledBy != nil {
 		sections = append(sections, component.SummarySection{
 			Header:  "Controlled By",
 			Content: controlledBy,
@@ -128,6 +132,14 @@ func (rc *ReplicaSetConfiguration) Create(options Options) (*component.Summary,
 
 	summary := component.NewSummary("Configuration", sections...)
 
+	actions, err := scaleAction(rs, rs.Spec.Replicas)
+	if err != nil {
+		return nil, errors.Wrap(err, "generate scale action")
+	}
+	for _, action := range actions {
+		summary.AddAction(action)
+	}
+
 	return summary, nil
 }
 
@@ -185,14 +197,14 @@ func (replicaSetStatus *ReplicaSetStatus) Create() (*component.Quadrant, error)
 }
 
 type replicaSetObject interface {
-	Config(options Options) error
+	Config(ctx context.Context, options Options) error
 	Status(ctx context.Context, options Options) error
 	Pods(ctx context.Context, object runtime.Object, options Options) error
 }
 
 type replicaSetHandler struct {
 	replicaSet *appsv1.ReplicaSet
-	configFunc func(*appsv1.ReplicaSet, Options) (*component.Summary, error)
+	configFunc func(context.Context, *appsv1.ReplicaSet, Options) (*component.Summary, error)
 	statusFunc func(context.Context, *appsv1.ReplicaSet, Options) (*component.Quadrant, error)
 	podFunc    func(context.Context, runtime.Object, Options) (component.Component, error)
 	object     *Object
@@ -220,8 +232,8 @@ func newReplicaSetHandler(replicaSet *appsv1.ReplicaSet, object *Object) (*repli
 	return rh, nil
 }
 
-func (r *replicaSetHandler) Config(options Options) error {
-	out, err := r.configFunc(r.replicaSet, options)
+func (r *replicaSetHandler) Config(ctx context.Context, options Options) error {
+	out, err := r.configFunc(ctx, r.replicaSet, options)
 	if err != nil {
 		return err
 	}
@@ -229,8 +241,8 @@ func (r *replicaSetHandler) Config(options Options) error {
 	return nil
 }
 
-func defaultReplicaSetConfig(replicaSet *appsv1.ReplicaSet, options Options) (*component.Summary, error) {
-	return NewReplicaSetConfiguration(replicaSet).Create(options)
+func defaultReplicaSetConfig(ctx context.Context, replicaSet *appsv1.ReplicaSet, options Options) (*component.Summary, error) {
+	return NewReplicaSetConfiguration(replicaSet).Create(ctx, options)
 }
 
 func (r *replicaSetHandler) Status(ctx context.Context, options Options) error {
@@ -87,8 +87,22 @@ func Test_ReplicaSetListHandler(t *testing.T) {
 	require.NoError(t, err)
 
 	containers := component.NewContainers()
-	containers.Add("nginx", "nginx:1.15")
-	containers.Add("kuard", "gcr.io/kuar-demo/kuard-amd64:1")
+	containers.AddContainerDef(component.ContainerDef{
+		Name:       "nginx",
+		Image:      "nginx:1.15",
+		Registry:   "docker.io",
+		Repository: "library/nginx",
+		Tag:        "1.15",
+		Warnings:   []string{"Image is not pinned to a digest"},
+	})
+	containers.AddContainerDef(component.ContainerDef{
+		Name:       "kuard",
+		Image:      "gcr.io/kuar-demo/kuard-amd64:1",
+		Registry:   "gcr.io",
+		Repository: "kuar-demo/kuard-amd64",
+		Tag:        "1",
+		Warnings:   []string{"Image is not pinned to a digest"},
+	})
 
 	cols := component.NewTableCols("Name", "Labels", "Status", "Age", "Containers", "Selector")
 	expected := component.NewTable("ReplicaSets", "We couldn't find any replica sets!", cols)
@@ -97,7 +111,7 @@ func Test_ReplicaSetListHandler(t *testing.T) {
 		"Labels":     component.NewLabels(labels),
 		"Age":        component.NewTimestamp(now),
 		"Selector":   component.NewSelectors([]component.Selector{component.NewLabelSelector("app", "myapp")}),
-		"Status":     component.NewText("2/3"),
+		"Status":     component.NewStatusText("2/3", component.NodeStatusWarning),
 		"Containers": containers,
 	})
 
@@ -143,7 +157,7 @@ func Test_ReplicaSetConfiguration(t *testing.T) {
 			expected: component.NewSummary("Configuration", []component.SummarySection{
 				{
 					Header:  "Controlled By",
-					Content: component.NewLink("", "replicaset-controller", "/owner"),
+					Content: component.NewBreadcrumb(component.NewLink("", "replicaset-controller", "/owner")),
 				},
 				{
 					Header:  "Replica Status",
@@ -173,10 +187,20 @@ func Test_ReplicaSetConfiguration(t *testing.T) {
 			rc := NewReplicaSetConfiguration(tc.replicaset)
 
 			if tc.replicaset != nil && len(tc.replicaset.OwnerReferences) > 0 {
-				tpo.PathForOwner(tc.replicaset, &tc.replicaset.OwnerReferences[0], "/owner")
+				ownerReference := tc.replicaset.OwnerReferences[0]
+				tpo.PathForOwner(tc.replicaset, &ownerReference, "/owner")
+
+				key := store.Key{
+					Namespace:  tc.replicaset.Namespace,
+					APIVersion: ownerReference.APIVersion,
+					Kind:       ownerReference.Kind,
+					Name:       ownerReference.Name,
+				}
+				tpo.objectStore.EXPECT().Get(gomock.Any(), key).Return(nil, false, nil)
 			}
 
-			summary, err := rc.Create(printOptions)
+			ctx := context.Background()
+			summary, err := rc.Create(ctx, printOptions)
 			if tc.isErr {
 				require.Error(t, err)
 				return
@@ -226,9 +250,10 @@ func Test_ReplicaSetStatus(t *testing.T) {
 		podList.Items = append(podList.Items, *testutil.ToUnstructured(t, &p))
 	}
 	key := store.Key{
-		Namespace:  "testing",
-		APIVersion: "v1",
-		Kind:       "Pod",
+		Namespace:     "testing",
+		APIVersion:    "v1",
+		Kind:          "Pod",
+		LabelSelector: rs.Spec.Selector,
 	}
 
 	tpo.objectStore.EXPECT().List(gomock.Any(), gomock.Eq(key)).Return(podList, false, nil)
@@ -305,22 +330,21 @@ func Test_ReplicaSetPods(t *testing.T) {
 	tpo.objectStore.EXPECT().List(gomock.Any(), gomock.Eq(key)).Return(podList, false, nil)
 
 	printOptions := tpo.ToOptions()
-	printOptions.DisableLabels = false
+	printOptions.Profile = ProfileFull
 
 	got, err := createPodListView(ctx, replicaSet, printOptions)
 	require.NoError(t, err)
 
 	cols := component.NewTableCols("Name", "Ready", "Phase", "Restarts", "Node", "Age")
 	expected := component.NewTable("Pods", "We couldn't find any pods!", cols)
-	expected.Add(component.TableRow{
+	expected.Add(podRowWithActions(t, pod, component.TableRow{
 		"Name":     component.NewLink("", "nginx-deployment-59478d9757-nfqbk", "/pod"),
 		"Ready":    component.NewText("0/1"),
 		"Phase":    component.NewText("Pending"),
-		"Restarts": component.NewText("0"),
+		"Restarts": component.NewNumberText("0", 0),
 		"Node":     nodeLink,
 		"Age":      component.NewTimestamp(now),
-	})
-	addPodTableFilters(expected)
+	}))
 
 	component.AssertEqual(t, expected, got)
 }
@@ -15,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/vmware/octant/internal/gvk"
 	"github.com/vmware/octant/pkg/store"
 	"github.com/vmware/octant/pkg/view/component"
 )
@@ -38,25 +39,27 @@ func ReplicationControllerListHandler(ctx context.Context, list *corev1.Replicat
 
 		row["Name"] = nameLink
 
-		row["Labels"] = component.NewLabels(rc.Labels)
+		row["Labels"] = options.LabelLinks.Labels(rc.Labels, rc.Namespace, gvk.ReplicationController)
 
-		status := fmt.Sprintf("%d/%d", rc.Status.AvailableReplicas, rc.Status.Replicas)
-		row["Status"] = component.NewText(status)
+		row["Status"] = printReplicaStatus(rc.Status.AvailableReplicas, rc.Status.Replicas)
 
 		ts := rc.CreationTimestamp.Time
-		row["Age"] = component.NewTimestamp(ts)
+		row["Age"] = options.Timestamp(ts)
 
-		containers := component.NewContainers()
-		for _, c := range rc.Spec.Template.Spec.Containers {
-			containers.Add(c.Name, c.Image)
-		}
-		row["Containers"] = containers
+		row["Containers"] = printContainers(rc.Spec.Template.Spec.Containers)
 
 		row["Selector"] = printSelectorMap(rc.Spec.Selector)
 
+		if err := options.TableActions.AddRowActions(row, &rc); err != nil {
+			return nil, err
+		}
+
 		tbl.Add(row)
 	}
-	return tbl, nil
+
+	options.TableActions.AddBulkActions(tbl)
+
+	return options.Profile.Apply(tbl), nil
 }
 
 // ReplicationControllerHandler is a printFunc that prints a ReplicationController
@@ -69,7 +72,7 @@ func ReplicationControllerHandler(ctx context.Context, rc *corev1.ReplicationCon
 		return nil, err
 	}
 
-	if err := rch.Config(options); err != nil {
+	if err := rch.Config(ctx, options); err != nil {
 		return nil, errors.Wrap(err, "print replicationcontroller configuration")
 	}
 
@@ -97,7 +100,7 @@ func NewReplicationControllerConfiguration(rc *corev1.ReplicationController) *Re
 }
 
 // Create generates a replicationcontroller configuration summary
-func (rcc *ReplicationControllerConfiguration) Create(options Options) (*component.Summary, error) {
+func (rcc *ReplicationControllerConfiguration) Create(ctx context.Context, options Options) (*component.Summary, error) {
 	if rcc == nil || rcc.replicationController == nil {
 		return nil, errors.New("replicationcontroller is nil")
 	}
@@ -106,12 +109,12 @@ func (rcc *ReplicationControllerConfiguration) Create(options Options) (*compone
 
 	sections := component.SummarySections{}
 
-	if controllerRef := metav1.GetControllerOf(replicationController); controllerRef != nil {
-		controlledBy, err := options.Link.ForOwner(replicationController, controllerRef)
-		if err != nil {
-			return nil, err
-		}
+	controlledBy, err := createOwnerChainView(ctx, replicationController, options)
+	if err != nil {
+		return nil, errors.Wrap(err, "create owner chain")
+	}
 
+	if controlledBy != nil {
 		sections = append(sections, component.SummarySection{
 			Header:  "Controlled By",
 			Content: controlledBy,
@@ -130,6 +133,15 @@ func (rcc *ReplicationControllerConfiguration) Create(options Options) (*compone
 	sections.AddText("Replicas", replicas)
 
 	summary := component.NewSummary("Configuration", sections...)
+
+	actions, err := scaleAction(replicationController, replicationController.Spec.Replicas)
+	if err != nil {
+		return nil, errors.Wrap(err, "generate scale action")
+	}
+	for _, action := range actions {
+		summary.AddAction(action)
+	}
+
 	return summary, nil
 }
 
@@ -188,14 +200,14 @@ func (rcs *ReplicationControllerStatus) Create() (*component.Quadrant, error) {
 }
 
 type replicationControllerObject interface {
-	Config(options Options) error
+	Config(ctx context.Context, options Options) error
 	Status(ctx context.Context, options Options) error
 	Pods(ctx context.Context, object runtime.Object, options Options) error
 }
 
 type replicationControllerHandler struct {
 	replicationController *corev1.ReplicationController
-	configFunc            func(*corev1.ReplicationController, Options) (*component.Summary, error)
+	configFunc            func(context.Context, *corev1.ReplicationController, Options) (*component.Summary, error)
 	statusFunc            func(context.Context, *corev1.ReplicationController, Options) (*component.Quadrant, error)
 	podFunc               func(context.Context, runtime.Object, Options) (component.Component, error)
 	object                *Object
@@ -223,8 +235,8 @@ func newReplicationControllerHandler(replicationController *corev1.ReplicationCo
 	return rch, nil
 }
 
-func (r *replicationControllerHandler) Config(options Options) error {
-	out, err := r.configFunc(r.replicationController, options)
+func (r *replicationControllerHandler) Config(ctx context.Context, options Options) error {
+	out, err := r.configFunc(ctx, r.replicationController, options)
 	if err != nil {
 		return err
 	}
@@ -232,8 +244,8 @@ func (r *replicationControllerHandler) Config(options Options) error {
 	return nil
 }
 
-func defaultReplicationControllerConfig(replicationController *corev1.ReplicationController, options Options) (*component.Summary, error) {
-	return NewReplicationControllerConfiguration(replicationController).Create(options)
+func defaultReplicationControllerConfig(ctx context.Context, replicationController *corev1.ReplicationController, options Options) (*component.Summary, error) {
+	return NewReplicationControllerConfiguration(replicationController).Create(ctx, options)
 }
 
 func (r *replicationControllerHandler) Status(ctx context.Context, options Options) error {
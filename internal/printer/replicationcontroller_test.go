@@ -81,14 +81,21 @@ func Test_ReplicationControllerListHandler(t *testing.T) {
 	require.NoError(t, err)
 
 	containers := component.NewContainers()
-	containers.Add("nginx", "nginx:1.15")
+	containers.AddContainerDef(component.ContainerDef{
+		Name:       "nginx",
+		Image:      "nginx:1.15",
+		Registry:   "docker.io",
+		Repository: "library/nginx",
+		Tag:        "1.15",
+		Warnings:   []string{"Image is not pinned to a digest"},
+	})
 
 	cols := component.NewTableCols("Name", "Labels", "Status", "Age", "Containers", "Selector")
 	expected := component.NewTable("ReplicationControllers", "We couldn't find any replication controllers!", cols)
 	expected.Add(component.TableRow{
 		"Name":       component.NewLink("", "rc-test", "/rc"),
 		"Labels":     component.NewLabels(validReplicationControllerLabels),
-		"Status":     component.NewText("0/3"),
+		"Status":     component.NewStatusText("0/3", component.NodeStatusError),
 		"Age":        component.NewTimestamp(validReplicationControllerCreationTime),
 		"Containers": containers,
 		"Selector":   component.NewSelectors([]component.Selector{component.NewLabelSelector("app", "myapp")}),
@@ -107,6 +114,18 @@ func Test_ReplicationControllerConfiguration(t *testing.T) {
 		Replicas:      3,
 	}
 
+	expected := component.NewSummary("Configuration", []component.SummarySection{
+		{
+			Header:  "Replica Status",
+			Content: component.NewText("Current 3 / Desired 3"),
+		},
+		{
+			Header:  "Replicas",
+			Content: component.NewText("3"),
+		},
+	}...)
+	expected.AddAction(scaleActionFor(t, rc, "ReplicationController", "3"))
+
 	cases := []struct {
 		name                  string
 		replicationController *corev1.ReplicationController
@@ -116,16 +135,7 @@ func Test_ReplicationControllerConfiguration(t *testing.T) {
 		{
 			name:                  "replicationcontroller",
 			replicationController: rc,
-			expected: component.NewSummary("Configuration", []component.SummarySection{
-				{
-					Header:  "Replica Status",
-					Content: component.NewText("Current 3 / Desired 3"),
-				},
-				{
-					Header:  "Replicas",
-					Content: component.NewText("3"),
-				},
-			}...),
+			expected:              expected,
 		},
 		{
 			name:                  "replicationcontroller is nil",
@@ -144,7 +154,8 @@ func Test_ReplicationControllerConfiguration(t *testing.T) {
 
 			rcc := NewReplicationControllerConfiguration(tc.replicationController)
 
-			summary, err := rcc.Create(printOptions)
+			ctx := context.Background()
+			summary, err := rcc.Create(ctx, printOptions)
 			if tc.isErr {
 				require.Error(t, err)
 				return
@@ -199,9 +210,10 @@ func TestReplicationControllerStatus(t *testing.T) {
 		podList.Items = append(podList.Items, *testutil.ToUnstructured(t, &p))
 	}
 	key := store.Key{
-		Namespace:  "testing",
-		APIVersion: "v1",
-		Kind:       "Pod",
+		Namespace:     "testing",
+		APIVersion:    "v1",
+		Kind:          "Pod",
+		LabelSelector: &metav1.LabelSelector{MatchLabels: replicationController.Spec.Selector},
 	}
 
 	tpo.objectStore.EXPECT().List(gomock.Any(), gomock.Eq(key)).Return(podList, false, nil)
@@ -278,22 +290,21 @@ func Test_ReplicationControllerPods(t *testing.T) {
 	tpo.objectStore.EXPECT().List(gomock.Any(), gomock.Eq(key)).Return(podList, false, nil)
 
 	printOptions := tpo.ToOptions()
-	printOptions.DisableLabels = false
+	printOptions.Profile = ProfileFull
 
 	got, err := createPodListView(ctx, rc, printOptions)
 	require.NoError(t, err)
 
 	cols := component.NewTableCols("Name", "Ready", "Phase", "Restarts", "Node", "Age")
 	expected := component.NewTable("Pods", "We couldn't find any pods!", cols)
-	expected.Add(component.TableRow{
+	expected.Add(podRowWithActions(t, pod, component.TableRow{
 		"Name":     component.NewLink("", "nginx-hv4qs", "/pod"),
 		"Ready":    component.NewText("0/1"),
 		"Phase":    component.NewText("Pending"),
-		"Restarts": component.NewText("0"),
+		"Restarts": component.NewNumberText("0", 0),
 		"Node":     nodeLink,
 		"Age":      component.NewTimestamp(now),
-	})
-	addPodTableFilters(expected)
+	}))
 
 	component.AssertEqual(t, expected, got)
 }
@@ -0,0 +1,122 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/internal/revision"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// registerRevisions registers a tab which lists the revisions of a
+// deployment or daemon set. Each earlier revision shows the changes rolling
+// back to it would make to the current pod template.
+func registerRevisions(ctx context.Context, o *Object, object runtime.Object, current corev1.PodTemplateSpec, options Options) {
+	o.RegisterTab(TabDescriptor{
+		Name: "Revisions",
+		Func: func() (component.Component, error) {
+			revisions, err := revision.List(ctx, options.DashConfig.ObjectStore(), object)
+			if err != nil {
+				return nil, errors.Wrap(err, "list revisions")
+			}
+
+			return createRevisionsView(object, revisions, current, options)
+		},
+	})
+}
+
+func createRevisionsView(object runtime.Object, revisions []revision.Revision, current corev1.PodTemplateSpec, options Options) (*component.Card, error) {
+	currentNumber := revision.Current(object, revisions)
+
+	currentJSON, err := json.MarshalIndent(current, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "encode current pod template")
+	}
+
+	cols := component.NewTableCols("Revision", "Name", "Images", "Age", "Changes")
+	table := component.NewTable("Revisions", "There are no revisions!", cols)
+
+	var numbers []string
+	for _, r := range revisions {
+		number := fmt.Sprintf("%d", r.Number)
+
+		var changes component.Component = component.NewText("Current revision")
+		if r.Number != currentNumber {
+			numbers = append(numbers, number)
+
+			revisionJSON, err := json.MarshalIndent(r.Template, "", "  ")
+			if err != nil {
+				return nil, errors.Wrapf(err, "encode pod template of revision %d", r.Number)
+			}
+
+			diff := component.NewDiff("", string(currentJSON), string(revisionJSON))
+			diff.SetLabels("current", fmt.Sprintf("revision %d", r.Number))
+			diff.Config.Language = "json"
+			changes = diff
+		}
+
+		table.Add(component.TableRow{
+			"Revision": component.NewText(number),
+			"Name":     component.NewText(r.Name),
+			"Images":   component.NewText(revisionImages(r.Template)),
+			"Age":      options.Timestamp(r.Created),
+			"Changes":  changes,
+		})
+	}
+
+	card := component.NewCard("Revisions")
+	card.SetBody(table)
+
+	if len(numbers) > 0 {
+		action, err := rollbackAction(object, numbers)
+		if err != nil {
+			return nil, err
+		}
+		card.AddAction(action)
+	}
+
+	return card, nil
+}
+
+// rollbackAction creates an action which rolls an object back to one of
+// numbers. The changes a rollback makes are listed in the revisions table,
+// so the rollback is confirmed before it is submitted.
+func rollbackAction(object runtime.Object, numbers []string) (component.Action, error) {
+	revisionField := component.NewFormFieldSelect("Revision", octant.RollbackRevisionField,
+		component.NewInputChoices(numbers), false)
+	revisionField.SetValidation(component.NewFormFieldValidation(component.ValidateRequired()))
+
+	form, err := component.CreateFormForObject(octant.ActionRollback, object, revisionField)
+	if err != nil {
+		return component.Action{}, err
+	}
+
+	return component.Action{
+		Name:           "Rollback",
+		Title:          "Roll Back to Revision",
+		Form:           form,
+		ConfirmChanges: true,
+		Danger:         component.DangerLevelWarning,
+	}, nil
+}
+
+func revisionImages(template corev1.PodTemplateSpec) string {
+	var images []string
+	for _, container := range template.Spec.Containers {
+		images = append(images, container.Image)
+	}
+
+	return strings.Join(images, ", ")
+}
@@ -0,0 +1,72 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware/octant/internal/revision"
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func Test_createRevisionsView(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	now := testutil.Time()
+
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.Annotations = map[string]string{"deployment.kubernetes.io/revision": "2"}
+
+	template := func(image string) corev1.PodTemplateSpec {
+		return corev1.PodTemplateSpec{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "app", Image: image}},
+			},
+		}
+	}
+
+	revisions := []revision.Revision{
+		{Number: 2, Name: "rs-2", Created: now, Template: template("app:2")},
+		{Number: 1, Name: "rs-1", Created: now, Template: template("app:1")},
+	}
+
+	got, err := createRevisionsView(deployment, revisions, template("app:2"), printOptions)
+	require.NoError(t, err)
+
+	table, ok := got.Config.Body.(*component.Table)
+	require.True(t, ok)
+
+	rows := table.Rows()
+	require.Len(t, rows, 2)
+
+	assert.Equal(t, component.NewText("Current revision"), rows[0]["Changes"])
+	assert.Equal(t, component.NewText("app:1"), rows[1]["Images"])
+
+	diff, ok := rows[1]["Changes"].(*component.Diff)
+	require.True(t, ok)
+	assert.True(t, diff.HasChanges())
+	assert.Equal(t, "revision 1", diff.Config.AfterLabel)
+
+	require.Len(t, got.Config.Actions, 1)
+	rollback := got.Config.Actions[0]
+	assert.Equal(t, "Rollback", rollback.Name)
+	assert.Equal(t, component.DangerLevelWarning, rollback.Danger)
+	assert.True(t, rollback.ConfirmChanges)
+
+	revisionField := component.NewFormFieldSelect("Revision", "revision", component.NewInputChoices([]string{"1"}), false)
+	revisionField.SetValidation(component.NewFormFieldValidation(component.ValidateRequired()))
+	assert.Equal(t, revisionField, rollback.Form.Fields[0])
+}
@@ -32,11 +32,17 @@ func RoleListHandler(_ context.Context, roleList *rbacv1.RoleList, options Optio
 		}
 
 		row["Name"] = nameLink
-		row["Age"] = component.NewTimestamp(role.CreationTimestamp.Time)
+		row["Age"] = options.Timestamp(role.CreationTimestamp.Time)
+		if err := options.TableActions.AddRowActions(row, &role); err != nil {
+			return nil, err
+		}
+
 		table.Add(row)
 	}
 
-	return table, nil
+	options.TableActions.AddBulkActions(table)
+
+	return options.Profile.Apply(table), nil
 }
 
 // RoleHandler is a printFunc that prints roles
@@ -11,7 +11,9 @@ import (
 
 	"github.com/pkg/errors"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware/octant/pkg/store"
 	"github.com/vmware/octant/pkg/view/component"
 )
 
@@ -32,7 +34,7 @@ func RoleBindingListHandler(ctx context.Context, roleBindingList *rbacv1.RoleBin
 		}
 
 		row["Name"] = nameLink
-		row["Age"] = component.NewTimestamp(roleBinding.CreationTimestamp.Time)
+		row["Age"] = opts.Timestamp(roleBinding.CreationTimestamp.Time)
 		row["Role kind"] = component.NewText(roleBinding.RoleRef.Kind)
 		roleName, err := roleLinkFromRoleBinding(ctx, &roleBinding, opts)
 		if err != nil {
@@ -40,10 +42,16 @@ func RoleBindingListHandler(ctx context.Context, roleBindingList *rbacv1.RoleBin
 		}
 		row["Role name"] = roleName
 
+		if err := opts.TableActions.AddRowActions(row, &roleBinding); err != nil {
+			return nil, err
+		}
+
 		table.Add(row)
 	}
 
-	return table, nil
+	opts.TableActions.AddBulkActions(table)
+
+	return opts.Profile.Apply(table), nil
 }
 
 func roleLinkFromRoleBinding(ctx context.Context, roleBinding *rbacv1.RoleBinding, options Options) (*component.Link, error) {
@@ -80,6 +88,10 @@ func RoleBindingHandler(ctx context.Context, roleBinding *rbacv1.RoleBinding, op
 		return nil, errors.Wrap(err, "print rolebinding subjects")
 	}
 
+	if err := rh.PolicyRules(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print rolebinding policy rules")
+	}
+
 	// configSummary, err := printRoleBindingConfig(ctx, roleBinding, opts)
 	// if err != nil {
 	// 	return nil, err
@@ -134,28 +146,58 @@ func createRoleBindingSubjectsView(ctx context.Context, roleBinding *rbacv1.Role
 		return nil, errors.New("role binding is nil")
 	}
 
-	columns := component.NewTableCols("Kind", "Name", "Namespace")
+	return createSubjectsView(ctx, roleBinding.Subjects, roleBinding.Namespace, options)
+}
+
+// createSubjectsView creates a table of binding subjects. ServiceAccount subjects
+// are resolved against the object store: existing service accounts are linked and
+// missing service accounts are flagged.
+func createSubjectsView(ctx context.Context, subjects []rbacv1.Subject, namespace string, options Options) (*component.Table, error) {
+	columns := component.NewTableCols("Kind", "Name", "Namespace", "Status")
 	table := component.NewTable("Subjects", "There are no subjects!", columns)
 
-	for _, subject := range roleBinding.Subjects {
+	objectStore := options.DashConfig.ObjectStore()
+
+	for i := range subjects {
+		subject := subjects[i]
+		if subject.Kind == rbacv1.ServiceAccountKind && subject.Namespace == "" {
+			subject.Namespace = namespace
+		}
+
 		row := component.TableRow{}
 
 		row["Kind"] = component.NewText(subject.Kind)
+		row["Name"] = component.NewText(subject.Name)
+		row["Namespace"] = component.NewText(subject.Namespace)
+		row["Status"] = component.NewText("")
+
+		if subject.Kind == rbacv1.ServiceAccountKind {
+			key := store.Key{
+				Namespace:  subject.Namespace,
+				APIVersion: "v1",
+				Kind:       rbacv1.ServiceAccountKind,
+				Name:       subject.Name,
+			}
 
-		if subject.Kind == "ServiceAccount" {
-			name, err := serviceAccountLinkFromSubjects(ctx, &subject, options)
+			_, found, err := objectStore.Get(ctx, key)
 			if err != nil {
-				return nil, err
+				return nil, errors.Wrapf(err, "get service account for key %s", key)
 			}
-			row["Name"] = name
-		} else {
-			row["Name"] = component.NewText(subject.Name)
-		}
 
-		row["Namespace"] = component.NewText(subject.Namespace)
+			if found {
+				name, err := serviceAccountLinkFromSubjects(ctx, &subject, options)
+				if err != nil {
+					return nil, err
+				}
+				row["Name"] = name
+			} else {
+				row["Status"] = component.NewText("ServiceAccount not found")
+			}
+		}
 
 		table.Add(row)
 	}
+
 	return table, nil
 }
 
@@ -168,16 +210,64 @@ func serviceAccountLinkFromSubjects(_ context.Context, subject *rbacv1.Subject,
 	return options.Link.ForGVK(namespace, "v1", subject.Kind, subject.Name, subject.Name)
 }
 
+// createRoleRefPolicyRulesView creates a table of the policy rules of the role
+// referenced by a binding.
+func createRoleRefPolicyRulesView(ctx context.Context, roleRef rbacv1.RoleRef, namespace string, options Options) (*component.Table, error) {
+	key := store.Key{
+		APIVersion: fmt.Sprintf("%s/%s", roleRef.APIGroup, "v1"),
+		Kind:       roleRef.Kind,
+		Name:       roleRef.Name,
+	}
+
+	if roleRef.Kind == "Role" {
+		key.Namespace = namespace
+	}
+
+	object, found, err := options.DashConfig.ObjectStore().Get(ctx, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get role for key %s", key)
+	}
+
+	if !found {
+		cols := component.NewTableCols("Resources", "Non-Resource URLs", "Resource Names", "Verbs")
+		placeholder := fmt.Sprintf("%s %s does not exist!", roleRef.Kind, roleRef.Name)
+		return component.NewTable("Policy Rules", placeholder, cols), nil
+	}
+
+	var rules []rbacv1.PolicyRule
+
+	switch roleRef.Kind {
+	case "ClusterRole":
+		clusterRole := &rbacv1.ClusterRole{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, clusterRole); err != nil {
+			return nil, errors.Wrap(err, "convert unstructured cluster role")
+		}
+		rules = clusterRole.Rules
+	case "Role":
+		role := &rbacv1.Role{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, role); err != nil {
+			return nil, errors.Wrap(err, "convert unstructured role")
+		}
+		rules = role.Rules
+	default:
+		return nil, errors.Errorf("unable to handle role ref kind %q", roleRef.Kind)
+	}
+
+	return printPolicyRules(rules)
+}
+
 type roleBindingObject interface {
 	Config(ctx context.Context, options Options) error
 	Subjects(ctx context.Context, options Options) error
+	PolicyRules(ctx context.Context, options Options) error
 }
 
 type roleBindingHandler struct {
-	roleBinding  *rbacv1.RoleBinding
-	configFunc   func(context.Context, *rbacv1.RoleBinding, Options) (*component.Summary, error)
-	subjectsFunc func(context.Context, *rbacv1.RoleBinding, Options) (component.Component, error)
-	object       *Object
+	roleBinding     *rbacv1.RoleBinding
+	configFunc      func(context.Context, *rbacv1.RoleBinding, Options) (*component.Summary, error)
+	subjectsFunc    func(context.Context, *rbacv1.RoleBinding, Options) (component.Component, error)
+	policyRulesFunc func(context.Context, *rbacv1.RoleBinding, Options) (component.Component, error)
+	object          *Object
 }
 
 var _ roleBindingObject = (*roleBindingHandler)(nil)
@@ -192,10 +282,11 @@ func newRoleBindingHandler(roleBinding *rbacv1.RoleBinding, object *Object) (*ro
 	}
 
 	rh := &roleBindingHandler{
-		roleBinding:  roleBinding,
-		configFunc:   defaultRoleBindingConfig,
-		subjectsFunc: defaultRoleBindingSubjects,
-		object:       object,
+		roleBinding:     roleBinding,
+		configFunc:      defaultRoleBindingConfig,
+		subjectsFunc:    defaultRoleBindingSubjects,
+		policyRulesFunc: defaultRoleBindingPolicyRules,
+		object:          object,
 	}
 
 	return rh, nil
@@ -231,3 +322,21 @@ func (r *roleBindingHandler) Subjects(ctx context.Context, options Options) erro
 func defaultRoleBindingSubjects(ctx context.Context, roleBinding *rbacv1.RoleBinding, options Options) (component.Component, error) {
 	return createRoleBindingSubjectsView(ctx, roleBinding, options)
 }
+
+func (r *roleBindingHandler) PolicyRules(ctx context.Context, options Options) error {
+	if r.roleBinding == nil {
+		return errors.New("can't display policy rules for nil rolebinding")
+	}
+
+	r.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return r.policyRulesFunc(ctx, r.roleBinding, options)
+		},
+	})
+	return nil
+}
+
+func defaultRoleBindingPolicyRules(ctx context.Context, roleBinding *rbacv1.RoleBinding, options Options) (component.Component, error) {
+	return createRoleRefPolicyRulesView(ctx, roleBinding.RoleRef, roleBinding.Namespace, options)
+}
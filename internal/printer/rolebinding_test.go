@@ -13,8 +13,10 @@ import (
 
 	"github.com/stretchr/testify/require"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/store"
 	"github.com/vmware/octant/pkg/view/component"
 )
 
@@ -115,6 +117,7 @@ func Test_createRoleBindingSubjectsView(t *testing.T) {
 	cases := []struct {
 		name     string
 		subject  *rbacv1.Subject
+		found    bool
 		expected component.TableRow
 	}{
 		{
@@ -124,15 +127,28 @@ func Test_createRoleBindingSubjectsView(t *testing.T) {
 				"Kind":      component.NewText("User"),
 				"Name":      component.NewText("test@test.com"),
 				"Namespace": component.NewText("namespace"),
+				"Status":    component.NewText(""),
 			},
 		},
 		{
 			name:    "Service Account",
 			subject: testutil.CreateRoleBindingSubject("ServiceAccount", "svc-auto", "namespace"),
+			found:   true,
 			expected: component.TableRow{
 				"Kind":      component.NewText("ServiceAccount"),
 				"Name":      component.NewLink("", "serviceAccount", "/service-account"),
 				"Namespace": component.NewText("namespace"),
+				"Status":    component.NewText(""),
+			},
+		},
+		{
+			name:    "missing Service Account",
+			subject: testutil.CreateRoleBindingSubject("ServiceAccount", "svc-missing", "namespace"),
+			expected: component.TableRow{
+				"Kind":      component.NewText("ServiceAccount"),
+				"Name":      component.NewText("svc-missing"),
+				"Namespace": component.NewText("namespace"),
+				"Status":    component.NewText("ServiceAccount not found"),
 			},
 		},
 	}
@@ -155,15 +171,94 @@ func Test_createRoleBindingSubjectsView(t *testing.T) {
 					AnyTimes()
 			}
 
+			if tc.subject.Kind == "ServiceAccount" {
+				key := store.Key{
+					Namespace:  tc.subject.Namespace,
+					APIVersion: "v1",
+					Kind:       "ServiceAccount",
+					Name:       tc.subject.Name,
+				}
+
+				var serviceAccount *unstructured.Unstructured
+				if tc.found {
+					serviceAccount = testutil.ToUnstructured(t, testutil.CreateServiceAccount(tc.subject.Name))
+				}
+
+				tpo.objectStore.EXPECT().
+					Get(gomock.Any(), gomock.Eq(key)).
+					Return(serviceAccount, tc.found, nil)
+			}
+
 			ctx := context.Background()
 			observed, err := createRoleBindingSubjectsView(ctx, roleBinding, printOptions)
 			require.NoError(t, err)
 
 			expected := component.NewTableWithRows("Subjects", "There are no subjects!",
-				component.NewTableCols("Kind", "Name", "Namespace"),
+				component.NewTableCols("Kind", "Name", "Namespace", "Status"),
 				[]component.TableRow{tc.expected})
 
 			component.AssertEqual(t, expected, observed)
 		})
 	}
 }
+
+func Test_createRoleRefPolicyRulesView(t *testing.T) {
+	role := testutil.CreateRole("pod-reader")
+
+	cols := component.NewTableCols("Resources", "Non-Resource URLs", "Resource Names", "Verbs")
+
+	rulesTable := component.NewTable("Policy Rules", "There are no policy rules!", cols)
+	rulesTable.Add(component.TableRow{
+		"Resources":         component.NewText("pods"),
+		"Non-Resource URLs": component.NewText(""),
+		"Resource Names":    component.NewText(""),
+		"Verbs":             component.NewText("['get', 'watch', 'list']"),
+	})
+
+	cases := []struct {
+		name     string
+		object   *unstructured.Unstructured
+		found    bool
+		expected *component.Table
+	}{
+		{
+			name:     "role exists",
+			object:   testutil.ToUnstructured(t, role),
+			found:    true,
+			expected: rulesTable,
+		},
+		{
+			name:     "role is missing",
+			expected: component.NewTable("Policy Rules", "Role pod-reader does not exist!", cols),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			tpo := newTestPrinterOptions(controller)
+			printOptions := tpo.ToOptions()
+
+			roleBinding := testutil.CreateRoleBinding("read-pods", role.Name, nil)
+
+			key := store.Key{
+				Namespace:  roleBinding.Namespace,
+				APIVersion: "rbac.authorization.k8s.io/v1",
+				Kind:       "Role",
+				Name:       role.Name,
+			}
+
+			tpo.objectStore.EXPECT().
+				Get(gomock.Any(), gomock.Eq(key)).
+				Return(tc.object, tc.found, nil)
+
+			ctx := context.Background()
+			got, err := createRoleRefPolicyRulesView(ctx, roleBinding.RoleRef, roleBinding.Namespace, printOptions)
+			require.NoError(t, err)
+
+			component.AssertEqual(t, tc.expected, got)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// scaleAction creates an action which sets the replicas of a scalable
+// object. Objects without replicas, and objects whose replicas are managed
+// by a controller, can't be scaled directly.
+func scaleAction(object runtime.Object, replicas *int32) ([]component.Action, error) {
+	if replicas == nil {
+		return []component.Action{}, nil
+	}
+
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return nil, err
+	}
+
+	if metav1.GetControllerOf(accessor) != nil {
+		return []component.Action{}, nil
+	}
+
+	replicasField := component.NewFormFieldNumber("Replicas", "replicas", fmt.Sprintf("%d", *replicas))
+	replicasField.SetValidation(octant.ReplicasValidation())
+
+	form, err := component.CreateFormForObject(octant.ActionScale, object, replicasField)
+	if err != nil {
+		return nil, err
+	}
+
+	action := component.Action{
+		Name:  "Scale",
+		Title: fmt.Sprintf("Scale %s", object.GetObjectKind().GroupVersionKind().Kind),
+		Form:  form,
+	}
+
+	return []component.Action{action}, nil
+}
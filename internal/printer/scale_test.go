@@ -0,0 +1,100 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func Test_scaleAction(t *testing.T) {
+	deployment := testutil.CreateDeployment("deployment")
+
+	replicaSet := testutil.CreateAppReplicaSet("replicaset")
+	replicaSet.Spec.Replicas = pointer.Int32Ptr(2)
+
+	controlledReplicaSet := replicaSet.DeepCopy()
+	controlledReplicaSet.OwnerReferences = []metav1.OwnerReference{
+		*metav1.NewControllerRef(deployment, deployment.GroupVersionKind()),
+	}
+
+	tests := []struct {
+		name     string
+		object   runtime.Object
+		replicas *int32
+		expected []component.Action
+	}{
+		{
+			name:     "deployment",
+			object:   deployment,
+			replicas: pointer.Int32Ptr(3),
+			expected: []component.Action{scaleActionFor(t, deployment, "Deployment", "3")},
+		},
+		{
+			name:     "replica set",
+			object:   replicaSet,
+			replicas: replicaSet.Spec.Replicas,
+			expected: []component.Action{scaleActionFor(t, replicaSet, "ReplicaSet", "2")},
+		},
+		{
+			name:     "controlled replica set",
+			object:   controlledReplicaSet,
+			replicas: controlledReplicaSet.Spec.Replicas,
+			expected: []component.Action{},
+		},
+		{
+			name:     "no replicas",
+			object:   deployment,
+			expected: []component.Action{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := scaleAction(test.object, test.replicas)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func scaleActionFor(t *testing.T, object runtime.Object, kind, replicas string) component.Action {
+	apiVersion, _ := object.GetObjectKind().GroupVersionKind().ToAPIVersionAndKind()
+
+	replicasField := component.NewFormFieldNumber("Replicas", "replicas", replicas)
+	replicasField.SetValidation(component.NewFormFieldValidation(
+		component.ValidateRequired(),
+		component.ValidateMin(0),
+	))
+
+	accessor, err := meta.Accessor(object)
+	require.NoError(t, err)
+
+	return component.Action{
+		Name:  "Scale",
+		Title: "Scale " + kind,
+		Form: component.Form{
+			Fields: []component.FormField{
+				replicasField,
+				component.NewFormFieldHidden("apiVersion", apiVersion),
+				component.NewFormFieldHidden("kind", kind),
+				component.NewFormFieldHidden("name", accessor.GetName()),
+				component.NewFormFieldHidden("namespace", accessor.GetNamespace()),
+				component.NewFormFieldHidden("action", "overview/scale"),
+			},
+		},
+	}
+}
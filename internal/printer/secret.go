@@ -12,6 +12,8 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 
+	"github.com/vmware/octant/internal/gvk"
+	"github.com/vmware/octant/internal/octant"
 	"github.com/vmware/octant/pkg/view/component"
 )
 
@@ -37,15 +39,59 @@ func SecretListHandler(ctx context.Context, list *corev1.SecretList, options Opt
 
 		row["Name"] = nameLink
 
-		row["Labels"] = component.NewLabels(secret.ObjectMeta.Labels)
+		row["Labels"] = options.LabelLinks.Labels(secret.ObjectMeta.Labels, secret.Namespace, gvk.Secret)
 		row["Type"] = component.NewText(string(secret.Type))
 		row["Data"] = component.NewText(fmt.Sprintf("%d", len(secret.Data)))
-		row["Age"] = component.NewTimestamp(secret.ObjectMeta.CreationTimestamp.Time)
+		row["Age"] = options.Timestamp(secret.ObjectMeta.CreationTimestamp.Time)
+
+		if err := options.TableActions.AddRowActions(row, &secret); err != nil {
+			return nil, err
+		}
 
 		table.Add(row)
 	}
 
-	return table, nil
+	options.TableActions.AddBulkActions(table)
+
+	return options.Profile.Apply(table), nil
+}
+
+// SecretCreateCard creates a card with actions for creating secrets in a namespace.
+func SecretCreateCard(namespace string) (component.Component, error) {
+	card := component.NewCard("Create Secret")
+	card.SetBody(component.NewText("Values are base64 encoded when the secret is created."))
+
+	card.AddAction(createSecretAction("Generic", "Create Generic Secret", namespace, corev1.SecretTypeOpaque,
+		component.NewFormFieldTextarea("Data (key=value per line)", "data", "")))
+	card.AddAction(createSecretAction("Docker Registry", "Create Docker Registry Secret", namespace, corev1.SecretTypeDockerConfigJson,
+		component.NewFormFieldText("Server", "server", ""),
+		component.NewFormFieldText("Username", "username", ""),
+		component.NewFormFieldPassword("Password", "password", ""),
+		component.NewFormFieldText("Email", "email", "")))
+	card.AddAction(createSecretAction("TLS", "Create TLS Secret", namespace, corev1.SecretTypeTLS,
+		component.NewFormFieldFile("Certificate", "certificate"),
+		component.NewFormFieldFile("Key", "key")))
+
+	return card, nil
+}
+
+func createSecretAction(name, title, namespace string, secretType corev1.SecretType, fields ...component.FormField) component.Action {
+	formFields := []component.FormField{
+		component.NewFormFieldText("Name", "name", ""),
+	}
+	formFields = append(formFields, fields...)
+	formFields = append(formFields,
+		component.NewFormFieldHidden("namespace", namespace),
+		component.NewFormFieldHidden("secretType", string(secretType)),
+		component.NewFormFieldHidden("action", octant.ActionCreateSecret),
+	)
+
+	return component.Action{
+		Name:           name,
+		Title:          title,
+		Form:           component.Form{Fields: formFields},
+		ConfirmChanges: true,
+	}
 }
 
 // SecretHandler is a printFunc for printing a secret summary.
@@ -95,6 +141,20 @@ func (s *SecretConfiguration) Create(options Options) (*component.Summary, error
 	})
 
 	summary := component.NewSummary("Configuration", sections...)
+
+	var keys []string
+	for k := range secret.Data {
+		keys = append(keys, k)
+	}
+
+	actions, err := dataKeyActions(secret, keys)
+	if err != nil {
+		return nil, errors.Wrap(err, "create secret data key actions")
+	}
+	for _, action := range actions {
+		summary.AddAction(action)
+	}
+
 	return summary, nil
 }
 
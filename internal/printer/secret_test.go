@@ -72,6 +72,34 @@ func Test_SecretListHandler(t *testing.T) {
 	component.AssertEqual(t, expected, got)
 }
 
+func Test_SecretCreateCard(t *testing.T) {
+	got, err := SecretCreateCard("default")
+	require.NoError(t, err)
+
+	card, ok := got.(*component.Card)
+	require.True(t, ok)
+
+	require.Len(t, card.Config.Actions, 3)
+
+	tls := card.Config.Actions[2]
+	expected := component.Action{
+		Name:  "TLS",
+		Title: "Create TLS Secret",
+		Form: component.Form{
+			Fields: []component.FormField{
+				component.NewFormFieldText("Name", "name", ""),
+				component.NewFormFieldFile("Certificate", "certificate"),
+				component.NewFormFieldFile("Key", "key"),
+				component.NewFormFieldHidden("namespace", "default"),
+				component.NewFormFieldHidden("secretType", "kubernetes.io/tls"),
+				component.NewFormFieldHidden("action", "overview/createSecret"),
+			},
+		},
+		ConfirmChanges: true,
+	}
+	require.Equal(t, expected, tls)
+}
+
 func Test_SecretConfig(t *testing.T) {
 	secret := testutil.CreateSecret("secret")
 	secret.Type = corev1.SecretTypeOpaque
@@ -85,12 +113,17 @@ func Test_SecretConfig(t *testing.T) {
 		{
 			name:   "general",
 			secret: secret,
-			expected: component.NewSummary("Configuration", []component.SummarySection{
-				{
-					Header:  "Type",
-					Content: component.NewText("Opaque"),
-				},
-			}...)},
+			expected: func() *component.Summary {
+				summary := component.NewSummary("Configuration", []component.SummarySection{
+					{
+						Header:  "Type",
+						Content: component.NewText("Opaque"),
+					},
+				}...)
+				addDataKeyActions(t, summary, secret)
+				return summary
+			}(),
+		},
 		{
 			name:   "secret is nil",
 			secret: nil,
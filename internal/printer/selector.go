@@ -6,8 +6,14 @@ SPDX-License-Identifier: Apache-2.0
 package printer
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
+	"github.com/vmware/octant/pkg/store"
 	"github.com/vmware/octant/pkg/view/component"
 )
 
@@ -41,3 +47,25 @@ func printSelectorMap(selector map[string]string) *component.Selectors {
 
 	return s
 }
+
+// printMatchedPodCount prints the number of pods in a namespace which are
+// matched by a selector. The count comes from the object store's selector
+// index, so printing it for each row of a list does not list pods per row.
+func printMatchedPodCount(ctx context.Context, namespace string, selector labels.Selector, options Options) (component.Component, error) {
+	if options.DashConfig == nil {
+		return nil, errors.New("dash config is nil")
+	}
+
+	key := store.Key{
+		Namespace:  namespace,
+		APIVersion: "v1",
+		Kind:       "Pod",
+	}
+
+	count, err := store.CountMatching(ctx, options.DashConfig.ObjectStore(), key, selector)
+	if err != nil {
+		return nil, errors.Wrap(err, "count matched pods")
+	}
+
+	return component.NewText(fmt.Sprintf("%d", count)), nil
+}
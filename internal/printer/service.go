@@ -12,21 +12,41 @@ import (
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
 
+	"github.com/vmware/octant/internal/gvk"
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/internal/portforward"
 	"github.com/vmware/octant/pkg/store"
 	"github.com/vmware/octant/pkg/view/component"
 )
 
+// serviceTypes are the types a service can have.
+var serviceTypes = []string{
+	string(corev1.ServiceTypeClusterIP),
+	string(corev1.ServiceTypeNodePort),
+	string(corev1.ServiceTypeLoadBalancer),
+	string(corev1.ServiceTypeExternalName),
+}
+
+func addServiceTableFilters(table *component.Table) {
+	table.AddFilter("Type", component.TableFilter{
+		Values:   serviceTypes,
+		Selected: serviceTypes,
+	})
+}
+
 // ServiceListHandler is a printFunc that lists services
-func ServiceListHandler(_ context.Context, list *corev1.ServiceList, options Options) (component.Component, error) {
+func ServiceListHandler(ctx context.Context, list *corev1.ServiceList, options Options) (component.Component, error) {
 	if list == nil {
 		return nil, errors.New("nil list")
 	}
 
-	cols := component.NewTableCols("Name", "Labels", "Type", "Cluster IP", "External IP", "Target Ports", "Age", "Selector")
+	cols := component.NewTableCols("Name", "Labels", "Type", "Cluster IP", "External IP", "Target Ports", "Age", "Selector", "Pods")
 	tbl := component.NewTable("Services", "We couldn't find any services!", cols)
+	addServiceTableFilters(tbl)
 
 	for _, s := range list.Items {
 		row := component.TableRow{}
@@ -36,20 +56,39 @@ func ServiceListHandler(_ context.Context, list *corev1.ServiceList, options Opt
 		}
 
 		row["Name"] = nameLink
-		row["Labels"] = component.NewLabels(s.Labels)
+		row["Labels"] = options.LabelLinks.Labels(s.Labels, s.Namespace, gvk.Service)
 		row["Type"] = component.NewText(string(s.Spec.Type))
 		row["Cluster IP"] = component.NewText(s.Spec.ClusterIP)
 		row["External IP"] = component.NewText(describeExternalIPs(s))
 		row["Target Ports"] = printServicePorts(s.Spec.Ports)
 
 		ts := s.CreationTimestamp.Time
-		row["Age"] = component.NewTimestamp(ts)
+		row["Age"] = options.Timestamp(ts)
 
 		row["Selector"] = printSelectorMap(s.Spec.Selector)
 
+		// services without a selector have their endpoints managed
+		// elsewhere, so they don't match pods.
+		if len(s.Spec.Selector) == 0 {
+			row["Pods"] = component.NewText("<none>")
+		} else {
+			pods, err := printMatchedPodCount(ctx, s.Namespace, labels.SelectorFromSet(s.Spec.Selector), options)
+			if err != nil {
+				return nil, err
+			}
+			row["Pods"] = pods
+		}
+
+		if err := options.TableActions.AddRowActions(row, &s); err != nil {
+			return nil, err
+		}
+
 		tbl.Add(row)
 	}
-	return tbl, nil
+
+	options.TableActions.AddBulkActions(tbl)
+
+	return options.Profile.Apply(tbl), nil
 }
 
 // ServiceHandler is a printFunc that prints a Services.
@@ -74,6 +113,12 @@ func ServiceHandler(ctx context.Context, service *corev1.Service, options Option
 		return nil, errors.Wrap(err, "print service endpoints")
 	}
 
+	if service.Spec.Type == corev1.ServiceTypeLoadBalancer {
+		if err := addReachabilityButton(o, service); err != nil {
+			return nil, errors.Wrap(err, "add reachability check")
+		}
+	}
+
 	return o.ToComponent(ctx, options)
 }
 
@@ -132,6 +177,20 @@ func (sc *ServiceConfiguration) Create(ctx context.Context, options Options) (*c
 		Content: component.NewText(strings.Join(ports, ", ")),
 	})
 
+	if len(service.Spec.Selector) > 0 && options.DashConfig != nil {
+		forwardPorts, err := describeServicePorts(service, options.DashConfig.PortForwarder())
+		if err != nil {
+			return nil, errors.Wrap(err, "describe service ports")
+		}
+
+		if len(forwardPorts) > 0 {
+			sections = append(sections, component.SummarySection{
+				Header:  "Port Forward",
+				Content: component.NewPorts(forwardPorts),
+			})
+		}
+	}
+
 	sections = append(sections, component.SummarySection{
 		Header:  "Session Affinity",
 		Content: component.NewText(string(service.Spec.SessionAffinity)),
@@ -237,7 +296,7 @@ func editServiceAction(ctx context.Context, service *corev1.Service, options Opt
 		}
 	}
 
-	form, err := component.CreateFormForObject("overview/serviceEditor", service,
+	form, err := component.CreateFormForObject(octant.ActionEditService, service,
 		component.NewFormFieldSelect("Selectors", "selectors", choices, true))
 	if err != nil {
 		return component.Action{}, err
@@ -354,6 +413,46 @@ func createServiceEndpointsView(ctx context.Context, service *corev1.Service, op
 	return table, nil
 }
 
+// describeServicePorts describes a service's TCP ports so they can be
+// forwarded to the pods the service selects.
+func describeServicePorts(service *corev1.Service, portForwarder portforward.PortForwarder) ([]component.Port, error) {
+	apiVersion, kind := gvk.Service.ToAPIVersionAndKind()
+
+	state, err := portForwarder.Find(service.Namespace, gvk.Service, service.Name)
+	if err != nil {
+		if _, ok := err.(notFound); !ok {
+			return nil, errors.Wrap(err, "query port forward service for service")
+		}
+	}
+
+	var list []component.Port
+	for _, servicePort := range service.Spec.Ports {
+		if servicePort.Protocol != "" && servicePort.Protocol != corev1.ProtocolTCP {
+			continue
+		}
+
+		pfs := component.PortForwardState{IsForwardable: true}
+		for _, forwarded := range state.Ports {
+			if int32(forwarded.Remote) == servicePort.Port {
+				pfs.ID = state.ID
+				pfs.Port = int(forwarded.Local)
+				pfs.IsForwarded = true
+			}
+		}
+
+		port := component.NewPort(
+			service.Namespace,
+			apiVersion,
+			kind,
+			service.Name,
+			int(servicePort.Port),
+			string(corev1.ProtocolTCP), pfs)
+		list = append(list, *port)
+	}
+
+	return list, nil
+}
+
 func describeTargetPort(port corev1.ServicePort) string {
 	if targetPort := port.TargetPort.String(); targetPort != "0" {
 		return fmt.Sprintf("%s/%s", targetPort, port.Protocol)
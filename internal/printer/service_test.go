@@ -18,6 +18,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
+	"github.com/vmware/octant/internal/gvk"
+	"github.com/vmware/octant/internal/portforward"
 	"github.com/vmware/octant/internal/testutil"
 	"github.com/vmware/octant/pkg/store"
 	"github.com/vmware/octant/pkg/view/component"
@@ -79,12 +81,21 @@ func Test_ServiceListHandler(t *testing.T) {
 
 	tpo.PathForObject(&object.Items[0], object.Items[0].Name, "/service")
 
+	matchedPod := testutil.CreatePod("matched")
+	matchedPod.Labels = map[string]string{"app": "myapp"}
+	otherPod := testutil.CreatePod("other")
+	podKey := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), podKey).
+		Return(testutil.ToUnstructuredList(t, matchedPod, otherPod), false, nil)
+
 	ctx := context.Background()
 	got, err := ServiceListHandler(ctx, object, printOptions)
 	require.NoError(t, err)
 
-	cols := component.NewTableCols("Name", "Labels", "Type", "Cluster IP", "External IP", "Target Ports", "Age", "Selector")
+	cols := component.NewTableCols("Name", "Labels", "Type", "Cluster IP", "External IP", "Target Ports", "Age", "Selector", "Pods")
 	expected := component.NewTable("Services", "We couldn't find any services!", cols)
+	addServiceTableFilters(expected)
 	expected.Add(component.TableRow{
 		"Name":         component.NewLink("", "service", "/service"),
 		"Labels":       component.NewLabels(labels),
@@ -94,6 +105,7 @@ func Test_ServiceListHandler(t *testing.T) {
 		"Target Ports": component.NewText("8181/TCP, 8888/UDP"),
 		"Age":          component.NewTimestamp(now),
 		"Selector":     component.NewSelectors([]component.Selector{component.NewLabelSelector("app", "myapp")}),
+		"Pods":         component.NewText("1"),
 	})
 
 	component.AssertEqual(t, expected, got)
@@ -106,7 +118,8 @@ func Test_ServiceConfiguration(t *testing.T) {
 			HealthCheckNodePort:      31311,
 			LoadBalancerSourceRanges: []string{"range1", "range2"},
 			Ports: []corev1.ServicePort{
-				{Name: "http", Port: 8080},
+				{Name: "http", Port: 8080, Protocol: corev1.ProtocolTCP},
+				{Name: "dns", Port: 53, Protocol: corev1.ProtocolUDP},
 			},
 			Selector:        map[string]string{"app": "app1"},
 			SessionAffinity: corev1.ServiceAffinityNone,
@@ -114,6 +127,7 @@ func Test_ServiceConfiguration(t *testing.T) {
 		},
 	}
 	validService.Namespace = "default"
+	validService.Name = "service"
 
 	cases := []struct {
 		name     string
@@ -135,7 +149,14 @@ func Test_ServiceConfiguration(t *testing.T) {
 				},
 				{
 					Header:  "Ports",
-					Content: component.NewText("http 8080/TCP"),
+					Content: component.NewText("http 8080/TCP, dns 53/UDP"),
+				},
+				{
+					Header: "Port Forward",
+					Content: component.NewPorts([]component.Port{
+						*component.NewPort("default", "v1", "Service", "service", 8080, "TCP",
+							component.PortForwardState{IsForwardable: true, IsForwarded: true, ID: "id", Port: 45000}),
+					}),
 				},
 				{
 					Header:  "Session Affinity",
@@ -182,6 +203,14 @@ func Test_ServiceConfiguration(t *testing.T) {
 			List(gomock.Any(), podKey).
 			Return(pods, false, nil).AnyTimes()
 
+		state := portforward.State{
+			ID:    "id",
+			Ports: []portforward.ForwardedPort{{Local: 45000, Remote: 8080}},
+		}
+		tpo.portForwarder.EXPECT().
+			Find("default", gvk.Service, "service").
+			Return(state, nil).AnyTimes()
+
 		sc := NewServiceConfiguration(tc.service)
 
 		summary, err := sc.Create(ctx, printOptions)
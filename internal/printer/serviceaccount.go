@@ -8,13 +8,15 @@ package printer
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/client-go/kubernetes/scheme"
 
+	"github.com/vmware/octant/internal/gvk"
+	"github.com/vmware/octant/internal/rbac"
 	"github.com/vmware/octant/pkg/store"
 	"github.com/vmware/octant/pkg/view/component"
 )
@@ -37,25 +39,33 @@ func ServiceAccountListHandler(_ context.Context, list *corev1.ServiceAccountLis
 		}
 
 		row["Name"] = nameLink
-		row["Labels"] = component.NewLabels(serviceAccount.Labels)
+		row["Labels"] = options.LabelLinks.Labels(serviceAccount.Labels, serviceAccount.Namespace, gvk.ServiceAccount)
 		row["Secrets"] = component.NewText(fmt.Sprint(len(serviceAccount.Secrets)))
-		row["Age"] = component.NewTimestamp(serviceAccount.CreationTimestamp.Time)
+		row["Age"] = options.Timestamp(serviceAccount.CreationTimestamp.Time)
+
+		if err := options.TableActions.AddRowActions(row, &serviceAccount); err != nil {
+			return nil, err
+		}
 
 		table.Add(row)
 	}
 
-	return table, nil
+	options.TableActions.AddBulkActions(table)
+
+	return options.Profile.Apply(table), nil
 }
 
 type serviceAccountObject interface {
 	Config(ctx context.Context, options Options) error
 	PolicyRules(ctx context.Context, serviceAccount *corev1.ServiceAccount, options Options) error
+	Pods(ctx context.Context, options Options) error
 }
 
 type serviceAccountHandler struct {
 	serviceAccount  *corev1.ServiceAccount
 	configFunc      func(context.Context, *corev1.ServiceAccount, Options) (*component.Summary, error)
 	policyRulesFunc func(context.Context, *corev1.ServiceAccount, Options) (*component.Table, error)
+	podsFunc        func(context.Context, *corev1.ServiceAccount, Options) (*component.Table, error)
 	object          *Object
 }
 
@@ -74,6 +84,7 @@ func newServiceAccountHandler(serviceAccount *corev1.ServiceAccount, object *Obj
 		serviceAccount:  serviceAccount,
 		configFunc:      defaultServiceAccountConfig,
 		policyRulesFunc: defaultServiceAccountPolicyRules,
+		podsFunc:        defaultServiceAccountPods,
 		object:          object,
 	}
 	return s, nil
@@ -102,6 +113,10 @@ func ServiceAccountHandler(ctx context.Context, serviceAccount *corev1.ServiceAc
 		return nil, errors.Wrap(err, "print service account configuration")
 	}
 
+	if err := s.Pods(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print service account pods")
+	}
+
 	return o.ToComponent(ctx, options)
 }
 
@@ -131,6 +146,8 @@ func (s *ServiceAccountConfiguration) Create(options Options) (*component.Summar
 
 	sections := component.SummarySections{}
 
+	sections.AddText("Automount Service Account Token", strconv.FormatBool(serviceAccountAutomountsToken(serviceAccount)))
+
 	var pullSecrets []string
 
 	for _, s := range serviceAccount.ImagePullSecrets {
@@ -189,6 +206,16 @@ func generateServiceAccountSecretsList(namespace string, secretNames []string, o
 	return component.NewList("", items), nil
 }
 
+// serviceAccountAutomountsToken returns whether the service account allows
+// its token to be automounted. Kubernetes treats an unset value as true.
+func serviceAccountAutomountsToken(serviceAccount *corev1.ServiceAccount) bool {
+	if serviceAccount.AutomountServiceAccountToken == nil {
+		return true
+	}
+
+	return *serviceAccount.AutomountServiceAccountToken
+}
+
 func serviceAccountTokens(ctx context.Context, serviceAccount corev1.ServiceAccount, o store.Store) ([]string, error) {
 	key := store.Key{
 		Namespace:  serviceAccount.Namespace,
@@ -254,173 +281,144 @@ func (s *ServiceAccountPolicyRules) Create() (*component.Table, error) {
 		return nil, errors.New("serviceaccount is nil")
 	}
 
-	var roleRefs []rbacv1.RoleRef
+	subject := rbac.Subject{
+		Kind:      rbacv1.ServiceAccountKind,
+		Name:      s.name,
+		Namespace: s.namespace,
+	}
+
+	resolver := rbac.NewResolver(s.objectStore)
 
-	roleBindingRoleRefs, err := s.listRoleBindings()
+	bindings, err := resolver.Bindings(s.context, subject, s.namespace)
 	if err != nil {
 		return nil, err
 	}
 
-	roleRefs = append(roleRefs, roleBindingRoleRefs...)
-
-	clusterRoleBindingRefs, err := s.listClusterRoleBindings()
+	policyRules, err := resolver.PolicyRules(s.context, bindings)
 	if err != nil {
 		return nil, err
 	}
 
-	roleRefs = append(roleRefs, clusterRoleBindingRefs...)
-
-	var policyRules []rbacv1.PolicyRule
-
-	for _, roleRef := range roleRefs {
-		key := store.Key{
-			APIVersion: "rbac.authorization.k8s.io/v1",
-			Kind:       roleRef.Kind,
-			Name:       roleRef.Name,
-		}
-		switch kind := roleRef.Kind; kind {
-		case "ClusterRole":
-			object, found, err := s.objectStore.Get(s.context, key)
-			if err != nil {
-				return nil, err
-			}
+	return printPolicyRules(policyRules)
+}
 
-			if !found {
-				return nil, errors.Errorf("unable to find %s", key)
-			}
+func defaultServiceAccountConfig(ctx context.Context, serviceAccount *corev1.ServiceAccount, options Options) (*component.Summary, error) {
+	return NewServiceAccountConfiguration(ctx, serviceAccount, options).Create(options)
+}
 
-			clusterRole := &rbacv1.ClusterRole{}
-			if err := scheme.Scheme.Convert(object, clusterRole, nil); err != nil {
-				return nil, err
-			}
+func (s *serviceAccountHandler) PolicyRules(ctx context.Context, serviceAccount *corev1.ServiceAccount, options Options) error {
+	s.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return s.policyRulesFunc(ctx, serviceAccount, options)
+		},
+	})
 
-			policyRules = append(policyRules, clusterRole.Rules...)
+	return nil
+}
 
-		case "Role":
-			key.Namespace = s.namespace
+func defaultServiceAccountPolicyRules(ctx context.Context, serviceAccount *corev1.ServiceAccount, options Options) (*component.Table, error) {
+	return NewServiceAccountPolicyRules(ctx, serviceAccount, options).Create()
+}
 
-			object, found, err := s.objectStore.Get(s.context, key)
-			if err != nil {
-				return nil, err
-			}
+func (s *serviceAccountHandler) Pods(ctx context.Context, options Options) error {
+	if s.serviceAccount == nil {
+		return errors.New("can't display pods for nil service account")
+	}
 
-			if !found {
-				return nil, errors.Errorf("unable to find %s", key)
-			}
+	s.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return s.podsFunc(ctx, s.serviceAccount, options)
+		},
+	})
 
-			role := &rbacv1.Role{}
-			if err := scheme.Scheme.Convert(object, role, nil); err != nil {
-				return nil, err
-			}
+	return nil
+}
 
-			policyRules = append(policyRules, role.Rules...)
+func defaultServiceAccountPods(ctx context.Context, serviceAccount *corev1.ServiceAccount, options Options) (*component.Table, error) {
+	return createServiceAccountPodsView(ctx, serviceAccount, options)
+}
 
-		default:
-			return nil, errors.Errorf("unable to handle role ref kind %q", kind)
-		}
+// createServiceAccountPodsView creates a table of pods in the service account's
+// namespace which run as the service account, and how they consume its token.
+func createServiceAccountPodsView(ctx context.Context, serviceAccount *corev1.ServiceAccount, options Options) (*component.Table, error) {
+	if serviceAccount == nil {
+		return nil, errors.New("service account is nil")
 	}
 
-	return printPolicyRules(policyRules)
-}
-
-func (s *ServiceAccountPolicyRules) listRoleBindings() ([]rbacv1.RoleRef, error) {
-	roleBindingKey := store.Key{
-		Namespace:  s.namespace,
-		APIVersion: "rbac.authorization.k8s.io/v1",
-		Kind:       "RoleBinding",
+	key := store.Key{
+		Namespace:  serviceAccount.Namespace,
+		APIVersion: "v1",
+		Kind:       "Pod",
 	}
 
-	objects, _, err := s.objectStore.List(s.context, roleBindingKey)
+	pods, err := loadPods(ctx, key, options.DashConfig.ObjectStore(), nil)
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrap(err, "list pods for service account")
 	}
 
-	var list []rbacv1.RoleRef
+	cols := component.NewTableCols("Name", "Automount Token", "Projected Token", "Age")
+	table := component.NewTable("Pods", "There are no pods using this service account!", cols)
 
-	for i := range objects.Items {
-		roleBinding := &rbacv1.RoleBinding{}
-		if err := scheme.Scheme.Convert(&objects.Items[i], roleBinding, nil); err != nil {
-			return nil, err
+	for _, pod := range pods {
+		if podServiceAccountName(pod) != serviceAccount.Name {
+			continue
 		}
 
-		if s.isMatchSubjects(roleBinding.Subjects) {
-			list = append(list, roleBinding.RoleRef)
+		nameLink, err := options.Link.ForObject(pod, pod.Name)
+		if err != nil {
+			return nil, err
 		}
+
+		table.Add(component.TableRow{
+			"Name":            nameLink,
+			"Automount Token": component.NewText(strconv.FormatBool(podAutomountsToken(pod, serviceAccount))),
+			"Projected Token": component.NewText(strconv.FormatBool(podProjectsToken(pod))),
+			"Age":             options.Timestamp(pod.CreationTimestamp.Time),
+		})
 	}
 
-	return list, nil
+	return table, nil
 }
 
-func (s *ServiceAccountPolicyRules) listClusterRoleBindings() ([]rbacv1.RoleRef, error) {
-	roleBindingKey := store.Key{
-		APIVersion: "rbac.authorization.k8s.io/v1",
-		Kind:       "ClusterRoleBinding",
+// podServiceAccountName returns the service account a pod runs as. Pods without
+// an explicit service account run as the namespace's default service account.
+func podServiceAccountName(pod *corev1.Pod) string {
+	if pod.Spec.ServiceAccountName != "" {
+		return pod.Spec.ServiceAccountName
 	}
 
-	objects, _, err := s.objectStore.List(s.context, roleBindingKey)
-	if err != nil {
-		return nil, err
+	if pod.Spec.DeprecatedServiceAccount != "" {
+		return pod.Spec.DeprecatedServiceAccount
 	}
 
-	var list []rbacv1.RoleRef
-
-	for _, object := range objects.Items {
-		roleBinding := &rbacv1.RoleBinding{}
-		if err := scheme.Scheme.Convert(&object, roleBinding, nil); err != nil {
-			return nil, err
-		}
-
-		if s.isMatchSubjects(roleBinding.Subjects) {
-			list = append(list, roleBinding.RoleRef)
-		}
-	}
-
-	return list, nil
+	return "default"
 }
 
-func (s *ServiceAccountPolicyRules) isMatchSubjects(subjects []rbacv1.Subject) bool {
-	subjectMatched := false
-	for _, subject := range subjects {
-		if s.isSubject(subject) {
-			subjectMatched = true
-			break
-		}
+// podAutomountsToken returns whether a pod has the service account token
+// automounted. A pod level setting overrides the service account setting.
+func podAutomountsToken(pod *corev1.Pod, serviceAccount *corev1.ServiceAccount) bool {
+	if pod.Spec.AutomountServiceAccountToken != nil {
+		return *pod.Spec.AutomountServiceAccountToken
 	}
 
-	return subjectMatched
+	return serviceAccountAutomountsToken(serviceAccount)
 }
 
-func (s *ServiceAccountPolicyRules) isSubject(subject rbacv1.Subject) bool {
-	inNamespace := fmt.Sprintf("system:serviceaccounts:%s", s.namespace)
-
-	apiGroup := "rbac.authorization.k8s.io"
+// podProjectsToken returns whether a pod mounts a projected service account token.
+func podProjectsToken(pod *corev1.Pod) bool {
+	for _, volume := range pod.Spec.Volumes {
+		if volume.Projected == nil {
+			continue
+		}
 
-	if subject.Kind == "ServiceAccount" && subject.Name == s.name {
-		return true
-	} else if subject.Kind == "Group" && subject.Name == inNamespace && subject.APIGroup == apiGroup {
-		return true
-	} else if subject.Kind == "Group" && subject.Name == "system:serviceaccounts" && subject.APIGroup == apiGroup {
-		return true
+		for _, source := range volume.Projected.Sources {
+			if source.ServiceAccountToken != nil {
+				return true
+			}
+		}
 	}
 
 	return false
 }
-
-func defaultServiceAccountConfig(ctx context.Context, serviceAccount *corev1.ServiceAccount, options Options) (*component.Summary, error) {
-	return NewServiceAccountConfiguration(ctx, serviceAccount, options).Create(options)
-}
-
-func (s *serviceAccountHandler) PolicyRules(ctx context.Context, serviceAccount *corev1.ServiceAccount, options Options) error {
-	s.object.RegisterItems(ItemDescriptor{
-		Width: component.WidthFull,
-		Func: func() (component.Component, error) {
-			return s.policyRulesFunc(ctx, serviceAccount, options)
-		},
-	})
-
-	return nil
-}
-
-func defaultServiceAccountPolicyRules(ctx context.Context, serviceAccount *corev1.ServiceAccount, options Options) (*component.Table, error) {
-	return NewServiceAccountPolicyRules(ctx, serviceAccount, options).Create()
-}
@@ -92,6 +92,10 @@ func Test_ServiceAccountConfiguration(t *testing.T) {
 			namespace:      serviceAccount.Namespace,
 			serviceaccount: serviceAccount,
 			expected: component.NewSummary("Configuration", []component.SummarySection{
+				{
+					Header:  "Automount Service Account Token",
+					Content: component.NewText("true"),
+				},
 				{
 					Header: "Image Pull Secrets",
 					Content: component.NewList("", []component.Component{
@@ -149,6 +153,79 @@ func Test_ServiceAccountConfiguration(t *testing.T) {
 	}
 }
 
+func Test_createServiceAccountPodsView(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	now := testutil.Time()
+
+	automount := false
+	serviceAccount := testutil.CreateServiceAccount("sa")
+	serviceAccount.AutomountServiceAccountToken = &automount
+
+	podWithProjectedToken := testutil.CreatePod("pod1")
+	podWithProjectedToken.CreationTimestamp = metav1.Time{Time: now}
+	podWithProjectedToken.Spec.ServiceAccountName = serviceAccount.Name
+	podWithProjectedToken.Spec.Volumes = []corev1.Volume{
+		{
+			Name: "token",
+			VolumeSource: corev1.VolumeSource{
+				Projected: &corev1.ProjectedVolumeSource{
+					Sources: []corev1.VolumeProjection{
+						{ServiceAccountToken: &corev1.ServiceAccountTokenProjection{Path: "token"}},
+					},
+				},
+			},
+		},
+	}
+
+	podAutomount := testutil.CreatePod("pod2")
+	podAutomount.CreationTimestamp = metav1.Time{Time: now}
+	podAutomount.Spec.ServiceAccountName = serviceAccount.Name
+	automountOverride := true
+	podAutomount.Spec.AutomountServiceAccountToken = &automountOverride
+
+	podDefault := testutil.CreatePod("pod3")
+
+	key := store.Key{
+		Namespace:  serviceAccount.Namespace,
+		APIVersion: "v1",
+		Kind:       "Pod",
+	}
+
+	tpo.objectStore.EXPECT().List(gomock.Any(), gomock.Eq(key)).
+		Return(testutil.ToUnstructuredList(t, podWithProjectedToken, podAutomount, podDefault), false, nil)
+
+	tpo.PathForObject(podWithProjectedToken, podWithProjectedToken.Name, "/pod1")
+	tpo.PathForObject(podAutomount, podAutomount.Name, "/pod2")
+
+	ctx := context.Background()
+	got, err := createServiceAccountPodsView(ctx, serviceAccount, printOptions)
+	require.NoError(t, err)
+
+	cols := component.NewTableCols("Name", "Automount Token", "Projected Token", "Age")
+	expected := component.NewTable("Pods", "There are no pods using this service account!", cols)
+	expected.Add([]component.TableRow{
+		{
+			"Name":            component.NewLink("", "pod1", "/pod1"),
+			"Automount Token": component.NewText("false"),
+			"Projected Token": component.NewText("true"),
+			"Age":             component.NewTimestamp(now),
+		},
+		{
+			"Name":            component.NewLink("", "pod2", "/pod2"),
+			"Automount Token": component.NewText("true"),
+			"Projected Token": component.NewText("false"),
+			"Age":             component.NewTimestamp(now),
+		},
+	}...)
+
+	component.AssertEqual(t, expected, got)
+}
+
 func Test_ServiceAccountPolicyRules(t *testing.T) {
 	serviceAccount := testutil.CreateServiceAccount("sa")
 
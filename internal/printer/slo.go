@@ -0,0 +1,236 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware/octant/internal/config"
+	"github.com/vmware/octant/internal/objectstatus"
+	"github.com/vmware/octant/internal/slo"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// sloWorkloadKeys are the kinds of workloads which can configure an
+// availability target.
+var sloWorkloadKeys = []store.Key{
+	{APIVersion: "apps/v1", Kind: "Deployment"},
+	{APIVersion: "apps/v1", Kind: "StatefulSet"},
+	{APIVersion: "apps/v1", Kind: "DaemonSet"},
+}
+
+// ObserveWorkloadSLOs creates a function which observes the workloads in all
+// namespaces that configure an availability target. A workload is available
+// when its object status is not in error.
+func ObserveWorkloadSLOs(dashConfig config.Dash) slo.ObserveFunc {
+	return func(ctx context.Context) ([]slo.Observation, error) {
+		objectStore := dashConfig.ObjectStore()
+
+		var observations []slo.Observation
+		var observeErr error
+
+		for _, key := range sloWorkloadKeys {
+			list, _, err := objectStore.List(ctx, key)
+			if err != nil {
+				observeErr = errors.Wrapf(err, "list %s objects", key.Kind)
+				continue
+			}
+
+			for i := range list.Items {
+				observation, ok, err := observeWorkloadSLO(ctx, &list.Items[i], objectStore)
+				if err != nil {
+					observeErr = err
+					continue
+				}
+
+				if ok {
+					observations = append(observations, observation)
+				}
+			}
+		}
+
+		return observations, observeErr
+	}
+}
+
+// observeWorkloadSLO observes a workload's availability and restarts. It
+// returns false if the workload does not configure a valid availability target.
+func observeWorkloadSLO(ctx context.Context, object *unstructured.Unstructured, objectStore store.Store) (slo.Observation, bool, error) {
+	if _, ok, err := slo.TargetFromAnnotations(object.GetAnnotations()); err != nil || !ok {
+		return slo.Observation{}, false, nil
+	}
+
+	workload, selector, err := sloWorkload(object)
+	if err != nil {
+		return slo.Observation{}, false, err
+	}
+
+	status, err := objectstatus.Status(ctx, workload, objectStore)
+	if err != nil {
+		return slo.Observation{}, false, errors.Wrapf(err, "get status for %s %s", object.GetKind(), object.GetName())
+	}
+
+	restarts, err := workloadRestarts(ctx, object.GetNamespace(), selector, objectStore)
+	if err != nil {
+		return slo.Observation{}, false, err
+	}
+
+	return slo.Observation{
+		UID:       object.GetUID(),
+		Available: status.Status() != component.NodeStatusError,
+		Restarts:  restarts,
+	}, true, nil
+}
+
+// sloWorkload converts a workload to its typed object and returns the
+// selector for its pods.
+func sloWorkload(object *unstructured.Unstructured) (runtime.Object, *metav1.LabelSelector, error) {
+	var workload runtime.Object
+	var selector func() *metav1.LabelSelector
+
+	switch object.GetKind() {
+	case "Deployment":
+		deployment := &appsv1.Deployment{}
+		workload, selector = deployment, func() *metav1.LabelSelector { return deployment.Spec.Selector }
+	case "StatefulSet":
+		statefulSet := &appsv1.StatefulSet{}
+		workload, selector = statefulSet, func() *metav1.LabelSelector { return statefulSet.Spec.Selector }
+	case "DaemonSet":
+		daemonSet := &appsv1.DaemonSet{}
+		workload, selector = daemonSet, func() *metav1.LabelSelector { return daemonSet.Spec.Selector }
+	default:
+		return nil, nil, errors.Errorf("%s does not support service level objectives", object.GetKind())
+	}
+
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, workload); err != nil {
+		return nil, nil, errors.Wrapf(err, "convert %s %s", object.GetKind(), object.GetName())
+	}
+
+	return workload, selector(), nil
+}
+
+// sloHistory returns the SLO history for options. It returns nil if there
+// is none.
+func sloHistory(options Options) *slo.History {
+	if options.DashConfig == nil {
+		return nil
+	}
+
+	return options.DashConfig.SLOHistory()
+}
+
+// registerWorkloadSLO registers an SLO summary for workloads which configure
+// an availability target. Workloads without a target are skipped.
+func registerWorkloadSLO(o *Object, object runtime.Object, options Options) error {
+	history := sloHistory(options)
+	if history == nil {
+		return nil
+	}
+
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return errors.Wrap(err, "get accessor for workload")
+	}
+
+	if _, ok := accessor.GetAnnotations()[slo.AvailabilityAnnotation]; !ok {
+		return nil
+	}
+
+	o.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createWorkloadSLOView(object, history)
+		},
+	})
+
+	return nil
+}
+
+// createWorkloadSLOView summarizes a workload's performance against its
+// target from the samples in the SLO history.
+func createWorkloadSLOView(object runtime.Object, history *slo.History) (*component.Summary, error) {
+	if history == nil {
+		return nil, errors.New("slo history is nil")
+	}
+
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return nil, errors.Wrap(err, "get accessor for workload")
+	}
+
+	var sections component.SummarySections
+
+	target, _, err := slo.TargetFromAnnotations(accessor.GetAnnotations())
+	if err != nil {
+		sections.AddText("Error", err.Error())
+		return component.NewSummary("Service Level Objective", sections...), nil
+	}
+
+	report := history.Report(accessor.GetUID(), target)
+
+	sections.AddText("Target", fmt.Sprintf("%.2f%% over %s", target.Availability, target.Window))
+
+	if !report.HasData() {
+		sections.AddText("Status", "Collecting data")
+		return component.NewSummary("Service Level Objective", sections...), nil
+	}
+
+	sections.AddText("Availability", fmt.Sprintf("%.2f%%", report.Availability))
+	sections.AddText("Restarts", fmt.Sprintf("%d", report.Restarts))
+	sections.AddText("Observed", report.Observed.String())
+
+	if report.IsMet() {
+		sections.AddText("Status", "Meeting target")
+	} else {
+		sections.AddText("Status", "Breaching target")
+	}
+
+	return component.NewSummary("Service Level Objective", sections...), nil
+}
+
+// workloadRestarts returns the total container restarts of the pods matching a workload's selector.
+func workloadRestarts(ctx context.Context, namespace string, selector *metav1.LabelSelector, objectStore store.Store) (int32, error) {
+	if selector == nil {
+		return 0, nil
+	}
+
+	key := store.Key{
+		Namespace:  namespace,
+		APIVersion: "v1",
+		Kind:       "Pod",
+	}
+
+	pods, err := loadPods(ctx, key, objectStore, selector)
+	if err != nil {
+		return 0, errors.Wrap(err, "load pods for workload")
+	}
+
+	var restarts int32
+	for _, pod := range pods {
+		restarts += podRestarts(pod.Status.ContainerStatuses)
+	}
+
+	return restarts, nil
+}
+
+func podRestarts(statuses []corev1.ContainerStatus) int32 {
+	var restarts int32
+	for _, status := range statuses {
+		restarts += status.RestartCount
+	}
+
+	return restarts
+}
@@ -0,0 +1,130 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware/octant/internal/slo"
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func TestObserveWorkloadSLOs(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.UID = "deployment-uid"
+	deployment.Annotations = map[string]string{
+		slo.AvailabilityAnnotation: "99.5",
+	}
+	deployment.Spec.Selector = &metav1.LabelSelector{
+		MatchLabels: map[string]string{"app": "app"},
+	}
+	deployment.Status.Replicas = 1
+	deployment.Status.AvailableReplicas = 1
+
+	untracked := testutil.CreateDeployment("untracked")
+
+	pod := testutil.CreatePod("pod")
+	pod.Labels = map[string]string{"app": "app"}
+	pod.Status.ContainerStatuses = []corev1.ContainerStatus{
+		{Name: "a", RestartCount: 1},
+		{Name: "b", RestartCount: 2},
+	}
+
+	deploymentKey := store.Key{APIVersion: "apps/v1", Kind: "Deployment"}
+	tpo.objectStore.EXPECT().List(gomock.Any(), deploymentKey).
+		Return(testutil.ToUnstructuredList(t, deployment, untracked), false, nil)
+	for _, kind := range []string{"StatefulSet", "DaemonSet"} {
+		key := store.Key{APIVersion: "apps/v1", Kind: kind}
+		tpo.objectStore.EXPECT().List(gomock.Any(), key).
+			Return(testutil.ToUnstructuredList(t), false, nil)
+	}
+
+	podKey := store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod"}
+	tpo.objectStore.EXPECT().List(gomock.Any(), podKey).
+		Return(testutil.ToUnstructuredList(t, pod), false, nil)
+
+	observe := ObserveWorkloadSLOs(printOptions.DashConfig)
+
+	got, err := observe(context.Background())
+	require.NoError(t, err)
+
+	expected := []slo.Observation{
+		{UID: "deployment-uid", Available: true, Restarts: 3},
+	}
+	assert.Equal(t, expected, got)
+}
+
+func Test_createWorkloadSLOView(t *testing.T) {
+	now := testutil.Time()
+	history := slo.NewHistory(slo.WithClock(func() time.Time { return now }))
+
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.UID = "deployment-uid"
+	deployment.Annotations = map[string]string{
+		slo.AvailabilityAnnotation: "99.5",
+		slo.WindowAnnotation:       "30m",
+	}
+
+	history.Record(deployment.UID, true, 3)
+
+	got, err := createWorkloadSLOView(deployment, history)
+	require.NoError(t, err)
+
+	expected := component.NewSummary("Service Level Objective", []component.SummarySection{
+		{Header: "Target", Content: component.NewText("99.50% over 30m0s")},
+		{Header: "Status", Content: component.NewText("Collecting data")},
+	}...)
+	component.AssertEqual(t, expected, got)
+
+	now = now.Add(10 * time.Minute)
+	history.Record(deployment.UID, true, 3)
+
+	got, err = createWorkloadSLOView(deployment, history)
+	require.NoError(t, err)
+
+	expected = component.NewSummary("Service Level Objective", []component.SummarySection{
+		{Header: "Target", Content: component.NewText("99.50% over 30m0s")},
+		{Header: "Availability", Content: component.NewText("100.00%")},
+		{Header: "Restarts", Content: component.NewText("0")},
+		{Header: "Observed", Content: component.NewText("10m0s")},
+		{Header: "Status", Content: component.NewText("Meeting target")},
+	}...)
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_createWorkloadSLOView_invalid_target(t *testing.T) {
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.Annotations = map[string]string{
+		slo.AvailabilityAnnotation: "high",
+	}
+
+	got, err := createWorkloadSLOView(deployment, slo.NewHistory())
+	require.NoError(t, err)
+
+	_, _, targetErr := slo.TargetFromAnnotations(deployment.Annotations)
+	require.Error(t, targetErr)
+
+	expected := component.NewSummary("Service Level Objective", []component.SummarySection{
+		{Header: "Error", Content: component.NewText(targetErr.Error())},
+	}...)
+	component.AssertEqual(t, expected, got)
+}
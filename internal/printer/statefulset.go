@@ -15,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/vmware/octant/internal/gvk"
 	"github.com/vmware/octant/pkg/store"
 	"github.com/vmware/octant/pkg/view/component"
 )
@@ -25,7 +26,7 @@ func StatefulSetListHandler(_ context.Context, list *appsv1.StatefulSetList, opt
 		return nil, errors.New("nil list")
 	}
 
-	cols := component.NewTableCols("Name", "Labels", "Desired", "Current", "Age", "Selector")
+	cols := component.NewTableCols("Name", "Labels", "Desired", "Current", "Age", "Containers", "Selector")
 	tbl := component.NewTable("StatefulSets", "We couldn't find any stateful sets!", cols)
 
 	for _, statefulSet := range list.Items {
@@ -36,23 +37,30 @@ func StatefulSetListHandler(_ context.Context, list *appsv1.StatefulSetList, opt
 		}
 
 		row["Name"] = nameLink
-		row["Labels"] = component.NewLabels(statefulSet.Labels)
+		row["Labels"] = options.LabelLinks.Labels(statefulSet.Labels, statefulSet.Namespace, gvk.StatefulSet)
 
 		desired := fmt.Sprintf("%d", *statefulSet.Spec.Replicas)
 		row["Desired"] = component.NewText(desired)
 
-		current := fmt.Sprintf("%d", statefulSet.Status.Replicas)
-		row["Current"] = component.NewText(current)
+		row["Current"] = printReplicaCount(statefulSet.Status.Replicas,
+			statefulSet.Status.ReadyReplicas, *statefulSet.Spec.Replicas)
 
 		ts := statefulSet.CreationTimestamp.Time
-		row["Age"] = component.NewTimestamp(ts)
+		row["Age"] = options.Timestamp(ts)
 
+		row["Containers"] = printContainers(statefulSet.Spec.Template.Spec.Containers)
 		row["Selector"] = printSelector(statefulSet.Spec.Selector)
 
+		if err := options.TableActions.AddRowActions(row, &statefulSet); err != nil {
+			return nil, err
+		}
+
 		tbl.Add(row)
 	}
 
-	return tbl, nil
+	options.TableActions.AddBulkActions(tbl)
+
+	return options.Profile.Apply(tbl), nil
 }
 
 // StatefulSetHandler is a printFunc that prints a StatefulSet
@@ -73,6 +81,12 @@ func StatefulSetHandler(ctx context.Context, statefulSet *appsv1.StatefulSet, op
 		return nil, errors.Wrap(err, "print statefulset status")
 	}
 
+	if err := registerWorkloadSLO(o, statefulSet, options); err != nil {
+		return nil, errors.Wrap(err, "print statefulset service level objective")
+	}
+
+	registerWorkloadLogs(ctx, o, statefulSet.Namespace, statefulSet.Spec.Selector, options)
+
 	if err := sh.Pods(ctx, statefulSet, options); err != nil {
 		return nil, errors.Wrap(err, "print statefulset pods")
 	}
@@ -139,6 +153,15 @@ func (sc *StatefulSetConfiguration) Create(options Options) (*component.Summary,
 	sections.AddText("Pod Management Policy", string(statefulSet.Spec.PodManagementPolicy))
 
 	summary := component.NewSummary("Configuration", sections...)
+
+	actions, err := scaleAction(statefulSet, statefulSet.Spec.Replicas)
+	if err != nil {
+		return nil, errors.Wrap(err, "generate scale action")
+	}
+	for _, action := range actions {
+		summary.AddAction(action)
+	}
+
 	return summary, nil
 }
 
@@ -81,15 +81,26 @@ func Test_StatefulSetListHandler(t *testing.T) {
 	got, err := StatefulSetListHandler(ctx, object, printOptions)
 	require.NoError(t, err)
 
-	cols := component.NewTableCols("Name", "Labels", "Desired", "Current", "Age", "Selector")
+	cols := component.NewTableCols("Name", "Labels", "Desired", "Current", "Age", "Containers", "Selector")
 	expected := component.NewTable("StatefulSets", "We couldn't find any stateful sets!", cols)
+	containers := component.NewContainers()
+	containers.AddContainerDef(component.ContainerDef{
+		Name:       "nginx",
+		Image:      "k8s.gcr.io/nginx-slim:0.8",
+		Registry:   "k8s.gcr.io",
+		Repository: "nginx-slim",
+		Tag:        "0.8",
+		Warnings:   []string{"Image is not pinned to a digest"},
+	})
+
 	expected.Add(component.TableRow{
-		"Name":     component.NewLink("", "web", "/path"),
-		"Labels":   component.NewLabels(labels),
-		"Desired":  component.NewText("3"),
-		"Current":  component.NewText("1"),
-		"Age":      component.NewTimestamp(now),
-		"Selector": component.NewSelectors([]component.Selector{component.NewLabelSelector("app", "myapp")}),
+		"Name":       component.NewLink("", "web", "/path"),
+		"Labels":     component.NewLabels(labels),
+		"Desired":    component.NewText("3"),
+		"Current":    component.NewStatusText("1", component.NodeStatusError),
+		"Age":        component.NewTimestamp(now),
+		"Containers": containers,
+		"Selector":   component.NewSelectors([]component.Selector{component.NewLabelSelector("app", "myapp")}),
 	})
 
 	component.AssertEqual(t, expected, got)
@@ -139,9 +150,10 @@ func Test_StatefulSetStatus(t *testing.T) {
 	}
 
 	key := store.Key{
-		Namespace:  "testing",
-		APIVersion: "v1",
-		Kind:       "Pod",
+		Namespace:     "testing",
+		APIVersion:    "v1",
+		Kind:          "Pod",
+		LabelSelector: sts.Spec.Selector,
 	}
 
 	tpo.objectStore.EXPECT().List(gomock.Any(), gomock.Eq(key)).Return(podList, false, nil)
@@ -204,6 +216,26 @@ func Test_StatefulSetConfiguration(t *testing.T) {
 		},
 	}
 
+	expected := component.NewSummary("Configuration", []component.SummarySection{
+		{
+			Header:  "Update Strategy",
+			Content: component.NewText("RollingUpdate"),
+		},
+		{
+			Header:  "Selectors",
+			Content: component.NewSelectors([]component.Selector{component.NewLabelSelector("app", "myapp")}),
+		},
+		{
+			Header:  "Replicas",
+			Content: component.NewText("3 Desired / 1 Total"),
+		},
+		{
+			Header:  "Pod Management Policy",
+			Content: component.NewText("OrderedReady"),
+		},
+	}...)
+	expected.AddAction(scaleActionFor(t, validStatefulSet, "StatefulSet", "3"))
+
 	cases := []struct {
 		name        string
 		statefulSet *appsv1.StatefulSet
@@ -213,24 +245,7 @@ func Test_StatefulSetConfiguration(t *testing.T) {
 		{
 			name:        "default",
 			statefulSet: validStatefulSet,
-			expected: component.NewSummary("Configuration", []component.SummarySection{
-				{
-					Header:  "Update Strategy",
-					Content: component.NewText("RollingUpdate"),
-				},
-				{
-					Header:  "Selectors",
-					Content: component.NewSelectors([]component.Selector{component.NewLabelSelector("app", "myapp")}),
-				},
-				{
-					Header:  "Replicas",
-					Content: component.NewText("3 Desired / 1 Total"),
-				},
-				{
-					Header:  "Pod Management Policy",
-					Content: component.NewText("OrderedReady"),
-				},
-			}...),
+			expected:    expected,
 		},
 		{
 			name:        "statefulset is nil",
@@ -327,22 +342,21 @@ func Test_StatefulSetPods(t *testing.T) {
 	tpo.objectStore.EXPECT().List(gomock.Any(), gomock.Eq(key)).Return(podList, false, nil)
 
 	printOptions := tpo.ToOptions()
-	printOptions.DisableLabels = false
+	printOptions.Profile = ProfileFull
 
 	got, err := createPodListView(ctx, statefulSet, printOptions)
 	require.NoError(t, err)
 
 	cols := component.NewTableCols("Name", "Ready", "Phase", "Restarts", "Node", "Age")
 	expected := component.NewTable("Pods", "We couldn't find any pods!", cols)
-	expected.Add(component.TableRow{
+	expected.Add(podRowWithActions(t, pod, component.TableRow{
 		"Name":     component.NewLink("", "web-0", "/pod"),
 		"Ready":    component.NewText("1/1"),
 		"Phase":    component.NewText("Pending"),
-		"Restarts": component.NewText("0"),
+		"Restarts": component.NewNumberText("0", 0),
 		"Node":     nodeLink,
 		"Age":      component.NewTimestamp(now),
-	})
-	addPodTableFilters(expected)
+	}))
 
 	component.AssertEqual(t, expected, got)
 }
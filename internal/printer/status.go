@@ -0,0 +1,35 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"fmt"
+
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// printReplicaStatus prints a ready/desired replica count. The status is
+// an error when no replicas are ready, and a warning when some are not.
+func printReplicaStatus(ready, desired int32) *component.StatusText {
+	return component.NewStatusText(fmt.Sprintf("%d/%d", ready, desired), replicaStatus(ready, desired))
+}
+
+// printReplicaCount prints a replica count with the status of the
+// ready/desired replica count.
+func printReplicaCount(count, ready, desired int32) *component.StatusText {
+	return component.NewStatusText(fmt.Sprintf("%d", count), replicaStatus(ready, desired))
+}
+
+func replicaStatus(ready, desired int32) component.NodeStatus {
+	switch {
+	case ready >= desired:
+		return component.NodeStatusOK
+	case ready == 0:
+		return component.NodeStatusError
+	default:
+		return component.NodeStatusWarning
+	}
+}
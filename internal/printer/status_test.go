@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func Test_printReplicaStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		ready    int32
+		desired  int32
+		expected *component.StatusText
+	}{
+		{
+			name:     "all ready",
+			ready:    3,
+			desired:  3,
+			expected: component.NewStatusText("3/3", component.NodeStatusOK),
+		},
+		{
+			name:     "scaled to zero",
+			ready:    0,
+			desired:  0,
+			expected: component.NewStatusText("0/0", component.NodeStatusOK),
+		},
+		{
+			name:     "some ready",
+			ready:    1,
+			desired:  3,
+			expected: component.NewStatusText("1/3", component.NodeStatusWarning),
+		},
+		{
+			name:     "none ready",
+			ready:    0,
+			desired:  3,
+			expected: component.NewStatusText("0/3", component.NodeStatusError),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := printReplicaStatus(test.ready, test.desired)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
@@ -0,0 +1,168 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	storagev1 "k8s.io/api/storage/v1"
+
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+const (
+	// defaultStorageClassAnnotation marks a storage class as the cluster default.
+	defaultStorageClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+)
+
+// StorageClassListHandler is a printFunc that prints storage classes
+func StorageClassListHandler(_ context.Context, list *storagev1.StorageClassList, options Options) (component.Component, error) {
+	if list == nil {
+		return nil, errors.New("storage class list is nil")
+	}
+
+	cols := component.NewTableCols("Name", "Provisioner", "Reclaim Policy", "Volume Binding Mode", "Default", "Age")
+	tbl := component.NewTable("Storage Classes", "We couldn't find any storage classes!", cols)
+
+	for _, storageClass := range list.Items {
+		row := component.TableRow{}
+		nameLink, err := options.Link.ForObject(&storageClass, storageClass.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		row["Name"] = nameLink
+		row["Provisioner"] = component.NewText(storageClass.Provisioner)
+		row["Reclaim Policy"] = component.NewText(storageClassReclaimPolicy(&storageClass))
+		row["Volume Binding Mode"] = component.NewText(storageClassVolumeBindingMode(&storageClass))
+		row["Default"] = component.NewText(fmt.Sprintf("%t", isDefaultStorageClass(&storageClass)))
+		ts := storageClass.CreationTimestamp.Time
+		row["Age"] = options.Timestamp(ts)
+
+		if err := options.TableActions.AddRowActions(row, &storageClass); err != nil {
+			return nil, err
+		}
+
+		tbl.Add(row)
+	}
+
+	options.TableActions.AddBulkActions(tbl)
+
+	return options.Profile.Apply(tbl), nil
+}
+
+// StorageClassHandler is a printFunc that prints a storage class
+func StorageClassHandler(ctx context.Context, storageClass *storagev1.StorageClass, options Options) (component.Component, error) {
+	o := NewObject(storageClass)
+
+	config, err := NewStorageClassConfiguration(storageClass).Create(options)
+	if err != nil {
+		return nil, errors.Wrap(err, "print storage class configuration")
+	}
+	o.RegisterConfig(config)
+
+	o.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createStorageClassParametersView(storageClass)
+		},
+	})
+
+	return o.ToComponent(ctx, options)
+}
+
+// StorageClassConfiguration generates a storage class configuration
+type StorageClassConfiguration struct {
+	storageClass *storagev1.StorageClass
+}
+
+// NewStorageClassConfiguration creates an instance of StorageClassConfiguration
+func NewStorageClassConfiguration(storageClass *storagev1.StorageClass) *StorageClassConfiguration {
+	return &StorageClassConfiguration{
+		storageClass: storageClass,
+	}
+}
+
+// Create creates a storage class configuration summary
+func (s *StorageClassConfiguration) Create(options Options) (*component.Summary, error) {
+	if s == nil || s.storageClass == nil {
+		return nil, errors.New("storage class is nil")
+	}
+
+	storageClass := s.storageClass
+
+	var sections component.SummarySections
+
+	sections.AddText("Provisioner", storageClass.Provisioner)
+	sections.AddText("Reclaim Policy", storageClassReclaimPolicy(storageClass))
+	sections.AddText("Volume Binding Mode", storageClassVolumeBindingMode(storageClass))
+
+	if allow := storageClass.AllowVolumeExpansion; allow != nil {
+		sections.AddText("Allow Volume Expansion", fmt.Sprintf("%t", *allow))
+	}
+
+	if mountOptions := storageClass.MountOptions; len(mountOptions) > 0 {
+		sections.AddText("Mount Options", strings.Join(mountOptions, ", "))
+	}
+
+	sections.AddText("Default", fmt.Sprintf("%t", isDefaultStorageClass(storageClass)))
+
+	summary := component.NewSummary("Configuration", sections...)
+
+	return summary, nil
+}
+
+func createStorageClassParametersView(storageClass *storagev1.StorageClass) (*component.Table, error) {
+	if storageClass == nil {
+		return nil, errors.New("storage class is nil")
+	}
+
+	cols := component.NewTableCols("Key", "Value")
+	tbl := component.NewTable("Parameters", "There are no parameters!", cols)
+
+	var keys []string
+	for key := range storageClass.Parameters {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		tbl.Add(component.TableRow{
+			"Key":   component.NewText(key),
+			"Value": component.NewText(storageClass.Parameters[key]),
+		})
+	}
+
+	return tbl, nil
+}
+
+// storageClassReclaimPolicy returns the reclaim policy, which is Delete
+// when it isn't set.
+func storageClassReclaimPolicy(storageClass *storagev1.StorageClass) string {
+	if storageClass.ReclaimPolicy == nil {
+		return "Delete"
+	}
+
+	return string(*storageClass.ReclaimPolicy)
+}
+
+// storageClassVolumeBindingMode returns the volume binding mode, which is
+// Immediate when it isn't set.
+func storageClassVolumeBindingMode(storageClass *storagev1.StorageClass) string {
+	if storageClass.VolumeBindingMode == nil {
+		return string(storagev1.VolumeBindingImmediate)
+	}
+
+	return string(*storageClass.VolumeBindingMode)
+}
+
+func isDefaultStorageClass(storageClass *storagev1.StorageClass) bool {
+	return storageClass.Annotations[defaultStorageClassAnnotation] == "true"
+}
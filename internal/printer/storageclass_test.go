@@ -0,0 +1,142 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func Test_StorageClassListHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	now := testutil.Time()
+	object := testutil.CreateStorageClass("standard")
+	object.CreationTimestamp = metav1.Time{Time: now}
+	object.Annotations = map[string]string{defaultStorageClassAnnotation: "true"}
+
+	tpo.PathForObject(object, object.Name, "/storage-class")
+
+	list := &storagev1.StorageClassList{
+		Items: []storagev1.StorageClass{*object},
+	}
+
+	ctx := context.Background()
+	got, err := StorageClassListHandler(ctx, list, printOptions)
+	require.NoError(t, err)
+
+	cols := component.NewTableCols("Name", "Provisioner", "Reclaim Policy", "Volume Binding Mode", "Default", "Age")
+	expected := component.NewTable("Storage Classes", "We couldn't find any storage classes!", cols)
+	expected.Add(component.TableRow{
+		"Name":                component.NewLink("", object.Name, "/storage-class"),
+		"Provisioner":         component.NewText("kubernetes.io/no-provisioner"),
+		"Reclaim Policy":      component.NewText("Delete"),
+		"Volume Binding Mode": component.NewText("Immediate"),
+		"Default":             component.NewText("true"),
+		"Age":                 component.NewTimestamp(now),
+	})
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_StorageClassConfiguration(t *testing.T) {
+	allowExpansion := true
+
+	storageClass := testutil.CreateStorageClass("standard")
+	storageClass.AllowVolumeExpansion = &allowExpansion
+	storageClass.ReclaimPolicy = nil
+
+	cases := []struct {
+		name         string
+		storageClass *storagev1.StorageClass
+		isErr        bool
+		expected     *component.Summary
+	}{
+		{
+			name:         "general",
+			storageClass: storageClass,
+			expected: component.NewSummary("Configuration", []component.SummarySection{
+				{
+					Header:  "Provisioner",
+					Content: component.NewText("kubernetes.io/no-provisioner"),
+				},
+				{
+					Header:  "Reclaim Policy",
+					Content: component.NewText("Delete"),
+				},
+				{
+					Header:  "Volume Binding Mode",
+					Content: component.NewText("Immediate"),
+				},
+				{
+					Header:  "Allow Volume Expansion",
+					Content: component.NewText("true"),
+				},
+				{
+					Header:  "Default",
+					Content: component.NewText("false"),
+				},
+			}...),
+		},
+		{
+			name:         "storage class is nil",
+			storageClass: nil,
+			isErr:        true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			tpo := newTestPrinterOptions(controller)
+			printOptions := tpo.ToOptions()
+
+			sc := NewStorageClassConfiguration(tc.storageClass)
+
+			summary, err := sc.Create(printOptions)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			component.AssertEqual(t, tc.expected, summary)
+		})
+	}
+}
+
+func Test_createStorageClassParametersView(t *testing.T) {
+	storageClass := testutil.CreateStorageClass("standard")
+	storageClass.Parameters = map[string]string{
+		"type":   "pd-ssd",
+		"fstype": "ext4",
+	}
+
+	got, err := createStorageClassParametersView(storageClass)
+	require.NoError(t, err)
+
+	cols := component.NewTableCols("Key", "Value")
+	expected := component.NewTableWithRows("Parameters", "There are no parameters!", cols, []component.TableRow{
+		{"Key": component.NewText("fstype"), "Value": component.NewText("ext4")},
+		{"Key": component.NewText("type"), "Value": component.NewText("pd-ssd")},
+	})
+
+	component.AssertEqual(t, expected, got)
+}
@@ -0,0 +1,180 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware/octant/internal/octant"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// RowActionFunc creates actions for the row which shows object in a list
+// table.
+type RowActionFunc func(object runtime.Object) ([]component.Button, error)
+
+// TableActions are actions list handlers add to the tables they print. Row
+// actions operate on a single object, while bulk actions run the matching
+// row action of every selected row.
+type TableActions struct {
+	rowActions  []RowActionFunc
+	bulkActions []component.TableBulkAction
+
+	// ctx and access check if the actions can be performed. They are set
+	// for a single print by withAccess.
+	ctx    context.Context
+	access store.AccessChecker
+}
+
+// NewTableActions creates an instance of TableActions with no actions.
+func NewTableActions() *TableActions {
+	return &TableActions{}
+}
+
+// DefaultTableActions creates an instance of TableActions which can delete
+// objects one at a time or in bulk.
+func DefaultTableActions() *TableActions {
+	ta := NewTableActions()
+	ta.RegisterRowAction(DeleteRowAction)
+	ta.RegisterBulkAction(component.NewTableBulkAction("Delete", octant.ActionDeleteObject,
+		component.WithBulkActionConfirmation("Delete objects",
+			"Are you sure you want to delete the selected objects? This action is permanent and cannot be recovered.")))
+	return ta
+}
+
+// RegisterRowAction registers a function which creates row actions.
+func (ta *TableActions) RegisterRowAction(fn RowActionFunc) {
+	ta.rowActions = append(ta.rowActions, fn)
+}
+
+// RegisterBulkAction registers a bulk action.
+func (ta *TableActions) RegisterBulkAction(bulkAction component.TableBulkAction) {
+	ta.bulkActions = append(ta.bulkActions, bulkAction)
+}
+
+// withAccess returns a copy of ta which disables the actions the current
+// credentials can't perform.
+func (ta *TableActions) withAccess(ctx context.Context, checker store.AccessChecker) *TableActions {
+	if ta == nil || checker == nil {
+		return ta
+	}
+
+	bound := *ta
+	bound.ctx = ctx
+	bound.access = checker
+	return &bound
+}
+
+// AddRowActions adds the registered row actions for object to row. It does
+// nothing if ta is nil.
+func (ta *TableActions) AddRowActions(row component.TableRow, object runtime.Object) error {
+	if ta == nil {
+		return nil
+	}
+
+	for _, fn := range ta.rowActions {
+		buttons, err := fn(object)
+		if err != nil {
+			return errors.Wrap(err, "create row actions")
+		}
+
+		for _, button := range buttons {
+			if ta.access != nil {
+				button.DisabledReason = actionDeniedReason(ta.ctx, ta.access, button.Payload)
+			}
+			row.AddAction(button)
+		}
+	}
+
+	return nil
+}
+
+// AddBulkActions adds the registered bulk actions to table. It does nothing
+// if ta is nil. Bulk actions are disabled if the matching row action is
+// disabled in every row which has it.
+func (ta *TableActions) AddBulkActions(table *component.Table) {
+	if ta == nil {
+		return
+	}
+
+	for _, bulkAction := range ta.bulkActions {
+		bulkAction.DisabledReason = bulkActionDeniedReason(table, bulkAction.Action)
+		table.AddBulkAction(bulkAction)
+	}
+}
+
+// bulkActionDeniedReason returns why the rows' actions named actionName
+// can't be performed, or a blank string if at least one can.
+func bulkActionDeniedReason(table *component.Table, actionName string) string {
+	var reason string
+	for _, row := range table.Rows() {
+		gridActions, ok := row[component.GridActionKey].(*component.GridActions)
+		if !ok {
+			continue
+		}
+
+		for _, button := range gridActions.Config.Actions {
+			if name, _ := button.Payload.String("action"); name != actionName {
+				continue
+			}
+			if button.DisabledReason == "" {
+				return ""
+			}
+			reason = button.DisabledReason
+		}
+	}
+
+	return reason
+}
+
+// DeleteRowAction creates a row action which deletes object. Objects which
+// are being deleted have no action.
+func DeleteRowAction(object runtime.Object) ([]component.Button, error) {
+	button, err := deleteObjectButton(object)
+	if err != nil {
+		return nil, err
+	}
+
+	if button == nil {
+		return nil, nil
+	}
+
+	return []component.Button{*button}, nil
+}
+
+// deleteObjectButton creates a button which deletes object. It returns nil
+// if the object is being deleted.
+func deleteObjectButton(object runtime.Object) (*component.Button, error) {
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return nil, err
+	}
+
+	if accessor.GetDeletionTimestamp() != nil {
+		return nil, nil
+	}
+
+	key, err := store.KeyFromObject(object)
+	if err != nil {
+		return nil, err
+	}
+
+	confirmation, err := deleteObjectConfirmation(object)
+	if err != nil {
+		return nil, err
+	}
+
+	button := component.NewButton("Delete",
+		action.CreatePayload(octant.ActionDeleteObject, key.ToActionPayload()),
+		confirmation)
+	return &button, nil
+}
@@ -0,0 +1,110 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func TestTableActions(t *testing.T) {
+	deployment := testutil.CreateDeployment("deployment")
+
+	ta := DefaultTableActions()
+	ta.RegisterRowAction(func(object runtime.Object) ([]component.Button, error) {
+		return []component.Button{
+			component.NewButton("Restart", action.CreatePayload("overview/restart", action.Payload{})),
+		}, nil
+	})
+
+	row := component.TableRow{}
+	require.NoError(t, ta.AddRowActions(row, deployment))
+
+	expected := component.NewGridActions()
+	expected.AddAction(component.NewButton("Delete",
+		action.Payload{
+			"action":     "octant/deleteObject",
+			"namespace":  "namespace",
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"name":       "deployment",
+		},
+		component.WithButtonConfirmation("Delete Deployment",
+			"Are you sure you want to delete *Deployment* **deployment**? This action is permanent and cannot be recovered.")))
+	expected.AddAction(component.NewButton("Restart", action.Payload{"action": "overview/restart"}))
+	assert.Equal(t, expected, row[component.GridActionKey])
+
+	table := component.NewTable("table", "placeholder", component.NewTableCols("Name"))
+	ta.AddBulkActions(table)
+
+	require.Len(t, table.Config.BulkActions, 1)
+	assert.Equal(t, "octant/deleteObject", table.Config.BulkActions[0].Action)
+}
+
+func TestTableActions_withAccess(t *testing.T) {
+	checker := &fakeAccessChecker{denied: map[string]bool{"delete": true}}
+	ta := DefaultTableActions().withAccess(context.Background(), checker)
+
+	table := component.NewTable("table", "placeholder", component.NewTableCols("Name"))
+	row := component.TableRow{"Name": component.NewText("deployment")}
+	require.NoError(t, ta.AddRowActions(row, testutil.CreateDeployment("deployment")))
+	table.Add(row)
+	ta.AddBulkActions(table)
+
+	gridActions := row[component.GridActionKey].(*component.GridActions)
+	require.Len(t, gridActions.Config.Actions, 1)
+	assert.Equal(t, `Your credentials can't delete Deployment "deployment"`, gridActions.Config.Actions[0].DisabledReason)
+
+	require.Len(t, table.Config.BulkActions, 1)
+	assert.Equal(t, `Your credentials can't delete Deployment "deployment"`, table.Config.BulkActions[0].DisabledReason)
+
+	// the shared actions are not bound to the checker.
+	unbound := component.TableRow{}
+	require.NoError(t, DefaultTableActions().AddRowActions(unbound, testutil.CreateDeployment("deployment")))
+	assert.Empty(t, unbound[component.GridActionKey].(*component.GridActions).Config.Actions[0].DisabledReason)
+}
+
+func TestTableActions_row_action_error(t *testing.T) {
+	ta := NewTableActions()
+	ta.RegisterRowAction(func(object runtime.Object) ([]component.Button, error) {
+		return nil, errors.New("failed")
+	})
+
+	row := component.TableRow{}
+	require.Error(t, ta.AddRowActions(row, testutil.CreateDeployment("deployment")))
+}
+
+func TestTableActions_nil(t *testing.T) {
+	var ta *TableActions
+
+	row := component.TableRow{}
+	require.NoError(t, ta.AddRowActions(row, testutil.CreateDeployment("deployment")))
+	assert.Empty(t, row)
+
+	table := component.NewTable("table", "placeholder", component.NewTableCols("Name"))
+	ta.AddBulkActions(table)
+	assert.Empty(t, table.Config.BulkActions)
+}
+
+func TestDeleteRowAction_deleting(t *testing.T) {
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+
+	got, err := DeleteRowAction(deployment)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
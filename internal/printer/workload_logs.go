@@ -0,0 +1,58 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// registerWorkloadLogs registers a tab which merges the logs of the pods
+// selected by a workload.
+func registerWorkloadLogs(ctx context.Context, o *Object, namespace string, selector *metav1.LabelSelector, options Options) {
+	if selector == nil {
+		return
+	}
+
+	o.RegisterTab(TabDescriptor{
+		Name: "Logs",
+		Func: func() (component.Component, error) {
+			return createWorkloadLogsView(ctx, namespace, selector, options)
+		},
+	})
+}
+
+func createWorkloadLogsView(ctx context.Context, namespace string, selector *metav1.LabelSelector, options Options) (*component.Logs, error) {
+	labelSelector, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return nil, errors.Wrap(err, "convert label selector")
+	}
+
+	key := store.Key{
+		Namespace:  namespace,
+		APIVersion: "v1",
+		Kind:       "Pod",
+	}
+
+	pods, err := loadPods(ctx, key, options.DashConfig.ObjectStore(), selector)
+	if err != nil {
+		return nil, errors.Wrap(err, "load pods")
+	}
+
+	var names []string
+	for _, pod := range pods {
+		names = append(names, pod.Name)
+	}
+	sort.Strings(names)
+
+	return component.NewAggregatedLogs(namespace, labelSelector.String(), names, component.AggregatedLogOptions{}), nil
+}
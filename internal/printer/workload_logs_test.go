@@ -0,0 +1,46 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func Test_createWorkloadLogsView(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	podB := testutil.CreatePod("b")
+	podB.Labels = map[string]string{"app": "app"}
+	podA := testutil.CreatePod("a")
+	podA.Labels = map[string]string{"app": "app"}
+	other := testutil.CreatePod("other")
+	other.Labels = map[string]string{"app": "other"}
+
+	list := testutil.ToUnstructuredList(t, podB, podA, other)
+
+	key := store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod"}
+	tpo.objectStore.EXPECT().List(gomock.Any(), key).Return(list, false, nil)
+
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "app"}}
+
+	got, err := createWorkloadLogsView(context.Background(), "namespace", selector, tpo.ToOptions())
+	require.NoError(t, err)
+
+	expected := component.NewAggregatedLogs("namespace", "app=app", []string{"a", "b"}, component.AggregatedLogOptions{})
+	component.AssertEqual(t, expected, got)
+}
@@ -7,6 +7,7 @@ package queryer
 
 import (
 	"context"
+	"sort"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -33,6 +34,7 @@ import (
 	"k8s.io/kubernetes/pkg/apis/core"
 
 	"github.com/vmware/octant/internal/gvk"
+	"github.com/vmware/octant/internal/util/kubernetes"
 	dashstrings "github.com/vmware/octant/internal/util/strings"
 	"github.com/vmware/octant/pkg/navigation"
 	"github.com/vmware/octant/pkg/store"
@@ -50,6 +52,8 @@ type Queryer interface {
 	ServicesForIngress(ctx context.Context, ingress *extv1beta1.Ingress) (*unstructured.UnstructuredList, error)
 	ServicesForPod(ctx context.Context, pod *corev1.Pod) ([]*corev1.Service, error)
 	ServiceAccountForPod(ctx context.Context, pod *corev1.Pod) (*corev1.ServiceAccount, error)
+	ConfigMapsForPod(ctx context.Context, pod *corev1.Pod) ([]*corev1.ConfigMap, error)
+	SecretsForPod(ctx context.Context, pod *corev1.Pod) ([]*corev1.Secret, error)
 }
 
 type childrenCache struct {
@@ -321,31 +325,19 @@ func (osq *ObjectStoreQueryer) Events(ctx context.Context, object metav1.Object)
 
 	u := &unstructured.Unstructured{Object: m}
 
-	key := store.Key{
-		Namespace:  u.GetNamespace(),
-		APIVersion: "v1",
-		Kind:       "Event",
-	}
-
-	allEvents, _, err := osq.objectStore.List(ctx, key)
+	allEvents, err := kubernetes.ListEvents(ctx, osq.objectStore, u.GetNamespace())
 	if err != nil {
 		return nil, err
 	}
 
 	var events []*corev1.Event
-	for _, unstructuredEvent := range allEvents.Items {
-		event := &corev1.Event{}
-		err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredEvent.Object, event)
-		if err != nil {
-			return nil, err
-		}
-
-		involvedObject := event.InvolvedObject
+	for i := range allEvents {
+		involvedObject := allEvents[i].InvolvedObject
 		if involvedObject.Namespace == u.GetNamespace() &&
 			involvedObject.APIVersion == u.GetAPIVersion() &&
 			involvedObject.Kind == u.GetKind() &&
 			involvedObject.Name == u.GetName() {
-			events = append(events, event)
+			events = append(events, &allEvents[i])
 		}
 	}
 
@@ -651,6 +643,168 @@ func (osq *ObjectStoreQueryer) ServiceAccountForPod(ctx context.Context, pod *co
 
 }
 
+// ConfigMapsForPod returns the config maps a pod mounts or references in its
+// containers' environment. Config maps which do not exist are skipped.
+func (osq *ObjectStoreQueryer) ConfigMapsForPod(ctx context.Context, pod *corev1.Pod) ([]*corev1.ConfigMap, error) {
+	if pod == nil {
+		return nil, errors.New("pod is nil")
+	}
+
+	names, _ := podReferences(pod)
+
+	var configMaps []*corev1.ConfigMap
+	for _, name := range names {
+		u, err := osq.podReference(ctx, pod.Namespace, "ConfigMap", name)
+		if err != nil {
+			return nil, err
+		}
+		if u == nil {
+			continue
+		}
+
+		configMap := &corev1.ConfigMap{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, configMap); err != nil {
+			return nil, errors.WithMessage(err, "converting unstructured object to config map")
+		}
+
+		if err = copyObjectMeta(configMap, u); err != nil {
+			return nil, errors.Wrap(err, "copying object metadata")
+		}
+
+		configMaps = append(configMaps, configMap)
+	}
+
+	return configMaps, nil
+}
+
+// SecretsForPod returns the secrets a pod mounts, references in its
+// containers' environment, or pulls images with. Secrets which do not
+// exist are skipped. The data of the returned secrets is removed.
+func (osq *ObjectStoreQueryer) SecretsForPod(ctx context.Context, pod *corev1.Pod) ([]*corev1.Secret, error) {
+	if pod == nil {
+		return nil, errors.New("pod is nil")
+	}
+
+	_, names := podReferences(pod)
+
+	var secrets []*corev1.Secret
+	for _, name := range names {
+		u, err := osq.podReference(ctx, pod.Namespace, "Secret", name)
+		if err != nil {
+			return nil, err
+		}
+		if u == nil {
+			continue
+		}
+
+		secret := &corev1.Secret{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, secret); err != nil {
+			return nil, errors.WithMessage(err, "converting unstructured object to secret")
+		}
+
+		if err = copyObjectMeta(secret, u); err != nil {
+			return nil, errors.Wrap(err, "copying object metadata")
+		}
+
+		secret.Data = nil
+		secret.StringData = nil
+
+		secrets = append(secrets, secret)
+	}
+
+	return secrets, nil
+}
+
+// podReference returns an object referenced by a pod, or nil if it does not
+// exist.
+func (osq *ObjectStoreQueryer) podReference(ctx context.Context, namespace, kind, name string) (*unstructured.Unstructured, error) {
+	key := store.Key{
+		Namespace:  namespace,
+		APIVersion: "v1",
+		Kind:       kind,
+		Name:       name,
+	}
+
+	u, found, err := osq.objectStore.Get(ctx, key)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "retrieve %s %q from namespace %q",
+			kind, key.Name, key.Namespace)
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	return u, nil
+}
+
+// podReferences returns the sorted names of the config maps and secrets a
+// pod references.
+func podReferences(pod *corev1.Pod) ([]string, []string) {
+	configMaps := make(map[string]bool)
+	secrets := make(map[string]bool)
+
+	for _, volume := range pod.Spec.Volumes {
+		if volume.ConfigMap != nil {
+			configMaps[volume.ConfigMap.Name] = true
+		}
+		if volume.Secret != nil {
+			secrets[volume.Secret.SecretName] = true
+		}
+		if volume.Projected != nil {
+			for _, source := range volume.Projected.Sources {
+				if source.ConfigMap != nil {
+					configMaps[source.ConfigMap.Name] = true
+				}
+				if source.Secret != nil {
+					secrets[source.Secret.Name] = true
+				}
+			}
+		}
+	}
+
+	containers := append(append([]corev1.Container{}, pod.Spec.InitContainers...), pod.Spec.Containers...)
+	for _, c := range containers {
+		for _, envFrom := range c.EnvFrom {
+			if envFrom.ConfigMapRef != nil {
+				configMaps[envFrom.ConfigMapRef.Name] = true
+			}
+			if envFrom.SecretRef != nil {
+				secrets[envFrom.SecretRef.Name] = true
+			}
+		}
+
+		for _, env := range c.Env {
+			if env.ValueFrom == nil {
+				continue
+			}
+			if ref := env.ValueFrom.ConfigMapKeyRef; ref != nil {
+				configMaps[ref.Name] = true
+			}
+			if ref := env.ValueFrom.SecretKeyRef; ref != nil {
+				secrets[ref.Name] = true
+			}
+		}
+	}
+
+	for _, ref := range pod.Spec.ImagePullSecrets {
+		secrets[ref.Name] = true
+	}
+
+	return sortedNames(configMaps), sortedNames(secrets)
+}
+
+func sortedNames(m map[string]bool) []string {
+	var names []string
+	for name := range m {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
 func (osq *ObjectStoreQueryer) getSelector(object runtime.Object) (*metav1.LabelSelector, error) {
 	switch t := object.(type) {
 	case *appsv1.DaemonSet:
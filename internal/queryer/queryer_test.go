@@ -219,6 +219,15 @@ func TestCacheQueryer_Events(t *testing.T) {
 					List(gomock.Any(), gomock.Eq(key)).
 					Return(testutil.ToUnstructuredList(t, events[0], events[1], events[2]), false, nil)
 
+				eventsAPIKey := store.Key{
+					Namespace:  "default",
+					APIVersion: "events.k8s.io/v1beta1",
+					Kind:       "Event",
+				}
+				o.EXPECT().
+					List(gomock.Any(), gomock.Eq(eventsAPIKey)).
+					Return(&unstructured.UnstructuredList{}, false, nil)
+
 			},
 			expected: []string{"event-0", "event-1", "event-2"},
 		},
@@ -892,6 +901,102 @@ func TestObjectStoreQueryer_ServiceAccountForPod(t *testing.T) {
 	require.Equal(t, serviceAccount, got)
 }
 
+func TestObjectStoreQueryer_ConfigMapsForPod(t *testing.T) {
+	configMap := testutil.CreateConfigMap("config-map")
+
+	pod := testutil.CreatePod("pod")
+	pod.Spec.Volumes = []corev1.Volume{
+		{
+			Name: "config",
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: configMap.Name},
+				},
+			},
+		},
+	}
+	pod.Spec.Containers = []corev1.Container{
+		{
+			Name: "app",
+			EnvFrom: []corev1.EnvFromSource{
+				{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: configMap.Name}}},
+				{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "missing"}}},
+			},
+		},
+	}
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	o := storeFake.NewMockStore(controller)
+	key, err := store.KeyFromObject(configMap)
+	require.NoError(t, err)
+	o.EXPECT().
+		Get(gomock.Any(), key).
+		Return(testutil.ToUnstructured(t, configMap), true, nil)
+	missingKey := key
+	missingKey.Name = "missing"
+	o.EXPECT().
+		Get(gomock.Any(), missingKey).
+		Return(nil, false, nil)
+
+	discovery := queryerFake.NewMockDiscoveryInterface(controller)
+
+	q := New(o, discovery)
+
+	ctx := context.Background()
+	got, err := q.ConfigMapsForPod(ctx, pod)
+	require.NoError(t, err)
+
+	require.Equal(t, []*corev1.ConfigMap{configMap}, got)
+}
+
+func TestObjectStoreQueryer_SecretsForPod(t *testing.T) {
+	secret := testutil.CreateSecret("secret")
+	secret.Data = map[string][]byte{"password": []byte("secret")}
+
+	pod := testutil.CreatePod("pod")
+	pod.Spec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: secret.Name}}
+	pod.Spec.Containers = []corev1.Container{
+		{
+			Name: "app",
+			Env: []corev1.EnvVar{
+				{
+					Name: "PASSWORD",
+					ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: secret.Name},
+							Key:                  "password",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	o := storeFake.NewMockStore(controller)
+	key, err := store.KeyFromObject(secret)
+	require.NoError(t, err)
+	o.EXPECT().
+		Get(gomock.Any(), key).
+		Return(testutil.ToUnstructured(t, secret), true, nil)
+
+	discovery := queryerFake.NewMockDiscoveryInterface(controller)
+
+	q := New(o, discovery)
+
+	ctx := context.Background()
+	got, err := q.SecretsForPod(ctx, pod)
+	require.NoError(t, err)
+
+	expected := secret.DeepCopy()
+	expected.Data = nil
+	require.Equal(t, []*corev1.Secret{expected}, got)
+}
+
 func TestCacheQueryer_getSelector(t *testing.T) {
 	selector := &metav1.LabelSelector{
 		MatchLabels: map[string]string{"foo": "bar"},
@@ -0,0 +1,136 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rbac
+
+import (
+	"fmt"
+	"sort"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+var (
+	// Verbs are the verbs an access matrix is built for.
+	Verbs = []string{"get", "list", "watch", "create", "update", "patch", "delete"}
+
+	// DefaultResources are resources an access matrix always includes,
+	// even if no rule mentions them.
+	DefaultResources = []Resource{
+		{Resource: "configmaps"},
+		{Resource: "persistentvolumeclaims"},
+		{Resource: "pods"},
+		{Resource: "secrets"},
+		{Resource: "serviceaccounts"},
+		{Resource: "services"},
+		{Group: "apps", Resource: "daemonsets"},
+		{Group: "apps", Resource: "deployments"},
+		{Group: "apps", Resource: "statefulsets"},
+		{Group: "batch", Resource: "cronjobs"},
+		{Group: "batch", Resource: "jobs"},
+		{Group: apiGroup, Resource: "rolebindings"},
+		{Group: apiGroup, Resource: "roles"},
+	}
+)
+
+// Resource is a resource in an API group.
+type Resource struct {
+	Group    string
+	Resource string
+}
+
+// String returns the resource in resource.group form.
+func (r Resource) String() string {
+	if r.Group == "" {
+		return r.Resource
+	}
+
+	return fmt.Sprintf("%s.%s", r.Resource, r.Group)
+}
+
+// Allows returns true if rules allow verb on every object of resource.
+// Rules restricted to resource names don't, so they are ignored.
+func Allows(rules []rbacv1.PolicyRule, verb string, resource Resource) bool {
+	for _, rule := range rules {
+		if len(rule.ResourceNames) > 0 {
+			continue
+		}
+
+		if contains(rule.Verbs, verb, rbacv1.VerbAll) &&
+			contains(rule.APIGroups, resource.Group, rbacv1.APIGroupAll) &&
+			contains(rule.Resources, resource.Resource, rbacv1.ResourceAll) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Matrix is the access rules grant to resources by verb.
+type Matrix struct {
+	// Resources are the resources in the matrix sorted by name.
+	Resources []Resource
+
+	rules []rbacv1.PolicyRule
+}
+
+// NewMatrix creates a Matrix for rules. It contains the default resources
+// and every resource named by a rule.
+func NewMatrix(rules []rbacv1.PolicyRule) *Matrix {
+	seen := make(map[Resource]bool)
+	var resources []Resource
+
+	add := func(resource Resource) {
+		if seen[resource] {
+			return
+		}
+		seen[resource] = true
+		resources = append(resources, resource)
+	}
+
+	for _, resource := range DefaultResources {
+		add(resource)
+	}
+
+	for _, rule := range rules {
+		for _, group := range rule.APIGroups {
+			if group == rbacv1.APIGroupAll {
+				continue
+			}
+
+			for _, resource := range rule.Resources {
+				if resource == rbacv1.ResourceAll {
+					continue
+				}
+
+				add(Resource{Group: group, Resource: resource})
+			}
+		}
+	}
+
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].String() < resources[j].String()
+	})
+
+	return &Matrix{
+		Resources: resources,
+		rules:     rules,
+	}
+}
+
+// Allows returns true if the matrix's rules allow verb on resource.
+func (m *Matrix) Allows(verb string, resource Resource) bool {
+	return Allows(m.rules, verb, resource)
+}
+
+func contains(list []string, s, wildcard string) bool {
+	for _, item := range list {
+		if item == s || item == wildcard {
+			return true
+		}
+	}
+
+	return false
+}
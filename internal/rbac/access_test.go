@@ -0,0 +1,57 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+func TestAllows(t *testing.T) {
+	rules := []rbacv1.PolicyRule{
+		{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get", "list"}},
+		{APIGroups: []string{"apps"}, Resources: []string{"*"}, Verbs: []string{"*"}},
+		{APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"token"}, Verbs: []string{"get"}},
+	}
+
+	tests := []struct {
+		name     string
+		verb     string
+		resource Resource
+		expected bool
+	}{
+		{name: "listed verb", verb: "list", resource: Resource{Resource: "pods"}, expected: true},
+		{name: "unlisted verb", verb: "delete", resource: Resource{Resource: "pods"}},
+		{name: "wildcards", verb: "patch", resource: Resource{Group: "apps", Resource: "deployments"}, expected: true},
+		{name: "other group", verb: "get", resource: Resource{Group: "batch", Resource: "jobs"}},
+		{name: "resource names", verb: "get", resource: Resource{Resource: "secrets"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, Allows(rules, test.verb, test.resource))
+		})
+	}
+}
+
+func TestNewMatrix(t *testing.T) {
+	rules := []rbacv1.PolicyRule{
+		{APIGroups: []string{"example.com"}, Resources: []string{"widgets"}, Verbs: []string{"get"}},
+		{APIGroups: []string{"*"}, Resources: []string{"pods"}, Verbs: []string{"get"}},
+	}
+
+	matrix := NewMatrix(rules)
+
+	assert.Len(t, matrix.Resources, len(DefaultResources)+1)
+	assert.Contains(t, matrix.Resources, Resource{Group: "example.com", Resource: "widgets"})
+	assert.Equal(t, "configmaps", matrix.Resources[0].String())
+
+	assert.True(t, matrix.Allows("get", Resource{Group: "example.com", Resource: "widgets"}))
+	assert.True(t, matrix.Allows("get", Resource{Resource: "pods"}))
+	assert.False(t, matrix.Allows("list", Resource{Resource: "pods"}))
+}
@@ -0,0 +1,283 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package rbac resolves the roles bound to users, groups, and service
+// accounts, and the access those roles grant.
+package rbac
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/vmware/octant/pkg/store"
+)
+
+const (
+	apiVersion = "rbac.authorization.k8s.io/v1"
+	apiGroup   = "rbac.authorization.k8s.io"
+
+	// serviceAccountsGroup is the group all service accounts belong to.
+	serviceAccountsGroup = "system:serviceaccounts"
+)
+
+// Subject is a user, group, or service account roles can be bound to.
+// Namespace is only set for service accounts.
+type Subject struct {
+	Kind      string
+	Name      string
+	Namespace string
+}
+
+// String returns the subject as kind/name, or kind/namespace/name for
+// service accounts.
+func (s Subject) String() string {
+	if s.Namespace == "" {
+		return fmt.Sprintf("%s/%s", s.Kind, s.Name)
+	}
+
+	return fmt.Sprintf("%s/%s/%s", s.Kind, s.Namespace, s.Name)
+}
+
+// Matches returns true if a binding's subject applies to s. Service
+// accounts are also members of the system:serviceaccounts groups.
+func (s Subject) Matches(subject rbacv1.Subject) bool {
+	switch s.Kind {
+	case rbacv1.ServiceAccountKind:
+		if subject.Kind == rbacv1.ServiceAccountKind {
+			return subject.Name == s.Name &&
+				(subject.Namespace == "" || subject.Namespace == s.Namespace)
+		}
+
+		if subject.Kind == rbacv1.GroupKind && subject.APIGroup == apiGroup {
+			return subject.Name == serviceAccountsGroup ||
+				subject.Name == fmt.Sprintf("%s:%s", serviceAccountsGroup, s.Namespace)
+		}
+
+		return false
+	default:
+		return subject.Kind == s.Kind && subject.Name == s.Name
+	}
+}
+
+// Binding is a role binding or cluster role binding.
+type Binding struct {
+	Kind      string
+	Name      string
+	Namespace string
+	RoleRef   rbacv1.RoleRef
+}
+
+// Resolver resolves bindings and policy rules for subjects using the
+// objects in a store.
+type Resolver struct {
+	objectStore store.Store
+}
+
+// NewResolver creates an instance of Resolver.
+func NewResolver(objectStore store.Store) *Resolver {
+	return &Resolver{
+		objectStore: objectStore,
+	}
+}
+
+// Subjects returns the subjects bound by role bindings in namespace and by
+// cluster role bindings. Group subjects a service account belongs to
+// implicitly are not expanded.
+func (r *Resolver) Subjects(ctx context.Context, namespace string) ([]Subject, error) {
+	roleBindings, err := r.listRoleBindings(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterRoleBindings, err := r.listClusterRoleBindings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[Subject]bool)
+	var list []Subject
+
+	add := func(subjects []rbacv1.Subject) {
+		for _, s := range subjects {
+			subject := Subject{Kind: s.Kind, Name: s.Name}
+			if s.Kind == rbacv1.ServiceAccountKind {
+				subject.Namespace = s.Namespace
+			}
+
+			if seen[subject] {
+				continue
+			}
+			seen[subject] = true
+			list = append(list, subject)
+		}
+	}
+
+	for i := range roleBindings {
+		add(roleBindings[i].Subjects)
+	}
+	for i := range clusterRoleBindings {
+		add(clusterRoleBindings[i].Subjects)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].String() < list[j].String()
+	})
+
+	return list, nil
+}
+
+// Bindings returns the role bindings in namespace and the cluster role
+// bindings which bind subject.
+func (r *Resolver) Bindings(ctx context.Context, subject Subject, namespace string) ([]Binding, error) {
+	roleBindings, err := r.listRoleBindings(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterRoleBindings, err := r.listClusterRoleBindings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []Binding
+
+	for _, roleBinding := range roleBindings {
+		if matchesAny(subject, roleBinding.Subjects) {
+			list = append(list, Binding{
+				Kind:      "RoleBinding",
+				Name:      roleBinding.Name,
+				Namespace: roleBinding.Namespace,
+				RoleRef:   roleBinding.RoleRef,
+			})
+		}
+	}
+
+	for _, clusterRoleBinding := range clusterRoleBindings {
+		if matchesAny(subject, clusterRoleBinding.Subjects) {
+			list = append(list, Binding{
+				Kind:    "ClusterRoleBinding",
+				Name:    clusterRoleBinding.Name,
+				RoleRef: clusterRoleBinding.RoleRef,
+			})
+		}
+	}
+
+	return list, nil
+}
+
+// PolicyRules returns the policy rules of the roles referenced by bindings.
+func (r *Resolver) PolicyRules(ctx context.Context, bindings []Binding) ([]rbacv1.PolicyRule, error) {
+	var policyRules []rbacv1.PolicyRule
+
+	for _, binding := range bindings {
+		roleRef := binding.RoleRef
+
+		key := store.Key{
+			APIVersion: apiVersion,
+			Kind:       roleRef.Kind,
+			Name:       roleRef.Name,
+		}
+
+		switch kind := roleRef.Kind; kind {
+		case "ClusterRole":
+			clusterRole := &rbacv1.ClusterRole{}
+			if err := r.get(ctx, key, clusterRole); err != nil {
+				return nil, err
+			}
+
+			policyRules = append(policyRules, clusterRole.Rules...)
+		case "Role":
+			key.Namespace = binding.Namespace
+
+			role := &rbacv1.Role{}
+			if err := r.get(ctx, key, role); err != nil {
+				return nil, err
+			}
+
+			policyRules = append(policyRules, role.Rules...)
+		default:
+			return nil, errors.Errorf("unable to handle role ref kind %q", kind)
+		}
+	}
+
+	return policyRules, nil
+}
+
+func (r *Resolver) get(ctx context.Context, key store.Key, into interface{}) error {
+	object, found, err := r.objectStore.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errors.Errorf("unable to find %s", key)
+	}
+
+	return scheme.Scheme.Convert(object, into, nil)
+}
+
+func (r *Resolver) listRoleBindings(ctx context.Context, namespace string) ([]rbacv1.RoleBinding, error) {
+	key := store.Key{
+		Namespace:  namespace,
+		APIVersion: apiVersion,
+		Kind:       "RoleBinding",
+	}
+
+	objects, _, err := r.objectStore.List(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []rbacv1.RoleBinding
+	for i := range objects.Items {
+		roleBinding := rbacv1.RoleBinding{}
+		if err := scheme.Scheme.Convert(&objects.Items[i], &roleBinding, nil); err != nil {
+			return nil, err
+		}
+
+		list = append(list, roleBinding)
+	}
+
+	return list, nil
+}
+
+func (r *Resolver) listClusterRoleBindings(ctx context.Context) ([]rbacv1.ClusterRoleBinding, error) {
+	key := store.Key{
+		APIVersion: apiVersion,
+		Kind:       "ClusterRoleBinding",
+	}
+
+	objects, _, err := r.objectStore.List(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []rbacv1.ClusterRoleBinding
+	for i := range objects.Items {
+		clusterRoleBinding := rbacv1.ClusterRoleBinding{}
+		if err := scheme.Scheme.Convert(&objects.Items[i], &clusterRoleBinding, nil); err != nil {
+			return nil, err
+		}
+
+		list = append(list, clusterRoleBinding)
+	}
+
+	return list, nil
+}
+
+func matchesAny(subject Subject, subjects []rbacv1.Subject) bool {
+	for _, s := range subjects {
+		if subject.Matches(s) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,159 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	rbacv1 "k8s.io/api/rbac/v1"
+
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/store"
+	storeFake "github.com/vmware/octant/pkg/store/fake"
+)
+
+func TestSubject_Matches(t *testing.T) {
+	serviceAccount := Subject{Kind: "ServiceAccount", Name: "sa", Namespace: "default"}
+	user := Subject{Kind: "User", Name: "jane"}
+
+	tests := []struct {
+		name     string
+		subject  Subject
+		binding  rbacv1.Subject
+		expected bool
+	}{
+		{
+			name:     "service account",
+			subject:  serviceAccount,
+			binding:  rbacv1.Subject{Kind: "ServiceAccount", Name: "sa", Namespace: "default"},
+			expected: true,
+		},
+		{
+			name:    "service account in other namespace",
+			subject: serviceAccount,
+			binding: rbacv1.Subject{Kind: "ServiceAccount", Name: "sa", Namespace: "other"},
+		},
+		{
+			name:     "all service accounts",
+			subject:  serviceAccount,
+			binding:  rbacv1.Subject{Kind: "Group", Name: "system:serviceaccounts", APIGroup: apiGroup},
+			expected: true,
+		},
+		{
+			name:     "service accounts in namespace",
+			subject:  serviceAccount,
+			binding:  rbacv1.Subject{Kind: "Group", Name: "system:serviceaccounts:default", APIGroup: apiGroup},
+			expected: true,
+		},
+		{
+			name:    "service accounts in other namespace",
+			subject: serviceAccount,
+			binding: rbacv1.Subject{Kind: "Group", Name: "system:serviceaccounts:other", APIGroup: apiGroup},
+		},
+		{
+			name:     "user",
+			subject:  user,
+			binding:  rbacv1.Subject{Kind: "User", Name: "jane", APIGroup: apiGroup},
+			expected: true,
+		},
+		{
+			name:    "group with the user's name",
+			subject: user,
+			binding: rbacv1.Subject{Kind: "Group", Name: "jane", APIGroup: apiGroup},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.subject.Matches(test.binding))
+		})
+	}
+}
+
+func TestResolver(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	role := testutil.CreateRole("role")
+	clusterRole := testutil.CreateClusterRole("cluster-role")
+
+	roleBinding := testutil.CreateRoleBinding("rb", role.Name, []rbacv1.Subject{
+		{Kind: "User", Name: "jane", APIGroup: apiGroup},
+		{Kind: "User", Name: "joe", APIGroup: apiGroup},
+	})
+
+	clusterRoleBinding := testutil.CreateClusterRoleBinding("crb", clusterRole.Name, []rbacv1.Subject{
+		{Kind: "User", Name: "jane", APIGroup: apiGroup},
+		{Kind: "ServiceAccount", Name: "sa", Namespace: "namespace"},
+	})
+	clusterRoleBinding.RoleRef.Kind = "ClusterRole"
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: apiVersion, Kind: "RoleBinding"}).
+		Return(testutil.ToUnstructuredList(t, roleBinding), false, nil).
+		AnyTimes()
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: apiVersion, Kind: "ClusterRoleBinding"}).
+		Return(testutil.ToUnstructuredList(t, clusterRoleBinding), false, nil).
+		AnyTimes()
+	objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: apiVersion, Kind: "Role", Name: "role"}).
+		Return(testutil.ToUnstructured(t, role), true, nil)
+	objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{APIVersion: apiVersion, Kind: "ClusterRole", Name: "cluster-role"}).
+		Return(testutil.ToUnstructured(t, clusterRole), true, nil)
+
+	ctx := context.Background()
+	resolver := NewResolver(objectStore)
+
+	subjects, err := resolver.Subjects(ctx, "namespace")
+	require.NoError(t, err)
+
+	expectedSubjects := []Subject{
+		{Kind: "ServiceAccount", Name: "sa", Namespace: "namespace"},
+		{Kind: "User", Name: "jane"},
+		{Kind: "User", Name: "joe"},
+	}
+	assert.Equal(t, expectedSubjects, subjects)
+
+	bindings, err := resolver.Bindings(ctx, Subject{Kind: "User", Name: "jane"}, "namespace")
+	require.NoError(t, err)
+
+	expectedBindings := []Binding{
+		{Kind: "RoleBinding", Name: "rb", Namespace: "namespace", RoleRef: roleBinding.RoleRef},
+		{Kind: "ClusterRoleBinding", Name: "crb", RoleRef: clusterRoleBinding.RoleRef},
+	}
+	assert.Equal(t, expectedBindings, bindings)
+
+	rules, err := resolver.PolicyRules(ctx, bindings)
+	require.NoError(t, err)
+
+	expectedRules := append(append([]rbacv1.PolicyRule{}, role.Rules...), clusterRole.Rules...)
+	assert.Equal(t, expectedRules, rules)
+}
+
+func TestResolver_PolicyRules_missing_role(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{APIVersion: apiVersion, Kind: "ClusterRole", Name: "missing"}).
+		Return(nil, false, nil)
+
+	bindings := []Binding{
+		{Kind: "ClusterRoleBinding", Name: "crb", RoleRef: rbacv1.RoleRef{Kind: "ClusterRole", Name: "missing"}},
+	}
+
+	resolver := NewResolver(objectStore)
+	_, err := resolver.PolicyRules(context.Background(), bindings)
+	require.Error(t, err)
+}
@@ -410,7 +410,7 @@ func (h *HandlerObjectStatus) Status(ctx context.Context, object runtime.Object)
 		return nil, err
 	}
 
-	status.Details = append(status.Details, pluginStatus.ObjectStatus.Details...)
+	status.Contribute(pluginStatus.ObjectStatus)
 
 	return &status, nil
 }
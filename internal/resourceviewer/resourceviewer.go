@@ -19,6 +19,7 @@ import (
 	"github.com/vmware/octant/internal/objectvisitor"
 	"github.com/vmware/octant/internal/queryer"
 	"github.com/vmware/octant/internal/util/kubernetes"
+	"github.com/vmware/octant/pkg/plugin"
 )
 
 const (
@@ -31,7 +32,14 @@ type ViewerOpt func(*ResourceViewer) error
 // WithDefaultQueryer configures ResourceViewer with the default visitor.
 func WithDefaultQueryer(dashConfig config.Dash, q queryer.Queryer) ViewerOpt {
 	return func(rv *ResourceViewer) error {
-		visitor, err := objectvisitor.NewDefaultVisitor(dashConfig, q)
+		var pluginStore plugin.ManagerStore
+		if pluginManager := dashConfig.PluginManager(); pluginManager != nil {
+			pluginStore = pluginManager.Store()
+		}
+
+		relationships := objectvisitor.NewRelationships(dashConfig.ObjectStore(), pluginStore)
+		visitor, err := objectvisitor.NewDefaultVisitor(dashConfig, q,
+			objectvisitor.SetRelationships(relationships))
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,189 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package revision lists the revisions of deployments and daemon sets, so
+// they can be compared and rolled back.
+package revision
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware/octant/pkg/store"
+)
+
+const (
+	// deploymentRevisionAnnotation is the annotation a deployment
+	// controller sets on deployments and the replica sets it creates.
+	deploymentRevisionAnnotation = "deployment.kubernetes.io/revision"
+)
+
+// Revision is a revision of a workload's pod template.
+type Revision struct {
+	Number   int64
+	Name     string
+	Created  time.Time
+	Template corev1.PodTemplateSpec
+}
+
+// List returns the revisions of a deployment or daemon set, newest first.
+// Deployment revisions are stored in the replica sets it owns, and daemon
+// set revisions are stored in the controller revisions it owns.
+func List(ctx context.Context, objectStore store.Store, object runtime.Object) ([]Revision, error) {
+	var revisions []Revision
+	var err error
+
+	switch o := object.(type) {
+	case *appsv1.Deployment:
+		revisions, err = deploymentRevisions(ctx, objectStore, o)
+	case *appsv1.DaemonSet:
+		revisions, err = daemonSetRevisions(ctx, objectStore, o)
+	default:
+		return nil, errors.Errorf("can't list revisions of %T", object)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Number > revisions[j].Number
+	})
+
+	return revisions, nil
+}
+
+// Find returns the revision with a number.
+func Find(revisions []Revision, number int64) (Revision, bool) {
+	for _, revision := range revisions {
+		if revision.Number == number {
+			return revision, true
+		}
+	}
+
+	return Revision{}, false
+}
+
+// Current returns the number of the revision a deployment or daemon set is
+// running. It is zero if it is not known.
+func Current(object runtime.Object, revisions []Revision) int64 {
+	switch o := object.(type) {
+	case *appsv1.Deployment:
+		number, err := strconv.ParseInt(o.Annotations[deploymentRevisionAnnotation], 10, 64)
+		if err == nil {
+			return number
+		}
+	case *appsv1.DaemonSet:
+		// The newest controller revision is the one the daemon set is running.
+		if len(revisions) > 0 {
+			return revisions[0].Number
+		}
+	}
+
+	return 0
+}
+
+func deploymentRevisions(ctx context.Context, objectStore store.Store, deployment *appsv1.Deployment) ([]Revision, error) {
+	key := store.Key{
+		Namespace:  deployment.Namespace,
+		APIVersion: "apps/v1",
+		Kind:       "ReplicaSet",
+	}
+
+	list, _, err := objectStore.List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "list replica sets")
+	}
+
+	var revisions []Revision
+	for i := range list.Items {
+		replicaSet := &appsv1.ReplicaSet{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(list.Items[i].Object, replicaSet); err != nil {
+			return nil, errors.Wrap(err, "convert replica set")
+		}
+
+		if !isControlledBy(replicaSet, deployment) {
+			continue
+		}
+
+		number, err := strconv.ParseInt(replicaSet.Annotations[deploymentRevisionAnnotation], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		template := *replicaSet.Spec.Template.DeepCopy()
+		// The deployment controller adds the hash label to templates it
+		// copies to replica sets.
+		delete(template.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+
+		revisions = append(revisions, Revision{
+			Number:   number,
+			Name:     replicaSet.Name,
+			Created:  replicaSet.CreationTimestamp.Time,
+			Template: template,
+		})
+	}
+
+	return revisions, nil
+}
+
+func daemonSetRevisions(ctx context.Context, objectStore store.Store, daemonSet *appsv1.DaemonSet) ([]Revision, error) {
+	key := store.Key{
+		Namespace:  daemonSet.Namespace,
+		APIVersion: "apps/v1",
+		Kind:       "ControllerRevision",
+	}
+
+	list, _, err := objectStore.List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "list controller revisions")
+	}
+
+	var revisions []Revision
+	for i := range list.Items {
+		controllerRevision := &appsv1.ControllerRevision{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(list.Items[i].Object, controllerRevision); err != nil {
+			return nil, errors.Wrap(err, "convert controller revision")
+		}
+
+		if !isControlledBy(controllerRevision, daemonSet) {
+			continue
+		}
+
+		// Daemon set controller revisions store a patch which replaces the
+		// pod template.
+		var data struct {
+			Spec struct {
+				Template corev1.PodTemplateSpec `json:"template"`
+			} `json:"spec"`
+		}
+		if err := json.Unmarshal(controllerRevision.Data.Raw, &data); err != nil {
+			return nil, errors.Wrapf(err, "decode controller revision %s", controllerRevision.Name)
+		}
+
+		revisions = append(revisions, Revision{
+			Number:   controllerRevision.Revision,
+			Name:     controllerRevision.Name,
+			Created:  controllerRevision.CreationTimestamp.Time,
+			Template: data.Spec.Template,
+		})
+	}
+
+	return revisions, nil
+}
+
+func isControlledBy(object, owner metav1.Object) bool {
+	controllerRef := metav1.GetControllerOf(object)
+	return controllerRef != nil && controllerRef.UID == owner.GetUID()
+}
@@ -0,0 +1,130 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package revision
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/store"
+	storeFake "github.com/vmware/octant/pkg/store/fake"
+)
+
+func podTemplate(image string) corev1.PodTemplateSpec {
+	return corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{"app": "app"},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "app", Image: image}},
+		},
+	}
+}
+
+func TestList_deployment(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.Annotations = map[string]string{deploymentRevisionAnnotation: "2"}
+	ownerRef := *metav1.NewControllerRef(deployment, deployment.GroupVersionKind())
+
+	createReplicaSet := func(name, revision, image string, owned bool) *appsv1.ReplicaSet {
+		replicaSet := testutil.CreateAppReplicaSet(name)
+		replicaSet.Annotations = map[string]string{deploymentRevisionAnnotation: revision}
+		replicaSet.Spec.Template = podTemplate(image)
+		replicaSet.Spec.Template.Labels[appsv1.DefaultDeploymentUniqueLabelKey] = "hash"
+		if owned {
+			replicaSet.OwnerReferences = []metav1.OwnerReference{ownerRef}
+		}
+		return replicaSet
+	}
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "apps/v1", Kind: "ReplicaSet"}).
+		Return(testutil.ToUnstructuredList(t,
+			createReplicaSet("rs-1", "1", "app:1", true),
+			createReplicaSet("rs-2", "2", "app:2", true),
+			createReplicaSet("other", "3", "other:1", false),
+		), false, nil)
+
+	got, err := List(context.Background(), objectStore, deployment)
+	require.NoError(t, err)
+
+	expected := []Revision{
+		{Number: 2, Name: "rs-2", Template: podTemplate("app:2")},
+		{Number: 1, Name: "rs-1", Template: podTemplate("app:1")},
+	}
+	assert.Equal(t, expected, got)
+	assert.Equal(t, int64(2), Current(deployment, got))
+}
+
+func TestList_daemonSet(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	daemonSet := testutil.CreateDaemonSet("daemonset")
+	ownerRef := *metav1.NewControllerRef(daemonSet, daemonSet.GroupVersionKind())
+
+	createControllerRevision := func(name string, revision int64, image string) *appsv1.ControllerRevision {
+		return &appsv1.ControllerRevision{
+			TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "ControllerRevision"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       "namespace",
+				OwnerReferences: []metav1.OwnerReference{ownerRef},
+			},
+			Data: runtime.RawExtension{
+				Raw: []byte(`{"spec":{"template":{"$patch":"replace","metadata":{"labels":{"app":"app"}},` +
+					`"spec":{"containers":[{"name":"app","image":"` + image + `"}]}}}}`),
+			},
+			Revision: revision,
+		}
+	}
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "apps/v1", Kind: "ControllerRevision"}).
+		Return(testutil.ToUnstructuredList(t,
+			createControllerRevision("cr-1", 1, "app:1"),
+			createControllerRevision("cr-3", 3, "app:3"),
+		), false, nil)
+
+	got, err := List(context.Background(), objectStore, daemonSet)
+	require.NoError(t, err)
+
+	expected := []Revision{
+		{Number: 3, Name: "cr-3", Template: podTemplate("app:3")},
+		{Number: 1, Name: "cr-1", Template: podTemplate("app:1")},
+	}
+	assert.Equal(t, expected, got)
+	assert.Equal(t, int64(3), Current(daemonSet, got))
+
+	found, ok := Find(got, 1)
+	require.True(t, ok)
+	assert.Equal(t, "cr-1", found.Name)
+
+	_, ok = Find(got, 2)
+	assert.False(t, ok)
+}
+
+func TestList_unsupported(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	_, err := List(context.Background(), storeFake.NewMockStore(controller), testutil.CreatePod("pod"))
+	require.Error(t, err)
+}
@@ -0,0 +1,225 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package rollout finds deployments which have exceeded their progress
+// deadline, and analyzes their pods to explain why.
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware/octant/pkg/store"
+)
+
+const (
+	// progressDeadlineExceeded is the reason of a deployment's progressing
+	// condition when its progress deadline has been exceeded.
+	progressDeadlineExceeded = "ProgressDeadlineExceeded"
+)
+
+// Reason is the reason a deployment is stuck.
+type Reason string
+
+const (
+	// ReasonUnknown is used when a deployment's pods do not explain why it is stuck.
+	ReasonUnknown Reason = "unknown"
+	// ReasonImagePull is used when pod images can't be pulled.
+	ReasonImagePull Reason = "image pull failure"
+	// ReasonQuota is used when pods can't be created because of a resource quota.
+	ReasonQuota Reason = "quota"
+	// ReasonScheduling is used when pods can't be scheduled.
+	ReasonScheduling Reason = "scheduling"
+)
+
+var imagePullReasons = map[string]bool{
+	"ErrImagePull":     true,
+	"ImagePullBackOff": true,
+	"InvalidImageName": true,
+}
+
+// Stuck is a deployment which has exceeded its progress deadline.
+type Stuck struct {
+	Namespace string
+	Name      string
+	Reason    Reason
+	// Detail is a message from the deployment or pod which explains the reason.
+	Detail string
+}
+
+// String returns a description of the stuck deployment.
+func (s Stuck) String() string {
+	message := fmt.Sprintf("Deployment %s/%s exceeded its progress deadline", s.Namespace, s.Name)
+	if s.Reason == ReasonUnknown {
+		return message
+	}
+
+	message = fmt.Sprintf("%s (%s)", message, s.Reason)
+	if s.Detail != "" {
+		message = fmt.Sprintf("%s: %s", message, s.Detail)
+	}
+
+	return message
+}
+
+// Key returns a key which identifies the deployment.
+func (s Stuck) Key() string {
+	return s.Namespace + "/" + s.Name
+}
+
+// FindStuck lists deployments in all namespaces, and returns the ones which
+// have exceeded their progress deadline sorted by namespace and name.
+func FindStuck(ctx context.Context, objectStore store.Store) ([]Stuck, error) {
+	if objectStore == nil {
+		return nil, errors.New("object store is nil")
+	}
+
+	key := store.Key{APIVersion: "apps/v1", Kind: "Deployment"}
+	list, _, err := objectStore.List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "list deployments")
+	}
+
+	var stuck []Stuck
+	for i := range list.Items {
+		deployment := &appsv1.Deployment{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(list.Items[i].Object, deployment); err != nil {
+			return nil, errors.Wrap(err, "convert unstructured deployment")
+		}
+
+		if !IsStuck(deployment) {
+			continue
+		}
+
+		pods, err := listPods(ctx, objectStore, deployment)
+		if err != nil {
+			return nil, err
+		}
+
+		stuck = append(stuck, Analyze(deployment, pods))
+	}
+
+	sort.Slice(stuck, func(i, j int) bool {
+		return stuck[i].Key() < stuck[j].Key()
+	})
+
+	return stuck, nil
+}
+
+// IsStuck returns true if a deployment has exceeded its progress deadline.
+func IsStuck(deployment *appsv1.Deployment) bool {
+	if deployment == nil {
+		return false
+	}
+
+	condition := findCondition(deployment, appsv1.DeploymentProgressing)
+	return condition != nil &&
+		condition.Status == corev1.ConditionFalse &&
+		condition.Reason == progressDeadlineExceeded
+}
+
+// StuckSince returns when a deployment exceeded its progress deadline. It
+// returns false if the deployment is not stuck.
+func StuckSince(deployment *appsv1.Deployment) (time.Time, bool) {
+	if !IsStuck(deployment) {
+		return time.Time{}, false
+	}
+
+	condition := findCondition(deployment, appsv1.DeploymentProgressing)
+	return condition.LastTransitionTime.Time, true
+}
+
+// Analyze determines why a deployment is stuck using its conditions and
+// its pods.
+func Analyze(deployment *appsv1.Deployment, pods []corev1.Pod) Stuck {
+	stuck := Stuck{
+		Namespace: deployment.Namespace,
+		Name:      deployment.Name,
+		Reason:    ReasonUnknown,
+	}
+
+	// A replica set which can't create pods reports the failure on the
+	// deployment, e.g. when a resource quota is exceeded.
+	if condition := findCondition(deployment, appsv1.DeploymentReplicaFailure); condition != nil &&
+		condition.Status == corev1.ConditionTrue &&
+		strings.Contains(condition.Message, "exceeded quota") {
+		stuck.Reason = ReasonQuota
+		stuck.Detail = condition.Message
+		return stuck
+	}
+
+	for _, pod := range pods {
+		for _, status := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+			waiting := status.State.Waiting
+			if waiting != nil && imagePullReasons[waiting.Reason] {
+				stuck.Reason = ReasonImagePull
+				stuck.Detail = fmt.Sprintf("container %s in pod %s: %s", status.Name, pod.Name, waiting.Reason)
+				return stuck
+			}
+		}
+	}
+
+	for _, pod := range pods {
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == corev1.PodScheduled &&
+				condition.Status == corev1.ConditionFalse &&
+				condition.Reason == corev1.PodReasonUnschedulable {
+				stuck.Reason = ReasonScheduling
+				stuck.Detail = fmt.Sprintf("pod %s: %s", pod.Name, condition.Message)
+				return stuck
+			}
+		}
+	}
+
+	return stuck
+}
+
+func findCondition(deployment *appsv1.Deployment, conditionType appsv1.DeploymentConditionType) *appsv1.DeploymentCondition {
+	for i := range deployment.Status.Conditions {
+		if deployment.Status.Conditions[i].Type == conditionType {
+			return &deployment.Status.Conditions[i]
+		}
+	}
+
+	return nil
+}
+
+func listPods(ctx context.Context, objectStore store.Store, deployment *appsv1.Deployment) ([]corev1.Pod, error) {
+	if deployment.Spec.Selector == nil {
+		return nil, nil
+	}
+
+	key := store.Key{
+		Namespace:     deployment.Namespace,
+		APIVersion:    "v1",
+		Kind:          "Pod",
+		LabelSelector: deployment.Spec.Selector,
+	}
+
+	list, _, err := objectStore.List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list pods for deployment %s", deployment.Name)
+	}
+
+	var pods []corev1.Pod
+	for i := range list.Items {
+		pod := corev1.Pod{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(list.Items[i].Object, &pod); err != nil {
+			return nil, errors.Wrap(err, "convert unstructured pod")
+		}
+
+		pods = append(pods, pod)
+	}
+
+	return pods, nil
+}
@@ -0,0 +1,223 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rollout
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/store"
+	storeFake "github.com/vmware/octant/pkg/store/fake"
+)
+
+func TestFindStuck(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	healthy := testutil.CreateDeployment("healthy")
+
+	stuck := createStuckDeployment("stuck")
+	stuck.Spec.Selector = &metav1.LabelSelector{
+		MatchLabels: map[string]string{"app": "stuck"},
+	}
+
+	pod := testutil.CreatePod("stuck-pod")
+	pod.Labels = map[string]string{"app": "stuck"}
+	pod.Status.ContainerStatuses = []corev1.ContainerStatus{
+		{
+			Name: "app",
+			State: corev1.ContainerState{
+				Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"},
+			},
+		},
+	}
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: "apps/v1", Kind: "Deployment"}).
+		Return(testutil.ToUnstructuredList(t, healthy, stuck), false, nil)
+
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{
+			Namespace:     "namespace",
+			APIVersion:    "v1",
+			Kind:          "Pod",
+			LabelSelector: stuck.Spec.Selector,
+		}).
+		Return(testutil.ToUnstructuredList(t, pod), false, nil)
+
+	got, err := FindStuck(context.Background(), objectStore)
+	require.NoError(t, err)
+
+	expected := []Stuck{
+		{
+			Namespace: "namespace",
+			Name:      "stuck",
+			Reason:    ReasonImagePull,
+			Detail:    "container app in pod stuck-pod: ImagePullBackOff",
+		},
+	}
+	assert.Equal(t, expected, got)
+}
+
+func TestIsStuck(t *testing.T) {
+	progressing := testutil.CreateDeployment("progressing")
+	progressing.Status.Conditions = []appsv1.DeploymentCondition{
+		{
+			Type:   appsv1.DeploymentProgressing,
+			Status: corev1.ConditionTrue,
+			Reason: "NewReplicaSetAvailable",
+		},
+	}
+
+	assert.True(t, IsStuck(createStuckDeployment("stuck")))
+	assert.False(t, IsStuck(progressing))
+	assert.False(t, IsStuck(testutil.CreateDeployment("no-conditions")))
+	assert.False(t, IsStuck(nil))
+}
+
+func TestStuckSince(t *testing.T) {
+	since := time.Unix(1546300800, 0)
+
+	stuck := createStuckDeployment("stuck")
+	stuck.Status.Conditions[0].LastTransitionTime = metav1.NewTime(since)
+
+	got, ok := StuckSince(stuck)
+	require.True(t, ok)
+	assert.Equal(t, since, got)
+
+	_, ok = StuckSince(testutil.CreateDeployment("no-conditions"))
+	assert.False(t, ok)
+}
+
+func TestAnalyze(t *testing.T) {
+	quota := createStuckDeployment("deployment")
+	quota.Status.Conditions = append(quota.Status.Conditions, appsv1.DeploymentCondition{
+		Type:    appsv1.DeploymentReplicaFailure,
+		Status:  corev1.ConditionTrue,
+		Reason:  "FailedCreate",
+		Message: `pods "app" is forbidden: exceeded quota: compute`,
+	})
+
+	unschedulable := testutil.CreatePod("pod")
+	unschedulable.Status.Conditions = []corev1.PodCondition{
+		{
+			Type:    corev1.PodScheduled,
+			Status:  corev1.ConditionFalse,
+			Reason:  corev1.PodReasonUnschedulable,
+			Message: "0/3 nodes are available: 3 Insufficient cpu.",
+		},
+	}
+
+	imagePull := testutil.CreatePod("pod")
+	imagePull.Status.InitContainerStatuses = []corev1.ContainerStatus{
+		{
+			Name: "init",
+			State: corev1.ContainerState{
+				Waiting: &corev1.ContainerStateWaiting{Reason: "ErrImagePull"},
+			},
+		},
+	}
+
+	cases := []struct {
+		name       string
+		deployment *appsv1.Deployment
+		pods       []corev1.Pod
+		expected   Stuck
+	}{
+		{
+			name:       "quota",
+			deployment: quota,
+			expected: Stuck{
+				Reason: ReasonQuota,
+				Detail: `pods "app" is forbidden: exceeded quota: compute`,
+			},
+		},
+		{
+			name:       "image pull",
+			deployment: createStuckDeployment("deployment"),
+			pods:       []corev1.Pod{*imagePull},
+			expected: Stuck{
+				Reason: ReasonImagePull,
+				Detail: "container init in pod pod: ErrImagePull",
+			},
+		},
+		{
+			name:       "scheduling",
+			deployment: createStuckDeployment("deployment"),
+			pods:       []corev1.Pod{*unschedulable},
+			expected: Stuck{
+				Reason: ReasonScheduling,
+				Detail: "pod pod: 0/3 nodes are available: 3 Insufficient cpu.",
+			},
+		},
+		{
+			name:       "unknown",
+			deployment: createStuckDeployment("deployment"),
+			pods:       []corev1.Pod{*testutil.CreatePod("pod")},
+			expected: Stuck{
+				Reason: ReasonUnknown,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := Analyze(tc.deployment, tc.pods)
+
+			tc.expected.Namespace = "namespace"
+			tc.expected.Name = "deployment"
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestStuck_String(t *testing.T) {
+	cases := []struct {
+		name     string
+		stuck    Stuck
+		expected string
+	}{
+		{
+			name:     "unknown",
+			stuck:    Stuck{Namespace: "default", Name: "app", Reason: ReasonUnknown},
+			expected: "Deployment default/app exceeded its progress deadline",
+		},
+		{
+			name:     "with detail",
+			stuck:    Stuck{Namespace: "default", Name: "app", Reason: ReasonScheduling, Detail: "pod app-1: no nodes"},
+			expected: "Deployment default/app exceeded its progress deadline (scheduling): pod app-1: no nodes",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.stuck.String())
+		})
+	}
+}
+
+func createStuckDeployment(name string) *appsv1.Deployment {
+	deployment := testutil.CreateDeployment(name)
+	deployment.Status.Conditions = []appsv1.DeploymentCondition{
+		{
+			Type:   appsv1.DeploymentProgressing,
+			Status: corev1.ConditionFalse,
+			Reason: "ProgressDeadlineExceeded",
+		},
+	}
+
+	return deployment
+}
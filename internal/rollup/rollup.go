@@ -0,0 +1,291 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rollup
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/pkg/store"
+)
+
+const (
+	// WarningEventWindow is how long a warning event is counted after it was
+	// last seen.
+	WarningEventWindow = 15 * time.Minute
+)
+
+var (
+	workloadKeys = []store.Key{
+		{APIVersion: "apps/v1", Kind: "Deployment"},
+		{APIVersion: "apps/v1", Kind: "StatefulSet"},
+		{APIVersion: "apps/v1", Kind: "DaemonSet"},
+		{APIVersion: "batch/v1beta1", Kind: "CronJob"},
+	}
+	podKey   = store.Key{APIVersion: "v1", Kind: "Pod"}
+	eventKey = store.Key{APIVersion: "v1", Kind: "Event"}
+)
+
+// Rollup summarizes the health of a namespace.
+type Rollup struct {
+	// Workloads is the number of deployments, stateful sets, daemon sets,
+	// and cron jobs.
+	Workloads int `json:"workloads"`
+	// PodsNotReady is the number of pods which are not ready. Completed pods
+	// are not counted.
+	PodsNotReady int `json:"podsNotReady"`
+	// WarningEvents is the number of warning events seen in the last
+	// WarningEventWindow.
+	WarningEvents int `json:"warningEvents"`
+}
+
+// Tracker keeps rollups for every namespace. It is updated incrementally by
+// informer events, so reading rollups does not query the cluster.
+type Tracker struct {
+	mu sync.RWMutex
+	// generation is incremented when the object store's client changes.
+	// Events from informers of a previous generation are ignored.
+	generation int
+	// workloads is a set of workloads in each namespace.
+	workloads map[string]map[string]bool
+	// podsNotReady is a set of pods which are not ready in each namespace.
+	podsNotReady map[string]map[string]bool
+	// warningEvents is when each warning event in a namespace was last seen.
+	warningEvents map[string]map[string]time.Time
+
+	now func() time.Time
+}
+
+// NewTracker creates an instance of Tracker which watches objectStore. It
+// restarts when the object store's client changes.
+func NewTracker(ctx context.Context, objectStore store.Store) *Tracker {
+	t := newTracker()
+	t.watch(ctx, objectStore)
+
+	objectStore.RegisterOnUpdate(func(newObjectStore store.Store) {
+		t.reset()
+		t.watch(ctx, newObjectStore)
+	})
+
+	return t
+}
+
+func newTracker() *Tracker {
+	return &Tracker{
+		workloads:     make(map[string]map[string]bool),
+		podsNotReady:  make(map[string]map[string]bool),
+		warningEvents: make(map[string]map[string]time.Time),
+		now:           time.Now,
+	}
+}
+
+// Rollups returns the rollups for namespaces with at least one workload,
+// pod which is not ready, or warning event.
+func (t *Tracker) Rollups() map[string]Rollup {
+	if t == nil {
+		return nil
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	rollups := make(map[string]Rollup)
+	update := func(namespace string, fn func(rollup *Rollup)) {
+		rollup := rollups[namespace]
+		fn(&rollup)
+		rollups[namespace] = rollup
+	}
+
+	for namespace, set := range t.workloads {
+		update(namespace, func(rollup *Rollup) { rollup.Workloads = len(set) })
+	}
+
+	for namespace, set := range t.podsNotReady {
+		update(namespace, func(rollup *Rollup) { rollup.PodsNotReady = len(set) })
+	}
+
+	since := t.now().Add(-WarningEventWindow)
+	for namespace, events := range t.warningEvents {
+		count := 0
+		for _, lastSeen := range events {
+			if lastSeen.After(since) {
+				count++
+			}
+		}
+		if count > 0 {
+			update(namespace, func(rollup *Rollup) { rollup.WarningEvents = count })
+		}
+	}
+
+	return rollups
+}
+
+func (t *Tracker) reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.generation++
+	t.workloads = make(map[string]map[string]bool)
+	t.podsNotReady = make(map[string]map[string]bool)
+	t.warningEvents = make(map[string]map[string]time.Time)
+}
+
+func (t *Tracker) watch(ctx context.Context, objectStore store.Store) {
+	logger := log.From(ctx).With("component", "namespace-rollups")
+
+	t.mu.RLock()
+	generation := t.generation
+	t.mu.RUnlock()
+
+	handlers := map[store.Key]func(object *unstructured.Unstructured, deleted bool){
+		podKey:   t.updatePod,
+		eventKey: t.updateEvent,
+	}
+	for _, key := range workloadKeys {
+		handlers[key] = t.updateWorkload
+	}
+
+	for key, fn := range handlers {
+		if err := objectStore.Watch(ctx, key, t.handler(generation, fn)); err != nil {
+			logger.WithErr(err).Debugf("unable to watch %s; it will not be included in rollups", key.Kind)
+		}
+	}
+}
+
+// handler creates an informer event handler which calls fn while generation
+// is current.
+func (t *Tracker) handler(generation int, fn func(object *unstructured.Unstructured, deleted bool)) kcache.ResourceEventHandler {
+	call := func(obj interface{}, deleted bool) {
+		if tombstone, ok := obj.(kcache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+
+		object, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			return
+		}
+
+		t.mu.Lock()
+		defer t.mu.Unlock()
+
+		if generation != t.generation {
+			return
+		}
+
+		fn(object, deleted)
+	}
+
+	return kcache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { call(obj, false) },
+		UpdateFunc: func(_, obj interface{}) { call(obj, false) },
+		DeleteFunc: func(obj interface{}) { call(obj, true) },
+	}
+}
+
+// updateWorkload updates the workload set. It must be called with the lock held.
+func (t *Tracker) updateWorkload(object *unstructured.Unstructured, deleted bool) {
+	name := fmt.Sprintf("%s/%s", object.GetKind(), object.GetName())
+	setMember(t.workloads, object.GetNamespace(), name, !deleted)
+}
+
+// updatePod updates the set of pods which are not ready. It must be called
+// with the lock held.
+func (t *Tracker) updatePod(object *unstructured.Unstructured, deleted bool) {
+	notReady := false
+	if !deleted {
+		pod := &corev1.Pod{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, pod); err != nil {
+			return
+		}
+		notReady = !isPodReady(pod)
+	}
+
+	setMember(t.podsNotReady, object.GetNamespace(), object.GetName(), notReady)
+}
+
+// updateEvent updates when warning events were last seen. It must be
+// called with the lock held.
+func (t *Tracker) updateEvent(object *unstructured.Unstructured, deleted bool) {
+	namespace := object.GetNamespace()
+
+	event := &corev1.Event{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, event); err != nil {
+		return
+	}
+
+	if deleted || event.Type != corev1.EventTypeWarning {
+		if events, ok := t.warningEvents[namespace]; ok {
+			delete(events, object.GetName())
+			if len(events) == 0 {
+				delete(t.warningEvents, namespace)
+			}
+		}
+		return
+	}
+
+	events, ok := t.warningEvents[namespace]
+	if !ok {
+		events = make(map[string]time.Time)
+		t.warningEvents[namespace] = events
+	}
+	events[object.GetName()] = eventLastSeen(event)
+}
+
+// isPodReady returns true if a pod is ready or has completed.
+func isPodReady(pod *corev1.Pod) bool {
+	if pod.Status.Phase == corev1.PodSucceeded {
+		return true
+	}
+
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+// eventLastSeen returns when an event was last seen.
+func eventLastSeen(event *corev1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
+
+// setMember adds or removes a name from a namespace's set. Empty sets are
+// removed.
+func setMember(sets map[string]map[string]bool, namespace, name string, isMember bool) {
+	set, ok := sets[namespace]
+	if !isMember {
+		if ok {
+			delete(set, name)
+			if len(set) == 0 {
+				delete(sets, namespace)
+			}
+		}
+		return
+	}
+
+	if !ok {
+		set = make(map[string]bool)
+		sets[namespace] = set
+	}
+	set[name] = true
+}
@@ -0,0 +1,96 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rollup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/store"
+	storeFake "github.com/vmware/octant/pkg/store/fake"
+)
+
+func TestTracker(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	now := time.Unix(1547211430, 0)
+
+	handlers := make(map[string]kcache.ResourceEventHandler)
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Watch(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, key store.Key, handler kcache.ResourceEventHandler) error {
+			handlers[key.Kind] = handler
+			return nil
+		}).
+		Times(len(workloadKeys) + 2)
+	objectStore.EXPECT().RegisterOnUpdate(gomock.Any())
+
+	tracker := NewTracker(context.Background(), objectStore)
+	tracker.now = func() time.Time { return now }
+
+	deployment := testutil.ToUnstructured(t, testutil.CreateDeployment("deployment"))
+	handlers["Deployment"].OnAdd(deployment)
+	handlers["StatefulSet"].OnAdd(testutil.ToUnstructured(t, testutil.CreateStatefulSet("statefulset")))
+
+	readyPod := testutil.CreatePod("ready")
+	readyPod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+	handlers["Pod"].OnAdd(testutil.ToUnstructured(t, readyPod))
+
+	completedPod := testutil.CreatePod("completed")
+	completedPod.Status.Phase = corev1.PodSucceeded
+	handlers["Pod"].OnAdd(testutil.ToUnstructured(t, completedPod))
+
+	notReadyPod := testutil.ToUnstructured(t, testutil.CreatePod("not-ready"))
+	handlers["Pod"].OnAdd(notReadyPod)
+
+	recent := testutil.CreateEvent("recent")
+	recent.Type = corev1.EventTypeWarning
+	recent.LastTimestamp = metav1.NewTime(now.Add(-time.Minute))
+	handlers["Event"].OnAdd(testutil.ToUnstructured(t, recent))
+
+	old := testutil.CreateEvent("old")
+	old.Type = corev1.EventTypeWarning
+	old.LastTimestamp = metav1.NewTime(now.Add(-time.Hour))
+	handlers["Event"].OnAdd(testutil.ToUnstructured(t, old))
+
+	normal := testutil.CreateEvent("normal")
+	normal.Type = corev1.EventTypeNormal
+	normal.LastTimestamp = metav1.NewTime(now)
+	handlers["Event"].OnAdd(testutil.ToUnstructured(t, normal))
+
+	expected := map[string]Rollup{
+		testutil.DefaultNamespace: {Workloads: 2, PodsNotReady: 1, WarningEvents: 1},
+	}
+	assert.Equal(t, expected, tracker.Rollups())
+
+	handlers["Deployment"].OnDelete(kcache.DeletedFinalStateUnknown{Obj: deployment})
+	handlers["Pod"].OnDelete(notReadyPod)
+
+	expected = map[string]Rollup{
+		testutil.DefaultNamespace: {Workloads: 1, WarningEvents: 1},
+	}
+	assert.Equal(t, expected, tracker.Rollups())
+
+	tracker.reset()
+	handlers["Deployment"].OnAdd(deployment)
+	assert.Empty(t, tracker.Rollups())
+}
+
+func TestTracker_Rollups_nil(t *testing.T) {
+	var tracker *Tracker
+	assert.Nil(t, tracker.Rollups())
+}
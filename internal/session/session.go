@@ -0,0 +1,207 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package session
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// userHeaders are headers set by authenticating proxies which contain the
+// name of the user. They are checked in order.
+var userHeaders = []string{
+	"X-Forwarded-User",
+	"X-Remote-User",
+	"X-Forwarded-Email",
+}
+
+// Session is a client connected to the dashboard.
+type Session struct {
+	// ID is the ID of the client.
+	ID string
+	// User is the user reported by an authenticating proxy. It is blank
+	// if the dashboard is not behind one.
+	User string
+	// RemoteAddr is the address of the client.
+	RemoteAddr string
+	// UserAgent is the user agent of the client.
+	UserAgent string
+	// ContentPath is the content path the client is viewing.
+	ContentPath string
+	// Namespace is the namespace the client is viewing.
+	Namespace string
+	// Started is when the client connected.
+	Started time.Time
+}
+
+// FromRequest creates a session from a HTTP request.
+func FromRequest(id string, r *http.Request, started time.Time) Session {
+	return Session{
+		ID:         id,
+		User:       UserFromRequest(r),
+		RemoteAddr: r.RemoteAddr,
+		UserAgent:  r.UserAgent(),
+		Started:    started,
+	}
+}
+
+// CanTerminate returns true if the session may terminate other. A session
+// may terminate itself, and sessions of a user authenticated by a proxy may
+// terminate the user's other sessions.
+func (s Session) CanTerminate(other Session) bool {
+	if s.ID == other.ID {
+		return true
+	}
+
+	return s.User != "" && s.User == other.User
+}
+
+type idKey struct{}
+
+// WithID returns a context for requests made by the session with id.
+func WithID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, idKey{}, id)
+}
+
+// IDFrom returns the ID of the session which made a request. It is blank if
+// the request wasn't made by a session.
+func IDFrom(ctx context.Context) string {
+	id, _ := ctx.Value(idKey{}).(string)
+	return id
+}
+
+// UserFromRequest returns the user set by an authenticating proxy.
+func UserFromRequest(r *http.Request) string {
+	for _, header := range userHeaders {
+		if user := r.Header.Get(header); user != "" {
+			return user
+		}
+	}
+
+	return ""
+}
+
+// TerminateFunc terminates a session.
+type TerminateFunc func()
+
+type entry struct {
+	session   Session
+	terminate TerminateFunc
+}
+
+// Registry tracks the sessions connected to the dashboard.
+type Registry struct {
+	mu       sync.RWMutex
+	sessions map[string]*entry
+}
+
+// NewRegistry creates an instance of Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		sessions: make(map[string]*entry),
+	}
+}
+
+// Add adds a session. terminate is called when the session is terminated.
+func (r *Registry) Add(session Session, terminate TerminateFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.sessions[session.ID] = &entry{
+		session:   session,
+		terminate: terminate,
+	}
+}
+
+// Remove removes a session.
+func (r *Registry) Remove(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.sessions, id)
+}
+
+// SetContentPath sets the content path of a session.
+func (r *Registry) SetContentPath(id, contentPath string) {
+	r.update(id, func(session *Session) {
+		session.ContentPath = contentPath
+	})
+}
+
+// SetNamespace sets the namespace of a session.
+func (r *Registry) SetNamespace(id, namespace string) {
+	r.update(id, func(session *Session) {
+		session.Namespace = namespace
+	})
+}
+
+func (r *Registry) update(id string, fn func(session *Session)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e, ok := r.sessions[id]
+	if !ok {
+		return
+	}
+
+	fn(&e.session)
+}
+
+// Get returns a session.
+func (r *Registry) Get(id string) (Session, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	e, ok := r.sessions[id]
+	if !ok {
+		return Session{}, false
+	}
+
+	return e.session, true
+}
+
+// List lists sessions ordered by when they started.
+func (r *Registry) List() []Session {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var list []Session
+	for _, e := range r.sessions {
+		list = append(list, e.session)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Started.Equal(list[j].Started) {
+			return list[i].ID < list[j].ID
+		}
+		return list[i].Started.Before(list[j].Started)
+	})
+
+	return list
+}
+
+// Terminate terminates a session and removes it.
+func (r *Registry) Terminate(id string) error {
+	r.mu.Lock()
+	e, ok := r.sessions[id]
+	delete(r.sessions, id)
+	r.mu.Unlock()
+
+	if !ok {
+		return errors.Errorf("session %q does not exist", id)
+	}
+
+	if e.terminate != nil {
+		e.terminate()
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package session
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	now := time.Unix(1547211430, 0)
+
+	r := NewRegistry()
+
+	terminated := false
+	r.Add(Session{ID: "b", Started: now.Add(time.Minute)}, func() {
+		terminated = true
+	})
+	r.Add(Session{ID: "a", Started: now}, nil)
+
+	r.SetContentPath("b", "overview/namespace/default")
+	r.SetNamespace("b", "default")
+	r.SetContentPath("missing", "overview")
+
+	expected := []Session{
+		{ID: "a", Started: now},
+		{ID: "b", Started: now.Add(time.Minute), ContentPath: "overview/namespace/default", Namespace: "default"},
+	}
+	assert.Equal(t, expected, r.List())
+
+	got, ok := r.Get("b")
+	require.True(t, ok)
+	assert.Equal(t, expected[1], got)
+
+	_, ok = r.Get("missing")
+	assert.False(t, ok)
+
+	require.NoError(t, r.Terminate("b"))
+	assert.True(t, terminated)
+	assert.Equal(t, expected[:1], r.List())
+
+	require.Error(t, r.Terminate("b"))
+
+	r.Remove("a")
+	assert.Empty(t, r.List())
+}
+
+func TestSession_CanTerminate(t *testing.T) {
+	tests := []struct {
+		name     string
+		caller   Session
+		target   Session
+		expected bool
+	}{
+		{
+			name:     "same session",
+			caller:   Session{ID: "a"},
+			target:   Session{ID: "a"},
+			expected: true,
+		},
+		{
+			name:   "other session",
+			caller: Session{ID: "a"},
+			target: Session{ID: "b"},
+		},
+		{
+			name:     "same user",
+			caller:   Session{ID: "a", User: "user"},
+			target:   Session{ID: "b", User: "user"},
+			expected: true,
+		},
+		{
+			name:   "other user",
+			caller: Session{ID: "a", User: "user"},
+			target: Session{ID: "b", User: "other"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.caller.CanTerminate(test.target))
+		})
+	}
+}
+
+func TestIDFrom(t *testing.T) {
+	assert.Equal(t, "", IDFrom(context.Background()))
+	assert.Equal(t, "id", IDFrom(WithID(context.Background(), "id")))
+}
+
+func TestUserFromRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		headers  map[string]string
+		expected string
+	}{
+		{
+			name: "no proxy",
+		},
+		{
+			name:     "forwarded user",
+			headers:  map[string]string{"X-Forwarded-User": "user", "X-Forwarded-Email": "user@example.com"},
+			expected: "user",
+		},
+		{
+			name:     "remote user",
+			headers:  map[string]string{"X-Remote-User": "user"},
+			expected: "user",
+		},
+		{
+			name:     "forwarded email",
+			headers:  map[string]string{"X-Forwarded-Email": "user@example.com"},
+			expected: "user@example.com",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/api/v1/stream", nil)
+			for k, v := range test.headers {
+				r.Header.Set(k, v)
+			}
+
+			assert.Equal(t, test.expected, UserFromRequest(r))
+		})
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package slo
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/vmware/octant/internal/log"
+)
+
+const (
+	// DefaultSampleInterval is how often a Sampler observes workloads.
+	DefaultSampleInterval = 30 * time.Second
+)
+
+// Observation is the availability and restarts of a workload at the time it
+// was observed.
+type Observation struct {
+	UID       types.UID
+	Available bool
+	Restarts  int32
+}
+
+// ObserveFunc observes every workload which configures an availability
+// target. If it returns an error, observations may be incomplete.
+type ObserveFunc func(ctx context.Context) ([]Observation, error)
+
+// Sampler records observations of workloads in a History on an interval, so
+// samples are collected whether or not workloads are being viewed.
+type Sampler struct {
+	history  *History
+	observe  ObserveFunc
+	interval time.Duration
+}
+
+// NewSampler creates an instance of Sampler.
+func NewSampler(history *History, observe ObserveFunc, interval time.Duration) *Sampler {
+	return &Sampler{
+		history:  history,
+		observe:  observe,
+		interval: interval,
+	}
+}
+
+// Run samples workloads until the context is done.
+func (s *Sampler) Run(ctx context.Context) {
+	wait.UntilWithContext(ctx, s.sample, s.interval)
+}
+
+// sample records an observation of every workload. Workloads which were not
+// observed are dropped from the history, unless observing failed, in which
+// case a workload may have been missed rather than deleted.
+func (s *Sampler) sample(ctx context.Context) {
+	observations, err := s.observe(ctx)
+	if err != nil {
+		log.From(ctx).WithErr(err).Debugf("unable to observe all workloads for service level objectives")
+	}
+
+	uids := make(map[types.UID]bool)
+	for _, observation := range observations {
+		s.history.Record(observation.UID, observation.Available, observation.Restarts)
+		uids[observation.UID] = true
+	}
+
+	if err == nil {
+		s.history.Retain(uids)
+	}
+}
@@ -0,0 +1,46 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package slo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSampler_sample(t *testing.T) {
+	h := NewHistory()
+	h.Record("deleted", true, 0)
+
+	observations := []Observation{
+		{UID: "a", Available: true, Restarts: 1},
+		{UID: "b", Available: false},
+	}
+	var observeErr error
+	observe := func(ctx context.Context) ([]Observation, error) {
+		return observations, observeErr
+	}
+
+	s := NewSampler(h, observe, time.Minute)
+	ctx := context.Background()
+
+	observeErr = errors.New("forbidden")
+	s.sample(ctx)
+
+	assert.Len(t, h.Samples("deleted"), 1, "workloads are kept when observing fails")
+	assert.Len(t, h.Samples("a"), 1)
+
+	observeErr = nil
+	s.sample(ctx)
+
+	assert.Empty(t, h.Samples("deleted"))
+	assert.Len(t, h.Samples("a"), 2)
+	assert.Equal(t, int32(1), h.Samples("a")[1].Restarts)
+	assert.False(t, h.Samples("b")[1].Available)
+}
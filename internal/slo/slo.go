@@ -0,0 +1,241 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package slo
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// AvailabilityAnnotation configures a workload's availability target as a percentage.
+	AvailabilityAnnotation = "octant.dev/slo-availability"
+	// WindowAnnotation configures the window a workload's availability is measured over.
+	WindowAnnotation = "octant.dev/slo-window"
+
+	// DefaultWindow is the window used when a workload does not configure one.
+	DefaultWindow = time.Hour
+	// DefaultRetention is how long a History keeps samples.
+	DefaultRetention = 24 * time.Hour
+)
+
+// Target is an availability target measured over a window.
+type Target struct {
+	// Availability is the target availability as a percentage.
+	Availability float64
+	// Window is the duration availability is measured over.
+	Window time.Duration
+}
+
+// TargetFromAnnotations creates a Target from workload annotations. It
+// returns false if the workload does not configure an availability target.
+func TargetFromAnnotations(annotations map[string]string) (Target, bool, error) {
+	value, ok := annotations[AvailabilityAnnotation]
+	if !ok {
+		return Target{}, false, nil
+	}
+
+	availability, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(value), "%"), 64)
+	if err != nil {
+		return Target{}, false, errors.Wrapf(err, "parse %s", AvailabilityAnnotation)
+	}
+
+	if availability <= 0 || availability > 100 {
+		return Target{}, false, errors.Errorf("%s must be greater than 0 and at most 100", AvailabilityAnnotation)
+	}
+
+	target := Target{
+		Availability: availability,
+		Window:       DefaultWindow,
+	}
+
+	if value, ok := annotations[WindowAnnotation]; ok {
+		window, err := time.ParseDuration(strings.TrimSpace(value))
+		if err != nil {
+			return Target{}, false, errors.Wrapf(err, "parse %s", WindowAnnotation)
+		}
+
+		if window <= 0 {
+			return Target{}, false, errors.Errorf("%s must be positive", WindowAnnotation)
+		}
+
+		target.Window = window
+	}
+
+	return target, true, nil
+}
+
+// Sample is a point in time observation of a workload.
+type Sample struct {
+	Timestamp time.Time
+	Available bool
+	// Restarts is the cumulative container restart count of the workload's pods.
+	Restarts int32
+}
+
+// Report describes how a workload is performing against its target.
+type Report struct {
+	Target Target
+	// Availability is the measured availability as a percentage.
+	Availability float64
+	// Restarts is the number of container restarts observed within the window.
+	Restarts int32
+	// Samples is the number of samples within the window.
+	Samples int
+	// Observed is the duration of the window covered by samples.
+	Observed time.Duration
+}
+
+// HasData returns true if there are enough samples to measure availability.
+func (r Report) HasData() bool {
+	return r.Samples > 1
+}
+
+// IsMet returns true if the measured availability meets the target.
+func (r Report) IsMet() bool {
+	return r.Availability >= r.Target.Availability
+}
+
+// HistoryOption is an option for configuring History.
+type HistoryOption func(h *History)
+
+// WithClock configures the clock History uses.
+func WithClock(clock func() time.Time) HistoryOption {
+	return func(h *History) {
+		h.clock = clock
+	}
+}
+
+// WithRetention configures how long History keeps samples.
+func WithRetention(retention time.Duration) HistoryOption {
+	return func(h *History) {
+		h.retention = retention
+	}
+}
+
+// History records samples for workloads.
+type History struct {
+	clock     func() time.Time
+	retention time.Duration
+
+	mu      sync.Mutex
+	samples map[types.UID][]Sample
+}
+
+// NewHistory creates an instance of History.
+func NewHistory(options ...HistoryOption) *History {
+	h := &History{
+		clock:     time.Now,
+		retention: DefaultRetention,
+		samples:   make(map[types.UID][]Sample),
+	}
+
+	for _, option := range options {
+		option(h)
+	}
+
+	return h
+}
+
+// Record records a sample for a workload. The sample is timestamped by the history's clock.
+func (h *History) Record(uid types.UID, available bool, restarts int32) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.clock()
+
+	samples := append(h.samples[uid], Sample{
+		Timestamp: now,
+		Available: available,
+		Restarts:  restarts,
+	})
+
+	cutoff := now.Add(-h.retention)
+	i := 0
+	for i < len(samples) && samples[i].Timestamp.Before(cutoff) {
+		i++
+	}
+
+	h.samples[uid] = samples[i:]
+}
+
+// Samples returns the samples recorded for a workload.
+func (h *History) Samples(uid types.UID) []Sample {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	samples := make([]Sample, len(h.samples[uid]))
+	copy(samples, h.samples[uid])
+	return samples
+}
+
+// Report creates a report for a workload for a target. Availability is
+// weighted by the time between samples: the interval following a sample is
+// counted as available if that sample was available.
+func (h *History) Report(uid types.UID, target Target) Report {
+	now := h.clock()
+	cutoff := now.Add(-target.Window)
+
+	var samples []Sample
+	for _, sample := range h.Samples(uid) {
+		if !sample.Timestamp.Before(cutoff) {
+			samples = append(samples, sample)
+		}
+	}
+
+	report := Report{
+		Target:       target,
+		Availability: 100,
+		Samples:      len(samples),
+	}
+
+	if len(samples) == 0 {
+		return report
+	}
+
+	var available, total time.Duration
+	for i := 0; i < len(samples)-1; i++ {
+		interval := samples[i+1].Timestamp.Sub(samples[i].Timestamp)
+		total += interval
+		if samples[i].Available {
+			available += interval
+		}
+	}
+
+	report.Observed = total
+
+	switch {
+	case total > 0:
+		report.Availability = 100 * float64(available) / float64(total)
+	case !samples[len(samples)-1].Available:
+		report.Availability = 0
+	}
+
+	restarts := samples[len(samples)-1].Restarts - samples[0].Restarts
+	if restarts > 0 {
+		report.Restarts = restarts
+	}
+
+	return report
+}
+
+// Retain drops the samples of workloads which are not in uids, e.g. because
+// they were deleted.
+func (h *History) Retain(uids map[types.UID]bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for uid := range h.samples {
+		if !uids[uid] {
+			delete(h.samples, uid)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package slo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestTargetFromAnnotations(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expected    Target
+		isFound     bool
+		isErr       bool
+	}{
+		{
+			name:        "not configured",
+			annotations: map[string]string{},
+		},
+		{
+			name:        "availability with default window",
+			annotations: map[string]string{AvailabilityAnnotation: "99.5"},
+			expected:    Target{Availability: 99.5, Window: DefaultWindow},
+			isFound:     true,
+		},
+		{
+			name: "availability and window",
+			annotations: map[string]string{
+				AvailabilityAnnotation: "99%",
+				WindowAnnotation:       "30m",
+			},
+			expected: Target{Availability: 99, Window: 30 * time.Minute},
+			isFound:  true,
+		},
+		{
+			name:        "invalid availability",
+			annotations: map[string]string{AvailabilityAnnotation: "high"},
+			isErr:       true,
+		},
+		{
+			name:        "availability out of range",
+			annotations: map[string]string{AvailabilityAnnotation: "101"},
+			isErr:       true,
+		},
+		{
+			name: "invalid window",
+			annotations: map[string]string{
+				AvailabilityAnnotation: "99",
+				WindowAnnotation:       "-1h",
+			},
+			isErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, isFound, err := TargetFromAnnotations(test.annotations)
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.isFound, isFound)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func TestHistory_Report(t *testing.T) {
+	now := time.Unix(1548424410, 0)
+	clock := func() time.Time { return now }
+
+	h := NewHistory(WithClock(clock))
+
+	record := func(at time.Duration, available bool, restarts int32) {
+		now = time.Unix(1548424410, 0).Add(at)
+		h.Record("uid", available, restarts)
+	}
+
+	record(0, true, 1)
+	record(30*time.Minute, false, 2)
+	record(40*time.Minute, true, 4)
+	record(60*time.Minute, true, 4)
+
+	target := Target{Availability: 90, Window: time.Hour}
+	got := h.Report("uid", target)
+
+	expected := Report{
+		Target:       target,
+		Availability: 100 * float64(50) / float64(60),
+		Restarts:     3,
+		Samples:      4,
+		Observed:     time.Hour,
+	}
+
+	assert.Equal(t, expected, got)
+	assert.True(t, got.HasData())
+	assert.False(t, got.IsMet())
+}
+
+func TestHistory_Report_no_data(t *testing.T) {
+	h := NewHistory()
+	h.Record("uid", true, 0)
+
+	got := h.Report("uid", Target{Availability: 99, Window: time.Hour})
+	assert.False(t, got.HasData())
+}
+
+func TestHistory_Record_retention(t *testing.T) {
+	now := time.Unix(1548424410, 0)
+	clock := func() time.Time { return now }
+
+	h := NewHistory(WithClock(clock), WithRetention(time.Hour))
+
+	h.Record("uid", true, 0)
+	now = now.Add(2 * time.Hour)
+	h.Record("uid", false, 0)
+
+	expected := []Sample{
+		{Timestamp: now, Available: false},
+	}
+
+	assert.Equal(t, expected, h.Samples("uid"))
+}
+
+func TestHistory_Retain(t *testing.T) {
+	h := NewHistory()
+	h.Record("live", true, 0)
+	h.Record("deleted", true, 0)
+
+	h.Retain(map[types.UID]bool{"live": true})
+
+	assert.Len(t, h.Samples("live"), 1)
+	assert.Empty(t, h.Samples("deleted"))
+}
@@ -10,6 +10,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	extv1beta1 "k8s.io/api/extensions/v1beta1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -190,6 +191,17 @@ func CreateJob(name string) *batchv1.Job {
 	}
 }
 
+// CreateNamespace creates a namespace
+func CreateNamespace(name string) *corev1.Namespace {
+	return &corev1.Namespace{
+		TypeMeta:   genTypeMeta(gvk.Namespace),
+		ObjectMeta: genObjectMeta(name, false),
+		Status: corev1.NamespaceStatus{
+			Phase: corev1.NamespaceActive,
+		},
+	}
+}
+
 func CreateNode(name string) *corev1.Node {
 	return &corev1.Node{
 		TypeMeta:   genTypeMeta(gvk.Node),
@@ -300,6 +312,50 @@ func CreatePersistentVolumeClaim(name string) *corev1.PersistentVolumeClaim {
 	}
 }
 
+// CreatePersistentVolume creates a persistent volume bound to the claim
+// created by CreatePersistentVolumeClaim.
+func CreatePersistentVolume(name string) *corev1.PersistentVolume {
+	file := corev1.PersistentVolumeFilesystem
+
+	return &corev1.PersistentVolume{
+		TypeMeta:   genTypeMeta(gvk.PersistentVolume),
+		ObjectMeta: genObjectMeta(name, false),
+		Spec: corev1.PersistentVolumeSpec{
+			Capacity: corev1.ResourceList{
+				corev1.ResourceName(corev1.ResourceStorage): resource.MustParse("10Gi"),
+			},
+			AccessModes: []corev1.PersistentVolumeAccessMode{
+				corev1.ReadWriteOnce,
+			},
+			ClaimRef: &corev1.ObjectReference{
+				Kind:      "PersistentVolumeClaim",
+				Namespace: DefaultNamespace,
+				Name:      "pvc",
+			},
+			PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimRetain,
+			StorageClassName:              "manual",
+			VolumeMode:                    &file,
+		},
+		Status: corev1.PersistentVolumeStatus{
+			Phase: corev1.VolumeBound,
+		},
+	}
+}
+
+// CreateStorageClass creates a storage class
+func CreateStorageClass(name string) *storagev1.StorageClass {
+	reclaimPolicy := corev1.PersistentVolumeReclaimDelete
+	bindingMode := storagev1.VolumeBindingImmediate
+
+	return &storagev1.StorageClass{
+		TypeMeta:          genTypeMeta(gvk.StorageClass),
+		ObjectMeta:        genObjectMeta(name, false),
+		Provisioner:       "kubernetes.io/no-provisioner",
+		ReclaimPolicy:     &reclaimPolicy,
+		VolumeBindingMode: &bindingMode,
+	}
+}
+
 // CreateRole creates a role.
 func CreateRole(name string) *rbacv1.Role {
 	return &rbacv1.Role{
@@ -0,0 +1,143 @@
+package kubernetes
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	eventsv1beta1 "k8s.io/api/events/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/pkg/store"
+)
+
+var (
+	// CoreEventKey is the store key for core/v1 events.
+	CoreEventKey = store.Key{APIVersion: "v1", Kind: "Event"}
+	// EventsAPIEventKey is the store key for events.k8s.io events.
+	EventsAPIEventKey = store.Key{APIVersion: "events.k8s.io/v1beta1", Kind: "Event"}
+)
+
+// ListEvents lists events in a namespace from both the core/v1 and the
+// events.k8s.io APIs. Both APIs can report the same event, so events are
+// deduplicated by UID, preferring the core/v1 representation. If
+// events.k8s.io events can't be listed, e.g. the cluster does not serve the
+// API or the user can't list them, only core/v1 events are returned.
+func ListEvents(ctx context.Context, o store.Store, namespace string) ([]corev1.Event, error) {
+	if o == nil {
+		return nil, errors.New("object store is nil")
+	}
+
+	coreKey := CoreEventKey
+	coreKey.Namespace = namespace
+
+	coreList, _, err := o.List(ctx, coreKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list events for key %s", coreKey)
+	}
+
+	var events []corev1.Event
+	seen := make(map[types.UID]bool)
+
+	for i := range coreList.Items {
+		event := corev1.Event{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(coreList.Items[i].Object, &event); err != nil {
+			return nil, errors.Wrap(err, "convert unstructured event")
+		}
+
+		if event.UID != "" {
+			seen[event.UID] = true
+		}
+
+		events = append(events, NormalizeEvent(event))
+	}
+
+	eventsKey := EventsAPIEventKey
+	eventsKey.Namespace = namespace
+
+	eventsList, _, err := o.List(ctx, eventsKey)
+	if err != nil {
+		log.From(ctx).WithErr(err).Debugf("unable to list events for key %s; showing core events", eventsKey)
+		return events, nil
+	}
+
+	for i := range eventsList.Items {
+		event := eventsv1beta1.Event{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(eventsList.Items[i].Object, &event); err != nil {
+			return nil, errors.Wrap(err, "convert unstructured event")
+		}
+
+		if event.UID != "" && seen[event.UID] {
+			continue
+		}
+
+		events = append(events, ConvertEvent(event))
+	}
+
+	return events, nil
+}
+
+// ConvertEvent converts an events.k8s.io event to a core/v1 event.
+func ConvertEvent(in eventsv1beta1.Event) corev1.Event {
+	out := corev1.Event{
+		ObjectMeta:          in.ObjectMeta,
+		InvolvedObject:      in.Regarding,
+		Related:             in.Related,
+		Reason:              in.Reason,
+		Message:             in.Note,
+		Type:                in.Type,
+		Source:              in.DeprecatedSource,
+		FirstTimestamp:      in.DeprecatedFirstTimestamp,
+		LastTimestamp:       in.DeprecatedLastTimestamp,
+		Count:               in.DeprecatedCount,
+		EventTime:           in.EventTime,
+		Action:              in.Action,
+		ReportingController: in.ReportingController,
+		ReportingInstance:   in.ReportingInstance,
+	}
+	out.APIVersion = "v1"
+	out.Kind = "Event"
+
+	if in.Series != nil {
+		out.Series = &corev1.EventSeries{
+			Count:            in.Series.Count,
+			LastObservedTime: in.Series.LastObservedTime,
+			State:            corev1.EventSeriesState(in.Series.State),
+		}
+	}
+
+	if out.Source.Component == "" {
+		out.Source.Component = in.ReportingController
+	}
+
+	return NormalizeEvent(out)
+}
+
+// NormalizeEvent fills in the deprecated count and timestamp fields for
+// events which were recorded using the events.k8s.io API and only carry
+// an event time and series.
+func NormalizeEvent(event corev1.Event) corev1.Event {
+	if event.FirstTimestamp.IsZero() && !event.EventTime.IsZero() {
+		event.FirstTimestamp.Time = event.EventTime.Time
+	}
+
+	if event.LastTimestamp.IsZero() {
+		switch {
+		case event.Series != nil && !event.Series.LastObservedTime.IsZero():
+			event.LastTimestamp.Time = event.Series.LastObservedTime.Time
+		case !event.EventTime.IsZero():
+			event.LastTimestamp.Time = event.EventTime.Time
+		}
+	}
+
+	if event.Count == 0 && !event.EventTime.IsZero() {
+		event.Count = 1
+		if event.Series != nil && event.Series.Count > 0 {
+			event.Count = event.Series.Count
+		}
+	}
+
+	return event
+}
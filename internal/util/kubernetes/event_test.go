@@ -0,0 +1,172 @@
+package kubernetes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	eventsv1beta1 "k8s.io/api/events/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/store"
+	storefake "github.com/vmware/octant/pkg/store/fake"
+)
+
+func Test_ListEvents(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	coreEvent := &corev1.Event{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Event"},
+		ObjectMeta: metav1.ObjectMeta{Name: "core", Namespace: "default", UID: "1"},
+		Message:    "core",
+	}
+
+	duplicateEvent := &eventsv1beta1.Event{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "events.k8s.io/v1beta1", Kind: "Event"},
+		ObjectMeta: metav1.ObjectMeta{Name: "core", Namespace: "default", UID: "1"},
+		Note:       "duplicate",
+	}
+
+	eventsAPIEvent := &eventsv1beta1.Event{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "events.k8s.io/v1beta1", Kind: "Event"},
+		ObjectMeta: metav1.ObjectMeta{Name: "events-api", Namespace: "default", UID: "2"},
+		Note:       "events api",
+	}
+
+	o := storefake.NewMockStore(controller)
+	o.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "default", APIVersion: "v1", Kind: "Event"}).
+		Return(testutil.ToUnstructuredList(t, coreEvent), false, nil)
+	o.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "default", APIVersion: "events.k8s.io/v1beta1", Kind: "Event"}).
+		Return(testutil.ToUnstructuredList(t, duplicateEvent, eventsAPIEvent), false, nil)
+
+	ctx := context.Background()
+	got, err := ListEvents(ctx, o, "default")
+	require.NoError(t, err)
+
+	var messages []string
+	for _, event := range got {
+		messages = append(messages, event.Message)
+	}
+
+	assert.Equal(t, []string{"core", "events api"}, messages)
+}
+
+func Test_ListEvents_events_api_error(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	coreEvent := &corev1.Event{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Event"},
+		ObjectMeta: metav1.ObjectMeta{Name: "core", Namespace: "default", UID: "1"},
+		Message:    "core",
+	}
+
+	o := storefake.NewMockStore(controller)
+	o.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "default", APIVersion: "v1", Kind: "Event"}).
+		Return(testutil.ToUnstructuredList(t, coreEvent), false, nil)
+	o.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "default", APIVersion: "events.k8s.io/v1beta1", Kind: "Event"}).
+		Return(nil, false, errors.New("forbidden"))
+
+	ctx := context.Background()
+	got, err := ListEvents(ctx, o, "default")
+	require.NoError(t, err)
+
+	require.Len(t, got, 1)
+	assert.Equal(t, "core", got[0].Message)
+}
+
+func Test_ConvertEvent(t *testing.T) {
+	eventTime := metav1.MicroTime{Time: time.Unix(1548424410, 0)}
+	lastObserved := metav1.MicroTime{Time: time.Unix(1548424420, 0)}
+
+	regarding := corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Name: "pod", Namespace: "default"}
+
+	in := eventsv1beta1.Event{
+		ObjectMeta:          metav1.ObjectMeta{Name: "event", Namespace: "default"},
+		EventTime:           eventTime,
+		Series:              &eventsv1beta1.EventSeries{Count: 3, LastObservedTime: lastObserved},
+		ReportingController: "controller",
+		ReportingInstance:   "instance",
+		Action:              "action",
+		Reason:              "reason",
+		Regarding:           regarding,
+		Note:                "note",
+		Type:                corev1.EventTypeWarning,
+	}
+
+	got := ConvertEvent(in)
+
+	expected := corev1.Event{
+		TypeMeta:       metav1.TypeMeta{APIVersion: "v1", Kind: "Event"},
+		ObjectMeta:     in.ObjectMeta,
+		InvolvedObject: regarding,
+		Reason:         "reason",
+		Message:        "note",
+		Source:         corev1.EventSource{Component: "controller"},
+		FirstTimestamp: metav1.Time{Time: eventTime.Time},
+		LastTimestamp:  metav1.Time{Time: lastObserved.Time},
+		Count:          3,
+		Type:           corev1.EventTypeWarning,
+		EventTime:      eventTime,
+		Series: &corev1.EventSeries{
+			Count:            3,
+			LastObservedTime: lastObserved,
+		},
+		Action:              "action",
+		ReportingController: "controller",
+		ReportingInstance:   "instance",
+	}
+
+	assert.Equal(t, expected, got)
+}
+
+func Test_NormalizeEvent(t *testing.T) {
+	eventTime := metav1.MicroTime{Time: time.Unix(1548424410, 0)}
+
+	tests := []struct {
+		name     string
+		event    corev1.Event
+		expected corev1.Event
+	}{
+		{
+			name: "core event",
+			event: corev1.Event{
+				Count:          2,
+				FirstTimestamp: metav1.Time{Time: time.Unix(1, 0)},
+				LastTimestamp:  metav1.Time{Time: time.Unix(2, 0)},
+			},
+			expected: corev1.Event{
+				Count:          2,
+				FirstTimestamp: metav1.Time{Time: time.Unix(1, 0)},
+				LastTimestamp:  metav1.Time{Time: time.Unix(2, 0)},
+			},
+		},
+		{
+			name:  "events api singleton event",
+			event: corev1.Event{EventTime: eventTime},
+			expected: corev1.Event{
+				Count:          1,
+				EventTime:      eventTime,
+				FirstTimestamp: metav1.Time{Time: eventTime.Time},
+				LastTimestamp:  metav1.Time{Time: eventTime.Time},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, NormalizeEvent(test.event))
+		})
+	}
+}
@@ -7,6 +7,7 @@ package action
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -42,6 +43,8 @@ type Alert struct {
 	Message string `json:"message"`
 	// Expiration is the time the alert expires.
 	Expiration *time.Time `json:"expiration,omitempty"`
+	// Link is an optional path to the object the alert is about.
+	Link string `json:"link,omitempty"`
 }
 
 // CreateAlert creates an alert with optional expiration. If the expireAt is < 1
@@ -73,6 +76,12 @@ type Dispatcher interface {
 	Handle(ctx context.Context, alerter Alerter, payload Payload) error
 }
 
+// PayloadValidator is a Dispatcher which checks payloads before they are
+// handled, e.g. against the validation rules of the form which sent them.
+type PayloadValidator interface {
+	ValidatePayload(payload Payload) error
+}
+
 // Dispatchers is a slice of Dispatcher.
 type Dispatchers []Dispatcher
 
@@ -81,12 +90,32 @@ func (d Dispatchers) ToActionPaths() map[string]DispatcherFunc {
 	m := make(map[string]DispatcherFunc)
 
 	for i := range d {
-		m[d[i].ActionName()] = d[i].Handle
+		m[d[i].ActionName()] = ToDispatcherFunc(d[i])
 	}
 
 	return m
 }
 
+// ToDispatcherFunc converts a Dispatcher to a DispatcherFunc. If the
+// Dispatcher is a PayloadValidator, payloads which are not valid are not
+// handled. An error alert is sent instead.
+func ToDispatcherFunc(d Dispatcher) DispatcherFunc {
+	validator, ok := d.(PayloadValidator)
+	if !ok {
+		return d.Handle
+	}
+
+	return func(ctx context.Context, alerter Alerter, payload Payload) error {
+		if err := validator.ValidatePayload(payload); err != nil {
+			message := fmt.Sprintf("Invalid input: %s", err)
+			alerter.SendAlert(CreateAlert(AlertTypeError, message, DefaultAlertExpiration))
+			return nil
+		}
+
+		return d.Handle(ctx, alerter, payload)
+	}
+}
+
 // Manager manages actions.
 type Manager struct {
 	logger     log.Logger
@@ -113,9 +142,19 @@ func (m *Manager) Register(actionPath string, actionFunc DispatcherFunc) error {
 	return nil
 }
 
+// Unregister removes the dispatcher function for an action path.
+func (m *Manager) Unregister(actionPath string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.dispatches, actionPath)
+}
+
 // Dispatch dispatches a payload to a path.
 func (m *Manager) Dispatch(ctx context.Context, alerter Alerter, actionPath string, payload Payload) error {
+	m.mu.Lock()
 	f, ok := m.dispatches[actionPath]
+	m.mu.Unlock()
 	if !ok {
 		return &NotFoundError{Path: actionPath}
 
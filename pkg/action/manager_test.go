@@ -48,3 +48,66 @@ func TestManager(t *testing.T) {
 
 	assert.True(t, payloadRan)
 }
+
+type validatingDispatcher struct {
+	handled bool
+}
+
+var _ action.PayloadValidator = (*validatingDispatcher)(nil)
+
+func (d *validatingDispatcher) ActionName() string {
+	return "validating"
+}
+
+func (d *validatingDispatcher) Handle(context.Context, action.Alerter, action.Payload) error {
+	d.handled = true
+	return nil
+}
+
+func (d *validatingDispatcher) ValidatePayload(payload action.Payload) error {
+	_, err := payload.String("required")
+	return err
+}
+
+func TestDispatchers_ToActionPaths_validation(t *testing.T) {
+	tests := []struct {
+		name        string
+		payload     action.Payload
+		wantHandled bool
+	}{
+		{
+			name:        "valid payload",
+			payload:     action.Payload{"required": "value"},
+			wantHandled: true,
+		},
+		{
+			name:    "invalid payload",
+			payload: action.Payload{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			alerter := fake.NewMockAlerter(controller)
+			if !test.wantHandled {
+				alerter.EXPECT().
+					SendAlert(gomock.Any()).
+					DoAndReturn(func(alert action.Alert) {
+						assert.Equal(t, action.AlertTypeError, alert.Type)
+					})
+			}
+
+			d := &validatingDispatcher{}
+			paths := action.Dispatchers{d}.ToActionPaths()
+
+			fn, ok := paths[d.ActionName()]
+			require.True(t, ok)
+
+			require.NoError(t, fn(context.Background(), alerter, test.payload))
+			assert.Equal(t, test.wantHandled, d.handled)
+		})
+	}
+}
@@ -124,3 +124,15 @@ func (p Payload) Float64(key string) (float64, error) {
 		return 0, errors.Errorf("unable to handle type %T for %q; got %#v", p[key], key, v)
 	}
 }
+
+// Bool returns a bool from the payload.
+func (p Payload) Bool(key string) (bool, error) {
+	switch v := p[key].(type) {
+	case string:
+		return strconv.ParseBool(v)
+	case bool:
+		return v, nil
+	default:
+		return false, errors.Errorf("unable to handle type %T for %q; got %#v", p[key], key, v)
+	}
+}
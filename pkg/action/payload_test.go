@@ -235,3 +235,51 @@ func TestPayload_Uint16(t *testing.T) {
 		})
 	}
 }
+
+func TestPayload_Bool(t *testing.T) {
+	tests := []struct {
+		name     string
+		payload  Payload
+		key      string
+		isErr    bool
+		expected bool
+	}{
+		{
+			name:     "source is string",
+			payload:  Payload{"bool": "true"},
+			key:      "bool",
+			expected: true,
+		},
+		{
+			name:     "source is bool",
+			payload:  Payload{"bool": true},
+			key:      "bool",
+			expected: true,
+		},
+		{
+			name:    "value is not string or bool",
+			payload: Payload{"bool": float64(1)},
+			key:     "bool",
+			isErr:   true,
+		},
+		{
+			name:    "key does not exist",
+			payload: Payload{},
+			key:     "invalid",
+			isErr:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.payload.Bool(test.key)
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
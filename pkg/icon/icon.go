@@ -18,30 +18,37 @@ const (
 	ClusterOverview                   = "objects"
 	ClusterOverviewClusterRole        = "c-role"
 	ClusterOverviewClusterRoleBinding = "crb"
+	ClusterOverviewNamespace          = "ns"
 	ClusterOverviewNode               = "node"
+	ClusterOverviewPersistentVolume   = "pv"
+	ClusterOverviewStorageClass       = "sc"
 
-	Configuration       = "cog"
-	ConfigurationPlugin = "plugin"
+	Configuration             = "cog"
+	ConfigurationPlugin       = "plugin"
+	ConfigurationSessions     = "users"
+	ConfigurationPortForwards = "connect"
+	ConfigurationAuditLog     = "history"
 
 	CustomResourceDefinition = "crd"
 
-	Overview                      = "objects"
-	OverviewConfigMap             = "cm"
-	OverviewCronJob               = "cronjob"
-	OverviewDaemonSet             = "ds"
-	OverviewDeployment            = "deploy"
-	OverviewIngress               = "ing"
-	OverviewJob                   = "job"
-	OverviewPersistentVolumeClaim = "pvc"
-	OverviewPod                   = "pod"
-	OverviewReplicaSet            = "rs"
-	OverviewReplicationController = "deploy"
-	OverviewRole                  = "role"
-	OverviewRoleBinding           = "rb"
-	OverviewSecret                = "secret"
-	OverviewService               = "svc"
-	OverviewServiceAccount        = "sa"
-	OverviewStatefulSet           = "sts"
+	Overview                        = "objects"
+	OverviewConfigMap               = "cm"
+	OverviewCronJob                 = "cronjob"
+	OverviewDaemonSet               = "ds"
+	OverviewDeployment              = "deploy"
+	OverviewHorizontalPodAutoscaler = "hpa"
+	OverviewIngress                 = "ing"
+	OverviewJob                     = "job"
+	OverviewPersistentVolumeClaim   = "pvc"
+	OverviewPod                     = "pod"
+	OverviewReplicaSet              = "rs"
+	OverviewReplicationController   = "deploy"
+	OverviewRole                    = "role"
+	OverviewRoleBinding             = "rb"
+	OverviewSecret                  = "secret"
+	OverviewService                 = "svc"
+	OverviewServiceAccount          = "sa"
+	OverviewStatefulSet             = "sts"
 )
 
 // LoadIcon loads an icon by name.
@@ -76,7 +76,9 @@ func New(title, navigationPath string, options ...Option) (*Navigation, error) {
 	return navigation, nil
 }
 
-// CRDEntries generates navigation entries for CRDs.
+// CRDEntries generates navigation entries for CRDs. There is an entry for
+// each API group with CRDs, and each group has an entry for every CRD
+// installed in it.
 func CRDEntries(ctx context.Context, prefix, namespace string, objectStore store.Store, wantsClusterScoped bool) ([]Navigation, bool, error) {
 	var list []Navigation
 
@@ -88,6 +90,9 @@ func CRDEntries(ctx context.Context, prefix, namespace string, objectStore store
 	}
 
 	sort.Slice(crds, func(i, j int) bool {
+		if crds[i].Spec.Group != crds[j].Spec.Group {
+			return crds[i].Spec.Group < crds[j].Spec.Group
+		}
 		return crds[i].Name < crds[j].Name
 	})
 
@@ -98,7 +103,7 @@ func CRDEntries(ctx context.Context, prefix, namespace string, objectStore store
 			continue
 		}
 
-		objects, isLoading, err := ListCustomResources(ctx, crds[i], namespace, objectStore, nil)
+		_, isLoading, err := ListCustomResources(ctx, crds[i], namespace, objectStore, nil)
 		if err != nil {
 			return nil, false, err
 		}
@@ -107,15 +112,28 @@ func CRDEntries(ctx context.Context, prefix, namespace string, objectStore store
 			loading = true
 		}
 
-		if len(objects.Items) > 0 {
-			navigation, err := New(crds[i].Name, path.Join(prefix, crds[i].Name),
-				SetNavigationIcon(icon.CustomResourceDefinition),
-				SetLoading(isLoading))
+		group := crds[i].Spec.Group
+		if len(list) == 0 || list[len(list)-1].Title != group {
+			groupNavigation, err := New(group, path.Join(prefix, "groups", group),
+				SetNavigationIcon(icon.CustomResourceDefinition))
 			if err != nil {
 				return nil, false, err
 			}
 
-			list = append(list, *navigation)
+			list = append(list, *groupNavigation)
+		}
+
+		navigation, err := New(crds[i].Spec.Names.Kind, path.Join(prefix, crds[i].Name),
+			SetNavigationIcon(icon.CustomResourceDefinition),
+			SetLoading(isLoading))
+		if err != nil {
+			return nil, false, err
+		}
+
+		groupNavigation := &list[len(list)-1]
+		groupNavigation.Children = append(groupNavigation.Children, *navigation)
+		if isLoading {
+			groupNavigation.Loading = true
 		}
 	}
 
@@ -63,8 +63,9 @@ func TestCRDEntries_namespace_scoped(t *testing.T) {
 	objectStore := fake.NewMockStore(controller)
 	clusterScopedCRD := createCRD("cluster-scoped", "ClusterScoped", true)
 	namespaceScopedCRD := createCRD("namespace-scoped", "NamespaceScoped", false)
+	emptyCRD := createCRD("empty", "Empty", false)
 
-	crds := testutil.ToUnstructuredList(t, clusterScopedCRD, namespaceScopedCRD)
+	crds := testutil.ToUnstructuredList(t, clusterScopedCRD, namespaceScopedCRD, emptyCRD)
 	crdKey := store.Key{
 		APIVersion: "apiextensions.k8s.io/v1beta1",
 		Kind:       "CustomResourceDefinition",
@@ -88,6 +89,15 @@ func TestCRDEntries_namespace_scoped(t *testing.T) {
 		List(gomock.Any(), crNamespaceKey).
 		Return(namespaceCRs, false, nil).
 		AnyTimes()
+	crEmptyKey := store.Key{
+		Namespace:  "default",
+		APIVersion: "testing/v1",
+		Kind:       "Empty",
+	}
+	objectStore.EXPECT().
+		List(gomock.Any(), crEmptyKey).
+		Return(&unstructured.UnstructuredList{}, false, nil).
+		AnyTimes()
 	crClusterKey := store.Key{
 		APIVersion: "testing/v1",
 		Kind:       "ClusterScoped",
@@ -103,7 +113,9 @@ func TestCRDEntries_namespace_scoped(t *testing.T) {
 	require.NoError(t, err)
 
 	namespaceExpected := []Navigation{
-		createNavForCR(t, namespaceCR.GetName()),
+		createNavForGroup(t, "testing",
+			createNavForCR(t, "Empty", emptyCRD.Name),
+			createNavForCR(t, "NamespaceScoped", namespaceScopedCRD.Name)),
 	}
 
 	assert.Equal(t, namespaceExpected, namespaceGot)
@@ -112,14 +124,23 @@ func TestCRDEntries_namespace_scoped(t *testing.T) {
 	require.NoError(t, err)
 
 	clusterExpected := []Navigation{
-		createNavForCR(t, clusterCR.GetName()),
+		createNavForGroup(t, "testing",
+			createNavForCR(t, "ClusterScoped", clusterScopedCRD.Name)),
 	}
 
 	assert.Equal(t, clusterExpected, clusterGot)
 }
 
-func createNavForCR(t *testing.T, name string) Navigation {
-	nav, err := New(name, path.Join("/prefix", name), SetNavigationIcon(icon.CustomResourceDefinition))
+func createNavForGroup(t *testing.T, group string, children ...Navigation) Navigation {
+	nav, err := New(group, path.Join("/prefix", "groups", group), SetNavigationIcon(icon.CustomResourceDefinition))
+	require.NoError(t, err)
+
+	nav.Children = children
+	return *nav
+}
+
+func createNavForCR(t *testing.T, kind, crdName string) Navigation {
+	nav, err := New(kind, path.Join("/prefix", crdName), SetNavigationIcon(icon.CustomResourceDefinition))
 	require.NoError(t, err)
 
 	return *nav
@@ -37,6 +37,24 @@ type Capabilities struct {
 	IsModule bool `json:",omitempty"`
 	// ActionNames is a list of action names this plugin handles
 	ActionNames []string `json:",omitempty"`
+	// Relationships are references between objects the plugin knows about,
+	// e.g. from an operator's custom resource to the secrets it uses.
+	Relationships []Relationship `json:",omitempty"`
+}
+
+// Relationship describes a reference from one kind of object to another
+// kind through a field holding the referenced objects' names. The
+// referenced objects are in the same namespace as the source object.
+type Relationship struct {
+	// Source is the kind holding the reference. The version is ignored so
+	// the relationship applies to every version of the kind.
+	Source schema.GroupVersionKind
+	// Target is the kind being referenced.
+	Target schema.GroupVersionKind
+	// Path is the field holding the names of the referenced objects, e.g.
+	// ".spec.secretName". A segment ending in "[]" visits every item in a
+	// list, e.g. ".spec.volumes[].secretName".
+	Path string
 }
 
 // HasPrinterSupport returns true if this plugin supports the supplied GVK.
@@ -46,6 +64,12 @@ func (c Capabilities) HasPrinterSupport(gvk schema.GroupVersionKind) bool {
 		includesGVK(gvk, c.SupportsPrinterItems)
 }
 
+// HasObjectStatusSupport returns true if this plugin contributes to the
+// status of objects with the supplied GVK.
+func (c Capabilities) HasObjectStatusSupport(gvk schema.GroupVersionKind) bool {
+	return includesGVK(gvk, c.SupportsObjectStatus)
+}
+
 // HasTabSupport returns true if this plugins supports creating a tab for
 // the supplied GVK.
 func (c Capabilities) HasTabSupport(gvk schema.GroupVersionKind) bool {
@@ -72,7 +96,9 @@ type TabResponse struct {
 
 // ObjectStatusResponse is an object status response from plugin.
 type ObjectStatusResponse struct {
-	// ObjectStatus is status of an object.
+	// ObjectStatus is status of an object. Its details are added to the
+	// status Octant computes for the object. If its status is set, it
+	// overrides the computed status.
 	ObjectStatus component.PodSummary
 }
 
@@ -81,6 +107,9 @@ type Metadata struct {
 	Name         string
 	Description  string
 	Capabilities Capabilities
+	// APIVersion is the plugin API version negotiated with the plugin when
+	// it registered.
+	APIVersion int
 }
 
 // Service is the interface that is exposed as a plugin. The plugin is required to implement this
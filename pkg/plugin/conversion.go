@@ -28,6 +28,7 @@ func convertToCapabilities(in *dashboard.RegisterResponse_Capabilities) Capabili
 		SupportsTab:           convertToGroupVersionKindList(in.SupportsTab),
 		IsModule:              in.IsModule,
 		ActionNames:           in.ActionNames,
+		Relationships:         convertToRelationships(in.Relationships),
 	}
 
 	return c
@@ -42,11 +43,46 @@ func convertFromCapabilities(in Capabilities) dashboard.RegisterResponse_Capabil
 		SupportsTab:           convertFromGroupVersionKindList(in.SupportsTab),
 		IsModule:              in.IsModule,
 		ActionNames:           in.ActionNames,
+		Relationships:         convertFromRelationships(in.Relationships),
 	}
 
 	return c
 }
 
+func convertToRelationships(in []*dashboard.RegisterResponse_Relationship) []Relationship {
+	var list []Relationship
+
+	for _, r := range in {
+		if r == nil || r.Source == nil || r.Target == nil {
+			continue
+		}
+
+		list = append(list, Relationship{
+			Source: convertToGroupVersionKind(*r.Source),
+			Target: convertToGroupVersionKind(*r.Target),
+			Path:   r.Path,
+		})
+	}
+
+	return list
+}
+
+func convertFromRelationships(in []Relationship) []*dashboard.RegisterResponse_Relationship {
+	var list []*dashboard.RegisterResponse_Relationship
+
+	for _, r := range in {
+		source := convertFromGroupVersionKind(r.Source)
+		target := convertFromGroupVersionKind(r.Target)
+		list = append(list, &dashboard.RegisterResponse_Relationship{
+			Source: &source,
+			Target: &target,
+			Path:   r.Path,
+		})
+	}
+
+	return list
+}
+
 func convertToGroupVersionKindList(in []*dashboard.RegisterResponse_GroupVersionKind) []schema.GroupVersionKind {
 	var list []schema.GroupVersionKind
 
@@ -148,13 +184,7 @@ func convertFromSummarySections(in []component.SummarySection) ([]*dashboard.Pri
 }
 
 func convertToSummarySection(in dashboard.PrintResponse_SummaryItem) (component.SummarySection, error) {
-	var typedObject component.TypedObject
-	err := json.Unmarshal(in.Component, &typedObject)
-	if err != nil {
-		return component.SummarySection{}, err
-	}
-
-	view, err := typedObject.ToComponent()
+	view, err := component.Unmarshal(in.Component)
 	if err != nil {
 		return component.SummarySection{}, err
 	}
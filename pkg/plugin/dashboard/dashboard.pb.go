@@ -346,6 +346,9 @@ func (m *NavigationResponse_Navigation) GetIconSource() string {
 
 type RegisterRequest struct {
 	DashboardAPIAddress  string   `protobuf:"bytes,1,opt,name=dashboardAPIAddress,proto3" json:"dashboardAPIAddress,omitempty"`
+	Config               []byte   `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	ApiVersion           uint32   `protobuf:"varint,3,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	MinApiVersion        uint32   `protobuf:"varint,4,opt,name=min_api_version,json=minApiVersion,proto3" json:"min_api_version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -383,10 +386,33 @@ func (m *RegisterRequest) GetDashboardAPIAddress() string {
 	return ""
 }
 
+func (m *RegisterRequest) GetConfig() []byte {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *RegisterRequest) GetApiVersion() uint32 {
+	if m != nil {
+		return m.ApiVersion
+	}
+	return 0
+}
+
+func (m *RegisterRequest) GetMinApiVersion() uint32 {
+	if m != nil {
+		return m.MinApiVersion
+	}
+	return 0
+}
+
 type RegisterResponse struct {
 	PluginName           string                         `protobuf:"bytes,1,opt,name=pluginName,proto3" json:"pluginName,omitempty"`
 	Description          string                         `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	Capabilities         *RegisterResponse_Capabilities `protobuf:"bytes,3,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	ApiVersion           uint32                         `protobuf:"varint,4,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	MinApiVersion        uint32                         `protobuf:"varint,5,opt,name=min_api_version,json=minApiVersion,proto3" json:"min_api_version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
 	XXX_unrecognized     []byte                         `json:"-"`
 	XXX_sizecache        int32                          `json:"-"`
@@ -438,6 +464,20 @@ func (m *RegisterResponse) GetCapabilities() *RegisterResponse_Capabilities {
 	return nil
 }
 
+func (m *RegisterResponse) GetApiVersion() uint32 {
+	if m != nil {
+		return m.ApiVersion
+	}
+	return 0
+}
+
+func (m *RegisterResponse) GetMinApiVersion() uint32 {
+	if m != nil {
+		return m.MinApiVersion
+	}
+	return 0
+}
+
 type RegisterResponse_GroupVersionKind struct {
 	Group                string   `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
 	Version              string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
@@ -501,6 +541,7 @@ type RegisterResponse_Capabilities struct {
 	SupportsTab           []*RegisterResponse_GroupVersionKind `protobuf:"bytes,5,rep,name=supportsTab,proto3" json:"supportsTab,omitempty"`
 	IsModule              bool                                 `protobuf:"varint,6,opt,name=isModule,proto3" json:"isModule,omitempty"`
 	ActionNames           []string                             `protobuf:"bytes,7,rep,name=action_names,json=actionNames,proto3" json:"action_names,omitempty"`
+	Relationships         []*RegisterResponse_Relationship     `protobuf:"bytes,8,rep,name=relationships,proto3" json:"relationships,omitempty"`
 	XXX_NoUnkeyedLiteral  struct{}                             `json:"-"`
 	XXX_unrecognized      []byte                               `json:"-"`
 	XXX_sizecache         int32                                `json:"-"`
@@ -580,6 +621,68 @@ func (m *RegisterResponse_Capabilities) GetActionNames() []string {
 	return nil
 }
 
+func (m *RegisterResponse_Capabilities) GetRelationships() []*RegisterResponse_Relationship {
+	if m != nil {
+		return m.Relationships
+	}
+	return nil
+}
+
+type RegisterResponse_Relationship struct {
+	Source               *RegisterResponse_GroupVersionKind `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Target               *RegisterResponse_GroupVersionKind `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Path                 string                             `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *RegisterResponse_Relationship) Reset()         { *m = RegisterResponse_Relationship{} }
+func (m *RegisterResponse_Relationship) String() string { return proto.CompactTextString(m) }
+func (*RegisterResponse_Relationship) ProtoMessage()    {}
+func (*RegisterResponse_Relationship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{8, 2}
+}
+
+func (m *RegisterResponse_Relationship) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterResponse_Relationship.Unmarshal(m, b)
+}
+func (m *RegisterResponse_Relationship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterResponse_Relationship.Marshal(b, m, deterministic)
+}
+func (m *RegisterResponse_Relationship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterResponse_Relationship.Merge(m, src)
+}
+func (m *RegisterResponse_Relationship) XXX_Size() int {
+	return xxx_messageInfo_RegisterResponse_Relationship.Size(m)
+}
+func (m *RegisterResponse_Relationship) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterResponse_Relationship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterResponse_Relationship proto.InternalMessageInfo
+
+func (m *RegisterResponse_Relationship) GetSource() *RegisterResponse_GroupVersionKind {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (m *RegisterResponse_Relationship) GetTarget() *RegisterResponse_GroupVersionKind {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *RegisterResponse_Relationship) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
 type ObjectRequest struct {
 	Object               []byte   `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -867,6 +970,7 @@ func init() {
 	proto.RegisterType((*RegisterResponse)(nil), "dashboard.RegisterResponse")
 	proto.RegisterType((*RegisterResponse_GroupVersionKind)(nil), "dashboard.RegisterResponse.GroupVersionKind")
 	proto.RegisterType((*RegisterResponse_Capabilities)(nil), "dashboard.RegisterResponse.Capabilities")
+	proto.RegisterType((*RegisterResponse_Relationship)(nil), "dashboard.RegisterResponse.Relationship")
 	proto.RegisterType((*ObjectRequest)(nil), "dashboard.ObjectRequest")
 	proto.RegisterType((*PrintResponse)(nil), "dashboard.PrintResponse")
 	proto.RegisterType((*PrintResponse_SummaryItem)(nil), "dashboard.PrintResponse.SummaryItem")
@@ -878,62 +982,69 @@ func init() {
 func init() { proto.RegisterFile("dashboard.proto", fileDescriptor_9b97678da3a35dfb) }
 
 var fileDescriptor_9b97678da3a35dfb = []byte{
-	// 871 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xef, 0x6e, 0xe3, 0x44,
-	0x10, 0x57, 0xfe, 0x27, 0x13, 0x1f, 0x09, 0xdb, 0x70, 0x18, 0xf7, 0xb8, 0x06, 0xeb, 0x24, 0x8a,
-	0x84, 0x02, 0x3a, 0x84, 0x84, 0xe0, 0x84, 0x1a, 0xa5, 0x88, 0x46, 0x40, 0x5b, 0xb9, 0xa5, 0x7c,
-	0x2c, 0x1b, 0x7b, 0x49, 0x16, 0x9c, 0x5d, 0xe3, 0x5d, 0x17, 0xe5, 0x59, 0xf8, 0xc4, 0x17, 0x24,
-	0x5e, 0x81, 0x07, 0xe2, 0x39, 0x90, 0xd7, 0x6b, 0x67, 0x9d, 0x26, 0x11, 0x2d, 0xf7, 0xcd, 0xf3,
-	0x9b, 0x99, 0xdf, 0xcc, 0xce, 0x9f, 0x5d, 0x43, 0x2f, 0xc0, 0x62, 0x31, 0xe3, 0x38, 0x0e, 0x46,
-	0x51, 0xcc, 0x25, 0x47, 0x9d, 0x02, 0x70, 0x5b, 0xd0, 0xf8, 0x6a, 0x19, 0xc9, 0x95, 0xfb, 0x02,
-	0xde, 0x98, 0x70, 0x26, 0x09, 0x93, 0x1e, 0xf9, 0x35, 0x21, 0x42, 0x22, 0x04, 0xf5, 0x08, 0xcb,
-	0x85, 0x5d, 0x19, 0x56, 0x8e, 0x3b, 0x9e, 0xfa, 0x76, 0x5f, 0x41, 0xaf, 0xb0, 0x12, 0x11, 0x67,
-	0x82, 0xa0, 0x0f, 0xa0, 0xef, 0x67, 0xd0, 0x6d, 0xac, 0x31, 0xe5, 0x62, 0x79, 0x3d, 0xbf, 0x6c,
-	0xea, 0x7e, 0x04, 0x07, 0x67, 0x98, 0x05, 0x21, 0x19, 0xfb, 0x92, 0x72, 0x96, 0x07, 0xb2, 0xa1,
-	0x15, 0xe1, 0x55, 0xc8, 0x71, 0xa0, 0x1d, 0x73, 0xd1, 0x7d, 0x0a, 0x83, 0xb2, 0x83, 0x26, 0x3a,
-	0x80, 0x37, 0xcf, 0xf1, 0x1d, 0x9d, 0x63, 0x83, 0xc6, 0xfd, 0xbd, 0x0a, 0xc8, 0x44, 0x75, 0x7e,
-	0x67, 0x00, 0xac, 0x40, 0x55, 0x80, 0xee, 0xcb, 0xe3, 0xd1, 0xba, 0x24, 0xf7, 0x5d, 0x4c, 0xc8,
-	0xf0, 0x75, 0xfe, 0xae, 0x00, 0xac, 0x55, 0x68, 0x00, 0x0d, 0x49, 0x65, 0x48, 0x74, 0x81, 0x32,
-	0xa1, 0xa8, 0x5a, 0x75, 0x5d, 0x35, 0x74, 0x0a, 0x6d, 0x7f, 0x41, 0xc3, 0x20, 0x26, 0xcc, 0xae,
-	0x0d, 0x6b, 0x0f, 0x4a, 0xa0, 0xf0, 0x44, 0x87, 0xd0, 0xa1, 0x3e, 0x67, 0xb7, 0x0c, 0x2f, 0x89,
-	0x5d, 0x57, 0xf4, 0xed, 0x14, 0x38, 0xc7, 0x4b, 0x82, 0x8e, 0xa0, 0xab, 0x94, 0x82, 0x27, 0xb1,
-	0x4f, 0xec, 0x86, 0x52, 0x43, 0x0a, 0x5d, 0x29, 0xc4, 0x9d, 0x40, 0xcf, 0x23, 0x73, 0x2a, 0x24,
-	0x89, 0xf3, 0xba, 0x7f, 0x0c, 0x07, 0x45, 0x16, 0xe3, 0xcb, 0xe9, 0x38, 0x08, 0x62, 0x22, 0x84,
-	0x3e, 0xce, 0x36, 0x95, 0xfb, 0x67, 0x13, 0xfa, 0x6b, 0x16, 0x5d, 0xe0, 0xe7, 0x00, 0x51, 0x98,
-	0xcc, 0xa9, 0x4a, 0x44, 0x7b, 0x1b, 0x08, 0x1a, 0x42, 0x37, 0x20, 0xc2, 0x8f, 0x69, 0xa4, 0x3a,
-	0x90, 0x15, 0xc6, 0x84, 0xd0, 0xb7, 0x60, 0xf9, 0x38, 0xc2, 0x33, 0x1a, 0x52, 0x49, 0x89, 0xb0,
-	0x6b, 0xf7, 0x9a, 0xb4, 0x19, 0x74, 0x34, 0x31, 0xec, 0xbd, 0x92, 0xb7, 0x73, 0x03, 0xfd, 0xaf,
-	0x63, 0x9e, 0x44, 0x37, 0x24, 0x16, 0x94, 0xb3, 0x6f, 0x28, 0x0b, 0xd2, 0x5e, 0xcd, 0x53, 0x2c,
-	0xef, 0x95, 0x12, 0xd2, 0xc1, 0xbb, 0xcb, 0x8c, 0x74, 0x56, 0xb9, 0x98, 0x76, 0xf1, 0x17, 0xca,
-	0x02, 0x95, 0x49, 0xc7, 0x53, 0xdf, 0xce, 0x5f, 0x75, 0xb0, 0xcc, 0xb0, 0x68, 0x06, 0x6f, 0x89,
-	0x24, 0x8a, 0x78, 0x2c, 0xc5, 0x65, 0x4c, 0x99, 0x24, 0xf1, 0x84, 0xb3, 0x9f, 0xe8, 0xdc, 0xae,
-	0xa8, 0x1e, 0x7f, 0xb8, 0x2f, 0xff, 0xcd, 0x0c, 0xbd, 0xed, 0x54, 0x5b, 0x62, 0x5c, 0x49, 0x2c,
-	0x13, 0x61, 0x57, 0x5f, 0x43, 0x8c, 0x8c, 0x0a, 0xfd, 0x08, 0x83, 0x0d, 0xc5, 0x54, 0x92, 0xa5,
-	0xd0, 0xa3, 0xfa, 0xb0, 0x10, 0x5b, 0x99, 0xcc, 0x08, 0x17, 0xb3, 0x9f, 0x89, 0x2f, 0xf5, 0x21,
-	0xea, 0xff, 0x27, 0x82, 0xc9, 0x84, 0xce, 0xa1, 0x9b, 0xe3, 0xd7, 0x78, 0x66, 0x37, 0x1e, 0x41,
-	0x6c, 0x12, 0x20, 0x07, 0xda, 0x54, 0x7c, 0xc7, 0x83, 0x24, 0x24, 0x76, 0x73, 0x58, 0x39, 0x6e,
-	0x7b, 0x85, 0x8c, 0xde, 0x03, 0x0b, 0xab, 0xfb, 0x48, 0xad, 0xa2, 0xb0, 0x5b, 0xc3, 0x5a, 0x3a,
-	0xd1, 0x19, 0x96, 0x8e, 0xbc, 0x70, 0xdf, 0x87, 0x27, 0x59, 0x7a, 0xf9, 0xae, 0x3d, 0x85, 0x26,
-	0x57, 0x80, 0xbe, 0xe2, 0xb4, 0xe4, 0xfe, 0x53, 0x81, 0x27, 0xaa, 0x54, 0xc5, 0x3a, 0xbd, 0x82,
-	0xa6, 0x6f, 0x8e, 0xd1, 0x0b, 0xe3, 0x10, 0x25, 0xcb, 0xd1, 0x55, 0xb2, 0x5c, 0xe2, 0x78, 0x95,
-	0x96, 0xd8, 0xd3, 0x3e, 0xa9, 0xb7, 0x30, 0x07, 0xe4, 0x3f, 0x7a, 0x67, 0x3e, 0xe9, 0x9a, 0x50,
-	0xdd, 0xfa, 0x34, 0xc9, 0x4c, 0x70, 0x26, 0xd0, 0x35, 0x8c, 0xd3, 0xa3, 0x2c, 0x08, 0x0e, 0x48,
-	0xac, 0x97, 0x49, 0x4b, 0xe8, 0x19, 0x74, 0x7c, 0xbe, 0x8c, 0x38, 0x23, 0x4c, 0xaa, 0x7d, 0xb2,
-	0xbc, 0x35, 0xe0, 0x7e, 0x09, 0x7d, 0x15, 0xff, 0x1a, 0xcf, 0x8a, 0xa3, 0x22, 0xa8, 0xb3, 0xf5,
-	0x9d, 0xa1, 0xbe, 0x53, 0xf6, 0x10, 0xaf, 0x78, 0x92, 0x53, 0x68, 0xc9, 0xfd, 0x1c, 0x06, 0x66,
-	0xc3, 0x0b, 0x0e, 0x17, 0x2c, 0x6e, 0x8e, 0x54, 0x56, 0xde, 0x12, 0xe6, 0x9e, 0x80, 0xf5, 0x03,
-	0x96, 0xfe, 0xc2, 0x78, 0x70, 0x7e, 0x4b, 0xe5, 0xe9, 0xa9, 0x0e, 0x9d, 0x8b, 0x46, 0x9b, 0xaa,
-	0x66, 0x9b, 0x5e, 0xfe, 0xd1, 0x80, 0xe6, 0xa5, 0xba, 0xd2, 0xd0, 0x09, 0xb4, 0xf4, 0x13, 0x88,
-	0xde, 0x31, 0x8a, 0x5b, 0x7e, 0x3c, 0x1d, 0x67, 0x9b, 0x4a, 0xa7, 0x7c, 0x01, 0x96, 0xf9, 0xaa,
-	0xa1, 0xe7, 0x86, 0xed, 0x96, 0xf7, 0xd1, 0x39, 0xda, 0xa9, 0xd7, 0x84, 0xd3, 0xd2, 0xbb, 0xf4,
-	0x6c, 0xc7, 0xdb, 0x92, 0x91, 0xbd, 0xbb, 0xf7, 0xe5, 0x41, 0x13, 0x68, 0xe7, 0x9b, 0x82, 0x9c,
-	0xad, 0xeb, 0x93, 0xd1, 0x1c, 0xee, 0x59, 0x2d, 0xf4, 0x05, 0x34, 0x54, 0xaf, 0x91, 0x6d, 0x58,
-	0x95, 0xf6, 0xc1, 0xb1, 0x77, 0xcd, 0x25, 0x9a, 0x82, 0x55, 0xda, 0xec, 0xdd, 0x1c, 0x47, 0xf7,
-	0x34, 0x1b, 0xb3, 0x31, 0x86, 0x76, 0x3e, 0x73, 0x7b, 0x68, 0x0e, 0x37, 0x53, 0x31, 0x47, 0xf4,
-	0x53, 0x68, 0xab, 0xd1, 0x19, 0x07, 0x01, 0x7a, 0xdb, 0x30, 0x34, 0xe7, 0xc9, 0xe9, 0x1b, 0x0a,
-	0xf5, 0x37, 0x85, 0x3e, 0x83, 0xae, 0xb2, 0xf8, 0x3e, 0x0a, 0xb0, 0x24, 0x8f, 0xf1, 0x3c, 0x25,
-	0x21, 0x79, 0x90, 0xe7, 0xac, 0xa9, 0x7e, 0xee, 0x3e, 0xf9, 0x37, 0x00, 0x00, 0xff, 0xff, 0x3a,
-	0xe2, 0x8f, 0x89, 0xef, 0x09, 0x00, 0x00,
+	// 984 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0xe3, 0xc4,
+	0x17, 0x57, 0x3e, 0x9b, 0x9c, 0x38, 0xff, 0xe4, 0x3f, 0x2d, 0xc5, 0xb8, 0xcb, 0x36, 0x58, 0x2b,
+	0x28, 0x12, 0x2a, 0x68, 0x11, 0x12, 0x82, 0x0a, 0x6d, 0x94, 0x22, 0x36, 0x02, 0xba, 0x95, 0xbb,
+	0x2c, 0x97, 0x65, 0x62, 0x0f, 0xc9, 0x80, 0x3d, 0x63, 0x3c, 0xe3, 0x45, 0x7d, 0x16, 0xae, 0x90,
+	0xb8, 0xe6, 0x01, 0x78, 0x20, 0xae, 0xb9, 0xe5, 0x0e, 0xcd, 0x78, 0xec, 0x8c, 0xd3, 0xb4, 0xda,
+	0x16, 0xee, 0x7c, 0xbe, 0x7e, 0xe7, 0x63, 0xce, 0x39, 0x3e, 0x30, 0x8a, 0xb0, 0x58, 0x2d, 0x38,
+	0xce, 0xa2, 0xe3, 0x34, 0xe3, 0x92, 0xa3, 0x7e, 0xc5, 0xf0, 0x77, 0xa0, 0xf3, 0x79, 0x92, 0xca,
+	0x2b, 0xff, 0x11, 0xfc, 0x6f, 0xc6, 0x99, 0x24, 0x4c, 0x06, 0xe4, 0xa7, 0x9c, 0x08, 0x89, 0x10,
+	0xb4, 0x53, 0x2c, 0x57, 0x6e, 0x63, 0xd2, 0x38, 0xea, 0x07, 0xfa, 0xdb, 0x3f, 0x81, 0x51, 0xa5,
+	0x25, 0x52, 0xce, 0x04, 0x41, 0xef, 0xc2, 0x38, 0x2c, 0x58, 0x97, 0x99, 0xe1, 0x69, 0x13, 0x27,
+	0x18, 0x85, 0x75, 0x55, 0xff, 0x7d, 0xd8, 0x7d, 0x8a, 0x59, 0x14, 0x93, 0x69, 0x28, 0x29, 0x67,
+	0xa5, 0x23, 0x17, 0x76, 0x52, 0x7c, 0x15, 0x73, 0x1c, 0x19, 0xc3, 0x92, 0xf4, 0xf7, 0x61, 0xaf,
+	0x6e, 0x60, 0x80, 0x76, 0xe1, 0xff, 0x67, 0xf8, 0x25, 0x5d, 0x62, 0x0b, 0xc6, 0xff, 0xa5, 0x09,
+	0xc8, 0xe6, 0x9a, 0xf8, 0x9e, 0x02, 0xb0, 0x8a, 0xab, 0x1d, 0x0c, 0x1e, 0x1f, 0x1d, 0xaf, 0x4b,
+	0x72, 0xdd, 0xc4, 0x66, 0x59, 0xb6, 0xde, 0x1f, 0x0d, 0x80, 0xb5, 0x08, 0xed, 0x41, 0x47, 0x52,
+	0x19, 0x13, 0x53, 0xa0, 0x82, 0xa8, 0xaa, 0xd6, 0x5c, 0x57, 0x0d, 0x9d, 0x42, 0x2f, 0x5c, 0xd1,
+	0x38, 0xca, 0x08, 0x73, 0x5b, 0x93, 0xd6, 0x9d, 0x02, 0xa8, 0x2c, 0xd1, 0x01, 0xf4, 0x69, 0xc8,
+	0xd9, 0x25, 0xc3, 0x09, 0x71, 0xdb, 0x1a, 0xbe, 0xa7, 0x18, 0x67, 0x38, 0x21, 0xe8, 0x10, 0x06,
+	0x5a, 0x28, 0x78, 0x9e, 0x85, 0xc4, 0xed, 0x68, 0x31, 0x28, 0xd6, 0x85, 0xe6, 0xf8, 0xbf, 0x35,
+	0x60, 0x14, 0x90, 0x25, 0x15, 0x92, 0x64, 0x65, 0xe1, 0x3f, 0x80, 0xdd, 0x2a, 0x8c, 0xe9, 0xf9,
+	0x7c, 0x1a, 0x45, 0x19, 0x11, 0xc2, 0xe4, 0xb3, 0x4d, 0x84, 0xf6, 0xa1, 0x1b, 0x72, 0xf6, 0x3d,
+	0x5d, 0xea, 0xfc, 0x9c, 0xc0, 0x50, 0xca, 0x3d, 0x4e, 0xe9, 0xe5, 0x4b, 0x92, 0x09, 0x55, 0xe5,
+	0xd6, 0xa4, 0x71, 0x34, 0x0c, 0x00, 0xa7, 0xf4, 0x45, 0xc1, 0x41, 0x6f, 0xc3, 0x28, 0xa1, 0xec,
+	0xd2, 0x56, 0x6a, 0x6b, 0xa5, 0x61, 0x42, 0xd9, 0xb4, 0xd2, 0xf3, 0xff, 0xea, 0xc1, 0x78, 0x1d,
+	0xa6, 0x79, 0xc2, 0x87, 0x00, 0x69, 0x9c, 0x2f, 0xa9, 0x4e, 0xd5, 0x84, 0x67, 0x71, 0xd0, 0x04,
+	0x06, 0x11, 0x11, 0x61, 0x46, 0x53, 0xfd, 0xc6, 0x45, 0xe9, 0x6d, 0x16, 0xfa, 0x0a, 0x9c, 0x10,
+	0xa7, 0x78, 0x41, 0x63, 0x2a, 0x29, 0x11, 0x3a, 0xc0, 0xfa, 0x2b, 0x6c, 0x3a, 0x3d, 0x9e, 0x59,
+	0xfa, 0x41, 0xcd, 0x7a, 0x33, 0xdb, 0xf6, 0xab, 0x64, 0xdb, 0xd9, 0x92, 0xad, 0xf7, 0x02, 0xc6,
+	0x5f, 0x64, 0x3c, 0x4f, 0x0d, 0xfd, 0x25, 0x65, 0x91, 0x6a, 0xab, 0xa5, 0xe2, 0x95, 0x6d, 0xa5,
+	0x09, 0x35, 0x23, 0x25, 0x52, 0x91, 0x5e, 0x49, 0xaa, 0x86, 0xfb, 0x91, 0xb2, 0x48, 0xa7, 0xd4,
+	0x0f, 0xf4, 0xb7, 0xf7, 0x77, 0x1b, 0x1c, 0x3b, 0x7e, 0xb4, 0x80, 0xd7, 0x44, 0x9e, 0xa6, 0x3c,
+	0x93, 0xe2, 0x3c, 0xa3, 0x4c, 0x92, 0x6c, 0x56, 0x3c, 0x63, 0x43, 0xb7, 0xe3, 0x7b, 0xb7, 0x15,
+	0x62, 0x33, 0xc2, 0x60, 0x3b, 0xd4, 0x16, 0x1f, 0x17, 0x12, 0xcb, 0x5c, 0xb8, 0xcd, 0xff, 0xc0,
+	0x47, 0x01, 0x85, 0xbe, 0x83, 0xbd, 0x0d, 0xc1, 0x5c, 0x92, 0x44, 0xb8, 0xad, 0x7b, 0xb8, 0xd8,
+	0x8a, 0x64, 0x7b, 0x78, 0xb6, 0xf8, 0x81, 0x84, 0xd2, 0x24, 0xd1, 0xfe, 0x37, 0x1e, 0x6c, 0x24,
+	0x74, 0x06, 0x83, 0x92, 0xff, 0x1c, 0x2f, 0xdc, 0xce, 0x3d, 0x80, 0x6d, 0x00, 0xe4, 0x41, 0x8f,
+	0x8a, 0xaf, 0x79, 0x94, 0xc7, 0xc4, 0xed, 0x4e, 0x1a, 0x47, 0xbd, 0xa0, 0xa2, 0xd1, 0x5b, 0xe0,
+	0x60, 0xbd, 0x3a, 0xf5, 0xd6, 0x10, 0xee, 0xce, 0xa4, 0xa5, 0x46, 0xa3, 0xe0, 0xa9, 0xd9, 0x51,
+	0xe1, 0x0c, 0x33, 0x12, 0xeb, 0x65, 0x23, 0x56, 0x34, 0x15, 0x6e, 0xef, 0xda, 0x86, 0xba, 0x16,
+	0x50, 0x60, 0x19, 0x04, 0x75, 0x73, 0xef, 0xf7, 0x06, 0x38, 0xb6, 0x1c, 0x9d, 0x42, 0xd7, 0x6c,
+	0xa5, 0x62, 0xf9, 0xde, 0x2d, 0x55, 0x63, 0xab, 0x50, 0x24, 0xce, 0x96, 0x44, 0xba, 0xcd, 0xfb,
+	0xa0, 0x14, 0xb6, 0xd5, 0x76, 0x6e, 0x59, 0xff, 0xb4, 0x77, 0x60, 0x58, 0xbc, 0x4f, 0xb9, 0x16,
+	0xf7, 0xa1, 0xcb, 0x35, 0xc3, 0xfc, 0x8e, 0x0c, 0xe5, 0xff, 0xd9, 0x80, 0xa1, 0xee, 0x95, 0x6a,
+	0x31, 0x9d, 0x54, 0xeb, 0xb0, 0x98, 0xa3, 0x47, 0x56, 0x50, 0x35, 0xcd, 0xe3, 0x8b, 0x3c, 0x49,
+	0x70, 0x76, 0xa5, 0x7a, 0xac, 0x5a, 0x9a, 0x27, 0xd0, 0x15, 0xf6, 0x84, 0xbc, 0xa2, 0x75, 0x61,
+	0xa3, 0xf6, 0x04, 0x35, 0xbd, 0xaf, 0x82, 0x2c, 0x08, 0x6f, 0x06, 0x03, 0x4b, 0x59, 0xa5, 0xb2,
+	0x22, 0x38, 0x22, 0x99, 0xd9, 0x26, 0x86, 0x42, 0x0f, 0xa0, 0x1f, 0xf2, 0x24, 0xe5, 0x8c, 0x30,
+	0x69, 0x56, 0xf9, 0x9a, 0xe1, 0x7f, 0x06, 0x63, 0xed, 0xff, 0x39, 0x5e, 0x54, 0xa9, 0x22, 0x68,
+	0xb3, 0xf5, 0xf6, 0xd5, 0xdf, 0x0a, 0x3d, 0xc6, 0x57, 0x3c, 0x2f, 0x21, 0x0c, 0xe5, 0x7f, 0x02,
+	0x7b, 0x76, 0xc7, 0x57, 0x18, 0x3e, 0x38, 0xdc, 0x9e, 0xa9, 0xa2, 0xbc, 0x35, 0x9e, 0xff, 0x04,
+	0x9c, 0x6f, 0xb1, 0x0c, 0x57, 0xd6, 0x71, 0xf0, 0xb3, 0xa2, 0xe7, 0xa7, 0xc6, 0x75, 0x49, 0x5a,
+	0xcf, 0xd4, 0xb4, 0x9f, 0xe9, 0xf1, 0xaf, 0x1d, 0xe8, 0x9e, 0xeb, 0x9f, 0x03, 0x7a, 0x02, 0x3b,
+	0xe6, 0x5c, 0x41, 0x6f, 0x58, 0xc5, 0xad, 0x1f, 0x3a, 0x9e, 0xb7, 0x4d, 0x64, 0x42, 0x7e, 0x06,
+	0x8e, 0x7d, 0x81, 0xa0, 0x87, 0x96, 0xee, 0x96, 0x5b, 0xc6, 0x3b, 0xbc, 0x51, 0x6e, 0x00, 0xe7,
+	0xb5, 0x1b, 0xe2, 0xc1, 0x0d, 0x77, 0x40, 0x01, 0xf6, 0xe6, 0xad, 0x57, 0x02, 0x9a, 0x41, 0xaf,
+	0xec, 0x7c, 0xe4, 0x6d, 0x1d, 0x87, 0x02, 0xe6, 0xe0, 0x96, 0x51, 0x41, 0x9f, 0x42, 0x47, 0xbf,
+	0x35, 0x72, 0x2d, 0xad, 0xda, 0x3c, 0x78, 0xee, 0x4d, 0x7d, 0x89, 0xe6, 0xe0, 0xd4, 0x56, 0xdb,
+	0xcd, 0x18, 0x87, 0xd7, 0x24, 0x1b, 0xbd, 0x31, 0x85, 0x5e, 0xd9, 0x73, 0xb7, 0xc0, 0x1c, 0x6c,
+	0x86, 0x62, 0xb7, 0xe8, 0x47, 0xd0, 0xd3, 0xad, 0x33, 0x8d, 0x22, 0xf4, 0xba, 0xa5, 0x68, 0xf7,
+	0x93, 0x37, 0xb6, 0x04, 0xfa, 0xf2, 0x45, 0x1f, 0xc3, 0x40, 0x6b, 0x7c, 0x93, 0x46, 0x58, 0x92,
+	0xfb, 0x58, 0x9e, 0x92, 0x98, 0xdc, 0xc9, 0x72, 0xd1, 0xd5, 0x87, 0xf8, 0x87, 0xff, 0x0c, 0x00,
+	0x95, 0x02, 0x76, 0x0f, 0x9b, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -11,6 +11,7 @@ import (
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -124,27 +125,45 @@ func (c *GRPCClient) Navigation(ctx context.Context) (navigation.Navigation, err
 	return entries, nil
 }
 
-// Register register a plugin.
+// Register register a plugin. The settings in ctx are passed to the plugin.
 func (c *GRPCClient) Register(ctx context.Context, dashboardAPIAddress string) (Metadata, error) {
 	var m Metadata
 
 	err := c.run(func() error {
+		config, err := encodeSettings(SettingsFrom(ctx))
+		if err != nil {
+			return errors.Wrap(err, "encode plugin settings")
+		}
+
 		registerRequest := &dashboard.RegisterRequest{
 			DashboardAPIAddress: dashboardAPIAddress,
+			Config:              config,
+			ApiVersion:          APIVersion,
+			MinApiVersion:       MinAPIVersion,
 		}
 
 		resp, err := c.client.Register(ctx, registerRequest)
 		if err != nil {
+			if s, ok := status.FromError(err); ok && s.Code() == codes.FailedPrecondition {
+				return &IncompatibleError{message: s.Message()}
+			}
+
 			spew.Dump(err)
 			return errors.WithMessage(err, "unable to call register function")
 		}
 
+		version, err := negotiateAPIVersion("Octant", "the plugin", int(resp.MinApiVersion), int(resp.ApiVersion))
+		if err != nil {
+			return err
+		}
+
 		capabilities := convertToCapabilities(resp.Capabilities)
 
 		m = Metadata{
 			Name:         resp.PluginName,
 			Description:  resp.Description,
 			Capabilities: capabilities,
+			APIVersion:   version,
 		}
 
 		return nil
@@ -267,12 +286,7 @@ func (c *GRPCClient) PrintTab(ctx context.Context, object runtime.Object) (TabRe
 			return errors.Wrap(err, "grpc client print tab")
 		}
 
-		var to component.TypedObject
-		if err := json.Unmarshal(resp.Layout, &to); err != nil {
-			return err
-		}
-
-		c, err := to.ToComponent()
+		c, err := component.Unmarshal(resp.Layout)
 		if err != nil {
 			return err
 		}
@@ -360,8 +374,21 @@ func (s *GRPCServer) Navigation(ctx context.Context, req *dashboard.NavigationRe
 
 }
 
-// Register register a plugin.
+// Register register a plugin. The settings sent by Octant are available
+// from ctx with SettingsFrom.
 func (s *GRPCServer) Register(ctx context.Context, registerRequest *dashboard.RegisterRequest) (*dashboard.RegisterResponse, error) {
+	settings, err := decodeSettings(registerRequest.Config)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode plugin settings")
+	}
+
+	version, err := negotiateAPIVersion("the plugin", "Octant",
+		int(registerRequest.MinApiVersion), int(registerRequest.ApiVersion))
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	ctx = WithAPIVersion(WithSettings(ctx, settings), version)
 	m, err := s.Impl.Register(ctx, registerRequest.DashboardAPIAddress)
 	if err != nil {
 		return nil, err
@@ -370,9 +397,11 @@ func (s *GRPCServer) Register(ctx context.Context, registerRequest *dashboard.Re
 	capabilities := convertFromCapabilities(m.Capabilities)
 
 	return &dashboard.RegisterResponse{
-		PluginName:   m.Name,
-		Description:  m.Description,
-		Capabilities: &capabilities,
+		PluginName:    m.Name,
+		Description:   m.Description,
+		Capabilities:  &capabilities,
+		ApiVersion:    APIVersion,
+		MinApiVersion: MinAPIVersion,
 	}, nil
 }
 
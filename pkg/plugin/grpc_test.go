@@ -14,6 +14,8 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -144,14 +146,27 @@ func Test_GRPCClient_Register(t *testing.T) {
 				SupportsPrinterItems:  inGVKs,
 				SupportsObjectStatus:  inGVKs,
 				SupportsTab:           inGVKs,
+				Relationships: []*dashboard.RegisterResponse_Relationship{
+					{
+						Source: &dashboard.RegisterResponse_GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Database"},
+						Target: &dashboard.RegisterResponse_GroupVersionKind{Version: "v1", Kind: "Secret"},
+						Path:   ".spec.secretName",
+					},
+				},
 			},
 		}
 
-		mocks.protoClient.EXPECT().Register(gomock.Any(), gomock.Any()).Return(resp, nil)
+		apiAddress := "localhost:54321"
+		registerRequest := &dashboard.RegisterRequest{
+			DashboardAPIAddress: apiAddress,
+			Config:              []byte(`{"endpoint":"http://localhost:9090"}`),
+			ApiVersion:          plugin.APIVersion,
+			MinApiVersion:       plugin.MinAPIVersion,
+		}
+		mocks.protoClient.EXPECT().Register(gomock.Any(), gomock.Eq(registerRequest)).Return(resp, nil)
 
 		client := mocks.genClient()
-		apiAddress := "localhost:54321"
-		ctx := context.Background()
+		ctx := plugin.WithSettings(context.Background(), plugin.Settings{"endpoint": "http://localhost:9090"})
 		got, err := client.Register(ctx, apiAddress)
 		require.NoError(t, err)
 
@@ -166,12 +181,55 @@ func Test_GRPCClient_Register(t *testing.T) {
 				SupportsPrinterItems:  outGVKs,
 				SupportsObjectStatus:  outGVKs,
 				SupportsTab:           outGVKs,
+				Relationships: []plugin.Relationship{
+					{
+						Source: schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Database"},
+						Target: schema.GroupVersionKind{Version: "v1", Kind: "Secret"},
+						Path:   ".spec.secretName",
+					},
+				},
 			},
+			// the plugin predates versioning
+			APIVersion: 1,
 		}
 		assert.Equal(t, expected, got)
 	})
 }
 
+func Test_GRPCClient_Register_incompatible(t *testing.T) {
+	tests := []struct {
+		name string
+		resp *dashboard.RegisterResponse
+		err  error
+	}{
+		{
+			name: "plugin is too new",
+			resp: &dashboard.RegisterResponse{
+				PluginName:    "my-plugin",
+				ApiVersion:    plugin.APIVersion + 2,
+				MinApiVersion: plugin.APIVersion + 1,
+			},
+		},
+		{
+			name: "plugin rejects Octant",
+			err:  status.Error(codes.FailedPrecondition, "incompatible plugin API"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			testWithGRPCClient(t, func(mocks *grpcClientMocks) {
+				mocks.protoClient.EXPECT().Register(gomock.Any(), gomock.Any()).Return(test.resp, test.err)
+
+				client := mocks.genClient()
+				_, err := client.Register(context.Background(), "localhost:54321")
+				require.Error(t, err)
+				assert.True(t, plugin.IsIncompatible(err))
+			})
+		})
+	}
+}
+
 func Test_GRPCClient_Print(t *testing.T) {
 	testWithGRPCClient(t, func(mocks *grpcClientMocks) {
 		object := testutil.CreateDeployment("deployment")
@@ -370,18 +428,32 @@ func Test_GRPCServer_Register(t *testing.T) {
 				SupportsPrinterItems:  inGVKs,
 				SupportsObjectStatus:  inGVKs,
 				SupportsTab:           inGVKs,
+				Relationships: []plugin.Relationship{
+					{
+						Source: schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Database"},
+						Target: schema.GroupVersionKind{Version: "v1", Kind: "Secret"},
+						Path:   ".spec.secretName",
+					},
+				},
 			},
 		}
 
 		apiAddress := "localhost:54321"
 
-		mocks.service.EXPECT().Register(gomock.Any(), gomock.Eq(apiAddress)).Return(metadata, nil)
+		mocks.service.EXPECT().Register(gomock.Any(), gomock.Eq(apiAddress)).
+			DoAndReturn(func(ctx context.Context, dashboardAPIAddress string) (plugin.Metadata, error) {
+				assert.Equal(t, plugin.Settings{"endpoint": "http://localhost:9090"}, plugin.SettingsFrom(ctx))
+				// Octant predates versioning
+				assert.Equal(t, 1, plugin.APIVersionFrom(ctx))
+				return metadata, nil
+			})
 
 		server := mocks.genServer()
 
 		ctx := context.Background()
 		got, err := server.Register(ctx, &dashboard.RegisterRequest{
 			DashboardAPIAddress: apiAddress,
+			Config:              []byte(`{"endpoint":"http://localhost:9090"}`),
 		})
 		require.NoError(t, err)
 
@@ -395,13 +467,36 @@ func Test_GRPCServer_Register(t *testing.T) {
 				SupportsPrinterItems:  outGVKs,
 				SupportsObjectStatus:  outGVKs,
 				SupportsTab:           outGVKs,
+				Relationships: []*dashboard.RegisterResponse_Relationship{
+					{
+						Source: &dashboard.RegisterResponse_GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Database"},
+						Target: &dashboard.RegisterResponse_GroupVersionKind{Version: "v1", Kind: "Secret"},
+						Path:   ".spec.secretName",
+					},
+				},
 			},
+			ApiVersion:    plugin.APIVersion,
+			MinApiVersion: plugin.MinAPIVersion,
 		}
 
 		assert.Equal(t, expected, got)
 	})
 }
 
+func Test_GRPCServer_Register_incompatible(t *testing.T) {
+	testWithGRPCServer(t, func(mocks *grpcServerMocks) {
+		server := mocks.genServer()
+
+		_, err := server.Register(context.Background(), &dashboard.RegisterRequest{
+			DashboardAPIAddress: "localhost:54321",
+			ApiVersion:          plugin.APIVersion + 2,
+			MinApiVersion:       plugin.APIVersion + 1,
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
 func Test_GRPCServer_Print(t *testing.T) {
 	testWithGRPCServer(t, func(mocks *grpcServerMocks) {
 		object := testutil.CreateDeployment("deployment")
@@ -0,0 +1,193 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware/octant/internal/log"
+	"github.com/vmware/octant/pkg/navigation"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+const (
+	// callTimeout is how long a plugin has to respond to a call.
+	callTimeout = 10 * time.Second
+	// healthCheckTimeout is how long a plugin has to respond to a health
+	// check.
+	healthCheckTimeout = 5 * time.Second
+	// maxConsecutiveErrors is the number of calls in a row which can fail
+	// before a plugin is quarantined.
+	maxConsecutiveErrors = 3
+)
+
+// call calls a plugin. Calls which time out or panic fail, and each
+// failure is counted against the plugin. Plugins which fail too many
+// calls in a row are quarantined: they are not called again until they are
+// restarted.
+func (m *Manager) call(ctx context.Context, name string, fn func(ctx context.Context) error) error {
+	if m.isQuarantined(name) {
+		return errors.Errorf("plugin %q is quarantined", name)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				errCh <- errors.Errorf("plugin %q panicked: %v", name, r)
+			}
+		}()
+
+		errCh <- fn(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-errCh:
+	case <-ctx.Done():
+		err = errors.Errorf("plugin %q did not respond within %s", name, callTimeout)
+	}
+
+	m.recordResult(ctx, name, err)
+	return err
+}
+
+// recordResult counts a failed call against a plugin, and quarantines it
+// if too many calls in a row failed.
+func (m *Manager) recordResult(ctx context.Context, name string, err error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	l, ok := m.plugins[name]
+	if !ok {
+		return
+	}
+
+	if err == nil {
+		l.callErrors = 0
+		return
+	}
+
+	l.callErrors++
+
+	status := m.store.Statuses()[name]
+	status.Errors++
+	status.LastError = err.Error()
+
+	if l.callErrors >= maxConsecutiveErrors && status.State == StateRunning {
+		log.From(ctx).With("plugin-name", name).Errorf("quarantining plugin after %d failed calls", l.callErrors)
+		status.State = StateQuarantined
+	}
+
+	m.store.SetStatus(name, status)
+}
+
+// isQuarantined returns true if a plugin is quarantined.
+func (m *Manager) isQuarantined(name string) bool {
+	return m.store.Statuses()[name].State == StateQuarantined
+}
+
+// healthyClientNames returns the names of plugins which are not
+// quarantined.
+func (m *Manager) healthyClientNames() []string {
+	statuses := m.store.Statuses()
+
+	var names []string
+	for _, name := range m.store.ClientNames() {
+		if statuses[name].State != StateQuarantined {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// guard isolates the plugins a runner calls. A plugin which fails is
+// logged and counted, but doesn't fail the run, so the other plugins'
+// responses are still used.
+func (m *Manager) guard(runner DefaultRunner) DefaultRunner {
+	runFunc := runner.RunFunc
+	if runFunc == nil {
+		return runner
+	}
+
+	return DefaultRunner{
+		RunFunc: func(ctx context.Context, name string, gvk schema.GroupVersionKind, object runtime.Object) error {
+			err := m.call(ctx, name, func(ctx context.Context) error {
+				return runFunc(ctx, name, gvk, object)
+			})
+			if err != nil {
+				log.From(ctx).WithErr(err).With("plugin-name", name).Errorf("plugin call failed")
+			}
+
+			return nil
+		},
+	}
+}
+
+// healthCheck pings a plugin. Plugins which don't respond in time fail.
+func healthCheck(client Client) error {
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- ping(client)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-time.After(healthCheckTimeout):
+		return errors.Errorf("health check did not respond within %s", healthCheckTimeout)
+	}
+}
+
+// guardedModuleService is a ModuleService whose navigation and content
+// calls are isolated by a Manager.
+type guardedModuleService struct {
+	ModuleService
+
+	manager *Manager
+	name    string
+}
+
+var _ ModuleService = (*guardedModuleService)(nil)
+
+// Navigation returns navigation from the plugin.
+func (s *guardedModuleService) Navigation(ctx context.Context) (navigation.Navigation, error) {
+	var nav navigation.Navigation
+	err := s.manager.call(ctx, s.name, func(ctx context.Context) error {
+		var err error
+		nav, err = s.ModuleService.Navigation(ctx)
+		return err
+	})
+	if err != nil {
+		return navigation.Navigation{}, err
+	}
+
+	return nav, nil
+}
+
+// Content returns content from the plugin.
+func (s *guardedModuleService) Content(ctx context.Context, contentPath string) (component.ContentResponse, error) {
+	var content component.ContentResponse
+	err := s.manager.call(ctx, s.name, func(ctx context.Context) error {
+		var err error
+		content, err = s.ModuleService.Content(ctx, contentPath)
+		return err
+	})
+	if err != nil {
+		return component.EmptyContentResponse, err
+	}
+
+	return content, nil
+}
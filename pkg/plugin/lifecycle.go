@@ -0,0 +1,137 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"time"
+)
+
+const (
+	// watchInterval is how often plugins are pinged and the plugin
+	// directories are scanned for changes.
+	watchInterval = 5 * time.Second
+	// maxRestartBackoff is the longest time to wait before restarting a
+	// plugin which keeps failing.
+	maxRestartBackoff = 5 * time.Minute
+	// stableDuration is how long a restarted plugin has to run before its
+	// backoff is reset.
+	stableDuration = time.Minute
+	// maxHealthHistory is the number of health events kept for a plugin.
+	maxHealthHistory = 20
+)
+
+// State is the lifecycle state of a plugin.
+type State string
+
+const (
+	// StateRunning is for plugins which are registered and responding.
+	StateRunning State = "Running"
+	// StateRestarting is for plugins which crashed or failed to start, and
+	// are waiting to be restarted.
+	StateRestarting State = "Restarting"
+	// StateQuarantined is for plugins which failed too many calls in a
+	// row. They are not called until they are restarted.
+	StateQuarantined State = "Quarantined"
+	// StateDisabled is for plugins which were disabled from the dashboard.
+	// They are not started until they are restarted.
+	StateDisabled State = "Disabled"
+	// StateIncompatible is for plugins which have no plugin API version in
+	// common with Octant. They are not started until they are restarted.
+	StateIncompatible State = "Incompatible"
+)
+
+// HealthEvent is a change in the health of a plugin.
+type HealthEvent struct {
+	// Time is when the change happened.
+	Time time.Time
+	// State is the state the plugin changed to.
+	State State
+	// Error is the error the plugin had, if any.
+	Error string
+}
+
+// Status is the lifecycle status of a plugin.
+type Status struct {
+	// State is the lifecycle state of the plugin.
+	State State
+	// Restarts is the number of times the plugin was restarted.
+	Restarts int
+	// Errors is the number of calls and health checks the plugin failed.
+	Errors int
+	// LastError is the last error the plugin had. It is kept after the
+	// plugin is restarted.
+	LastError string
+	// History is the plugin's recent changes of state and errors, oldest
+	// first.
+	History []HealthEvent
+}
+
+// record adds a health event to the history of a status which changed
+// from prev. Only the most recent events are kept. A status restored after
+// its plugin was removed from the store keeps its history as is.
+func (s *Status) record(prev Status, now time.Time) {
+	if prev.State == "" && len(s.History) > 0 {
+		return
+	}
+
+	s.History = prev.History
+	failed := s.Errors > prev.Errors || s.LastError != prev.LastError
+	if s.State == prev.State && !failed {
+		return
+	}
+
+	event := HealthEvent{Time: now, State: s.State}
+	if failed {
+		event.Error = s.LastError
+	}
+
+	start := 0
+	if len(prev.History) >= maxHealthHistory {
+		start = len(prev.History) - maxHealthHistory + 1
+	}
+
+	history := make([]HealthEvent, 0, maxHealthHistory)
+	history = append(history, prev.History[start:]...)
+	s.History = append(history, event)
+}
+
+// lifecycle tracks the restarts of a plugin.
+type lifecycle struct {
+	config config
+	// failures is the number of times in a row the plugin failed.
+	failures int
+	// callErrors is the number of calls in a row the plugin failed.
+	callErrors int
+	// started is when the plugin was last started.
+	started time.Time
+	// nextStart is the earliest time the plugin can be restarted.
+	nextStart time.Time
+	// connected is a client for a remote plugin which was connected to
+	// without holding the manager lock. It is used the next time the
+	// plugin is started.
+	connected *remoteClient
+}
+
+// failed records a failure of the plugin at now, and delays its next
+// restart. The delay doubles with each failure in a row.
+func (l *lifecycle) failed(now time.Time) {
+	l.failures++
+	l.nextStart = now.Add(restartBackoff(l.failures))
+}
+
+// restartBackoff returns how long to wait before restarting a plugin which
+// failed a number of times in a row.
+func restartBackoff(failures int) time.Duration {
+	backoff := watchInterval
+	for i := 1; i < failures; i++ {
+		backoff *= 2
+		if backoff >= maxRestartBackoff {
+			return maxRestartBackoff
+		}
+	}
+
+	return backoff
+}
@@ -0,0 +1,66 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_restartBackoff(t *testing.T) {
+	tests := []struct {
+		failures int
+		expected time.Duration
+	}{
+		{failures: 1, expected: 5 * time.Second},
+		{failures: 2, expected: 10 * time.Second},
+		{failures: 4, expected: 40 * time.Second},
+		{failures: 10, expected: maxRestartBackoff},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, restartBackoff(test.failures))
+	}
+}
+
+func Test_lifecycle_failed(t *testing.T) {
+	now := time.Unix(1547211430, 0)
+
+	l := &lifecycle{}
+	l.failed(now)
+	l.failed(now)
+
+	assert.Equal(t, 2, l.failures)
+	assert.Equal(t, now.Add(10*time.Second), l.nextStart)
+}
+
+func TestStatus_record(t *testing.T) {
+	now := time.Unix(1547211430, 0)
+
+	status := Status{State: StateRunning}
+	for i := 0; i < maxHealthHistory+5; i++ {
+		prev := status
+		status.Errors++
+		status.LastError = "failed"
+		status.record(prev, now.Add(time.Duration(i)*time.Second))
+	}
+
+	require.Len(t, status.History, maxHealthHistory)
+	assert.Equal(t, now.Add(5*time.Second), status.History[0].Time)
+	assert.Equal(t, "failed", status.History[0].Error)
+
+	// statuses which didn't change are not recorded
+	prev := status
+	status.record(prev, now)
+	assert.Len(t, status.History, maxHealthHistory)
+
+	// restored statuses keep their history
+	status.record(Status{}, now)
+	assert.Equal(t, prev.History, status.History)
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	octantErrors "github.com/vmware/octant/internal/errors"
 	"github.com/vmware/octant/internal/log"
 	"github.com/vmware/octant/internal/module"
 	"github.com/vmware/octant/internal/portforward"
@@ -76,6 +77,9 @@ type ManagerStore interface {
 	GetCommand(name string) (string, error)
 	Clients() map[string]Client
 	ClientNames() []string
+	Remove(name string)
+	SetStatus(name string, status Status)
+	Statuses() map[string]Status
 }
 
 // DefaultStore is the default implement of ManagerStore.
@@ -83,6 +87,9 @@ type DefaultStore struct {
 	clients  map[string]Client
 	metadata map[string]Metadata
 	commands map[string]string
+	statuses map[string]Status
+
+	mu sync.RWMutex
 }
 
 var _ ManagerStore = (*DefaultStore)(nil)
@@ -93,25 +100,38 @@ func NewDefaultStore() *DefaultStore {
 		clients:  make(map[string]Client),
 		metadata: make(map[string]Metadata),
 		commands: make(map[string]string),
+		statuses: make(map[string]Status),
 	}
 }
 
-// Store stores information for a plugin.
+// Store stores information for a registered plugin, and marks it as
+// running.
 func (s *DefaultStore) Store(name string, client Client, metadata *Metadata, cmd string) error {
 	if metadata == nil {
 		return errors.New("metadata is nil")
 	}
 
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	s.clients[name] = client
 	s.metadata[name] = *metadata
 	s.commands[name] = cmd
 
+	prev := s.statuses[name]
+	status := prev
+	status.State = StateRunning
+	status.record(prev, time.Now())
+	s.statuses[name] = status
+
 	return nil
 }
 
 // GetService gets the service for a plugin.
 func (s *DefaultStore) GetService(name string) (Service, error) {
+	s.mu.RLock()
 	client, ok := s.clients[name]
+	s.mu.RUnlock()
 	if !ok {
 		return nil, errors.Errorf("plugin %q doesn't have a client", name)
 	}
@@ -136,6 +156,9 @@ func (s *DefaultStore) GetService(name string) (Service, error) {
 
 // GetMetadata gets the metadata for a plugin.
 func (s *DefaultStore) GetMetadata(name string) (*Metadata, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	metadata, ok := s.metadata[name]
 	if !ok {
 		return nil, errors.Errorf("plugin %q doesn't have metadata", name)
@@ -146,6 +169,9 @@ func (s *DefaultStore) GetMetadata(name string) (*Metadata, error) {
 
 // GetCommand gets the command for a plugin.
 func (s *DefaultStore) GetCommand(name string) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	cmd, ok := s.commands[name]
 	if !ok {
 		return "", errors.Errorf("plugin %q doesn't have command", name)
@@ -156,7 +182,14 @@ func (s *DefaultStore) GetCommand(name string) (string, error) {
 
 // Clients returns all the clients in the store.
 func (s *DefaultStore) Clients() map[string]Client {
-	return s.clients
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	clients := make(map[string]Client)
+	for name, client := range s.clients {
+		clients[name] = client
+	}
+	return clients
 }
 
 // ClientNames returns the client names in the store.
@@ -168,9 +201,45 @@ func (s *DefaultStore) ClientNames() []string {
 	return list
 }
 
+// Remove removes a plugin from the store.
+func (s *DefaultStore) Remove(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.clients, name)
+	delete(s.metadata, name)
+	delete(s.commands, name)
+	delete(s.statuses, name)
+}
+
+// SetStatus sets the lifecycle status of a plugin. Plugins which are not
+// registered can have a status, e.g. if they failed to start. Changes of
+// state and errors are recorded in the status history.
+func (s *DefaultStore) SetStatus(name string, status Status) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	status.record(s.statuses[name], time.Now())
+	s.statuses[name] = status
+}
+
+// Statuses returns the lifecycle status of each plugin.
+func (s *DefaultStore) Statuses() map[string]Status {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	statuses := make(map[string]Status)
+	for name, status := range s.statuses {
+		statuses[name] = status
+	}
+	return statuses
+}
+
 type config struct {
 	cmd  string
 	name string
+	// remote is set for plugins which run as remote gRPC services.
+	remote *RemotePlugin
 }
 
 // ManagerInterface is an interface which represent a plugin manager.
@@ -186,23 +255,50 @@ type ManagerInterface interface {
 
 	// ObjectStatus returns the object status
 	ObjectStatus(ctx context.Context, object runtime.Object) (*ObjectStatusResponse, error)
+
+	// Restart restarts a plugin.
+	Restart(ctx context.Context, name string) error
+
+	// Disable stops a plugin until it is restarted.
+	Disable(ctx context.Context, name string) error
 }
 
 // ModuleRegistrar is a module registrar.
 type ModuleRegistrar interface {
 	// Register registers a module.
 	Register(mod module.Module) error
+	// Unregister stops and removes a module.
+	Unregister(name string)
 }
 
 // ActionRegistrar is an action registrar.
 type ActionRegistrar interface {
 	// Register registers an action.
 	Register(actionPath string, actionFunc action.DispatcherFunc) error
+	// Unregister removes an action.
+	Unregister(actionPath string)
 }
 
 // ManagerOption is an option for configuring Manager.
 type ManagerOption func(*Manager)
 
+// WithConfig configures a Manager to watch the plugin directories in
+// config. Plugins added to them are started, and plugins removed from them
+// are unloaded.
+func WithConfig(config Config) ManagerOption {
+	return func(m *Manager) {
+		m.Config = config
+	}
+}
+
+// WithPluginSettings configures a Manager to pass settings to plugins when
+// they register. Settings are keyed by plugin name.
+func WithPluginSettings(settings map[string]Settings) ManagerOption {
+	return func(m *Manager) {
+		m.Settings = settings
+	}
+}
+
 // Manager manages plugins
 type Manager struct {
 	PortForwarder   portforward.PortForwarder
@@ -210,10 +306,15 @@ type Manager struct {
 	ClientFactory   ClientFactory
 	ModuleRegistrar ModuleRegistrar
 	ActionRegistrar ActionRegistrar
+	// Config is the configuration for the plugin directories. They are not
+	// watched if it is nil.
+	Config Config
+	// Settings are the settings passed to plugins, keyed by plugin name.
+	Settings map[string]Settings
 
 	Runners Runners
 
-	configs []config
+	plugins map[string]*lifecycle
 	store   ManagerStore
 
 	lock sync.Mutex
@@ -230,6 +331,7 @@ func NewManager(apiService api.API, moduleRegistrar ModuleRegistrar, actionRegis
 		API:             apiService,
 		ModuleRegistrar: moduleRegistrar,
 		ActionRegistrar: actionRegistrar,
+		plugins:         make(map[string]*lifecycle),
 	}
 
 	for _, option := range options {
@@ -254,20 +356,43 @@ func (m *Manager) Load(cmd string) error {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
+	return m.load(cmd)
+}
+
+func (m *Manager) load(cmd string) error {
 	name := filepath.Base(cmd)
 
-	for _, c := range m.configs {
-		if name == c.name {
-			return errors.Errorf("tried to load plugin %q more than once", name)
-		}
+	if _, ok := m.plugins[name]; ok {
+		return errors.Errorf("tried to load plugin %q more than once", name)
 	}
 
-	c := config{
-		name: name,
-		cmd:  cmd,
+	m.plugins[name] = &lifecycle{
+		config: config{
+			name: name,
+			cmd:  cmd,
+		},
 	}
 
-	m.configs = append(m.configs, c)
+	return nil
+}
+
+// LoadRemote loads a plugin which runs as a remote gRPC service. Octant
+// connects to it rather than starting it.
+func (m *Manager) LoadRemote(remotePlugin RemotePlugin) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.plugins[remotePlugin.Name]; ok {
+		return errors.Errorf("tried to load plugin %q more than once", remotePlugin.Name)
+	}
+
+	m.plugins[remotePlugin.Name] = &lifecycle{
+		config: config{
+			name:   remotePlugin.Name,
+			cmd:    remotePlugin.Address,
+			remote: &remotePlugin,
+		},
+	}
 
 	return nil
 }
@@ -289,15 +414,35 @@ func (m *Manager) Start(ctx context.Context) error {
 	logger := log.From(ctx)
 	logger.With("addr", m.API.Addr()).Debugf("starting plugin api service")
 
+	m.connectRemotePlugins(ctx, time.Now())
+
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
-	for i := range m.configs {
-		c := m.configs[i]
+	for _, name := range m.pluginNames() {
+		l := m.plugins[name]
+
+		// remote plugins which couldn't be connected to are reconnected
+		// to with a backoff.
+		if l.config.remote != nil && l.connected == nil {
+			continue
+		}
+
+		if err := m.start(ctx, l); err != nil {
+			state := StateRestarting
+			switch {
+			case IsIncompatible(err):
+				state = StateIncompatible
+			case l.config.remote == nil:
+				return err
+			}
 
-		if err := m.start(ctx, c); err != nil {
-			return err
+			logger.With("plugin-name", name).WithErr(err).Errorf("unable to start plugin")
+			m.setFailed(l, time.Now(), state, err)
+			continue
 		}
+
+		l.started = time.Now()
 	}
 
 	go m.watchPlugins(ctx)
@@ -308,51 +453,374 @@ func (m *Manager) Start(ctx context.Context) error {
 func (m *Manager) watchPlugins(ctx context.Context) {
 	logger := log.From(ctx)
 
-	timer := time.NewTimer(5 * time.Second)
-	running := true
+	timer := time.NewTimer(watchInterval)
+	defer timer.Stop()
 
-	for running {
+	for {
 		select {
 		case <-ctx.Done():
 			logger.Infof("shutting down plugin watcher")
-			running = false
-			break
+			return
 		case <-timer.C:
-			for clientName, client := range m.store.Clients() {
-				rpcClient, err := client.Client()
-				if err != nil {
-					logger.WithErr(err).Errorf("retrieve plugin client for ping")
-				}
-
-				if err := rpcClient.Ping(); err != nil {
-					logger.With("plugin-name", clientName).Infof("restarting plugin")
-
-					cmd, err := m.store.GetCommand(clientName)
-					if err != nil {
-						logger.WithErr(err).Errorf("unable to find command for plugin")
-						continue
-					}
-
-					c := config{
-						name: clientName,
-						cmd:  cmd,
-					}
-
-					if err := m.start(ctx, c); err != nil {
-						logger.WithErr(err).Errorf("unable to restart plugin")
-						continue
-					}
-				}
+			m.Reconcile(ctx)
+			timer.Reset(watchInterval)
+		}
+	}
+}
+
+// Reconcile starts plugins added to the plugin directories, unloads
+// plugins removed from them, and restarts plugins which stopped
+// responding. Plugins which keep failing are restarted with a backoff.
+func (m *Manager) Reconcile(ctx context.Context) {
+	m.connectRemotePlugins(ctx, time.Now())
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.Config != nil {
+		m.syncPluginDirs(ctx)
+	}
+
+	now := time.Now()
+	clients := m.store.Clients()
+
+	for _, name := range m.pluginNames() {
+		m.checkPlugin(ctx, m.plugins[name], clients[name], now)
+	}
+}
+
+// syncPluginDirs loads plugins which were added to the plugin directories,
+// and unloads plugins which were removed. Remote plugins are not in the
+// plugin directories, so they are kept.
+func (m *Manager) syncPluginDirs(ctx context.Context) {
+	logger := log.From(ctx)
+
+	available, err := AvailablePlugins(m.Config)
+	if err != nil {
+		logger.WithErr(err).Debugf("finding available plugins")
+		return
+	}
+
+	found := make(map[string]bool)
+	for _, cmd := range available {
+		name := filepath.Base(cmd)
+		found[name] = true
+
+		if _, ok := m.plugins[name]; ok {
+			continue
+		}
+
+		logger.With("plugin-name", name, "cmd", cmd).Infof("found new plugin")
+		if err := m.load(cmd); err != nil {
+			logger.WithErr(err).Errorf("unable to load plugin")
+		}
+	}
+
+	for _, name := range m.pluginNames() {
+		if !found[name] && m.plugins[name].config.remote == nil {
+			logger.With("plugin-name", name).Infof("plugin was removed; unloading it")
+			m.unload(name)
+		}
+	}
+}
+
+// connectRemotePlugins connects to the remote plugins which are due to be
+// started. Connecting blocks until the plugin responds or dialTimeout
+// passes, so it is done without holding the manager lock. Plugins which
+// can't be connected to wait to be reconnected to with a backoff.
+func (m *Manager) connectRemotePlugins(ctx context.Context, now time.Time) {
+	m.lock.Lock()
+	var pending []*lifecycle
+	for _, name := range m.pluginNames() {
+		l := m.plugins[name]
+		if l.config.remote == nil || l.connected != nil || now.Before(l.nextStart) {
+			continue
+		}
+
+		if _, ok := m.store.Clients()[name]; ok {
+			continue
+		}
+
+		switch m.store.Statuses()[name].State {
+		case StateDisabled, StateIncompatible:
+			continue
+		}
+
+		pending = append(pending, l)
+	}
+	m.lock.Unlock()
+
+	for _, l := range pending {
+		client := newRemoteClient(*l.config.remote)
+		err := client.connect()
+
+		m.lock.Lock()
+		switch {
+		case m.plugins[l.config.name] != l:
+			// the plugin was unloaded while it was connected to.
+			client.Kill()
+		case err != nil:
+			log.From(ctx).With("plugin-name", l.config.name).WithErr(err).Errorf("unable to connect to remote plugin")
+			m.setFailed(l, time.Now(), StateRestarting, err)
+		default:
+			l.connected = client
+		}
+		m.lock.Unlock()
+	}
+}
+
+// connectRemotePlugin connects to a plugin without holding the manager
+// lock, if it is a remote plugin. It returns nil for other plugins.
+func (m *Manager) connectRemotePlugin(name string) (*remoteClient, error) {
+	m.lock.Lock()
+	l, ok := m.plugins[name]
+	m.lock.Unlock()
+
+	if !ok || l.config.remote == nil {
+		return nil, nil
+	}
+
+	client := newRemoteClient(*l.config.remote)
+	if err := client.connect(); err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// checkPlugin pings a plugin, and starts it if it isn't responding and its
+// backoff has passed.
+func (m *Manager) checkPlugin(ctx context.Context, l *lifecycle, client Client, now time.Time) {
+	logger := log.From(ctx).With("plugin-name", l.config.name)
+
+	switch m.store.Statuses()[l.config.name].State {
+	case StateDisabled, StateIncompatible:
+		return
+	}
+
+	if client != nil {
+		if m.isQuarantined(l.config.name) {
+			logger.Infof("stopping quarantined plugin")
+			m.stop(l.config.name, client)
+			m.setFailed(l, now, StateQuarantined, nil)
+			return
+		}
+
+		err := healthCheck(client)
+		if err == nil {
+			if l.failures > 0 && now.Sub(l.started) >= stableDuration {
+				l.failures = 0
 			}
+			return
+		}
 
-			timer.Reset(5 * time.Second)
+		logger.WithErr(err).Infof("plugin stopped responding")
+		m.stop(l.config.name, client)
+		m.setFailed(l, now, StateRestarting, errors.Wrap(err, "plugin stopped responding"))
+	}
+
+	if now.Before(l.nextStart) {
+		return
+	}
+
+	status := m.store.Statuses()[l.config.name]
+	if client != nil || l.failures > 0 {
+		status.Restarts++
+		m.store.SetStatus(l.config.name, status)
+	}
+
+	logger.Infof("starting plugin")
+	if err := m.launch(ctx, l, now); err != nil {
+		logger.WithErr(err).Errorf("unable to start plugin")
+	}
+}
+
+// launch starts a plugin. A plugin which fails to start is stopped, and
+// waits to be restarted. Incompatible plugins are not restarted.
+func (m *Manager) launch(ctx context.Context, l *lifecycle, now time.Time) error {
+	if err := m.start(ctx, l); err != nil {
+		if client, ok := m.store.Clients()[l.config.name]; ok {
+			m.stop(l.config.name, client)
+		}
+
+		state := StateRestarting
+		if IsIncompatible(err) {
+			state = StateIncompatible
 		}
+		m.setFailed(l, now, state, err)
+		return err
 	}
 
+	l.started = now
+	l.callErrors = 0
+	return nil
 }
 
-func (m *Manager) start(ctx context.Context, c config) error {
-	client := m.ClientFactory.Init(ctx, c.cmd)
+// Restart restarts a plugin now, regardless of its backoff. Quarantined,
+// disabled, and incompatible plugins are started again.
+func (m *Manager) Restart(ctx context.Context, name string) error {
+	connected, err := m.connectRemotePlugin(name)
+	if err != nil {
+		return errors.Wrapf(err, "restart plugin %q", name)
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	l, ok := m.plugins[name]
+	if !ok {
+		if connected != nil {
+			connected.Kill()
+		}
+		return errors.Errorf("plugin %q is not loaded", name)
+	}
+
+	log.From(ctx).With("plugin-name", name).Infof("restarting plugin")
+
+	if connected != nil {
+		if l.connected != nil {
+			l.connected.Kill()
+		}
+		l.connected = connected
+	}
+
+	if client, ok := m.store.Clients()[name]; ok {
+		m.stop(name, client)
+	}
+
+	status := m.store.Statuses()[name]
+	status.Restarts++
+	m.store.SetStatus(name, status)
+
+	l.failures = 0
+	if err := m.launch(ctx, l, time.Now()); err != nil {
+		return errors.Wrapf(err, "restart plugin %q", name)
+	}
+
+	return nil
+}
+
+// Disable stops a plugin. It is not started again until it is restarted.
+func (m *Manager) Disable(ctx context.Context, name string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.plugins[name]; !ok {
+		return errors.Errorf("plugin %q is not loaded", name)
+	}
+
+	log.From(ctx).With("plugin-name", name).Infof("disabling plugin")
+
+	if client, ok := m.store.Clients()[name]; ok {
+		m.stop(name, client)
+	}
+
+	status := m.store.Statuses()[name]
+	status.State = StateDisabled
+	m.store.SetStatus(name, status)
+
+	return nil
+}
+
+// setFailed records a plugin failure, and sets the state the plugin waits
+// in until it is restarted. Failures with an error are counted.
+func (m *Manager) setFailed(l *lifecycle, now time.Time, state State, err error) {
+	l.failed(now)
+
+	status := m.store.Statuses()[l.config.name]
+	status.State = state
+	if err != nil {
+		status.Errors++
+		status.LastError = err.Error()
+	}
+	m.store.SetStatus(l.config.name, status)
+}
+
+// stop stops a plugin and removes it from the store. Its status is kept,
+// so it is shown while the plugin waits to be restarted.
+func (m *Manager) stop(name string, client Client) {
+	m.deregister(name)
+	client.Kill()
+
+	status, ok := m.store.Statuses()[name]
+	m.store.Remove(name)
+	if ok {
+		m.store.SetStatus(name, status)
+	}
+}
+
+// unload stops a plugin and removes it from the manager.
+func (m *Manager) unload(name string) {
+	if client, ok := m.store.Clients()[name]; ok {
+		client.Kill()
+	}
+
+	m.deregister(name)
+	m.store.Remove(name)
+	delete(m.plugins, name)
+}
+
+// deregister removes the actions and module registered by a plugin.
+func (m *Manager) deregister(name string) {
+	metadata, err := m.store.GetMetadata(name)
+	if err != nil {
+		return
+	}
+
+	for _, actionName := range metadata.Capabilities.ActionNames {
+		m.ActionRegistrar.Unregister(actionName)
+	}
+
+	if metadata.Capabilities.IsModule {
+		m.ModuleRegistrar.Unregister(metadata.Name)
+	}
+}
+
+// pluginNames returns the names of loaded plugins in order.
+func (m *Manager) pluginNames() []string {
+	var names []string
+	for name := range m.plugins {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// ping checks if a plugin is responding.
+func ping(client Client) error {
+	rpcClient, err := client.Client()
+	if err != nil {
+		return err
+	}
+
+	return rpcClient.Ping()
+}
+
+// newClient creates a client for a plugin. Remote plugins are connected to
+// rather than started, using the client connected by connectRemotePlugins
+// if there is one.
+func (m *Manager) newClient(ctx context.Context, l *lifecycle) Client {
+	if l.config.remote != nil {
+		if client := l.connected; client != nil {
+			l.connected = nil
+			return client
+		}
+
+		return newRemoteClient(*l.config.remote)
+	}
+
+	return m.ClientFactory.Init(ctx, l.config.cmd)
+}
+
+func (m *Manager) start(ctx context.Context, l *lifecycle) (err error) {
+	c := l.config
+	client := m.newClient(ctx, l)
+	defer func() {
+		if err != nil {
+			client.Kill()
+		}
+	}()
 
 	rpcClient, err := client.Client()
 	if err != nil {
@@ -371,7 +839,7 @@ func (m *Manager) start(ctx context.Context, c config) error {
 		return errors.Errorf("unknown type for plugin %q: %T", c.name, raw)
 	}
 
-	metadata, err := service.Register(ctx, m.API.Addr())
+	metadata, err := service.Register(WithSettings(ctx, m.Settings[c.name]), m.API.Addr())
 	if err != nil {
 		return errors.Wrapf(err, "register plugin %q", c.name)
 	}
@@ -383,7 +851,9 @@ func (m *Manager) start(ctx context.Context, c config) error {
 	for _, actionName := range metadata.Capabilities.ActionNames {
 		pluginLogger.With("action-path", actionName).Infof("registering plugin action")
 		err := m.ActionRegistrar.Register(actionName, func(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
-			return service.HandleAction(ctx, payload)
+			return m.call(ctx, c.name, func(ctx context.Context) error {
+				return service.HandleAction(ctx, payload)
+			})
 		})
 
 		if err != nil {
@@ -404,7 +874,11 @@ func (m *Manager) start(ctx context.Context, c config) error {
 
 		pluginLogger.Infof("plugin supports navigation")
 
-		mp, err := NewModuleProxy(c.name, &metadata, service)
+		mp, err := NewModuleProxy(c.name, &metadata, &guardedModuleService{
+			ModuleService: service,
+			manager:       m,
+			name:          c.name,
+		})
 		if err != nil {
 			return errors.Wrap(err, "creating module proxy")
 		}
@@ -428,6 +902,13 @@ func (m *Manager) Stop(ctx context.Context) {
 		logger.With("plugin-name", name).Debugf("stopping plugin")
 		client.Kill()
 	}
+
+	for _, l := range m.plugins {
+		if l.connected != nil {
+			l.connected.Kill()
+			l.connected = nil
+		}
+	}
 }
 
 // Print prints an object with plugins which are configured to print the objects's
@@ -438,6 +919,7 @@ func (m *Manager) Print(ctx context.Context, object runtime.Object) (*PrintRespo
 	}
 
 	runner, ch := m.Runners.Print(m.store)
+	runner = m.guard(runner)
 	done := make(chan bool)
 
 	var pr PrintResponse
@@ -452,8 +934,8 @@ func (m *Manager) Print(ctx context.Context, object runtime.Object) (*PrintRespo
 		done <- true
 	}()
 
-	if err := runner.Run(ctx, object, m.store.ClientNames()); err != nil {
-		return nil, err
+	if err := runner.Run(ctx, object, m.healthyClientNames()); err != nil {
+		return nil, octantErrors.WrapPluginFailure(err, "print object")
 	}
 	close(ch)
 
@@ -468,6 +950,7 @@ func (m *Manager) Tabs(ctx context.Context, object runtime.Object) ([]component.
 	}
 
 	runner, ch := m.Runners.Tab(m.store)
+	runner = m.guard(runner)
 	done := make(chan bool)
 
 	var tabs []component.Tab
@@ -480,8 +963,8 @@ func (m *Manager) Tabs(ctx context.Context, object runtime.Object) ([]component.
 		done <- true
 	}()
 
-	if err := runner.Run(ctx, object, m.store.ClientNames()); err != nil {
-		return nil, err
+	if err := runner.Run(ctx, object, m.healthyClientNames()); err != nil {
+		return nil, octantErrors.WrapPluginFailure(err, "print tabs")
 	}
 
 	close(ch)
@@ -494,30 +977,50 @@ func (m *Manager) Tabs(ctx context.Context, object runtime.Object) ([]component.
 	return tabs, nil
 }
 
-// ObjectStatus updates the object status of an object configured from a plugin
+// ObjectStatus collects the status plugins contribute for an object. The
+// details from each plugin are combined. If plugins set a status, the most
+// severe is used.
 func (m *Manager) ObjectStatus(ctx context.Context, object runtime.Object) (*ObjectStatusResponse, error) {
 	if m.Runners == nil {
 		return nil, errors.New("runners is nil")
 	}
 
 	runner, ch := m.Runners.ObjectStatus(m.store)
+	runner = m.guard(runner)
 	done := make(chan bool)
 
 	var osr ObjectStatusResponse
 
 	go func() {
 		for resp := range ch {
-			osr.ObjectStatus = resp.ObjectStatus
+			osr.ObjectStatus.Details = append(osr.ObjectStatus.Details, resp.ObjectStatus.Details...)
+			osr.ObjectStatus.Status = moreSevere(osr.ObjectStatus.Status, resp.ObjectStatus.Status)
 		}
 
 		done <- true
 	}()
 
-	if err := runner.Run(ctx, object, m.store.ClientNames()); err != nil {
-		return nil, err
+	if err := runner.Run(ctx, object, m.healthyClientNames()); err != nil {
+		return nil, octantErrors.WrapPluginFailure(err, "get object status")
 	}
 	close(ch)
 
 	<-done
 	return &osr, nil
 }
+
+// moreSevere returns the more severe of two statuses. A blank status is
+// less severe than any other.
+func moreSevere(a, b component.NodeStatus) component.NodeStatus {
+	severity := map[component.NodeStatus]int{
+		component.NodeStatusOK:      1,
+		component.NodeStatusWarning: 2,
+		component.NodeStatusError:   3,
+	}
+
+	if severity[b] > severity[a] {
+		return b
+	}
+
+	return a
+}
@@ -7,16 +7,21 @@ package plugin_test
 
 import (
 	"context"
+	"net"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/hashicorp/go-plugin"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/action"
 	dashPlugin "github.com/vmware/octant/pkg/plugin"
 	"github.com/vmware/octant/pkg/plugin/api"
 	"github.com/vmware/octant/pkg/plugin/fake"
@@ -103,6 +108,7 @@ func TestManager_Print(t *testing.T) {
 	actionRegistrar := fake.NewMockActionRegistrar(controller)
 
 	store.EXPECT().ClientNames().Return([]string{"plugin1", "plugin2"})
+	store.EXPECT().Statuses().Return(nil).AnyTimes()
 
 	ch := make(chan dashPlugin.PrintResponse)
 	printRunner := dashPlugin.DefaultRunner{
@@ -160,6 +166,7 @@ func TestManager_Tabs(t *testing.T) {
 	actionRegistrar := fake.NewMockActionRegistrar(controller)
 
 	store.EXPECT().ClientNames().Return([]string{"plugin1", "plugin2"})
+	store.EXPECT().Statuses().Return(nil).AnyTimes()
 
 	ch := make(chan component.Tab)
 	tabRunner := dashPlugin.DefaultRunner{
@@ -197,10 +204,442 @@ func TestManager_Tabs(t *testing.T) {
 	assert.Equal(t, expected, got)
 }
 
+func TestManager_Reconcile_pluginDirs(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "/plugins/plugin1", []byte("plugin"), 0755))
+
+	clientFactory := fake.NewMockClientFactory(controller)
+	client := newFakePluginClient("plugin1", controller)
+	clientFactory.EXPECT().Init(gomock.Any(), "/plugins/plugin1").Return(client)
+	client.clientProtocol.EXPECT().Ping().Return(nil)
+
+	manager := dashPlugin.NewManager(&stubAPIService{},
+		fake.NewMockModuleRegistrar(controller),
+		fake.NewMockActionRegistrar(controller),
+		dashPlugin.WithConfig(&stubConfig{fs: fs, dirs: []string{"/plugins"}}),
+		func(m *dashPlugin.Manager) {
+			m.ClientFactory = clientFactory
+		})
+
+	ctx := context.Background()
+
+	manager.Reconcile(ctx)
+	store := manager.Store()
+	assert.Equal(t, []string{"plugin1"}, store.ClientNames())
+	assert.Equal(t, dashPlugin.StateRunning, store.Statuses()["plugin1"].State)
+
+	manager.Reconcile(ctx)
+	assert.Equal(t, []string{"plugin1"}, store.ClientNames())
+	assert.False(t, client.killed)
+
+	require.NoError(t, fs.Remove("/plugins/plugin1"))
+
+	manager.Reconcile(ctx)
+	assert.Empty(t, store.ClientNames())
+	assert.Empty(t, store.Statuses())
+	assert.True(t, client.killed)
+}
+
+func TestManager_Reconcile_crash(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	clientFactory := fake.NewMockClientFactory(controller)
+	client := newFakePluginClient("plugin1", controller)
+	clientFactory.EXPECT().Init(gomock.Any(), "plugin1").Return(client)
+	client.clientProtocol.EXPECT().Ping().Return(errors.New("connection refused"))
+
+	manager := dashPlugin.NewManager(&stubAPIService{},
+		fake.NewMockModuleRegistrar(controller),
+		fake.NewMockActionRegistrar(controller),
+		func(m *dashPlugin.Manager) {
+			m.ClientFactory = clientFactory
+		})
+
+	require.NoError(t, manager.Load("plugin1"))
+
+	ctx := context.Background()
+
+	manager.Reconcile(ctx)
+	store := manager.Store()
+	assert.Equal(t, []string{"plugin1"}, store.ClientNames())
+
+	manager.Reconcile(ctx)
+	assert.Empty(t, store.ClientNames())
+	assert.True(t, client.killed)
+
+	expected := dashPlugin.Status{
+		State:     dashPlugin.StateRestarting,
+		Errors:    1,
+		LastError: "plugin stopped responding: connection refused",
+	}
+	assertStatus(t, expected, []dashPlugin.State{dashPlugin.StateRunning, dashPlugin.StateRestarting}, store.Statuses()["plugin1"])
+
+	// the plugin isn't restarted until its backoff passes
+	manager.Reconcile(ctx)
+	assert.Empty(t, store.ClientNames())
+}
+
+func TestManager_quarantine(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	service := fake.NewMockService(controller)
+	metadata := dashPlugin.Metadata{
+		Name: "plugin1",
+		Capabilities: dashPlugin.Capabilities{
+			ActionNames: []string{"plugin1/action"},
+		},
+	}
+	service.EXPECT().Register(gomock.Any(), "localhost:54321").Return(metadata, nil)
+	service.EXPECT().HandleAction(gomock.Any(), gomock.Any()).Return(errors.New("failed")).Times(3)
+
+	clientProtocol := fake.NewMockClientProtocol(controller)
+	clientProtocol.EXPECT().Dispense("plugin").Return(service, nil)
+	client := &fakePluginClient{clientProtocol: clientProtocol, service: service, name: "plugin1"}
+
+	clientFactory := fake.NewMockClientFactory(controller)
+	clientFactory.EXPECT().Init(gomock.Any(), "plugin1").Return(client)
+
+	var handleAction action.DispatcherFunc
+	actionRegistrar := fake.NewMockActionRegistrar(controller)
+	actionRegistrar.EXPECT().Register("plugin1/action", gomock.Any()).
+		DoAndReturn(func(actionPath string, actionFunc action.DispatcherFunc) error {
+			handleAction = actionFunc
+			return nil
+		})
+	actionRegistrar.EXPECT().Unregister("plugin1/action")
+
+	manager := dashPlugin.NewManager(&stubAPIService{},
+		fake.NewMockModuleRegistrar(controller),
+		actionRegistrar,
+		func(m *dashPlugin.Manager) {
+			m.ClientFactory = clientFactory
+		})
+	require.NoError(t, manager.Load("plugin1"))
+
+	ctx := context.Background()
+	manager.Reconcile(ctx)
+	require.NotNil(t, handleAction)
+
+	for i := 0; i < 3; i++ {
+		require.Error(t, handleAction(ctx, nil, action.Payload{}))
+	}
+
+	store := manager.Store()
+	expected := dashPlugin.Status{
+		State:     dashPlugin.StateQuarantined,
+		Errors:    3,
+		LastError: "failed",
+	}
+	expectedHistory := []dashPlugin.State{
+		dashPlugin.StateRunning,
+		dashPlugin.StateRunning,
+		dashPlugin.StateRunning,
+		dashPlugin.StateQuarantined,
+	}
+	assertStatus(t, expected, expectedHistory, store.Statuses()["plugin1"])
+
+	err := handleAction(ctx, nil, action.Payload{})
+	assert.EqualError(t, err, `plugin "plugin1" is quarantined`)
+
+	manager.Reconcile(ctx)
+	assert.True(t, client.killed)
+	assert.Empty(t, store.ClientNames())
+	assertStatus(t, expected, expectedHistory, store.Statuses()["plugin1"])
+}
+
+func TestManager_Disable_and_Restart(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	client1 := newFakePluginClient("plugin1", controller)
+	client2 := newFakePluginClient("plugin1", controller)
+	clientFactory := fake.NewMockClientFactory(controller)
+	gomock.InOrder(
+		clientFactory.EXPECT().Init(gomock.Any(), "plugin1").Return(client1),
+		clientFactory.EXPECT().Init(gomock.Any(), "plugin1").Return(client2),
+	)
+
+	manager := dashPlugin.NewManager(&stubAPIService{},
+		fake.NewMockModuleRegistrar(controller),
+		fake.NewMockActionRegistrar(controller),
+		func(m *dashPlugin.Manager) {
+			m.ClientFactory = clientFactory
+		})
+	require.NoError(t, manager.Load("plugin1"))
+
+	ctx := context.Background()
+	manager.Reconcile(ctx)
+
+	require.NoError(t, manager.Disable(ctx, "plugin1"))
+	assert.True(t, client1.killed)
+
+	// disabled plugins are not restarted
+	manager.Reconcile(ctx)
+
+	store := manager.Store()
+	assert.Empty(t, store.ClientNames())
+	assertStatus(t, dashPlugin.Status{State: dashPlugin.StateDisabled},
+		[]dashPlugin.State{dashPlugin.StateRunning, dashPlugin.StateDisabled},
+		store.Statuses()["plugin1"])
+
+	require.NoError(t, manager.Restart(ctx, "plugin1"))
+	assert.Equal(t, []string{"plugin1"}, store.ClientNames())
+	assertStatus(t, dashPlugin.Status{State: dashPlugin.StateRunning, Restarts: 1},
+		[]dashPlugin.State{dashPlugin.StateRunning, dashPlugin.StateDisabled, dashPlugin.StateRunning},
+		store.Statuses()["plugin1"])
+
+	assert.Error(t, manager.Restart(ctx, "missing"))
+	assert.Error(t, manager.Disable(ctx, "missing"))
+}
+
+// assertStatus asserts a plugin status, and the states in its history.
+func assertStatus(t *testing.T, expected dashPlugin.Status, expectedHistory []dashPlugin.State, got dashPlugin.Status) {
+	var history []dashPlugin.State
+	for _, event := range got.History {
+		history = append(history, event.State)
+	}
+	assert.Equal(t, expectedHistory, history)
+
+	got.History = nil
+	assert.Equal(t, expected, got)
+}
+
+func TestManager_Print_isolatesPlugins(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	store := fake.NewMockManagerStore(controller)
+	store.EXPECT().ClientNames().Return([]string{"plugin1", "plugin2"})
+	store.EXPECT().Statuses().Return(nil).AnyTimes()
+
+	ch := make(chan dashPlugin.PrintResponse)
+	printRunner := dashPlugin.DefaultRunner{
+		RunFunc: func(ctx context.Context, name string, gvk schema.GroupVersionKind, object runtime.Object) error {
+			if name == "plugin1" {
+				panic("plugin1 failed")
+			}
+
+			ch <- dashPlugin.PrintResponse{
+				Config: []component.SummarySection{{Header: name}},
+			}
+			return nil
+		},
+	}
+
+	runners := fake.NewMockRunners(controller)
+	runners.EXPECT().Print(gomock.Eq(store)).Return(printRunner, ch)
+
+	manager := dashPlugin.NewManager(&stubAPIService{},
+		fake.NewMockModuleRegistrar(controller),
+		fake.NewMockActionRegistrar(controller),
+		func(m *dashPlugin.Manager) {
+			m.Runners = runners
+		})
+	manager.SetStore(store)
+
+	got, err := manager.Print(context.Background(), testutil.CreatePod("pod"))
+	require.NoError(t, err)
+
+	expected := &dashPlugin.PrintResponse{
+		Config: []component.SummarySection{{Header: "plugin2"}},
+	}
+	assert.Equal(t, expected, got)
+}
+
+func TestManager_ObjectStatus(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	store := fake.NewMockManagerStore(controller)
+	store.EXPECT().ClientNames().Return([]string{"plugin1", "plugin2", "plugin3"})
+	store.EXPECT().Statuses().Return(nil).AnyTimes()
+
+	responses := map[string]dashPlugin.ObjectStatusResponse{
+		"plugin1": {ObjectStatus: component.PodSummary{
+			Details: []component.Component{component.NewText("plugin1")},
+			Status:  component.NodeStatusWarning,
+		}},
+		"plugin2": {ObjectStatus: component.PodSummary{
+			Details: []component.Component{component.NewText("plugin2")},
+		}},
+		"plugin3": {ObjectStatus: component.PodSummary{
+			Status: component.NodeStatusOK,
+		}},
+	}
+
+	ch := make(chan dashPlugin.ObjectStatusResponse)
+	objectStatusRunner := dashPlugin.DefaultRunner{
+		RunFunc: func(ctx context.Context, name string, gvk schema.GroupVersionKind, object runtime.Object) error {
+			ch <- responses[name]
+			return nil
+		},
+	}
+
+	runners := fake.NewMockRunners(controller)
+	runners.EXPECT().ObjectStatus(gomock.Eq(store)).Return(objectStatusRunner, ch)
+
+	manager := dashPlugin.NewManager(&stubAPIService{},
+		fake.NewMockModuleRegistrar(controller),
+		fake.NewMockActionRegistrar(controller),
+		func(m *dashPlugin.Manager) {
+			m.Runners = runners
+		})
+	manager.SetStore(store)
+
+	got, err := manager.ObjectStatus(context.Background(), testutil.CreatePod("pod"))
+	require.NoError(t, err)
+
+	require.Equal(t, component.NodeStatusWarning, got.ObjectStatus.Status)
+	require.ElementsMatch(t, []component.Component{
+		component.NewText("plugin1"),
+		component.NewText("plugin2"),
+	}, got.ObjectStatus.Details)
+}
+
+func TestManager_incompatible(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	service := fake.NewMockService(controller)
+	service.EXPECT().Register(gomock.Any(), gomock.Any()).Return(dashPlugin.Metadata{}, &dashPlugin.IncompatibleError{})
+
+	clientProtocol := fake.NewMockClientProtocol(controller)
+	clientProtocol.EXPECT().Dispense("plugin").Return(service, nil)
+	client := &fakePluginClient{clientProtocol: clientProtocol, name: "plugin1"}
+
+	clientFactory := fake.NewMockClientFactory(controller)
+	clientFactory.EXPECT().Init(gomock.Any(), "plugin1").Return(client)
+
+	manager := dashPlugin.NewManager(&stubAPIService{},
+		fake.NewMockModuleRegistrar(controller),
+		fake.NewMockActionRegistrar(controller),
+		func(m *dashPlugin.Manager) {
+			m.ClientFactory = clientFactory
+		})
+
+	require.NoError(t, manager.Load("plugin1"))
+
+	ctx := context.Background()
+	require.NoError(t, manager.Start(ctx))
+
+	store := manager.Store()
+	assert.Empty(t, store.ClientNames())
+	assert.True(t, client.killed)
+	assert.Equal(t, dashPlugin.StateIncompatible, store.Statuses()["plugin1"].State)
+
+	// incompatible plugins are not restarted
+	manager.Reconcile(ctx)
+	assert.Equal(t, dashPlugin.StateIncompatible, store.Statuses()["plugin1"].State)
+}
+
+func TestManager_LoadRemote(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	service := fake.NewMockService(controller)
+	service.EXPECT().Register(gomock.Any(), gomock.Eq("localhost:54321")).
+		Return(dashPlugin.Metadata{Name: "org-plugin"}, nil)
+
+	go func() {
+		_ = dashPlugin.ServeRemote(service, address, nil)
+	}()
+	waitForListener(t, address)
+
+	manager := dashPlugin.NewManager(&stubAPIService{},
+		fake.NewMockModuleRegistrar(controller),
+		fake.NewMockActionRegistrar(controller),
+		dashPlugin.WithConfig(&stubConfig{fs: afero.NewMemMapFs(), dirs: []string{"/plugins"}}))
+
+	remotePlugin := dashPlugin.RemotePlugin{Name: "org-plugin", Address: address}
+	require.NoError(t, manager.LoadRemote(remotePlugin))
+	require.Error(t, manager.LoadRemote(remotePlugin))
+
+	ctx := context.Background()
+
+	manager.Reconcile(ctx)
+	store := manager.Store()
+	assert.Equal(t, []string{"org-plugin"}, store.ClientNames())
+	assert.Equal(t, dashPlugin.StateRunning, store.Statuses()["org-plugin"].State)
+
+	command, err := store.GetCommand("org-plugin")
+	require.NoError(t, err)
+	assert.Equal(t, address, command)
+
+	// remote plugins are not in the plugin directories, but are kept
+	manager.Reconcile(ctx)
+	assert.Equal(t, []string{"org-plugin"}, store.ClientNames())
+	assert.Equal(t, dashPlugin.StateRunning, store.Statuses()["org-plugin"].State)
+
+	manager.Stop(ctx)
+}
+
+func TestManager_Start_unreachable_remote(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	manager := dashPlugin.NewManager(&stubAPIService{},
+		fake.NewMockModuleRegistrar(controller),
+		fake.NewMockActionRegistrar(controller))
+
+	require.NoError(t, manager.LoadRemote(dashPlugin.RemotePlugin{Name: "org-plugin", Address: address}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// remote plugins which can't be connected to don't stop Octant from
+	// starting
+	require.NoError(t, manager.Start(ctx))
+
+	store := manager.Store()
+	assert.Empty(t, store.ClientNames())
+
+	status := store.Statuses()["org-plugin"]
+	assert.Equal(t, dashPlugin.StateRestarting, status.State)
+	assert.Equal(t, 1, status.Errors)
+
+	// the plugin isn't reconnected to until its backoff passes
+	manager.Reconcile(ctx)
+	assert.Equal(t, 1, store.Statuses()["org-plugin"].Errors)
+
+	manager.Stop(ctx)
+}
+
+func waitForListener(t *testing.T, address string) {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		conn, err := net.Dial("tcp", address)
+		if err == nil {
+			require.NoError(t, conn.Close())
+			return
+		}
+
+		if time.Now().After(deadline) {
+			require.NoError(t, err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
 type fakePluginClient struct {
 	clientProtocol *fake.MockClientProtocol
 	service        *fake.MockService
 	name           string
+	killed         bool
 }
 
 var _ dashPlugin.Client = (*fakePluginClient)(nil)
@@ -226,7 +665,9 @@ func (c *fakePluginClient) Client() (plugin.ClientProtocol, error) {
 	return c.clientProtocol, nil
 }
 
-func (c *fakePluginClient) Kill() {}
+func (c *fakePluginClient) Kill() {
+	c.killed = true
+}
 
 type stubAPIService struct{}
 
@@ -239,3 +680,22 @@ func (f *stubAPIService) Addr() string {
 func (f *stubAPIService) Start(context.Context) error {
 	return nil
 }
+
+type stubConfig struct {
+	fs   afero.Fs
+	dirs []string
+}
+
+var _ dashPlugin.Config = (*stubConfig)(nil)
+
+func (c *stubConfig) PluginDirs() ([]string, error) {
+	return c.dirs, nil
+}
+
+func (c *stubConfig) Home() string {
+	return "/home"
+}
+
+func (c *stubConfig) Fs() afero.Fs {
+	return c.fs
+}
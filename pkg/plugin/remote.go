@@ -0,0 +1,263 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/vmware/octant/pkg/plugin/dashboard"
+)
+
+// dialTimeout is how long Octant waits to connect to a remote plugin.
+const dialTimeout = 10 * time.Second
+
+// RemotePlugin is a plugin which runs as a gRPC service, rather than being
+// started by Octant.
+type RemotePlugin struct {
+	// Name is the name of the plugin.
+	Name string `json:"name"`
+	// Address is the host:port of the plugin.
+	Address string `json:"address"`
+	// TLS configures TLS for connections to the plugin. Connections are
+	// not encrypted if it is nil.
+	TLS *RemoteTLS `json:"tls,omitempty"`
+
+	tlsConfig *tls.Config
+}
+
+// RemoteTLS is the TLS configuration for a remote plugin.
+type RemoteTLS struct {
+	// CAFile is the CA bundle used to verify the plugin's certificate. The
+	// system CAs are used if it is blank.
+	CAFile string `json:"caFile,omitempty"`
+	// CertFile and KeyFile are the client certificate Octant presents to
+	// the plugin, if any.
+	CertFile string `json:"certFile,omitempty"`
+	KeyFile  string `json:"keyFile,omitempty"`
+	// ServerName overrides the name used to verify the plugin's
+	// certificate.
+	ServerName string `json:"serverName,omitempty"`
+	// InsecureSkipVerify skips verifying the plugin's certificate.
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
+// LoadRemotePlugins loads remote plugins from a YAML or JSON file which
+// lists them, e.g.:
+//
+//	[{"name": "org-plugin", "address": "plugins.example.com:8443", "tls": {"caFile": "/etc/octant/ca.pem"}}]
+func LoadRemotePlugins(fs afero.Fs, filename string) ([]RemotePlugin, error) {
+	data, err := afero.ReadFile(fs, filename)
+	if err != nil {
+		return nil, errors.Wrap(err, "read remote plugins")
+	}
+
+	var remotePlugins []RemotePlugin
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), len(data))
+	if err := decoder.Decode(&remotePlugins); err != nil && err != io.EOF {
+		return nil, errors.Wrapf(err, "parse remote plugins in %s", filename)
+	}
+
+	for i := range remotePlugins {
+		remotePlugin := &remotePlugins[i]
+		if remotePlugin.Name == "" || remotePlugin.Address == "" {
+			return nil, errors.Errorf("remote plugin %d in %s requires a name and an address", i, filename)
+		}
+
+		if remotePlugin.TLS == nil {
+			continue
+		}
+
+		tlsConfig, err := remotePlugin.TLS.config(fs)
+		if err != nil {
+			return nil, errors.Wrapf(err, "configure TLS for remote plugin %q", remotePlugin.Name)
+		}
+		remotePlugin.tlsConfig = tlsConfig
+	}
+
+	return remotePlugins, nil
+}
+
+// config creates a TLS config, loading certificates from fs.
+func (t *RemoteTLS) config(fs afero.Fs) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		ServerName:         t.ServerName,
+		InsecureSkipVerify: t.InsecureSkipVerify,
+	}
+
+	if t.CAFile != "" {
+		data, err := afero.ReadFile(fs, t.CAFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "read CA file")
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, errors.Errorf("CA file %s has no certificates", t.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if t.CertFile != "" || t.KeyFile != "" {
+		certPEM, err := afero.ReadFile(fs, t.CertFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "read certificate file")
+		}
+
+		keyPEM, err := afero.ReadFile(fs, t.KeyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "read key file")
+		}
+
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, errors.Wrap(err, "load certificate")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// remoteClient is a Client for a remote plugin. It connects when the
+// plugin is started, and disconnects when it is killed.
+type remoteClient struct {
+	remotePlugin RemotePlugin
+
+	mu   sync.Mutex
+	conn *grpc.ClientConn
+}
+
+var _ Client = (*remoteClient)(nil)
+
+func newRemoteClient(remotePlugin RemotePlugin) *remoteClient {
+	return &remoteClient{remotePlugin: remotePlugin}
+}
+
+// connect connects to the plugin, if it isn't connected. It blocks until
+// the plugin is connected to, or dialTimeout passes.
+func (c *remoteClient) connect() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn != nil {
+		return nil
+	}
+
+	creds := grpc.WithInsecure()
+	if c.remotePlugin.tlsConfig != nil {
+		creds = grpc.WithTransportCredentials(credentials.NewTLS(c.remotePlugin.tlsConfig))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), dialTimeout)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, c.remotePlugin.Address, creds,
+		grpc.WithBlock(), grpc.FailOnNonTempDialError(true))
+	if err != nil {
+		return errors.Wrapf(err, "connect to remote plugin at %s", c.remotePlugin.Address)
+	}
+	c.conn = conn
+
+	return nil
+}
+
+// Client connects to the plugin, if it isn't connected, and returns a
+// protocol client for it.
+func (c *remoteClient) Client() (plugin.ClientProtocol, error) {
+	if err := c.connect(); err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return &remoteClientProtocol{conn: c.conn}, nil
+}
+
+// Kill disconnects from the plugin. The plugin keeps running.
+func (c *remoteClient) Kill() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn != nil {
+		_ = c.conn.Close()
+		c.conn = nil
+	}
+}
+
+// remoteClientProtocol dispenses the service of a remote plugin.
+type remoteClientProtocol struct {
+	conn *grpc.ClientConn
+}
+
+var _ plugin.ClientProtocol = (*remoteClientProtocol)(nil)
+
+// Close does nothing. The connection is closed when the client is killed.
+func (p *remoteClientProtocol) Close() error {
+	return nil
+}
+
+// Dispense dispenses the plugin's service.
+func (p *remoteClientProtocol) Dispense(name string) (interface{}, error) {
+	if name != Name {
+		return nil, errors.Errorf("unknown plugin type %q", name)
+	}
+
+	return NewGRPCClient(nil, dashboard.NewPluginClient(p.conn)), nil
+}
+
+// Ping checks the health of the plugin.
+func (p *remoteClientProtocol) Ping() error {
+	client := grpc_health_v1.NewHealthClient(p.conn)
+	_, err := client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{
+		Service: plugin.GRPCServiceName,
+	})
+
+	return err
+}
+
+// ServeRemote serves a plugin as a gRPC service on address, so it can be
+// used as a remote plugin. Connections are not encrypted if tlsConfig is nil.
+func ServeRemote(service Service, address string, tlsConfig *tls.Config) error {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return errors.Wrapf(err, "listen on %s", address)
+	}
+
+	return serveRemote(service, listener, tlsConfig)
+}
+
+func serveRemote(service Service, listener net.Listener, tlsConfig *tls.Config) error {
+	var options []grpc.ServerOption
+	if tlsConfig != nil {
+		options = append(options, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	server := grpc.NewServer(options...)
+	dashboard.RegisterPluginServer(server, &GRPCServer{Impl: service})
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(plugin.GRPCServiceName, grpc_health_v1.HealthCheckResponse_SERVING)
+	grpc_health_v1.RegisterHealthServer(server, healthServer)
+
+	return server.Serve(listener)
+}
@@ -0,0 +1,163 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware/octant/pkg/action"
+)
+
+func TestLoadRemotePlugins(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected []RemotePlugin
+		isErr    bool
+	}{
+		{
+			name: "yaml",
+			data: "- name: org-plugin\n  address: plugins.example.com:8443\n",
+			expected: []RemotePlugin{
+				{Name: "org-plugin", Address: "plugins.example.com:8443"},
+			},
+		},
+		{
+			name: "json",
+			data: `[{"name": "org-plugin", "address": "plugins.example.com:8443"}]`,
+			expected: []RemotePlugin{
+				{Name: "org-plugin", Address: "plugins.example.com:8443"},
+			},
+		},
+		{
+			name: "empty",
+			data: "",
+		},
+		{
+			name:  "missing address",
+			data:  "- name: org-plugin\n",
+			isErr: true,
+		},
+		{
+			name:  "missing CA file",
+			data:  "- name: org-plugin\n  address: plugins.example.com:8443\n  tls:\n    caFile: /ca.pem\n",
+			isErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, "/remote-plugins.yaml", []byte(test.data), 0600))
+
+			got, err := LoadRemotePlugins(fs, "/remote-plugins.yaml")
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func TestLoadRemotePlugins_tls(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	data := "- name: org-plugin\n  address: plugins.example.com:8443\n  tls:\n    serverName: plugins\n"
+	require.NoError(t, afero.WriteFile(fs, "/remote-plugins.yaml", []byte(data), 0600))
+
+	got, err := LoadRemotePlugins(fs, "/remote-plugins.yaml")
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+
+	require.NotNil(t, got[0].tlsConfig)
+	assert.Equal(t, "plugins", got[0].tlsConfig.ServerName)
+}
+
+func Test_remoteClient(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	service := &remoteService{}
+	go func() {
+		_ = serveRemote(service, listener, nil)
+	}()
+	defer listener.Close()
+
+	client := newRemoteClient(RemotePlugin{Name: "org-plugin", Address: listener.Addr().String()})
+	defer client.Kill()
+
+	rpcClient, err := client.Client()
+	require.NoError(t, err)
+	require.NoError(t, rpcClient.Ping())
+
+	raw, err := rpcClient.Dispense(Name)
+	require.NoError(t, err)
+
+	pluginService, ok := raw.(Service)
+	require.True(t, ok)
+
+	ctx := WithSettings(context.Background(), Settings{"key": "value"})
+	metadata, err := pluginService.Register(ctx, "127.0.0.1:7777")
+	require.NoError(t, err)
+
+	assert.Equal(t, Metadata{Name: "org-plugin", Description: "remote", APIVersion: APIVersion}, metadata)
+	assert.Equal(t, "127.0.0.1:7777", service.address)
+	assert.Equal(t, Settings{"key": "value"}, service.settings)
+
+	_, err = rpcClient.Dispense("other")
+	require.Error(t, err)
+}
+
+func Test_remoteClient_unavailable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	client := newRemoteClient(RemotePlugin{Name: "org-plugin", Address: address})
+
+	_, err = client.Client()
+	require.Error(t, err)
+}
+
+// remoteService is a Service served by a remote plugin.
+type remoteService struct {
+	address  string
+	settings Settings
+}
+
+var _ Service = (*remoteService)(nil)
+
+func (s *remoteService) Register(ctx context.Context, dashboardAPIAddress string) (Metadata, error) {
+	s.address = dashboardAPIAddress
+	s.settings = SettingsFrom(ctx)
+	return Metadata{Name: "org-plugin", Description: "remote"}, nil
+}
+
+func (s *remoteService) Print(ctx context.Context, object runtime.Object) (PrintResponse, error) {
+	return PrintResponse{}, nil
+}
+
+func (s *remoteService) PrintTab(ctx context.Context, object runtime.Object) (TabResponse, error) {
+	return TabResponse{}, nil
+}
+
+func (s *remoteService) ObjectStatus(ctx context.Context, object runtime.Object) (ObjectStatusResponse, error) {
+	return ObjectStatusResponse{}, nil
+}
+
+func (s *remoteService) HandleAction(ctx context.Context, payload action.Payload) error {
+	return nil
+}
@@ -188,7 +188,7 @@ func ObjectStatusRunner(store ManagerStore, ch chan<- ObjectStatusResponse) Defa
 				return err
 			}
 
-			if !metadata.Capabilities.HasPrinterSupport(gvk) {
+			if !metadata.Capabilities.HasObjectStatusSupport(gvk) {
 				return nil
 			}
 
@@ -186,3 +186,59 @@ func Test_TabRunner(t *testing.T) {
 	ctx := context.Background()
 	require.NoError(t, runner.Run(ctx, object, clientNames))
 }
+
+func Test_ObjectStatusRunner(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	store := fake.NewMockManagerStore(controller)
+	service := fake.NewMockService(controller)
+
+	object := testutil.CreateDeployment("deployment")
+	clientNames := []string{"plugin1", "plugin2"}
+
+	plugin1Metadata := &plugin.Metadata{
+		Capabilities: plugin.Capabilities{
+			SupportsObjectStatus: []schema.GroupVersionKind{gvk.Deployment},
+		},
+	}
+	store.EXPECT().
+		GetMetadata(gomock.Eq("plugin1")).Return(plugin1Metadata, nil)
+
+	plugin2Metadata := &plugin.Metadata{
+		Capabilities: plugin.Capabilities{
+			SupportsPrinterConfig: []schema.GroupVersionKind{gvk.Deployment},
+		},
+	}
+	store.EXPECT().
+		GetMetadata(gomock.Eq("plugin2")).Return(plugin2Metadata, nil)
+
+	store.EXPECT().
+		GetService(gomock.Eq("plugin1")).Return(service, nil)
+
+	osr := plugin.ObjectStatusResponse{
+		ObjectStatus: component.PodSummary{Status: component.NodeStatusWarning},
+	}
+
+	service.EXPECT().
+		ObjectStatus(gomock.Any(), gomock.Eq(object)).Return(osr, nil)
+
+	ch := make(chan plugin.ObjectStatusResponse)
+	defer close(ch)
+
+	runner := plugin.ObjectStatusRunner(store, ch)
+
+	done := make(chan bool)
+	go func() {
+		resp := <-ch
+		assert.Equal(t, osr, resp)
+		done <- true
+	}()
+
+	defer func() {
+		<-done
+	}()
+
+	ctx := context.Background()
+	require.NoError(t, runner.Run(ctx, object, clientNames))
+}
@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package service
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware/octant/pkg/plugin"
+)
+
+// Builder builds a plugin. The capabilities of the plugin are derived from
+// the handlers it is built with, e.g.:
+//
+//	p, err := service.NewBuilder("my-plugin", "a description").
+//		HandlesObjects(podGVK).
+//		WithPrinter(handlePrint).
+//		WithTab(handleTab).
+//		WithAction("my-plugin/refresh", handleRefresh).
+//		Register()
+type Builder struct {
+	name        string
+	description string
+	gvks        []schema.GroupVersionKind
+	handlers    HandlerFuncs
+	actions     map[string]HandlerActionFunc
+	options     []PluginOption
+}
+
+// NewBuilder creates an instance of Builder.
+func NewBuilder(name, description string) *Builder {
+	return &Builder{
+		name:        name,
+		description: description,
+		actions:     make(map[string]HandlerActionFunc),
+	}
+}
+
+// HandlesObjects sets the objects the plugin's printer, tab, and object
+// status handlers are called for.
+func (b *Builder) HandlesObjects(gvks ...schema.GroupVersionKind) *Builder {
+	b.gvks = append(b.gvks, gvks...)
+	return b
+}
+
+// WithPrinter adds a printer, which adds to the summary of objects.
+func (b *Builder) WithPrinter(fn HandlerPrinterFunc) *Builder {
+	b.handlers.Print = fn
+	return b
+}
+
+// WithTab adds a tab printer, which adds a tab to objects.
+func (b *Builder) WithTab(fn HandlerTabPrintFunc) *Builder {
+	b.handlers.PrintTab = fn
+	return b
+}
+
+// WithObjectStatus adds an object status handler, which adds to the status
+// of objects.
+func (b *Builder) WithObjectStatus(fn HandlerObjectStatusFunc) *Builder {
+	b.handlers.ObjectStatus = fn
+	return b
+}
+
+// WithAction adds a handler for an action. Actions are routed to their
+// handler by name.
+func (b *Builder) WithAction(name string, fn HandlerActionFunc) *Builder {
+	b.actions[name] = fn
+	return b
+}
+
+// WithNavigation adds navigation and content routes. A plugin with
+// navigation is a module.
+func (b *Builder) WithNavigation(fn HandlerNavigationFunc, routerInit HandlerInitRoutesFunc) *Builder {
+	b.handlers.Navigation = fn
+	b.handlers.InitRoutes = routerInit
+	return b
+}
+
+// WithOptions adds options to the plugin.
+func (b *Builder) WithOptions(options ...PluginOption) *Builder {
+	b.options = append(b.options, options...)
+	return b
+}
+
+// Capabilities returns the capabilities of the plugin being built.
+func (b *Builder) Capabilities() *plugin.Capabilities {
+	capabilities := &plugin.Capabilities{
+		IsModule: b.handlers.Navigation != nil,
+	}
+
+	if b.handlers.Print != nil {
+		capabilities.SupportsPrinterConfig = b.objects()
+	}
+	if b.handlers.PrintTab != nil {
+		capabilities.SupportsTab = b.objects()
+	}
+	if b.handlers.ObjectStatus != nil {
+		capabilities.SupportsObjectStatus = b.objects()
+	}
+
+	for name := range b.actions {
+		capabilities.ActionNames = append(capabilities.ActionNames, name)
+	}
+	sort.Strings(capabilities.ActionNames)
+
+	return capabilities
+}
+
+// Register registers the plugin with Octant.
+func (b *Builder) Register() (*Plugin, error) {
+	hasObjectHandler := b.handlers.Print != nil || b.handlers.PrintTab != nil || b.handlers.ObjectStatus != nil
+	if hasObjectHandler && len(b.gvks) == 0 {
+		return nil, errors.New("plugin has printers but handles no objects")
+	}
+
+	options := []PluginOption{
+		WithPrinter(b.handlers.Print),
+		WithTabPrinter(b.handlers.PrintTab),
+		WithObjectStatus(b.handlers.ObjectStatus),
+		WithNavigation(b.handlers.Navigation, b.handlers.InitRoutes),
+	}
+
+	if len(b.actions) > 0 {
+		options = append(options, WithActionHandler(b.handleAction))
+	}
+
+	return Register(b.name, b.description, b.Capabilities(), append(options, b.options...)...)
+}
+
+// handleAction routes an action to its handler.
+func (b *Builder) handleAction(request *ActionRequest) error {
+	actionName, err := request.Payload.String("action")
+	if err != nil {
+		return err
+	}
+
+	fn, ok := b.actions[actionName]
+	if !ok {
+		return errors.Errorf("plugin %q does not handle action %q", b.name, actionName)
+	}
+
+	return fn(request)
+}
+
+func (b *Builder) objects() []schema.GroupVersionKind {
+	return append([]schema.GroupVersionKind(nil), b.gvks...)
+}
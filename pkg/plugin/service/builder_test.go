@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware/octant/internal/gvk"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/navigation"
+	"github.com/vmware/octant/pkg/plugin"
+)
+
+func TestBuilder_Capabilities(t *testing.T) {
+	printFunc := func(*PrintRequest) (plugin.PrintResponse, error) {
+		return plugin.PrintResponse{}, nil
+	}
+	tabFunc := func(*PrintRequest) (plugin.TabResponse, error) {
+		return plugin.TabResponse{}, nil
+	}
+	objectStatusFunc := func(*PrintRequest) (plugin.ObjectStatusResponse, error) {
+		return plugin.ObjectStatusResponse{}, nil
+	}
+	actionFunc := func(*ActionRequest) error {
+		return nil
+	}
+	navigationFunc := func(*NavigationRequest) (navigation.Navigation, error) {
+		return navigation.Navigation{}, nil
+	}
+
+	tests := []struct {
+		name     string
+		builder  *Builder
+		expected *plugin.Capabilities
+	}{
+		{
+			name:     "no handlers",
+			builder:  NewBuilder("name", "description").HandlesObjects(gvk.Pod),
+			expected: &plugin.Capabilities{},
+		},
+		{
+			name: "object handlers",
+			builder: NewBuilder("name", "description").
+				HandlesObjects(gvk.Pod, gvk.Deployment).
+				WithPrinter(printFunc).
+				WithTab(tabFunc).
+				WithObjectStatus(objectStatusFunc),
+			expected: &plugin.Capabilities{
+				SupportsPrinterConfig: []schema.GroupVersionKind{gvk.Pod, gvk.Deployment},
+				SupportsTab:           []schema.GroupVersionKind{gvk.Pod, gvk.Deployment},
+				SupportsObjectStatus:  []schema.GroupVersionKind{gvk.Pod, gvk.Deployment},
+			},
+		},
+		{
+			name: "actions and navigation",
+			builder: NewBuilder("name", "description").
+				WithAction("name/b", actionFunc).
+				WithAction("name/a", actionFunc).
+				WithNavigation(navigationFunc, nil),
+			expected: &plugin.Capabilities{
+				ActionNames: []string{"name/a", "name/b"},
+				IsModule:    true,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.builder.Capabilities())
+		})
+	}
+}
+
+func TestBuilder_Register(t *testing.T) {
+	p, err := NewBuilder("name", "description").
+		HandlesObjects(gvk.Pod).
+		WithPrinter(func(*PrintRequest) (plugin.PrintResponse, error) {
+			return plugin.PrintResponse{}, nil
+		}).
+		Register()
+	require.NoError(t, err)
+
+	expected := &plugin.Capabilities{
+		SupportsPrinterConfig: []schema.GroupVersionKind{gvk.Pod},
+	}
+	assert.Equal(t, expected, p.pluginHandler.capabilities)
+	assert.NotNil(t, p.pluginHandler.HandlerFuncs.Print)
+}
+
+func TestBuilder_Register_without_objects(t *testing.T) {
+	_, err := NewBuilder("name", "description").
+		WithTab(func(*PrintRequest) (plugin.TabResponse, error) {
+			return plugin.TabResponse{}, nil
+		}).
+		Register()
+	require.Error(t, err)
+}
+
+func TestBuilder_actions(t *testing.T) {
+	var handled []string
+	handler := func(name string, err error) HandlerActionFunc {
+		return func(*ActionRequest) error {
+			handled = append(handled, name)
+			return err
+		}
+	}
+
+	p, err := NewBuilder("name", "description").
+		WithAction("name/a", handler("a", nil)).
+		WithAction("name/b", handler("b", errors.New("failed"))).
+		Register()
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	handleAction := p.pluginHandler.HandlerFuncs.HandleAction
+
+	require.NoError(t, handleAction(NewActionRequest(ctx, nil, action.CreatePayload("name/a", nil))))
+	require.Error(t, handleAction(NewActionRequest(ctx, nil, action.CreatePayload("name/b", nil))))
+	require.Error(t, handleAction(NewActionRequest(ctx, nil, action.CreatePayload("name/c", nil))))
+	require.Error(t, handleAction(NewActionRequest(ctx, nil, action.Payload{})))
+
+	assert.Equal(t, []string{"a", "b"}, handled)
+}
@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package service
+
+import (
+	"github.com/vmware/octant/pkg/plugin"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// TextSection creates a summary section with text. Printers use it to add
+// to the configuration and status of objects.
+func TextSection(header, text string) component.SummarySection {
+	return component.SummarySection{
+		Header:  header,
+		Content: component.NewText(text),
+	}
+}
+
+// NewTab creates a tab. Each view is shown full width in its own row.
+func NewTab(name string, views ...component.Component) *component.Tab {
+	layout := component.NewFlexLayout(name)
+	for _, view := range views {
+		layout.AddSections(component.FlexLayoutSection{
+			{Width: component.WidthFull, View: view},
+		})
+	}
+
+	return component.NewTabWithContents(*layout)
+}
+
+// NewCard creates a card with markdown text as its body.
+func NewCard(title, markdown string) *component.Card {
+	card := component.NewCard(title)
+	card.SetBody(component.NewMarkdownText(markdown))
+	return card
+}
+
+// NewObjectStatus creates an object status with a status and details.
+// Leave status blank to only add details to the status Octant computes.
+func NewObjectStatus(status component.NodeStatus, details ...string) plugin.ObjectStatusResponse {
+	var components []component.Component
+	for _, detail := range details {
+		components = append(components, component.NewText(detail))
+	}
+
+	return plugin.ObjectStatusResponse{
+		ObjectStatus: component.PodSummary{
+			Details: components,
+			Status:  status,
+		},
+	}
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware/octant/pkg/plugin"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func TestNewTab(t *testing.T) {
+	text := component.NewText("text")
+	card := NewCard("title", "body")
+
+	got := NewTab("Tab", text, card)
+
+	layout := component.NewFlexLayout("Tab")
+	layout.AddSections(
+		component.FlexLayoutSection{{Width: component.WidthFull, View: text}},
+		component.FlexLayoutSection{{Width: component.WidthFull, View: card}},
+	)
+
+	assert.Equal(t, "Tab", got.Name)
+	assert.Equal(t, *layout, got.Contents)
+}
+
+func TestNewObjectStatus(t *testing.T) {
+	got := NewObjectStatus(component.NodeStatusWarning, "a", "b")
+
+	expected := plugin.ObjectStatusResponse{
+		ObjectStatus: component.PodSummary{
+			Details: []component.Component{component.NewText("a"), component.NewText("b")},
+			Status:  component.NodeStatusWarning,
+		},
+	}
+
+	assert.Equal(t, expected, got)
+}
@@ -22,6 +22,7 @@ type Handler struct {
 	name         string
 	description  string
 	capabilities *plugin.Capabilities
+	settings     plugin.Settings
 
 	dashboardFactory func(dashboardAPIAddress string) (Dashboard, error)
 	dashboardClient  Dashboard
@@ -39,7 +40,8 @@ func (p *Handler) Validate() error {
 	return nil
 }
 
-// Register registers a plugin with Octant.
+// Register registers a plugin with Octant. The settings Octant passes are
+// available to the plugin's requests.
 func (p *Handler) Register(ctx context.Context, dashboardAPIAddress string) (plugin.Metadata, error) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
@@ -50,6 +52,7 @@ func (p *Handler) Register(ctx context.Context, dashboardAPIAddress string) (plu
 	}
 
 	p.dashboardClient = client
+	p.settings = plugin.SettingsFrom(ctx)
 
 	return plugin.Metadata{
 		Name:         p.name,
@@ -65,7 +68,7 @@ func (p *Handler) Print(ctx context.Context, object runtime.Object) (plugin.Prin
 	}
 
 	request := &PrintRequest{
-		baseRequest:     newBaseRequest(ctx, p.name),
+		baseRequest:     newBaseRequest(ctx, p.name, p.settings),
 		DashboardClient: p.dashboardClient,
 		Object:          object,
 	}
@@ -80,7 +83,7 @@ func (p *Handler) PrintTab(ctx context.Context, object runtime.Object) (plugin.T
 	}
 
 	request := &PrintRequest{
-		baseRequest:     newBaseRequest(ctx, p.name),
+		baseRequest:     newBaseRequest(ctx, p.name, p.settings),
 		DashboardClient: p.dashboardClient,
 		Object:          object,
 	}
@@ -95,7 +98,7 @@ func (p *Handler) ObjectStatus(ctx context.Context, object runtime.Object) (plug
 	}
 
 	request := &PrintRequest{
-		baseRequest:     newBaseRequest(ctx, p.name),
+		baseRequest:     newBaseRequest(ctx, p.name, p.settings),
 		DashboardClient: p.dashboardClient,
 		Object:          object,
 	}
@@ -110,7 +113,7 @@ func (p *Handler) HandleAction(ctx context.Context, payload action.Payload) erro
 	}
 
 	request := &ActionRequest{
-		baseRequest:     newBaseRequest(ctx, p.name),
+		baseRequest:     newBaseRequest(ctx, p.name, p.settings),
 		DashboardClient: p.dashboardClient,
 		Payload:         payload,
 	}
@@ -125,7 +128,7 @@ func (p *Handler) Navigation(ctx context.Context) (navigation.Navigation, error)
 	}
 
 	request := &NavigationRequest{
-		baseRequest:     newBaseRequest(ctx, p.name),
+		baseRequest:     newBaseRequest(ctx, p.name, p.settings),
 		DashboardClient: p.dashboardClient,
 	}
 
@@ -140,7 +143,7 @@ func (p *Handler) Content(ctx context.Context, contentPath string) (component.Co
 	}
 
 	request := &Request{
-		baseRequest:     newBaseRequest(ctx, p.name),
+		baseRequest:     newBaseRequest(ctx, p.name, p.settings),
 		dashboardClient: p.dashboardClient,
 		Path:            contentPath,
 	}
@@ -38,7 +38,8 @@ func TestHandler_Register(t *testing.T) {
 		dashboardFactory: factory,
 	}
 
-	ctx := context.Background()
+	settings := plugin.Settings{"endpoint": "http://localhost:9090"}
+	ctx := plugin.WithSettings(context.Background(), settings)
 	got, err := h.Register(ctx, "address")
 	require.NoError(t, err)
 
@@ -49,6 +50,7 @@ func TestHandler_Register(t *testing.T) {
 	}
 
 	require.Equal(t, expected, got)
+	require.Equal(t, settings, h.settings)
 }
 
 func TestHandler_Register_with_dashboard_factory_failure(t *testing.T) {
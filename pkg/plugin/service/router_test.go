@@ -82,7 +82,7 @@ func TestRouter_Match(t *testing.T) {
 			require.True(t, ok)
 
 			request := &Request{
-				baseRequest:     newBaseRequest(context.Background(), "plugin-name"),
+				baseRequest:     newBaseRequest(context.Background(), "plugin-name", nil),
 				dashboardClient: nil,
 				Path:            test.path,
 			}
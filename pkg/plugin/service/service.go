@@ -7,6 +7,7 @@ package service
 
 import (
 	"context"
+	"crypto/tls"
 	"path"
 	"strings"
 
@@ -129,15 +130,24 @@ func (p *Plugin) Serve() {
 	p.serverFactory(p.pluginHandler)
 }
 
+// ServeRemote serves a plugin as a gRPC service on address, for Octant to
+// connect to as a remote plugin. Connections are not encrypted if
+// tlsConfig is nil.
+func (p *Plugin) ServeRemote(address string, tlsConfig *tls.Config) error {
+	return plugin.ServeRemote(p.pluginHandler, address, tlsConfig)
+}
+
 type baseRequest struct {
 	ctx        context.Context
 	pluginName string
+	settings   plugin.Settings
 }
 
-func newBaseRequest(ctx context.Context, pluginName string) baseRequest {
+func newBaseRequest(ctx context.Context, pluginName string, settings plugin.Settings) baseRequest {
 	return baseRequest{
 		ctx:        ctx,
 		pluginName: pluginName,
+		settings:   settings,
 	}
 }
 
@@ -145,6 +155,12 @@ func (r *baseRequest) Context() context.Context {
 	return r.ctx
 }
 
+// Settings returns the settings Octant passed to the plugin when it
+// registered.
+func (r *baseRequest) Settings() plugin.Settings {
+	return r.settings
+}
+
 func (r *baseRequest) GeneratePath(pathParts ...string) string {
 	return path.Join(append([]string{r.pluginName}, pathParts...)...)
 }
@@ -157,6 +173,16 @@ type PrintRequest struct {
 	Object          runtime.Object
 }
 
+// NewPrintRequest creates a print request. Plugins use it to test their
+// printers, e.g. with the mock Dashboard in the fake package.
+func NewPrintRequest(ctx context.Context, dashboardClient Dashboard, object runtime.Object) *PrintRequest {
+	return &PrintRequest{
+		baseRequest:     newBaseRequest(ctx, "", nil),
+		DashboardClient: dashboardClient,
+		Object:          object,
+	}
+}
+
 // ActionRequest is a request for actions.
 type ActionRequest struct {
 	baseRequest
@@ -165,6 +191,16 @@ type ActionRequest struct {
 	Payload         action.Payload
 }
 
+// NewActionRequest creates an action request. Plugins use it to test their
+// action handlers.
+func NewActionRequest(ctx context.Context, dashboardClient Dashboard, payload action.Payload) *ActionRequest {
+	return &ActionRequest{
+		baseRequest:     newBaseRequest(ctx, "", nil),
+		DashboardClient: dashboardClient,
+		Payload:         payload,
+	}
+}
+
 // NavigationRequest is a request for navigation.
 type NavigationRequest struct {
 	baseRequest
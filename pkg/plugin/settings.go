@@ -0,0 +1,106 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Settings is the configuration for a plugin, e.g. the endpoints and
+// credentials it needs. It is passed to the plugin when it registers.
+type Settings map[string]interface{}
+
+type settingsKey struct{}
+
+// WithSettings returns a context which passes settings to the plugin
+// registered with it.
+func WithSettings(ctx context.Context, settings Settings) context.Context {
+	return context.WithValue(ctx, settingsKey{}, settings)
+}
+
+// SettingsFrom returns the settings for a context. Plugins use it while
+// registering to read the settings Octant passed them.
+func SettingsFrom(ctx context.Context) Settings {
+	settings, _ := ctx.Value(settingsKey{}).(Settings)
+	return settings
+}
+
+// encodeSettings encodes settings to send them to a plugin.
+func encodeSettings(settings Settings) ([]byte, error) {
+	if len(settings) == 0 {
+		return nil, nil
+	}
+
+	return json.Marshal(settings)
+}
+
+// decodeSettings decodes settings sent to a plugin.
+func decodeSettings(data []byte) (Settings, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var settings Settings
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// LoadSettings loads plugin settings from a YAML or JSON file. The file
+// maps the names of plugins to their settings, e.g.:
+//
+//	prometheus:
+//	  endpoint: http://prometheus:9090
+func LoadSettings(fs afero.Fs, filename string) (map[string]Settings, error) {
+	data, err := afero.ReadFile(fs, filename)
+	if err != nil {
+		return nil, errors.Wrap(err, "read plugin settings")
+	}
+
+	var all map[string]Settings
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), len(data))
+	if err := decoder.Decode(&all); err != nil && err != io.EOF {
+		return nil, errors.Wrapf(err, "parse plugin settings in %s", filename)
+	}
+
+	if all == nil {
+		all = make(map[string]Settings)
+	}
+
+	return all, nil
+}
+
+// ParseSetting parses a plugin setting in the form plugin.key=value, and
+// adds it to all. It replaces the setting loaded for the plugin, if any.
+func ParseSetting(all map[string]Settings, setting string) error {
+	parts := strings.SplitN(setting, "=", 2)
+	if len(parts) != 2 {
+		return errors.Errorf("plugin setting %q is not in the form plugin.key=value", setting)
+	}
+
+	nameAndKey := strings.SplitN(parts[0], ".", 2)
+	if len(nameAndKey) != 2 || nameAndKey[0] == "" || nameAndKey[1] == "" {
+		return errors.Errorf("plugin setting %q is not in the form plugin.key=value", setting)
+	}
+
+	name, key := nameAndKey[0], nameAndKey[1]
+	if all[name] == nil {
+		all[name] = make(Settings)
+	}
+
+	all[name][key] = parts[1]
+	return nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadSettings(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected map[string]Settings
+		isErr    bool
+	}{
+		{
+			name: "yaml",
+			data: "prometheus:\n  endpoint: http://prometheus:9090\n  timeout: 5\n",
+			expected: map[string]Settings{
+				"prometheus": {"endpoint": "http://prometheus:9090", "timeout": float64(5)},
+			},
+		},
+		{
+			name: "json",
+			data: `{"prometheus": {"endpoint": "http://prometheus:9090"}}`,
+			expected: map[string]Settings{
+				"prometheus": {"endpoint": "http://prometheus:9090"},
+			},
+		},
+		{
+			name:     "empty",
+			data:     "",
+			expected: map[string]Settings{},
+		},
+		{
+			name:  "invalid",
+			data:  "- prometheus",
+			isErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, "/plugins.yaml", []byte(test.data), 0600))
+
+			got, err := LoadSettings(fs, "/plugins.yaml")
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func TestParseSetting(t *testing.T) {
+	all := map[string]Settings{
+		"prometheus": {"endpoint": "http://prometheus:9090", "timeout": float64(5)},
+	}
+
+	require.NoError(t, ParseSetting(all, "prometheus.endpoint=http://localhost:9090"))
+	require.NoError(t, ParseSetting(all, "other.token=a=b"))
+
+	expected := map[string]Settings{
+		"prometheus": {"endpoint": "http://localhost:9090", "timeout": float64(5)},
+		"other":      {"token": "a=b"},
+	}
+	assert.Equal(t, expected, all)
+
+	for _, setting := range []string{"prometheus", "prometheus=value", ".key=value", "prometheus.=value"} {
+		assert.Error(t, ParseSetting(all, setting), setting)
+	}
+}
+
+func TestSettings_roundTrip(t *testing.T) {
+	settings := Settings{"endpoint": "http://prometheus:9090"}
+
+	data, err := encodeSettings(settings)
+	require.NoError(t, err)
+
+	got, err := decodeSettings(data)
+	require.NoError(t, err)
+	assert.Equal(t, settings, got)
+
+	ctx := WithSettings(context.Background(), got)
+	assert.Equal(t, settings, SettingsFrom(ctx))
+	assert.Nil(t, SettingsFrom(context.Background()))
+}
@@ -0,0 +1,94 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// APIVersion is the newest plugin API version this package supports.
+	// Version 2 adds plugin settings and API version negotiation.
+	APIVersion = 2
+	// MinAPIVersion is the oldest plugin API version this package
+	// supports. Version 1 is the API before versions were negotiated.
+	MinAPIVersion = 1
+)
+
+// IncompatibleError is returned when Octant and a plugin have no plugin
+// API version in common.
+type IncompatibleError struct {
+	message string
+}
+
+// Error returns the error message.
+func (e *IncompatibleError) Error() string {
+	return e.message
+}
+
+// IsIncompatible returns true if err is caused by Octant and a plugin
+// having no plugin API version in common.
+func IsIncompatible(err error) bool {
+	_, ok := errors.Cause(err).(*IncompatibleError)
+	return ok
+}
+
+// negotiateAPIVersion returns the newest plugin API version supported by
+// both this package and a peer which supports versions min to max. Peers
+// which predate versioning send no versions, and use version 1. The names
+// of both sides are used in the error.
+func negotiateAPIVersion(local, peer string, min, max int) (int, error) {
+	if max == 0 {
+		min, max = 1, 1
+	}
+	if min == 0 {
+		min = 1
+	}
+
+	version := max
+	if version > APIVersion {
+		version = APIVersion
+	}
+
+	if version < min || version < MinAPIVersion {
+		return 0, &IncompatibleError{
+			message: fmt.Sprintf("incompatible plugin API: %s supports versions %s, but %s supports versions %s",
+				local, versionRange(MinAPIVersion, APIVersion), peer, versionRange(min, max)),
+		}
+	}
+
+	return version, nil
+}
+
+func versionRange(min, max int) string {
+	if min == max {
+		return fmt.Sprintf("%d", min)
+	}
+
+	return fmt.Sprintf("%d to %d", min, max)
+}
+
+type apiVersionKey struct{}
+
+// WithAPIVersion returns a context with the plugin API version negotiated
+// with Octant.
+func WithAPIVersion(ctx context.Context, version int) context.Context {
+	return context.WithValue(ctx, apiVersionKey{}, version)
+}
+
+// APIVersionFrom returns the plugin API version negotiated with Octant.
+// Plugins use it while registering to serve older versions of Octant.
+func APIVersionFrom(ctx context.Context) int {
+	version, ok := ctx.Value(apiVersionKey{}).(int)
+	if !ok {
+		return MinAPIVersion
+	}
+
+	return version
+}
@@ -0,0 +1,81 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_negotiateAPIVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		min      int
+		max      int
+		expected int
+		isErr    bool
+	}{
+		{
+			name:     "peer predates versioning",
+			expected: 1,
+		},
+		{
+			name:     "same versions",
+			min:      MinAPIVersion,
+			max:      APIVersion,
+			expected: APIVersion,
+		},
+		{
+			name:     "older peer",
+			min:      1,
+			max:      1,
+			expected: 1,
+		},
+		{
+			name:     "newer peer which supports this version",
+			min:      1,
+			max:      APIVersion + 1,
+			expected: APIVersion,
+		},
+		{
+			name:  "newer peer which doesn't support this version",
+			min:   APIVersion + 1,
+			max:   APIVersion + 2,
+			isErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := negotiateAPIVersion("Octant", "the plugin", test.min, test.max)
+			if test.isErr {
+				require.Error(t, err)
+				assert.True(t, IsIncompatible(err))
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func Test_negotiateAPIVersion_error(t *testing.T) {
+	_, err := negotiateAPIVersion("Octant", "the plugin", 3, 4)
+	require.Error(t, err)
+
+	assert.Equal(t, "incompatible plugin API: Octant supports versions 1 to 2, but the plugin supports versions 3 to 4", err.Error())
+	assert.True(t, IsIncompatible(errors.Wrap(err, "register plugin")))
+}
+
+func TestAPIVersionFrom(t *testing.T) {
+	assert.Equal(t, MinAPIVersion, APIVersionFrom(context.Background()))
+	assert.Equal(t, 2, APIVersionFrom(WithAPIVersion(context.Background(), 2)))
+}
@@ -0,0 +1,21 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package store
+
+import "context"
+
+// AccessChecker is implemented by stores which can check if the current
+// credentials can perform a verb on the objects for a key. HasAccess
+// returns an error if they can't.
+type AccessChecker interface {
+	HasAccess(ctx context.Context, key Key, verb string) error
+}
+
+// SubresourceAccessChecker is implemented by stores which can also check
+// access to a subresource, e.g. the eviction subresource of pods.
+type SubresourceAccessChecker interface {
+	HasSubresourceAccess(ctx context.Context, key Key, subresource, verb string) error
+}
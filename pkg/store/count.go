@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package store
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// MatchCounter is implemented by stores which can count the objects matched
+// by a selector from an index rather than listing them for every caller.
+type MatchCounter interface {
+	CountMatching(ctx context.Context, key Key, selector labels.Selector) (int, error)
+}
+
+// CountMatching counts the objects for a key which are matched by a selector.
+// If the store is a MatchCounter, its index is used. Otherwise, the objects for
+// the key are listed and matched.
+func CountMatching(ctx context.Context, o Store, key Key, selector labels.Selector) (int, error) {
+	if counter, ok := o.(MatchCounter); ok {
+		return counter.CountMatching(ctx, key, selector)
+	}
+
+	key.Selector = nil
+	key.LabelSelector = nil
+	key.FieldSelector = nil
+	key.Limit = 0
+	key.Continue = ""
+	list, _, err := o.List(ctx, key)
+	if err != nil {
+		return 0, errors.Wrapf(err, "list %s", key)
+	}
+
+	count := 0
+	for i := range list.Items {
+		if selector.Matches(labels.Set(list.Items[i].GetLabels())) {
+			count++
+		}
+	}
+
+	return count, nil
+}
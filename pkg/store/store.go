@@ -10,11 +10,15 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/vmware/octant/internal/cluster"
@@ -28,14 +32,20 @@ type UpdateFn func(store Store)
 
 // Store stores Kubernetes objects.
 type Store interface {
+	// List lists the objects for key. If key has a limit and more objects
+	// match, the list's continue token is set to request the next page.
 	List(ctx context.Context, key Key) (list *unstructured.UnstructuredList, loading bool, err error)
 	Get(ctx context.Context, key Key) (object *unstructured.Unstructured, found bool, err error)
 	Delete(ctx context.Context, key Key) error
+	// Watch calls handler when objects matching key are added, updated, or
+	// deleted, until ctx is done.
 	Watch(ctx context.Context, key Key, handler cache.ResourceEventHandler) error
 	Unwatch(ctx context.Context, groupVersionKinds ...schema.GroupVersionKind) error
 	UpdateClusterClient(ctx context.Context, client cluster.ClientInterface) error
 	RegisterOnUpdate(fn UpdateFn)
 	Update(ctx context.Context, key Key, updater func(*unstructured.Unstructured) error) error
+	Patch(ctx context.Context, key Key, patchType types.PatchType, data []byte) error
+	Create(ctx context.Context, object *unstructured.Unstructured) error
 	IsLoading(ctx context.Context, key Key) bool
 }
 
@@ -46,6 +56,16 @@ type Key struct {
 	Kind       string
 	Name       string
 	Selector   *labels.Set
+	// LabelSelector selects objects by label. It is combined with
+	// Selector, and can contain match expressions.
+	LabelSelector *metav1.LabelSelector
+	// FieldSelector selects objects by field, e.g. spec.nodeName for pods.
+	FieldSelector *fields.Set
+	// Limit is the most objects List returns. If more objects match, the
+	// list's continue token is set. Zero lists every object.
+	Limit int64
+	// Continue is the continue token from the previous page of a list.
+	Continue string
 }
 
 func (k Key) String() string {
@@ -66,6 +86,14 @@ func (k Key) String() string {
 		sb.WriteString(fmt.Sprintf(", Selector='%s'", k.Selector.String()))
 	}
 
+	if k.LabelSelector != nil {
+		sb.WriteString(fmt.Sprintf(", LabelSelector='%s'", metav1.FormatLabelSelector(k.LabelSelector)))
+	}
+
+	if k.FieldSelector != nil && k.FieldSelector.String() != "" {
+		sb.WriteString(fmt.Sprintf(", FieldSelector='%s'", k.FieldSelector.String()))
+	}
+
 	sb.WriteString("]")
 
 	return sb.String()
@@ -76,6 +104,38 @@ func (k Key) GroupVersionKind() schema.GroupVersionKind {
 	return schema.FromAPIVersionAndKind(k.APIVersion, k.Kind)
 }
 
+// ResolveLabelSelector returns a selector which matches the labels selected
+// by both Selector and LabelSelector. It matches every object if neither is
+// set.
+func (k Key) ResolveLabelSelector() (labels.Selector, error) {
+	selector := labels.Everything()
+	if k.Selector != nil {
+		selector = k.Selector.AsSelector()
+	}
+
+	if k.LabelSelector == nil {
+		return selector, nil
+	}
+
+	labelSelector, err := metav1.LabelSelectorAsSelector(k.LabelSelector)
+	if err != nil {
+		return nil, errors.Wrapf(err, "convert label selector for %s", k)
+	}
+
+	requirements, _ := labelSelector.Requirements()
+	return selector.Add(requirements...), nil
+}
+
+// ResolveFieldSelector returns a selector for FieldSelector. It matches every
+// object if FieldSelector is not set.
+func (k Key) ResolveFieldSelector() fields.Selector {
+	if k.FieldSelector == nil {
+		return fields.Everything()
+	}
+
+	return k.FieldSelector.AsSelector()
+}
+
 // ToActionPayload converts the Key to a payload.
 func (k Key) ToActionPayload() action.Payload {
 	return action.Payload{
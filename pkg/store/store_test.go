@@ -5,6 +5,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/vmware/octant/internal/gvk"
 	"github.com/vmware/octant/internal/testutil"
@@ -45,6 +48,76 @@ func TestKey_GroupVersionKind(t *testing.T) {
 	assert.Equal(t, gvk.Pod, got)
 }
 
+func TestKey_ResolveLabelSelector(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      Key
+		expected string
+		isErr    bool
+	}{
+		{
+			name:     "no selectors",
+			key:      Key{},
+			expected: "",
+		},
+		{
+			name:     "selector",
+			key:      Key{Selector: &labels.Set{"app": "app"}},
+			expected: "app=app",
+		},
+		{
+			name: "label selector",
+			key: Key{
+				LabelSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "tier", Operator: metav1.LabelSelectorOpIn, Values: []string{"web"}},
+					},
+				},
+			},
+			expected: "tier in (web)",
+		},
+		{
+			name: "selector and label selector",
+			key: Key{
+				Selector:      &labels.Set{"app": "app"},
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "web"}},
+			},
+			expected: "app=app,tier=web",
+		},
+		{
+			name: "invalid label selector",
+			key: Key{
+				LabelSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "tier", Operator: "invalid"},
+					},
+				},
+			},
+			isErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.key.ResolveLabelSelector()
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, got.String())
+		})
+	}
+}
+
+func TestKey_ResolveFieldSelector(t *testing.T) {
+	assert.True(t, Key{}.ResolveFieldSelector().Empty())
+
+	key := Key{FieldSelector: &fields.Set{"spec.nodeName": "node"}}
+	assert.Equal(t, "spec.nodeName=node", key.ResolveFieldSelector().String())
+}
+
 func TestKeyFromObject(t *testing.T) {
 	pod := testutil.CreatePod("pod")
 
@@ -1,8 +1,32 @@
 package component
 
+// DangerLevel is how destructive an action is.
+type DangerLevel string
+
+const (
+	// DangerLevelNone is for actions which are not destructive.
+	DangerLevelNone DangerLevel = ""
+	// DangerLevelWarning is for actions which disrupt workloads but can be
+	// undone.
+	DangerLevelWarning DangerLevel = "warning"
+	// DangerLevelDanger is for actions which can't be undone.
+	DangerLevelDanger DangerLevel = "danger"
+)
+
 // Action is an action that can be performed on a component.
 type Action struct {
 	Name  string `json:"name"`
 	Title string `json:"title"`
 	Form  Form   `json:"form"`
+	// ConfirmChanges requests that the changed form values are confirmed
+	// before the action is submitted.
+	ConfirmChanges bool `json:"confirmChanges,omitempty"`
+	// DisabledReason explains why the action can't be performed. Actions
+	// with a reason are disabled.
+	DisabledReason string `json:"disabledReason,omitempty"`
+	// Danger is how destructive the action is.
+	Danger DangerLevel `json:"danger,omitempty"`
+	// ConfirmationText is text, e.g. the object's name, which must be typed
+	// before the action is submitted.
+	ConfirmationText string `json:"confirmationText,omitempty"`
 }
@@ -1,5 +1,7 @@
 package component
 
+import "github.com/pkg/errors"
+
 type AlertType string
 
 const (
@@ -13,10 +15,20 @@ const (
 	AlertTypeSuccess AlertType = "success"
 )
 
+// AlertLink is an action link for an alert, e.g. to the object which
+// caused the alert.
+type AlertLink struct {
+	Text string `json:"text"`
+	Ref  string `json:"ref"`
+}
+
 // Alert is an alert. It can be used in components which support alerts.
 type Alert struct {
 	Type    AlertType `json:"type"`
 	Message string    `json:"message"`
+	// Dismissible is true if the alert can be hidden.
+	Dismissible bool       `json:"dismissible,omitempty"`
+	Link        *AlertLink `json:"link,omitempty"`
 }
 
 // NewAlert creates an instance of Alert.
@@ -26,3 +38,21 @@ func NewAlert(alertType AlertType, message string) Alert {
 		Message: message,
 	}
 }
+
+// SetLink sets the alert's action link.
+func (a *Alert) SetLink(text, ref string) {
+	a.Link = &AlertLink{
+		Text: text,
+		Ref:  ref,
+	}
+}
+
+// Validate returns an error if the alert type is unknown.
+func (a *Alert) Validate() error {
+	switch a.Type {
+	case AlertTypeError, AlertTypeWarning, AlertTypeInfo, AlertTypeSuccess:
+		return nil
+	default:
+		return errors.Errorf("unknown alert type %q", a.Type)
+	}
+}
@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// Banner is a component which displays an alert on its own, e.g. above a
+// summary.
+type Banner struct {
+	base
+	Config Alert `json:"config"`
+}
+
+var _ Component = (*Banner)(nil)
+
+// NewBanner creates a banner component.
+func NewBanner(alert Alert) *Banner {
+	return &Banner{
+		base:   newBase(typeBanner, nil),
+		Config: alert,
+	}
+}
+
+type bannerMarshal Banner
+
+// MarshalJSON implements json.Marshaler
+func (b *Banner) MarshalJSON() ([]byte, error) {
+	if err := b.Config.Validate(); err != nil {
+		return nil, errors.WithMessage(err, "validate banner component")
+	}
+
+	m := bannerMarshal(*b)
+	m.Metadata.Type = typeBanner
+	return json.Marshal(&m)
+}
+
+// String returns the banner's message.
+func (b *Banner) String() string {
+	return b.Config.Message
+}
@@ -0,0 +1,50 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Banner_Marshal(t *testing.T) {
+	alert := NewAlert(AlertTypeWarning, "Deployment has exceeded its progress deadline")
+	alert.Dismissible = true
+	alert.SetLink("View", "/overview/namespace/default/workloads/deployments/nginx")
+
+	input := NewBanner(alert)
+
+	actual, err := json.Marshal(input)
+	require.NoError(t, err)
+
+	expected := `
+            {
+                "metadata": {
+                  "type": "banner"
+                },
+                "config": {
+                  "type": "warning",
+                  "message": "Deployment has exceeded its progress deadline",
+                  "dismissible": true,
+                  "link": {
+                    "text": "View",
+                    "ref": "/overview/namespace/default/workloads/deployments/nginx"
+                  }
+                }
+            }
+`
+	assert.JSONEq(t, expected, string(actual))
+}
+
+func Test_Banner_Marshal_invalid_type(t *testing.T) {
+	input := NewBanner(NewAlert("urgent", "message"))
+
+	_, err := json.Marshal(input)
+	require.Error(t, err)
+}
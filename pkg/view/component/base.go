@@ -7,30 +7,44 @@ package component
 
 const (
 	typeAnnotations        = "annotations"
+	typeBanner             = "banner"
+	typeBreadcrumb         = "breadcrumb"
 	typeButtonGroup        = "buttonGroup"
 	typeCard               = "card"
 	typeCardList           = "cardList"
+	typeCode               = "code"
 	typeContainers         = "containers"
+	typeDiff               = "diff"
+	typeDonutChart         = "donutChart"
 	typeError              = "error"
 	typeExpressionSelector = "expressionSelector"
 	typeFlexLayout         = "flexlayout"
+	typeGraph              = "graph"
 	typeGraphviz           = "graphviz"
+	typeGridActions        = "gridActions"
 	typeLabels             = "labels"
 	typeLabelSelector      = "labelSelector"
 	typeLink               = "link"
 	typeList               = "list"
 	typeLoading            = "loading"
 	typeLogs               = "logs"
+	typeMarkdown           = "markdown"
 	typePodStatus          = "podStatus"
 	typePort               = "port"
 	typePorts              = "ports"
 	typePortForward        = "portforward"
+	typeProgress           = "progress"
 	typeQuadrant           = "quadrant"
 	typeResourceViewer     = "resourceViewer"
 	typeSelectors          = "selectors"
+	typeStatGrid           = "statGrid"
+	typeStatusText         = "statusText"
 	typeSummary            = "summary"
 	typeTable              = "table"
+	typeTabs               = "tabs"
+	typeTerminal           = "terminal"
 	typeText               = "text"
+	typeTimeseries         = "timeseries"
 	typeTimestamp          = "timestamp"
 	typeYAML               = "yaml"
 )
@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// Breadcrumb is a component representing a trail of links.
+type Breadcrumb struct {
+	base
+	Config BreadcrumbConfig `json:"config"`
+}
+
+// BreadcrumbConfig is the contents of Breadcrumb.
+type BreadcrumbConfig struct {
+	Links []*Link `json:"links"`
+}
+
+func (t *BreadcrumbConfig) UnmarshalJSON(data []byte) error {
+	x := struct {
+		Links []TypedObject
+	}{}
+
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+
+	for _, item := range x.Links {
+		c, err := item.ToComponent()
+		if err != nil {
+			return err
+		}
+
+		l, ok := c.(*Link)
+		if !ok {
+			return errors.Errorf("breadcrumb item is a %s, not a link", item.Metadata.Type)
+		}
+		t.Links = append(t.Links, l)
+	}
+
+	return nil
+}
+
+// NewBreadcrumb creates a breadcrumb component.
+func NewBreadcrumb(links ...*Link) *Breadcrumb {
+	return &Breadcrumb{
+		base: newBase(typeBreadcrumb, nil),
+		Config: BreadcrumbConfig{
+			Links: links,
+		},
+	}
+}
+
+// Add adds links to the end of the breadcrumb.
+func (t *Breadcrumb) Add(links ...*Link) {
+	t.Config.Links = append(t.Config.Links, links...)
+}
+
+// GetMetadata accesses the components metadata. Implements Component.
+func (t *Breadcrumb) GetMetadata() Metadata {
+	return t.Metadata
+}
+
+type breadcrumbMarshal Breadcrumb
+
+// MarshalJSON implements json.Marshaler
+func (t *Breadcrumb) MarshalJSON() ([]byte, error) {
+	m := breadcrumbMarshal(*t)
+	m.Metadata.Type = typeBreadcrumb
+	return json.Marshal(&m)
+}
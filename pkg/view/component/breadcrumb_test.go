@@ -0,0 +1,28 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Breadcrumb_Marshal(t *testing.T) {
+	b := NewBreadcrumb(NewLink("", "deployment", "/deployment"))
+	b.Add(NewLink("", "replicaset", "/replicaset"))
+
+	actual, err := json.Marshal(b)
+	require.NoError(t, err)
+
+	expected, err := ioutil.ReadFile(path.Join("testdata", "breadcrumb.json"))
+	require.NoError(t, err, "reading test fixtures")
+	assert.JSONEq(t, string(expected), string(actual))
+}
@@ -10,6 +10,9 @@ import (
 type Confirmation struct {
 	Title string `json:"title"`
 	Body  string `json:"body"`
+	// RequiredText is text, e.g. the object's name, which must be typed
+	// before the dialog can be accepted.
+	RequiredText string `json:"requiredText,omitempty"`
 }
 
 // ButtonOption is a function for configuring a Button.
@@ -27,11 +30,44 @@ func WithButtonConfirmation(title, body string) ButtonOption {
 	}
 }
 
+// WithButtonTypedConfirmation configures a button with a confirmation
+// which is only accepted once text is typed.
+func WithButtonTypedConfirmation(title, body, text string) ButtonOption {
+	return func(button *Button) {
+		confirmation := Confirmation{
+			Title:        title,
+			Body:         body,
+			RequiredText: text,
+		}
+
+		button.Confirmation = &confirmation
+	}
+}
+
+// WithButtonDanger sets how destructive a button's action is.
+func WithButtonDanger(level DangerLevel) ButtonOption {
+	return func(button *Button) {
+		button.Danger = level
+	}
+}
+
+// WithButtonDisabled disables a button. The reason is shown to the user.
+func WithButtonDisabled(reason string) ButtonOption {
+	return func(button *Button) {
+		button.DisabledReason = reason
+	}
+}
+
 // Button is a button in a group.
 type Button struct {
 	Name         string         `json:"name"`
 	Payload      action.Payload `json:"payload"`
 	Confirmation *Confirmation  `json:"confirmation,omitempty"`
+	// DisabledReason explains why the button can't be clicked. Buttons
+	// with a reason are disabled.
+	DisabledReason string `json:"disabledReason,omitempty"`
+	// Danger is how destructive the button's action is.
+	Danger DangerLevel `json:"danger,omitempty"`
 }
 
 // NewButton creates an instance of Button.
@@ -80,3 +116,19 @@ func (bg *ButtonGroup) MarshalJSON() ([]byte, error) {
 	m.Metadata.Type = typeButtonGroup
 	return json.Marshal(&m)
 }
+
+// UnmarshalJSON unmarshals a button group. It is needed when a button group
+// is embedded in another component's config.
+func (bg *ButtonGroup) UnmarshalJSON(data []byte) error {
+	var to TypedObject
+	if err := json.Unmarshal(data, &to); err != nil {
+		return err
+	}
+
+	bg.Metadata = to.Metadata
+	if len(to.Config) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(to.Config, &bg.Config)
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/octant/pkg/action"
 )
 
 func Test_ButtonGroup_Marshal(t *testing.T) {
@@ -24,6 +26,27 @@ func Test_ButtonGroup_Marshal(t *testing.T) {
 			},
 			expectedFile: "button_group_empty.json",
 		},
+		{
+			name: "disabled button",
+			input: func() *ButtonGroup {
+				bg := NewButtonGroup()
+				bg.AddButton(NewButton("Delete", action.Payload{"action": "octant/deleteObject"},
+					WithButtonDisabled("no delete access")))
+				return bg
+			},
+			expectedFile: "button_group_disabled.json",
+		},
+		{
+			name: "dangerous button",
+			input: func() *ButtonGroup {
+				bg := NewButtonGroup()
+				bg.AddButton(NewButton("Delete", action.Payload{"action": "octant/deleteObject"},
+					WithButtonTypedConfirmation("Delete Namespace", "Are you sure?", "default"),
+					WithButtonDanger(DangerLevelDanger)))
+				return bg
+			},
+			expectedFile: "button_group_danger.json",
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,112 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware/octant/pkg/action"
+)
+
+// CodeEdit describes how changes to editable code are submitted. The code
+// is added to the payload using the field name, and the payload is sent
+// as an action.
+type CodeEdit struct {
+	Payload action.Payload `json:"payload"`
+	Field   string         `json:"field"`
+	// ID identifies the edit, so the result of submitting it can be shown
+	// with the code. It is optional.
+	ID string `json:"id,omitempty"`
+}
+
+// CodeConfig is the contents of Code.
+type CodeConfig struct {
+	Value string `json:"value"`
+	// Language is a syntax highlighting hint, e.g. "yaml" or "json".
+	Language    string `json:"language,omitempty"`
+	LineNumbers bool   `json:"lineNumbers,omitempty"`
+	// Edit is set if the code is editable. Otherwise it is read-only.
+	Edit *CodeEdit `json:"edit,omitempty"`
+}
+
+// Code is a component for source code or structured text, e.g. YAML
+// manifests, config map data, and CRD schemas.
+type Code struct {
+	base
+	Config CodeConfig `json:"config"`
+}
+
+var _ Component = (*Code)(nil)
+
+// NewCode creates a read-only code component.
+func NewCode(title, language, value string) *Code {
+	return &Code{
+		base: newBase(typeCode, TitleFromString(title)),
+		Config: CodeConfig{
+			Value:    value,
+			Language: language,
+		},
+	}
+}
+
+// SetEditable makes the code editable. When changes are submitted, the
+// code is added to the payload using field, and the payload is sent as
+// an action. The payload must contain the action name.
+func (c *Code) SetEditable(payload action.Payload, field string) {
+	c.Config.Edit = &CodeEdit{
+		Payload: payload,
+		Field:   field,
+	}
+}
+
+// IsEditable returns true if the code is editable.
+func (c *Code) IsEditable() bool {
+	return c.Config.Edit != nil
+}
+
+type codeMarshal Code
+
+// MarshalJSON implements json.Marshaler
+func (c *Code) MarshalJSON() ([]byte, error) {
+	if edit := c.Config.Edit; edit != nil {
+		if _, err := edit.Payload.String("action"); err != nil {
+			return nil, errors.New("editable code payload does not have an action")
+		}
+		if edit.Field == "" {
+			return nil, errors.New("editable code field is blank")
+		}
+	}
+
+	m := codeMarshal(*c)
+	m.Metadata.Type = typeCode
+	return json.Marshal(&m)
+}
+
+// codeLanguages maps file extensions to languages.
+var codeLanguages = map[string]string{
+	".json":       "json",
+	".yaml":       "yaml",
+	".yml":        "yaml",
+	".xml":        "xml",
+	".ini":        "ini",
+	".conf":       "ini",
+	".properties": "properties",
+	".sh":         "bash",
+	".py":         "python",
+	".js":         "javascript",
+	".toml":       "ini",
+}
+
+// CodeLanguageForName returns a syntax highlighting hint for a file name,
+// e.g. a config map key. It returns a blank string if the name does not
+// have a known extension.
+func CodeLanguageForName(name string) string {
+	return codeLanguages[strings.ToLower(path.Ext(name))]
+}
@@ -0,0 +1,99 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/octant/pkg/action"
+)
+
+func Test_Code_Marshal(t *testing.T) {
+	input := NewCode("Manifest", "yaml", "key: value")
+	input.Config.LineNumbers = true
+	input.SetEditable(action.Payload{"action": "configmap/update"}, "value")
+
+	actual, err := json.Marshal(input)
+	require.NoError(t, err)
+
+	expected := `
+            {
+                "metadata": {
+                  "type": "code",
+                  "title": [{"metadata": {"type": "text"}, "config": {"value": "Manifest"}}]
+                },
+                "config": {
+                  "value": "key: value",
+                  "language": "yaml",
+                  "lineNumbers": true,
+                  "edit": {
+                    "payload": {"action": "configmap/update"},
+                    "field": "value"
+                  }
+                }
+            }
+`
+	assert.JSONEq(t, expected, string(actual))
+}
+
+func Test_Code_Marshal_invalid(t *testing.T) {
+	cases := []struct {
+		name    string
+		payload action.Payload
+		field   string
+	}{
+		{
+			name:    "no action",
+			payload: action.Payload{},
+			field:   "value",
+		},
+		{
+			name:    "no field",
+			payload: action.Payload{"action": "configmap/update"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			input := NewCode("Manifest", "yaml", "key: value")
+			input.SetEditable(tc.payload, tc.field)
+
+			_, err := json.Marshal(input)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestCode_IsEditable(t *testing.T) {
+	c := NewCode("", "", "value")
+	assert.False(t, c.IsEditable())
+
+	c.SetEditable(action.Payload{"action": "configmap/update"}, "value")
+	assert.True(t, c.IsEditable())
+}
+
+func TestCodeLanguageForName(t *testing.T) {
+	cases := []struct {
+		name     string
+		expected string
+	}{
+		{name: "config.yaml", expected: "yaml"},
+		{name: "config.YML", expected: "yaml"},
+		{name: "settings.json", expected: "json"},
+		{name: "app.properties", expected: "properties"},
+		{name: "README", expected: ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, CodeLanguageForName(tc.name))
+		})
+	}
+}
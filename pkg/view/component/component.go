@@ -22,6 +22,15 @@ type ContentResponse struct {
 	Components []Component      `json:"viewComponents"`
 	IconName   string           `json:"iconName,omitempty"`
 	IconSource string           `json:"iconSource,omitempty"`
+	Breadcrumb *Breadcrumb      `json:"breadcrumb,omitempty"`
+	Siblings   *Siblings        `json:"siblings,omitempty"`
+}
+
+// Siblings links to the objects before and after an object in a set of
+// related objects, e.g. the other pods in a ReplicaSet.
+type Siblings struct {
+	Previous *Link `json:"previous,omitempty"`
+	Next     *Link `json:"next,omitempty"`
 }
 
 // NewContentResponse creates an instance of ContentResponse.
@@ -41,6 +50,11 @@ func (c *ContentResponse) UnmarshalJSON(data []byte) error {
 	stage := struct {
 		Title      []TypedObject `json:"title,omitempty"`
 		Components []TypedObject `json:"viewComponents,omitempty"`
+		Breadcrumb *TypedObject  `json:"breadcrumb,omitempty"`
+		Siblings   *struct {
+			Previous *TypedObject `json:"previous,omitempty"`
+			Next     *TypedObject `json:"next,omitempty"`
+		} `json:"siblings,omitempty"`
 	}{}
 
 	if err := json.Unmarshal(data, &stage); err != nil {
@@ -65,9 +79,55 @@ func (c *ContentResponse) UnmarshalJSON(data []byte) error {
 		c.Components = append(c.Components, vc)
 	}
 
+	if stage.Breadcrumb != nil {
+		vc, err := stage.Breadcrumb.ToComponent()
+		if err != nil {
+			return err
+		}
+
+		breadcrumb, ok := vc.(*Breadcrumb)
+		if !ok {
+			return errors.Errorf("expected breadcrumb to be a breadcrumb; got %T", vc)
+		}
+
+		c.Breadcrumb = breadcrumb
+	}
+
+	if stage.Siblings != nil {
+		previous, err := linkFromTypedObject(stage.Siblings.Previous)
+		if err != nil {
+			return errors.Wrap(err, "previous sibling")
+		}
+
+		next, err := linkFromTypedObject(stage.Siblings.Next)
+		if err != nil {
+			return errors.Wrap(err, "next sibling")
+		}
+
+		c.Siblings = &Siblings{Previous: previous, Next: next}
+	}
+
 	return nil
 }
 
+func linkFromTypedObject(to *TypedObject) (*Link, error) {
+	if to == nil {
+		return nil, nil
+	}
+
+	vc, err := to.ToComponent()
+	if err != nil {
+		return nil, err
+	}
+
+	l, ok := vc.(*Link)
+	if !ok {
+		return nil, errors.Errorf("expected link; got %T", vc)
+	}
+
+	return l, nil
+}
+
 func getTitleByUnmarshalInterface(config json.RawMessage) (string, error) {
 	var objmap map[string]interface{}
 	if err := json.Unmarshal(config, &objmap); err != nil {
@@ -0,0 +1,50 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentResponse_UnmarshalJSON_navigation(t *testing.T) {
+	cr := NewContentResponse(Title(NewText("Pods"), NewText("pod-b")))
+	cr.Add(NewText("summary"))
+	cr.Breadcrumb = NewBreadcrumb(
+		NewLink("", "deployment", "/deployment"),
+		NewLink("", "replicaset", "/replicaset"),
+	)
+	cr.Siblings = &Siblings{
+		Previous: NewLink("", "pod-a", "/pod-a"),
+		Next:     NewLink("", "pod-c", "/pod-c"),
+	}
+
+	data, err := json.Marshal(cr)
+	require.NoError(t, err)
+
+	var got ContentResponse
+	require.NoError(t, json.Unmarshal(data, &got))
+
+	assert.Equal(t, cr.Breadcrumb, got.Breadcrumb)
+	assert.Equal(t, cr.Siblings, got.Siblings)
+}
+
+func TestContentResponse_UnmarshalJSON_without_navigation(t *testing.T) {
+	cr := NewContentResponse(Title(NewText("Pods")))
+	cr.Add(NewText("summary"))
+
+	data, err := json.Marshal(cr)
+	require.NoError(t, err)
+
+	var got ContentResponse
+	require.NoError(t, json.Unmarshal(data, &got))
+
+	assert.Nil(t, got.Breadcrumb)
+	assert.Nil(t, got.Siblings)
+}
@@ -20,8 +20,13 @@ type ContainersConfig struct {
 
 // ContainerDef defines an individual docker container
 type ContainerDef struct {
-	Name  string `json:"name"`
-	Image string `json:"image"`
+	Name       string   `json:"name"`
+	Image      string   `json:"image"`
+	Registry   string   `json:"registry,omitempty"`
+	Repository string   `json:"repository,omitempty"`
+	Tag        string   `json:"tag,omitempty"`
+	Digest     string   `json:"digest,omitempty"`
+	Warnings   []string `json:"warnings,omitempty"`
 }
 
 // NewContainers creates a containers component
@@ -39,7 +44,12 @@ func (t *Containers) GetMetadata() Metadata {
 
 // Add adds additional items to the tail of the containers.
 func (t *Containers) Add(name string, image string) {
-	t.Config.Containers = append(t.Config.Containers, ContainerDef{Name: name, Image: image})
+	t.AddContainerDef(ContainerDef{Name: name, Image: image})
+}
+
+// AddContainerDef adds a container definition to the tail of the containers.
+func (t *Containers) AddContainerDef(def ContainerDef) {
+	t.Config.Containers = append(t.Config.Containers, def)
 }
 
 type containersMarshal Containers
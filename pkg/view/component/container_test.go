@@ -40,6 +40,24 @@ func Test_Containers_Marshal(t *testing.T) {
 			},
 			expectedPath: "container.json",
 		},
+		{
+			name: "with image reference",
+			input: &Containers{
+				Config: ContainersConfig{
+					Containers: []ContainerDef{
+						{
+							Name:       "nginx",
+							Image:      "nginx",
+							Registry:   "docker.io",
+							Repository: "library/nginx",
+							Tag:        "latest",
+							Warnings:   []string{"Image uses the implicit :latest tag"},
+						},
+					},
+				},
+			},
+			expectedPath: "container_image_reference.json",
+		},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,86 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// DiffMode is how a diff is displayed.
+type DiffMode string
+
+const (
+	// DiffModeUnified displays changes in one column.
+	DiffModeUnified DiffMode = "unified"
+	// DiffModeSplit displays before and after side by side.
+	DiffModeSplit DiffMode = "split"
+)
+
+// DiffConfig is the contents of Diff.
+type DiffConfig struct {
+	Before      string   `json:"before"`
+	After       string   `json:"after"`
+	BeforeLabel string   `json:"beforeLabel,omitempty"`
+	AfterLabel  string   `json:"afterLabel,omitempty"`
+	Mode        DiffMode `json:"mode"`
+	// Language is a syntax highlighting hint, e.g. "yaml" or "json".
+	Language string `json:"language,omitempty"`
+}
+
+// Diff is a component comparing two versions of text, e.g. two revisions
+// of a manifest.
+type Diff struct {
+	base
+	Config DiffConfig `json:"config"`
+}
+
+var _ Component = (*Diff)(nil)
+
+// NewDiff creates a diff component which is displayed as a unified diff.
+func NewDiff(title, before, after string) *Diff {
+	return &Diff{
+		base: newBase(typeDiff, TitleFromString(title)),
+		Config: DiffConfig{
+			Before: before,
+			After:  after,
+			Mode:   DiffModeUnified,
+		},
+	}
+}
+
+// SetLabels sets the labels for the before and after text,
+// e.g. revision names.
+func (d *Diff) SetLabels(before, after string) {
+	d.Config.BeforeLabel = before
+	d.Config.AfterLabel = after
+}
+
+// SetMode sets the display mode.
+func (d *Diff) SetMode(mode DiffMode) {
+	d.Config.Mode = mode
+}
+
+// HasChanges returns true if before and after are different.
+func (d *Diff) HasChanges() bool {
+	return d.Config.Before != d.Config.After
+}
+
+type diffMarshal Diff
+
+// MarshalJSON implements json.Marshaler
+func (d *Diff) MarshalJSON() ([]byte, error) {
+	switch d.Config.Mode {
+	case DiffModeUnified, DiffModeSplit:
+	default:
+		return nil, errors.Errorf("invalid diff mode %q", d.Config.Mode)
+	}
+
+	m := diffMarshal(*d)
+	m.Metadata.Type = typeDiff
+	return json.Marshal(&m)
+}
@@ -0,0 +1,53 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Diff_Marshal(t *testing.T) {
+	input := NewDiff("Revisions", "replicas: 1", "replicas: 2")
+	input.SetLabels("1", "2")
+	input.SetMode(DiffModeSplit)
+
+	actual, err := json.Marshal(input)
+	require.NoError(t, err)
+
+	expected := `
+            {
+                "metadata": {
+                  "type": "diff",
+                  "title": [{"metadata": {"type": "text"}, "config": {"value": "Revisions"}}]
+                },
+                "config": {
+                  "before": "replicas: 1",
+                  "after": "replicas: 2",
+                  "beforeLabel": "1",
+                  "afterLabel": "2",
+                  "mode": "split"
+                }
+            }
+`
+	assert.JSONEq(t, expected, string(actual))
+}
+
+func Test_Diff_Marshal_invalid_mode(t *testing.T) {
+	input := NewDiff("Revisions", "a", "b")
+	input.SetMode("sideways")
+
+	_, err := json.Marshal(input)
+	require.Error(t, err)
+}
+
+func TestDiff_HasChanges(t *testing.T) {
+	assert.False(t, NewDiff("", "a", "a").HasChanges())
+	assert.True(t, NewDiff("", "a", "b").HasChanges())
+}
@@ -0,0 +1,96 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import "encoding/json"
+
+// DonutChartSize is the diameter of a donut chart in pixels.
+type DonutChartSize int
+
+const (
+	// DonutChartSizeSmall is a small donut chart.
+	DonutChartSizeSmall DonutChartSize = 50
+	// DonutChartSizeMedium is a medium donut chart.
+	DonutChartSizeMedium DonutChartSize = 100
+)
+
+// DonutSegment is a segment of a donut chart.
+type DonutSegment struct {
+	Count  int        `json:"count"`
+	Status NodeStatus `json:"status"`
+}
+
+// DonutChartLabels are the labels shown with the total of a donut chart.
+type DonutChartLabels struct {
+	Plural   string `json:"plural"`
+	Singular string `json:"singular"`
+}
+
+// DonutChartConfig is the contents of a DonutChart.
+type DonutChartConfig struct {
+	Segments []DonutSegment   `json:"segments"`
+	Labels   DonutChartLabels `json:"labels"`
+	Size     DonutChartSize   `json:"size"`
+}
+
+// DonutChart is a component which shows the proportion of segments in
+// a whole, e.g. the available replicas for a deployment.
+type DonutChart struct {
+	base
+	Config DonutChartConfig `json:"config"`
+}
+
+var _ Component = (*DonutChart)(nil)
+
+// NewDonutChart creates a donut chart component.
+func NewDonutChart() *DonutChart {
+	return &DonutChart{
+		base: newBase(typeDonutChart, nil),
+		Config: DonutChartConfig{
+			Size: DonutChartSizeMedium,
+		},
+	}
+}
+
+// AddSegment adds a segment to the chart.
+func (dc *DonutChart) AddSegment(count int, status NodeStatus) {
+	dc.Config.Segments = append(dc.Config.Segments, DonutSegment{
+		Count:  count,
+		Status: status,
+	})
+}
+
+// SetLabels sets the labels for the chart's total.
+func (dc *DonutChart) SetLabels(plural, singular string) {
+	dc.Config.Labels = DonutChartLabels{
+		Plural:   plural,
+		Singular: singular,
+	}
+}
+
+// SetSize sets the size of the chart.
+func (dc *DonutChart) SetSize(size DonutChartSize) {
+	dc.Config.Size = size
+}
+
+// Total returns the sum of the chart's segments.
+func (dc *DonutChart) Total() int {
+	total := 0
+	for _, segment := range dc.Config.Segments {
+		total += segment.Count
+	}
+
+	return total
+}
+
+type donutChartMarshal DonutChart
+
+// MarshalJSON implements json.Marshaler
+func (dc *DonutChart) MarshalJSON() ([]byte, error) {
+	m := donutChartMarshal(*dc)
+	m.Metadata.Type = typeDonutChart
+	return json.Marshal(&m)
+}
@@ -0,0 +1,51 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DonutChart_Marshal(t *testing.T) {
+	input := NewDonutChart()
+	input.SetLabels("replicas", "replica")
+	input.SetSize(DonutChartSizeSmall)
+	input.AddSegment(2, NodeStatusOK)
+	input.AddSegment(1, NodeStatusError)
+
+	actual, err := json.Marshal(input)
+	require.NoError(t, err)
+
+	expected := `
+            {
+                "metadata": {
+                  "type": "donutChart"
+                },
+                "config": {
+                  "segments": [
+                    {"count": 2, "status": "ok"},
+                    {"count": 1, "status": "error"}
+                  ],
+                  "labels": {"plural": "replicas", "singular": "replica"},
+                  "size": 50
+                }
+            }
+`
+	assert.JSONEq(t, expected, string(actual))
+}
+
+func Test_DonutChart_Total(t *testing.T) {
+	dc := NewDonutChart()
+	assert.Equal(t, 0, dc.Total())
+
+	dc.AddSegment(2, NodeStatusOK)
+	dc.AddSegment(1, NodeStatusWarning)
+	assert.Equal(t, 3, dc.Total())
+}
@@ -17,15 +17,28 @@ const (
 )
 
 // FlexLayoutItem is an item in a flex layout.
+// FlexLayoutItemWidths are the widths of an item on screens of different
+// sizes. A blank width uses the width for the next smaller screen size.
+type FlexLayoutItemWidths struct {
+	Small      int `json:"sm,omitempty"`
+	Medium     int `json:"md,omitempty"`
+	Large      int `json:"lg,omitempty"`
+	ExtraLarge int `json:"xl,omitempty"`
+}
+
 type FlexLayoutItem struct {
-	Width int       `json:"width,omitempty"`
-	View  Component `json:"view,omitempty"`
+	Width int `json:"width,omitempty"`
+	// Widths are the item's widths on screens of different sizes. They
+	// override Width.
+	Widths *FlexLayoutItemWidths `json:"widths,omitempty"`
+	View   Component             `json:"view,omitempty"`
 }
 
 func (fli *FlexLayoutItem) UnmarshalJSON(data []byte) error {
 	x := struct {
-		Width int
-		View  TypedObject
+		Width  int
+		Widths *FlexLayoutItemWidths
+		View   TypedObject
 	}{}
 
 	if err := json.Unmarshal(data, &x); err != nil {
@@ -33,6 +46,7 @@ func (fli *FlexLayoutItem) UnmarshalJSON(data []byte) error {
 	}
 
 	fli.Width = x.Width
+	fli.Widths = x.Widths
 	var err error
 	fli.View, err = x.View.ToComponent()
 	if err != nil {
@@ -45,10 +59,20 @@ func (fli *FlexLayoutItem) UnmarshalJSON(data []byte) error {
 // FlexLayoutSection is a slice of items group together.
 type FlexLayoutSection []FlexLayoutItem
 
+// FlexLayoutSectionInfo names a flex layout section. The ID is the
+// section's anchor on the page.
+type FlexLayoutSectionInfo struct {
+	ID    string `json:"id,omitempty"`
+	Title string `json:"title,omitempty"`
+}
+
 // FlexLayoutConfig is configuration for the flex layout view.
 type FlexLayoutConfig struct {
-	Sections    []FlexLayoutSection `json:"sections,omitempty"`
-	ButtonGroup *ButtonGroup        `json:"buttonGroup,omitempty"`
+	Sections []FlexLayoutSection `json:"sections,omitempty"`
+	// SectionInfo names sections. An entry names the section with the same
+	// index. It is empty if no sections are named.
+	SectionInfo []FlexLayoutSectionInfo `json:"sectionInfo,omitempty"`
+	ButtonGroup *ButtonGroup            `json:"buttonGroup,omitempty"`
 }
 
 // FlexLayout is a flex layout view.
@@ -75,6 +99,23 @@ func (fl *FlexLayout) GetMetadata() Metadata {
 // AddSections adds one or more sections to the flex layout.
 func (fl *FlexLayout) AddSections(sections ...FlexLayoutSection) {
 	fl.Config.Sections = append(fl.Config.Sections, sections...)
+	if len(fl.Config.SectionInfo) > 0 {
+		fl.padSectionInfo()
+	}
+}
+
+// AddNamedSection adds a section with an identifier and a title.
+func (fl *FlexLayout) AddNamedSection(id, title string, section FlexLayoutSection) {
+	fl.padSectionInfo()
+	fl.Config.Sections = append(fl.Config.Sections, section)
+	fl.Config.SectionInfo = append(fl.Config.SectionInfo, FlexLayoutSectionInfo{ID: id, Title: title})
+}
+
+// padSectionInfo adds blank info for sections which are not named.
+func (fl *FlexLayout) padSectionInfo() {
+	for len(fl.Config.SectionInfo) < len(fl.Config.Sections) {
+		fl.Config.SectionInfo = append(fl.Config.SectionInfo, FlexLayoutSectionInfo{})
+	}
 }
 
 type flexLayoutMarshal FlexLayout
@@ -0,0 +1,30 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlexLayout_AddNamedSection(t *testing.T) {
+	fl := NewFlexLayout("Title")
+
+	fl.AddSections(FlexLayoutSection{{Width: WidthFull, View: NewText("unnamed")}})
+	assert.Empty(t, fl.Config.SectionInfo)
+
+	fl.AddNamedSection("status", "Status", FlexLayoutSection{{Width: WidthFull, View: NewText("status")}})
+	fl.AddSections(FlexLayoutSection{{Width: WidthFull, View: NewText("unnamed")}})
+
+	expected := []FlexLayoutSectionInfo{
+		{},
+		{ID: "status", Title: "Status"},
+		{},
+	}
+	assert.Equal(t, expected, fl.Config.SectionInfo)
+	assert.Len(t, fl.Config.Sections, 3)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -22,6 +23,9 @@ const (
 	FieldTypeSelect   = "select"
 	FieldTypeTextarea = "textarea"
 	FieldTypeHidden   = "hidden"
+	FieldTypeFile     = "file"
+	FieldTypeToggle   = "toggle"
+	FieldTypeTokens   = "tokens"
 )
 
 type InputChoice struct {
@@ -30,10 +34,47 @@ type InputChoice struct {
 	Checked bool   `json:"checked"`
 }
 
+// NewInputChoices creates choices for values which are only known when a
+// form is printed, e.g. the tags of an image. Values in selected are
+// checked.
+func NewInputChoices(values []string, selected ...string) []InputChoice {
+	checked := make(map[string]bool)
+	for _, value := range selected {
+		checked[value] = true
+	}
+
+	choices := make([]InputChoice, 0, len(values))
+	for _, value := range values {
+		choices = append(choices, InputChoice{
+			Label:   value,
+			Value:   value,
+			Checked: checked[value],
+		})
+	}
+
+	return choices
+}
+
+// NewObjectInputChoices creates a choice for each object in a list, e.g.
+// to choose a node. The value of a choice is the object's name.
+func NewObjectInputChoices(list *unstructured.UnstructuredList, selected ...string) []InputChoice {
+	if list == nil {
+		return []InputChoice{}
+	}
+
+	var names []string
+	for i := range list.Items {
+		names = append(names, list.Items[i].GetName())
+	}
+
+	return NewInputChoices(names, selected...)
+}
+
 type baseFormField struct {
-	label     string
-	name      string
-	fieldType string
+	label      string
+	name       string
+	fieldType  string
+	validation *FormFieldValidation
 }
 
 func newBaseFormField(label, name, fieldType string) *baseFormField {
@@ -56,12 +97,23 @@ func (bff *baseFormField) Type() string {
 	return bff.fieldType
 }
 
+// Validation returns the rules the field's value must satisfy.
+func (bff *baseFormField) Validation() *FormFieldValidation {
+	return bff.validation
+}
+
+// SetValidation sets the rules the field's value must satisfy.
+func (bff *baseFormField) SetValidation(validation *FormFieldValidation) {
+	bff.validation = validation
+}
+
 type FormField interface {
 	Label() string
 	Name() string
 	Type() string
 	Configuration() map[string]interface{}
 	Value() interface{}
+	Validation() *FormFieldValidation
 
 	json.Unmarshaler
 	json.Marshaler
@@ -69,6 +121,12 @@ type FormField interface {
 
 // marshalFormField marshals a form field to JSON.
 func marshalFormField(ff FormField) ([]byte, error) {
+	m := formFieldMap(ff)
+	return json.Marshal(&m)
+}
+
+// formFieldMap converts a form field to a map for marshaling.
+func formFieldMap(ff FormField) map[string]interface{} {
 	m := map[string]interface{}{
 		"label":         ff.Label(),
 		"name":          ff.Name(),
@@ -77,7 +135,11 @@ func marshalFormField(ff FormField) ([]byte, error) {
 		"value":         ff.Value(),
 	}
 
-	return json.Marshal(&m)
+	if validation := ff.Validation(); validation != nil {
+		m["validation"] = validation
+	}
+
+	return m
 }
 
 type FormFieldCheckBox struct {
@@ -118,9 +180,10 @@ func (ff *FormFieldCheckBox) Value() interface{} {
 
 func (ff *FormFieldCheckBox) UnmarshalJSON(data []byte) error {
 	x := struct {
-		Label         string `json:"label"`
-		Name          string `json:"name"`
-		Type          string `json:"type"`
+		Label         string               `json:"label"`
+		Name          string               `json:"name"`
+		Type          string               `json:"type"`
+		Validation    *FormFieldValidation `json:"validation"`
 		Configuration struct {
 			Choices []InputChoice
 		} `json:"configuration"`
@@ -131,6 +194,7 @@ func (ff *FormFieldCheckBox) UnmarshalJSON(data []byte) error {
 	}
 
 	ff.baseFormField = newBaseFormField(x.Label, x.Name, x.Type)
+	ff.validation = x.Validation
 	ff.choices = x.Configuration.Choices
 
 	return nil
@@ -175,9 +239,10 @@ func (ff *FormFieldRadio) Value() interface{} {
 
 func (ff *FormFieldRadio) UnmarshalJSON(data []byte) error {
 	x := struct {
-		Label         string `json:"label"`
-		Name          string `json:"name"`
-		Type          string `json:"type"`
+		Label         string               `json:"label"`
+		Name          string               `json:"name"`
+		Type          string               `json:"type"`
+		Validation    *FormFieldValidation `json:"validation"`
 		Configuration struct {
 			Choices []InputChoice
 		} `json:"configuration"`
@@ -188,6 +253,7 @@ func (ff *FormFieldRadio) UnmarshalJSON(data []byte) error {
 	}
 
 	ff.baseFormField = newBaseFormField(x.Label, x.Name, x.Type)
+	ff.validation = x.Validation
 	ff.choices = x.Configuration.Choices
 
 	return nil
@@ -229,6 +295,7 @@ func (ff *FormFieldText) UnmarshalJSON(data []byte) error {
 		Label         string                 `json:"label"`
 		Name          string                 `json:"name"`
 		Type          string                 `json:"type"`
+		Validation    *FormFieldValidation   `json:"validation"`
 		Configuration map[string]interface{} `json:"configuration"`
 		Value         string                 `json:"value"`
 	}{}
@@ -238,6 +305,7 @@ func (ff *FormFieldText) UnmarshalJSON(data []byte) error {
 	}
 
 	ff.baseFormField = newBaseFormField(x.Label, x.Name, x.Type)
+	ff.validation = x.Validation
 	ff.value = x.Value
 
 	return nil
@@ -276,6 +344,7 @@ func (ff *FormFieldPassword) UnmarshalJSON(data []byte) error {
 		Label         string                 `json:"label"`
 		Name          string                 `json:"name"`
 		Type          string                 `json:"type"`
+		Validation    *FormFieldValidation   `json:"validation"`
 		Configuration map[string]interface{} `json:"configuration"`
 		Value         string                 `json:"value"`
 	}{}
@@ -285,6 +354,7 @@ func (ff *FormFieldPassword) UnmarshalJSON(data []byte) error {
 	}
 
 	ff.baseFormField = newBaseFormField(x.Label, x.Name, x.Type)
+	ff.validation = x.Validation
 	ff.value = x.Value
 
 	return nil
@@ -322,6 +392,7 @@ func (ff *FormFieldNumber) UnmarshalJSON(data []byte) error {
 		Label         string                 `json:"label"`
 		Name          string                 `json:"name"`
 		Type          string                 `json:"type"`
+		Validation    *FormFieldValidation   `json:"validation"`
 		Configuration map[string]interface{} `json:"configuration"`
 		Value         string                 `json:"value"`
 	}{}
@@ -331,6 +402,7 @@ func (ff *FormFieldNumber) UnmarshalJSON(data []byte) error {
 	}
 
 	ff.baseFormField = newBaseFormField(x.Label, x.Name, x.Type)
+	ff.validation = x.Validation
 	ff.value = x.Value
 
 	return nil
@@ -385,9 +457,10 @@ func (ff *FormFieldSelect) MarshalJSON() ([]byte, error) {
 
 func (ff *FormFieldSelect) UnmarshalJSON(data []byte) error {
 	x := struct {
-		Label         string `json:"label"`
-		Name          string `json:"name"`
-		Type          string `json:"type"`
+		Label         string               `json:"label"`
+		Name          string               `json:"name"`
+		Type          string               `json:"type"`
+		Validation    *FormFieldValidation `json:"validation"`
 		Configuration struct {
 			Choices  []InputChoice `json:"choices"`
 			Multiple bool          `json:"multiple"`
@@ -399,6 +472,7 @@ func (ff *FormFieldSelect) UnmarshalJSON(data []byte) error {
 	}
 
 	ff.baseFormField = newBaseFormField(x.Label, x.Name, x.Type)
+	ff.validation = x.Validation
 	ff.choices = x.Configuration.Choices
 	ff.multiple = x.Configuration.Multiple
 
@@ -408,20 +482,54 @@ func (ff *FormFieldSelect) UnmarshalJSON(data []byte) error {
 type FormFieldTextarea struct {
 	*baseFormField
 
-	value string
+	value     string
+	rows      int
+	monospace bool
 }
 
-func NewFormFieldTextarea(label, name, value string) *FormFieldTextarea {
-	return &FormFieldTextarea{
+// FormFieldTextareaOption is an option for configuring a FormFieldTextarea.
+type FormFieldTextareaOption func(ff *FormFieldTextarea)
+
+// WithTextareaRows sets the number of rows a textarea shows.
+func WithTextareaRows(rows int) FormFieldTextareaOption {
+	return func(ff *FormFieldTextarea) {
+		ff.rows = rows
+	}
+}
+
+// WithTextareaMonospace shows a textarea's value in a monospace font. Use it
+// for code, e.g. a manifest.
+func WithTextareaMonospace() FormFieldTextareaOption {
+	return func(ff *FormFieldTextarea) {
+		ff.monospace = true
+	}
+}
+
+func NewFormFieldTextarea(label, name, value string, options ...FormFieldTextareaOption) *FormFieldTextarea {
+	ff := &FormFieldTextarea{
 		baseFormField: newBaseFormField(label, name, FieldTypeTextarea),
 		value:         value,
 	}
+
+	for _, option := range options {
+		option(ff)
+	}
+
+	return ff
 }
 
 var _ FormField = (*FormFieldTextarea)(nil)
 
 func (ff *FormFieldTextarea) Configuration() map[string]interface{} {
-	return map[string]interface{}{}
+	configuration := map[string]interface{}{}
+	if ff.rows > 0 {
+		configuration["rows"] = ff.rows
+	}
+	if ff.monospace {
+		configuration["monospace"] = true
+	}
+
+	return configuration
 }
 
 func (ff *FormFieldTextarea) Value() interface{} {
@@ -434,11 +542,15 @@ func (ff *FormFieldTextarea) MarshalJSON() ([]byte, error) {
 
 func (ff *FormFieldTextarea) UnmarshalJSON(data []byte) error {
 	x := struct {
-		Label         string                 `json:"label"`
-		Name          string                 `json:"name"`
-		Type          string                 `json:"type"`
-		Configuration map[string]interface{} `json:"configuration"`
-		Value         string                 `json:"value"`
+		Label         string               `json:"label"`
+		Name          string               `json:"name"`
+		Type          string               `json:"type"`
+		Validation    *FormFieldValidation `json:"validation"`
+		Configuration struct {
+			Rows      int  `json:"rows"`
+			Monospace bool `json:"monospace"`
+		} `json:"configuration"`
+		Value string `json:"value"`
 	}{}
 
 	if err := json.Unmarshal(data, &x); err != nil {
@@ -446,7 +558,54 @@ func (ff *FormFieldTextarea) UnmarshalJSON(data []byte) error {
 	}
 
 	ff.baseFormField = newBaseFormField(x.Label, x.Name, x.Type)
+	ff.validation = x.Validation
 	ff.value = x.Value
+	ff.rows = x.Configuration.Rows
+	ff.monospace = x.Configuration.Monospace
+
+	return nil
+}
+
+// FormFieldFile is a field which reads the contents of a file.
+type FormFieldFile struct {
+	*baseFormField
+}
+
+// NewFormFieldFile creates an instance of FormFieldFile.
+func NewFormFieldFile(label, name string) *FormFieldFile {
+	return &FormFieldFile{
+		baseFormField: newBaseFormField(label, name, FieldTypeFile),
+	}
+}
+
+var _ FormField = (*FormFieldFile)(nil)
+
+func (ff *FormFieldFile) Configuration() map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+func (ff *FormFieldFile) Value() interface{} {
+	return ""
+}
+
+func (ff *FormFieldFile) MarshalJSON() ([]byte, error) {
+	return marshalFormField(ff)
+}
+
+func (ff *FormFieldFile) UnmarshalJSON(data []byte) error {
+	x := struct {
+		Label      string               `json:"label"`
+		Name       string               `json:"name"`
+		Type       string               `json:"type"`
+		Validation *FormFieldValidation `json:"validation"`
+	}{}
+
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+
+	ff.baseFormField = newBaseFormField(x.Label, x.Name, x.Type)
+	ff.validation = x.Validation
 
 	return nil
 }
@@ -483,6 +642,7 @@ func (ff *FormFieldHidden) UnmarshalJSON(data []byte) error {
 		Label         string                 `json:"label"`
 		Name          string                 `json:"name"`
 		Type          string                 `json:"type"`
+		Validation    *FormFieldValidation   `json:"validation"`
 		Configuration map[string]interface{} `json:"configuration"`
 		Value         string                 `json:"value"`
 	}{}
@@ -492,11 +652,115 @@ func (ff *FormFieldHidden) UnmarshalJSON(data []byte) error {
 	}
 
 	ff.baseFormField = newBaseFormField(x.Label, x.Name, x.Type)
+	ff.validation = x.Validation
 	ff.value = x.Value
 
 	return nil
 }
 
+// FormFieldToggle is a field which is on or off.
+type FormFieldToggle struct {
+	*baseFormField
+
+	value bool
+}
+
+// NewFormFieldToggle creates an instance of FormFieldToggle.
+func NewFormFieldToggle(label, name string, value bool) *FormFieldToggle {
+	return &FormFieldToggle{
+		baseFormField: newBaseFormField(label, name, FieldTypeToggle),
+		value:         value,
+	}
+}
+
+var _ FormField = (*FormFieldToggle)(nil)
+
+func (ff *FormFieldToggle) Configuration() map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+func (ff *FormFieldToggle) Value() interface{} {
+	return ff.value
+}
+
+func (ff *FormFieldToggle) MarshalJSON() ([]byte, error) {
+	return marshalFormField(ff)
+}
+
+func (ff *FormFieldToggle) UnmarshalJSON(data []byte) error {
+	x := struct {
+		Label      string               `json:"label"`
+		Name       string               `json:"name"`
+		Type       string               `json:"type"`
+		Validation *FormFieldValidation `json:"validation"`
+		Value      bool                 `json:"value"`
+	}{}
+
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+
+	ff.baseFormField = newBaseFormField(x.Label, x.Name, x.Type)
+	ff.validation = x.Validation
+	ff.value = x.Value
+
+	return nil
+}
+
+// FormFieldTokens is a field which is a list of values, e.g. container
+// arguments. Values are entered one at a time.
+type FormFieldTokens struct {
+	*baseFormField
+
+	values []string
+}
+
+// NewFormFieldTokens creates an instance of FormFieldTokens.
+func NewFormFieldTokens(label, name string, values []string) *FormFieldTokens {
+	return &FormFieldTokens{
+		baseFormField: newBaseFormField(label, name, FieldTypeTokens),
+		values:        values,
+	}
+}
+
+var _ FormField = (*FormFieldTokens)(nil)
+
+func (ff *FormFieldTokens) Configuration() map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+func (ff *FormFieldTokens) Value() interface{} {
+	if ff.values == nil {
+		return []string{}
+	}
+
+	return ff.values
+}
+
+func (ff *FormFieldTokens) MarshalJSON() ([]byte, error) {
+	return marshalFormField(ff)
+}
+
+func (ff *FormFieldTokens) UnmarshalJSON(data []byte) error {
+	x := struct {
+		Label      string               `json:"label"`
+		Name       string               `json:"name"`
+		Type       string               `json:"type"`
+		Validation *FormFieldValidation `json:"validation"`
+		Value      []string             `json:"value"`
+	}{}
+
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+
+	ff.baseFormField = newBaseFormField(x.Label, x.Name, x.Type)
+	ff.validation = x.Validation
+	ff.values = x.Value
+
+	return nil
+}
+
 type Form struct {
 	Fields []FormField `json:"fields"`
 }
@@ -507,15 +771,7 @@ func (f *Form) MarshalJSON() ([]byte, error) {
 	}{}
 
 	for _, field := range f.Fields {
-		m := map[string]interface{}{
-			"label":         field.Label(),
-			"name":          field.Name(),
-			"type":          field.Type(),
-			"configuration": field.Configuration(),
-			"value":         field.Value(),
-		}
-
-		t.Fields = append(t.Fields, m)
+		t.Fields = append(t.Fields, formFieldMap(field))
 	}
 
 	return json.Marshal(t)
@@ -529,6 +785,7 @@ func (f *Form) UnmarshalJSON(data []byte) error {
 			Type          string                 `json:"type"`
 			Configuration map[string]interface{} `json:"configuration"`
 			Value         interface{}            `json:"value"`
+			Validation    *FormFieldValidation   `json:"validation"`
 		} `json:"fields"`
 	}{}
 
@@ -563,8 +820,14 @@ func (f *Form) UnmarshalJSON(data []byte) error {
 			ff = &FormFieldTextarea{}
 		case FieldTypeHidden:
 			ff = &FormFieldHidden{}
+		case FieldTypeFile:
+			ff = &FormFieldFile{}
+		case FieldTypeToggle:
+			ff = &FormFieldToggle{}
+		case FieldTypeTokens:
+			ff = &FormFieldTokens{}
 		default:
-			return errors.Errorf("unknown form field type %q", field)
+			return errors.Errorf("unknown form field type %q", field.Type)
 		}
 
 		if err := ff.UnmarshalJSON(fieldData); err != nil {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/vmware/octant/internal/testutil"
 )
@@ -119,6 +120,94 @@ func TestFormFieldTextarea_UnmarshalJSON(t *testing.T) {
 	assertFormFieldEqual(t, expected, &got)
 }
 
+func TestFormFieldTextarea_options(t *testing.T) {
+	ff := NewFormFieldTextarea("label", "name", "text", WithTextareaRows(20), WithTextareaMonospace())
+
+	expected := map[string]interface{}{
+		"rows":      20,
+		"monospace": true,
+	}
+	assert.Equal(t, expected, ff.Configuration())
+
+	data, err := json.Marshal(&ff)
+	require.NoError(t, err)
+
+	var got FormFieldTextarea
+
+	require.NoError(t, json.Unmarshal(data, &got))
+
+	assertFormFieldEqual(t, ff, &got)
+}
+
+func TestFormFieldToggle_UnmarshalJSON(t *testing.T) {
+	expected := NewFormFieldToggle("label", "name", true)
+
+	data, err := json.Marshal(&expected)
+	require.NoError(t, err)
+
+	var got FormFieldToggle
+
+	require.NoError(t, json.Unmarshal(data, &got))
+
+	assertFormFieldEqual(t, expected, &got)
+}
+
+func TestFormFieldTokens_UnmarshalJSON(t *testing.T) {
+	expected := NewFormFieldTokens("label", "name", []string{"a", "b"})
+
+	data, err := json.Marshal(&expected)
+	require.NoError(t, err)
+
+	var got FormFieldTokens
+
+	require.NoError(t, json.Unmarshal(data, &got))
+
+	assertFormFieldEqual(t, expected, &got)
+}
+
+func TestNewInputChoices(t *testing.T) {
+	got := NewInputChoices([]string{"a", "b", "c"}, "b")
+
+	expected := []InputChoice{
+		{Label: "a", Value: "a"},
+		{Label: "b", Value: "b", Checked: true},
+		{Label: "c", Value: "c"},
+	}
+	assert.Equal(t, expected, got)
+}
+
+func TestNewObjectInputChoices(t *testing.T) {
+	list := &unstructured.UnstructuredList{}
+	for _, name := range []string{"node-1", "node-2"} {
+		item := unstructured.Unstructured{}
+		item.SetName(name)
+		list.Items = append(list.Items, item)
+	}
+
+	got := NewObjectInputChoices(list, "node-2")
+
+	expected := []InputChoice{
+		{Label: "node-1", Value: "node-1"},
+		{Label: "node-2", Value: "node-2", Checked: true},
+	}
+	assert.Equal(t, expected, got)
+
+	assert.Equal(t, []InputChoice{}, NewObjectInputChoices(nil))
+}
+
+func TestFormFieldFile_UnmarshalJSON(t *testing.T) {
+	expected := NewFormFieldFile("label", "name")
+
+	data, err := json.Marshal(&expected)
+	require.NoError(t, err)
+
+	var got FormFieldFile
+
+	require.NoError(t, json.Unmarshal(data, &got))
+
+	assertFormFieldEqual(t, expected, &got)
+}
+
 func TestFormFieldHidden_UnmarshalJSON(t *testing.T) {
 	expected := NewFormFieldHidden("label", "name")
 
@@ -169,6 +258,30 @@ func TestForm_UnmarshalJSON(t *testing.T) {
 			name:      "hidden field",
 			formField: NewFormFieldHidden("name", "7"),
 		},
+		{
+			name:      "file field",
+			formField: NewFormFieldFile("label", "name"),
+		},
+		{
+			name:      "toggle field",
+			formField: NewFormFieldToggle("label", "name", true),
+		},
+		{
+			name:      "tokens field",
+			formField: NewFormFieldTokens("label", "name", []string{"a", "b"}),
+		},
+		{
+			name:      "text area field with options",
+			formField: NewFormFieldTextarea("label", "name", "7", WithTextareaRows(20), WithTextareaMonospace()),
+		},
+		{
+			name: "field with validation",
+			formField: func() FormField {
+				ff := NewFormFieldNumber("label", "name", "7")
+				ff.SetValidation(NewFormFieldValidation(ValidateRequired(), ValidateMin(0)))
+				return ff
+			}(),
+		},
 	}
 
 	for _, test := range tests {
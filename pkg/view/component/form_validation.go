@@ -0,0 +1,210 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// FormFieldValidation are the rules a form field's value must satisfy.
+// Clients check them before a form is submitted, and action handlers check
+// them again before they use the submitted values.
+type FormFieldValidation struct {
+	// Required is true if the field must have a value.
+	Required bool `json:"required,omitempty"`
+	// Min is the lowest number the field accepts.
+	Min *float64 `json:"min,omitempty"`
+	// Max is the highest number the field accepts.
+	Max *float64 `json:"max,omitempty"`
+	// Pattern is a regular expression the whole value must match.
+	Pattern string `json:"pattern,omitempty"`
+	// Enum are the values the field accepts.
+	Enum []string `json:"enum,omitempty"`
+}
+
+// FormFieldValidationOption is an option for configuring
+// FormFieldValidation.
+type FormFieldValidationOption func(v *FormFieldValidation)
+
+// ValidateRequired requires a value.
+func ValidateRequired() FormFieldValidationOption {
+	return func(v *FormFieldValidation) {
+		v.Required = true
+	}
+}
+
+// ValidateMin requires a number which is at least min.
+func ValidateMin(min float64) FormFieldValidationOption {
+	return func(v *FormFieldValidation) {
+		v.Min = &min
+	}
+}
+
+// ValidateMax requires a number which is at most max.
+func ValidateMax(max float64) FormFieldValidationOption {
+	return func(v *FormFieldValidation) {
+		v.Max = &max
+	}
+}
+
+// ValidatePattern requires a value which matches a regular expression.
+func ValidatePattern(pattern string) FormFieldValidationOption {
+	return func(v *FormFieldValidation) {
+		v.Pattern = pattern
+	}
+}
+
+// ValidateEnum requires a value which is one of values.
+func ValidateEnum(values ...string) FormFieldValidationOption {
+	return func(v *FormFieldValidation) {
+		v.Enum = values
+	}
+}
+
+// NewFormFieldValidation creates an instance of FormFieldValidation.
+func NewFormFieldValidation(options ...FormFieldValidationOption) *FormFieldValidation {
+	v := &FormFieldValidation{}
+	for _, option := range options {
+		option(v)
+	}
+
+	return v
+}
+
+// Validate returns an error if value does not satisfy the rules. Values
+// are decoded from JSON, so numbers may be strings or float64s, and fields
+// with several values are slices. Blank values are only checked by
+// Required.
+func (v *FormFieldValidation) Validate(value interface{}) error {
+	if v == nil {
+		return nil
+	}
+
+	values, err := formValueStrings(value)
+	if err != nil {
+		return err
+	}
+
+	if len(values) == 0 {
+		if v.Required {
+			return errors.New("is required")
+		}
+		return nil
+	}
+
+	for _, s := range values {
+		if err := v.validateString(s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (v *FormFieldValidation) validateString(s string) error {
+	if v.Min != nil || v.Max != nil {
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return errors.Errorf("%q is not a number", s)
+		}
+		if v.Min != nil && f < *v.Min {
+			return errors.Errorf("must be at least %s", formatFloat(*v.Min))
+		}
+		if v.Max != nil && f > *v.Max {
+			return errors.Errorf("must be at most %s", formatFloat(*v.Max))
+		}
+	}
+
+	if v.Pattern != "" {
+		re, err := regexp.Compile(fmt.Sprintf("^(?:%s)$", v.Pattern))
+		if err != nil {
+			return errors.Wrap(err, "invalid pattern")
+		}
+		if !re.MatchString(s) {
+			return errors.Errorf("%q does not match %s", s, v.Pattern)
+		}
+	}
+
+	if len(v.Enum) > 0 {
+		found := false
+		for _, allowed := range v.Enum {
+			if s == allowed {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.Errorf("must be one of %s", strings.Join(v.Enum, ", "))
+		}
+	}
+
+	return nil
+}
+
+// formValueStrings converts a submitted value to strings. Blank values are
+// dropped.
+func formValueStrings(value interface{}) ([]string, error) {
+	switch t := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if strings.TrimSpace(t) == "" {
+			return nil, nil
+		}
+		return []string{t}, nil
+	case float64:
+		return []string{formatFloat(t)}, nil
+	case bool:
+		return []string{strconv.FormatBool(t)}, nil
+	case []string:
+		var values []string
+		for _, s := range t {
+			values = append(values, s)
+		}
+		return values, nil
+	case []interface{}:
+		var values []string
+		for _, item := range t {
+			list, err := formValueStrings(item)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, list...)
+		}
+		return values, nil
+	default:
+		return nil, errors.Errorf("unsupported value type %T", value)
+	}
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// Validate returns an error if a submitted value does not satisfy its
+// field's rules. values are keyed by field name.
+func (f *Form) Validate(values map[string]interface{}) error {
+	for _, field := range f.Fields {
+		if err := field.Validation().Validate(values[field.Name()]); err != nil {
+			return errors.Wrap(err, formFieldTitle(field))
+		}
+	}
+
+	return nil
+}
+
+func formFieldTitle(field FormField) string {
+	if field.Label() != "" {
+		return field.Label()
+	}
+
+	return field.Name()
+}
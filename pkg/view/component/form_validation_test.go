@@ -0,0 +1,151 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormFieldValidation_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		validation *FormFieldValidation
+		value      interface{}
+		isErr      bool
+	}{
+		{
+			name:  "nil validation",
+			value: "-1",
+		},
+		{
+			name:       "required with value",
+			validation: NewFormFieldValidation(ValidateRequired()),
+			value:      "value",
+		},
+		{
+			name:       "required without value",
+			validation: NewFormFieldValidation(ValidateRequired()),
+			value:      " ",
+			isErr:      true,
+		},
+		{
+			name:       "required with empty list",
+			validation: NewFormFieldValidation(ValidateRequired()),
+			value:      []interface{}{},
+			isErr:      true,
+		},
+		{
+			name:       "blank value is not checked unless required",
+			validation: NewFormFieldValidation(ValidateMin(0)),
+			value:      "",
+		},
+		{
+			name:       "number string at min",
+			validation: NewFormFieldValidation(ValidateMin(0)),
+			value:      "0",
+		},
+		{
+			name:       "number string below min",
+			validation: NewFormFieldValidation(ValidateMin(0)),
+			value:      "-1",
+			isErr:      true,
+		},
+		{
+			name:       "number above max",
+			validation: NewFormFieldValidation(ValidateMax(10)),
+			value:      float64(11),
+			isErr:      true,
+		},
+		{
+			name:       "not a number",
+			validation: NewFormFieldValidation(ValidateMin(0)),
+			value:      "three",
+			isErr:      true,
+		},
+		{
+			name:       "pattern match",
+			validation: NewFormFieldValidation(ValidatePattern("[a-z]+")),
+			value:      "nginx",
+		},
+		{
+			name:       "pattern must match whole value",
+			validation: NewFormFieldValidation(ValidatePattern("[a-z]+")),
+			value:      "nginx-1",
+			isErr:      true,
+		},
+		{
+			name:       "enum member",
+			validation: NewFormFieldValidation(ValidateEnum("a", "b")),
+			value:      []interface{}{"a", "b"},
+		},
+		{
+			name:       "not an enum member",
+			validation: NewFormFieldValidation(ValidateEnum("a", "b")),
+			value:      "c",
+			isErr:      true,
+		},
+		{
+			name:       "unsupported type",
+			validation: NewFormFieldValidation(ValidateRequired()),
+			value:      map[string]interface{}{},
+			isErr:      true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.validation.Validate(test.value)
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestFormFieldValidation_MarshalJSON(t *testing.T) {
+	ff := NewFormFieldNumber("Replicas", "replicas", "1")
+	ff.SetValidation(NewFormFieldValidation(ValidateRequired(), ValidateMin(0)))
+
+	data, err := json.Marshal(ff)
+	require.NoError(t, err)
+
+	var got map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &got))
+
+	expected := map[string]interface{}{
+		"required": true,
+		"min":      float64(0),
+	}
+	assert.Equal(t, expected, got["validation"])
+}
+
+func TestForm_Validate(t *testing.T) {
+	replicas := NewFormFieldNumber("Replicas", "replicas", "1")
+	replicas.SetValidation(NewFormFieldValidation(ValidateRequired(), ValidateMin(0)))
+
+	form := Form{
+		Fields: []FormField{
+			replicas,
+			NewFormFieldHidden("name", "deployment"),
+		},
+	}
+
+	require.NoError(t, form.Validate(map[string]interface{}{"replicas": "3"}))
+
+	err := form.Validate(map[string]interface{}{"replicas": "-1"})
+	require.Error(t, err)
+	assert.Equal(t, "Replicas: must be at least 0", err.Error())
+
+	err = form.Validate(map[string]interface{}{})
+	require.Error(t, err)
+	assert.Equal(t, "Replicas: is required", err.Error())
+}
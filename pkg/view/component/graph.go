@@ -0,0 +1,246 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// AdjList is an adjacency list - it maps nodes to edges
+type AdjList map[string][]Edge
+
+// Edge represents a directed edge in a graph
+type Edge struct {
+	Node string   `json:"node"`
+	Type EdgeType `json:"edge"`
+}
+
+// Add adds a directed edge to the adjacency list
+func (al AdjList) Add(src string, edge Edge) {
+	edges, ok := al[src]
+	if !ok || edges == nil {
+		edges = make([]Edge, 0)
+	}
+
+	edges = append(edges, edge)
+	al[src] = edges
+}
+
+type NodeStatus string
+
+const (
+	// NodeStatusOK means a node is in a health state
+	NodeStatusOK NodeStatus = "ok"
+	// NodeStatusWarning means ...
+	NodeStatusWarning NodeStatus = "warning"
+	// NodeStatusError means ...
+	NodeStatusError NodeStatus = "error"
+)
+
+// EdgeType represents whether a relationship between resources is implicit or explicit
+type EdgeType string
+
+const (
+	// EdgeTypeImplicit is an implicit edge
+	EdgeTypeImplicit = "implicit"
+	// EdgeTypeExplicit is an explicit edge
+	EdgeTypeExplicit = "explicit"
+)
+
+// Nodes is a set of graph nodes
+type Nodes map[string]Node
+
+// Node is a node in a graph, representing a kubernetes object
+// IsNetwork is a hint to the layout engine.
+type Node struct {
+	Name       string      `json:"name,omitempty"`
+	APIVersion string      `json:"apiVersion,omitempty"`
+	Kind       string      `json:"kind,omitempty"`
+	Status     NodeStatus  `json:"status,omitempty"`
+	Details    []Component `json:"details,omitempty"`
+	Path       *Link       `json:"path,omitempty"`
+}
+
+// UnmarshalJSON unmarshals a node from JSON.
+func (n *Node) UnmarshalJSON(data []byte) error {
+	x := struct {
+		Name       string        `json:"name,omitempty"`
+		APIVersion string        `json:"apiVersion,omitempty"`
+		Kind       string        `json:"kind,omitempty"`
+		Status     NodeStatus    `json:"status,omitempty"`
+		Details    []TypedObject `json:"details,omitempty"`
+		Path       *TypedObject  `json:"path,omitempty"`
+	}{}
+
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+
+	n.Name = x.Name
+	n.APIVersion = x.APIVersion
+	n.Kind = x.Kind
+	n.Status = x.Status
+
+	for i := range x.Details {
+		detail, err := x.Details[i].ToComponent()
+		if err != nil {
+			return errors.Wrapf(err, "unmarshal details for node %q", x.Name)
+		}
+
+		n.Details = append(n.Details, detail)
+	}
+
+	path, err := linkFromTypedObject(x.Path)
+	if err != nil {
+		return errors.Wrapf(err, "unmarshal path for node %q", x.Name)
+	}
+	n.Path = path
+
+	return nil
+}
+
+// GraphConfig is a directed graph of nodes, e.g. kubernetes objects and
+// the relationships between them.
+type GraphConfig struct {
+	Edges AdjList `json:"edges,omitempty"`
+	Nodes Nodes   `json:"nodes,omitempty"`
+}
+
+func newGraphConfig() GraphConfig {
+	return GraphConfig{
+		Edges: AdjList{},
+		Nodes: Nodes{},
+	}
+}
+
+// AddNode adds a node. An existing node with the same id is replaced.
+func (gc *GraphConfig) AddNode(id string, node Node) {
+	if gc.Nodes == nil {
+		gc.Nodes = Nodes{}
+	}
+
+	gc.Nodes[id] = node
+}
+
+// AddEdge adds a directed edge from a node to a child node. The child node
+// must exist.
+func (gc *GraphConfig) AddEdge(nodeID, childID string, edgeType EdgeType) error {
+	if _, ok := gc.Nodes[childID]; !ok {
+		return errors.Errorf("node %q does not exist in graph. available [%s]",
+			childID, strings.Join(gc.NodeIDs(), ", "))
+	}
+
+	if gc.Edges == nil {
+		gc.Edges = AdjList{}
+	}
+
+	gc.Edges.Add(nodeID, Edge{
+		Node: childID,
+		Type: edgeType,
+	})
+
+	return nil
+}
+
+// NodeIDs returns the sorted ids of the graph's nodes.
+func (gc *GraphConfig) NodeIDs() []string {
+	var ids []string
+	for id := range gc.Nodes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	return ids
+}
+
+// Children returns the sorted ids of the nodes a node has edges to.
+func (gc *GraphConfig) Children(id string) []string {
+	var ids []string
+	for _, edge := range gc.Edges[id] {
+		ids = append(ids, edge.Node)
+	}
+	sort.Strings(ids)
+
+	return ids
+}
+
+// Parents returns the sorted ids of the nodes which have edges to a node.
+func (gc *GraphConfig) Parents(id string) []string {
+	var ids []string
+	for nodeID, edges := range gc.Edges {
+		for _, edge := range edges {
+			if edge.Node == id {
+				ids = append(ids, nodeID)
+				break
+			}
+		}
+	}
+	sort.Strings(ids)
+
+	return ids
+}
+
+// Validate returns an error if an edge refers to a node which does not exist.
+func (gc *GraphConfig) Validate() error {
+	for nodeID, edges := range gc.Edges {
+		if _, ok := gc.Nodes[nodeID]; !ok {
+			return errors.Errorf("node %q in edges does not have a node entry. existing nodes: %s",
+				nodeID, strings.Join(gc.NodeIDs(), ", "))
+		}
+
+		for _, edge := range edges {
+			if _, ok := gc.Nodes[edge.Node]; !ok {
+				return errors.Errorf("edge %q from node %q does not have a node entry", edge.Node, nodeID)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Graph is a component which shows nodes and the directed edges between
+// them. Plugins can use it to show relationships between objects.
+type Graph struct {
+	base
+	Config GraphConfig `json:"config"`
+}
+
+var _ Component = (*Graph)(nil)
+
+// NewGraph creates a graph component.
+func NewGraph(title string) *Graph {
+	return &Graph{
+		base:   newBase(typeGraph, TitleFromString(title)),
+		Config: newGraphConfig(),
+	}
+}
+
+// AddNode adds a node.
+func (g *Graph) AddNode(id string, node Node) {
+	g.Config.AddNode(id, node)
+}
+
+// AddEdge adds a directed edge from a node to a child node.
+func (g *Graph) AddEdge(nodeID, childID string, edgeType EdgeType) error {
+	return g.Config.AddEdge(nodeID, childID, edgeType)
+}
+
+type graphMarshal Graph
+
+// MarshalJSON implements json.Marshaler
+func (g *Graph) MarshalJSON() ([]byte, error) {
+	if err := g.Config.Validate(); err != nil {
+		return nil, errors.WithMessage(err, "validate graph component")
+	}
+
+	m := graphMarshal(*g)
+	m.Metadata.Type = typeGraph
+	return json.Marshal(&m)
+}
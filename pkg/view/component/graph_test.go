@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Graph_Marshal(t *testing.T) {
+	g := NewGraph("Graph")
+	g.AddNode("deployment", Node{Name: "deployment", Kind: "Deployment", Status: NodeStatusOK})
+	g.AddNode("replicaSet", Node{Name: "replica-set", Kind: "ReplicaSet", Status: NodeStatusError})
+	require.NoError(t, g.AddEdge("deployment", "replicaSet", EdgeTypeExplicit))
+
+	actual, err := json.Marshal(g)
+	require.NoError(t, err)
+
+	expected := `
+            {
+                "metadata": {
+                  "type": "graph",
+                  "title": [{"metadata": {"type": "text"}, "config": {"value": "Graph"}}]
+                },
+                "config": {
+                  "edges": {
+                    "deployment": [{"node": "replicaSet", "edge": "explicit"}]
+                  },
+                  "nodes": {
+                    "deployment": {"name": "deployment", "kind": "Deployment", "status": "ok"},
+                    "replicaSet": {"name": "replica-set", "kind": "ReplicaSet", "status": "error"}
+                  }
+                }
+            }
+`
+	assert.JSONEq(t, expected, string(actual))
+}
+
+func Test_Graph_Marshal_invalid_edge(t *testing.T) {
+	g := NewGraph("Graph")
+	g.AddNode("replicaSet", Node{})
+	g.Config.Edges.Add("deployment", Edge{Node: "replicaSet", Type: EdgeTypeExplicit})
+
+	_, err := json.Marshal(g)
+	require.Error(t, err)
+}
+
+func Test_GraphConfig_AddEdge_missing_node(t *testing.T) {
+	gc := GraphConfig{}
+	gc.AddNode("deployment", Node{})
+
+	require.Error(t, gc.AddEdge("deployment", "replicaSet", EdgeTypeExplicit))
+}
+
+func Test_GraphConfig_adjacency(t *testing.T) {
+	gc := GraphConfig{}
+	for _, id := range []string{"service", "deployment", "replicaSet", "pod-a", "pod-b"} {
+		gc.AddNode(id, Node{})
+	}
+
+	require.NoError(t, gc.AddEdge("deployment", "replicaSet", EdgeTypeExplicit))
+	require.NoError(t, gc.AddEdge("replicaSet", "pod-b", EdgeTypeExplicit))
+	require.NoError(t, gc.AddEdge("replicaSet", "pod-a", EdgeTypeExplicit))
+	require.NoError(t, gc.AddEdge("service", "pod-a", EdgeTypeImplicit))
+
+	assert.Equal(t, []string{"deployment", "pod-a", "pod-b", "replicaSet", "service"}, gc.NodeIDs())
+	assert.Equal(t, []string{"pod-a", "pod-b"}, gc.Children("replicaSet"))
+	assert.Equal(t, []string{"replicaSet", "service"}, gc.Parents("pod-a"))
+	assert.Empty(t, gc.Children("pod-a"))
+	assert.Empty(t, gc.Parents("service"))
+}
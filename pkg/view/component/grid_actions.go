@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+)
+
+// GridActionKey is the key for actions in a table row.
+const GridActionKey = "_action"
+
+// GridActionsConfig is configuration for grid actions.
+type GridActionsConfig struct {
+	// Actions are the actions for a row. They are dispatched the same way
+	// as buttons.
+	Actions []Button `json:"actions"`
+}
+
+// GridActions are actions for a table row.
+type GridActions struct {
+	base
+	Config GridActionsConfig `json:"config"`
+}
+
+// NewGridActions creates an instance of GridActions.
+func NewGridActions() *GridActions {
+	return &GridActions{
+		base: newBase(typeGridActions, nil),
+	}
+}
+
+// AddAction adds an action.
+func (ga *GridActions) AddAction(button Button) {
+	ga.Config.Actions = append(ga.Config.Actions, button)
+}
+
+type gridActionsMarshal GridActions
+
+// MarshalJSON marshals grid actions.
+func (ga *GridActions) MarshalJSON() ([]byte, error) {
+	m := gridActionsMarshal(*ga)
+	m.Metadata.Type = typeGridActions
+	return json.Marshal(&m)
+}
@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/octant/pkg/action"
+)
+
+func Test_GridActions_Marshal(t *testing.T) {
+	ga := NewGridActions()
+	ga.AddAction(NewButton("Delete",
+		action.Payload{"action": "octant/deleteObject", "name": "pod"},
+		WithButtonConfirmation("Delete Pod", "Are you sure?")))
+
+	actual, err := json.Marshal(ga)
+	require.NoError(t, err)
+
+	expected, err := ioutil.ReadFile(path.Join("testdata", "grid_actions.json"))
+	require.NoError(t, err, "reading test fixtures")
+	assert.JSONEq(t, string(expected), string(actual))
+}
+
+func Test_TableRow_AddAction(t *testing.T) {
+	row := TableRow{"Name": NewText("pod")}
+
+	deleteButton := NewButton("Delete", action.Payload{"action": "octant/deleteObject"})
+	forwardButton := NewButton("Forward port 80", action.Payload{"action": "overview/startPortForward"})
+
+	row.AddAction(deleteButton)
+	row.AddAction(forwardButton)
+
+	expected := NewGridActions()
+	expected.AddAction(deleteButton)
+	expected.AddAction(forwardButton)
+
+	assert.Equal(t, expected, row[GridActionKey])
+}
@@ -29,6 +29,11 @@ func Test_Labels_Marshal(t *testing.T) {
 			}),
 			expectedPath: "labels.json",
 		},
+		{
+			name:         "with links",
+			input:        linkedLabels(),
+			expectedPath: "labels_links.json",
+		},
 	}
 
 	for _, tc := range cases {
@@ -44,6 +49,15 @@ func Test_Labels_Marshal(t *testing.T) {
 	}
 }
 
+func linkedLabels() *component.Labels {
+	labels := component.NewLabels(map[string]string{
+		"foo":            "bar",
+		"controller-uid": "uid",
+	})
+	labels.SetLinks("/overview/namespace/default/workloads/pods")
+	return labels
+}
+
 func Test_Labels_GetMetadata(t *testing.T) {
 	input := component.NewLabels(map[string]string{
 		"foo": "bar",
@@ -68,3 +82,8 @@ func Test_Labels_filter(t *testing.T) {
 
 	assert.JSONEq(t, string(expected), string(got))
 }
+
+func Test_Labels_String(t *testing.T) {
+	labels := component.NewLabels(map[string]string{"b": "2", "a": "1"})
+	assert.Equal(t, "a=1, b=2", labels.String())
+}
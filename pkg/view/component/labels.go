@@ -5,7 +5,12 @@ SPDX-License-Identifier: Apache-2.0
 
 package component
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
 
 var labelsFilteredKeys = []string{
 	"controller-revision-hash",
@@ -25,6 +30,9 @@ type Labels struct {
 // LabelsConfig is the contents of Labels
 type LabelsConfig struct {
 	Labels map[string]string `json:"labels"`
+	// Links are content paths by label key. Clicking a linked label shows
+	// the list at its content path filtered by the label.
+	Links map[string]string `json:"links,omitempty"`
 }
 
 // NewLabels creates a labels component
@@ -42,6 +50,34 @@ func (t *Labels) GetMetadata() Metadata {
 	return t.Metadata
 }
 
+// SetLink links a label to the list at a content path.
+func (t *Labels) SetLink(key, contentPath string) {
+	if t.Config.Links == nil {
+		t.Config.Links = make(map[string]string)
+	}
+
+	t.Config.Links[key] = contentPath
+}
+
+// SetLinks links every label to the list at a content path, e.g. the
+// list of objects of the same kind.
+func (t *Labels) SetLinks(contentPath string) {
+	for key := range t.Config.Labels {
+		t.SetLink(key, contentPath)
+	}
+}
+
+// String returns the labels as key=value pairs sorted by key.
+func (t *Labels) String() string {
+	var pairs []string
+	for k, v := range t.Config.Labels {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ", ")
+}
+
 type labelsMarshal Labels
 
 // MarshalJSON implements json.Marshaler. It will filter
@@ -53,6 +89,14 @@ func (t *Labels) MarshalJSON() ([]byte, error) {
 			filtered.Config.Labels[k] = v
 		}
 	}
+	for k, v := range t.Config.Links {
+		if _, ok := filtered.Config.Labels[k]; ok {
+			if filtered.Config.Links == nil {
+				filtered.Config.Links = make(map[string]string)
+			}
+			filtered.Config.Links[k] = v
+		}
+	}
 
 	m := labelsMarshal(*filtered)
 	m.Metadata.Type = typeLabels
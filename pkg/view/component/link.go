@@ -17,17 +17,58 @@ type Link struct {
 type LinkConfig struct {
 	Text string `json:"value"`
 	Ref  string `json:"ref"`
+	// Download is true if Ref is an API path which is downloaded.
+	Download bool `json:"download,omitempty"`
+	// External is true if Ref is a URL outside of the dashboard, e.g. a
+	// runbook, rather than a content path. Clients only link to external
+	// http and https URLs.
+	External bool `json:"external,omitempty"`
+	// NewWindow is true if the link opens in a new window.
+	NewWindow bool `json:"newWindow,omitempty"`
+}
+
+// LinkOption is an option for configuring Link.
+type LinkOption func(l *Link)
+
+// WithLinkNewWindow opens the link in a new window.
+func WithLinkNewWindow() LinkOption {
+	return func(l *Link) {
+		l.Config.NewWindow = true
+	}
 }
 
 // NewLink creates a link component
-func NewLink(title, s, ref string) *Link {
-	return &Link{
+func NewLink(title, s, ref string, options ...LinkOption) *Link {
+	l := &Link{
 		base: newBase(typeLink, TitleFromString(title)),
 		Config: LinkConfig{
 			Text: s,
 			Ref:  ref,
 		},
 	}
+
+	for _, option := range options {
+		option(l)
+	}
+
+	return l
+}
+
+// NewExternalLink creates a link component for a URL outside of the
+// dashboard, e.g. a Grafana dashboard or a cloud console. It opens in a new
+// window.
+func NewExternalLink(title, s, url string) *Link {
+	l := NewLink(title, s, url, WithLinkNewWindow())
+	l.Config.External = true
+	return l
+}
+
+// NewDownloadLink creates a link component which downloads from an API
+// path, e.g. "inventory?format=csv".
+func NewDownloadLink(title, s, apiPath string) *Link {
+	l := NewLink(title, s, apiPath)
+	l.Config.Download = true
+	return l
 }
 
 // SupportsTitle designates this is a TextComponent.
@@ -48,6 +89,11 @@ func (t *Link) Ref() string {
 	return t.Config.Ref
 }
 
+// IsExternal returns true if the link's ref is outside of the dashboard.
+func (t *Link) IsExternal() bool {
+	return t.Config.External
+}
+
 type linkMarshal Link
 
 // MarshalJSON implements json.Marshaler
@@ -37,6 +37,58 @@ func Test_Link_Marshal(t *testing.T) {
                   "ref": "/overview/deployments/nginx-deployment"
                 }
             }
+`,
+		},
+		{
+			name:  "download",
+			input: NewDownloadLink("Export", "CSV", "inventory?format=csv"),
+			expected: `
+            {
+                "metadata": {
+                  "type": "link",
+                  "title": [{"metadata": {"type": "text"}, "config": {"value": "Export"}}]
+                },
+                "config": {
+                  "value": "CSV",
+                  "ref": "inventory?format=csv",
+                  "download": true
+                }
+            }
+`,
+		},
+		{
+			name:  "external",
+			input: NewExternalLink("Docs", "Runbook", "https://example.com/runbook"),
+			expected: `
+            {
+                "metadata": {
+                  "type": "link",
+                  "title": [{"metadata": {"type": "text"}, "config": {"value": "Docs"}}]
+                },
+                "config": {
+                  "value": "Runbook",
+                  "ref": "https://example.com/runbook",
+                  "external": true,
+                  "newWindow": true
+                }
+            }
+`,
+		},
+		{
+			name:  "new window",
+			input: NewLink("Name", "nginx", "/overview/pods/nginx", WithLinkNewWindow()),
+			expected: `
+            {
+                "metadata": {
+                  "type": "link",
+                  "title": [{"metadata": {"type": "text"}, "config": {"value": "Name"}}]
+                },
+                "config": {
+                  "value": "nginx",
+                  "ref": "/overview/pods/nginx",
+                  "newWindow": true
+                }
+            }
 `,
 		},
 		{
@@ -7,12 +7,151 @@ package component
 
 import (
 	"encoding/json"
+	"hash/fnv"
+	"net/url"
+	"path"
+	"strconv"
+	"time"
 )
 
+// LogLevel is the severity of a log entry.
+type LogLevel string
+
+const (
+	// LogLevelUnknown is the level of entries without a recognized severity.
+	LogLevelUnknown LogLevel = ""
+	// LogLevelDebug is the level of debug entries.
+	LogLevelDebug LogLevel = "debug"
+	// LogLevelInfo is the level of informational entries.
+	LogLevelInfo LogLevel = "info"
+	// LogLevelWarning is the level of warning entries.
+	LogLevelWarning LogLevel = "warning"
+	// LogLevelError is the level of error entries.
+	LogLevelError LogLevel = "error"
+	// LogLevelFatal is the level of fatal entries.
+	LogLevelFatal LogLevel = "fatal"
+)
+
+// LogEntry is a structured log entry.
+type LogEntry struct {
+	Timestamp time.Time         `json:"timestamp,omitempty"`
+	Level     LogLevel          `json:"level,omitempty"`
+	Message   string            `json:"message,omitempty"`
+	Fields    map[string]string `json:"fields,omitempty"`
+	// Pod is the pod which logged the entry in aggregated logs.
+	Pod string `json:"pod,omitempty"`
+	// Container is the container which logged the entry in aggregated logs.
+	Container string `json:"container,omitempty"`
+	// Color is the color key of Pod in aggregated logs.
+	Color string `json:"color,omitempty"`
+}
+
+var (
+	// logColors are the color keys given to pods in aggregated logs.
+	logColors = []string{"blue", "green", "orange", "purple", "teal", "red", "yellow", "gray"}
+)
+
+// LogColor returns the color key of a pod in aggregated logs. A pod is
+// always given the same color.
+func LogColor(pod string) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(pod))
+	return logColors[h.Sum32()%uint32(len(logColors))]
+}
+
+// LogStreamOptions are options for a container log stream.
+type LogStreamOptions struct {
+	// Follow streams new entries as they are logged.
+	Follow bool
+	// SinceSeconds only includes entries newer than this many seconds.
+	// Zero includes all entries.
+	SinceSeconds int64
+	// Previous streams the entries of the container's previous instance.
+	Previous bool
+}
+
+// LogStreamToken returns a token which references a container log stream.
+// The token is the stream's path relative to the logs API.
+func LogStreamToken(namespace, pod, container string, options LogStreamOptions) string {
+	token := path.Join(
+		"namespace", url.PathEscape(namespace),
+		"pod", url.PathEscape(pod),
+		"container", url.PathEscape(container))
+
+	values := options.values()
+	if len(values) > 0 {
+		token += "?" + values.Encode()
+	}
+
+	return token
+}
+
+func (options LogStreamOptions) values() url.Values {
+	values := url.Values{}
+	if options.Follow {
+		values.Set("follow", "true")
+	}
+	if options.SinceSeconds > 0 {
+		values.Set("sinceSeconds", strconv.FormatInt(options.SinceSeconds, 10))
+	}
+	if options.Previous {
+		values.Set("previous", "true")
+	}
+
+	return values
+}
+
+// AggregatedLogOptions are options for the merged logs of several pods.
+type AggregatedLogOptions struct {
+	LogStreamOptions
+	// Include only includes entries with messages which match this
+	// regular expression.
+	Include string
+	// Exclude excludes entries with messages which match this regular
+	// expression.
+	Exclude string
+}
+
+// AggregatedLogStreamToken returns a token which references the merged logs
+// of the pods in a namespace which match a label selector. The token is the
+// stream's path relative to the logs API.
+func AggregatedLogStreamToken(namespace, selector string, options AggregatedLogOptions) string {
+	token := path.Join("namespace", url.PathEscape(namespace), "pods")
+
+	values := options.values()
+	values.Set("selector", selector)
+	if options.Include != "" {
+		values.Set("include", options.Include)
+	}
+	if options.Exclude != "" {
+		values.Set("exclude", options.Exclude)
+	}
+
+	return token + "?" + values.Encode()
+}
+
 type LogsConfig struct {
 	Namespace  string   `json:"namespace,omitempty"`
 	Name       string   `json:"name,omitempty"`
 	Containers []string `json:"containers,omitempty"`
+	// Container is the container initially shown.
+	Container    string `json:"container,omitempty"`
+	Follow       bool   `json:"follow,omitempty"`
+	SinceSeconds int64  `json:"sinceSeconds,omitempty"`
+	Previous     bool   `json:"previous,omitempty"`
+	// PreviousContainers are the containers which have been restarted, so
+	// the logs of their previous instance can be shown.
+	PreviousContainers []string `json:"previousContainers,omitempty"`
+	// Token references the log stream for Container.
+	Token string `json:"token,omitempty"`
+	// Selector selects the pods whose logs are merged in aggregated logs.
+	Selector string `json:"selector,omitempty"`
+	// Pods are the pods whose logs are merged in aggregated logs.
+	Pods []string `json:"pods,omitempty"`
+	// PodColors are the color keys of Pods.
+	PodColors map[string]string `json:"podColors,omitempty"`
+	Include   string            `json:"include,omitempty"`
+	Exclude   string            `json:"exclude,omitempty"`
 }
 
 type Logs struct {
@@ -31,6 +170,50 @@ func NewLogs(namespace, name string, containers []string) *Logs {
 	}
 }
 
+// NewLogStream creates a logs component which shows a single container's
+// log stream. Printers and plugins can use it to embed a live log pane.
+func NewLogStream(namespace, pod, container string, options LogStreamOptions) *Logs {
+	return &Logs{
+		Config: LogsConfig{
+			Namespace:    namespace,
+			Name:         pod,
+			Containers:   []string{container},
+			Container:    container,
+			Follow:       options.Follow,
+			SinceSeconds: options.SinceSeconds,
+			Previous:     options.Previous,
+			Token:        LogStreamToken(namespace, pod, container, options),
+		},
+		base: newBase(typeLogs, TitleFromString("Logs")),
+	}
+}
+
+// NewAggregatedLogs creates a logs component which merges the logs of the
+// pods in a namespace which match a label selector. Each pod's entries are
+// prefixed with its name and colored with its color key.
+func NewAggregatedLogs(namespace, selector string, pods []string, options AggregatedLogOptions) *Logs {
+	podColors := make(map[string]string)
+	for _, pod := range pods {
+		podColors[pod] = LogColor(pod)
+	}
+
+	return &Logs{
+		Config: LogsConfig{
+			Namespace:    namespace,
+			Follow:       options.Follow,
+			SinceSeconds: options.SinceSeconds,
+			Previous:     options.Previous,
+			Token:        AggregatedLogStreamToken(namespace, selector, options),
+			Selector:     selector,
+			Pods:         pods,
+			PodColors:    podColors,
+			Include:      options.Include,
+			Exclude:      options.Exclude,
+		},
+		base: newBase(typeLogs, TitleFromString("Logs")),
+	}
+}
+
 // GetMetadata accesses the components metadata. Implements Component.
 func (l *Logs) GetMetadata() Metadata {
 	return l.Metadata
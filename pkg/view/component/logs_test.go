@@ -48,3 +48,76 @@ func Test_Logs_Marshal(t *testing.T) {
 		})
 	}
 }
+
+func Test_NewLogStream(t *testing.T) {
+	got := NewLogStream("default", "pod", "app", LogStreamOptions{Follow: true, SinceSeconds: 60})
+
+	expected := &Logs{
+		base: newBase(typeLogs, TitleFromString("Logs")),
+		Config: LogsConfig{
+			Namespace:    "default",
+			Name:         "pod",
+			Containers:   []string{"app"},
+			Container:    "app",
+			Follow:       true,
+			SinceSeconds: 60,
+			Token:        "namespace/default/pod/pod/container/app?follow=true&sinceSeconds=60",
+		},
+	}
+
+	assert.Equal(t, expected, got)
+}
+
+func Test_LogStreamToken(t *testing.T) {
+	cases := []struct {
+		name     string
+		options  LogStreamOptions
+		expected string
+	}{
+		{
+			name:     "no options",
+			expected: "namespace/default/pod/pod/container/app",
+		},
+		{
+			name:     "follow",
+			options:  LogStreamOptions{Follow: true},
+			expected: "namespace/default/pod/pod/container/app?follow=true",
+		},
+		{
+			name:     "since seconds",
+			options:  LogStreamOptions{SinceSeconds: 30},
+			expected: "namespace/default/pod/pod/container/app?sinceSeconds=30",
+		},
+		{
+			name:     "previous",
+			options:  LogStreamOptions{Previous: true},
+			expected: "namespace/default/pod/pod/container/app?previous=true",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := LogStreamToken("default", "pod", "app", tc.options)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func Test_NewAggregatedLogs(t *testing.T) {
+	options := AggregatedLogOptions{
+		LogStreamOptions: LogStreamOptions{Follow: true},
+		Include:          "error",
+	}
+	got := NewAggregatedLogs("default", "app=web", []string{"a", "b"}, options)
+
+	assert.Equal(t, "namespace/default/pods?follow=true&include=error&selector=app%3Dweb", got.Config.Token)
+	assert.Equal(t, []string{"a", "b"}, got.Config.Pods)
+	assert.Equal(t, map[string]string{"a": LogColor("a"), "b": LogColor("b")}, got.Config.PodColors)
+	assert.Equal(t, "error", got.Config.Include)
+	assert.True(t, got.Config.Follow)
+}
+
+func Test_LogColor(t *testing.T) {
+	assert.Equal(t, LogColor("pod"), LogColor("pod"))
+	assert.Contains(t, logColors, LogColor("pod"))
+}
@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import "encoding/json"
+
+// Markdown is a component which renders a markdown document, e.g. release
+// notes or an operator's status message. Unlike markdown Text, it is
+// rendered as a block and can contain headings, lists, tables, and code.
+type Markdown struct {
+	base
+	Config MarkdownConfig `json:"config"`
+}
+
+// MarkdownConfig is the contents of Markdown.
+type MarkdownConfig struct {
+	Value string `json:"value"`
+}
+
+var _ Component = (*Markdown)(nil)
+
+// NewMarkdown creates a markdown component.
+func NewMarkdown(title string, value string) *Markdown {
+	return &Markdown{
+		base: newBase(typeMarkdown, TitleFromString(title)),
+		Config: MarkdownConfig{
+			Value: value,
+		},
+	}
+}
+
+type markdownMarshal Markdown
+
+// MarshalJSON implements json.Marshaler
+func (m *Markdown) MarshalJSON() ([]byte, error) {
+	x := markdownMarshal(*m)
+	x.Metadata.Type = typeMarkdown
+	return json.Marshal(&x)
+}
+
+// String returns the markdown source.
+func (m *Markdown) String() string {
+	return m.Config.Value
+}
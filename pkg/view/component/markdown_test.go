@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Markdown_Marshal(t *testing.T) {
+	input := NewMarkdown("Release Notes", "# nginx\n\n* installed")
+
+	actual, err := json.Marshal(input)
+	require.NoError(t, err)
+
+	expected := `
+            {
+                "metadata": {
+                  "type": "markdown",
+                  "title": [
+                    {
+                      "metadata": {
+                        "type": "text"
+                      },
+                      "config": {
+                        "value": "Release Notes"
+                      }
+                    }
+                  ]
+                },
+                "config": {
+                  "value": "# nginx\n\n* installed"
+                }
+            }
+`
+	assert.JSONEq(t, expected, string(actual))
+}
+
+func Test_Markdown_String(t *testing.T) {
+	input := NewMarkdown("", "**ready**")
+	assert.Equal(t, "**ready**", input.String())
+}
@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// PlainText converts a component to plain text. Components without a text
+// representation are converted to a blank string.
+func PlainText(c Component) string {
+	switch t := c.(type) {
+	case nil:
+		return ""
+	case *Timestamp:
+		return time.Unix(t.Config.Timestamp, 0).UTC().Format(time.RFC3339)
+	case *Labels:
+		return joinPairs(t.Config.Labels)
+	case *Annotations:
+		return joinPairs(t.Config.Annotations)
+	case *Selectors:
+		var parts []string
+		for _, selector := range t.Config.Selectors {
+			parts = append(parts, selectorText(selector))
+		}
+		return strings.Join(parts, ", ")
+	case *List:
+		var parts []string
+		for _, item := range t.Config.Items {
+			if s := PlainText(item); s != "" {
+				parts = append(parts, s)
+			}
+		}
+		return strings.Join(parts, ", ")
+	case *Ports:
+		var parts []string
+		for _, port := range t.Config.Ports {
+			parts = append(parts, fmt.Sprintf("%d/%s", port.Config.Port, port.Config.Protocol))
+		}
+		return strings.Join(parts, ", ")
+	case *Code:
+		return t.Config.Value
+	default:
+		return c.String()
+	}
+}
+
+func joinPairs(m map[string]string) string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var parts []string
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf("%s=%s", k, m[k]))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func selectorText(selector Selector) string {
+	switch t := selector.(type) {
+	case *LabelSelector:
+		return fmt.Sprintf("%s=%s", t.Config.Key, t.Config.Value)
+	case *ExpressionSelector:
+		switch t.Config.Operator {
+		case OperatorExists:
+			return t.Config.Key
+		case OperatorDoesNotExist:
+			return "!" + t.Config.Key
+		default:
+			return fmt.Sprintf("%s %s (%s)", t.Config.Key, t.Config.Operator, strings.Join(t.Config.Values, ", "))
+		}
+	default:
+		return selector.Name()
+	}
+}
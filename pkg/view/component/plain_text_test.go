@@ -0,0 +1,68 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlainText(t *testing.T) {
+	cases := []struct {
+		name     string
+		c        Component
+		expected string
+	}{
+		{
+			name:     "nil",
+			expected: "",
+		},
+		{
+			name:     "text",
+			c:        NewText("value"),
+			expected: "value",
+		},
+		{
+			name:     "link",
+			c:        NewLink("", "pod", "/pod"),
+			expected: "pod",
+		},
+		{
+			name:     "timestamp",
+			c:        NewTimestamp(time.Unix(0, 0)),
+			expected: "1970-01-01T00:00:00Z",
+		},
+		{
+			name:     "labels",
+			c:        NewLabels(map[string]string{"b": "2", "a": "1"}),
+			expected: "a=1, b=2",
+		},
+		{
+			name: "selectors",
+			c: NewSelectors([]Selector{
+				NewLabelSelector("app", "nginx"),
+				NewExpressionSelector("tier", OperatorIn, []string{"web", "api"}),
+			}),
+			expected: "app=nginx, tier In (web, api)",
+		},
+		{
+			name: "list",
+			c: NewList("", []Component{
+				NewText("a"),
+				NewText("b"),
+			}),
+			expected: "a, b",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, PlainText(tc.c))
+		})
+	}
+}
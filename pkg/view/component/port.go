@@ -61,6 +61,22 @@ func (t *Port) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&m)
 }
 
+// UnmarshalJSON unmarshals a port. It is needed when a port is embedded in
+// another component's config.
+func (t *Port) UnmarshalJSON(data []byte) error {
+	var to TypedObject
+	if err := json.Unmarshal(data, &to); err != nil {
+		return err
+	}
+
+	t.Metadata = to.Metadata
+	if len(to.Config) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(to.Config, &t.Config)
+}
+
 type PortsConfig struct {
 	Ports []Port `json:"ports,omitempty"`
 }
@@ -0,0 +1,87 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import "encoding/json"
+
+const (
+	// progressWarning is the percentage a progress warns at.
+	progressWarning = 75
+	// progressError is the percentage a progress errors at.
+	progressError = 90
+)
+
+// ProgressConfig is the contents of a Progress.
+type ProgressConfig struct {
+	// Value is the percentage of the whole which is used.
+	Value  float64    `json:"value"`
+	Label  string     `json:"label"`
+	Status NodeStatus `json:"status"`
+}
+
+// Progress is a component which shows how much of a whole is used, e.g.
+// the CPU requested on a node out of its allocatable CPU.
+type Progress struct {
+	base
+	Config ProgressConfig `json:"config"`
+}
+
+var _ Component = (*Progress)(nil)
+
+// NewProgress creates a progress component for used out of total. Its
+// status is a warning at 75% and an error at 90%.
+func NewProgress(label string, used, total float64) *Progress {
+	var value float64
+	if total > 0 {
+		value = used / total * 100
+	}
+
+	status := NodeStatusOK
+	switch {
+	case value >= progressError:
+		status = NodeStatusError
+	case value >= progressWarning:
+		status = NodeStatusWarning
+	}
+
+	return &Progress{
+		base: newBase(typeProgress, nil),
+		Config: ProgressConfig{
+			Value:  value,
+			Label:  label,
+			Status: status,
+		},
+	}
+}
+
+// SetStatus sets the status of the progress.
+func (p *Progress) SetStatus(status NodeStatus) {
+	p.Config.Status = status
+}
+
+type progressMarshal Progress
+
+// MarshalJSON implements json.Marshaler
+func (p *Progress) MarshalJSON() ([]byte, error) {
+	m := progressMarshal(*p)
+	m.Metadata.Type = typeProgress
+	return json.Marshal(&m)
+}
+
+// String returns the label of the progress.
+func (p *Progress) String() string {
+	return p.Config.Label
+}
+
+// LessThan returns true if this progress's value is less than the argument supplied.
+func (p *Progress) LessThan(i interface{}) bool {
+	v, ok := i.(*Progress)
+	if !ok {
+		return false
+	}
+
+	return p.Config.Value < v.Config.Value
+}
@@ -0,0 +1,68 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Progress_Marshal(t *testing.T) {
+	input := NewProgress("CPU", 1, 4)
+
+	actual, err := json.Marshal(input)
+	require.NoError(t, err)
+
+	expected := `
+            {
+                "metadata": {
+                  "type": "progress"
+                },
+                "config": {
+                  "value": 25,
+                  "label": "CPU",
+                  "status": "ok"
+                }
+            }
+`
+	assert.JSONEq(t, expected, string(actual))
+}
+
+func Test_NewProgress(t *testing.T) {
+	tests := []struct {
+		name     string
+		used     float64
+		total    float64
+		value    float64
+		expected NodeStatus
+	}{
+		{name: "empty total", used: 1, total: 0, value: 0, expected: NodeStatusOK},
+		{name: "ok", used: 1, total: 2, value: 50, expected: NodeStatusOK},
+		{name: "warning", used: 3, total: 4, value: 75, expected: NodeStatusWarning},
+		{name: "error", used: 9, total: 10, value: 90, expected: NodeStatusError},
+		{name: "over committed", used: 3, total: 2, value: 150, expected: NodeStatusError},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := NewProgress("label", test.used, test.total)
+			assert.Equal(t, test.value, p.Config.Value)
+			assert.Equal(t, test.expected, p.Config.Status)
+		})
+	}
+}
+
+func Test_Progress_LessThan(t *testing.T) {
+	a := NewProgress("a", 1, 4)
+	b := NewProgress("b", 3, 4)
+
+	assert.True(t, a.LessThan(b))
+	assert.False(t, b.LessThan(a))
+	assert.False(t, a.LessThan(NewText("a")))
+}
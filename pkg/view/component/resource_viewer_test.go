@@ -26,50 +26,52 @@ func Test_ResourceViewer_Marshal(t *testing.T) {
 			name: "in general",
 			input: &ResourceViewer{
 				Config: ResourceViewerConfig{
-					Edges: AdjList{
-						"69e4ea11-2985-11e9-b356-42010a8000e5": []Edge{
-							{
-								Node: "bf4800b5b6602c4c78ba3b654af02b3b",
-								Type: "explicit",
+					GraphConfig: GraphConfig{
+						Edges: AdjList{
+							"69e4ea11-2985-11e9-b356-42010a8000e5": []Edge{
+								{
+									Node: "bf4800b5b6602c4c78ba3b654af02b3b",
+									Type: "explicit",
+								},
 							},
-						},
-						"71c2b4eb-2949-11e9-b356-42010a8000e5": []Edge{
-							{
-								Node: "8682460a-29b5-11e9-b356-42010a8000e5",
-								Type: "explicit",
+							"71c2b4eb-2949-11e9-b356-42010a8000e5": []Edge{
+								{
+									Node: "8682460a-29b5-11e9-b356-42010a8000e5",
+									Type: "explicit",
+								},
 							},
-						},
-						"8682460a-29b5-11e9-b356-42010a8000e5": []Edge{
-							{
-								Node: "bf4800b5b6602c4c78ba3b654af02b3b",
-								Type: "explicit",
+							"8682460a-29b5-11e9-b356-42010a8000e5": []Edge{
+								{
+									Node: "bf4800b5b6602c4c78ba3b654af02b3b",
+									Type: "explicit",
+								},
 							},
 						},
-					},
-					Nodes: Nodes{
-						"69e4ea11-2985-11e9-b356-42010a8000e5": Node{
-							Name:       "my-nginx",
-							APIVersion: "v1",
-							Kind:       "Service",
-							Status:     "ok",
-						},
-						"71c2b4eb-2949-11e9-b356-42010a8000e5": Node{
-							Name:       "nginx-deployment",
-							APIVersion: "apps/v1",
-							Kind:       "Deployment",
-							Status:     "ok",
-						},
-						"8682460a-29b5-11e9-b356-42010a8000e5": Node{
-							Name:       "nginx-deployment-56c74bb7cd",
-							APIVersion: "extensions/v1beta1",
-							Kind:       "ReplicaSet",
-							Status:     "ok",
-						},
-						"bf4800b5b6602c4c78ba3b654af02b3b": Node{
-							Name:       "nginx-deployment-56c74bb7cd pods",
-							APIVersion: "v1",
-							Kind:       "Pod",
-							Status:     "ok",
+						Nodes: Nodes{
+							"69e4ea11-2985-11e9-b356-42010a8000e5": Node{
+								Name:       "my-nginx",
+								APIVersion: "v1",
+								Kind:       "Service",
+								Status:     "ok",
+							},
+							"71c2b4eb-2949-11e9-b356-42010a8000e5": Node{
+								Name:       "nginx-deployment",
+								APIVersion: "apps/v1",
+								Kind:       "Deployment",
+								Status:     "ok",
+							},
+							"8682460a-29b5-11e9-b356-42010a8000e5": Node{
+								Name:       "nginx-deployment-56c74bb7cd",
+								APIVersion: "extensions/v1beta1",
+								Kind:       "ReplicaSet",
+								Status:     "ok",
+							},
+							"bf4800b5b6602c4c78ba3b654af02b3b": Node{
+								Name:       "nginx-deployment-56c74bb7cd pods",
+								APIVersion: "v1",
+								Kind:       "Pod",
+								Status:     "ok",
+							},
 						},
 					},
 				},
@@ -105,14 +107,16 @@ func Test_ResourceViewer_AddEdge(t *testing.T) {
 	require.NoError(t, rv.AddEdge("nodeID", "childID", EdgeTypeExplicit))
 
 	expected := ResourceViewerConfig{
-		Edges: AdjList{
-			"nodeID": []Edge{
-				{Node: "childID", Type: EdgeTypeExplicit},
+		GraphConfig: GraphConfig{
+			Edges: AdjList{
+				"nodeID": []Edge{
+					{Node: "childID", Type: EdgeTypeExplicit},
+				},
+			},
+			Nodes: Nodes{
+				"nodeID":  node,
+				"childID": childNode,
 			},
-		},
-		Nodes: Nodes{
-			"nodeID":  node,
-			"childID": childNode,
 		},
 	}
 
@@ -127,7 +131,6 @@ func Test_ResourceViewer_AddEdge_missing_node(t *testing.T) {
 	node := Node{}
 	rv.AddNode("nodeID", node)
 
-
 	require.Error(t, rv.AddEdge("nodeID", "childID", EdgeTypeExplicit))
 }
 
@@ -144,8 +147,10 @@ func Test_ResourceViewer_AddNode(t *testing.T) {
 	rv.AddNode("nodeID", node)
 
 	expected := ResourceViewerConfig{
-		Edges: AdjList{},
-		Nodes: Nodes{"nodeID": node},
+		GraphConfig: GraphConfig{
+			Edges: AdjList{},
+			Nodes: Nodes{"nodeID": node},
+		},
 	}
 
 	assert.Equal(t, expected, rv.Config)
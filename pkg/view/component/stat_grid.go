@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import "encoding/json"
+
+// Stat is a labeled value in a stat grid.
+type Stat struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+// StatGridConfig is the contents of a StatGrid.
+type StatGridConfig struct {
+	Stats []Stat `json:"stats"`
+}
+
+// StatGrid is a component which shows headline values in a grid, e.g.
+// the number of nodes in a cluster.
+type StatGrid struct {
+	base
+	Config StatGridConfig `json:"config"`
+}
+
+var _ Component = (*StatGrid)(nil)
+
+// NewStatGrid creates a stat grid component.
+func NewStatGrid(title string, stats ...Stat) *StatGrid {
+	return &StatGrid{
+		base: newBase(typeStatGrid, TitleFromString(title)),
+		Config: StatGridConfig{
+			Stats: stats,
+		},
+	}
+}
+
+// Add adds a stat to the grid.
+func (sg *StatGrid) Add(label, value string) {
+	sg.Config.Stats = append(sg.Config.Stats, Stat{
+		Label: label,
+		Value: value,
+	})
+}
+
+type statGridMarshal StatGrid
+
+// MarshalJSON implements json.Marshaler
+func (sg *StatGrid) MarshalJSON() ([]byte, error) {
+	m := statGridMarshal(*sg)
+	m.Metadata.Type = typeStatGrid
+	return json.Marshal(&m)
+}
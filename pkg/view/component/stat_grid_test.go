@@ -0,0 +1,39 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_StatGrid_Marshal(t *testing.T) {
+	input := NewStatGrid("Capacity")
+	input.Add("Nodes", "3")
+	input.Add("Pods", "12")
+
+	actual, err := json.Marshal(input)
+	require.NoError(t, err)
+
+	expected := `
+            {
+                "metadata": {
+                  "type": "statGrid",
+                  "title": [{"metadata": {"type": "text"}, "config": {"value": "Capacity"}}]
+                },
+                "config": {
+                  "stats": [
+                    {"label": "Nodes", "value": "3"},
+                    {"label": "Pods", "value": "12"}
+                  ]
+                }
+            }
+`
+	assert.JSONEq(t, expected, string(actual))
+}
@@ -0,0 +1,58 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import "encoding/json"
+
+// StatusText is a component for text with a status. The status allows
+// the text to be styled by severity.
+type StatusText struct {
+	base
+	Config StatusTextConfig `json:"config"`
+}
+
+var _ Component = (*StatusText)(nil)
+
+// StatusTextConfig is the contents of StatusText.
+type StatusTextConfig struct {
+	Text   string     `json:"value"`
+	Status NodeStatus `json:"status"`
+}
+
+// NewStatusText creates a status text component.
+func NewStatusText(s string, status NodeStatus) *StatusText {
+	return &StatusText{
+		base: newBase(typeStatusText, nil),
+		Config: StatusTextConfig{
+			Text:   s,
+			Status: status,
+		},
+	}
+}
+
+type statusTextMarshal StatusText
+
+// MarshalJSON implements json.Marshaler
+func (t *StatusText) MarshalJSON() ([]byte, error) {
+	m := statusTextMarshal(*t)
+	m.Metadata.Type = typeStatusText
+	return json.Marshal(&m)
+}
+
+// String returns the text content of the component.
+func (t *StatusText) String() string {
+	return t.Config.Text
+}
+
+// LessThan returns true if this component's value is less than the argument supplied.
+func (t *StatusText) LessThan(i interface{}) bool {
+	v, ok := i.(*StatusText)
+	if !ok {
+		return false
+	}
+
+	return t.Config.Text < v.Config.Text
+}
@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_StatusText_Marshal(t *testing.T) {
+	input := NewStatusText("0/3", NodeStatusError)
+
+	actual, err := json.Marshal(input)
+	require.NoError(t, err)
+
+	expected := `
+            {
+                "metadata": {
+                  "type": "statusText"
+                },
+                "config": {
+                  "value": "0/3",
+                  "status": "error"
+                }
+            }
+`
+	assert.JSONEq(t, expected, string(actual))
+}
+
+func Test_StatusText_LessThan(t *testing.T) {
+	a := NewStatusText("1/3", NodeStatusWarning)
+	b := NewStatusText("3/3", NodeStatusOK)
+
+	assert.True(t, a.LessThan(b))
+	assert.False(t, b.LessThan(a))
+	assert.False(t, a.LessThan(NewText("3/3")))
+}
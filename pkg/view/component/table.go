@@ -6,20 +6,126 @@ SPDX-License-Identifier: Apache-2.0
 package component
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io"
 	"sort"
+	"strings"
 	"sync"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/pkg/errors"
 )
 
-// TableFilter describer a text filter for a table.
+// TableFilter describer a text filter for a table. Values are the values
+// a column can be filtered by, and Selected are the values rows are shown
+// for.
 type TableFilter struct {
 	Values   []string `json:"values"`
 	Selected []string `json:"selected"`
 }
 
+// SortDirection is the direction a table column is sorted in.
+type SortDirection string
+
+const (
+	// SortAscending sorts from the lowest value to the highest.
+	SortAscending SortDirection = "asc"
+	// SortDescending sorts from the highest value to the lowest.
+	SortDescending SortDirection = "desc"
+)
+
+// sortableColumns are column names which are sortable by default.
+var sortableColumns = map[string]bool{
+	"Name":     true,
+	"Age":      true,
+	"Status":   true,
+	"Restarts": true,
+}
+
+// TableSort describes how a table is sorted when it is first shown.
+type TableSort struct {
+	Column    string        `json:"column"`
+	Direction SortDirection `json:"direction"`
+}
+
+// TablePage requests a page of table rows. A zero limit requests every row.
+// Continue is the continue token of the table's previous page. Lists with a
+// continue token are paged by the object store instead of by offset.
+type TablePage struct {
+	Offset   int
+	Limit    int
+	Continue string
+}
+
+// TableQuery narrows the rows of a table. Filters are the selected values
+// of the table's filters by column name. Search is text a row must contain
+// in at least one column; it is ignored if the table is not searchable.
+type TableQuery struct {
+	Filters map[string][]string
+	Search  string
+}
+
+// TableExportFormat is a format tables can be exported as.
+type TableExportFormat string
+
+const (
+	// TableExportCSV exports a header row of column names followed by a
+	// row for each table row.
+	TableExportCSV TableExportFormat = "csv"
+	// TableExportJSON exports an array of objects keyed by column name.
+	TableExportJSON TableExportFormat = "json"
+)
+
+// TablePagination describes the page of rows a table contains. Total is the
+// number of rows before the table was paginated. Continue is the continue
+// token of the next page if the rows were paged by the object store.
+type TablePagination struct {
+	Offset   int    `json:"offset"`
+	Limit    int    `json:"limit"`
+	Total    int    `json:"total"`
+	Continue string `json:"continue,omitempty"`
+}
+
+// TableBulkAction is an action which runs on every selected row of a table.
+// Action is the name of the action to run. Each selected row runs its own
+// row action whose payload has that name, so rows without one are skipped.
+// Bulk actions with a DisabledReason are disabled.
+type TableBulkAction struct {
+	Name           string        `json:"name"`
+	Action         string        `json:"action"`
+	Confirmation   *Confirmation `json:"confirmation,omitempty"`
+	DisabledReason string        `json:"disabledReason,omitempty"`
+}
+
+// TableBulkActionOption is a function for configuring a TableBulkAction.
+type TableBulkActionOption func(bulkAction *TableBulkAction)
+
+// WithBulkActionConfirmation configures a bulk action with a confirmation.
+func WithBulkActionConfirmation(title, body string) TableBulkActionOption {
+	return func(bulkAction *TableBulkAction) {
+		bulkAction.Confirmation = &Confirmation{
+			Title: title,
+			Body:  body,
+		}
+	}
+}
+
+// NewTableBulkAction creates an instance of TableBulkAction.
+func NewTableBulkAction(name, actionName string, options ...TableBulkActionOption) TableBulkAction {
+	bulkAction := TableBulkAction{
+		Name:   name,
+		Action: actionName,
+	}
+
+	for _, option := range options {
+		option(&bulkAction)
+	}
+
+	return bulkAction
+}
+
 // TableConfig is the contents of a Table
 type TableConfig struct {
 	Columns      []TableCol             `json:"columns"`
@@ -27,18 +133,37 @@ type TableConfig struct {
 	EmptyContent string                 `json:"emptyContent"`
 	Loading      bool                   `json:"loading"`
 	Filters      map[string]TableFilter `json:"filters"`
+	DefaultSort  *TableSort             `json:"defaultSort,omitempty"`
+	Pagination   *TablePagination       `json:"pagination,omitempty"`
+	BulkActions  []TableBulkAction      `json:"bulkActions,omitempty"`
+	Searchable   bool                   `json:"searchable,omitempty"`
+	Search       string                 `json:"search,omitempty"`
 }
 
 // TableCol describes a column from a table. Accessor is the key this
 // column will appear as in table rows, and must be unique within a table.
+// Sortable columns can be sorted by the user.
 type TableCol struct {
 	Name     string `json:"name"`
 	Accessor string `json:"accessor"`
+	Sortable bool   `json:"sortable,omitempty"`
 }
 
 // TableRow is a row in table. Each key->value represents a particular column in the row.
 type TableRow map[string]Component
 
+// AddAction adds an action to the row. Actions are stored in GridActions
+// under GridActionKey.
+func (t TableRow) AddAction(button Button) {
+	gridActions, ok := t[GridActionKey].(*GridActions)
+	if !ok {
+		gridActions = NewGridActions()
+		t[GridActionKey] = gridActions
+	}
+
+	gridActions.AddAction(button)
+}
+
 func (t *TableRow) UnmarshalJSON(data []byte) error {
 	*t = make(TableRow)
 
@@ -88,7 +213,8 @@ func NewTableWithRows(title, placeholder string, cols []TableCol, rows []TableRo
 }
 
 // NewTableCols returns a slice of table columns, each with name/accessor
-// set according to the provided keys arguments.
+// set according to the provided keys arguments. Name, Age, Status, and
+// Restarts columns are sortable.
 func NewTableCols(keys ...string) []TableCol {
 	if len(keys) == 0 {
 		return make([]TableCol, 0)
@@ -99,6 +225,7 @@ func NewTableCols(keys ...string) []TableCol {
 	for i, k := range keys {
 		cols[i].Name = k
 		cols[i].Accessor = k
+		cols[i].Sortable = sortableColumns[k]
 	}
 	return cols
 }
@@ -154,9 +281,124 @@ func (t *Table) AddColumn(name string) {
 	t.Config.Columns = append(t.Config.Columns, TableCol{
 		Name:     name,
 		Accessor: name,
+		Sortable: sortableColumns[name],
 	})
 }
 
+// SetSortable sets whether a column can be sorted by the user.
+func (t *Table) SetSortable(name string, sortable bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for i := range t.Config.Columns {
+		if t.Config.Columns[i].Name == name {
+			t.Config.Columns[i].Sortable = sortable
+		}
+	}
+}
+
+// SetDefaultSort sets the column and direction the table is sorted by when
+// it is first shown. The column is made sortable.
+func (t *Table) SetDefaultSort(name string, direction SortDirection) {
+	t.SetSortable(name, true)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.Config.DefaultSort = &TableSort{
+		Column:    name,
+		Direction: direction,
+	}
+}
+
+// AddBulkAction adds an action which runs on the selected rows.
+func (t *Table) AddBulkAction(bulkAction TableBulkAction) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.Config.BulkActions = append(t.Config.BulkActions, bulkAction)
+}
+
+// Paginate limits the table's rows to a page. Tables whose rows all fit in
+// the first page are not paginated.
+func (t *Table) Paginate(page TablePage) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	total := len(t.Config.Rows)
+	if page.Limit < 1 || (page.Offset < 1 && total <= page.Limit) {
+		return
+	}
+
+	offset := page.Offset
+	if offset < 0 {
+		offset = 0
+	}
+	if offset > total {
+		offset = total
+	}
+
+	end := offset + page.Limit
+	if end > total {
+		end = total
+	}
+
+	t.Config.Rows = t.Config.Rows[offset:end]
+	t.Config.Pagination = &TablePagination{
+		Offset: offset,
+		Limit:  page.Limit,
+		Total:  total,
+	}
+}
+
+// SetContinue describes the table's rows as a page which was listed with
+// page's limit and continue token. next is the continue token of the next
+// page, and remaining is the number of rows after this page.
+func (t *Table) SetContinue(page TablePage, next string, remaining int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if page.Offset < 1 && next == "" {
+		return
+	}
+
+	t.Config.Pagination = &TablePagination{
+		Offset:   page.Offset,
+		Limit:    page.Limit,
+		Total:    page.Offset + len(t.Config.Rows) + remaining,
+		Continue: next,
+	}
+}
+
+// RemoveColumn removes a column, its values in each row, and its filter
+// from the table.
+func (t *Table) RemoveColumn(name string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var columns []TableCol
+	for _, col := range t.Config.Columns {
+		if col.Name == name {
+			for _, row := range t.Config.Rows {
+				delete(row, col.Accessor)
+			}
+			continue
+		}
+		columns = append(columns, col)
+	}
+
+	t.Config.Columns = columns
+	delete(t.Config.Filters, name)
+}
+
+// ClearFilters removes the table's filters.
+func (t *Table) ClearFilters() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.Config.Filters = make(map[string]TableFilter)
+}
+
 // AddFilter adds a filter to the table. Each column can only have a
 // single filter.
 func (t *Table) AddFilter(columnName string, filter TableFilter) {
@@ -166,6 +408,155 @@ func (t *Table) AddFilter(columnName string, filter TableFilter) {
 	t.Config.Filters[columnName] = filter
 }
 
+// SetSearchable sets whether rows can be searched by text.
+func (t *Table) SetSearchable(searchable bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.Config.Searchable = searchable
+}
+
+// Query removes rows which do not match query. Filters for columns without
+// a table filter are ignored, and the selected values of the table's
+// filters are updated so clients show what was applied.
+func (t *Table) Query(query TableQuery) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	filters := make(map[string][]string)
+	for name, selected := range query.Filters {
+		filter, ok := t.Config.Filters[name]
+		if !ok {
+			continue
+		}
+
+		filter.Selected = selected
+		t.Config.Filters[name] = filter
+		filters[t.accessor(name)] = selected
+	}
+
+	search := ""
+	if t.Config.Searchable {
+		search = strings.ToLower(strings.TrimSpace(query.Search))
+		t.Config.Search = search
+	}
+
+	if len(filters) == 0 && search == "" {
+		return
+	}
+
+	var rows []TableRow
+	for _, row := range t.Config.Rows {
+		if rowMatchesFilters(row, filters) && t.rowMatchesSearch(row, search) {
+			rows = append(rows, row)
+		}
+	}
+
+	t.Config.Rows = rows
+}
+
+// accessor returns the accessor for a column. It must be called with the
+// lock held.
+func (t *Table) accessor(name string) string {
+	for _, col := range t.Config.Columns {
+		if col.Name == name {
+			return col.Accessor
+		}
+	}
+
+	return name
+}
+
+func rowMatchesFilters(row TableRow, filters map[string][]string) bool {
+	for accessor, selected := range filters {
+		cell, ok := row[accessor]
+		if !ok {
+			return false
+		}
+
+		found := false
+		for _, value := range selected {
+			if cell.String() == value {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// rowMatchesSearch returns true if a column in row contains search. It must
+// be called with the lock held.
+func (t *Table) rowMatchesSearch(row TableRow, search string) bool {
+	if search == "" {
+		return true
+	}
+
+	for _, col := range t.Config.Columns {
+		cell, ok := row[col.Accessor]
+		if !ok {
+			continue
+		}
+
+		if strings.Contains(strings.ToLower(cell.String()), search) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Export writes the table's rows in format. Cells are flattened to plain
+// text, e.g. links are exported as their text and timestamps as RFC3339.
+func (t *Table) Export(w io.Writer, format TableExportFormat) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	switch format {
+	case TableExportCSV:
+		cw := csv.NewWriter(w)
+
+		var header []string
+		for _, col := range t.Config.Columns {
+			header = append(header, col.Name)
+		}
+		if err := cw.Write(header); err != nil {
+			return err
+		}
+
+		for _, row := range t.Config.Rows {
+			var record []string
+			for _, col := range t.Config.Columns {
+				record = append(record, PlainText(row[col.Accessor]))
+			}
+			if err := cw.Write(record); err != nil {
+				return err
+			}
+		}
+
+		cw.Flush()
+		return cw.Error()
+	case TableExportJSON:
+		objects := make([]map[string]string, 0, len(t.Config.Rows))
+		for _, row := range t.Config.Rows {
+			object := make(map[string]string)
+			for _, col := range t.Config.Columns {
+				object[col.Name] = PlainText(row[col.Accessor])
+			}
+			objects = append(objects, object)
+		}
+
+		return json.NewEncoder(w).Encode(objects)
+	default:
+		return errors.Errorf("unsupported table export format %q", format)
+	}
+}
+
 // Columns returns the table columns.
 func (t *Table) Columns() []TableCol {
 	return t.Config.Columns
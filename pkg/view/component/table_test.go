@@ -6,10 +6,12 @@ SPDX-License-Identifier: Apache-2.0
 package component
 
 import (
+	"bytes"
 	"encoding/json"
 	"io/ioutil"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -28,6 +30,15 @@ func Test_TableCols(t *testing.T) {
 				{Name: "a", Accessor: "a"},
 			},
 		},
+		{
+			name: "sortable by default",
+			in:   []string{"Name", "Labels", "Age"},
+			expected: []TableCol{
+				{Name: "Name", Accessor: "Name", Sortable: true},
+				{Name: "Labels", Accessor: "Labels"},
+				{Name: "Age", Accessor: "Age", Sortable: true},
+			},
+		},
 		{
 			name:     "empty list",
 			in:       []string{},
@@ -144,6 +155,226 @@ func Test_Table_AddColumn(t *testing.T) {
 	assert.Equal(t, expected, table.Columns())
 }
 
+func TestTable_SetDefaultSort(t *testing.T) {
+	table := NewTable("table", "placeholder", NewTableCols("Name", "Restarts"))
+	table.SetDefaultSort("Restarts", SortDescending)
+
+	expected := []TableCol{
+		{Name: "Name", Accessor: "Name", Sortable: true},
+		{Name: "Restarts", Accessor: "Restarts", Sortable: true},
+	}
+	assert.Equal(t, expected, table.Columns())
+	assert.Equal(t, &TableSort{Column: "Restarts", Direction: SortDescending}, table.Config.DefaultSort)
+
+	table.SetSortable("Name", false)
+	assert.False(t, table.Columns()[0].Sortable)
+}
+
+func TestTable_AddBulkAction(t *testing.T) {
+	table := NewTable("table", "placeholder", NewTableCols("Name"))
+	table.AddBulkAction(NewTableBulkAction("Delete", "octant/deleteObject",
+		WithBulkActionConfirmation("Delete objects", "Are you sure?")))
+
+	expected := []TableBulkAction{
+		{
+			Name:         "Delete",
+			Action:       "octant/deleteObject",
+			Confirmation: &Confirmation{Title: "Delete objects", Body: "Are you sure?"},
+		},
+	}
+	assert.Equal(t, expected, table.Config.BulkActions)
+}
+
+func TestTable_Paginate(t *testing.T) {
+	tests := []struct {
+		name       string
+		page       TablePage
+		expected   []string
+		pagination *TablePagination
+	}{
+		{
+			name:     "no limit",
+			expected: []string{"a", "b", "c"},
+		},
+		{
+			name:     "rows fit in the first page",
+			page:     TablePage{Limit: 3},
+			expected: []string{"a", "b", "c"},
+		},
+		{
+			name:       "first page",
+			page:       TablePage{Limit: 2},
+			expected:   []string{"a", "b"},
+			pagination: &TablePagination{Offset: 0, Limit: 2, Total: 3},
+		},
+		{
+			name:       "last page",
+			page:       TablePage{Offset: 2, Limit: 2},
+			expected:   []string{"c"},
+			pagination: &TablePagination{Offset: 2, Limit: 2, Total: 3},
+		},
+		{
+			name:       "offset past the last row",
+			page:       TablePage{Offset: 5, Limit: 2},
+			pagination: &TablePagination{Offset: 3, Limit: 2, Total: 3},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			table := NewTable("table", "placeholder", NewTableCols("Name"))
+			for _, name := range []string{"a", "b", "c"} {
+				table.Add(TableRow{"Name": NewText(name)})
+			}
+
+			table.Paginate(test.page)
+
+			var got []string
+			for _, row := range table.Rows() {
+				got = append(got, row["Name"].(*Text).Config.Text)
+			}
+
+			assert.Equal(t, test.expected, got)
+			assert.Equal(t, test.pagination, table.Config.Pagination)
+		})
+	}
+}
+
+func TestTable_SetContinue(t *testing.T) {
+	tests := []struct {
+		name       string
+		page       TablePage
+		next       string
+		remaining  int
+		pagination *TablePagination
+	}{
+		{
+			name: "only page",
+			page: TablePage{Limit: 2},
+		},
+		{
+			name:       "first page",
+			page:       TablePage{Limit: 2},
+			next:       "next",
+			remaining:  3,
+			pagination: &TablePagination{Offset: 0, Limit: 2, Total: 5, Continue: "next"},
+		},
+		{
+			name:       "last page",
+			page:       TablePage{Offset: 2, Limit: 2, Continue: "token"},
+			pagination: &TablePagination{Offset: 2, Limit: 2, Total: 4},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			table := NewTable("table", "placeholder", NewTableCols("Name"))
+			for _, name := range []string{"a", "b"} {
+				table.Add(TableRow{"Name": NewText(name)})
+			}
+
+			table.SetContinue(test.page, test.next, test.remaining)
+
+			assert.Equal(t, test.pagination, table.Config.Pagination)
+		})
+	}
+}
+
+func TestTable_Query(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      TableQuery
+		searchable bool
+		expected   []string
+		selected   []string
+	}{
+		{
+			name:     "empty query",
+			expected: []string{"nginx", "redis", "postgres"},
+			selected: []string{"Running", "Pending"},
+		},
+		{
+			name:     "filter",
+			query:    TableQuery{Filters: map[string][]string{"Phase": {"Running"}}},
+			expected: []string{"nginx", "postgres"},
+			selected: []string{"Running"},
+		},
+		{
+			name:     "filter without a table filter",
+			query:    TableQuery{Filters: map[string][]string{"Name": {"nginx"}}},
+			expected: []string{"nginx", "redis", "postgres"},
+			selected: []string{"Running", "Pending"},
+		},
+		{
+			name:       "search",
+			query:      TableQuery{Search: " PEND "},
+			searchable: true,
+			expected:   []string{"redis"},
+			selected:   []string{"Running", "Pending"},
+		},
+		{
+			name:       "search labels",
+			query:      TableQuery{Search: "app=db"},
+			searchable: true,
+			expected:   []string{"postgres"},
+			selected:   []string{"Running", "Pending"},
+		},
+		{
+			name:     "search is ignored if the table is not searchable",
+			query:    TableQuery{Search: "redis"},
+			expected: []string{"nginx", "redis", "postgres"},
+			selected: []string{"Running", "Pending"},
+		},
+		{
+			name: "filter and search",
+			query: TableQuery{
+				Filters: map[string][]string{"Phase": {"Running"}},
+				Search:  "redis",
+			},
+			searchable: true,
+			selected:   []string{"Running"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			table := NewTable("table", "placeholder", NewTableCols("Name", "Labels", "Phase"))
+			table.AddFilter("Phase", TableFilter{
+				Values:   []string{"Running", "Pending"},
+				Selected: []string{"Running", "Pending"},
+			})
+			table.SetSearchable(test.searchable)
+			table.Add(
+				TableRow{"Name": NewText("nginx"), "Labels": NewLabels(map[string]string{"app": "web"}), "Phase": NewText("Running")},
+				TableRow{"Name": NewText("redis"), "Labels": NewLabels(map[string]string{"app": "cache"}), "Phase": NewText("Pending")},
+				TableRow{"Name": NewText("postgres"), "Labels": NewLabels(map[string]string{"app": "db"}), "Phase": NewText("Running")},
+			)
+
+			table.Query(test.query)
+
+			var got []string
+			for _, row := range table.Rows() {
+				got = append(got, row["Name"].(*Text).Config.Text)
+			}
+
+			assert.Equal(t, test.expected, got)
+			assert.Equal(t, test.selected, table.Config.Filters["Phase"].Selected)
+		})
+	}
+}
+
+func TestTable_RemoveColumn(t *testing.T) {
+	table := NewTable("table", "placeholder", NewTableCols("a", "b"))
+	table.Add(TableRow{"a": NewText("1"), "b": NewText("2")})
+	table.AddFilter("b", TableFilter{Values: []string{"2"}})
+
+	table.RemoveColumn("b")
+
+	assert.Equal(t, NewTableCols("a"), table.Columns())
+	assert.Equal(t, []TableRow{{"a": NewText("1")}}, table.Rows())
+	assert.Empty(t, table.Config.Filters)
+}
+
 func Test_Table_Sort(t *testing.T) {
 	cases := []struct {
 		name     string
@@ -179,6 +410,18 @@ func Test_Table_Sort(t *testing.T) {
 				{"a": NewText("1")},
 			},
 		},
+		{
+			name: "numbers",
+			rows: []TableRow{
+				{"a": NewNumberText("10", 10)},
+				{"a": NewNumberText("9", 9)},
+			},
+			reverse: false,
+			expected: []TableRow{
+				{"a": NewNumberText("9", 9)},
+				{"a": NewNumberText("10", 10)},
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -204,3 +447,57 @@ func TestTable_AddFilter(t *testing.T) {
 
 	assert.Equal(t, expected, table.Config.Filters)
 }
+
+func TestTable_Export(t *testing.T) {
+	table := NewTable("table", "placeholder", NewTableCols("Name", "Labels", "Age"))
+	table.Add(
+		TableRow{
+			"Name":   NewLink("", "nginx", "/overview/pods/nginx"),
+			"Labels": NewLabels(map[string]string{"tier": "web", "app": "nginx"}),
+			"Age":    NewTimestamp(time.Unix(0, 0)),
+		},
+		TableRow{
+			"Name": NewText("redis, primary"),
+		},
+	)
+
+	tests := []struct {
+		name     string
+		format   TableExportFormat
+		expected string
+		isErr    bool
+	}{
+		{
+			name:   "csv",
+			format: TableExportCSV,
+			expected: "Name,Labels,Age\n" +
+				"nginx,\"app=nginx, tier=web\",1970-01-01T00:00:00Z\n" +
+				"\"redis, primary\",,\n",
+		},
+		{
+			name:   "json",
+			format: TableExportJSON,
+			expected: `[{"Age":"1970-01-01T00:00:00Z","Labels":"app=nginx, tier=web","Name":"nginx"},` +
+				`{"Age":"","Labels":"","Name":"redis, primary"}]` + "\n",
+		},
+		{
+			name:   "unsupported format",
+			format: "xml",
+			isErr:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := table.Export(&buf, test.format)
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, buf.String())
+		})
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// TabsItem is a single tab in a Tabs component.
+type TabsItem struct {
+	// Name is the label shown for the tab.
+	Name string `json:"name"`
+	// Contents is the view shown when the tab is selected.
+	Contents Component `json:"contents"`
+}
+
+// UnmarshalJSON unmarshals a tab from JSON.
+func (t *TabsItem) UnmarshalJSON(data []byte) error {
+	x := struct {
+		Name     string      `json:"name"`
+		Contents TypedObject `json:"contents"`
+	}{}
+
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+
+	contents, err := x.Contents.ToComponent()
+	if err != nil {
+		return errors.Wrapf(err, "unmarshal contents for tab %q", x.Name)
+	}
+
+	t.Name = x.Name
+	t.Contents = contents
+
+	return nil
+}
+
+// TabsConfig is configuration for the tabs component.
+type TabsConfig struct {
+	Tabs []TabsItem `json:"tabs"`
+}
+
+// Tabs is a component which shows one of several alternative views. The
+// selected tab is chosen in the client, so a single flex layout section
+// can switch between views (e.g. conditions, events, and YAML) without a
+// round trip.
+type Tabs struct {
+	base
+	Config TabsConfig `json:"config"`
+}
+
+var _ Component = (*Tabs)(nil)
+
+// NewTabs creates a tabs component.
+func NewTabs(title string, tabs ...TabsItem) *Tabs {
+	return &Tabs{
+		base: newBase(typeTabs, TitleFromString(title)),
+		Config: TabsConfig{
+			Tabs: tabs,
+		},
+	}
+}
+
+// AddTab adds a tab. Tabs are shown in the order they are added.
+func (t *Tabs) AddTab(name string, contents Component) {
+	t.Config.Tabs = append(t.Config.Tabs, TabsItem{
+		Name:     name,
+		Contents: contents,
+	})
+}
+
+type tabsMarshal Tabs
+
+// MarshalJSON implements json.Marshaler
+func (t *Tabs) MarshalJSON() ([]byte, error) {
+	m := tabsMarshal(*t)
+	m.Metadata.Type = typeTabs
+	return json.Marshal(&m)
+}
@@ -0,0 +1,93 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Tabs_Marshal(t *testing.T) {
+	input := NewTabs("Details")
+	input.AddTab("Conditions", NewText("ready"))
+
+	actual, err := json.Marshal(input)
+	require.NoError(t, err)
+
+	expected := `
+            {
+                "metadata": {
+                  "type": "tabs",
+                  "title": [
+                    {
+                      "metadata": {
+                        "type": "text"
+                      },
+                      "config": {
+                        "value": "Details"
+                      }
+                    }
+                  ]
+                },
+                "config": {
+                  "tabs": [
+                    {
+                      "name": "Conditions",
+                      "contents": {
+                        "metadata": {
+                          "type": "text"
+                        },
+                        "config": {
+                          "value": "ready"
+                        }
+                      }
+                    }
+                  ]
+                }
+            }
+`
+	assert.JSONEq(t, expected, string(actual))
+}
+
+func TestTabs_AddTab(t *testing.T) {
+	tabs := NewTabs("tabs")
+	tabs.AddTab("first", NewText("1"))
+	tabs.AddTab("second", NewText("2"))
+
+	expected := NewTabs("tabs",
+		TabsItem{Name: "first", Contents: NewText("1")},
+		TabsItem{Name: "second", Contents: NewText("2")},
+	)
+
+	AssertEqual(t, expected, tabs)
+}
+
+func Test_FlexLayout_nested_tabs(t *testing.T) {
+	tabs := NewTabs("")
+	tabs.AddTab("Conditions", NewText("ready"))
+
+	layout := NewFlexLayout("layout")
+	layout.AddSections(FlexLayoutSection{
+		{Width: WidthFull, View: tabs},
+	})
+
+	data, err := json.Marshal(layout)
+	require.NoError(t, err)
+
+	to := TypedObject{}
+	require.NoError(t, json.Unmarshal(data, &to))
+
+	actual, err := to.ToComponent()
+	require.NoError(t, err)
+
+	got, ok := actual.(*FlexLayout)
+	require.True(t, ok)
+	require.Len(t, got.Config.Sections, 1)
+	AssertEqual(t, tabs, got.Config.Sections[0][0].View)
+}
@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"net/url"
+	"path"
+
+	"github.com/pkg/errors"
+)
+
+// TerminalSession describes an exec session in a container.
+type TerminalSession struct {
+	Namespace string   `json:"namespace"`
+	Pod       string   `json:"pod"`
+	Container string   `json:"container"`
+	Command   []string `json:"command"`
+	TTY       bool     `json:"tty,omitempty"`
+}
+
+// Validate returns an error if the session does not identify a container
+// and a command.
+func (s TerminalSession) Validate() error {
+	switch {
+	case s.Namespace == "":
+		return errors.New("terminal session namespace is blank")
+	case s.Pod == "":
+		return errors.New("terminal session pod is blank")
+	case s.Container == "":
+		return errors.New("terminal session container is blank")
+	case len(s.Command) == 0:
+		return errors.New("terminal session command is blank")
+	}
+
+	return nil
+}
+
+// Token returns a token which references the session. The token is the
+// session's path relative to the exec API, which upgrades a request for
+// the path to a streaming session.
+func (s TerminalSession) Token() string {
+	token := path.Join(
+		"namespace", url.PathEscape(s.Namespace),
+		"pod", url.PathEscape(s.Pod),
+		"container", url.PathEscape(s.Container))
+
+	values := url.Values{}
+	for _, arg := range s.Command {
+		values.Add("command", arg)
+	}
+	if s.TTY {
+		values.Set("tty", "true")
+	}
+
+	return token + "?" + values.Encode()
+}
+
+// TerminalConfig is the contents of a Terminal.
+type TerminalConfig struct {
+	Session TerminalSession `json:"session"`
+	Token   string          `json:"token"`
+}
+
+// Terminal is a component for an exec session in a container.
+type Terminal struct {
+	base
+	Config TerminalConfig `json:"config"`
+}
+
+var _ Component = (*Terminal)(nil)
+
+// NewTerminal creates a terminal component for an exec session.
+func NewTerminal(title string, session TerminalSession) *Terminal {
+	return &Terminal{
+		base: newBase(typeTerminal, TitleFromString(title)),
+		Config: TerminalConfig{
+			Session: session,
+			Token:   session.Token(),
+		},
+	}
+}
+
+type terminalMarshal Terminal
+
+// MarshalJSON implements json.Marshaler
+func (t *Terminal) MarshalJSON() ([]byte, error) {
+	if err := t.Config.Session.Validate(); err != nil {
+		return nil, errors.WithMessage(err, "validate terminal component")
+	}
+
+	m := terminalMarshal(*t)
+	m.Metadata.Type = typeTerminal
+	return json.Marshal(&m)
+}
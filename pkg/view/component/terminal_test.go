@@ -0,0 +1,112 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Terminal_Marshal(t *testing.T) {
+	input := NewTerminal("Shell", TerminalSession{
+		Namespace: "default",
+		Pod:       "pod",
+		Container: "app",
+		Command:   []string{"/bin/sh", "-c", "ls"},
+		TTY:       true,
+	})
+
+	actual, err := json.Marshal(input)
+	require.NoError(t, err)
+
+	expected := `
+            {
+                "metadata": {
+                  "type": "terminal",
+                  "title": [{"metadata": {"type": "text"}, "config": {"value": "Shell"}}]
+                },
+                "config": {
+                  "session": {
+                    "namespace": "default",
+                    "pod": "pod",
+                    "container": "app",
+                    "command": ["/bin/sh", "-c", "ls"],
+                    "tty": true
+                  },
+                  "token": "namespace/default/pod/pod/container/app?command=%2Fbin%2Fsh&command=-c&command=ls&tty=true"
+                }
+            }
+`
+	assert.JSONEq(t, expected, string(actual))
+}
+
+func Test_Terminal_Marshal_invalid(t *testing.T) {
+	input := NewTerminal("Shell", TerminalSession{
+		Namespace: "default",
+		Pod:       "pod",
+		Container: "app",
+	})
+
+	_, err := json.Marshal(input)
+	require.Error(t, err)
+}
+
+func TestTerminalSession_Validate(t *testing.T) {
+	valid := TerminalSession{
+		Namespace: "default",
+		Pod:       "pod",
+		Container: "app",
+		Command:   []string{"/bin/sh"},
+	}
+
+	cases := []struct {
+		name   string
+		mutate func(s *TerminalSession)
+		isErr  bool
+	}{
+		{
+			name:   "valid",
+			mutate: func(s *TerminalSession) {},
+		},
+		{
+			name:   "no namespace",
+			mutate: func(s *TerminalSession) { s.Namespace = "" },
+			isErr:  true,
+		},
+		{
+			name:   "no pod",
+			mutate: func(s *TerminalSession) { s.Pod = "" },
+			isErr:  true,
+		},
+		{
+			name:   "no container",
+			mutate: func(s *TerminalSession) { s.Container = "" },
+			isErr:  true,
+		},
+		{
+			name:   "no command",
+			mutate: func(s *TerminalSession) { s.Command = nil },
+			isErr:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			session := valid
+			tc.mutate(&session)
+
+			err := session.Validate()
+			if tc.isErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
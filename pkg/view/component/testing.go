@@ -1,20 +1,29 @@
 package component
 
 import (
-	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/octant/pkg/view/diff"
 )
 
-// AssertEqual asserts two components are equal.
+// AssertEqual asserts two components are equal. If they are not, the
+// failure lists each changed value by its path in the component tree.
 func AssertEqual(t *testing.T, expected, got Component) {
-	a, err := json.Marshal(expected)
+	changes, err := diff.Compare(expected, got)
 	require.NoError(t, err)
 
-	b, err := json.Marshal(got)
-	require.NoError(t, err)
+	if len(changes) == 0 {
+		return
+	}
+
+	var lines []string
+	for _, change := range changes {
+		lines = append(lines, change.String())
+	}
 
-	assert.JSONEq(t, string(a), string(b))
+	assert.Fail(t, "components are not equal", strings.Join(lines, "\n"))
 }
@@ -13,10 +13,12 @@ type Text struct {
 	Config TextConfig `json:"config"`
 }
 
-// TextConfig is the contents of Text
+// TextConfig is the contents of Text. SortValue is used instead of the
+// text when sorting, e.g. so "10" sorts after "9".
 type TextConfig struct {
-	Text       string `json:"value"`
-	IsMarkdown bool   `json:"isMarkdown,omitempty"`
+	Text       string   `json:"value"`
+	IsMarkdown bool     `json:"isMarkdown,omitempty"`
+	SortValue  *float64 `json:"sortValue,omitempty"`
 }
 
 // NewText creates a text component
@@ -29,6 +31,15 @@ func NewText(s string) *Text {
 	}
 }
 
+// NewNumberText creates a text component for a number which sorts
+// numerically.
+func NewNumberText(s string, value float64) *Text {
+	t := NewText(s)
+	t.SetSortValue(value)
+
+	return t
+}
+
 // NewMarkdownText creates a text component styled with markdown.
 func NewMarkdownText(s string) *Text {
 	t := NewText(s)
@@ -52,6 +63,11 @@ func (t *Text) DisableMarkdown() {
 	t.Config.IsMarkdown = false
 }
 
+// SetSortValue sets the value used to sort this text.
+func (t *Text) SetSortValue(value float64) {
+	t.Config.SortValue = &value
+}
+
 // SupportsTitle denotes this is a TextComponent.
 func (t *Text) SupportsTitle() {}
 
@@ -76,6 +92,10 @@ func (t *Text) LessThan(i interface{}) bool {
 		return false
 	}
 
+	if t.Config.SortValue != nil && v.Config.SortValue != nil {
+		return *t.Config.SortValue < *v.Config.SortValue
+	}
+
 	return t.Config.Text < v.Config.Text
 
 }
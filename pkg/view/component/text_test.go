@@ -121,6 +121,12 @@ func Test_Text_LessThan(t *testing.T) {
 			other:    NewText("a"),
 			expected: false,
 		},
+		{
+			name:     "sort value",
+			text:     *NewNumberText("10", 10),
+			other:    NewNumberText("9", 9),
+			expected: false,
+		},
 		{
 			name:     "other is not text",
 			text:     *NewText("b"),
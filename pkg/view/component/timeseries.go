@@ -0,0 +1,92 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// TimeseriesPoint is a value at a point in time.
+type TimeseriesPoint struct {
+	Timestamp int64   `json:"timestamp"`
+	Value     float64 `json:"value"`
+}
+
+// TimeseriesSeries is a named series of points.
+type TimeseriesSeries struct {
+	Name   string            `json:"name"`
+	Points []TimeseriesPoint `json:"points"`
+}
+
+// TimeseriesConfig is the contents of a Timeseries.
+type TimeseriesConfig struct {
+	Series    []TimeseriesSeries `json:"series"`
+	Unit      string             `json:"unit,omitempty"`
+	Sparkline bool               `json:"sparkline,omitempty"`
+}
+
+// Timeseries is a component which graphs values over time, e.g. the CPU
+// or memory usage of a pod. A sparkline is a compact graph without axes
+// or a legend.
+type Timeseries struct {
+	base
+	Config TimeseriesConfig `json:"config"`
+}
+
+var _ Component = (*Timeseries)(nil)
+
+// NewTimeseries creates a timeseries component. Unit describes the
+// values, e.g. "cores" or "bytes".
+func NewTimeseries(title, unit string) *Timeseries {
+	return &Timeseries{
+		base: newBase(typeTimeseries, TitleFromString(title)),
+		Config: TimeseriesConfig{
+			Unit: unit,
+		},
+	}
+}
+
+// NewSparkline creates a timeseries component which is shown as a sparkline.
+func NewSparkline(unit string) *Timeseries {
+	return &Timeseries{
+		base: newBase(typeTimeseries, nil),
+		Config: TimeseriesConfig{
+			Unit:      unit,
+			Sparkline: true,
+		},
+	}
+}
+
+// AddPoint adds a point to a series. The series is created if it does
+// not exist.
+func (ts *Timeseries) AddPoint(series string, t time.Time, value float64) {
+	point := TimeseriesPoint{
+		Timestamp: t.Unix(),
+		Value:     value,
+	}
+
+	for i := range ts.Config.Series {
+		if ts.Config.Series[i].Name == series {
+			ts.Config.Series[i].Points = append(ts.Config.Series[i].Points, point)
+			return
+		}
+	}
+
+	ts.Config.Series = append(ts.Config.Series, TimeseriesSeries{
+		Name:   series,
+		Points: []TimeseriesPoint{point},
+	})
+}
+
+type timeseriesMarshal Timeseries
+
+// MarshalJSON implements json.Marshaler
+func (ts *Timeseries) MarshalJSON() ([]byte, error) {
+	m := timeseriesMarshal(*ts)
+	m.Metadata.Type = typeTimeseries
+	return json.Marshal(&m)
+}
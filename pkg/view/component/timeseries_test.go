@@ -0,0 +1,76 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Timeseries_Marshal(t *testing.T) {
+	now := time.Unix(1548198349, 0)
+
+	input := NewTimeseries("Memory", "bytes")
+	input.AddPoint("usage", now, 1024)
+	input.AddPoint("limit", now, 4096)
+	input.AddPoint("usage", now.Add(time.Minute), 2048)
+
+	actual, err := json.Marshal(input)
+	require.NoError(t, err)
+
+	expected := `
+            {
+                "metadata": {
+                  "type": "timeseries",
+                  "title": [{"metadata": {"type": "text"}, "config": {"value": "Memory"}}]
+                },
+                "config": {
+                  "series": [
+                    {
+                      "name": "usage",
+                      "points": [
+                        {"timestamp": 1548198349, "value": 1024},
+                        {"timestamp": 1548198409, "value": 2048}
+                      ]
+                    },
+                    {
+                      "name": "limit",
+                      "points": [
+                        {"timestamp": 1548198349, "value": 4096}
+                      ]
+                    }
+                  ],
+                  "unit": "bytes"
+                }
+            }
+`
+	assert.JSONEq(t, expected, string(actual))
+}
+
+func Test_NewSparkline(t *testing.T) {
+	ts := NewSparkline("cores")
+	ts.AddPoint("cpu", time.Unix(1548198349, 0), 0.25)
+
+	expected := &Timeseries{
+		base: newBase(typeTimeseries, nil),
+		Config: TimeseriesConfig{
+			Series: []TimeseriesSeries{
+				{
+					Name:   "cpu",
+					Points: []TimeseriesPoint{{Timestamp: 1548198349, Value: 0.25}},
+				},
+			},
+			Unit:      "cores",
+			Sparkline: true,
+		},
+	}
+
+	assert.Equal(t, expected, ts)
+}
@@ -7,6 +7,7 @@ package component
 
 import (
 	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -18,19 +19,91 @@ type Timestamp struct {
 
 var _ (Component) = (*Timestamp)(nil)
 
+// TimestampDisplay is how a timestamp is shown.
+type TimestampDisplay string
+
+const (
+	// TimestampRelative shows the time since the timestamp, e.g. 5m. It is
+	// the default.
+	TimestampRelative TimestampDisplay = "relative"
+	// TimestampAbsolute shows the timestamp as a date and time.
+	TimestampAbsolute TimestampDisplay = "absolute"
+)
+
 // TimestampConfig is the contents of Timestamp
 type TimestampConfig struct {
 	Timestamp int64 `json:"timestamp"`
+	// Relative is the time since Timestamp when the component was created,
+	// e.g. 5m.
+	Relative string `json:"relative,omitempty"`
+	// Display is how clients show the timestamp. It defaults to
+	// TimestampRelative.
+	Display TimestampDisplay `json:"display,omitempty"`
+}
+
+// TimestampOption is an option for configuring Timestamp.
+type TimestampOption func(t *Timestamp)
+
+// WithTimestampDisplay sets how the timestamp is shown.
+func WithTimestampDisplay(display TimestampDisplay) TimestampOption {
+	return func(t *Timestamp) {
+		t.Config.Display = display
+	}
+}
+
+// WithTimestampRelativeTo computes the time since the timestamp from now
+// instead of the current time.
+func WithTimestampRelativeTo(now time.Time) TimestampOption {
+	return func(t *Timestamp) {
+		t.Config.Relative = humanizeDuration(now.Sub(t.Time()))
+	}
 }
 
 // NewTimestamp creates a timestamp component
-func NewTimestamp(t time.Time) *Timestamp {
-	return &Timestamp{
+func NewTimestamp(t time.Time, options ...TimestampOption) *Timestamp {
+	ts := &Timestamp{
 		base: newBase(typeTimestamp, nil),
 		Config: TimestampConfig{
 			Timestamp: t.Unix(),
+			Relative:  humanizeDuration(time.Since(t)),
 		},
 	}
+
+	for _, option := range options {
+		option(ts)
+	}
+
+	return ts
+}
+
+// humanizeDuration summarizes a duration by its largest unit, e.g. 3d.
+func humanizeDuration(d time.Duration) string {
+	switch {
+	case d < 0:
+		return "0s"
+	case d > 24*time.Hour:
+		return fmt.Sprintf("%dd", d/(24*time.Hour))
+	case d > time.Hour:
+		return fmt.Sprintf("%dh", d/time.Hour)
+	case d > time.Minute:
+		return fmt.Sprintf("%dm", d/time.Minute)
+	default:
+		return fmt.Sprintf("%ds", d/time.Second)
+	}
+}
+
+// Time returns the timestamp's time.
+func (t *Timestamp) Time() time.Time {
+	return time.Unix(t.Config.Timestamp, 0)
+}
+
+// String returns the timestamp as it is shown.
+func (t *Timestamp) String() string {
+	if t.Config.Display == TimestampAbsolute {
+		return t.Time().UTC().Format(time.RFC3339)
+	}
+
+	return t.Config.Relative
 }
 
 type timestampMarshal Timestamp
@@ -40,6 +40,28 @@ func Test_Timestamp_Marshal(t *testing.T) {
                   "timestamp": -14159040
                 }
             }
+`,
+		},
+		{
+			name: "absolute",
+			input: &Timestamp{
+				Config: TimestampConfig{
+					Timestamp: ts.Unix(),
+					Relative:  "18250d",
+					Display:   TimestampAbsolute,
+				},
+			},
+			expected: `
+            {
+                "metadata": {
+                  "type": "timestamp"
+                },
+                "config": {
+                  "timestamp": -14159040,
+                  "relative": "18250d",
+                  "display": "absolute"
+                }
+            }
 `,
 		},
 		{
@@ -116,3 +138,40 @@ func Test_Timestamp_LessThan(t *testing.T) {
 		})
 	}
 }
+
+func Test_Timestamp_String(t *testing.T) {
+	then := time.Now().Add(-3 * time.Hour).Add(-time.Minute)
+
+	relative := NewTimestamp(then)
+	assert.Equal(t, "3h", relative.String())
+
+	absolute := NewTimestamp(time.Unix(0, 0), WithTimestampDisplay(TimestampAbsolute))
+	assert.Equal(t, "1970-01-01T00:00:00Z", absolute.String())
+}
+
+func Test_Timestamp_relative_to(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+
+	ts := NewTimestamp(now.Add(-2*24*time.Hour-time.Hour), WithTimestampRelativeTo(now))
+	assert.Equal(t, "2d", ts.String())
+}
+
+func Test_humanizeDuration(t *testing.T) {
+	cases := []struct {
+		name     string
+		d        time.Duration
+		expected string
+	}{
+		{name: "future", d: -time.Minute, expected: "0s"},
+		{name: "seconds", d: 42 * time.Second, expected: "42s"},
+		{name: "minutes", d: 5*time.Minute + 30*time.Second, expected: "5m"},
+		{name: "hours", d: 2*time.Hour + 59*time.Minute, expected: "2h"},
+		{name: "days", d: 50 * time.Hour, expected: "2d"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, humanizeDuration(tc.d))
+		})
+	}
+}
@@ -11,11 +11,42 @@ import (
 	"github.com/pkg/errors"
 )
 
+// Unmarshal decodes a component from JSON. The component's type is read
+// from its metadata, e.g. {"metadata":{"type":"text"},"config":{...}}.
+func Unmarshal(data []byte) (Component, error) {
+	var to TypedObject
+	if err := json.Unmarshal(data, &to); err != nil {
+		return nil, errors.Wrap(err, "unmarshal typed object")
+	}
+
+	return to.ToComponent()
+}
+
 func unmarshal(to TypedObject) (Component, error) {
 	var o Component
 	var err error
 
 	switch to.Metadata.Type {
+	case typeAnnotations:
+		t := &Annotations{base: base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal annotations config")
+		o = t
+	case typeBanner:
+		t := &Banner{base: base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal banner config")
+		o = t
+	case typeBreadcrumb:
+		t := &Breadcrumb{base: base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal breadcrumb config")
+		o = t
+	case typeButtonGroup:
+		t := &ButtonGroup{base: base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal buttonGroup config")
+		o = t
 	case typeCard:
 		t := &Card{base: base{Metadata: to.Metadata}}
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
@@ -26,11 +57,31 @@ func unmarshal(to TypedObject) (Component, error) {
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
 			"unmarshal cardList config")
 		o = t
+	case typeCode:
+		t := &Code{base: base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal code config")
+		o = t
 	case typeContainers:
 		t := &Containers{base: base{Metadata: to.Metadata}}
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
 			"unmarshal containers config")
 		o = t
+	case typeDiff:
+		t := &Diff{base: base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal diff config")
+		o = t
+	case typeDonutChart:
+		t := &DonutChart{base: base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal donutChart config")
+		o = t
+	case typeError:
+		t := &Error{base: base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal error config")
+		o = t
 	case typeExpressionSelector:
 		t := &ExpressionSelector{base: base{Metadata: to.Metadata}}
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
@@ -39,13 +90,23 @@ func unmarshal(to TypedObject) (Component, error) {
 	case typeFlexLayout:
 		t := &FlexLayout{base: base{Metadata: to.Metadata}}
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
-			"unmarshal expressionSelector config")
+			"unmarshal flexlayout config")
+		o = t
+	case typeGraph:
+		t := &Graph{base: base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal graph config")
 		o = t
 	case typeGraphviz:
 		t := &Graphviz{base: base{Metadata: to.Metadata}}
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
 			"unmarshal graphviz config")
 		o = t
+	case typeGridActions:
+		t := &GridActions{base: base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal gridActions config")
+		o = t
 	case typeLabels:
 		t := &Labels{base: base{Metadata: to.Metadata}}
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
@@ -71,6 +132,36 @@ func unmarshal(to TypedObject) (Component, error) {
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
 			"unmarshal list config")
 		o = t
+	case typeLogs:
+		t := &Logs{base: base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal logs config")
+		o = t
+	case typeMarkdown:
+		t := &Markdown{base: base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal markdown config")
+		o = t
+	case typePodStatus:
+		t := &PodStatus{base: base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal podStatus config")
+		o = t
+	case typePort:
+		t := &Port{base: base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal port config")
+		o = t
+	case typePorts:
+		t := &Ports{base: base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal ports config")
+		o = t
+	case typeProgress:
+		t := &Progress{base: base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal progress config")
+		o = t
 	case typeQuadrant:
 		t := &Quadrant{base: base{Metadata: to.Metadata}}
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
@@ -86,6 +177,11 @@ func unmarshal(to TypedObject) (Component, error) {
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
 			"unmarshal selectors config")
 		o = t
+	case typeStatGrid:
+		t := &StatGrid{base: base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal statGrid config")
+		o = t
 	case typeSummary:
 		t := &Summary{base: base{Metadata: to.Metadata}}
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
@@ -96,16 +192,41 @@ func unmarshal(to TypedObject) (Component, error) {
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
 			"unmarshal table config")
 		o = t
+	case typeStatusText:
+		t := &StatusText{base: base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal statusText config")
+		o = t
+	case typeTabs:
+		t := &Tabs{base: base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal tabs config")
+		o = t
+	case typeTerminal:
+		t := &Terminal{base: base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal terminal config")
+		o = t
 	case typeText:
 		t := &Text{base: base{Metadata: to.Metadata}}
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
 			"unmarshal text config")
 		o = t
+	case typeTimeseries:
+		t := &Timeseries{base: base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal timeseries config")
+		o = t
 	case typeTimestamp:
 		t := &Timestamp{base: base{Metadata: to.Metadata}}
 		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
 			"unmarshal timestamp config")
 		o = t
+	case typeYAML:
+		t := &YAML{base: base{Metadata: to.Metadata}}
+		err = errors.Wrapf(json.Unmarshal(to.Config, &t.Config),
+			"unmarshal yaml config")
+		o = t
 
 	default:
 		return nil, errors.Errorf("unknown view component %q", to.Metadata.Type)
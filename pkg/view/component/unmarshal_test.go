@@ -10,8 +10,13 @@ import (
 	"io/ioutil"
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/octant/pkg/action"
 )
 
 func Test_unmarshal(t *testing.T) {
@@ -21,6 +26,20 @@ func Test_unmarshal(t *testing.T) {
 		objectType string
 		expected   Component
 	}{
+		{
+			name:       "banner",
+			configFile: "config_banner.json",
+			objectType: typeBanner,
+			expected: &Banner{
+				Config: Alert{
+					Type:        AlertTypeError,
+					Message:     "Deployment has exceeded its progress deadline",
+					Dismissible: true,
+					Link:        &AlertLink{Text: "View", Ref: "/deployment"},
+				},
+				base: newBase(typeBanner, nil),
+			},
+		},
 		{
 			name:       "cardList",
 			configFile: "config_card_list.json",
@@ -53,6 +72,23 @@ func Test_unmarshal(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:       "code",
+			configFile: "config_code.json",
+			objectType: typeCode,
+			expected: &Code{
+				Config: CodeConfig{
+					Value:       "key: value",
+					Language:    "yaml",
+					LineNumbers: true,
+					Edit: &CodeEdit{
+						Payload: action.Payload{"action": "configmap/update"},
+						Field:   "value",
+					},
+				},
+				base: newBase(typeCode, nil),
+			},
+		},
 		{
 			name:       "containers",
 			configFile: "config_containers.json",
@@ -67,6 +103,38 @@ func Test_unmarshal(t *testing.T) {
 				base: newBase(typeContainers, nil),
 			},
 		},
+		{
+			name:       "diff",
+			configFile: "config_diff.json",
+			objectType: typeDiff,
+			expected: &Diff{
+				Config: DiffConfig{
+					Before:      "replicas: 1",
+					After:       "replicas: 2",
+					BeforeLabel: "1",
+					AfterLabel:  "2",
+					Mode:        DiffModeSplit,
+					Language:    "yaml",
+				},
+				base: newBase(typeDiff, nil),
+			},
+		},
+		{
+			name:       "donutChart",
+			configFile: "config_donut_chart.json",
+			objectType: "donutChart",
+			expected: &DonutChart{
+				Config: DonutChartConfig{
+					Segments: []DonutSegment{
+						{Count: 2, Status: NodeStatusOK},
+						{Count: 1, Status: NodeStatusError},
+					},
+					Labels: DonutChartLabels{Plural: "replicas", Singular: "replica"},
+					Size:   DonutChartSizeMedium,
+				},
+				base: newBase(typeDonutChart, nil),
+			},
+		},
 		{
 			name:       "flexlayout",
 			configFile: "config_flexlayout.json",
@@ -85,6 +153,64 @@ func Test_unmarshal(t *testing.T) {
 				base: newBase(typeFlexLayout, nil),
 			},
 		},
+		{
+			name:       "breadcrumb",
+			configFile: "config_breadcrumb.json",
+			objectType: "breadcrumb",
+			expected: &Breadcrumb{
+				Config: BreadcrumbConfig{
+					Links: []*Link{
+						{
+							base:   newBase(typeLink, nil),
+							Config: LinkConfig{Text: "text", Ref: "ref"},
+						},
+					},
+				},
+				base: newBase(typeBreadcrumb, nil),
+			},
+		},
+		{
+			name:       "graph",
+			configFile: "config_graph.json",
+			objectType: "graph",
+			expected: &Graph{
+				Config: GraphConfig{
+					Edges: AdjList{
+						"deployment": []Edge{
+							{Node: "replicaSet", Type: EdgeTypeExplicit},
+						},
+					},
+					Nodes: Nodes{
+						"deployment": Node{
+							Name:       "deployment",
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Status:     NodeStatusOK,
+						},
+						"replicaSet": Node{
+							Name:       "replica-set",
+							APIVersion: "apps/v1",
+							Kind:       "ReplicaSet",
+							Status:     NodeStatusWarning,
+						},
+					},
+				},
+				base: newBase(typeGraph, nil),
+			},
+		},
+		{
+			name:       "grid actions",
+			configFile: "config_grid_actions.json",
+			objectType: "gridActions",
+			expected: &GridActions{
+				Config: GridActionsConfig{
+					Actions: []Button{
+						{Name: "Delete", Payload: action.Payload{"action": "octant/deleteObject"}},
+					},
+				},
+				base: newBase(typeGridActions, nil),
+			},
+		},
 		{
 			name:       "labels",
 			configFile: "config_labels.json",
@@ -135,6 +261,47 @@ func Test_unmarshal(t *testing.T) {
 				base: newBase(typeList, nil),
 			},
 		},
+		{
+			name:       "logs",
+			configFile: "config_logs.json",
+			objectType: "logs",
+			expected: &Logs{
+				Config: LogsConfig{
+					Namespace:    "default",
+					Name:         "pod",
+					Containers:   []string{"app"},
+					Container:    "app",
+					Follow:       true,
+					SinceSeconds: 300,
+					Token:        "namespace/default/pod/pod/container/app?follow=true&sinceSeconds=300",
+				},
+				base: newBase(typeLogs, nil),
+			},
+		},
+		{
+			name:       "markdown",
+			configFile: "config_markdown.json",
+			objectType: typeMarkdown,
+			expected: &Markdown{
+				Config: MarkdownConfig{
+					Value: "# nginx\n\n* installed",
+				},
+				base: newBase(typeMarkdown, nil),
+			},
+		},
+		{
+			name:       "progress",
+			configFile: "config_progress.json",
+			objectType: "progress",
+			expected: &Progress{
+				Config: ProgressConfig{
+					Value:  80,
+					Label:  "CPU",
+					Status: NodeStatusWarning,
+				},
+				base: newBase(typeProgress, nil),
+			},
+		},
 		{
 			name:       "quadrant",
 			configFile: "config_quadrant.json",
@@ -155,50 +322,52 @@ func Test_unmarshal(t *testing.T) {
 			objectType: "resourceViewer",
 			expected: &ResourceViewer{
 				Config: ResourceViewerConfig{
-					Edges: AdjList{
-						"69e4ea11-2985-11e9-b356-42010a8000e5": []Edge{
-							{
-								Node: "bf4800b5b6602c4c78ba3b654af02b3b",
-								Type: "explicit",
+					GraphConfig: GraphConfig{
+						Edges: AdjList{
+							"69e4ea11-2985-11e9-b356-42010a8000e5": []Edge{
+								{
+									Node: "bf4800b5b6602c4c78ba3b654af02b3b",
+									Type: "explicit",
+								},
 							},
-						},
-						"71c2b4eb-2949-11e9-b356-42010a8000e5": []Edge{
-							{
-								Node: "8682460a-29b5-11e9-b356-42010a8000e5",
-								Type: "explicit",
+							"71c2b4eb-2949-11e9-b356-42010a8000e5": []Edge{
+								{
+									Node: "8682460a-29b5-11e9-b356-42010a8000e5",
+									Type: "explicit",
+								},
 							},
-						},
-						"8682460a-29b5-11e9-b356-42010a8000e5": []Edge{
-							{
-								Node: "bf4800b5b6602c4c78ba3b654af02b3b",
-								Type: "explicit",
+							"8682460a-29b5-11e9-b356-42010a8000e5": []Edge{
+								{
+									Node: "bf4800b5b6602c4c78ba3b654af02b3b",
+									Type: "explicit",
+								},
 							},
 						},
-					},
-					Nodes: Nodes{
-						"69e4ea11-2985-11e9-b356-42010a8000e5": Node{
-							Name:       "my-nginx",
-							APIVersion: "v1",
-							Kind:       "Service",
-							Status:     "ok",
-						},
-						"71c2b4eb-2949-11e9-b356-42010a8000e5": Node{
-							Name:       "nginx-deployment",
-							APIVersion: "apps/v1",
-							Kind:       "Deployment",
-							Status:     "ok",
-						},
-						"8682460a-29b5-11e9-b356-42010a8000e5": Node{
-							Name:       "nginx-deployment-56c74bb7cd",
-							APIVersion: "extensions/v1beta1",
-							Kind:       "ReplicaSet",
-							Status:     "ok",
-						},
-						"bf4800b5b6602c4c78ba3b654af02b3b": Node{
-							Name:       "nginx-deployment-56c74bb7cd pods",
-							APIVersion: "v1",
-							Kind:       "Pod",
-							Status:     "ok",
+						Nodes: Nodes{
+							"69e4ea11-2985-11e9-b356-42010a8000e5": Node{
+								Name:       "my-nginx",
+								APIVersion: "v1",
+								Kind:       "Service",
+								Status:     "ok",
+							},
+							"71c2b4eb-2949-11e9-b356-42010a8000e5": Node{
+								Name:       "nginx-deployment",
+								APIVersion: "apps/v1",
+								Kind:       "Deployment",
+								Status:     "ok",
+							},
+							"8682460a-29b5-11e9-b356-42010a8000e5": Node{
+								Name:       "nginx-deployment-56c74bb7cd",
+								APIVersion: "extensions/v1beta1",
+								Kind:       "ReplicaSet",
+								Status:     "ok",
+							},
+							"bf4800b5b6602c4c78ba3b654af02b3b": Node{
+								Name:       "nginx-deployment-56c74bb7cd pods",
+								APIVersion: "v1",
+								Kind:       "Pod",
+								Status:     "ok",
+							},
 						},
 					},
 				},
@@ -232,6 +401,20 @@ func Test_unmarshal(t *testing.T) {
 				base: newBase(typeSelectors, nil),
 			},
 		},
+		{
+			name:       "statGrid",
+			configFile: "config_stat_grid.json",
+			objectType: "statGrid",
+			expected: &StatGrid{
+				Config: StatGridConfig{
+					Stats: []Stat{
+						{Label: "Nodes", Value: "3"},
+						{Label: "Pods", Value: "12"},
+					},
+				},
+				base: newBase(typeStatGrid, nil),
+			},
+		},
 		{
 			name:       "summary",
 			configFile: "config_summary.json",
@@ -282,6 +465,27 @@ func Test_unmarshal(t *testing.T) {
 			expected: &Table{
 				Config: TableConfig{
 					Columns: NewTableCols("Name", "Description"),
+					DefaultSort: &TableSort{
+						Column:    "Name",
+						Direction: SortAscending,
+					},
+					Pagination: &TablePagination{
+						Offset: 0,
+						Limit:  2,
+						Total:  3,
+					},
+					BulkActions: []TableBulkAction{
+						{
+							Name:   "Delete",
+							Action: "octant/deleteObject",
+							Confirmation: &Confirmation{
+								Title: "Delete objects",
+								Body:  "Are you sure?",
+							},
+						},
+					},
+					Searchable: true,
+					Search:     "first",
 					Rows: []TableRow{
 						{
 							"Description": &Text{
@@ -316,6 +520,47 @@ func Test_unmarshal(t *testing.T) {
 				base: newBase(typeTable, nil),
 			},
 		},
+		{
+			name:       "statusText",
+			configFile: "config_status_text.json",
+			objectType: "statusText",
+			expected: &StatusText{
+				Config: StatusTextConfig{Text: "2/3", Status: NodeStatusWarning},
+				base:   newBase(typeStatusText, nil),
+			},
+		},
+		{
+			name:       "tabs",
+			configFile: "config_tabs.json",
+			objectType: "tabs",
+			expected: &Tabs{
+				Config: TabsConfig{
+					Tabs: []TabsItem{
+						{Name: "Conditions", Contents: NewText("ready")},
+						{Name: "YAML", Contents: &Markdown{base: newBase(typeMarkdown, nil), Config: MarkdownConfig{Value: "* item"}}},
+					},
+				},
+				base: newBase(typeTabs, nil),
+			},
+		},
+		{
+			name:       "terminal",
+			configFile: "config_terminal.json",
+			objectType: "terminal",
+			expected: &Terminal{
+				Config: TerminalConfig{
+					Session: TerminalSession{
+						Namespace: "default",
+						Pod:       "pod",
+						Container: "app",
+						Command:   []string{"/bin/sh"},
+						TTY:       true,
+					},
+					Token: "namespace/default/pod/pod/container/app?command=%2Fbin%2Fsh&tty=true",
+				},
+				base: newBase(typeTerminal, nil),
+			},
+		},
 		{
 			name:       "text",
 			configFile: "config_text.json",
@@ -325,6 +570,27 @@ func Test_unmarshal(t *testing.T) {
 				base:   newBase(typeText, nil),
 			},
 		},
+		{
+			name:       "timeseries",
+			configFile: "config_timeseries.json",
+			objectType: "timeseries",
+			expected: &Timeseries{
+				Config: TimeseriesConfig{
+					Series: []TimeseriesSeries{
+						{
+							Name: "cpu",
+							Points: []TimeseriesPoint{
+								{Timestamp: 1548198349, Value: 0.25},
+								{Timestamp: 1548198409, Value: 0.5},
+							},
+						},
+					},
+					Unit:      "cores",
+					Sparkline: true,
+				},
+				base: newBase(typeTimeseries, nil),
+			},
+		},
 		{
 			name:       "timestamp",
 			configFile: "config_timestamp.json",
@@ -353,3 +619,130 @@ func Test_unmarshal(t *testing.T) {
 		})
 	}
 }
+
+func TestUnmarshal(t *testing.T) {
+	now := time.Unix(1548198349, 0)
+
+	card := NewCard("card")
+	card.SetBody(NewText("body"))
+
+	cardList := NewCardList("cards")
+	cardList.AddCard(*card)
+
+	buttonGroup := NewButtonGroup()
+	buttonGroup.AddButton(NewButton("Delete", action.Payload{"action": "delete"},
+		WithButtonConfirmation("Delete", "Are you sure?")))
+
+	containers := NewContainers()
+	containers.Add("nginx", "nginx:1.15")
+
+	donutChart := NewDonutChart()
+	donutChart.AddSegment(2, NodeStatusOK)
+	donutChart.SetLabels("Pods", "Pod")
+
+	flexLayout := NewFlexLayout("layout")
+	flexLayout.AddSections(FlexLayoutSection{
+		{Width: WidthHalf, View: NewText("text")},
+		{
+			Width:  WidthHalf,
+			Widths: &FlexLayoutItemWidths{Small: WidthFull, ExtraLarge: WidthQuarter},
+			View:   NewLabels(map[string]string{"app": "nginx"}),
+		},
+	})
+	flexLayout.SetButtonGroup(buttonGroup)
+
+	graph := NewGraph("graph")
+	graph.AddNode("pod", Node{
+		Name:       "pod",
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Status:     NodeStatusOK,
+		Details:    []Component{NewText("Running")},
+		Path:       NewLink("", "pod", "/pod"),
+	})
+
+	gridActions := NewGridActions()
+	gridActions.AddAction(NewButton("Edit", action.Payload{"action": "edit"}))
+
+	podStatus := NewPodStatus()
+	podStatus.AddSummary("pod", []Component{NewText("Running")}, NodeStatusOK)
+
+	quadrant := NewQuadrant("quadrant")
+	require.NoError(t, quadrant.Set(QuadNW, "Running", "1"))
+
+	resourceViewer := NewResourceViewer("resources")
+	resourceViewer.AddNode("pod", Node{Name: "pod", Details: []Component{NewText("Running")}})
+
+	table := NewTable("table", "placeholder", NewTableCols("Name", "Age"))
+	table.Add(TableRow{"Name": NewLink("", "nginx", "/nginx"), "Age": NewTimestamp(now)})
+
+	timeseries := NewTimeseries("CPU", "cores")
+	timeseries.AddPoint("usage", now, 0.5)
+
+	port := NewPort("default", "v1", "Pod", "pod", 8080, "TCP", PortForwardState{IsForwardable: true})
+
+	tests := []struct {
+		name      string
+		component Component
+	}{
+		{name: "annotations", component: NewAnnotations(map[string]string{"key": "value"})},
+		{name: "banner", component: NewBanner(NewAlert(AlertTypeInfo, "message"))},
+		{name: "breadcrumb", component: NewBreadcrumb(NewLink("", "parent", "/parent"))},
+		{name: "buttonGroup", component: buttonGroup},
+		{name: "card", component: card},
+		{name: "cardList", component: cardList},
+		{name: "code", component: NewCode("code", "yaml", "key: value")},
+		{name: "containers", component: containers},
+		{name: "diff", component: NewDiff("diff", "before", "after")},
+		{name: "donutChart", component: donutChart},
+		{name: "error", component: NewError(TitleFromString("error"), errors.New("failed"))},
+		{name: "expressionSelector", component: NewExpressionSelector("key", OperatorIn, []string{"a"})},
+		{name: "flexlayout", component: flexLayout},
+		{name: "graph", component: graph},
+		{name: "graphviz", component: NewGraphviz("digraph {}")},
+		{name: "gridActions", component: gridActions},
+		{name: "labels", component: NewLabels(map[string]string{"app": "nginx"})},
+		{name: "labelSelector", component: NewLabelSelector("app", "nginx")},
+		{name: "link", component: NewExternalLink("", "docs", "https://example.com")},
+		{name: "list", component: NewList("list", []Component{NewText("item")})},
+		{name: "loading", component: NewLoading(TitleFromString("loading"), "Loading...")},
+		{name: "logs", component: NewLogs("default", "pod", []string{"nginx"})},
+		{name: "markdown", component: NewMarkdown("markdown", "**bold**")},
+		{name: "podStatus", component: podStatus},
+		{name: "port", component: port},
+		{name: "ports", component: NewPorts([]Port{*port})},
+		{name: "quadrant", component: quadrant},
+		{name: "resourceViewer", component: resourceViewer},
+		{name: "selectors", component: NewSelectors([]Selector{NewLabelSelector("app", "nginx")})},
+		{name: "statusText", component: NewStatusText("ok", NodeStatusOK)},
+		{name: "summary", component: NewSummary("summary", SummarySection{Header: "Name", Content: NewText("nginx")})},
+		{name: "table", component: table},
+		{name: "tabs", component: NewTabs("tabs", TabsItem{Name: "Summary", Contents: NewText("summary")})},
+		{name: "terminal", component: NewTerminal("terminal", TerminalSession{Namespace: "default", Pod: "pod", Container: "nginx", Command: []string{"/bin/sh"}})},
+		{name: "text", component: NewText("text")},
+		{name: "timeseries", component: timeseries},
+		{name: "timestamp", component: NewTimestamp(now)},
+		{name: "yaml", component: NewYAML(TitleFromString("yaml"), "key: value")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := json.Marshal(test.component)
+			require.NoError(t, err)
+
+			got, err := Unmarshal(data)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.component.GetMetadata().Type, got.GetMetadata().Type)
+			AssertEqual(t, test.component, got)
+		})
+	}
+}
+
+func TestUnmarshal_unknown_type(t *testing.T) {
+	_, err := Unmarshal([]byte(`{"metadata":{"type":"unknown"},"config":{}}`))
+	require.Error(t, err)
+
+	_, err = Unmarshal([]byte(`not json`))
+	require.Error(t, err)
+}
@@ -0,0 +1,197 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package diff compares and hashes view components using their canonical
+// JSON representation. Components are compared by what is sent to the
+// client, so two components are equal if they render the same.
+package diff
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ChangeKind is the kind of a change.
+type ChangeKind string
+
+const (
+	// ChangeAdded is a value which only exists in the second value.
+	ChangeAdded ChangeKind = "added"
+	// ChangeRemoved is a value which only exists in the first value.
+	ChangeRemoved ChangeKind = "removed"
+	// ChangeModified is a value which exists in both values, but differs.
+	ChangeModified ChangeKind = "modified"
+)
+
+// Change is a difference between two values.
+type Change struct {
+	// Path is a JSON pointer to the changed value.
+	Path     string
+	Kind     ChangeKind
+	Expected interface{}
+	Got      interface{}
+}
+
+// String returns a description of the change.
+func (c Change) String() string {
+	path := c.Path
+	if path == "" {
+		path = "/"
+	}
+
+	switch c.Kind {
+	case ChangeAdded:
+		return fmt.Sprintf("%s: unexpected %s", path, formatValue(c.Got))
+	case ChangeRemoved:
+		return fmt.Sprintf("%s: missing %s", path, formatValue(c.Expected))
+	default:
+		return fmt.Sprintf("%s: expected %s, got %s", path, formatValue(c.Expected), formatValue(c.Got))
+	}
+}
+
+// Canonical returns the canonical JSON representation of a value. Object
+// keys are sorted, so values which marshal to the same JSON have the same
+// canonical representation.
+func Canonical(v interface{}) ([]byte, error) {
+	tree, err := toTree(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(tree)
+}
+
+// Hash returns a hash of the canonical JSON representation of a value.
+func Hash(v interface{}) (string, error) {
+	data, err := Canonical(v)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Compare returns the changes between two values. If the values are equal,
+// no changes are returned.
+func Compare(expected, got interface{}) ([]Change, error) {
+	a, err := toTree(expected)
+	if err != nil {
+		return nil, errors.Wrap(err, "convert expected value")
+	}
+
+	b, err := toTree(got)
+	if err != nil {
+		return nil, errors.Wrap(err, "convert got value")
+	}
+
+	var changes []Change
+	compare("", a, b, &changes)
+	return changes, nil
+}
+
+// toTree converts a value to the generic tree produced by decoding its
+// JSON representation.
+func toTree(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal value")
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var tree interface{}
+	if err := decoder.Decode(&tree); err != nil {
+		return nil, errors.Wrap(err, "decode value")
+	}
+
+	return tree, nil
+}
+
+func compare(path string, a, b interface{}, changes *[]Change) {
+	switch av := a.(type) {
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok {
+			break
+		}
+
+		for _, key := range mergedKeys(av, bv) {
+			keyPath := path + "/" + escape(key)
+			ae, inA := av[key]
+			be, inB := bv[key]
+			switch {
+			case !inB:
+				*changes = append(*changes, Change{Path: keyPath, Kind: ChangeRemoved, Expected: ae})
+			case !inA:
+				*changes = append(*changes, Change{Path: keyPath, Kind: ChangeAdded, Got: be})
+			default:
+				compare(keyPath, ae, be, changes)
+			}
+		}
+		return
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok {
+			break
+		}
+
+		for i := 0; i < len(av) || i < len(bv); i++ {
+			indexPath := path + "/" + strconv.Itoa(i)
+			switch {
+			case i >= len(bv):
+				*changes = append(*changes, Change{Path: indexPath, Kind: ChangeRemoved, Expected: av[i]})
+			case i >= len(av):
+				*changes = append(*changes, Change{Path: indexPath, Kind: ChangeAdded, Got: bv[i]})
+			default:
+				compare(indexPath, av[i], bv[i], changes)
+			}
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(a, b) {
+		*changes = append(*changes, Change{Path: path, Kind: ChangeModified, Expected: a, Got: b})
+	}
+}
+
+func mergedKeys(a, b map[string]interface{}) []string {
+	var keys []string
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// escape escapes a key for use in a JSON pointer.
+func escape(key string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+}
+
+func formatValue(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,123 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package diff_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/octant/pkg/view/component"
+	"github.com/vmware/octant/pkg/view/diff"
+)
+
+func TestCompare(t *testing.T) {
+	expected := component.NewSummary("Summary", component.SummarySections{
+		{Header: "a", Content: component.NewText("a")},
+		{Header: "b", Content: component.NewText("b")},
+	}...)
+
+	tests := []struct {
+		name     string
+		got      component.Component
+		expected []string
+	}{
+		{
+			name: "equal",
+			got: component.NewSummary("Summary", component.SummarySections{
+				{Header: "a", Content: component.NewText("a")},
+				{Header: "b", Content: component.NewText("b")},
+			}...),
+		},
+		{
+			name: "modified",
+			got: component.NewSummary("Summary", component.SummarySections{
+				{Header: "a", Content: component.NewText("a")},
+				{Header: "b", Content: component.NewText("changed")},
+			}...),
+			expected: []string{
+				`/config/sections/1/content/config/value: expected "b", got "changed"`,
+			},
+		},
+		{
+			name: "added and removed",
+			got: component.NewSummary("Summary", component.SummarySections{
+				{Header: "a", Content: component.NewText("a")},
+			}...),
+			expected: []string{
+				`/config/sections/1: missing {"content":{"config":{"value":"b"},"metadata":{"type":"text"}},"header":"b"}`,
+			},
+		},
+		{
+			name: "different type",
+			got:  component.NewText("Summary"),
+			expected: []string{
+				`/config/sections: missing [{"content":{"config":{"value":"a"},"metadata":{"type":"text"}},"header":"a"},{"content":{"config":{"value":"b"},"metadata":{"type":"text"}},"header":"b"}]`,
+				`/config/value: unexpected "Summary"`,
+				`/metadata/title: missing [{"config":{"value":"Summary"},"metadata":{"type":"text"}}]`,
+				`/metadata/type: expected "summary", got "text"`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			changes, err := diff.Compare(expected, test.got)
+			require.NoError(t, err)
+
+			var got []string
+			for _, change := range changes {
+				got = append(got, change.String())
+			}
+
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func TestCompare_root(t *testing.T) {
+	changes, err := diff.Compare(1, "1")
+	require.NoError(t, err)
+
+	expected := []diff.Change{
+		{Path: "", Kind: diff.ChangeModified, Expected: json.Number("1"), Got: "1"},
+	}
+	assert.Equal(t, expected, changes)
+	assert.Equal(t, `/: expected 1, got "1"`, changes[0].String())
+}
+
+func TestCompare_escapes_keys(t *testing.T) {
+	changes, err := diff.Compare(
+		map[string]string{"octant.dev/notes": "a"},
+		map[string]string{"octant.dev/notes": "b"})
+	require.NoError(t, err)
+
+	require.Len(t, changes, 1)
+	assert.Equal(t, "/octant.dev~1notes", changes[0].Path)
+}
+
+func TestHash(t *testing.T) {
+	a, err := diff.Hash(map[string]interface{}{"a": 1, "b": []string{"c"}})
+	require.NoError(t, err)
+
+	b, err := diff.Hash(map[string]interface{}{"b": []string{"c"}, "a": 1})
+	require.NoError(t, err)
+
+	c, err := diff.Hash(map[string]interface{}{"a": 2, "b": []string{"c"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, a, b)
+	assert.NotEqual(t, a, c)
+}
+
+func TestCanonical(t *testing.T) {
+	got, err := diff.Canonical(component.NewText("text"))
+	require.NoError(t, err)
+
+	assert.Equal(t, `{"config":{"value":"text"},"metadata":{"type":"text"}}`, string(got))
+}
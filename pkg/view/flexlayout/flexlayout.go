@@ -6,6 +6,8 @@ SPDX-License-Identifier: Apache-2.0
 package flexlayout
 
 import (
+	"github.com/pkg/errors"
+
 	"github.com/vmware/octant/pkg/action"
 	"github.com/vmware/octant/pkg/view/component"
 )
@@ -30,6 +32,57 @@ func (fl *FlexLayout) AddSection() *Section {
 	return section
 }
 
+// AddNamedSection adds a section with an identifier and a title. Printers
+// and plugins can find the section by its identifier to add items to it.
+// If a section with the identifier exists, it is returned instead.
+func (fl *FlexLayout) AddNamedSection(id, title string) *Section {
+	if section, ok := fl.Section(id); ok {
+		return section
+	}
+
+	section := NewSection()
+	section.ID = id
+	section.Title = title
+	fl.sections = append(fl.sections, section)
+	return section
+}
+
+// Section returns the section with an identifier.
+func (fl *FlexLayout) Section(id string) (*Section, bool) {
+	if id == "" {
+		return nil, false
+	}
+
+	for _, section := range fl.sections {
+		if section.ID == id {
+			return section, true
+		}
+	}
+
+	return nil, false
+}
+
+// MoveSection moves the section with an identifier to an index. The other
+// sections keep their order.
+func (fl *FlexLayout) MoveSection(id string, index int) error {
+	if index < 0 || index >= len(fl.sections) {
+		return errors.Errorf("invalid index %d", index)
+	}
+
+	for i, section := range fl.sections {
+		if section.ID != id {
+			continue
+		}
+
+		sections := append(fl.sections[:i:i], fl.sections[i+1:]...)
+		sections = append(sections[:index:index], append([]*Section{section}, sections[index:]...)...)
+		fl.sections = sections
+		return nil
+	}
+
+	return errors.Errorf("section %q does not exist", id)
+}
+
 // AddButton adds a button the button group for a flex layout.
 func (fl *FlexLayout) AddButton(name string, payload action.Payload, buttonOptions ...component.ButtonOption) {
 	button := component.NewButton(name, payload, buttonOptions...)
@@ -38,29 +91,39 @@ func (fl *FlexLayout) AddButton(name string, payload action.Payload, buttonOptio
 
 // ToComponent converts the FlexLayout to a FlexLayout.
 func (fl *FlexLayout) ToComponent(title string) *component.FlexLayout {
-	var sections []component.FlexLayoutSection
+	if title == "" {
+		title = "Summary"
+	}
+
+	view := component.NewFlexLayout(title)
 
 	for _, section := range fl.sections {
+		members := section.visibleMembers()
+		if len(members) == 0 && len(section.Members) > 0 {
+			// every member was omitted, so the section is omitted as well.
+			continue
+		}
+
 		layoutSection := component.FlexLayoutSection{}
 
-		for _, member := range section.Members {
+		for _, member := range members {
 			item := component.FlexLayoutItem{
-				Width: member.Width,
-				View:  member.View,
+				Width:  member.Width,
+				Widths: member.Widths,
+				View:   member.View,
 			}
 
 			layoutSection = append(layoutSection, item)
 		}
 
-		sections = append(sections, layoutSection)
-	}
+		if section.ID == "" && section.Title == "" {
+			view.AddSections(layoutSection)
+			continue
+		}
 
-	if title == "" {
-		title = "Summary"
+		view.AddNamedSection(section.ID, section.Title, layoutSection)
 	}
 
-	view := component.NewFlexLayout(title)
-	view.AddSections(sections...)
 	view.SetButtonGroup(fl.buttonGroup)
 
 	return view
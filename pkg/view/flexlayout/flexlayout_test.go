@@ -8,6 +8,7 @@ package flexlayout_test
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/vmware/octant/pkg/action"
 	"github.com/vmware/octant/pkg/view/component"
@@ -74,3 +75,120 @@ func TestFlexLayout_add_button(t *testing.T) {
 
 	component.AssertEqual(t, expected, got)
 }
+
+func TestFlexLayout_named_sections(t *testing.T) {
+	fl := flexlayout.New()
+
+	t1 := component.NewText("item 1")
+	t2 := component.NewText("item 2")
+	t3 := component.NewText("item 3")
+	t4 := component.NewText("item 4")
+
+	require.NoError(t, fl.AddNamedSection("status", "Status").Add(t1, component.WidthFull))
+	require.NoError(t, fl.AddSection().Add(t2, component.WidthFull))
+
+	status, ok := fl.Section("status")
+	require.True(t, ok)
+	require.NoError(t, status.Insert(0, t3, component.WidthHalf))
+
+	assert.Equal(t, status, fl.AddNamedSection("status", "Other"))
+
+	_, ok = fl.Section("missing")
+	assert.False(t, ok)
+
+	require.NoError(t, fl.AddNamedSection("plugins", "").Add(t4, component.WidthFull))
+	require.NoError(t, fl.MoveSection("plugins", 0))
+
+	got := fl.ToComponent("Title")
+
+	expected := component.NewFlexLayout("Title")
+	expected.AddNamedSection("plugins", "", component.FlexLayoutSection{
+		{Width: component.WidthFull, View: t4},
+	})
+	expected.AddNamedSection("status", "Status", component.FlexLayoutSection{
+		{Width: component.WidthHalf, View: t3},
+		{Width: component.WidthFull, View: t1},
+	})
+	expected.AddSections(component.FlexLayoutSection{
+		{Width: component.WidthFull, View: t2},
+	})
+
+	component.AssertEqual(t, expected, got)
+}
+
+func TestFlexLayout_MoveSection_invalid(t *testing.T) {
+	fl := flexlayout.New()
+	fl.AddNamedSection("status", "Status")
+
+	assert.Error(t, fl.MoveSection("missing", 0))
+	assert.Error(t, fl.MoveSection("status", 1))
+	assert.Error(t, fl.MoveSection("status", -1))
+}
+
+func TestSection_Insert(t *testing.T) {
+	section := flexlayout.NewSection()
+
+	t1 := component.NewText("item 1")
+	t2 := component.NewText("item 2")
+	t3 := component.NewText("item 3")
+
+	require.NoError(t, section.Insert(5, t1, component.WidthFull))
+	require.NoError(t, section.Insert(0, t2, component.WidthFull))
+	require.NoError(t, section.Insert(1, t3, component.WidthFull))
+	assert.Error(t, section.Insert(-1, t3, component.WidthFull))
+	assert.Error(t, section.Insert(0, t3, 25))
+
+	expected := []flexlayout.SectionMember{
+		{View: t2, Width: component.WidthFull},
+		{View: t3, Width: component.WidthFull},
+		{View: t1, Width: component.WidthFull},
+	}
+	assert.Equal(t, expected, section.Members)
+}
+
+func TestFlexLayout_omit_if_empty(t *testing.T) {
+	fl := flexlayout.New()
+
+	text := component.NewText("text")
+	emptyTable := component.NewTable("empty", "placeholder", component.NewTableCols("Name"))
+
+	section1 := fl.AddSection()
+	require.NoError(t, section1.Add(text, component.WidthHalf, flexlayout.WithOmitIfEmpty()))
+	require.NoError(t, section1.Add(emptyTable, component.WidthHalf, flexlayout.WithOmitIfEmpty()))
+
+	section2 := fl.AddSection()
+	require.NoError(t, section2.Add(emptyTable, component.WidthFull, flexlayout.WithOmitIfEmpty()))
+
+	got := fl.ToComponent("Title")
+
+	expected := component.NewFlexLayout("Title")
+	expected.AddSections(component.FlexLayoutSection{
+		{Width: component.WidthHalf, View: text},
+	})
+
+	component.AssertEqual(t, expected, got)
+}
+
+func TestFlexLayout_widths(t *testing.T) {
+	fl := flexlayout.New()
+
+	text := component.NewText("text")
+	widths := component.FlexLayoutItemWidths{
+		Small:      component.WidthFull,
+		ExtraLarge: component.WidthQuarter,
+	}
+
+	section := fl.AddSection()
+	require.NoError(t, section.Add(text, component.WidthHalf, flexlayout.WithWidths(widths)))
+	assert.Error(t, section.Add(text, component.WidthHalf,
+		flexlayout.WithWidths(component.FlexLayoutItemWidths{Large: 25})))
+
+	got := fl.ToComponent("Title")
+
+	expected := component.NewFlexLayout("Title")
+	expected.AddSections(component.FlexLayoutSection{
+		{Width: component.WidthHalf, Widths: &widths, View: text},
+	})
+
+	component.AssertEqual(t, expected, got)
+}
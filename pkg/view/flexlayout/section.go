@@ -18,9 +18,37 @@ const (
 type SectionMember struct {
 	View  component.Component
 	Width int
+	// Widths are the member's widths on screens of different sizes.
+	Widths *component.FlexLayoutItemWidths
+	// OmitIfEmpty omits the member from the layout if its view is empty,
+	// e.g. a table without rows.
+	OmitIfEmpty bool
+}
+
+// MemberOption is an option for configuring a SectionMember.
+type MemberOption func(member *SectionMember)
+
+// WithOmitIfEmpty omits a member from the layout if its view is empty.
+func WithOmitIfEmpty() MemberOption {
+	return func(member *SectionMember) {
+		member.OmitIfEmpty = true
+	}
+}
+
+// WithWidths sets a member's widths on screens of different sizes, e.g.
+// full width on small screens and a quarter on extra large screens.
+func WithWidths(widths component.FlexLayoutItemWidths) MemberOption {
+	return func(member *SectionMember) {
+		member.Widths = &widths
+	}
 }
 
 type Section struct {
+	// ID identifies a named section. It is the section's anchor on the page.
+	ID string
+	// Title is shown above a named section.
+	Title string
+
 	Members []SectionMember
 }
 
@@ -28,12 +56,69 @@ func NewSection() *Section {
 	return &Section{}
 }
 
-func (s *Section) Add(view component.Component, width int) error {
-	if width > maxWidth {
-		return errors.Errorf("component width %d; max width %d", width, maxWidth)
+func (s *Section) Add(view component.Component, width int, options ...MemberOption) error {
+	member, err := newSectionMember(view, width, options...)
+	if err != nil {
+		return err
 	}
-	member := SectionMember{View: view, Width: width}
 	s.Members = append(s.Members, member)
 
 	return nil
 }
+
+// Insert inserts a view at an index in the section. An index past the end
+// of the section appends the view.
+func (s *Section) Insert(index int, view component.Component, width int, options ...MemberOption) error {
+	member, err := newSectionMember(view, width, options...)
+	if err != nil {
+		return err
+	}
+
+	if index < 0 {
+		return errors.Errorf("invalid index %d", index)
+	}
+
+	if index > len(s.Members) {
+		index = len(s.Members)
+	}
+
+	s.Members = append(s.Members, SectionMember{})
+	copy(s.Members[index+1:], s.Members[index:])
+	s.Members[index] = member
+
+	return nil
+}
+
+// visibleMembers returns the members which are not omitted from the layout.
+func (s *Section) visibleMembers() []SectionMember {
+	var members []SectionMember
+	for _, member := range s.Members {
+		if member.OmitIfEmpty && (member.View == nil || member.View.IsEmpty()) {
+			continue
+		}
+		members = append(members, member)
+	}
+
+	return members
+}
+
+func newSectionMember(view component.Component, width int, options ...MemberOption) (SectionMember, error) {
+	member := SectionMember{View: view, Width: width}
+	for _, option := range options {
+		option(&member)
+	}
+
+	widths := []int{member.Width}
+	if member.Widths != nil {
+		widths = append(widths, member.Widths.Small, member.Widths.Medium,
+			member.Widths.Large, member.Widths.ExtraLarge)
+	}
+
+	for _, w := range widths {
+		if w > maxWidth {
+			return SectionMember{}, errors.Errorf("component width %d; max width %d", w, maxWidth)
+		}
+	}
+
+	return member, nil
+}
@@ -14,6 +14,7 @@ import { InputFilterComponent } from './components/input-filter/input-filter.com
 import { NotifierComponent } from './components/notifier/notifier.component';
 import { NavigationComponent } from './components/navigation/navigation.component';
 import { ContextSelectorComponent } from './modules/overview/components/context-selector/context-selector.component';
+import { EventFilterSelectorComponent } from './modules/overview/components/event-filter-selector/event-filter-selector.component';
 import { DefaultPipe } from './modules/overview/pipes/default.pipe';
 import { NgSelectModule } from '@ng-select/ng-select';
 import {
@@ -45,6 +46,7 @@ describe('AppComponent', () => {
         NotifierComponent,
         NavigationComponent,
         ContextSelectorComponent,
+        EventFilterSelectorComponent,
         DefaultPipe,
       ],
     }).compileComponents();
@@ -5,6 +5,7 @@
 import { async, ComponentFixture, TestBed } from '@angular/core/testing';
 import { NamespaceComponent } from './namespace.component';
 import { NgSelectModule } from '@ng-select/ng-select';
+import { ClarityModule } from '@clr/angular';
 
 describe('NamespaceComponent', () => {
   let component: NamespaceComponent;
@@ -12,7 +13,7 @@ describe('NamespaceComponent', () => {
 
   beforeEach(async(() => {
     TestBed.configureTestingModule({
-      imports: [NgSelectModule],
+      imports: [NgSelectModule, ClarityModule],
       declarations: [NamespaceComponent],
     }).compileComponents();
   }));
@@ -26,4 +27,15 @@ describe('NamespaceComponent', () => {
   it('should create', () => {
     expect(component).toBeTruthy();
   });
+
+  it('flags namespaces with problems', () => {
+    component.rollups = {
+      healthy: { workloads: 3, podsNotReady: 0, warningEvents: 0 },
+      unhealthy: { workloads: 1, podsNotReady: 2, warningEvents: 0 },
+    };
+
+    expect(component.hasProblems('healthy')).toBeFalsy();
+    expect(component.hasProblems('unhealthy')).toBeTruthy();
+    expect(component.hasProblems('missing')).toBeFalsy();
+  });
 });
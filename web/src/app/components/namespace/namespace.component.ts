@@ -5,6 +5,7 @@
 import { Component, OnInit } from '@angular/core';
 import { NamespaceService } from 'src/app/services/namespace/namespace.service';
 import trackByIdentity from 'src/app/util/trackBy/trackByIdentity';
+import { NamespaceRollup, NamespaceRollups } from 'src/app/models/namespace';
 
 @Component({
   selector: 'app-namespace',
@@ -13,6 +14,7 @@ import trackByIdentity from 'src/app/util/trackBy/trackByIdentity';
 })
 export class NamespaceComponent implements OnInit {
   namespaces: string[];
+  rollups: NamespaceRollups = {};
   currentNamespace = '';
   trackByIdentity = trackByIdentity;
 
@@ -28,6 +30,21 @@ export class NamespaceComponent implements OnInit {
         this.namespaces = namespaces;
       }
     );
+
+    this.namespaceService.namespaceRollups.subscribe(
+      (rollups: NamespaceRollups) => {
+        this.rollups = rollups;
+      }
+    );
+  }
+
+  rollup(namespace: string): NamespaceRollup {
+    return this.rollups[namespace];
+  }
+
+  hasProblems(namespace: string): boolean {
+    const rollup = this.rollup(namespace);
+    return !!rollup && (rollup.podsNotReady > 0 || rollup.warningEvents > 0);
   }
 
   selectNamespace(namespace: string) {
@@ -3,6 +3,7 @@
 //
 
 import { async, ComponentFixture, TestBed } from '@angular/core/testing';
+import { RouterTestingModule } from '@angular/router/testing';
 
 import { NotifierComponent } from './notifier.component';
 
@@ -12,6 +13,7 @@ describe('NotifierComponent', () => {
 
   beforeEach(async(() => {
     TestBed.configureTestingModule({
+      imports: [RouterTestingModule],
       declarations: [NotifierComponent],
     }).compileComponents();
   }));
@@ -20,7 +20,9 @@ export class NotifierComponent implements OnInit, OnDestroy {
   loading = false;
   error: string;
   warning: string;
+  warningLink: string;
   info: string;
+  infoLink: string;
 
   constructor(private notifierService: NotifierService) {}
 
@@ -38,6 +40,7 @@ export class NotifierComponent implements OnInit, OnDestroy {
         this.warning = lastWarningSignal
           ? (lastWarningSignal.data as string)
           : '';
+        this.warningLink = lastWarningSignal ? lastWarningSignal.link : '';
 
         const lastErrorSignal = _.findLast(currentSignals, {
           type: NotifierSignalType.ERROR,
@@ -48,12 +51,14 @@ export class NotifierComponent implements OnInit, OnDestroy {
           type: NotifierSignalType.INFO,
         });
         this.info = lastInfoSignal ? (lastInfoSignal.data as string) : '';
+        this.infoLink = lastInfoSignal ? lastInfoSignal.link : '';
       }
     );
   }
 
   onWarningClose() {
     this.warning = '';
+    this.warningLink = '';
     // TODO: remove warning from signals queue?
   }
 
@@ -11,6 +11,13 @@ export interface Content {
   title: View[];
   iconName?: string;
   iconSource?: string;
+  breadcrumb?: BreadcrumbView;
+  siblings?: Siblings;
+}
+
+export interface Siblings {
+  previous?: LinkView;
+  next?: LinkView;
 }
 
 export interface Metadata {
@@ -29,9 +36,26 @@ export interface AnnotationsView extends View {
   };
 }
 
+export interface BreadcrumbView extends View {
+  config: {
+    links: LinkView[];
+  };
+}
+
+export interface AlertLink {
+  text: string;
+  ref: string;
+}
+
 export interface Alert {
   type: string;
   message: string;
+  dismissible?: boolean;
+  link?: AlertLink;
+}
+
+export interface BannerView extends View {
+  config: Alert;
 }
 
 export interface CardView extends View {
@@ -51,6 +75,11 @@ export interface CardListView extends View {
 export interface ContainerDef {
   name: string;
   image: string;
+  registry?: string;
+  repository?: string;
+  tag?: string;
+  digest?: string;
+  warnings?: string[];
 }
 
 export interface ContainersView extends View {
@@ -65,20 +94,31 @@ export interface GraphvizView {
   };
 }
 
+export interface FlexLayoutItemWidths {
+  sm?: number;
+  md?: number;
+  lg?: number;
+  xl?: number;
+}
+
 export interface FlexLayoutItem {
   width: number;
+  widths?: FlexLayoutItemWidths;
   view: View;
 }
 
 export interface Confirmation {
   title: string;
   body: string;
+  requiredText?: string;
 }
 
 export interface Button {
   payload: {};
   name: string;
   confirmation?: Confirmation;
+  disabledReason?: string;
+  danger?: string;
 }
 
 export interface ButtonGroupView extends View {
@@ -87,9 +127,21 @@ export interface ButtonGroupView extends View {
   };
 }
 
+export interface GridActionsView extends View {
+  config: {
+    actions: Button[];
+  };
+}
+
+export interface FlexLayoutSectionInfo {
+  id?: string;
+  title?: string;
+}
+
 export interface FlexLayoutView extends View {
   config: {
     sections: FlexLayoutItem[][];
+    sectionInfo?: FlexLayoutSectionInfo[];
     buttonGroup: ButtonGroupView;
   };
 }
@@ -97,6 +149,7 @@ export interface FlexLayoutView extends View {
 export interface LabelsView extends View {
   config: {
     labels: { [key: string]: string };
+    links?: { [key: string]: string };
   };
 }
 
@@ -104,6 +157,26 @@ export interface LinkView extends View {
   config: {
     ref: string;
     value: string;
+    download?: boolean;
+    external?: boolean;
+    newWindow?: boolean;
+  };
+}
+
+export interface MarkdownView extends View {
+  config: {
+    value: string;
+  };
+}
+
+export interface TabsItem {
+  name: string;
+  contents: View;
+}
+
+export interface TabsView extends View {
+  config: {
+    tabs: TabsItem[];
   };
 }
 
@@ -191,7 +264,14 @@ export interface Node {
   path: LinkView;
 }
 
-export interface ResourceViewerView extends View {
+export interface GraphView extends View {
+  config: {
+    edges: { [key: string]: Edge[] };
+    nodes: Node[];
+  };
+}
+
+export interface ResourceViewerView extends GraphView {
   config: {
     edges: { [key: string]: Edge[] };
     nodes: Node[];
@@ -210,12 +290,21 @@ export interface SummaryItem {
   content: View;
 }
 
+export interface FieldValidation {
+  required?: boolean;
+  min?: number;
+  max?: number;
+  pattern?: string;
+  enum?: string[];
+}
+
 export interface ActionField {
   configuration: any;
   label: string;
   name: string;
   type: string;
   value: any;
+  validation?: FieldValidation;
 }
 
 export interface ActionForm {
@@ -226,6 +315,10 @@ export interface Action {
   name: string;
   title: string;
   form: ActionForm;
+  confirmChanges?: boolean;
+  disabledReason?: string;
+  danger?: string;
+  confirmationText?: string;
 }
 
 export interface SummaryView extends View {
@@ -243,9 +336,40 @@ export interface TableView extends View {
     emptyContent: string;
     loading: boolean;
     filters: TableFilters;
+    defaultSort?: TableSort;
+    pagination?: TablePagination;
+    bulkActions?: TableBulkAction[];
+    searchable?: boolean;
+    search?: string;
   };
 }
 
+// TableBulkAction runs on every selected row. Each row runs its own row
+// action whose payload action matches.
+export interface TableBulkAction {
+  name: string;
+  action: string;
+  confirmation?: Confirmation;
+  disabledReason?: string;
+}
+
+// TablePagination describes the page of rows a table contains. Total is
+// the number of rows before the table was paginated. Continue is the
+// continue token of the next page if the server paged the rows by token.
+export interface TablePagination {
+  offset: number;
+  limit: number;
+  total: number;
+  continue?: string;
+}
+
+export type SortDirection = 'asc' | 'desc';
+
+export interface TableSort {
+  column: string;
+  direction: SortDirection;
+}
+
 export interface TableFilters {
   [key: string]: TableFilter;
 }
@@ -262,18 +386,121 @@ export interface TableRow {
 export interface TableColumn {
   name: string;
   accessor: string;
+  sortable?: boolean;
 }
 
 export interface TextView extends View {
   config: {
     value: string;
     isMarkdown?: boolean;
+    sortValue?: number;
+  };
+}
+
+export interface DonutSegment {
+  count: number;
+  status: string;
+}
+
+export interface DonutChartView extends View {
+  config: {
+    segments: DonutSegment[];
+    labels: {
+      plural: string;
+      singular: string;
+    };
+    size: number;
+  };
+}
+
+export interface ProgressView extends View {
+  config: {
+    value: number;
+    label: string;
+    status: string;
+  };
+}
+
+export interface Stat {
+  label: string;
+  value: string;
+}
+
+export interface StatGridView extends View {
+  config: {
+    stats: Stat[];
+  };
+}
+
+export interface TimeseriesPoint {
+  timestamp: number;
+  value: number;
+}
+
+export interface TimeseriesSeries {
+  name: string;
+  points: TimeseriesPoint[];
+}
+
+export interface TimeseriesView extends View {
+  config: {
+    series: TimeseriesSeries[];
+    unit?: string;
+    sparkline?: boolean;
+  };
+}
+
+export interface CodeView extends View {
+  config: {
+    value: string;
+    language?: string;
+    lineNumbers?: boolean;
+    edit?: {
+      payload: { [key: string]: any };
+      field: string;
+      id?: string;
+    };
+  };
+}
+
+export interface DiffView extends View {
+  config: {
+    before: string;
+    after: string;
+    beforeLabel?: string;
+    afterLabel?: string;
+    mode: 'unified' | 'split';
+    language?: string;
+  };
+}
+
+export interface TerminalSession {
+  namespace: string;
+  pod: string;
+  container: string;
+  command: string[];
+  tty?: boolean;
+}
+
+export interface TerminalView extends View {
+  config: {
+    session: TerminalSession;
+    token: string;
+  };
+}
+
+export interface StatusTextView extends View {
+  config: {
+    value: string;
+    status: string;
   };
 }
 
 export interface TimestampView extends View {
   config: {
     timestamp: number;
+    relative?: string;
+    display?: 'relative' | 'absolute';
   };
 }
 
@@ -288,12 +515,18 @@ export interface LogsView extends View {
     namespace: string;
     name: string;
     containers: string[];
+    container?: string;
+    follow?: boolean;
+    sinceSeconds?: number;
+    token?: string;
   };
 }
 
 export interface LogEntry {
   timestamp: string; // TODO: should be Date
   message: string;
+  level?: string;
+  fields?: { [key: string]: string };
 }
 
 export interface LogResponse {
@@ -9,3 +9,13 @@ export interface Namespaces {
 export interface Namespace {
   namespace: string;
 }
+
+export interface NamespaceRollup {
+  workloads: number;
+  podsNotReady: number;
+  warningEvents: number;
+}
+
+export interface NamespaceRollups {
+  [namespace: string]: NamespaceRollup;
+}
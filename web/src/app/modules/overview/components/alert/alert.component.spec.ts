@@ -3,6 +3,7 @@ import { async, ComponentFixture, TestBed } from '@angular/core/testing';
 import { AlertComponent } from './alert.component';
 import { By } from '@angular/platform-browser';
 import { DebugElement } from '@angular/core';
+import { RouterTestingModule } from '@angular/router/testing';
 
 describe('AlertComponent', () => {
   let component: AlertComponent;
@@ -10,6 +11,7 @@ describe('AlertComponent', () => {
 
   beforeEach(async(() => {
     TestBed.configureTestingModule({
+      imports: [RouterTestingModule],
       declarations: [AlertComponent],
     }).compileComponents();
   }));
@@ -44,4 +46,51 @@ describe('AlertComponent', () => {
     const el: DebugElement = fixture.debugElement.query(By.css('.alert-text'));
     expect(el.nativeElement.textContent.trim()).toBe('message');
   });
+
+  it('does not show a close button by default', () => {
+    const el: DebugElement = fixture.debugElement.query(By.css('.close'));
+    expect(el).toBeNull();
+  });
+});
+
+describe('AlertComponent dismissible with link', () => {
+  let component: AlertComponent;
+  let fixture: ComponentFixture<AlertComponent>;
+
+  beforeEach(async(() => {
+    TestBed.configureTestingModule({
+      imports: [RouterTestingModule],
+      declarations: [AlertComponent],
+    }).compileComponents();
+  }));
+
+  beforeEach(() => {
+    fixture = TestBed.createComponent(AlertComponent);
+    component = fixture.componentInstance;
+
+    component.alert = {
+      message: 'message',
+      type: 'error',
+      dismissible: true,
+      link: { text: 'View', ref: '/deployment' },
+    };
+
+    fixture.detectChanges();
+  });
+
+  it('shows the link', () => {
+    const el: DebugElement = fixture.debugElement.query(
+      By.css('.alert-action')
+    );
+    expect(el.nativeElement.textContent.trim()).toBe('View');
+  });
+
+  it('hides the alert when it is dismissed', () => {
+    const button: DebugElement = fixture.debugElement.query(By.css('.close'));
+    button.triggerEventHandler('click', null);
+    fixture.detectChanges();
+
+    const el: DebugElement = fixture.debugElement.query(By.css('.alert'));
+    expect(el).toBeNull();
+  });
 });
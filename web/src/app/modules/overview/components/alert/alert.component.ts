@@ -6,7 +6,7 @@ import {
   OnInit,
   SimpleChanges,
 } from '@angular/core';
-import { Alert } from '../../../../models/content';
+import { Alert, AlertLink } from '../../../../models/content';
 
 const alertLookup = {
   error: 'danger',
@@ -34,6 +34,9 @@ export class AlertComponent implements OnInit {
   alertClass = '';
   message = '';
   alertType = '';
+  dismissible = false;
+  dismissed = false;
+  link: AlertLink;
 
   constructor() {}
 
@@ -44,6 +47,12 @@ export class AlertComponent implements OnInit {
       this.alertClass = `alert-${alertClass}`;
       this.message = this.alert.message;
       this.alertType = this.alert.type;
+      this.dismissible = !!this.alert.dismissible;
+      this.link = this.alert.link;
     }
   }
+
+  dismiss() {
+    this.dismissed = true;
+  }
 }
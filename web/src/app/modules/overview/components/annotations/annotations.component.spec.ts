@@ -2,9 +2,14 @@
 // SPDX-License-Identifier: Apache-2.0
 //
 
+import { SimpleChange } from '@angular/core';
 import { async, ComponentFixture, TestBed } from '@angular/core/testing';
 
-import { AnnotationsComponent } from './annotations.component';
+import {
+  AnnotationsComponent,
+  prettyJSON,
+  truncateLength,
+} from './annotations.component';
 
 describe('AnnotationsComponent', () => {
   let component: AnnotationsComponent;
@@ -25,4 +30,34 @@ describe('AnnotationsComponent', () => {
   it('should create', () => {
     expect(component).toBeTruthy();
   });
+
+  it('should truncate long values until they are expanded', () => {
+    const long = 'x'.repeat(truncateLength + 1);
+    component.view = {
+      config: {
+        annotations: { short: 'value', long },
+      },
+      metadata: { type: 'annotations' },
+    };
+
+    component.ngOnChanges({
+      view: new SimpleChange(null, component.view, false),
+    });
+    fixture.detectChanges();
+
+    const [longEntry, shortEntry] = component.entries;
+    expect(longEntry.key).toBe('long');
+    expect(component.isExpanded(shortEntry)).toBe(true);
+    expect(component.isExpanded(longEntry)).toBe(false);
+
+    component.toggle(longEntry);
+    expect(component.isExpanded(longEntry)).toBe(true);
+  });
+
+  it('should pretty print JSON values', () => {
+    expect(prettyJSON('{"a":1}')).toBe('{\n  "a": 1\n}');
+    expect(prettyJSON('[1]')).toBe('[\n  1\n]');
+    expect(prettyJSON('{not json')).toBeUndefined();
+    expect(prettyJSON('value')).toBeUndefined();
+  });
 });
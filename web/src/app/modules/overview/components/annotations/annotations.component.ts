@@ -4,7 +4,17 @@
 
 import { Component, Input, OnChanges, SimpleChanges } from '@angular/core';
 import { AnnotationsView } from 'src/app/models/content';
-import trackByIdentity from 'src/app/util/trackBy/trackByIdentity';
+import { ViewService } from '../../services/view/view.service';
+
+// Values longer than this are truncated until they are expanded.
+export const truncateLength = 120;
+
+interface AnnotationEntry {
+  key: string;
+  value: string;
+  pretty?: string;
+  isLong: boolean;
+}
 
 @Component({
   selector: 'app-view-annotations',
@@ -13,17 +23,69 @@ import trackByIdentity from 'src/app/util/trackBy/trackByIdentity';
 })
 export class AnnotationsComponent implements OnChanges {
   @Input() view: AnnotationsView;
-  annotations: { [key: string]: string };
-  annotationKeys: string[];
-  trackByIdentity = trackByIdentity;
+  title: string;
+  entries: AnnotationEntry[] = [];
+  collapsed = false;
+  expanded = new Set<string>();
 
-  constructor() {}
+  constructor(private viewService: ViewService) {}
 
   ngOnChanges(changes: SimpleChanges): void {
     if (changes.view.currentValue) {
       const view = changes.view.currentValue as AnnotationsView;
-      this.annotations = view.config.annotations;
-      this.annotationKeys = Object.keys(this.annotations);
+      this.title = this.viewService.viewTitleAsText(view);
+
+      const annotations = view.config.annotations || {};
+      this.entries = Object.keys(annotations)
+        .sort()
+        .map(key => {
+          const value = annotations[key];
+          return {
+            key,
+            value,
+            pretty: prettyJSON(value),
+            isLong: value.length > truncateLength,
+          };
+        });
+    }
+  }
+
+  toggleCollapsed() {
+    this.collapsed = !this.collapsed;
+  }
+
+  isExpanded(entry: AnnotationEntry): boolean {
+    return !entry.isLong || this.expanded.has(entry.key);
+  }
+
+  toggle(entry: AnnotationEntry) {
+    if (this.expanded.has(entry.key)) {
+      this.expanded.delete(entry.key);
+    } else {
+      this.expanded.add(entry.key);
     }
   }
+
+  truncate(value: string): string {
+    return `${value.substring(0, truncateLength)}…`;
+  }
+
+  trackByKey(index: number, entry: AnnotationEntry): string {
+    return entry.key;
+  }
+}
+
+// prettyJSON returns an indented version of a value if it is a JSON object
+// or array. Otherwise, it returns undefined.
+export function prettyJSON(value: string): string | undefined {
+  const trimmed = value.trim();
+  if (!trimmed.startsWith('{') && !trimmed.startsWith('[')) {
+    return undefined;
+  }
+
+  try {
+    return JSON.stringify(JSON.parse(trimmed), null, 2);
+  } catch (e) {
+    return undefined;
+  }
 }
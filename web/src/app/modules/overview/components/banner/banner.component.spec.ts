@@ -0,0 +1,50 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { async, ComponentFixture, TestBed } from '@angular/core/testing';
+import { SimpleChange } from '@angular/core';
+import { By } from '@angular/platform-browser';
+import { RouterTestingModule } from '@angular/router/testing';
+
+import { BannerComponent } from './banner.component';
+import { AlertComponent } from '../alert/alert.component';
+import { BannerView } from 'src/app/models/content';
+
+describe('BannerComponent', () => {
+  let component: BannerComponent;
+  let fixture: ComponentFixture<BannerComponent>;
+
+  beforeEach(async(() => {
+    TestBed.configureTestingModule({
+      imports: [RouterTestingModule],
+      declarations: [BannerComponent, AlertComponent],
+    }).compileComponents();
+  }));
+
+  beforeEach(() => {
+    fixture = TestBed.createComponent(BannerComponent);
+    component = fixture.componentInstance;
+  });
+
+  it('shows the alert', () => {
+    const view: BannerView = {
+      metadata: { type: 'banner' },
+      config: {
+        type: 'warning',
+        message: 'Deployment has exceeded its progress deadline',
+      },
+    };
+
+    component.view = view;
+    component.ngOnChanges({
+      view: new SimpleChange(null, view, true),
+    });
+    fixture.detectChanges();
+
+    const el = fixture.debugElement.query(By.css('.alert-text'));
+    expect(el.nativeElement.textContent.trim()).toBe(
+      'Deployment has exceeded its progress deadline'
+    );
+  });
+});
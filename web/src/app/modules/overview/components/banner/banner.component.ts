@@ -0,0 +1,25 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+import { Component, Input, OnChanges, SimpleChanges } from '@angular/core';
+import { Alert, BannerView } from 'src/app/models/content';
+
+@Component({
+  selector: 'app-view-banner',
+  templateUrl: './banner.component.html',
+  styleUrls: ['./banner.component.scss'],
+})
+export class BannerComponent implements OnChanges {
+  @Input() view: BannerView;
+
+  alert: Alert;
+
+  constructor() {}
+
+  ngOnChanges(changes: SimpleChanges): void {
+    if (changes.view.currentValue) {
+      const view = changes.view.currentValue as BannerView;
+      this.alert = view.config;
+    }
+  }
+}
@@ -0,0 +1,28 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { Component, Input, OnChanges, SimpleChanges } from '@angular/core';
+import { BreadcrumbView, LinkView } from 'src/app/models/content';
+import trackByIdentity from 'src/app/util/trackBy/trackByIdentity';
+
+@Component({
+  selector: 'app-view-breadcrumb',
+  templateUrl: './breadcrumb.component.html',
+  styleUrls: ['./breadcrumb.component.scss'],
+})
+export class BreadcrumbComponent implements OnChanges {
+  @Input() view: BreadcrumbView;
+
+  links: LinkView[];
+  trackByIdentity = trackByIdentity;
+
+  constructor() {}
+
+  ngOnChanges(changes: SimpleChanges): void {
+    if (changes.view.currentValue) {
+      const view = changes.view.currentValue as BreadcrumbView;
+      this.links = view.config.links || [];
+    }
+  }
+}
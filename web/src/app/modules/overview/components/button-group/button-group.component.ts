@@ -13,6 +13,8 @@ export class ButtonGroupComponent implements OnInit {
   isModalOpen = false;
   modalTitle = '';
   modalBody = '';
+  modalRequiredText = '';
+  modalTypedText = '';
   payload = {};
 
   constructor(private actionService: ActionService) {}
@@ -31,7 +33,15 @@ export class ButtonGroupComponent implements OnInit {
     this.resetModal();
   }
 
+  canAcceptModal(): boolean {
+    return !this.modalRequiredText || this.modalTypedText === this.modalRequiredText;
+  }
+
   acceptModal() {
+    if (!this.canAcceptModal()) {
+      return;
+    }
+
     const payload = this.payload;
     this.resetModal();
     this.doAction(payload);
@@ -48,6 +58,8 @@ export class ButtonGroupComponent implements OnInit {
   private activateModal(payload: {}, confirmation: Confirmation) {
     this.modalTitle = confirmation.title;
     this.modalBody = confirmation.body;
+    this.modalRequiredText = confirmation.requiredText || '';
+    this.modalTypedText = '';
     this.isModalOpen = true;
 
     this.payload = payload;
@@ -57,6 +69,8 @@ export class ButtonGroupComponent implements OnInit {
     this.isModalOpen = false;
     this.modalBody = '';
     this.modalTitle = '';
+    this.modalRequiredText = '';
+    this.modalTypedText = '';
     this.payload = {};
   }
 }
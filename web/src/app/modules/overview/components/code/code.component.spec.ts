@@ -0,0 +1,130 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { async, ComponentFixture, TestBed } from '@angular/core/testing';
+import { SimpleChange } from '@angular/core';
+import { Subject } from 'rxjs';
+
+import { CodeComponent } from './code.component';
+import { CodeView } from 'src/app/models/content';
+import { OverviewModule } from '../../overview.module';
+import { ActionService } from '../../services/action/action.service';
+import {
+  EditResult,
+  EditResultsService,
+} from 'src/app/services/edit-results/edit-results.service';
+
+describe('CodeComponent', () => {
+  let component: CodeComponent;
+  let fixture: ComponentFixture<CodeComponent>;
+  const actionService = jasmine.createSpyObj('ActionService', ['perform']);
+  let results: Subject<EditResult>;
+
+  beforeEach(async(() => {
+    results = new Subject<EditResult>();
+    const editResultsService = { forEdit: () => results };
+
+    TestBed.configureTestingModule({
+      imports: [OverviewModule],
+      providers: [
+        { provide: ActionService, useValue: actionService },
+        { provide: EditResultsService, useValue: editResultsService },
+      ],
+    }).compileComponents();
+  }));
+
+  beforeEach(() => {
+    fixture = TestBed.createComponent(CodeComponent);
+    component = fixture.componentInstance;
+  });
+
+  const setView = (view: CodeView) => {
+    component.view = view;
+    component.ngOnChanges({
+      view: new SimpleChange(null, view, true),
+    });
+    fixture.detectChanges();
+  };
+
+  it('numbers lines', () => {
+    setView({
+      metadata: { type: 'code' },
+      config: { value: 'a: 1\nb: 2', language: 'yaml', lineNumbers: true },
+    });
+
+    expect(component.lineNumbers).toEqual([1, 2]);
+    expect(component.languages).toEqual(['yaml']);
+    expect(component.editable).toBeFalsy();
+  });
+
+  it('sends edits as an action', () => {
+    setView({
+      metadata: { type: 'code' },
+      config: {
+        value: 'a: 1',
+        edit: {
+          payload: { action: 'configmap/update' },
+          field: 'value',
+        },
+      },
+    });
+
+    component.edit();
+    component.draft = 'a: 2';
+    component.save();
+
+    expect(actionService.perform).toHaveBeenCalledWith({
+      action: 'configmap/update',
+      value: 'a: 2',
+    });
+    expect(component.editing).toBeFalsy();
+  });
+
+  it('keeps edits open until they are applied', () => {
+    setView({
+      metadata: { type: 'code' },
+      config: {
+        value: 'a: 1',
+        edit: {
+          payload: { action: 'overview/applyObject' },
+          field: 'manifest',
+          id: 'edit',
+        },
+      },
+    });
+
+    component.edit();
+    component.draft = 'a: 2';
+    component.save();
+    expect(component.editing).toBeTruthy();
+    expect(component.submitting).toBeTruthy();
+
+    results.next({
+      id: 'edit',
+      applied: false,
+      message: 'Unable to apply',
+      causes: [{ field: '.data.a', message: 'conflict' }],
+      conflict: true,
+    });
+    fixture.detectChanges();
+
+    expect(component.editing).toBeTruthy();
+    expect(component.submitting).toBeFalsy();
+    const element: HTMLElement = fixture.nativeElement;
+    expect(element.querySelector('.code-causes').textContent).toContain(
+      '.data.a: conflict'
+    );
+
+    component.save(true);
+    expect(actionService.perform).toHaveBeenCalledWith({
+      action: 'overview/applyObject',
+      manifest: 'a: 2',
+      force: true,
+    });
+
+    results.next({ id: 'edit', applied: true, message: 'Applied' });
+    expect(component.editing).toBeFalsy();
+    expect(component.result).toBeUndefined();
+  });
+});
@@ -0,0 +1,141 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+import {
+  Component,
+  Input,
+  OnChanges,
+  OnDestroy,
+  SimpleChanges,
+} from '@angular/core';
+import { Subscription } from 'rxjs';
+import { CodeView } from 'src/app/models/content';
+import {
+  EditResult,
+  EditResultsService,
+} from 'src/app/services/edit-results/edit-results.service';
+import { ActionService } from '../../services/action/action.service';
+import { ViewService } from '../../services/view/view.service';
+
+@Component({
+  selector: 'app-view-code',
+  templateUrl: './code.component.html',
+  styleUrls: ['./code.component.scss'],
+})
+export class CodeComponent implements OnChanges, OnDestroy {
+  @Input() view: CodeView;
+
+  title: string;
+
+  source: string;
+
+  languages: string[];
+
+  lineNumbers: number[] = [];
+
+  editable = false;
+
+  editing = false;
+
+  draft: string;
+
+  submitting = false;
+
+  // result is the result of the last edit which was not applied.
+  result: EditResult;
+
+  private editID: string;
+
+  private resultSubscription: Subscription;
+
+  constructor(
+    private viewService: ViewService,
+    private actionService: ActionService,
+    private editResultsService: EditResultsService
+  ) {}
+
+  ngOnChanges(changes: SimpleChanges): void {
+    if (changes.view.currentValue) {
+      const view = changes.view.currentValue as CodeView;
+
+      this.title = this.viewService.viewTitleAsText(view);
+      this.source = view.config.value;
+      this.languages = view.config.language
+        ? [view.config.language]
+        : undefined;
+      this.lineNumbers = view.config.lineNumbers
+        ? this.source.split('\n').map((_, i) => i + 1)
+        : [];
+      this.editable = !!view.config.edit;
+      this.watchResults(view.config.edit ? view.config.edit.id : undefined);
+
+      if (!this.editing) {
+        this.draft = this.source;
+      }
+    }
+  }
+
+  ngOnDestroy() {
+    this.watchResults(undefined);
+  }
+
+  edit() {
+    this.draft = this.source;
+    this.editing = true;
+  }
+
+  cancel() {
+    this.editing = false;
+    this.submitting = false;
+    this.result = undefined;
+  }
+
+  // save submits the draft. Edits with an ID stay open until their result
+  // is received, so the draft can be corrected if it is not applied. force
+  // resubmits an edit which conflicts with fields another manager owns.
+  save(force = false) {
+    const edit = this.view.config.edit;
+    this.actionService.perform({
+      ...edit.payload,
+      [edit.field]: this.draft,
+      ...(force ? { force: true } : {}),
+    });
+
+    if (edit.id) {
+      this.submitting = true;
+      return;
+    }
+
+    this.editing = false;
+  }
+
+  private watchResults(id: string) {
+    if (id === this.editID) {
+      return;
+    }
+
+    if (this.resultSubscription) {
+      this.resultSubscription.unsubscribe();
+      this.resultSubscription = undefined;
+    }
+
+    this.editID = id;
+    if (id) {
+      this.resultSubscription = this.editResultsService
+        .forEdit(id)
+        .subscribe(result => this.showResult(result));
+    }
+  }
+
+  private showResult(result: EditResult) {
+    this.submitting = false;
+
+    if (result.applied) {
+      this.editing = false;
+      this.result = undefined;
+      return;
+    }
+
+    this.result = result;
+  }
+}
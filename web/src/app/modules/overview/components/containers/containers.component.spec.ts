@@ -5,6 +5,9 @@
 import { async, ComponentFixture, TestBed } from '@angular/core/testing';
 
 import { ContainersComponent } from './containers.component';
+import { SimpleChange } from '@angular/core';
+import { By } from '@angular/platform-browser';
+import { ContainersView } from 'src/app/models/content';
 
 describe('ContainersComponent', () => {
   let component: ContainersComponent;
@@ -25,4 +28,41 @@ describe('ContainersComponent', () => {
   it('should create', () => {
     expect(component).toBeTruthy();
   });
+
+  it('flags containers with image warnings', () => {
+    const view: ContainersView = {
+      metadata: { type: 'containers' },
+      config: {
+        containers: [
+          {
+            name: 'nginx',
+            image: 'nginx',
+            registry: 'docker.io',
+            repository: 'library/nginx',
+            tag: 'latest',
+            warnings: ['Image uses the implicit :latest tag'],
+          },
+          {
+            name: 'kuard',
+            image: 'gcr.io/kuar-demo/kuard-amd64@sha256:abc',
+            registry: 'gcr.io',
+            repository: 'kuar-demo/kuard-amd64',
+            digest: 'sha256:abc',
+          },
+        ],
+      },
+    };
+
+    component.view = view;
+    component.ngOnChanges({
+      view: new SimpleChange(null, view, true),
+    });
+    fixture.detectChanges();
+
+    const icons = fixture.debugElement.queryAll(By.css('.is-warning'));
+    expect(icons.length).toEqual(1);
+    expect(icons[0].attributes.title).toEqual(
+      'Image uses the implicit :latest tag'
+    );
+  });
 });
@@ -26,4 +26,12 @@ export class ContainersComponent implements OnChanges {
   trackItem(index: number, item: ContainerDef): string {
     return item.name;
   }
+
+  hasWarnings(container: ContainerDef): boolean {
+    return container.warnings && container.warnings.length > 0;
+  }
+
+  warningText(container: ContainerDef): string {
+    return (container.warnings || []).join('\n');
+  }
 }
@@ -4,20 +4,36 @@
 
 import { async, ComponentFixture, TestBed } from '@angular/core/testing';
 
+import { SimpleChange } from '@angular/core';
+import { ActivatedRoute, Router } from '@angular/router';
+import { ClrDatagridSortOrder } from '@clr/angular';
+import { ActivatedRouteStub } from 'src/app/testing/activated-route-stub';
+import { TableView } from '../../../../models/content';
+import { ActionService } from '../../services/action/action.service';
 import { OverviewModule } from '../../overview.module';
 import { DatagridComponent } from './datagrid.component';
 
 describe('DatagridComponent', () => {
   let component: DatagridComponent;
   let fixture: ComponentFixture<DatagridComponent>;
+  let routerSpy: any;
 
   beforeEach(async(() => {
+    const mockRouter = {
+      navigate: jasmine.createSpy('navigate'),
+    };
+
     TestBed.configureTestingModule({
       imports: [OverviewModule],
+      providers: [
+        { provide: Router, useValue: mockRouter },
+        { provide: ActivatedRoute, useValue: new ActivatedRouteStub() },
+      ],
     }).compileComponents();
   }));
 
   beforeEach(() => {
+    routerSpy = TestBed.get(Router);
     fixture = TestBed.createComponent(DatagridComponent);
     component = fixture.componentInstance;
     fixture.detectChanges();
@@ -26,4 +42,176 @@ describe('DatagridComponent', () => {
   it('should create', () => {
     expect(component).toBeTruthy();
   });
+
+  it('builds download URLs from the router URL', () => {
+    routerSpy.url = '/overview/namespace/default/workloads/pods?search=nginx';
+
+    expect(component.downloadURL('csv')).toMatch(
+      /export\/table\?search=nginx&path=overview%2Fnamespace%2Fdefault%2Fworkloads%2Fpods&format=csv$/
+    );
+  });
+
+  it('returns row actions', () => {
+    const button = { name: 'Delete', payload: { action: 'octant/deleteObject' } };
+    const row = {
+      _action: {
+        metadata: { type: 'gridActions' },
+        config: { actions: [button] },
+      },
+    };
+
+    expect(component.rowActions(row)).toEqual([button]);
+    expect(component.rowActions({})).toEqual([]);
+  });
+
+  it('runs bulk actions on the selected rows', () => {
+    const actionService: ActionService = TestBed.get(ActionService);
+    spyOn(actionService, 'perform');
+
+    const rowWithAction = (name: string, action: string) => ({
+      _action: {
+        metadata: { type: 'gridActions' },
+        config: {
+          actions: [{ name: 'Delete', payload: { action, name } }],
+        },
+      },
+    });
+
+    component.selected = [
+      rowWithAction('a', 'octant/deleteObject'),
+      rowWithAction('b', 'overview/other'),
+      rowWithAction('c', 'octant/deleteObject'),
+    ];
+    component.onBulkActionClick({
+      name: 'Delete',
+      action: 'octant/deleteObject',
+    });
+
+    expect(actionService.perform).toHaveBeenCalledTimes(2);
+    expect(actionService.perform).toHaveBeenCalledWith({
+      action: 'octant/deleteObject',
+      name: 'a',
+    });
+    expect(actionService.perform).toHaveBeenCalledWith({
+      action: 'octant/deleteObject',
+      name: 'c',
+    });
+  });
+
+  it('sorts sortable columns with the default sort', () => {
+    const view: TableView = {
+      metadata: { type: 'table' },
+      config: {
+        columns: [
+          { name: 'Name', accessor: 'Name', sortable: true },
+          { name: 'Labels', accessor: 'Labels' },
+        ],
+        rows: [],
+        emptyContent: '',
+        loading: false,
+        filters: {},
+        defaultSort: { column: 'Name', direction: 'desc' },
+      },
+    };
+
+    component.view = view;
+    component.ngOnChanges({ view: new SimpleChange(undefined, view, true) });
+
+    expect(component.comparator('Name')).toBeTruthy();
+    expect(component.comparator('Labels')).toBeUndefined();
+    expect(component.sortOrder('Name')).toEqual(ClrDatagridSortOrder.DESC);
+    expect(component.sortOrder('Labels')).toEqual(
+      ClrDatagridSortOrder.UNSORTED
+    );
+  });
+
+  it('searches rows on the server', () => {
+    component.applySearch('  nginx ');
+    expect(routerSpy.navigate).toHaveBeenCalledWith([], {
+      relativeTo: jasmine.anything(),
+      queryParams: { search: 'nginx', offset: null, continue: null },
+      queryParamsHandling: 'merge',
+    });
+
+    component.applySearch('');
+    expect(routerSpy.navigate).toHaveBeenCalledWith([], {
+      relativeTo: jasmine.anything(),
+      queryParams: { search: null, offset: null, continue: null },
+      queryParamsHandling: 'merge',
+    });
+  });
+
+  it('requests pages from the server', () => {
+    const view: TableView = {
+      metadata: { type: 'table' },
+      config: {
+        columns: [{ name: 'Name', accessor: 'Name', sortable: true }],
+        rows: [],
+        emptyContent: '',
+        loading: false,
+        filters: {},
+        pagination: { offset: 100, limit: 100, total: 250 },
+      },
+    };
+
+    component.view = view;
+    component.ngOnChanges({ view: new SimpleChange(undefined, view, true) });
+
+    expect(component.hasPreviousPage()).toBeTruthy();
+    expect(component.hasNextPage()).toBeTruthy();
+
+    component.nextPage();
+    expect(routerSpy.navigate).toHaveBeenCalledWith([], {
+      relativeTo: jasmine.anything(),
+      queryParams: { offset: 200, limit: 100, continue: null },
+      queryParamsHandling: 'merge',
+    });
+
+    component.previousPage();
+    expect(routerSpy.navigate).toHaveBeenCalledWith([], {
+      relativeTo: jasmine.anything(),
+      queryParams: { offset: 0, limit: 100, continue: null },
+      queryParamsHandling: 'merge',
+    });
+  });
+
+  it('requests pages listed by continue token', () => {
+    const pagination = {
+      offset: 0,
+      limit: 100,
+      total: 100,
+      continue: 'cache:default/pod',
+    };
+    const view: TableView = {
+      metadata: { type: 'table' },
+      config: {
+        columns: [{ name: 'Name', accessor: 'Name', sortable: true }],
+        rows: [],
+        emptyContent: '',
+        loading: false,
+        filters: {},
+        pagination,
+      },
+    };
+
+    component.view = view;
+    component.ngOnChanges({ view: new SimpleChange(undefined, view, true) });
+
+    expect(component.hasNextPage()).toBeTruthy();
+
+    component.nextPage();
+    expect(routerSpy.navigate).toHaveBeenCalledWith([], {
+      relativeTo: jasmine.anything(),
+      queryParams: { offset: 100, limit: 100, continue: 'cache:default/pod' },
+      queryParamsHandling: 'merge',
+    });
+
+    component.pagination = { offset: 200, limit: 100, total: 250 };
+    component.previousPage();
+    expect(routerSpy.navigate).toHaveBeenCalledWith([], {
+      relativeTo: jasmine.anything(),
+      queryParams: { offset: 100, limit: 100, continue: 'cache:default/pod' },
+      queryParamsHandling: 'merge',
+    });
+  });
 });
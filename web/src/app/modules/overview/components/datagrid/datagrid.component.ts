@@ -3,10 +3,27 @@
 //
 
 import { Component, Input, OnChanges, SimpleChanges } from '@angular/core';
-import { TableFilters, TableRow, TableView } from 'src/app/models/content';
+import { ActivatedRoute, Router } from '@angular/router';
+import { ClrDatagridSortOrder } from '@clr/angular';
+import {
+  Button,
+  Confirmation,
+  GridActionsView,
+  TableBulkAction,
+  TableFilters,
+  TablePagination,
+  TableRow,
+  TableView,
+} from 'src/app/models/content';
+import { ColumnComparator } from 'src/app/util/sortValue';
 import trackByIndex from 'src/app/util/trackBy/trackByIndex';
 import trackByIdentity from 'src/app/util/trackBy/trackByIdentity';
 import { ViewService } from '../../services/view/view.service';
+import { ExportService } from 'src/app/services/export/export.service';
+import { ActionService } from '../../services/action/action.service';
+
+// gridActionKey is the row key which contains actions for the row.
+const gridActionKey = '_action';
 
 @Component({
   selector: 'app-view-datagrid',
@@ -22,12 +39,35 @@ export class DatagridComponent implements OnChanges {
   placeholder: string;
   lastUpdated: Date;
   filters: TableFilters;
+  comparators: { [columnName: string]: ColumnComparator } = {};
+  sortOrders: { [columnName: string]: ClrDatagridSortOrder } = {};
+  pagination: TablePagination;
+  bulkActions: TableBulkAction[] = [];
+  selected: TableRow[] = [];
+  searchable: boolean;
+  search = '';
 
   identifyRow = trackByIndex;
   identifyColumn = trackByIdentity;
   loading: boolean;
 
-  constructor(private viewService: ViewService) {}
+  isModalOpen = false;
+  modalTitle = '';
+  modalBody = '';
+  modalRequiredText = '';
+  modalTypedText = '';
+  private payloads: {}[] = [];
+  // continue tokens of the pages which have been shown, by offset, so
+  // previous pages can be requested again.
+  private continueTokens: { [offset: number]: string } = {};
+
+  constructor(
+    private viewService: ViewService,
+    private actionService: ActionService,
+    private exportService: ExportService,
+    private router: Router,
+    private activatedRoute: ActivatedRoute
+  ) {}
 
   ngOnChanges(changes: SimpleChanges): void {
     if (changes.view) {
@@ -40,10 +80,198 @@ export class DatagridComponent implements OnChanges {
       this.lastUpdated = new Date();
       this.loading = current.config.loading;
       this.filters = current.config.filters;
+      this.pagination = current.config.pagination;
+      this.bulkActions = current.config.bulkActions || [];
+      this.searchable = current.config.searchable;
+      this.search = current.config.search || '';
+      // rows are replaced on every update, so a selection would refer to
+      // rows which are no longer shown.
+      this.selected = [];
+      this.setSorting(current);
+    }
+  }
+
+  comparator(columnName: string): ColumnComparator {
+    return this.comparators[columnName];
+  }
+
+  sortOrder(columnName: string): ClrDatagridSortOrder {
+    return this.sortOrders[columnName] || ClrDatagridSortOrder.UNSORTED;
+  }
+
+  private setSorting(view: TableView) {
+    const comparators = {};
+    view.config.columns
+      .filter(column => column.sortable)
+      .forEach(column => {
+        comparators[column.name] =
+          this.comparators[column.name] || new ColumnComparator(column.name);
+      });
+    this.comparators = comparators;
+
+    // only apply the default sort the first time a table is shown, so
+    // updates do not undo the user's choice.
+    const defaultSort = view.config.defaultSort;
+    if (Object.keys(this.sortOrders).length === 0 && defaultSort) {
+      this.sortOrders = {
+        [defaultSort.column]:
+          defaultSort.direction === 'desc'
+            ? ClrDatagridSortOrder.DESC
+            : ClrDatagridSortOrder.ASC,
+      };
+    }
+  }
+
+  hasPreviousPage(): boolean {
+    return !!this.pagination && this.pagination.offset > 0;
+  }
+
+  hasNextPage(): boolean {
+    return (
+      !!this.pagination &&
+      (!!this.pagination.continue ||
+        this.pagination.offset + this.pagination.limit < this.pagination.total)
+    );
+  }
+
+  previousPage() {
+    const offset = Math.max(this.pagination.offset - this.pagination.limit, 0);
+    this.setPage(offset, this.continueTokens[offset]);
+  }
+
+  nextPage() {
+    const offset = this.pagination.offset + this.pagination.limit;
+    if (this.pagination.continue) {
+      this.continueTokens[offset] = this.pagination.continue;
     }
+    this.setPage(offset, this.pagination.continue);
+  }
+
+  // the page is requested through the query params, so the server only
+  // sends the rows in the page. Pages the server listed by continue token
+  // are requested with the token; without it they are found by offset.
+  private setPage(offset: number, continueToken?: string) {
+    this.router.navigate([], {
+      relativeTo: this.activatedRoute,
+      queryParams: {
+        offset,
+        limit: this.pagination.limit,
+        continue: continueToken || null,
+      },
+      queryParamsHandling: 'merge',
+    });
+  }
+
+  // search is applied by the server so it covers every page of rows. The
+  // offset is cleared because the rows of the current page may not match.
+  applySearch(search: string) {
+    this.continueTokens = {};
+    this.router.navigate([], {
+      relativeTo: this.activatedRoute,
+      queryParams: {
+        search: search.trim() || null,
+        offset: null,
+        continue: null,
+      },
+      queryParamsHandling: 'merge',
+    });
+  }
+
+  copyAsMarkdown() {
+    this.exportService.copyAsMarkdown(this.view);
+  }
+
+  // downloads are only offered for searchable tables, i.e. lists shown on
+  // their own, since the export API downloads the content's first table.
+  downloadURL(format: string): string {
+    return this.exportService.tableURL(this.router.url, format);
   }
 
   hasFilter(columnName: string): boolean {
     return !!this.view.config.filters[columnName];
   }
+
+  rowActions(row: TableRow): Button[] {
+    const gridActions = row[gridActionKey] as GridActionsView;
+    if (!gridActions || !gridActions.config.actions) {
+      return [];
+    }
+
+    return gridActions.config.actions;
+  }
+
+  onActionClick(button: Button) {
+    this.perform([button.payload], button.confirmation);
+  }
+
+  hasBulkActions(): boolean {
+    return this.bulkActions.length > 0;
+  }
+
+  // bulk actions run the matching row action of each selected row. Rows
+  // without one, or whose action needs typed confirmation or is disabled,
+  // are skipped.
+  onBulkActionClick(bulkAction: TableBulkAction) {
+    const payloads = this.selected
+      .map(row =>
+        this.rowActions(row).find(
+          button => button.payload['action'] === bulkAction.action
+        )
+      )
+      .filter(button => !!button && !button.disabledReason)
+      .filter(
+        button => !(button.confirmation && button.confirmation.requiredText)
+      )
+      .map(button => button.payload);
+
+    if (payloads.length === 0) {
+      return;
+    }
+
+    this.perform(payloads, bulkAction.confirmation);
+  }
+
+  cancelModal() {
+    this.resetModal();
+  }
+
+  canAcceptModal(): boolean {
+    return !this.modalRequiredText || this.modalTypedText === this.modalRequiredText;
+  }
+
+  acceptModal() {
+    if (!this.canAcceptModal()) {
+      return;
+    }
+
+    const payloads = this.payloads;
+    this.resetModal();
+    payloads.forEach(payload => this.actionService.perform(payload));
+  }
+
+  private perform(payloads: {}[], confirmation?: Confirmation) {
+    if (confirmation) {
+      this.activateModal(payloads, confirmation);
+    } else {
+      payloads.forEach(payload => this.actionService.perform(payload));
+    }
+  }
+
+  private activateModal(payloads: {}[], confirmation: Confirmation) {
+    this.modalTitle = confirmation.title;
+    this.modalBody = confirmation.body;
+    this.modalRequiredText = confirmation.requiredText || '';
+    this.modalTypedText = '';
+    this.payloads = payloads;
+    this.isModalOpen = true;
+  }
+
+  private resetModal() {
+    this.isModalOpen = false;
+    this.modalTitle = '';
+    this.modalBody = '';
+    this.modalRequiredText = '';
+    this.modalTypedText = '';
+    this.payloads = [];
+  }
 }
@@ -0,0 +1,78 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { async, ComponentFixture, TestBed } from '@angular/core/testing';
+import { SimpleChange } from '@angular/core';
+import { By } from '@angular/platform-browser';
+
+import { DiffComponent } from './diff.component';
+import { DiffView } from 'src/app/models/content';
+
+describe('DiffComponent', () => {
+  let component: DiffComponent;
+  let fixture: ComponentFixture<DiffComponent>;
+
+  beforeEach(async(() => {
+    TestBed.configureTestingModule({
+      declarations: [DiffComponent],
+    }).compileComponents();
+  }));
+
+  beforeEach(() => {
+    fixture = TestBed.createComponent(DiffComponent);
+    component = fixture.componentInstance;
+  });
+
+  const setView = (view: DiffView) => {
+    component.view = view;
+    component.ngOnChanges({
+      view: new SimpleChange(null, view, true),
+    });
+    fixture.detectChanges();
+  };
+
+  it('shows a unified diff', () => {
+    setView({
+      metadata: { type: 'diff' },
+      config: {
+        before: 'replicas: 1',
+        after: 'replicas: 2',
+        mode: 'unified',
+      },
+    });
+
+    expect(component.hasChanges).toBeTruthy();
+    const rows = fixture.debugElement.queryAll(By.css('.diff-table tr'));
+    expect(rows.length).toBe(2);
+    expect(rows[0].nativeElement.classList).toContain('diff-removed');
+    expect(rows[1].nativeElement.classList).toContain('diff-added');
+  });
+
+  it('shows a split diff', () => {
+    setView({
+      metadata: { type: 'diff' },
+      config: {
+        before: 'replicas: 1',
+        after: 'replicas: 2',
+        beforeLabel: '1',
+        afterLabel: '2',
+        mode: 'split',
+      },
+    });
+
+    expect(component.mode).toBe('split');
+    const rows = fixture.debugElement.queryAll(By.css('.diff-split tr'));
+    expect(rows.length).toBe(1);
+  });
+
+  it('shows when there are no changes', () => {
+    setView({
+      metadata: { type: 'diff' },
+      config: { before: 'a', after: 'a', mode: 'unified' },
+    });
+
+    const empty = fixture.debugElement.query(By.css('.diff-empty'));
+    expect(empty).toBeTruthy();
+  });
+});
@@ -0,0 +1,64 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+import { Component, Input, OnChanges, SimpleChanges } from '@angular/core';
+import { DiffView } from 'src/app/models/content';
+import { DiffLine, DiffRow, lineDiff, splitRows } from 'src/app/util/lineDiff';
+import { ViewService } from '../../services/view/view.service';
+
+@Component({
+  selector: 'app-view-diff',
+  templateUrl: './diff.component.html',
+  styleUrls: ['./diff.component.scss'],
+})
+export class DiffComponent implements OnChanges {
+  @Input() view: DiffView;
+
+  title: string;
+
+  beforeLabel: string;
+
+  afterLabel: string;
+
+  mode: 'unified' | 'split' = 'unified';
+
+  lines: DiffLine[] = [];
+
+  rows: DiffRow[] = [];
+
+  hasChanges = false;
+
+  constructor(private viewService: ViewService) {}
+
+  ngOnChanges(changes: SimpleChanges): void {
+    if (changes.view.currentValue) {
+      const view = changes.view.currentValue as DiffView;
+
+      this.title = this.viewService.viewTitleAsText(view);
+      this.beforeLabel = view.config.beforeLabel || 'Before';
+      this.afterLabel = view.config.afterLabel || 'After';
+      if (!changes.view.previousValue) {
+        this.mode = view.config.mode === 'split' ? 'split' : 'unified';
+      }
+
+      this.lines = lineDiff(view.config.before, view.config.after);
+      this.rows = splitRows(this.lines);
+      this.hasChanges = this.lines.some(line => line.kind !== 'same');
+    }
+  }
+
+  setMode(mode: 'unified' | 'split') {
+    this.mode = mode;
+  }
+
+  marker(line: DiffLine): string {
+    switch (line.kind) {
+      case 'added':
+        return '+';
+      case 'removed':
+        return '-';
+      default:
+        return ' ';
+    }
+  }
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { async, ComponentFixture, TestBed } from '@angular/core/testing';
+import { SimpleChange } from '@angular/core';
+import { By } from '@angular/platform-browser';
+
+import { DonutChartComponent } from './donut-chart.component';
+import { DonutChartView } from 'src/app/models/content';
+
+describe('DonutChartComponent', () => {
+  let component: DonutChartComponent;
+  let fixture: ComponentFixture<DonutChartComponent>;
+
+  beforeEach(async(() => {
+    TestBed.configureTestingModule({
+      declarations: [DonutChartComponent],
+    }).compileComponents();
+  }));
+
+  beforeEach(() => {
+    fixture = TestBed.createComponent(DonutChartComponent);
+    component = fixture.componentInstance;
+  });
+
+  it('creates an arc for each segment', () => {
+    const view: DonutChartView = {
+      metadata: { type: 'donutChart' },
+      config: {
+        segments: [
+          { count: 3, status: 'ok' },
+          { count: 0, status: 'warning' },
+          { count: 1, status: 'error' },
+        ],
+        labels: { plural: 'replicas', singular: 'replica' },
+        size: 100,
+      },
+    };
+
+    component.view = view;
+    component.ngOnChanges({
+      view: new SimpleChange(null, view, true),
+    });
+    fixture.detectChanges();
+
+    expect(component.total).toEqual(4);
+    expect(component.label).toEqual('replicas');
+    expect(component.arcs).toEqual([
+      { status: 'ok', dashArray: '75 25', dashOffset: 25 },
+      { status: 'error', dashArray: '25 75', dashOffset: -50 },
+    ]);
+
+    const segments = fixture.debugElement.queryAll(By.css('.donut-segment'));
+    expect(segments.length).toEqual(2);
+    expect(segments[1].classes['status-error']).toBeTruthy();
+  });
+});
@@ -0,0 +1,71 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+import { Component, Input, OnChanges, SimpleChanges } from '@angular/core';
+import { DonutChartView } from 'src/app/models/content';
+
+interface Arc {
+  status: string;
+  dashArray: string;
+  dashOffset: number;
+}
+
+// The radius of a circle with a circumference of 100, so segment
+// lengths are percentages.
+const radius = 100 / (2 * Math.PI);
+
+@Component({
+  selector: 'app-view-donut-chart',
+  templateUrl: './donut-chart.component.html',
+  styleUrls: ['./donut-chart.component.scss'],
+})
+export class DonutChartComponent implements OnChanges {
+  @Input() view: DonutChartView;
+
+  radius = radius;
+
+  arcs: Arc[] = [];
+
+  total = 0;
+
+  label: string;
+
+  size: number;
+
+  constructor() {}
+
+  ngOnChanges(changes: SimpleChanges): void {
+    if (changes.view.currentValue) {
+      const view = changes.view.currentValue as DonutChartView;
+      const segments = view.config.segments || [];
+
+      this.size = view.config.size;
+      this.total = segments.reduce((sum, segment) => sum + segment.count, 0);
+      this.label =
+        this.total === 1
+          ? view.config.labels.singular
+          : view.config.labels.plural;
+
+      // Arcs start at the top of the circle and go clockwise.
+      let offset = 25;
+      this.arcs = [];
+      if (this.total > 0) {
+        segments
+          .filter(segment => segment.count > 0)
+          .forEach(segment => {
+            const length = (segment.count / this.total) * 100;
+            this.arcs.push({
+              status: segment.status,
+              dashArray: `${length} ${100 - length}`,
+              dashOffset: offset,
+            });
+            offset -= length;
+          });
+      }
+    }
+  }
+
+  trackByFn(index, item) {
+    return index;
+  }
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { async, ComponentFixture, TestBed } from '@angular/core/testing';
+import { ClarityModule } from '@clr/angular';
+import { BehaviorSubject } from 'rxjs';
+import { By } from '@angular/platform-browser';
+
+import { EventFilterSelectorComponent } from './event-filter-selector.component';
+import { EventFiltersService } from '../../../../services/event-filters/event-filters.service';
+
+class MockEventFiltersService {
+  active = new BehaviorSubject<string[]>(['hide-normal']);
+  toggle(preset: string) {}
+  reset() {}
+}
+
+describe('EventFilterSelectorComponent', () => {
+  let component: EventFilterSelectorComponent;
+  let fixture: ComponentFixture<EventFilterSelectorComponent>;
+
+  beforeEach(async(() => {
+    TestBed.configureTestingModule({
+      imports: [ClarityModule],
+      declarations: [EventFilterSelectorComponent],
+      providers: [
+        { provide: EventFiltersService, useClass: MockEventFiltersService },
+      ],
+    }).compileComponents();
+  }));
+
+  beforeEach(() => {
+    fixture = TestBed.createComponent(EventFilterSelectorComponent);
+    component = fixture.componentInstance;
+    fixture.detectChanges();
+  });
+
+  it('should create', () => {
+    expect(component).toBeTruthy();
+  });
+
+  it('marks the active presets', () => {
+    expect(component.isActive(component.presets[0])).toBeTruthy();
+    expect(component.isActive(component.presets[1])).toBeFalsy();
+  });
+
+  it('toggles a preset when the button is clicked', () => {
+    const onClickMock = spyOn(component, 'toggle');
+
+    fixture.debugElement
+      .query(By.css('button.dropdown-toggle'))
+      .nativeElement.click();
+    fixture.detectChanges();
+
+    fixture.debugElement
+      .query(By.css('button.preset-button:last-of-type'))
+      .nativeElement.click();
+
+    expect(onClickMock).toHaveBeenCalledWith(component.presets[2]);
+  });
+});
@@ -0,0 +1,53 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { Component, OnDestroy, OnInit } from '@angular/core';
+import { Subscription } from 'rxjs';
+import {
+  EventFilterPreset,
+  eventFilterPresets,
+  EventFiltersService,
+} from '../../../../services/event-filters/event-filters.service';
+
+@Component({
+  selector: 'app-event-filter-selector',
+  templateUrl: './event-filter-selector.component.html',
+  styleUrls: ['./event-filter-selector.component.scss'],
+})
+export class EventFilterSelectorComponent implements OnInit, OnDestroy {
+  presets = eventFilterPresets;
+  active: string[] = [];
+
+  private subscription: Subscription;
+
+  constructor(private eventFilters: EventFiltersService) {}
+
+  ngOnInit() {
+    this.subscription = this.eventFilters.active.subscribe(
+      active => (this.active = active)
+    );
+  }
+
+  ngOnDestroy() {
+    if (this.subscription) {
+      this.subscription.unsubscribe();
+    }
+  }
+
+  isActive(preset: EventFilterPreset) {
+    return this.active.includes(preset.name);
+  }
+
+  toggle(preset: EventFilterPreset) {
+    this.eventFilters.toggle(preset.name);
+  }
+
+  reset() {
+    this.eventFilters.reset();
+  }
+
+  trackByFn(index, item) {
+    return index;
+  }
+}
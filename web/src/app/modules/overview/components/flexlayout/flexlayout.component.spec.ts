@@ -26,4 +26,39 @@ describe('FlexlayoutComponent', () => {
   it('should create', () => {
     expect(component).toBeTruthy();
   });
+
+  it('names sections', () => {
+    component.view = {
+      metadata: { type: 'flexlayout' },
+      config: {
+        sections: [[], []],
+        sectionInfo: [{}, { id: 'status', title: 'Status' }],
+        buttonGroup: undefined,
+      },
+    };
+    fixture.detectChanges();
+
+    expect(component.sectionInfo(0)).toEqual({});
+    expect(component.sectionInfo(1)).toEqual({ id: 'status', title: 'Status' });
+
+    const element: HTMLElement = fixture.nativeElement;
+    expect(element.querySelector('#status')).toBeTruthy();
+    expect(element.querySelector('.section-title').textContent).toContain(
+      'Status'
+    );
+  });
+
+  it('converts item widths to column classes', () => {
+    expect(
+      component.itemClasses({ width: 12, view: undefined })
+    ).toEqual(['clr-col-md-6']);
+
+    expect(
+      component.itemClasses({
+        width: 12,
+        widths: { sm: 24, xl: 6 },
+        view: undefined,
+      })
+    ).toEqual(['clr-col-sm-12', 'clr-col-md-6', 'clr-col-xl-3']);
+  });
 });
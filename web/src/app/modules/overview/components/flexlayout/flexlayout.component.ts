@@ -3,7 +3,11 @@
 //
 
 import { Component, Input } from '@angular/core';
-import { FlexLayoutView } from 'src/app/models/content';
+import {
+  FlexLayoutItem,
+  FlexLayoutSectionInfo,
+  FlexLayoutView,
+} from 'src/app/models/content';
 import trackByIndex from 'src/app/util/trackBy/trackByIndex';
 
 @Component({
@@ -14,4 +18,20 @@ import trackByIndex from 'src/app/util/trackBy/trackByIndex';
 export class FlexlayoutComponent {
   @Input() view: FlexLayoutView;
   identifySection = trackByIndex;
+
+  sectionInfo(index: number): FlexLayoutSectionInfo {
+    if (!this.view || !this.view.config || !this.view.config.sectionInfo) {
+      return {};
+    }
+    return this.view.config.sectionInfo[index] || {};
+  }
+
+  // itemClasses converts an item's widths, which are out of 24, to grid
+  // column classes, which are out of 12.
+  itemClasses(item: FlexLayoutItem): string[] {
+    const widths = { md: item.width, ...(item.widths || {}) };
+    return ['sm', 'md', 'lg', 'xl']
+      .filter(size => widths[size])
+      .map(size => `clr-col-${size}-${widths[size] / 2}`);
+  }
 }
@@ -9,8 +9,17 @@ import {
   FormBuilder,
   FormControl,
   FormGroup,
+  ValidationErrors,
+  ValidatorFn,
+  Validators,
 } from '@angular/forms';
 
+export interface FieldChange {
+  label: string;
+  previous: string;
+  current: string;
+}
+
 interface Choice {
   label: string;
   value: string;
@@ -29,6 +38,15 @@ export class FormComponent implements OnInit {
   @Input()
   title: string;
 
+  @Input()
+  confirmChanges = false;
+
+  @Input()
+  danger = '';
+
+  @Input()
+  confirmationText = '';
+
   @Output()
   submit: EventEmitter<FormGroup> = new EventEmitter(true);
 
@@ -37,6 +55,12 @@ export class FormComponent implements OnInit {
 
   formGroup: FormGroup;
 
+  changes: FieldChange[];
+
+  fileNames: { [name: string]: string } = {};
+
+  typedConfirmationText = '';
+
   constructor(private formBuilder: FormBuilder) {}
 
   ngOnInit() {
@@ -44,7 +68,7 @@ export class FormComponent implements OnInit {
       const controls: { [name: string]: AbstractControl } = {};
       this.form.fields.forEach(field => {
         const value = field.value;
-        controls[field.name] = new FormControl(value);
+        controls[field.name] = new FormControl(value, this.validators(field));
       });
 
       this.formGroup = this.formBuilder.group(controls);
@@ -52,9 +76,160 @@ export class FormComponent implements OnInit {
   }
 
   onFormSubmit() {
+    if (!this.isConfirmed()) {
+      return;
+    }
+
+    if (this.formGroup.invalid) {
+      this.formGroup.markAllAsTouched();
+      return;
+    }
+
+    if (this.confirmChanges && !this.changes) {
+      this.changes = this.changedFields();
+      return;
+    }
+
+    this.changes = undefined;
     this.submit.emit(this.formGroup);
   }
 
+  // isConfirmed returns true if the action doesn't need typed
+  // confirmation, or if the confirmation text was typed.
+  isConfirmed(): boolean {
+    return (
+      !this.confirmationText ||
+      this.typedConfirmationText === this.confirmationText
+    );
+  }
+
+  submitClass(): string {
+    switch (this.danger) {
+      case 'danger':
+        return 'btn-danger';
+      case 'warning':
+        return 'btn-warning';
+      default:
+        return 'btn-primary';
+    }
+  }
+
+  onConfirmBack() {
+    this.changes = undefined;
+  }
+
+  changedFields(): FieldChange[] {
+    return this.form.fields
+      .filter(field => field.type !== 'hidden')
+      .filter(field => this.formGroup.value[field.name] !== field.value)
+      .map(field => ({
+        label: field.label,
+        previous: this.displayValue(field, field.value),
+        current: this.displayValue(field, this.formGroup.value[field.name]),
+      }));
+  }
+
+  onFileChange(field: ActionField, event: Event) {
+    const files = (event.target as HTMLInputElement).files;
+    if (!files || files.length === 0) {
+      return;
+    }
+
+    const file = files[0];
+    const reader = new FileReader();
+    reader.onload = () => {
+      this.fileNames[field.name] = file.name;
+      this.formGroup.controls[field.name].setValue(reader.result);
+    };
+    reader.readAsText(file);
+  }
+
+  addToken(field: ActionField, input: HTMLInputElement) {
+    const token = input.value.trim();
+    input.value = '';
+    if (!token) {
+      return;
+    }
+
+    const control = this.formGroup.controls[field.name];
+    control.setValue([...(control.value || []), token]);
+  }
+
+  removeToken(field: ActionField, index: number) {
+    const control = this.formGroup.controls[field.name];
+    const tokens = [...(control.value || [])];
+    tokens.splice(index, 1);
+    control.setValue(tokens);
+  }
+
+  private displayValue(field: ActionField, value: any): string {
+    switch (field.type) {
+      case 'toggle':
+        return value ? 'On' : 'Off';
+      case 'tokens':
+        return (value || []).join(', ');
+    }
+
+    if (!value) {
+      return value;
+    }
+
+    switch (field.type) {
+      case 'password':
+        return '********';
+      case 'file':
+        return this.fileNames[field.name] || '(file contents)';
+      default:
+        return value;
+    }
+  }
+
+  validators(field: ActionField): ValidatorFn[] {
+    const validation = field.validation;
+    if (!validation) {
+      return [];
+    }
+
+    const validators: ValidatorFn[] = [];
+    if (validation.required) {
+      validators.push(Validators.required);
+    }
+    if (validation.min !== undefined) {
+      validators.push(Validators.min(validation.min));
+    }
+    if (validation.max !== undefined) {
+      validators.push(Validators.max(validation.max));
+    }
+    if (validation.pattern) {
+      validators.push(Validators.pattern(`^(?:${validation.pattern})$`));
+    }
+    if (validation.enum && validation.enum.length > 0) {
+      validators.push(enumValidator(validation.enum));
+    }
+    return validators;
+  }
+
+  errorMessage(field: ActionField): string {
+    const errors = this.formGroup.controls[field.name].errors;
+    if (!errors) {
+      return '';
+    }
+
+    const validation = field.validation || {};
+    if (errors.required) {
+      return `${field.label} is required`;
+    } else if (errors.min) {
+      return `${field.label} must be at least ${validation.min}`;
+    } else if (errors.max) {
+      return `${field.label} must be at most ${validation.max}`;
+    } else if (errors.pattern) {
+      return `${field.label} must match ${validation.pattern}`;
+    } else if (errors.enum) {
+      return `${field.label} must be one of ${validation.enum.join(', ')}`;
+    }
+    return `${field.label} is invalid`;
+  }
+
   onFormCancel() {
     this.cancel.emit(true);
   }
@@ -67,3 +242,15 @@ export class FormComponent implements OnInit {
     return index;
   }
 }
+
+const enumValidator = (values: string[]): ValidatorFn => {
+  return (control: AbstractControl): ValidationErrors | null => {
+    const value = control.value;
+    if (value === null || value === undefined || value === '') {
+      return null;
+    }
+
+    const selected: string[] = Array.isArray(value) ? value : [`${value}`];
+    return selected.every(v => values.includes(v)) ? null : { enum: true };
+  };
+};
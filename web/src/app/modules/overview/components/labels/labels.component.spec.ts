@@ -3,20 +3,39 @@
 //
 
 import { async, ComponentFixture, TestBed } from '@angular/core/testing';
+import { SimpleChange } from '@angular/core';
+import { Router } from '@angular/router';
+import { LabelsView } from 'src/app/models/content';
+import { LabelFilterService } from 'src/app/services/label-filter/label-filter.service';
 
 import { LabelsComponent } from './labels.component';
 
 describe('LabelsComponent', () => {
   let component: LabelsComponent;
   let fixture: ComponentFixture<LabelsComponent>;
+  let routerSpy: any;
+  let labelFilterSpy: any;
 
   beforeEach(async(() => {
+    const mockRouter = {
+      navigate: jasmine.createSpy('navigate'),
+    };
+    const mockLabelFilter = {
+      add: jasmine.createSpy('add'),
+    };
+
     TestBed.configureTestingModule({
       declarations: [LabelsComponent],
+      providers: [
+        { provide: Router, useValue: mockRouter },
+        { provide: LabelFilterService, useValue: mockLabelFilter },
+      ],
     }).compileComponents();
   }));
 
   beforeEach(() => {
+    routerSpy = TestBed.get(Router);
+    labelFilterSpy = TestBed.get(LabelFilterService);
     fixture = TestBed.createComponent(LabelsComponent);
     component = fixture.componentInstance;
     fixture.detectChanges();
@@ -25,4 +44,38 @@ describe('LabelsComponent', () => {
   it('should create', () => {
     expect(component).toBeTruthy();
   });
+
+  describe('click', () => {
+    beforeEach(() => {
+      const view: LabelsView = {
+        metadata: { type: 'labels' },
+        config: {
+          labels: { app: 'nginx', tier: 'web' },
+          links: { app: '/overview/namespace/default/workloads/pods' },
+        },
+      };
+      component.view = view;
+      component.ngOnChanges({ view: new SimpleChange(null, view, true) });
+    });
+
+    it('navigates to the filtered list for linked labels', () => {
+      component.click('app', 'nginx');
+
+      expect(routerSpy.navigate).toHaveBeenCalledWith(
+        ['/overview/namespace/default/workloads/pods'],
+        { queryParams: { filters: 'app:nginx' } }
+      );
+      expect(labelFilterSpy.add).not.toHaveBeenCalled();
+    });
+
+    it('filters the current content for other labels', () => {
+      component.click('tier', 'web');
+
+      expect(labelFilterSpy.add).toHaveBeenCalledWith({
+        key: 'tier',
+        value: 'web',
+      });
+      expect(routerSpy.navigate).not.toHaveBeenCalled();
+    });
+  });
 });
@@ -3,6 +3,7 @@
 //
 
 import { Component, Input, OnChanges, SimpleChanges } from '@angular/core';
+import { Router } from '@angular/router';
 import { LabelsView } from 'src/app/models/content';
 import { LabelFilterService } from 'src/app/services/label-filter/label-filter.service';
 import trackByIdentity from 'src/app/util/trackBy/trackByIdentity';
@@ -18,11 +19,13 @@ export class LabelsComponent implements OnChanges {
   title: string;
   labelKeys: string[];
   labels: { [key: string]: string };
+  links: { [key: string]: string };
   trackByIdentity = trackByIdentity;
 
   constructor(
     private labelFilter: LabelFilterService,
-    private viewService: ViewService
+    private viewService: ViewService,
+    private router: Router
   ) {}
 
   ngOnChanges(changes: SimpleChanges): void {
@@ -31,11 +34,22 @@ export class LabelsComponent implements OnChanges {
 
       this.title = this.viewService.viewTitleAsText(view);
       this.labels = view.config.labels;
+      this.links = view.config.links || {};
       this.labelKeys = Object.keys(this.labels);
     }
   }
 
+  // linked labels show their list filtered by the label. Other labels
+  // filter the current content.
   click(key: string, value: string) {
+    const link = this.links[key];
+    if (link) {
+      this.router.navigate([link], {
+        queryParams: { filters: `${key}:${value}` },
+      });
+      return;
+    }
+
     this.labelFilter.add({ key, value });
   }
 }
@@ -3,6 +3,7 @@
 //
 
 import { async, ComponentFixture, TestBed } from '@angular/core/testing';
+import { SimpleChange } from '@angular/core';
 import { RouterTestingModule } from '@angular/router/testing';
 
 import { OverviewModule } from '../../overview.module';
@@ -27,4 +28,52 @@ describe('LinkComponent', () => {
   it('should create', () => {
     expect(component).toBeTruthy();
   });
+
+  it('links to the API for download links', () => {
+    const view = {
+      metadata: { type: 'link' },
+      config: { value: 'CSV', ref: 'inventory?format=csv', download: true },
+    };
+    component.view = view;
+    component.ngOnChanges({ view: new SimpleChange(null, view, true) });
+    fixture.detectChanges();
+
+    expect(component.ref).toMatch(/\/api\/v1\/inventory\?format=csv$/);
+
+    const anchor: HTMLAnchorElement = fixture.nativeElement.querySelector('a');
+    expect(anchor.hasAttribute('download')).toBeTruthy();
+  });
+
+  it('opens external links in a new window', () => {
+    const view = {
+      metadata: { type: 'link' },
+      config: {
+        value: 'Runbook',
+        ref: 'https://example.com/runbook',
+        external: true,
+        newWindow: true,
+      },
+    };
+    component.view = view;
+    component.ngOnChanges({ view: new SimpleChange(null, view, true) });
+    fixture.detectChanges();
+
+    const anchor: HTMLAnchorElement = fixture.nativeElement.querySelector('a');
+    expect(anchor.getAttribute('href')).toEqual('https://example.com/runbook');
+    expect(anchor.getAttribute('target')).toEqual('_blank');
+    expect(anchor.getAttribute('rel')).toEqual('noopener noreferrer');
+  });
+
+  it('shows external links with unsafe schemes as text', () => {
+    const view = {
+      metadata: { type: 'link' },
+      config: { value: 'Runbook', ref: 'javascript:alert(1)', external: true },
+    };
+    component.view = view;
+    component.ngOnChanges({ view: new SimpleChange(null, view, true) });
+    fixture.detectChanges();
+
+    expect(component.kind).toEqual('text');
+    expect(fixture.nativeElement.querySelector('a')).toBeNull();
+  });
 });
@@ -4,6 +4,13 @@
 
 import { Component, Input, OnChanges, SimpleChanges } from '@angular/core';
 import { LinkView } from 'src/app/models/content';
+import getAPIBase from 'src/app/services/common/getAPIBase';
+
+// externalSchemes are the schemes external links may use. Links with other
+// schemes, e.g. javascript:, are shown as text.
+const externalSchemes = ['http:', 'https:'];
+
+type LinkKind = 'content' | 'download' | 'external' | 'text';
 
 @Component({
   selector: 'app-view-link',
@@ -15,14 +22,36 @@ export class LinkComponent implements OnChanges {
 
   ref: string;
   value: string;
+  kind: LinkKind = 'content';
+  target: string = null;
 
   constructor() {}
 
   ngOnChanges(changes: SimpleChanges): void {
     if (changes.view.currentValue) {
       const view = changes.view.currentValue as LinkView;
-      this.ref = view.config.ref;
       this.value = view.config.value;
+      this.target = view.config.newWindow ? '_blank' : null;
+
+      if (view.config.download) {
+        // Download links are relative to the API, so they are not routed.
+        this.kind = 'download';
+        this.ref = [getAPIBase(), 'api/v1', view.config.ref].join('/');
+      } else if (view.config.external) {
+        this.kind = isSafeExternalRef(view.config.ref) ? 'external' : 'text';
+        this.ref = view.config.ref;
+      } else {
+        this.kind = 'content';
+        this.ref = view.config.ref;
+      }
     }
   }
 }
+
+function isSafeExternalRef(ref: string): boolean {
+  try {
+    return externalSchemes.includes(new URL(ref).protocol);
+  } catch (e) {
+    return false;
+  }
+}
@@ -61,4 +61,36 @@ describe('LogsComponent', () => {
       nativeElement.scrollHeight - nativeElement.offsetHeight
     );
   });
+
+  it('should filter logs by level, field, and message', () => {
+    const timestamp = '2019-08-19T12:07:00.1222053Z';
+    component.containerLogs = [
+      {
+        timestamp,
+        message: 'request complete',
+        level: 'info',
+        fields: { path: '/healthz' },
+      },
+      {
+        timestamp,
+        message: 'request failed',
+        level: 'error',
+        fields: { path: '/api' },
+      },
+      { timestamp, message: 'plain text' },
+    ];
+
+    component.onFilterChange('level=error');
+    expect(component.visibleLogs().map(e => e.message)).toEqual([
+      'request failed',
+    ]);
+
+    component.onFilterChange('path=/healthz request');
+    expect(component.visibleLogs().map(e => e.message)).toEqual([
+      'request complete',
+    ]);
+
+    component.onFilterChange('');
+    expect(component.visibleLogs().length).toBe(3);
+  });
 });
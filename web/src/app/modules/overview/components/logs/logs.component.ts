@@ -35,6 +35,7 @@ export class LogsComponent implements OnInit, OnDestroy, AfterViewChecked {
 
   selectedContainer = '';
   shouldDisplayTimestamp = true;
+  filterText = '';
 
   constructor(
     private podLogsService: PodLogsService,
@@ -46,7 +47,9 @@ export class LogsComponent implements OnInit, OnDestroy, AfterViewChecked {
       .find(this.containerLogs)
       .create();
     if (this.view) {
-      if (
+      if (this.view.config.container) {
+        this.selectedContainer = this.view.config.container;
+      } else if (
         this.view.config.containers &&
         this.view.config.containers.length > 0
       ) {
@@ -70,20 +73,76 @@ export class LogsComponent implements OnInit, OnDestroy, AfterViewChecked {
     this.shouldDisplayTimestamp = !this.shouldDisplayTimestamp;
   }
 
+  onFilterChange(filterText: string): void {
+    this.filterText = filterText;
+  }
+
+  // Filters are space separated terms. A `key=value` term matches entries
+  // with the field (or level) value; other terms match the message.
+  visibleLogs(): LogEntry[] {
+    const terms = this.filterText.split(' ').filter(term => term !== '');
+    if (terms.length === 0) {
+      return this.containerLogs;
+    }
+
+    return this.containerLogs.filter(entry =>
+      terms.every(term => this.matchesTerm(entry, term))
+    );
+  }
+
+  logFields(entry: LogEntry): string[] {
+    return Object.keys(entry.fields || {})
+      .sort()
+      .map(key => `${key}=${entry.fields[key]}`);
+  }
+
+  private matchesTerm(entry: LogEntry, term: string): boolean {
+    const index = term.indexOf('=');
+    if (index > 0) {
+      const key = term.substring(0, index);
+      const value = term.substring(index + 1);
+      if (key === 'level') {
+        return entry.level === value;
+      }
+      return entry.fields !== undefined && entry.fields[key] === value;
+    }
+
+    return entry.message.includes(term);
+  }
+
   startStream() {
     const namespace = this.view.config.namespace;
     const pod = this.view.config.name;
     const container = this.selectedContainer;
-    if (namespace && pod && container) {
+    if (!(namespace && pod && container)) {
+      return;
+    }
+
+    const { token, follow, sinceSeconds } = this.view.config;
+    if (token && container === this.view.config.container) {
+      this.logStream = this.podLogsService.createStreamFromToken(
+        token,
+        !!follow
+      );
+    } else if (token) {
+      // Other containers use the same options as the referenced stream.
+      this.logStream = this.podLogsService.createStream(
+        namespace,
+        pod,
+        container,
+        { follow: !!follow, sinceSeconds }
+      );
+    } else {
       this.logStream = this.podLogsService.createStream(
         namespace,
         pod,
         container
       );
-      this.logStream.logEntries.subscribe((entries: LogEntry[]) => {
-        this.containerLogs = entries;
-      });
     }
+
+    this.logStream.logEntries.subscribe((entries: LogEntry[]) => {
+      this.containerLogs = entries;
+    });
   }
 
   identifyLog(index: number, item: LogEntry) {
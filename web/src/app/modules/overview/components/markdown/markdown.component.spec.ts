@@ -0,0 +1,46 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+import { Component } from '@angular/core';
+import { async, ComponentFixture, TestBed } from '@angular/core/testing';
+import { MarkdownModule } from 'ngx-markdown';
+
+import { MarkdownView } from '../../../../models/content';
+import { MarkdownComponent } from './markdown.component';
+
+@Component({
+  template: '<app-view-markdown [view]="view"></app-view-markdown>',
+})
+class TestWrapperComponent {
+  view: MarkdownView;
+}
+
+describe('MarkdownComponent', () => {
+  let component: TestWrapperComponent;
+  let fixture: ComponentFixture<TestWrapperComponent>;
+
+  beforeEach(async(() => {
+    TestBed.configureTestingModule({
+      imports: [MarkdownModule.forRoot()],
+      declarations: [TestWrapperComponent, MarkdownComponent],
+    }).compileComponents();
+  }));
+
+  beforeEach(() => {
+    fixture = TestBed.createComponent(TestWrapperComponent);
+    component = fixture.componentInstance;
+  });
+
+  it('renders markdown', () => {
+    component.view = {
+      config: { value: '# nginx\n\n* installed' },
+      metadata: { type: 'markdown' },
+    };
+    fixture.detectChanges();
+
+    const element: HTMLElement = fixture.nativeElement;
+    const markdown = element.querySelector('app-view-markdown div');
+    expect(markdown.querySelector('h1').textContent).toEqual('nginx');
+    expect(markdown.querySelector('li').textContent).toEqual('installed');
+  });
+});
@@ -0,0 +1,25 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+import { Component, Input, OnChanges, SimpleChanges } from '@angular/core';
+import { MarkdownView } from 'src/app/models/content';
+
+@Component({
+  selector: 'app-view-markdown',
+  templateUrl: './markdown.component.html',
+  styleUrls: ['./markdown.component.scss'],
+})
+export class MarkdownComponent implements OnChanges {
+  @Input() view: MarkdownView;
+
+  value: string;
+
+  constructor() {}
+
+  ngOnChanges(changes: SimpleChanges): void {
+    if (changes.view.currentValue) {
+      const view = changes.view.currentValue as MarkdownView;
+      this.value = view.config.value;
+    }
+  }
+}
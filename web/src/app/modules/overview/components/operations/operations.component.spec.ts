@@ -0,0 +1,66 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { async, ComponentFixture, TestBed } from '@angular/core/testing';
+import { By } from '@angular/platform-browser';
+import { BehaviorSubject } from 'rxjs';
+
+import { OverviewModule } from '../../overview.module';
+import { OperationsComponent } from './operations.component';
+import {
+  OperationProgress,
+  OperationsService,
+} from '../../../../services/operations/operations.service';
+
+class MockOperationsService {
+  current = new BehaviorSubject<OperationProgress[]>([
+    {
+      id: 'running',
+      done: false,
+      view: { metadata: { type: 'text' }, config: { value: 'scaling' } } as any,
+    },
+    {
+      id: 'finished',
+      done: true,
+      view: { metadata: { type: 'text' }, config: { value: 'done' } } as any,
+    },
+  ]);
+  remove(id: string) {}
+}
+
+describe('OperationsComponent', () => {
+  let component: OperationsComponent;
+  let fixture: ComponentFixture<OperationsComponent>;
+
+  beforeEach(async(() => {
+    TestBed.configureTestingModule({
+      imports: [OverviewModule],
+      providers: [
+        { provide: OperationsService, useClass: MockOperationsService },
+      ],
+    }).compileComponents();
+  }));
+
+  beforeEach(() => {
+    fixture = TestBed.createComponent(OperationsComponent);
+    component = fixture.componentInstance;
+    fixture.detectChanges();
+  });
+
+  it('shows each operation', () => {
+    expect(
+      fixture.debugElement.queryAll(By.css('.operation')).length
+    ).toEqual(2);
+  });
+
+  it('dismisses finished operations', () => {
+    const onClickMock = spyOn(component, 'dismiss');
+
+    const buttons = fixture.debugElement.queryAll(By.css('.dismiss-button'));
+    expect(buttons.length).toEqual(1);
+    buttons[0].nativeElement.click();
+
+    expect(onClickMock).toHaveBeenCalledWith(component.operations[1]);
+  });
+});
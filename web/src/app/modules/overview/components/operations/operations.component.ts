@@ -0,0 +1,43 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { Component, OnDestroy, OnInit } from '@angular/core';
+import { Subscription } from 'rxjs';
+import {
+  OperationProgress,
+  OperationsService,
+} from '../../../../services/operations/operations.service';
+
+@Component({
+  selector: 'app-operations',
+  templateUrl: './operations.component.html',
+  styleUrls: ['./operations.component.scss'],
+})
+export class OperationsComponent implements OnInit, OnDestroy {
+  operations: OperationProgress[] = [];
+
+  private subscription: Subscription;
+
+  constructor(private operationsService: OperationsService) {}
+
+  ngOnInit() {
+    this.subscription = this.operationsService.current.subscribe(
+      operations => (this.operations = operations)
+    );
+  }
+
+  ngOnDestroy() {
+    if (this.subscription) {
+      this.subscription.unsubscribe();
+    }
+  }
+
+  dismiss(operation: OperationProgress) {
+    this.operationsService.remove(operation.id);
+  }
+
+  trackByFn(index: number, item: OperationProgress) {
+    return item.id;
+  }
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { async, ComponentFixture, TestBed } from '@angular/core/testing';
+import { SimpleChange } from '@angular/core';
+import { By } from '@angular/platform-browser';
+
+import { ProgressComponent } from './progress.component';
+import { ProgressView } from 'src/app/models/content';
+
+describe('ProgressComponent', () => {
+  let component: ProgressComponent;
+  let fixture: ComponentFixture<ProgressComponent>;
+
+  beforeEach(async(() => {
+    TestBed.configureTestingModule({
+      declarations: [ProgressComponent],
+    }).compileComponents();
+  }));
+
+  beforeEach(() => {
+    fixture = TestBed.createComponent(ProgressComponent);
+    component = fixture.componentInstance;
+  });
+
+  it('shows the value and status', () => {
+    const view: ProgressView = {
+      metadata: { type: 'progress' },
+      config: {
+        value: 120.4,
+        label: 'CPU',
+        status: 'error',
+      },
+    };
+
+    component.view = view;
+    component.ngOnChanges({
+      view: new SimpleChange(null, view, true),
+    });
+    fixture.detectChanges();
+
+    expect(component.value).toEqual(100);
+    expect(component.label).toEqual('CPU (120%)');
+
+    const progress = fixture.debugElement.query(By.css('.progress'));
+    expect(progress.classes['status-error']).toBeTruthy();
+  });
+});
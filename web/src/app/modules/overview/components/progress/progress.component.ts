@@ -0,0 +1,33 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+import { Component, Input, OnChanges, SimpleChanges } from '@angular/core';
+import { ProgressView } from 'src/app/models/content';
+
+@Component({
+  selector: 'app-view-progress',
+  templateUrl: './progress.component.html',
+  styleUrls: ['./progress.component.scss'],
+})
+export class ProgressComponent implements OnChanges {
+  @Input() view: ProgressView;
+
+  value = 0;
+
+  label: string;
+
+  status: string;
+
+  constructor() {}
+
+  ngOnChanges(changes: SimpleChanges): void {
+    if (changes.view.currentValue) {
+      const view = changes.view.currentValue as ProgressView;
+
+      // Values over 100 are over committed, so the bar is shown full.
+      this.value = Math.min(Math.max(view.config.value, 0), 100);
+      this.label = `${view.config.label} (${Math.round(view.config.value)}%)`;
+      this.status = view.config.status;
+    }
+  }
+}
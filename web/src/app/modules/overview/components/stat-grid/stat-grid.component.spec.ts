@@ -0,0 +1,55 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { async, ComponentFixture, TestBed } from '@angular/core/testing';
+import { SimpleChange } from '@angular/core';
+import { By } from '@angular/platform-browser';
+
+import { StatGridComponent } from './stat-grid.component';
+import { StatGridView } from 'src/app/models/content';
+
+describe('StatGridComponent', () => {
+  let component: StatGridComponent;
+  let fixture: ComponentFixture<StatGridComponent>;
+
+  beforeEach(async(() => {
+    TestBed.configureTestingModule({
+      declarations: [StatGridComponent],
+    }).compileComponents();
+  }));
+
+  beforeEach(() => {
+    fixture = TestBed.createComponent(StatGridComponent);
+    component = fixture.componentInstance;
+  });
+
+  it('shows a value and label for each stat', () => {
+    const view: StatGridView = {
+      metadata: {
+        type: 'statGrid',
+        title: [{ metadata: { type: 'text' }, config: { value: 'Capacity' } }],
+      },
+      config: {
+        stats: [
+          { label: 'Nodes', value: '3' },
+          { label: 'Pods', value: '12' },
+        ],
+      },
+    };
+
+    component.view = view;
+    component.ngOnChanges({
+      view: new SimpleChange(null, view, true),
+    });
+    fixture.detectChanges();
+
+    expect(component.title).toEqual('Capacity');
+
+    const values = fixture.debugElement.queryAll(By.css('.stat-value'));
+    expect(values.map(value => value.nativeElement.textContent)).toEqual([
+      '3',
+      '12',
+    ]);
+  });
+});
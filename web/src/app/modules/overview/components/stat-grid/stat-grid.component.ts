@@ -0,0 +1,33 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+import { Component, Input, OnChanges, SimpleChanges } from '@angular/core';
+import { Stat, StatGridView } from 'src/app/models/content';
+import { ViewService } from '../../services/view/view.service';
+
+@Component({
+  selector: 'app-view-stat-grid',
+  templateUrl: './stat-grid.component.html',
+  styleUrls: ['./stat-grid.component.scss'],
+})
+export class StatGridComponent implements OnChanges {
+  @Input() view: StatGridView;
+
+  title: string;
+
+  stats: Stat[] = [];
+
+  constructor(private viewService: ViewService) {}
+
+  ngOnChanges(changes: SimpleChanges): void {
+    if (changes.view.currentValue) {
+      const view = changes.view.currentValue as StatGridView;
+      this.title = this.viewService.viewTitleAsText(view);
+      this.stats = view.config.stats || [];
+    }
+  }
+
+  trackByFn(index, item) {
+    return index;
+  }
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { async, ComponentFixture, TestBed } from '@angular/core/testing';
+import { SimpleChange } from '@angular/core';
+import { By } from '@angular/platform-browser';
+
+import { StatusTextComponent } from './status-text.component';
+import { StatusTextView } from 'src/app/models/content';
+
+describe('StatusTextComponent', () => {
+  let component: StatusTextComponent;
+  let fixture: ComponentFixture<StatusTextComponent>;
+
+  beforeEach(async(() => {
+    TestBed.configureTestingModule({
+      declarations: [StatusTextComponent],
+    }).compileComponents();
+  }));
+
+  beforeEach(() => {
+    fixture = TestBed.createComponent(StatusTextComponent);
+    component = fixture.componentInstance;
+  });
+
+  it('sets the status class', () => {
+    const view: StatusTextView = {
+      metadata: { type: 'statusText' },
+      config: { value: '0/3', status: 'error' },
+    };
+
+    component.view = view;
+    component.ngOnChanges({
+      view: new SimpleChange(null, view, true),
+    });
+    fixture.detectChanges();
+
+    const el = fixture.debugElement.query(By.css('.status-text'));
+    expect(el.nativeElement.textContent).toEqual('0/3');
+    expect(el.classes['status-error']).toBeTruthy();
+  });
+});
@@ -0,0 +1,28 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+import { Component, Input, OnChanges, SimpleChanges } from '@angular/core';
+import { StatusTextView } from 'src/app/models/content';
+
+@Component({
+  selector: 'app-view-status-text',
+  templateUrl: './status-text.component.html',
+  styleUrls: ['./status-text.component.scss'],
+})
+export class StatusTextComponent implements OnChanges {
+  @Input() view: StatusTextView;
+
+  value: string;
+
+  status: string;
+
+  constructor() {}
+
+  ngOnChanges(changes: SimpleChanges): void {
+    if (changes.view.currentValue) {
+      const view = changes.view.currentValue as StatusTextView;
+      this.value = view.config.value;
+      this.status = view.config.status;
+    }
+  }
+}
@@ -7,6 +7,7 @@ import { Action, SummaryItem, SummaryView } from 'src/app/models/content';
 import { FormGroup } from '@angular/forms';
 import { ActionService } from '../../services/action/action.service';
 import { ViewService } from '../../services/view/view.service';
+import { ExportService } from 'src/app/services/export/export.service';
 
 @Component({
   selector: 'app-view-summary',
@@ -22,7 +23,8 @@ export class SummaryComponent implements OnChanges {
 
   constructor(
     private actionService: ActionService,
-    private viewService: ViewService
+    private viewService: ViewService,
+    private exportService: ExportService
   ) {}
 
   ngOnChanges(changes: SimpleChanges): void {
@@ -36,6 +38,10 @@ export class SummaryComponent implements OnChanges {
     return `${index}-${item.header}`;
   }
 
+  copyAsMarkdown() {
+    this.exportService.copyAsMarkdown(this.view);
+  }
+
   onPortLoad(isLoading: boolean) {
     this.isLoading = isLoading;
   }
@@ -0,0 +1,54 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { async, ComponentFixture, TestBed } from '@angular/core/testing';
+import { SimpleChange } from '@angular/core';
+import { By } from '@angular/platform-browser';
+
+import { TerminalComponent } from './terminal.component';
+import { TerminalView } from 'src/app/models/content';
+
+describe('TerminalComponent', () => {
+  let component: TerminalComponent;
+  let fixture: ComponentFixture<TerminalComponent>;
+
+  beforeEach(async(() => {
+    TestBed.configureTestingModule({
+      declarations: [TerminalComponent],
+    }).compileComponents();
+  }));
+
+  beforeEach(() => {
+    fixture = TestBed.createComponent(TerminalComponent);
+    component = fixture.componentInstance;
+  });
+
+  it('shows the session target and command', () => {
+    const view: TerminalView = {
+      metadata: { type: 'terminal' },
+      config: {
+        session: {
+          namespace: 'default',
+          pod: 'pod',
+          container: 'app',
+          command: ['/bin/sh', '-c', 'ls'],
+          tty: true,
+        },
+        token: 'namespace/default/pod/pod/container/app?command=%2Fbin%2Fsh',
+      },
+    };
+
+    component.view = view;
+    component.ngOnChanges({
+      view: new SimpleChange(null, view, true),
+    });
+    fixture.detectChanges();
+
+    expect(component.target).toEqual('default/pod/app');
+    expect(component.command).toEqual('/bin/sh -c ls');
+
+    const body = fixture.debugElement.query(By.css('.terminal-body'));
+    expect(body.nativeElement.textContent).toContain('/bin/sh -c ls');
+  });
+});
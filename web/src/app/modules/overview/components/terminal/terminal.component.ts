@@ -0,0 +1,37 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+import { Component, Input, OnChanges, SimpleChanges } from '@angular/core';
+import { TerminalView } from 'src/app/models/content';
+import { ViewService } from '../../services/view/view.service';
+
+@Component({
+  selector: 'app-view-terminal',
+  templateUrl: './terminal.component.html',
+  styleUrls: ['./terminal.component.scss'],
+})
+export class TerminalComponent implements OnChanges {
+  @Input() view: TerminalView;
+
+  title: string;
+
+  target: string;
+
+  command: string;
+
+  tty = false;
+
+  constructor(private viewService: ViewService) {}
+
+  ngOnChanges(changes: SimpleChanges): void {
+    if (changes.view.currentValue) {
+      const view = changes.view.currentValue as TerminalView;
+      const session = view.config.session;
+
+      this.title = this.viewService.viewTitleAsText(view);
+      this.target = `${session.namespace}/${session.pod}/${session.container}`;
+      this.command = (session.command || []).join(' ');
+      this.tty = !!session.tty;
+    }
+  }
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { async, ComponentFixture, TestBed } from '@angular/core/testing';
+import { SimpleChange } from '@angular/core';
+import { By } from '@angular/platform-browser';
+
+import { TimeseriesComponent } from './timeseries.component';
+import { TimeseriesView } from 'src/app/models/content';
+
+describe('TimeseriesComponent', () => {
+  let component: TimeseriesComponent;
+  let fixture: ComponentFixture<TimeseriesComponent>;
+
+  beforeEach(async(() => {
+    TestBed.configureTestingModule({
+      declarations: [TimeseriesComponent],
+    }).compileComponents();
+  }));
+
+  beforeEach(() => {
+    fixture = TestBed.createComponent(TimeseriesComponent);
+    component = fixture.componentInstance;
+  });
+
+  const render = (view: TimeseriesView) => {
+    component.view = view;
+    component.ngOnChanges({
+      view: new SimpleChange(null, view, true),
+    });
+    fixture.detectChanges();
+  };
+
+  it('scales each series to the chart', () => {
+    render({
+      metadata: { type: 'timeseries' },
+      config: {
+        series: [
+          {
+            name: 'usage',
+            points: [
+              { timestamp: 20, value: 2 },
+              { timestamp: 10, value: 1 },
+            ],
+          },
+          { name: 'limit', points: [{ timestamp: 10, value: 4 }] },
+        ],
+        unit: 'cores',
+      },
+    });
+
+    expect(component.max).toEqual(4);
+    expect(component.lines).toEqual([
+      { name: 'usage', points: '0,22.5 100,15', latest: 2 },
+      { name: 'limit', points: '0,0', latest: 4 },
+    ]);
+
+    const legend = fixture.debugElement.queryAll(
+      By.css('.timeseries-legend-item')
+    );
+    expect(legend.length).toEqual(2);
+  });
+
+  it('hides the legend for sparklines', () => {
+    render({
+      metadata: { type: 'timeseries' },
+      config: {
+        series: [{ name: 'cpu', points: [{ timestamp: 10, value: 1 }] }],
+        sparkline: true,
+      },
+    });
+
+    expect(
+      fixture.debugElement.query(By.css('.timeseries-legend'))
+    ).toBeNull();
+  });
+});
@@ -0,0 +1,84 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+import { Component, Input, OnChanges, SimpleChanges } from '@angular/core';
+import { TimeseriesView } from 'src/app/models/content';
+import { ViewService } from '../../services/view/view.service';
+
+interface Line {
+  name: string;
+  points: string;
+  latest: number;
+}
+
+// Dimensions of the chart's view box. Points are scaled to fit.
+const width = 100;
+const height = 30;
+
+@Component({
+  selector: 'app-view-timeseries',
+  templateUrl: './timeseries.component.html',
+  styleUrls: ['./timeseries.component.scss'],
+})
+export class TimeseriesComponent implements OnChanges {
+  @Input() view: TimeseriesView;
+
+  width = width;
+
+  height = height;
+
+  title: string;
+
+  lines: Line[] = [];
+
+  max = 0;
+
+  unit: string;
+
+  sparkline = false;
+
+  constructor(private viewService: ViewService) {}
+
+  ngOnChanges(changes: SimpleChanges): void {
+    if (changes.view.currentValue) {
+      const view = changes.view.currentValue as TimeseriesView;
+      const series = view.config.series || [];
+
+      this.title = this.viewService.viewTitleAsText(view);
+      this.unit = view.config.unit;
+      this.sparkline = !!view.config.sparkline;
+
+      const points = series.reduce((all, s) => all.concat(s.points || []), []);
+      const timestamps = points.map(point => point.timestamp);
+      const start = Math.min(...timestamps);
+      const end = Math.max(...timestamps);
+
+      // Values are graphed from zero so usage is not exaggerated.
+      this.max = Math.max(0, ...points.map(point => point.value));
+
+      const x = (timestamp: number) =>
+        end > start ? ((timestamp - start) / (end - start)) * width : width;
+      const y = (value: number) =>
+        this.max > 0 ? height - (value / this.max) * height : height;
+
+      this.lines = series
+        .filter(s => s.points && s.points.length > 0)
+        .map(s => {
+          const sorted = [...s.points].sort(
+            (a, b) => a.timestamp - b.timestamp
+          );
+          return {
+            name: s.name,
+            points: sorted
+              .map(point => `${x(point.timestamp)},${y(point.value)}`)
+              .join(' '),
+            latest: sorted[sorted.length - 1].value,
+          };
+        });
+    }
+  }
+
+  trackByFn(index, item) {
+    return index;
+  }
+}
@@ -3,7 +3,9 @@
 //
 
 import { async, ComponentFixture, TestBed } from '@angular/core/testing';
+import { SimpleChange } from '@angular/core';
 
+import { TimestampView } from 'src/app/models/content';
 import { OverviewModule } from '../../overview.module';
 import { TimestampComponent } from './timestamp.component';
 
@@ -26,4 +28,18 @@ describe('TimestampComponent', () => {
   it('should create', () => {
     expect(component).toBeTruthy();
   });
+
+  it('shows absolute timestamps as a date', () => {
+    const view: TimestampView = {
+      metadata: { type: 'timestamp' },
+      config: { timestamp: 0, relative: '18000d', display: 'absolute' },
+    };
+    component.view = view;
+    component.ngOnChanges({ view: new SimpleChange(null, view, true) });
+    fixture.detectChanges();
+
+    expect(component.absolute).toBeTruthy();
+    const trigger: HTMLElement = fixture.nativeElement.querySelector('span');
+    expect(trigger.textContent).toEqual(component.humanReadable);
+  });
 });
@@ -16,6 +16,7 @@ export class TimestampComponent implements OnChanges {
 
   humanReadable: string;
   age: string;
+  absolute = false;
 
   constructor() {}
 
@@ -28,6 +29,7 @@ export class TimestampComponent implements OnChanges {
         .utcOffset('+0000')
         .format('LLLL z');
       this.age = this.summarizeTimestamp(timestamp);
+      this.absolute = view.config.display === 'absolute';
     }
   }
 
@@ -0,0 +1,61 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+import { SimpleChange } from '@angular/core';
+import { async, ComponentFixture, TestBed } from '@angular/core/testing';
+
+import { TabsView } from '../../../../models/content';
+import { OverviewModule } from '../../overview.module';
+import { ViewTabsComponent } from './view-tabs.component';
+
+describe('ViewTabsComponent', () => {
+  let component: ViewTabsComponent;
+  let fixture: ComponentFixture<ViewTabsComponent>;
+
+  const view: TabsView = {
+    metadata: { type: 'tabs' },
+    config: {
+      tabs: [
+        {
+          name: 'Conditions',
+          contents: { metadata: { type: 'text' }, config: { value: 'ready' } },
+        },
+        {
+          name: 'Events',
+          contents: { metadata: { type: 'text' }, config: { value: 'none' } },
+        },
+      ],
+    },
+  };
+
+  beforeEach(async(() => {
+    TestBed.configureTestingModule({
+      imports: [OverviewModule],
+    }).compileComponents();
+  }));
+
+  beforeEach(() => {
+    fixture = TestBed.createComponent(ViewTabsComponent);
+    component = fixture.componentInstance;
+  });
+
+  const setView = (v: TabsView) => {
+    component.view = v;
+    component.ngOnChanges({
+      view: new SimpleChange(undefined, v, false),
+    });
+    fixture.detectChanges();
+  };
+
+  it('selects the first tab', () => {
+    setView(view);
+    expect(component.activeTab).toEqual('Conditions');
+  });
+
+  it('keeps the selected tab when the view is updated', () => {
+    setView(view);
+    component.clickTab('Events');
+    setView({ ...view });
+    expect(component.activeTab).toEqual('Events');
+  });
+});
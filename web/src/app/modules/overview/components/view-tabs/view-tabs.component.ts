@@ -0,0 +1,39 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+import { Component, Input, OnChanges, SimpleChanges } from '@angular/core';
+import { TabsItem, TabsView } from 'src/app/models/content';
+
+@Component({
+  selector: 'app-view-tabs',
+  templateUrl: './view-tabs.component.html',
+  styleUrls: ['./view-tabs.component.scss'],
+})
+export class ViewTabsComponent implements OnChanges {
+  @Input() view: TabsView;
+
+  tabs: TabsItem[] = [];
+  activeTab: string;
+
+  constructor() {}
+
+  ngOnChanges(changes: SimpleChanges): void {
+    if (changes.view.currentValue) {
+      const view = changes.view.currentValue as TabsView;
+      this.tabs = view.config.tabs || [];
+
+      const names = this.tabs.map(tab => tab.name);
+      if (names.indexOf(this.activeTab) < 0 && names.length > 0) {
+        this.activeTab = names[0];
+      }
+    }
+  }
+
+  identifyTab(index: number, item: TabsItem): string {
+    return item.name;
+  }
+
+  clickTab(name: string) {
+    this.activeTab = name;
+  }
+}
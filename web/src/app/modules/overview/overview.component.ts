@@ -10,11 +10,19 @@ import {
   ViewChild,
 } from '@angular/core';
 import { ActivatedRoute, Params, Router, UrlSegment } from '@angular/router';
-import { ContentResponse, View } from 'src/app/models/content';
+import {
+  BreadcrumbView,
+  ContentResponse,
+  Siblings,
+  View,
+} from 'src/app/models/content';
 import { IconService } from './services/icon.service';
 import { ViewService } from './services/view/view.service';
 import { BehaviorSubject, combineLatest } from 'rxjs';
-import { ContentService } from './services/content/content.service';
+import {
+  ContentError,
+  ContentService,
+} from './services/content/content.service';
 import { WebsocketService } from './services/websocket/websocket.service';
 import { KubeContextService } from './services/kube-context/kube-context.service';
 import { take } from 'rxjs/operators';
@@ -48,6 +56,9 @@ export class OverviewComponent implements OnInit, OnDestroy {
   title: string = null;
   views: View[] = null;
   singleView: View = null;
+  breadcrumb: BreadcrumbView = null;
+  siblings: Siblings = null;
+  contentError: ContentError = null;
   private previousUrl = '';
   private iconName: string;
   private defaultPath: string;
@@ -65,6 +76,9 @@ export class OverviewComponent implements OnInit, OnDestroy {
     this.contentService.current.subscribe(contentResponse => {
       this.setContent(contentResponse);
     });
+    this.contentService.error.subscribe(contentError => {
+      this.contentError = contentError;
+    });
   }
 
   ngOnInit() {
@@ -84,6 +98,19 @@ export class OverviewComponent implements OnInit, OnDestroy {
     this.resetView();
   }
 
+  errorTitle(contentError: ContentError): string {
+    switch (contentError.type) {
+      case 'Forbidden':
+        return 'You do not have access to this content';
+      case 'Timeout':
+        return 'Timed out loading this content';
+      case 'PluginFailure':
+        return 'A plugin failed to print this content';
+      default:
+        return 'Unable to load this content';
+    }
+  }
+
   private withCurrentLocation(
     callback: (options: LocationCallbackOptions) => void,
     takeOne = false
@@ -135,6 +162,8 @@ export class OverviewComponent implements OnInit, OnDestroy {
     this.title = null;
     this.singleView = null;
     this.views = null;
+    this.breadcrumb = null;
+    this.siblings = null;
     this.hasReceivedContent = false;
   }
 
@@ -155,6 +184,8 @@ export class OverviewComponent implements OnInit, OnDestroy {
       this.singleView = views[0];
     }
 
+    this.breadcrumb = contentResponse.content.breadcrumb || null;
+    this.siblings = contentResponse.content.siblings || null;
     this.hasReceivedContent = true;
     this.iconName = this.iconService.load(contentResponse.content);
   };
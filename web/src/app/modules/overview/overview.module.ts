@@ -6,17 +6,27 @@ import { NgModule } from '@angular/core';
 import { FormsModule, ReactiveFormsModule } from '@angular/forms';
 import { RouterModule } from '@angular/router';
 import { ClarityModule } from '@clr/angular';
+import bash from 'highlight.js/lib/languages/bash';
+import ini from 'highlight.js/lib/languages/ini';
+import javascript from 'highlight.js/lib/languages/javascript';
 import json from 'highlight.js/lib/languages/json';
+import properties from 'highlight.js/lib/languages/properties';
+import python from 'highlight.js/lib/languages/python';
+import xml from 'highlight.js/lib/languages/xml';
 import yaml from 'highlight.js/lib/languages/yaml';
 import { HighlightModule } from 'ngx-highlightjs';
 import { MarkdownModule } from 'ngx-markdown';
 
 import { AnnotationsComponent } from './components/annotations/annotations.component';
+import { BannerComponent } from './components/banner/banner.component';
+import { BreadcrumbComponent } from './components/breadcrumb/breadcrumb.component';
 import { CardListComponent } from './components/card-list/card-list.component';
 import { CardComponent } from './components/card/card.component';
+import { CodeComponent } from './components/code/code.component';
 import { ContainersComponent } from './components/containers/containers.component';
 import { ContentSwitcherComponent } from './components/content-switcher/content-switcher.component';
 import { ContextSelectorComponent } from './components/context-selector/context-selector.component';
+import { EventFilterSelectorComponent } from './components/event-filter-selector/event-filter-selector.component';
 import { CytoscapeComponent } from './components/cytoscape/cytoscape.component';
 import { DatagridComponent } from './components/datagrid/datagrid.component';
 import { ErrorComponent } from './components/error/error.component';
@@ -34,7 +44,9 @@ import { LabelsComponent } from './components/labels/labels.component';
 import { LinkComponent } from './components/link/link.component';
 import { ListComponent } from './components/list/list.component';
 import { LoadingComponent } from './components/loading/loading.component';
+import { MarkdownComponent } from './components/markdown/markdown.component';
 import { LogsComponent } from './components/logs/logs.component';
+import { OperationsComponent } from './components/operations/operations.component';
 import { ObjectStatusComponent } from './components/object-status/object-status.component';
 import { PodStatusComponent } from './components/pod-status/pod-status.component';
 import { PortForwardComponent } from './components/port-forward/port-forward.component';
@@ -45,7 +57,15 @@ import { SelectorsComponent } from './components/selectors/selectors.component';
 import { SummaryComponent } from './components/summary/summary.component';
 import { TableComponent } from './components/table/table.component';
 import { TabsComponent } from './components/tabs/tabs.component';
+import { ViewTabsComponent } from './components/view-tabs/view-tabs.component';
+import { StatusTextComponent } from './components/status-text/status-text.component';
+import { DiffComponent } from './components/diff/diff.component';
+import { DonutChartComponent } from './components/donut-chart/donut-chart.component';
+import { ProgressComponent } from './components/progress/progress.component';
+import { StatGridComponent } from './components/stat-grid/stat-grid.component';
 import { TextComponent } from './components/text/text.component';
+import { TimeseriesComponent } from './components/timeseries/timeseries.component';
+import { TerminalComponent } from './components/terminal/terminal.component';
 import { TimestampComponent } from './components/timestamp/timestamp.component';
 import { YamlComponent } from './components/yaml/yaml.component';
 import { OverviewComponent } from './overview.component';
@@ -55,12 +75,24 @@ import { AlertComponent } from './components/alert/alert.component';
 import { ContentFilterComponent } from './components/content-filter/content-filter.component';
 
 export function hljsLanguages() {
-  return [{ name: 'yaml', func: yaml }, { name: 'json', func: json }];
+  return [
+    { name: 'yaml', func: yaml },
+    { name: 'json', func: json },
+    { name: 'xml', func: xml },
+    { name: 'ini', func: ini },
+    { name: 'properties', func: properties },
+    { name: 'bash', func: bash },
+    { name: 'python', func: python },
+    { name: 'javascript', func: javascript },
+  ];
 }
 
 @NgModule({
   declarations: [
     AnnotationsComponent,
+    BannerComponent,
+    BreadcrumbComponent,
+    CodeComponent,
     ContainersComponent,
     DatagridComponent,
     ExpressionSelectorComponent,
@@ -79,7 +111,14 @@ export function hljsLanguages() {
     SummaryComponent,
     TableComponent,
     TabsComponent,
+    StatusTextComponent,
+    DiffComponent,
+    DonutChartComponent,
+    ProgressComponent,
+    StatGridComponent,
     TextComponent,
+    TimeseriesComponent,
+    TerminalComponent,
     TimestampComponent,
     YamlComponent,
     OverviewComponent,
@@ -94,6 +133,7 @@ export function hljsLanguages() {
     HeptagonComponent,
     HeptagonLabelComponent,
     ContextSelectorComponent,
+    EventFilterSelectorComponent,
     DefaultPipe,
     FormComponent,
     CardListComponent,
@@ -102,6 +142,9 @@ export function hljsLanguages() {
     ButtonGroupComponent,
     AlertComponent,
     ContentFilterComponent,
+    OperationsComponent,
+    MarkdownComponent,
+    ViewTabsComponent,
   ],
   imports: [
     CommonModule,
@@ -115,6 +158,10 @@ export function hljsLanguages() {
     ReactiveFormsModule,
     MarkdownModule.forChild(),
   ],
-  exports: [ContextSelectorComponent, DefaultPipe],
+  exports: [
+    ContextSelectorComponent,
+    EventFilterSelectorComponent,
+    DefaultPipe,
+  ],
 })
 export class OverviewModule {}
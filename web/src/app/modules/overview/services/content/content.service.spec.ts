@@ -6,6 +6,8 @@
 import { TestBed } from '@angular/core/testing';
 
 import {
+  ContentError,
+  ContentErrorMessage,
   ContentService,
   ContentUpdate,
   ContentUpdateMessage,
@@ -70,6 +72,28 @@ describe('ContentService', () => {
     });
   });
 
+  describe('content error', () => {
+    const contentError: ContentError = {
+      type: 'Forbidden',
+      message: 'access denied',
+      contentPath: '/path',
+    };
+
+    it('sets the error until content is updated', () => {
+      const backendService = TestBed.get(WebsocketService);
+      backendService.triggerHandler(ContentErrorMessage, contentError);
+      expect(service.error.getValue()).toEqual(contentError);
+
+      backendService.triggerHandler(ContentUpdateMessage, {
+        content: { title: [], viewComponents: [] },
+        namespace: 'default',
+        contentPath: '/path',
+        queryParams: {},
+      });
+      expect(service.error.getValue()).toBeUndefined();
+    });
+  });
+
   describe('label filters updated', () => {
     let labelFilterService: LabelFilterService;
 
@@ -10,6 +10,22 @@ import {
 import { NamespaceService } from '../../../../services/namespace/namespace.service';
 
 export const ContentUpdateMessage = 'content';
+export const ContentErrorMessage = 'contentError';
+
+// ErrorType is the code of a typed error from the backend.
+export type ErrorType =
+  | 'Unknown'
+  | 'NotFound'
+  | 'Forbidden'
+  | 'Timeout'
+  | 'Conversion'
+  | 'PluginFailure';
+
+export interface ContentError {
+  type: ErrorType;
+  message: string;
+  contentPath: string;
+}
 
 export interface ContentUpdate {
   content: Content;
@@ -28,6 +44,7 @@ const emptyContentResponse: ContentResponse = {
 export class ContentService {
   defaultPath = new BehaviorSubject<string>('');
   current = new BehaviorSubject<ContentResponse>(emptyContentResponse);
+  error = new BehaviorSubject<ContentError>(undefined);
 
   private previousContentPath = '';
 
@@ -44,6 +61,7 @@ export class ContentService {
   ) {
     websocketService.registerHandler(ContentUpdateMessage, data => {
       const response = data as ContentUpdate;
+      this.error.next(undefined);
       this.setContent(response.content);
       namespaceService.setNamespace(response.namespace);
 
@@ -61,6 +79,10 @@ export class ContentService {
       }
     });
 
+    websocketService.registerHandler(ContentErrorMessage, data => {
+      this.error.next(data as ContentError);
+    });
+
     labelFilterService.filters.subscribe(filters => {
       this.filters = filters;
     });
@@ -32,6 +32,7 @@ interface Alert {
   type: NotifierSignalType;
   message: string;
   expiration?: string;
+  link?: string;
 }
 
 @Injectable({
@@ -51,7 +52,11 @@ export class WebsocketService implements BackendService {
 
     this.registerHandler('alert', data => {
       const alert = data as Alert;
-      const id = this.notifierSession.pushSignal(alert.type, alert.message);
+      const id = this.notifierSession.pushSignal(
+        alert.type,
+        alert.message,
+        alert.link
+      );
       if (alert.expiration) {
         const expiration = new Date(alert.expiration);
         const diff = expiration.getTime() - Date.now();
@@ -0,0 +1,47 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { inject, TestBed } from '@angular/core/testing';
+import { EditResult, EditResultsService } from './edit-results.service';
+import {
+  BackendService,
+  WebsocketService,
+} from '../../modules/overview/services/websocket/websocket.service';
+import { WebsocketServiceMock } from '../../modules/overview/services/websocket/mock';
+
+describe('EditResultsService', () => {
+  beforeEach(() => {
+    TestBed.configureTestingModule({
+      providers: [
+        EditResultsService,
+        {
+          provide: WebsocketService,
+          useClass: WebsocketServiceMock,
+        },
+      ],
+    });
+  });
+
+  it('emits results for an edit', inject(
+    [EditResultsService, WebsocketService],
+    (svc: EditResultsService, backendService: BackendService) => {
+      const results: EditResult[] = [];
+      svc.forEdit('edit').subscribe(result => results.push(result));
+
+      backendService.triggerHandler('editResult', {
+        id: 'other',
+        applied: true,
+        message: 'applied',
+      });
+      backendService.triggerHandler('editResult', {
+        id: 'edit',
+        applied: false,
+        message: 'rejected',
+      });
+
+      expect(results.length).toEqual(1);
+      expect(results[0].message).toEqual('rejected');
+    }
+  ));
+});
@@ -0,0 +1,39 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { Injectable } from '@angular/core';
+import { Observable, Subject } from 'rxjs';
+import { filter } from 'rxjs/operators';
+import { WebsocketService } from '../../modules/overview/services/websocket/websocket.service';
+
+export interface EditCause {
+  field?: string;
+  message: string;
+}
+
+export interface EditResult {
+  id: string;
+  applied: boolean;
+  message: string;
+  causes?: EditCause[];
+  conflict?: boolean;
+}
+
+@Injectable({
+  providedIn: 'root',
+})
+export class EditResultsService {
+  private results = new Subject<EditResult>();
+
+  constructor(websocketService: WebsocketService) {
+    websocketService.registerHandler('editResult', data => {
+      this.results.next(data as EditResult);
+    });
+  }
+
+  // forEdit returns the results of submitting the edit with an ID.
+  forEdit(id: string): Observable<EditResult> {
+    return this.results.pipe(filter(result => result.id === id));
+  }
+}
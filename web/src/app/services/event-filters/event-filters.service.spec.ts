@@ -0,0 +1,111 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { inject, TestBed } from '@angular/core/testing';
+import {
+  EventFiltersService,
+  eventFiltersStorageKey,
+} from './event-filters.service';
+import {
+  BackendService,
+  WebsocketService,
+} from '../../modules/overview/services/websocket/websocket.service';
+import { WebsocketServiceMock } from '../../modules/overview/services/websocket/mock';
+
+describe('EventFiltersService', () => {
+  beforeEach(() => {
+    localStorage.removeItem(eventFiltersStorageKey);
+
+    TestBed.configureTestingModule({
+      providers: [
+        EventFiltersService,
+        {
+          provide: WebsocketService,
+          useClass: WebsocketServiceMock,
+        },
+      ],
+    });
+  });
+
+  afterEach(() => {
+    localStorage.removeItem(eventFiltersStorageKey);
+  });
+
+  describe('event filters update', () => {
+    it('triggers the active subject', inject(
+      [EventFiltersService, WebsocketService],
+      (svc: EventFiltersService, backendService: BackendService) => {
+        backendService.triggerHandler('eventFilters', {
+          presets: ['hide-normal'],
+          defaults: ['hide-normal'],
+        });
+        svc.active.subscribe(current =>
+          expect(current).toEqual(['hide-normal'])
+        );
+      }
+    ));
+
+    it('sends the stored override to the backend', inject(
+      [EventFiltersService, WebsocketService],
+      (svc: EventFiltersService, backendService: BackendService) => {
+        localStorage.setItem(
+          eventFiltersStorageKey,
+          JSON.stringify(['hide-probes'])
+        );
+        spyOn(backendService, 'sendMessage');
+
+        backendService.triggerHandler('eventFilters', {
+          presets: [],
+          defaults: [],
+        });
+
+        expect(backendService.sendMessage).toHaveBeenCalledWith(
+          'setEventFilters',
+          { presets: ['hide-probes'] }
+        );
+      }
+    ));
+  });
+
+  describe('toggle', () => {
+    it('stores and sends the presets', inject(
+      [EventFiltersService, WebsocketService],
+      (svc: EventFiltersService, backendService: BackendService) => {
+        spyOn(backendService, 'sendMessage');
+
+        svc.toggle('hide-probes');
+
+        expect(backendService.sendMessage).toHaveBeenCalledWith(
+          'setEventFilters',
+          { presets: ['hide-probes'] }
+        );
+        expect(localStorage.getItem(eventFiltersStorageKey)).toEqual(
+          '["hide-probes"]'
+        );
+      }
+    ));
+  });
+
+  describe('reset', () => {
+    it('removes the override and sends the defaults', inject(
+      [EventFiltersService, WebsocketService],
+      (svc: EventFiltersService, backendService: BackendService) => {
+        backendService.triggerHandler('eventFilters', {
+          presets: ['hide-normal'],
+          defaults: ['hide-normal'],
+        });
+        svc.toggle('hide-normal');
+        spyOn(backendService, 'sendMessage');
+
+        svc.reset();
+
+        expect(backendService.sendMessage).toHaveBeenCalledWith(
+          'setEventFilters',
+          { presets: ['hide-normal'] }
+        );
+        expect(localStorage.getItem(eventFiltersStorageKey)).toBeNull();
+      }
+    ));
+  });
+});
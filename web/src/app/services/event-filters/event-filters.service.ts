@@ -0,0 +1,85 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { Injectable } from '@angular/core';
+import { BehaviorSubject } from 'rxjs';
+import { WebsocketService } from '../../modules/overview/services/websocket/websocket.service';
+
+export interface EventFilterPreset {
+  name: string;
+  description: string;
+}
+
+export const eventFilterPresets: EventFilterPreset[] = [
+  { name: 'hide-normal', description: 'Hide normal events' },
+  { name: 'hide-image-pull', description: 'Hide image pull events' },
+  { name: 'hide-probes', description: 'Hide probe events' },
+];
+
+export interface UpdateEventFiltersMessage {
+  presets: string[];
+  defaults: string[];
+}
+
+export const eventFiltersStorageKey = 'octant.eventFilters';
+
+@Injectable({
+  providedIn: 'root',
+})
+export class EventFiltersService {
+  active = new BehaviorSubject<string[]>([]);
+  private defaults: string[] = [];
+
+  constructor(private websocketService: WebsocketService) {
+    websocketService.registerHandler('eventFilters', data => {
+      const update = data as UpdateEventFiltersMessage;
+      this.defaults = update.defaults || [];
+
+      const override = this.loadOverride();
+      if (override && !sameValues(override, update.presets || [])) {
+        this.send(override);
+        return;
+      }
+
+      this.active.next(update.presets || []);
+    });
+  }
+
+  toggle(preset: string) {
+    const current = this.active.getValue();
+    const presets = current.includes(preset)
+      ? current.filter(cur => cur !== preset)
+      : [...current, preset];
+
+    localStorage.setItem(eventFiltersStorageKey, JSON.stringify(presets));
+    this.send(presets);
+  }
+
+  reset() {
+    localStorage.removeItem(eventFiltersStorageKey);
+    this.send(this.defaults);
+  }
+
+  private send(presets: string[]) {
+    this.active.next(presets);
+    this.websocketService.sendMessage('setEventFilters', { presets });
+  }
+
+  private loadOverride(): string[] {
+    const stored = localStorage.getItem(eventFiltersStorageKey);
+    if (!stored) {
+      return undefined;
+    }
+
+    try {
+      const presets = JSON.parse(stored);
+      return Array.isArray(presets) ? presets : undefined;
+    } catch (e) {
+      return undefined;
+    }
+  }
+}
+
+const sameValues = (a: string[], b: string[]) =>
+  a.length === b.length && a.every(value => b.includes(value));
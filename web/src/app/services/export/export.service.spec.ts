@@ -0,0 +1,54 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { TestBed } from '@angular/core/testing';
+import { HttpTestingController } from '@angular/common/http/testing';
+
+import { ExportService } from './export.service';
+import { View } from 'src/app/models/content';
+
+describe('ExportService', () => {
+  let service: ExportService;
+  let httpTestingController: HttpTestingController;
+
+  const view: View = { metadata: { type: 'summary' } };
+
+  beforeEach(() => {
+    TestBed.configureTestingModule({
+      providers: [ExportService],
+    });
+
+    service = TestBed.get(ExportService);
+    httpTestingController = TestBed.get(HttpTestingController);
+  });
+
+  afterEach(() => {
+    httpTestingController.verify();
+  });
+
+  it('posts the view to the export API', () => {
+    let result: string;
+    service.export(view).subscribe(text => (result = text));
+
+    const req = httpTestingController.expectOne(r =>
+      r.url.endsWith('api/v1/export?format=markdown')
+    );
+    expect(req.request.method).toEqual('POST');
+    expect(req.request.body).toEqual(view);
+    req.flush('| Field | Value |');
+
+    expect(result).toEqual('| Field | Value |');
+  });
+
+  it('builds a table download URL from the router URL', () => {
+    const url = service.tableURL(
+      '/overview/namespace/default/workloads/pods?search=nginx&offset=10&limit=10&continue=token',
+      'json'
+    );
+
+    expect(url).toMatch(
+      /api\/v1\/export\/table\?search=nginx&path=overview%2Fnamespace%2Fdefault%2Fworkloads%2Fpods&format=json$/
+    );
+  });
+});
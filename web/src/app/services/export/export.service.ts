@@ -0,0 +1,45 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { Injectable } from '@angular/core';
+import { HttpClient } from '@angular/common/http';
+import { Observable } from 'rxjs';
+import { View } from 'src/app/models/content';
+import getAPIBase from '../common/getAPIBase';
+
+const API_BASE = getAPIBase();
+
+@Injectable({
+  providedIn: 'root',
+})
+export class ExportService {
+  constructor(private http: HttpClient) {}
+
+  // export formats a view, e.g. a summary or table, using the export API.
+  export(view: View, format = 'markdown'): Observable<string> {
+    const url = [API_BASE, 'api/v1', `export?format=${format}`].join('/');
+    return this.http.post(url, view, { responseType: 'text' });
+  }
+
+  // tableURL is the address of a download of the table shown at a router
+  // URL. The router URL's query params, e.g. the search, narrow the rows,
+  // but its page is dropped so every matching row is downloaded.
+  tableURL(routerURL: string, format = 'csv'): string {
+    const [path, query] = routerURL.split('?');
+    const params = new URLSearchParams(query || '');
+    params.delete('offset');
+    params.delete('limit');
+    params.delete('continue');
+    params.set('path', path.replace(/^\//, ''));
+    params.set('format', format);
+
+    return [API_BASE, 'api/v1', `export/table?${params.toString()}`].join('/');
+  }
+
+  copyAsMarkdown(view: View): Promise<void> {
+    return this.export(view)
+      .toPromise()
+      .then(text => (navigator as any).clipboard.writeText(text));
+  }
+}
@@ -53,5 +53,21 @@ describe('NamespaceService', () => {
         );
       }
     ));
+
+    it('triggers the rollups subject', inject(
+      [NamespaceService, WebsocketService],
+      (svc: NamespaceService, backendService: BackendService) => {
+        const rollups = {
+          foo: { workloads: 2, podsNotReady: 1, warningEvents: 3 },
+        };
+        backendService.triggerHandler('namespaces', {
+          namespaces: ['foo', 'bar'],
+          rollups,
+        });
+        svc.namespaceRollups.subscribe(current =>
+          expect(current).toEqual(rollups)
+        );
+      }
+    ));
   });
 });
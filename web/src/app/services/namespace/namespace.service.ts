@@ -9,6 +9,7 @@ import { BehaviorSubject } from 'rxjs';
 import { NotifierService, NotifierSession } from '../notifier/notifier.service';
 import { WebsocketService } from '../../modules/overview/services/websocket/websocket.service';
 import { take } from 'rxjs/operators';
+import { NamespaceRollups } from '../../models/namespace';
 
 interface UpdateNamespaceMessage {
   namespace: string;
@@ -16,6 +17,7 @@ interface UpdateNamespaceMessage {
 
 export interface UpdateNamespacesMessage {
   namespaces: [];
+  rollups?: NamespaceRollups;
 }
 
 @Injectable({
@@ -24,6 +26,7 @@ export interface UpdateNamespacesMessage {
 export class NamespaceService {
   activeNamespace = new BehaviorSubject<string>('');
   availableNamespaces = new BehaviorSubject<string[]>([]);
+  namespaceRollups = new BehaviorSubject<NamespaceRollups>({});
 
   constructor(
     private router: Router,
@@ -33,6 +36,7 @@ export class NamespaceService {
     websocketService.registerHandler('namespaces', data => {
       const update = data as UpdateNamespacesMessage;
       this.availableNamespaces.next(update.namespaces);
+      this.namespaceRollups.next(update.rollups || {});
     });
 
     this.activeNamespace.subscribe(namespace => {
@@ -160,4 +160,25 @@ describe('NotifierService', () => {
     ];
     expect(currentObservedSignals).toEqual(currentExpectedSignals);
   });
+
+  it('should include a link when one is given', () => {
+    const service = new NotifierService();
+
+    const warningSignalID = service.pushSignal(
+      NotifierSignalType.WARNING,
+      'deployment is stuck',
+      '/overview/namespace/default/workloads/deployments/app'
+    );
+
+    const expectedSignals: Array<NotifierSignal> = [
+      {
+        id: warningSignalID,
+        sessionID: 'baseSignal',
+        type: NotifierSignalType.WARNING,
+        data: 'deployment is stuck',
+        link: '/overview/namespace/default/workloads/deployments/app',
+      },
+    ];
+    expect(service.globalSignalsStream.getValue()).toEqual(expectedSignals);
+  });
 });
@@ -18,6 +18,7 @@ export interface NotifierSignal {
   sessionID: string;
   type: NotifierSignalType;
   data: boolean | string;
+  link?: string;
 }
 
 export class NotifierSession {
@@ -30,15 +31,22 @@ export class NotifierSession {
     this.id = uniqueIDPrefix;
   }
 
-  pushSignal(type: NotifierSignalType, data: boolean | string): string {
+  pushSignal(
+    type: NotifierSignalType,
+    data: boolean | string,
+    link?: string
+  ): string {
     const currentSignals = this.globalSignalsStream.getValue();
     const newSignalID = _.uniqueId(this.uniqueIDPrefix);
-    const newSignal = {
+    const newSignal: NotifierSignal = {
       id: newSignalID,
       sessionID: this.uniqueIDPrefix,
       type,
       data,
     };
+    if (link) {
+      newSignal.link = link;
+    }
     this.globalSignalsStream.next([...currentSignals, newSignal]);
     return newSignalID;
   }
@@ -91,8 +99,12 @@ export class NotifierService {
     );
   }
 
-  pushSignal(type: NotifierSignalType, data: boolean | string): string {
-    return this.baseSignalSession.pushSignal(type, data);
+  pushSignal(
+    type: NotifierSignalType,
+    data: boolean | string,
+    link?: string
+  ): string {
+    return this.baseSignalSession.pushSignal(type, data, link);
   }
 
   removeSignal(id: string): boolean {
@@ -0,0 +1,67 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { fakeAsync, inject, TestBed, tick } from '@angular/core/testing';
+import {
+  finishedOperationTimeout,
+  OperationsService,
+} from './operations.service';
+import {
+  BackendService,
+  WebsocketService,
+} from '../../modules/overview/services/websocket/websocket.service';
+import { WebsocketServiceMock } from '../../modules/overview/services/websocket/mock';
+
+describe('OperationsService', () => {
+  beforeEach(() => {
+    TestBed.configureTestingModule({
+      providers: [
+        OperationsService,
+        {
+          provide: WebsocketService,
+          useClass: WebsocketServiceMock,
+        },
+      ],
+    });
+  });
+
+  it('replaces progress for an operation', inject(
+    [OperationsService, WebsocketService],
+    (svc: OperationsService, backendService: BackendService) => {
+      backendService.triggerHandler('operationProgress', {
+        id: 'op',
+        done: false,
+      });
+      backendService.triggerHandler('operationProgress', {
+        id: 'other',
+        done: false,
+      });
+      backendService.triggerHandler('operationProgress', {
+        id: 'op',
+        done: false,
+        view: { metadata: { type: 'text' } },
+      });
+
+      const current = svc.current.getValue();
+      expect(current.length).toEqual(2);
+      expect(current[0].view).toBeDefined();
+    }
+  ));
+
+  it('removes finished operations after a timeout', fakeAsync(
+    inject(
+      [OperationsService, WebsocketService],
+      (svc: OperationsService, backendService: BackendService) => {
+        backendService.triggerHandler('operationProgress', {
+          id: 'op',
+          done: true,
+        });
+        expect(svc.current.getValue().length).toEqual(1);
+
+        tick(finishedOperationTimeout);
+        expect(svc.current.getValue().length).toEqual(0);
+      }
+    )
+  ));
+});
@@ -0,0 +1,52 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { Injectable } from '@angular/core';
+import { BehaviorSubject } from 'rxjs';
+import { View } from '../../models/content';
+import { WebsocketService } from '../../modules/overview/services/websocket/websocket.service';
+
+export interface OperationProgress {
+  id: string;
+  done: boolean;
+  view: View;
+}
+
+// finished operations are shown for a while so their result can be read.
+export const finishedOperationTimeout = 10000;
+
+@Injectable({
+  providedIn: 'root',
+})
+export class OperationsService {
+  current = new BehaviorSubject<OperationProgress[]>([]);
+
+  constructor(websocketService: WebsocketService) {
+    websocketService.registerHandler('operationProgress', data => {
+      const progress = data as OperationProgress;
+      this.update(progress);
+
+      if (progress.done) {
+        setTimeout(() => this.remove(progress.id), finishedOperationTimeout);
+      }
+    });
+  }
+
+  remove(id: string) {
+    this.current.next(this.current.getValue().filter(op => op.id !== id));
+  }
+
+  private update(progress: OperationProgress) {
+    const operations = this.current.getValue();
+    const index = operations.findIndex(op => op.id === progress.id);
+    if (index < 0) {
+      this.current.next([...operations, progress]);
+      return;
+    }
+
+    const updated = [...operations];
+    updated[index] = progress;
+    this.current.next(updated);
+  }
+}
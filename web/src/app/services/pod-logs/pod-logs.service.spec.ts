@@ -4,7 +4,7 @@
 
 import { TestBed } from '@angular/core/testing';
 
-import { PodLogsService } from './pod-logs.service';
+import { logStreamToken, PodLogsService } from './pod-logs.service';
 
 describe('PodLogsService', () => {
   beforeEach(() => TestBed.configureTestingModule({}));
@@ -14,3 +14,22 @@ describe('PodLogsService', () => {
     expect(service).toBeTruthy();
   });
 });
+
+describe('logStreamToken', () => {
+  it('references the container stream', () => {
+    expect(logStreamToken('default', 'pod', 'app')).toEqual(
+      'namespace/default/pod/pod/container/app'
+    );
+  });
+
+  it('includes stream options', () => {
+    expect(
+      logStreamToken('default', 'pod', 'app', {
+        follow: true,
+        sinceSeconds: 60,
+      })
+    ).toEqual(
+      'namespace/default/pod/pod/container/app?follow=true&sinceSeconds=60'
+    );
+  });
+});
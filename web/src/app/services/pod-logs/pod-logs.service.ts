@@ -10,14 +10,43 @@ import getAPIBase from '../common/getAPIBase';
 
 const API_BASE = getAPIBase();
 
+export interface LogStreamOptions {
+  follow?: boolean;
+  sinceSeconds?: number;
+}
+
+// logStreamToken references a container log stream relative to the logs
+// API. It matches the token created by the logs component on the server.
+export const logStreamToken = (
+  namespace: string,
+  pod: string,
+  container: string,
+  options: LogStreamOptions = {}
+): string => {
+  const token = [
+    `namespace/${encodeURIComponent(namespace)}`,
+    `pod/${encodeURIComponent(pod)}`,
+    `container/${encodeURIComponent(container)}`,
+  ].join('/');
+
+  const params: string[] = [];
+  if (options.follow) {
+    params.push('follow=true');
+  }
+  if (options.sinceSeconds > 0) {
+    params.push(`sinceSeconds=${options.sinceSeconds}`);
+  }
+
+  return params.length > 0 ? `${token}?${params.join('&')}` : token;
+};
+
 export class PodLogsStreamer {
   public logEntries: BehaviorSubject<LogEntry[]>;
   private intervalID: number;
 
   constructor(
-    private namespace: string,
-    private pod: string,
-    private container: string,
+    private token: string,
+    private follow: boolean,
     private http: HttpClient
   ) {}
 
@@ -30,7 +59,9 @@ export class PodLogsStreamer {
   public start(): void {
     this.logEntries = new BehaviorSubject([]);
     this.poll();
-    this.intervalID = window.setInterval(() => this.poll(), 5000);
+    if (this.follow) {
+      this.intervalID = window.setInterval(() => this.poll(), 5000);
+    }
   }
 
   public close(): void {
@@ -39,14 +70,7 @@ export class PodLogsStreamer {
   }
 
   private logsUrl(): string {
-    return [
-      API_BASE,
-      'api/v1',
-      'logs',
-      `namespace/${this.namespace}`,
-      `pod/${this.pod}`,
-      `container/${this.container}`,
-    ].join('/');
+    return [API_BASE, 'api/v1', 'logs', this.token].join('/');
   }
 }
 
@@ -56,8 +80,21 @@ export class PodLogsStreamer {
 export class PodLogsService {
   constructor(private http: HttpClient) {}
 
-  public createStream(namespace, pod, container: string): PodLogsStreamer {
-    const pls = new PodLogsStreamer(namespace, pod, container, this.http);
+  public createStream(
+    namespace,
+    pod,
+    container: string,
+    options: LogStreamOptions = { follow: true }
+  ): PodLogsStreamer {
+    const token = logStreamToken(namespace, pod, container, options);
+    return this.createStreamFromToken(token, options.follow);
+  }
+
+  public createStreamFromToken(
+    token: string,
+    follow: boolean
+  ): PodLogsStreamer {
+    const pls = new PodLogsStreamer(token, follow, this.http);
     pls.start();
     return pls;
   }
@@ -0,0 +1,44 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { lineDiff, splitRows } from './lineDiff';
+
+describe('lineDiff', () => {
+  it('should mark unchanged text as the same', () => {
+    expect(lineDiff('a\nb', 'a\nb').map(line => line.kind)).toEqual([
+      'same',
+      'same',
+    ]);
+  });
+
+  it('should mark removed and added lines', () => {
+    const lines = lineDiff('a\nb\nc', 'a\nx\nc\nd');
+
+    expect(lines).toEqual([
+      { kind: 'same', text: 'a', beforeLine: 1, afterLine: 1 },
+      { kind: 'removed', text: 'b', beforeLine: 2 },
+      { kind: 'added', text: 'x', afterLine: 2 },
+      { kind: 'same', text: 'c', beforeLine: 3, afterLine: 3 },
+      { kind: 'added', text: 'd', afterLine: 4 },
+    ]);
+  });
+
+  it('should handle empty text', () => {
+    expect(lineDiff('', 'a').map(line => line.kind)).toEqual(['added']);
+    expect(lineDiff('a', '').map(line => line.kind)).toEqual(['removed']);
+  });
+});
+
+describe('splitRows', () => {
+  it('should pair removed and added lines', () => {
+    const rows = splitRows(lineDiff('a\nb\nc', 'a\nx\ny\nc'));
+
+    expect(rows.length).toBe(4);
+    expect(rows[1].before.text).toBe('b');
+    expect(rows[1].after.text).toBe('x');
+    expect(rows[2].before).toBeUndefined();
+    expect(rows[2].after.text).toBe('y');
+    expect(rows[3].before.text).toBe('c');
+  });
+});
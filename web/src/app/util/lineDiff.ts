@@ -0,0 +1,102 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+export type DiffLineKind = 'same' | 'added' | 'removed';
+
+export interface DiffLine {
+  kind: DiffLineKind;
+  text: string;
+  // line numbers are 1 based and are not set if the line is not present
+  beforeLine?: number;
+  afterLine?: number;
+}
+
+export interface DiffRow {
+  before?: DiffLine;
+  after?: DiffLine;
+}
+
+const splitLines = (s: string): string[] => (s ? s.split('\n') : []);
+
+// lineDiff compares text line by line using the longest common
+// subsequence of lines.
+export function lineDiff(before: string, after: string): DiffLine[] {
+  const a = splitLines(before);
+  const b = splitLines(after);
+
+  const lcs: number[][] = [];
+  for (let i = a.length; i >= 0; i--) {
+    lcs[i] = [];
+    for (let j = b.length; j >= 0; j--) {
+      if (i === a.length || j === b.length) {
+        lcs[i][j] = 0;
+      } else if (a[i] === b[j]) {
+        lcs[i][j] = lcs[i + 1][j + 1] + 1;
+      } else {
+        lcs[i][j] = Math.max(lcs[i + 1][j], lcs[i][j + 1]);
+      }
+    }
+  }
+
+  const lines: DiffLine[] = [];
+  let x = 0;
+  let y = 0;
+  while (x < a.length || y < b.length) {
+    if (x < a.length && y < b.length && a[x] === b[y]) {
+      lines.push({
+        kind: 'same',
+        text: a[x],
+        beforeLine: x + 1,
+        afterLine: y + 1,
+      });
+      x++;
+      y++;
+    } else if (
+      x < a.length &&
+      (y === b.length || lcs[x + 1][y] >= lcs[x][y + 1])
+    ) {
+      lines.push({ kind: 'removed', text: a[x], beforeLine: x + 1 });
+      x++;
+    } else {
+      lines.push({ kind: 'added', text: b[y], afterLine: y + 1 });
+      y++;
+    }
+  }
+
+  return lines;
+}
+
+// splitRows pairs removed and added lines so a diff can be displayed
+// side by side.
+export function splitRows(lines: DiffLine[]): DiffRow[] {
+  const rows: DiffRow[] = [];
+  let removed: DiffLine[] = [];
+  let added: DiffLine[] = [];
+
+  const flush = () => {
+    const n = Math.max(removed.length, added.length);
+    for (let i = 0; i < n; i++) {
+      rows.push({ before: removed[i], after: added[i] });
+    }
+    removed = [];
+    added = [];
+  };
+
+  lines.forEach(line => {
+    switch (line.kind) {
+      case 'removed':
+        removed.push(line);
+        break;
+      case 'added':
+        added.push(line);
+        break;
+      default:
+        flush();
+        rows.push({ before: line, after: line });
+    }
+  });
+  flush();
+
+  return rows;
+}
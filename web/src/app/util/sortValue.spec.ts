@@ -0,0 +1,55 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { TextView, TimestampView } from '../models/content';
+import { ColumnComparator, compareViews, sortValue } from './sortValue';
+
+const text = (value: string, sortValue?: number): TextView => ({
+  metadata: { type: 'text' },
+  config: { value, sortValue },
+});
+
+const timestamp = (value: number): TimestampView => ({
+  metadata: { type: 'timestamp' },
+  config: { timestamp: value },
+});
+
+describe('sortValue', () => {
+  it('uses the timestamp of a timestamp', () => {
+    expect(sortValue(timestamp(10))).toEqual(10);
+  });
+
+  it('uses the sort value of text if it is set', () => {
+    expect(sortValue(text('10', 10))).toEqual(10);
+    expect(sortValue(text('ten'))).toEqual('ten');
+  });
+
+  it('returns an empty string for a missing view', () => {
+    expect(sortValue(undefined)).toEqual('');
+  });
+});
+
+describe('compareViews', () => {
+  it('compares numbers numerically', () => {
+    expect(compareViews(text('9', 9), text('10', 10))).toBeLessThan(0);
+    expect(compareViews(timestamp(20), timestamp(10))).toBeGreaterThan(0);
+  });
+
+  it('compares text as strings', () => {
+    expect(compareViews(text('b'), text('a'))).toBeGreaterThan(0);
+  });
+
+  it('sorts numbers before strings', () => {
+    expect(compareViews(text('1', 1), text('a'))).toBeLessThan(0);
+  });
+});
+
+describe('ColumnComparator', () => {
+  it('compares rows by column', () => {
+    const comparator = new ColumnComparator('Restarts');
+    const a = { Restarts: text('2', 2) };
+    const b = { Restarts: text('11', 11) };
+    expect(comparator.compare(a, b)).toBeLessThan(0);
+  });
+});
@@ -0,0 +1,65 @@
+// Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { ClrDatagridComparatorInterface } from '@clr/angular';
+import {
+  LinkView,
+  TableRow,
+  TextView,
+  TimestampView,
+  View,
+} from '../models/content';
+
+// sortValue returns the value a view is sorted by. Timestamps and text with
+// a sort value sort as numbers, and other text sorts as strings.
+export function sortValue(view: View): number | string {
+  if (!view) {
+    return '';
+  }
+
+  switch (view.metadata.type) {
+    case 'timestamp':
+      return (view as TimestampView).config.timestamp;
+    case 'text': {
+      const text = view as TextView;
+      if (typeof text.config.sortValue === 'number') {
+        return text.config.sortValue;
+      }
+      return text.config.value;
+    }
+    case 'link':
+      return (view as LinkView).config.value;
+    default:
+      return '';
+  }
+}
+
+// compareViews compares views by their sort values. Numbers sort before
+// strings.
+export function compareViews(a: View, b: View): number {
+  const x = sortValue(a);
+  const y = sortValue(b);
+
+  if (typeof x === 'number' && typeof y === 'number') {
+    return x - y;
+  }
+  if (typeof x === 'number') {
+    return -1;
+  }
+  if (typeof y === 'number') {
+    return 1;
+  }
+
+  return x.localeCompare(y);
+}
+
+// ColumnComparator compares table rows by the view in a column.
+export class ColumnComparator
+  implements ClrDatagridComparatorInterface<TableRow> {
+  constructor(private accessor: string) {}
+
+  compare(a: TableRow, b: TableRow): number {
+    return compareViews(a[this.accessor], b[this.accessor]);
+  }
+}